@@ -163,15 +163,279 @@ func (x *_GenesisState_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ExecutorChangePlan
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutorChangePlan)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutorChangePlan)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ExecutorChangePlan)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ExecutorChangePlan)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*ScheduledMessages
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMessages)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMessages)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledMessages)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(ScheduledMessages)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*AdminProposal
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AdminProposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AdminProposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(AdminProposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(AdminProposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*ValidatorSigningInfo
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorSigningInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorSigningInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorSigningInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(ValidatorSigningInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*ValidatorMissedBlocks
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorMissedBlocks)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorMissedBlocks)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorMissedBlocks)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(ValidatorMissedBlocks)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                        protoreflect.MessageDescriptor
-	fd_GenesisState_params                 protoreflect.FieldDescriptor
-	fd_GenesisState_last_validator_powers  protoreflect.FieldDescriptor
-	fd_GenesisState_validators             protoreflect.FieldDescriptor
-	fd_GenesisState_exported               protoreflect.FieldDescriptor
-	fd_GenesisState_next_l2_sequence       protoreflect.FieldDescriptor
-	fd_GenesisState_finalized_l1_sequences protoreflect.FieldDescriptor
-	fd_GenesisState_bridge_info            protoreflect.FieldDescriptor
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_last_validator_powers      protoreflect.FieldDescriptor
+	fd_GenesisState_validators                 protoreflect.FieldDescriptor
+	fd_GenesisState_exported                   protoreflect.FieldDescriptor
+	fd_GenesisState_next_l2_sequence           protoreflect.FieldDescriptor
+	fd_GenesisState_finalized_l1_sequences     protoreflect.FieldDescriptor
+	fd_GenesisState_bridge_info                protoreflect.FieldDescriptor
+	fd_GenesisState_next_l1_sequence           protoreflect.FieldDescriptor
+	fd_GenesisState_executor_change_plans      protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_messages         protoreflect.FieldDescriptor
+	fd_GenesisState_next_scheduled_messages_id protoreflect.FieldDescriptor
+	fd_GenesisState_pending_admin              protoreflect.FieldDescriptor
+	fd_GenesisState_admin_proposals            protoreflect.FieldDescriptor
+	fd_GenesisState_next_admin_proposal_id     protoreflect.FieldDescriptor
+	fd_GenesisState_signing_infos              protoreflect.FieldDescriptor
+	fd_GenesisState_missed_blocks              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -184,6 +448,15 @@ func init() {
 	fd_GenesisState_next_l2_sequence = md_GenesisState.Fields().ByName("next_l2_sequence")
 	fd_GenesisState_finalized_l1_sequences = md_GenesisState.Fields().ByName("finalized_l1_sequences")
 	fd_GenesisState_bridge_info = md_GenesisState.Fields().ByName("bridge_info")
+	fd_GenesisState_next_l1_sequence = md_GenesisState.Fields().ByName("next_l1_sequence")
+	fd_GenesisState_executor_change_plans = md_GenesisState.Fields().ByName("executor_change_plans")
+	fd_GenesisState_scheduled_messages = md_GenesisState.Fields().ByName("scheduled_messages")
+	fd_GenesisState_next_scheduled_messages_id = md_GenesisState.Fields().ByName("next_scheduled_messages_id")
+	fd_GenesisState_pending_admin = md_GenesisState.Fields().ByName("pending_admin")
+	fd_GenesisState_admin_proposals = md_GenesisState.Fields().ByName("admin_proposals")
+	fd_GenesisState_next_admin_proposal_id = md_GenesisState.Fields().ByName("next_admin_proposal_id")
+	fd_GenesisState_signing_infos = md_GenesisState.Fields().ByName("signing_infos")
+	fd_GenesisState_missed_blocks = md_GenesisState.Fields().ByName("missed_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -293,6 +566,60 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.NextL1Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextL1Sequence)
+		if !f(fd_GenesisState_next_l1_sequence, value) {
+			return
+		}
+	}
+	if len(x.ExecutorChangePlans) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ExecutorChangePlans})
+		if !f(fd_GenesisState_executor_change_plans, value) {
+			return
+		}
+	}
+	if len(x.ScheduledMessages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ScheduledMessages})
+		if !f(fd_GenesisState_scheduled_messages, value) {
+			return
+		}
+	}
+	if x.NextScheduledMessagesId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextScheduledMessagesId)
+		if !f(fd_GenesisState_next_scheduled_messages_id, value) {
+			return
+		}
+	}
+	if x.PendingAdmin != "" {
+		value := protoreflect.ValueOfString(x.PendingAdmin)
+		if !f(fd_GenesisState_pending_admin, value) {
+			return
+		}
+	}
+	if len(x.AdminProposals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.AdminProposals})
+		if !f(fd_GenesisState_admin_proposals, value) {
+			return
+		}
+	}
+	if x.NextAdminProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextAdminProposalId)
+		if !f(fd_GenesisState_next_admin_proposal_id, value) {
+			return
+		}
+	}
+	if len(x.SigningInfos) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.SigningInfos})
+		if !f(fd_GenesisState_signing_infos, value) {
+			return
+		}
+	}
+	if len(x.MissedBlocks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.MissedBlocks})
+		if !f(fd_GenesisState_missed_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -322,6 +649,24 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.FinalizedL1Sequences) != 0
 	case "opinit.opchild.v1.GenesisState.bridge_info":
 		return x.BridgeInfo != nil
+	case "opinit.opchild.v1.GenesisState.next_l1_sequence":
+		return x.NextL1Sequence != uint64(0)
+	case "opinit.opchild.v1.GenesisState.executor_change_plans":
+		return len(x.ExecutorChangePlans) != 0
+	case "opinit.opchild.v1.GenesisState.scheduled_messages":
+		return len(x.ScheduledMessages) != 0
+	case "opinit.opchild.v1.GenesisState.next_scheduled_messages_id":
+		return x.NextScheduledMessagesId != uint64(0)
+	case "opinit.opchild.v1.GenesisState.pending_admin":
+		return x.PendingAdmin != ""
+	case "opinit.opchild.v1.GenesisState.admin_proposals":
+		return len(x.AdminProposals) != 0
+	case "opinit.opchild.v1.GenesisState.next_admin_proposal_id":
+		return x.NextAdminProposalId != uint64(0)
+	case "opinit.opchild.v1.GenesisState.signing_infos":
+		return len(x.SigningInfos) != 0
+	case "opinit.opchild.v1.GenesisState.missed_blocks":
+		return len(x.MissedBlocks) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.GenesisState"))
@@ -352,6 +697,24 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.FinalizedL1Sequences = nil
 	case "opinit.opchild.v1.GenesisState.bridge_info":
 		x.BridgeInfo = nil
+	case "opinit.opchild.v1.GenesisState.next_l1_sequence":
+		x.NextL1Sequence = uint64(0)
+	case "opinit.opchild.v1.GenesisState.executor_change_plans":
+		x.ExecutorChangePlans = nil
+	case "opinit.opchild.v1.GenesisState.scheduled_messages":
+		x.ScheduledMessages = nil
+	case "opinit.opchild.v1.GenesisState.next_scheduled_messages_id":
+		x.NextScheduledMessagesId = uint64(0)
+	case "opinit.opchild.v1.GenesisState.pending_admin":
+		x.PendingAdmin = ""
+	case "opinit.opchild.v1.GenesisState.admin_proposals":
+		x.AdminProposals = nil
+	case "opinit.opchild.v1.GenesisState.next_admin_proposal_id":
+		x.NextAdminProposalId = uint64(0)
+	case "opinit.opchild.v1.GenesisState.signing_infos":
+		x.SigningInfos = nil
+	case "opinit.opchild.v1.GenesisState.missed_blocks":
+		x.MissedBlocks = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.GenesisState"))
@@ -398,6 +761,48 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "opinit.opchild.v1.GenesisState.bridge_info":
 		value := x.BridgeInfo
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.opchild.v1.GenesisState.next_l1_sequence":
+		value := x.NextL1Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.GenesisState.executor_change_plans":
+		if len(x.ExecutorChangePlans) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ExecutorChangePlans}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.GenesisState.scheduled_messages":
+		if len(x.ScheduledMessages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.ScheduledMessages}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.GenesisState.next_scheduled_messages_id":
+		value := x.NextScheduledMessagesId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.GenesisState.pending_admin":
+		value := x.PendingAdmin
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.GenesisState.admin_proposals":
+		if len(x.AdminProposals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.AdminProposals}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.GenesisState.next_admin_proposal_id":
+		value := x.NextAdminProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.GenesisState.signing_infos":
+		if len(x.SigningInfos) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.SigningInfos}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.GenesisState.missed_blocks":
+		if len(x.MissedBlocks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.MissedBlocks}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.GenesisState"))
@@ -438,6 +843,34 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.FinalizedL1Sequences = *clv.list
 	case "opinit.opchild.v1.GenesisState.bridge_info":
 		x.BridgeInfo = value.Message().Interface().(*BridgeInfo)
+	case "opinit.opchild.v1.GenesisState.next_l1_sequence":
+		x.NextL1Sequence = value.Uint()
+	case "opinit.opchild.v1.GenesisState.executor_change_plans":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ExecutorChangePlans = *clv.list
+	case "opinit.opchild.v1.GenesisState.scheduled_messages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.ScheduledMessages = *clv.list
+	case "opinit.opchild.v1.GenesisState.next_scheduled_messages_id":
+		x.NextScheduledMessagesId = value.Uint()
+	case "opinit.opchild.v1.GenesisState.pending_admin":
+		x.PendingAdmin = value.Interface().(string)
+	case "opinit.opchild.v1.GenesisState.admin_proposals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.AdminProposals = *clv.list
+	case "opinit.opchild.v1.GenesisState.next_admin_proposal_id":
+		x.NextAdminProposalId = value.Uint()
+	case "opinit.opchild.v1.GenesisState.signing_infos":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.SigningInfos = *clv.list
+	case "opinit.opchild.v1.GenesisState.missed_blocks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.MissedBlocks = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.GenesisState"))
@@ -486,10 +919,48 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.BridgeInfo = new(BridgeInfo)
 		}
 		return protoreflect.ValueOfMessage(x.BridgeInfo.ProtoReflect())
+	case "opinit.opchild.v1.GenesisState.executor_change_plans":
+		if x.ExecutorChangePlans == nil {
+			x.ExecutorChangePlans = []*ExecutorChangePlan{}
+		}
+		value := &_GenesisState_10_list{list: &x.ExecutorChangePlans}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.GenesisState.scheduled_messages":
+		if x.ScheduledMessages == nil {
+			x.ScheduledMessages = []*ScheduledMessages{}
+		}
+		value := &_GenesisState_11_list{list: &x.ScheduledMessages}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.GenesisState.admin_proposals":
+		if x.AdminProposals == nil {
+			x.AdminProposals = []*AdminProposal{}
+		}
+		value := &_GenesisState_14_list{list: &x.AdminProposals}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.GenesisState.signing_infos":
+		if x.SigningInfos == nil {
+			x.SigningInfos = []*ValidatorSigningInfo{}
+		}
+		value := &_GenesisState_16_list{list: &x.SigningInfos}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.GenesisState.missed_blocks":
+		if x.MissedBlocks == nil {
+			x.MissedBlocks = []*ValidatorMissedBlocks{}
+		}
+		value := &_GenesisState_17_list{list: &x.MissedBlocks}
+		return protoreflect.ValueOfList(value)
 	case "opinit.opchild.v1.GenesisState.exported":
 		panic(fmt.Errorf("field exported of message opinit.opchild.v1.GenesisState is not mutable"))
 	case "opinit.opchild.v1.GenesisState.next_l2_sequence":
 		panic(fmt.Errorf("field next_l2_sequence of message opinit.opchild.v1.GenesisState is not mutable"))
+	case "opinit.opchild.v1.GenesisState.next_l1_sequence":
+		panic(fmt.Errorf("field next_l1_sequence of message opinit.opchild.v1.GenesisState is not mutable"))
+	case "opinit.opchild.v1.GenesisState.next_scheduled_messages_id":
+		panic(fmt.Errorf("field next_scheduled_messages_id of message opinit.opchild.v1.GenesisState is not mutable"))
+	case "opinit.opchild.v1.GenesisState.pending_admin":
+		panic(fmt.Errorf("field pending_admin of message opinit.opchild.v1.GenesisState is not mutable"))
+	case "opinit.opchild.v1.GenesisState.next_admin_proposal_id":
+		panic(fmt.Errorf("field next_admin_proposal_id of message opinit.opchild.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.GenesisState"))
@@ -522,6 +993,29 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "opinit.opchild.v1.GenesisState.bridge_info":
 		m := new(BridgeInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.opchild.v1.GenesisState.next_l1_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.GenesisState.executor_change_plans":
+		list := []*ExecutorChangePlan{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "opinit.opchild.v1.GenesisState.scheduled_messages":
+		list := []*ScheduledMessages{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "opinit.opchild.v1.GenesisState.next_scheduled_messages_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.GenesisState.pending_admin":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.GenesisState.admin_proposals":
+		list := []*AdminProposal{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "opinit.opchild.v1.GenesisState.next_admin_proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.GenesisState.signing_infos":
+		list := []*ValidatorSigningInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "opinit.opchild.v1.GenesisState.missed_blocks":
+		list := []*ValidatorMissedBlocks{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.GenesisState"))
@@ -624,21 +1118,64 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.BridgeInfo)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.NextL1Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextL1Sequence))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if len(x.ExecutorChangePlans) > 0 {
+			for _, e := range x.ExecutorChangePlans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
+		if len(x.ScheduledMessages) > 0 {
+			for _, e := range x.ScheduledMessages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextScheduledMessagesId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextScheduledMessagesId))
+		}
+		l = len(x.PendingAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AdminProposals) > 0 {
+			for _, e := range x.AdminProposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextAdminProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextAdminProposalId))
+		}
+		if len(x.SigningInfos) > 0 {
+			for _, e := range x.SigningInfos {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MissedBlocks) > 0 {
+			for _, e := range x.MissedBlocks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
 			}, nil
 		}
 		options := runtime.MarshalInputToOptions(input)
@@ -653,6 +1190,112 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MissedBlocks) > 0 {
+			for iNdEx := len(x.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MissedBlocks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.SigningInfos) > 0 {
+			for iNdEx := len(x.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SigningInfos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.NextAdminProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextAdminProposalId))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.AdminProposals) > 0 {
+			for iNdEx := len(x.AdminProposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AdminProposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.PendingAdmin) > 0 {
+			i -= len(x.PendingAdmin)
+			copy(dAtA[i:], x.PendingAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingAdmin)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.NextScheduledMessagesId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextScheduledMessagesId))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.ScheduledMessages) > 0 {
+			for iNdEx := len(x.ScheduledMessages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledMessages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.ExecutorChangePlans) > 0 {
+			for iNdEx := len(x.ExecutorChangePlans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecutorChangePlans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.NextL1Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextL1Sequence))
+			i--
+			dAtA[i] = 0x48
+		}
 		if x.BridgeInfo != nil {
 			encoded, err := options.Marshal(x.BridgeInfo)
 			if err != nil {
@@ -859,19 +1502,956 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastValidatorPowers = append(x.LastValidatorPowers, &LastValidatorPower{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastValidatorPowers[len(x.LastValidatorPowers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, &Validator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exported", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Exported = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextL2Sequence", wireType)
+				}
+				x.NextL2Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextL2Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.FinalizedL1Sequences = append(x.FinalizedL1Sequences, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.FinalizedL1Sequences) == 0 {
+						x.FinalizedL1Sequences = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.FinalizedL1Sequences = append(x.FinalizedL1Sequences, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedL1Sequences", wireType)
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BridgeInfo == nil {
+					x.BridgeInfo = &BridgeInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BridgeInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextL1Sequence", wireType)
+				}
+				x.NextL1Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextL1Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorChangePlans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutorChangePlans = append(x.ExecutorChangePlans, &ExecutorChangePlan{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutorChangePlans[len(x.ExecutorChangePlans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledMessages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledMessages = append(x.ScheduledMessages, &ScheduledMessages{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledMessages[len(x.ScheduledMessages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMessagesId", wireType)
+				}
+				x.NextScheduledMessagesId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextScheduledMessagesId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdminProposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdminProposals = append(x.AdminProposals, &AdminProposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdminProposals[len(x.AdminProposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextAdminProposalId", wireType)
+				}
+				x.NextAdminProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextAdminProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningInfos = append(x.SigningInfos, &ValidatorSigningInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SigningInfos[len(x.SigningInfos)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MissedBlocks = append(x.MissedBlocks, &ValidatorMissedBlocks{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MissedBlocks[len(x.MissedBlocks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorMissedBlocks_2_list)(nil)
+
+type _ValidatorMissedBlocks_2_list struct {
+	list *[]int64
+}
+
+func (x *_ValidatorMissedBlocks_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorMissedBlocks_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_ValidatorMissedBlocks_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorMissedBlocks_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorMissedBlocks_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValidatorMissedBlocks at list field MissedIndexes as it is not of Message kind"))
+}
+
+func (x *_ValidatorMissedBlocks_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorMissedBlocks_2_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_ValidatorMissedBlocks_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorMissedBlocks                protoreflect.MessageDescriptor
+	fd_ValidatorMissedBlocks_address        protoreflect.FieldDescriptor
+	fd_ValidatorMissedBlocks_missed_indexes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_genesis_proto_init()
+	md_ValidatorMissedBlocks = File_opinit_opchild_v1_genesis_proto.Messages().ByName("ValidatorMissedBlocks")
+	fd_ValidatorMissedBlocks_address = md_ValidatorMissedBlocks.Fields().ByName("address")
+	fd_ValidatorMissedBlocks_missed_indexes = md_ValidatorMissedBlocks.Fields().ByName("missed_indexes")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorMissedBlocks)(nil)
+
+type fastReflection_ValidatorMissedBlocks ValidatorMissedBlocks
+
+func (x *ValidatorMissedBlocks) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorMissedBlocks)(x)
+}
+
+func (x *ValidatorMissedBlocks) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorMissedBlocks_messageType fastReflection_ValidatorMissedBlocks_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorMissedBlocks_messageType{}
+
+type fastReflection_ValidatorMissedBlocks_messageType struct{}
+
+func (x fastReflection_ValidatorMissedBlocks_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorMissedBlocks)(nil)
+}
+func (x fastReflection_ValidatorMissedBlocks_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorMissedBlocks)
+}
+func (x fastReflection_ValidatorMissedBlocks_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorMissedBlocks
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorMissedBlocks) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorMissedBlocks
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorMissedBlocks) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorMissedBlocks_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorMissedBlocks) New() protoreflect.Message {
+	return new(fastReflection_ValidatorMissedBlocks)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorMissedBlocks) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorMissedBlocks)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorMissedBlocks) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ValidatorMissedBlocks_address, value) {
+			return
+		}
+	}
+	if len(x.MissedIndexes) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorMissedBlocks_2_list{list: &x.MissedIndexes})
+		if !f(fd_ValidatorMissedBlocks_missed_indexes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorMissedBlocks) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorMissedBlocks.address":
+		return x.Address != ""
+	case "opinit.opchild.v1.ValidatorMissedBlocks.missed_indexes":
+		return len(x.MissedIndexes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorMissedBlocks"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorMissedBlocks does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorMissedBlocks) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorMissedBlocks.address":
+		x.Address = ""
+	case "opinit.opchild.v1.ValidatorMissedBlocks.missed_indexes":
+		x.MissedIndexes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorMissedBlocks"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorMissedBlocks does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorMissedBlocks) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.ValidatorMissedBlocks.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.ValidatorMissedBlocks.missed_indexes":
+		if len(x.MissedIndexes) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorMissedBlocks_2_list{})
+		}
+		listValue := &_ValidatorMissedBlocks_2_list{list: &x.MissedIndexes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorMissedBlocks"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorMissedBlocks does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorMissedBlocks) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorMissedBlocks.address":
+		x.Address = value.Interface().(string)
+	case "opinit.opchild.v1.ValidatorMissedBlocks.missed_indexes":
+		lv := value.List()
+		clv := lv.(*_ValidatorMissedBlocks_2_list)
+		x.MissedIndexes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorMissedBlocks"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorMissedBlocks does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorMissedBlocks) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorMissedBlocks.missed_indexes":
+		if x.MissedIndexes == nil {
+			x.MissedIndexes = []int64{}
+		}
+		value := &_ValidatorMissedBlocks_2_list{list: &x.MissedIndexes}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.ValidatorMissedBlocks.address":
+		panic(fmt.Errorf("field address of message opinit.opchild.v1.ValidatorMissedBlocks is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorMissedBlocks"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorMissedBlocks does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorMissedBlocks) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorMissedBlocks.address":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.ValidatorMissedBlocks.missed_indexes":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_ValidatorMissedBlocks_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorMissedBlocks"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorMissedBlocks does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorMissedBlocks) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.ValidatorMissedBlocks", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorMissedBlocks) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorMissedBlocks) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorMissedBlocks) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorMissedBlocks) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorMissedBlocks)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MissedIndexes) > 0 {
+			l = 0
+			for _, e := range x.MissedIndexes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorMissedBlocks)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MissedIndexes) > 0 {
+			var pksize2 int
+			for _, num := range x.MissedIndexes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.MissedIndexes {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorMissedBlocks)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastValidatorPowers = append(x.LastValidatorPowers, &LastValidatorPower{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastValidatorPowers[len(x.LastValidatorPowers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 3:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorMissedBlocks: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -881,68 +2461,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validators = append(x.Validators, &Validator{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exported", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Exported = bool(v != 0)
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextL2Sequence", wireType)
-				}
-				x.NextL2Sequence = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.NextL2Sequence |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
+			case 2:
 				if wireType == 0 {
-					var v uint64
+					var v int64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -952,12 +2491,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						v |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					x.FinalizedL1Sequences = append(x.FinalizedL1Sequences, v)
+					x.MissedIndexes = append(x.MissedIndexes, v)
 				} else if wireType == 2 {
 					var packedLen int
 					for shift := uint(0); ; shift += 7 {
@@ -992,11 +2531,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						}
 					}
 					elementCount = count
-					if elementCount != 0 && len(x.FinalizedL1Sequences) == 0 {
-						x.FinalizedL1Sequences = make([]uint64, 0, elementCount)
+					if elementCount != 0 && len(x.MissedIndexes) == 0 {
+						x.MissedIndexes = make([]int64, 0, elementCount)
 					}
 					for iNdEx < postIndex {
-						var v uint64
+						var v int64
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1006,52 +2545,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							v |= uint64(b&0x7F) << shift
+							v |= int64(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
-						x.FinalizedL1Sequences = append(x.FinalizedL1Sequences, v)
+						x.MissedIndexes = append(x.MissedIndexes, v)
 					}
 				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedL1Sequences", wireType)
-				}
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BridgeInfo == nil {
-					x.BridgeInfo = &BridgeInfo{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BridgeInfo); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedIndexes", wireType)
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1109,7 +2612,7 @@ func (x *LastValidatorPower) ProtoReflect() protoreflect.Message {
 }
 
 func (x *LastValidatorPower) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_opchild_v1_genesis_proto_msgTypes[1]
+	mi := &file_opinit_opchild_v1_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,11 +3083,33 @@ type GenesisState struct {
 	// of the last-block's bonded validators.
 	LastValidatorPowers []*LastValidatorPower `protobuf:"bytes,2,rep,name=last_validator_powers,json=lastValidatorPowers,proto3" json:"last_validator_powers,omitempty"`
 	// delegations defines the validator set at genesis.
-	Validators           []*Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
-	Exported             bool         `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
-	NextL2Sequence       uint64       `protobuf:"varint,6,opt,name=next_l2_sequence,json=nextL2Sequence,proto3" json:"next_l2_sequence,omitempty"`
-	FinalizedL1Sequences []uint64     `protobuf:"varint,7,rep,packed,name=finalized_l1_sequences,json=finalizedL1Sequences,proto3" json:"finalized_l1_sequences,omitempty"`
-	BridgeInfo           *BridgeInfo  `protobuf:"bytes,8,opt,name=bridge_info,json=bridgeInfo,proto3" json:"bridge_info,omitempty"`
+	Validators     []*Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	Exported       bool         `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
+	NextL2Sequence uint64       `protobuf:"varint,6,opt,name=next_l2_sequence,json=nextL2Sequence,proto3" json:"next_l2_sequence,omitempty"`
+	// finalized_l1_sequences are the l1 sequences finalized above the
+	// next_l1_sequence.
+	FinalizedL1Sequences []uint64    `protobuf:"varint,7,rep,packed,name=finalized_l1_sequences,json=finalizedL1Sequences,proto3" json:"finalized_l1_sequences,omitempty"`
+	BridgeInfo           *BridgeInfo `protobuf:"bytes,8,opt,name=bridge_info,json=bridgeInfo,proto3" json:"bridge_info,omitempty"`
+	// next_l1_sequence is the l1 sequence next to the highest contiguous
+	// finalized l1 sequence; zero to derive it from finalized_l1_sequences.
+	NextL1Sequence uint64 `protobuf:"varint,9,opt,name=next_l1_sequence,json=nextL1Sequence,proto3" json:"next_l1_sequence,omitempty"`
+	// executor_change_plans are the executor change plans not applied yet.
+	ExecutorChangePlans []*ExecutorChangePlan `protobuf:"bytes,10,rep,name=executor_change_plans,json=executorChangePlans,proto3" json:"executor_change_plans,omitempty"`
+	// scheduled_messages are the admin messages waiting for the execution.
+	ScheduledMessages []*ScheduledMessages `protobuf:"bytes,11,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages,omitempty"`
+	// next_scheduled_messages_id is the id of the next scheduled messages.
+	NextScheduledMessagesId uint64 `protobuf:"varint,12,opt,name=next_scheduled_messages_id,json=nextScheduledMessagesId,proto3" json:"next_scheduled_messages_id,omitempty"`
+	// pending_admin is the address proposed to be the next admin.
+	PendingAdmin string `protobuf:"bytes,13,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
+	// admin_proposals are the admin proposals waiting for the approvals.
+	AdminProposals []*AdminProposal `protobuf:"bytes,14,rep,name=admin_proposals,json=adminProposals,proto3" json:"admin_proposals,omitempty"`
+	// next_admin_proposal_id is the id of the next admin proposal.
+	NextAdminProposalId uint64 `protobuf:"varint,15,opt,name=next_admin_proposal_id,json=nextAdminProposalId,proto3" json:"next_admin_proposal_id,omitempty"`
+	// signing_infos are the liveness information of the validators.
+	SigningInfos []*ValidatorSigningInfo `protobuf:"bytes,16,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+	// missed_blocks are the blocks missed by the validators in the signed
+	// blocks window.
+	MissedBlocks []*ValidatorMissedBlocks `protobuf:"bytes,17,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1656,6 +3181,116 @@ func (x *GenesisState) GetBridgeInfo() *BridgeInfo {
 	return nil
 }
 
+func (x *GenesisState) GetNextL1Sequence() uint64 {
+	if x != nil {
+		return x.NextL1Sequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetExecutorChangePlans() []*ExecutorChangePlan {
+	if x != nil {
+		return x.ExecutorChangePlans
+	}
+	return nil
+}
+
+func (x *GenesisState) GetScheduledMessages() []*ScheduledMessages {
+	if x != nil {
+		return x.ScheduledMessages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextScheduledMessagesId() uint64 {
+	if x != nil {
+		return x.NextScheduledMessagesId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPendingAdmin() string {
+	if x != nil {
+		return x.PendingAdmin
+	}
+	return ""
+}
+
+func (x *GenesisState) GetAdminProposals() []*AdminProposal {
+	if x != nil {
+		return x.AdminProposals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextAdminProposalId() uint64 {
+	if x != nil {
+		return x.NextAdminProposalId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSigningInfos() []*ValidatorSigningInfo {
+	if x != nil {
+		return x.SigningInfos
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMissedBlocks() []*ValidatorMissedBlocks {
+	if x != nil {
+		return x.MissedBlocks
+	}
+	return nil
+}
+
+// ValidatorMissedBlocks contains the indexes of the missed blocks of a
+// validator in the signed blocks window.
+type ValidatorMissedBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// missed_indexes are the indexes of the missed blocks.
+	MissedIndexes []int64 `protobuf:"varint,2,rep,packed,name=missed_indexes,json=missedIndexes,proto3" json:"missed_indexes,omitempty"`
+}
+
+func (x *ValidatorMissedBlocks) Reset() {
+	*x = ValidatorMissedBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorMissedBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorMissedBlocks) ProtoMessage() {}
+
+// Deprecated: Use ValidatorMissedBlocks.ProtoReflect.Descriptor instead.
+func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorMissedBlocks) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorMissedBlocks) GetMissedIndexes() []int64 {
+	if x != nil {
+		return x.MissedIndexes
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	state         protoimpl.MessageState
@@ -1671,7 +3306,7 @@ type LastValidatorPower struct {
 func (x *LastValidatorPower) Reset() {
 	*x = LastValidatorPower{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_opchild_v1_genesis_proto_msgTypes[1]
+		mi := &file_opinit_opchild_v1_genesis_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1685,7 +3320,7 @@ func (*LastValidatorPower) ProtoMessage() {}
 
 // Deprecated: Use LastValidatorPower.ProtoReflect.Descriptor instead.
 func (*LastValidatorPower) Descriptor() ([]byte, []int) {
-	return file_opinit_opchild_v1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_opinit_opchild_v1_genesis_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LastValidatorPower) GetAddress() string {
@@ -1714,7 +3349,7 @@ var file_opinit_opchild_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
 	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
 	0x2f, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x08, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
 	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
 	0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
@@ -1742,26 +3377,75 @@ var file_opinit_opchild_v1_genesis_proto_rawDesc = []byte{
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
 	0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67,
 	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x42, 0xca, 0x01, 0x0a, 0x15,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50,
-	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f,
-	0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x11, 0x4f, 0x70, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x11, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x13, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x31, 0x5f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x78,
+	0x74, 0x4c, 0x31, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x12, 0x5e, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x54, 0x0a, 0x0f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f,
+	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x57,
+	0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
+	0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x58, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x22, 0x72, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x42,
+	0xca, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
+	0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
+	0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02,
+	0x11, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x11, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c,
+	0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a,
+	0x3a, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1776,24 +3460,34 @@ func file_opinit_opchild_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_opinit_opchild_v1_genesis_proto_rawDescData
 }
 
-var file_opinit_opchild_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_opinit_opchild_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_opinit_opchild_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),       // 0: opinit.opchild.v1.GenesisState
-	(*LastValidatorPower)(nil), // 1: opinit.opchild.v1.LastValidatorPower
-	(*Params)(nil),             // 2: opinit.opchild.v1.Params
-	(*Validator)(nil),          // 3: opinit.opchild.v1.Validator
-	(*BridgeInfo)(nil),         // 4: opinit.opchild.v1.BridgeInfo
+	(*GenesisState)(nil),          // 0: opinit.opchild.v1.GenesisState
+	(*ValidatorMissedBlocks)(nil), // 1: opinit.opchild.v1.ValidatorMissedBlocks
+	(*LastValidatorPower)(nil),    // 2: opinit.opchild.v1.LastValidatorPower
+	(*Params)(nil),                // 3: opinit.opchild.v1.Params
+	(*Validator)(nil),             // 4: opinit.opchild.v1.Validator
+	(*BridgeInfo)(nil),            // 5: opinit.opchild.v1.BridgeInfo
+	(*ExecutorChangePlan)(nil),    // 6: opinit.opchild.v1.ExecutorChangePlan
+	(*ScheduledMessages)(nil),     // 7: opinit.opchild.v1.ScheduledMessages
+	(*AdminProposal)(nil),         // 8: opinit.opchild.v1.AdminProposal
+	(*ValidatorSigningInfo)(nil),  // 9: opinit.opchild.v1.ValidatorSigningInfo
 }
 var file_opinit_opchild_v1_genesis_proto_depIdxs = []int32{
-	2, // 0: opinit.opchild.v1.GenesisState.params:type_name -> opinit.opchild.v1.Params
-	1, // 1: opinit.opchild.v1.GenesisState.last_validator_powers:type_name -> opinit.opchild.v1.LastValidatorPower
-	3, // 2: opinit.opchild.v1.GenesisState.validators:type_name -> opinit.opchild.v1.Validator
-	4, // 3: opinit.opchild.v1.GenesisState.bridge_info:type_name -> opinit.opchild.v1.BridgeInfo
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 0: opinit.opchild.v1.GenesisState.params:type_name -> opinit.opchild.v1.Params
+	2, // 1: opinit.opchild.v1.GenesisState.last_validator_powers:type_name -> opinit.opchild.v1.LastValidatorPower
+	4, // 2: opinit.opchild.v1.GenesisState.validators:type_name -> opinit.opchild.v1.Validator
+	5, // 3: opinit.opchild.v1.GenesisState.bridge_info:type_name -> opinit.opchild.v1.BridgeInfo
+	6, // 4: opinit.opchild.v1.GenesisState.executor_change_plans:type_name -> opinit.opchild.v1.ExecutorChangePlan
+	7, // 5: opinit.opchild.v1.GenesisState.scheduled_messages:type_name -> opinit.opchild.v1.ScheduledMessages
+	8, // 6: opinit.opchild.v1.GenesisState.admin_proposals:type_name -> opinit.opchild.v1.AdminProposal
+	9, // 7: opinit.opchild.v1.GenesisState.signing_infos:type_name -> opinit.opchild.v1.ValidatorSigningInfo
+	1, // 8: opinit.opchild.v1.GenesisState.missed_blocks:type_name -> opinit.opchild.v1.ValidatorMissedBlocks
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_opinit_opchild_v1_genesis_proto_init() }
@@ -1816,6 +3510,18 @@ func file_opinit_opchild_v1_genesis_proto_init() {
 			}
 		}
 		file_opinit_opchild_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorMissedBlocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LastValidatorPower); i {
 			case 0:
 				return &v.state
@@ -1834,7 +3540,7 @@ func file_opinit_opchild_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_opinit_opchild_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Validators_FullMethodName = "/opinit.opchild.v1.Query/Validators"
-	Query_Validator_FullMethodName  = "/opinit.opchild.v1.Query/Validator"
-	Query_BridgeInfo_FullMethodName = "/opinit.opchild.v1.Query/BridgeInfo"
-	Query_Params_FullMethodName     = "/opinit.opchild.v1.Query/Params"
+	Query_Validators_FullMethodName            = "/opinit.opchild.v1.Query/Validators"
+	Query_Validator_FullMethodName             = "/opinit.opchild.v1.Query/Validator"
+	Query_BridgeInfo_FullMethodName            = "/opinit.opchild.v1.Query/BridgeInfo"
+	Query_Params_FullMethodName                = "/opinit.opchild.v1.Query/Params"
+	Query_WithdrawalCommitment_FullMethodName  = "/opinit.opchild.v1.Query/WithdrawalCommitment"
+	Query_WithdrawalProof_FullMethodName       = "/opinit.opchild.v1.Query/WithdrawalProof"
+	Query_DepositStatus_FullMethodName         = "/opinit.opchild.v1.Query/DepositStatus"
+	Query_DepositAttestations_FullMethodName   = "/opinit.opchild.v1.Query/DepositAttestations"
+	Query_ExecutorChangePlan_FullMethodName    = "/opinit.opchild.v1.Query/ExecutorChangePlan"
+	Query_ExecutorChangePlans_FullMethodName   = "/opinit.opchild.v1.Query/ExecutorChangePlans"
+	Query_ScheduledMessages_FullMethodName     = "/opinit.opchild.v1.Query/ScheduledMessages"
+	Query_ScheduledMessagesList_FullMethodName = "/opinit.opchild.v1.Query/ScheduledMessagesList"
+	Query_PendingAdmin_FullMethodName          = "/opinit.opchild.v1.Query/PendingAdmin"
+	Query_AdminProposal_FullMethodName         = "/opinit.opchild.v1.Query/AdminProposal"
+	Query_AdminProposals_FullMethodName        = "/opinit.opchild.v1.Query/AdminProposals"
+	Query_SigningInfo_FullMethodName           = "/opinit.opchild.v1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName          = "/opinit.opchild.v1.Query/SigningInfos"
 )
 
 // QueryClient is the client API for Query service.
@@ -42,6 +55,34 @@ type QueryClient interface {
 	BridgeInfo(ctx context.Context, in *QueryBridgeInfoRequest, opts ...grpc.CallOption) (*QueryBridgeInfoResponse, error)
 	// Parameters queries the rollup parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// WithdrawalCommitment queries the withdrawal tree root at a height.
+	WithdrawalCommitment(ctx context.Context, in *QueryWithdrawalCommitmentRequest, opts ...grpc.CallOption) (*QueryWithdrawalCommitmentResponse, error)
+	// WithdrawalProof queries the withdrawal leaf and its merkle proof.
+	WithdrawalProof(ctx context.Context, in *QueryWithdrawalProofRequest, opts ...grpc.CallOption) (*QueryWithdrawalProofResponse, error)
+	// DepositStatus queries the highest contiguous finalized l1 sequence and
+	// the missing l1 sequences.
+	DepositStatus(ctx context.Context, in *QueryDepositStatusRequest, opts ...grpc.CallOption) (*QueryDepositStatusResponse, error)
+	// DepositAttestations queries the deposits waiting for the attestations of
+	// the bridge executors.
+	DepositAttestations(ctx context.Context, in *QueryDepositAttestationsRequest, opts ...grpc.CallOption) (*QueryDepositAttestationsResponse, error)
+	// ExecutorChangePlan queries the executor change plan at the height.
+	ExecutorChangePlan(ctx context.Context, in *QueryExecutorChangePlanRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlanResponse, error)
+	// ExecutorChangePlans queries all the executor change plans.
+	ExecutorChangePlans(ctx context.Context, in *QueryExecutorChangePlansRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlansResponse, error)
+	// ScheduledMessages queries the scheduled messages of the id.
+	ScheduledMessages(ctx context.Context, in *QueryScheduledMessagesRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesResponse, error)
+	// ScheduledMessagesList queries all the scheduled messages.
+	ScheduledMessagesList(ctx context.Context, in *QueryScheduledMessagesListRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesListResponse, error)
+	// PendingAdmin queries the address proposed to be the next admin.
+	PendingAdmin(ctx context.Context, in *QueryPendingAdminRequest, opts ...grpc.CallOption) (*QueryPendingAdminResponse, error)
+	// AdminProposal queries the admin proposal of the id.
+	AdminProposal(ctx context.Context, in *QueryAdminProposalRequest, opts ...grpc.CallOption) (*QueryAdminProposalResponse, error)
+	// AdminProposals queries all the admin proposals waiting for the approvals.
+	AdminProposals(ctx context.Context, in *QueryAdminProposalsRequest, opts ...grpc.CallOption) (*QueryAdminProposalsResponse, error)
+	// SigningInfo queries the liveness information of a validator.
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the liveness information of all the validators.
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
 }
 
 type queryClient struct {
@@ -92,6 +133,136 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) WithdrawalCommitment(ctx context.Context, in *QueryWithdrawalCommitmentRequest, opts ...grpc.CallOption) (*QueryWithdrawalCommitmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWithdrawalCommitmentResponse)
+	err := c.cc.Invoke(ctx, Query_WithdrawalCommitment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalProof(ctx context.Context, in *QueryWithdrawalProofRequest, opts ...grpc.CallOption) (*QueryWithdrawalProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWithdrawalProofResponse)
+	err := c.cc.Invoke(ctx, Query_WithdrawalProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositStatus(ctx context.Context, in *QueryDepositStatusRequest, opts ...grpc.CallOption) (*QueryDepositStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDepositStatusResponse)
+	err := c.cc.Invoke(ctx, Query_DepositStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositAttestations(ctx context.Context, in *QueryDepositAttestationsRequest, opts ...grpc.CallOption) (*QueryDepositAttestationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDepositAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_DepositAttestations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutorChangePlan(ctx context.Context, in *QueryExecutorChangePlanRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExecutorChangePlanResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutorChangePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutorChangePlans(ctx context.Context, in *QueryExecutorChangePlansRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExecutorChangePlansResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutorChangePlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMessages(ctx context.Context, in *QueryScheduledMessagesRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMessagesList(ctx context.Context, in *QueryScheduledMessagesListRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledMessagesListResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledMessagesList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAdmin(ctx context.Context, in *QueryPendingAdminRequest, opts ...grpc.CallOption) (*QueryPendingAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingAdminResponse)
+	err := c.cc.Invoke(ctx, Query_PendingAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminProposal(ctx context.Context, in *QueryAdminProposalRequest, opts ...grpc.CallOption) (*QueryAdminProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAdminProposalResponse)
+	err := c.cc.Invoke(ctx, Query_AdminProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminProposals(ctx context.Context, in *QueryAdminProposalsRequest, opts ...grpc.CallOption) (*QueryAdminProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAdminProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_AdminProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, Query_SigningInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySigningInfosResponse)
+	err := c.cc.Invoke(ctx, Query_SigningInfos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +280,34 @@ type QueryServer interface {
 	BridgeInfo(context.Context, *QueryBridgeInfoRequest) (*QueryBridgeInfoResponse, error)
 	// Parameters queries the rollup parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// WithdrawalCommitment queries the withdrawal tree root at a height.
+	WithdrawalCommitment(context.Context, *QueryWithdrawalCommitmentRequest) (*QueryWithdrawalCommitmentResponse, error)
+	// WithdrawalProof queries the withdrawal leaf and its merkle proof.
+	WithdrawalProof(context.Context, *QueryWithdrawalProofRequest) (*QueryWithdrawalProofResponse, error)
+	// DepositStatus queries the highest contiguous finalized l1 sequence and
+	// the missing l1 sequences.
+	DepositStatus(context.Context, *QueryDepositStatusRequest) (*QueryDepositStatusResponse, error)
+	// DepositAttestations queries the deposits waiting for the attestations of
+	// the bridge executors.
+	DepositAttestations(context.Context, *QueryDepositAttestationsRequest) (*QueryDepositAttestationsResponse, error)
+	// ExecutorChangePlan queries the executor change plan at the height.
+	ExecutorChangePlan(context.Context, *QueryExecutorChangePlanRequest) (*QueryExecutorChangePlanResponse, error)
+	// ExecutorChangePlans queries all the executor change plans.
+	ExecutorChangePlans(context.Context, *QueryExecutorChangePlansRequest) (*QueryExecutorChangePlansResponse, error)
+	// ScheduledMessages queries the scheduled messages of the id.
+	ScheduledMessages(context.Context, *QueryScheduledMessagesRequest) (*QueryScheduledMessagesResponse, error)
+	// ScheduledMessagesList queries all the scheduled messages.
+	ScheduledMessagesList(context.Context, *QueryScheduledMessagesListRequest) (*QueryScheduledMessagesListResponse, error)
+	// PendingAdmin queries the address proposed to be the next admin.
+	PendingAdmin(context.Context, *QueryPendingAdminRequest) (*QueryPendingAdminResponse, error)
+	// AdminProposal queries the admin proposal of the id.
+	AdminProposal(context.Context, *QueryAdminProposalRequest) (*QueryAdminProposalResponse, error)
+	// AdminProposals queries all the admin proposals waiting for the approvals.
+	AdminProposals(context.Context, *QueryAdminProposalsRequest) (*QueryAdminProposalsResponse, error)
+	// SigningInfo queries the liveness information of a validator.
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the liveness information of all the validators.
+	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -128,6 +327,45 @@ func (UnimplementedQueryServer) BridgeInfo(context.Context, *QueryBridgeInfoRequ
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) WithdrawalCommitment(context.Context, *QueryWithdrawalCommitmentRequest) (*QueryWithdrawalCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalCommitment not implemented")
+}
+func (UnimplementedQueryServer) WithdrawalProof(context.Context, *QueryWithdrawalProofRequest) (*QueryWithdrawalProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalProof not implemented")
+}
+func (UnimplementedQueryServer) DepositStatus(context.Context, *QueryDepositStatusRequest) (*QueryDepositStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositStatus not implemented")
+}
+func (UnimplementedQueryServer) DepositAttestations(context.Context, *QueryDepositAttestationsRequest) (*QueryDepositAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAttestations not implemented")
+}
+func (UnimplementedQueryServer) ExecutorChangePlan(context.Context, *QueryExecutorChangePlanRequest) (*QueryExecutorChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutorChangePlan not implemented")
+}
+func (UnimplementedQueryServer) ExecutorChangePlans(context.Context, *QueryExecutorChangePlansRequest) (*QueryExecutorChangePlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutorChangePlans not implemented")
+}
+func (UnimplementedQueryServer) ScheduledMessages(context.Context, *QueryScheduledMessagesRequest) (*QueryScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMessages not implemented")
+}
+func (UnimplementedQueryServer) ScheduledMessagesList(context.Context, *QueryScheduledMessagesListRequest) (*QueryScheduledMessagesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMessagesList not implemented")
+}
+func (UnimplementedQueryServer) PendingAdmin(context.Context, *QueryPendingAdminRequest) (*QueryPendingAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAdmin not implemented")
+}
+func (UnimplementedQueryServer) AdminProposal(context.Context, *QueryAdminProposalRequest) (*QueryAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposal not implemented")
+}
+func (UnimplementedQueryServer) AdminProposals(context.Context, *QueryAdminProposalsRequest) (*QueryAdminProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposals not implemented")
+}
+func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +451,240 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WithdrawalCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WithdrawalCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalCommitment(ctx, req.(*QueryWithdrawalCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WithdrawalProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalProof(ctx, req.(*QueryWithdrawalProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DepositStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositStatus(ctx, req.(*QueryDepositStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DepositAttestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositAttestations(ctx, req.(*QueryDepositAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutorChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutorChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutorChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutorChangePlan(ctx, req.(*QueryExecutorChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutorChangePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorChangePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutorChangePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutorChangePlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutorChangePlans(ctx, req.(*QueryExecutorChangePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMessages(ctx, req.(*QueryScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMessagesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMessagesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMessagesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledMessagesList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMessagesList(ctx, req.(*QueryScheduledMessagesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAdmin(ctx, req.(*QueryPendingAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AdminProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminProposal(ctx, req.(*QueryAdminProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AdminProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminProposals(ctx, req.(*QueryAdminProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -236,6 +708,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "WithdrawalCommitment",
+			Handler:    _Query_WithdrawalCommitment_Handler,
+		},
+		{
+			MethodName: "WithdrawalProof",
+			Handler:    _Query_WithdrawalProof_Handler,
+		},
+		{
+			MethodName: "DepositStatus",
+			Handler:    _Query_DepositStatus_Handler,
+		},
+		{
+			MethodName: "DepositAttestations",
+			Handler:    _Query_DepositAttestations_Handler,
+		},
+		{
+			MethodName: "ExecutorChangePlan",
+			Handler:    _Query_ExecutorChangePlan_Handler,
+		},
+		{
+			MethodName: "ExecutorChangePlans",
+			Handler:    _Query_ExecutorChangePlans_Handler,
+		},
+		{
+			MethodName: "ScheduledMessages",
+			Handler:    _Query_ScheduledMessages_Handler,
+		},
+		{
+			MethodName: "ScheduledMessagesList",
+			Handler:    _Query_ScheduledMessagesList_Handler,
+		},
+		{
+			MethodName: "PendingAdmin",
+			Handler:    _Query_PendingAdmin_Handler,
+		},
+		{
+			MethodName: "AdminProposal",
+			Handler:    _Query_AdminProposal_Handler,
+		},
+		{
+			MethodName: "AdminProposals",
+			Handler:    _Query_AdminProposals_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "opinit/opchild/v1/query.proto",
@@ -19,15 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Msg_ExecuteMessages_FullMethodName         = "/opinit.opchild.v1.Msg/ExecuteMessages"
-	Msg_SetBridgeInfo_FullMethodName           = "/opinit.opchild.v1.Msg/SetBridgeInfo"
-	Msg_FinalizeTokenDeposit_FullMethodName    = "/opinit.opchild.v1.Msg/FinalizeTokenDeposit"
-	Msg_InitiateTokenWithdrawal_FullMethodName = "/opinit.opchild.v1.Msg/InitiateTokenWithdrawal"
-	Msg_AddValidator_FullMethodName            = "/opinit.opchild.v1.Msg/AddValidator"
-	Msg_RemoveValidator_FullMethodName         = "/opinit.opchild.v1.Msg/RemoveValidator"
-	Msg_UpdateParams_FullMethodName            = "/opinit.opchild.v1.Msg/UpdateParams"
-	Msg_SpendFeePool_FullMethodName            = "/opinit.opchild.v1.Msg/SpendFeePool"
-	Msg_UpdateOracle_FullMethodName            = "/opinit.opchild.v1.Msg/UpdateOracle"
+	Msg_ExecuteMessages_FullMethodName            = "/opinit.opchild.v1.Msg/ExecuteMessages"
+	Msg_ExecuteScheduledMessages_FullMethodName   = "/opinit.opchild.v1.Msg/ExecuteScheduledMessages"
+	Msg_CancelScheduledMessages_FullMethodName    = "/opinit.opchild.v1.Msg/CancelScheduledMessages"
+	Msg_ApproveAdminProposal_FullMethodName       = "/opinit.opchild.v1.Msg/ApproveAdminProposal"
+	Msg_ProposeAdmin_FullMethodName               = "/opinit.opchild.v1.Msg/ProposeAdmin"
+	Msg_AcceptAdmin_FullMethodName                = "/opinit.opchild.v1.Msg/AcceptAdmin"
+	Msg_SetBridgeInfo_FullMethodName              = "/opinit.opchild.v1.Msg/SetBridgeInfo"
+	Msg_FinalizeTokenDeposit_FullMethodName       = "/opinit.opchild.v1.Msg/FinalizeTokenDeposit"
+	Msg_FinalizeNftDeposit_FullMethodName         = "/opinit.opchild.v1.Msg/FinalizeNftDeposit"
+	Msg_InitiateTokenWithdrawal_FullMethodName    = "/opinit.opchild.v1.Msg/InitiateTokenWithdrawal"
+	Msg_InitiateNftWithdrawal_FullMethodName      = "/opinit.opchild.v1.Msg/InitiateNftWithdrawal"
+	Msg_AddValidator_FullMethodName               = "/opinit.opchild.v1.Msg/AddValidator"
+	Msg_RemoveValidator_FullMethodName            = "/opinit.opchild.v1.Msg/RemoveValidator"
+	Msg_UnjailValidator_FullMethodName            = "/opinit.opchild.v1.Msg/UnjailValidator"
+	Msg_RotateConsPubKey_FullMethodName           = "/opinit.opchild.v1.Msg/RotateConsPubKey"
+	Msg_UpdateValidatorPower_FullMethodName       = "/opinit.opchild.v1.Msg/UpdateValidatorPower"
+	Msg_SetValidatorSet_FullMethodName            = "/opinit.opchild.v1.Msg/SetValidatorSet"
+	Msg_UpdateParams_FullMethodName               = "/opinit.opchild.v1.Msg/UpdateParams"
+	Msg_SpendFeePool_FullMethodName               = "/opinit.opchild.v1.Msg/SpendFeePool"
+	Msg_UpdateOracle_FullMethodName               = "/opinit.opchild.v1.Msg/UpdateOracle"
+	Msg_UpdateDenomMetadata_FullMethodName        = "/opinit.opchild.v1.Msg/UpdateDenomMetadata"
+	Msg_RegisterExecutorChangePlan_FullMethodName = "/opinit.opchild.v1.Msg/RegisterExecutorChangePlan"
+	Msg_CancelExecutorChangePlan_FullMethodName   = "/opinit.opchild.v1.Msg/CancelExecutorChangePlan"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,16 +52,38 @@ const (
 type MsgClient interface {
 	// ExecuteMessages defines a rpc handler method for MsgExecuteMessages.
 	ExecuteMessages(ctx context.Context, in *MsgExecuteMessages, opts ...grpc.CallOption) (*MsgExecuteMessagesResponse, error)
+	// ExecuteScheduledMessages defines a rpc handler method for MsgExecuteScheduledMessages.
+	ExecuteScheduledMessages(ctx context.Context, in *MsgExecuteScheduledMessages, opts ...grpc.CallOption) (*MsgExecuteScheduledMessagesResponse, error)
+	// CancelScheduledMessages defines a rpc handler method for MsgCancelScheduledMessages.
+	CancelScheduledMessages(ctx context.Context, in *MsgCancelScheduledMessages, opts ...grpc.CallOption) (*MsgCancelScheduledMessagesResponse, error)
+	// ApproveAdminProposal defines a rpc handler method for MsgApproveAdminProposal.
+	ApproveAdminProposal(ctx context.Context, in *MsgApproveAdminProposal, opts ...grpc.CallOption) (*MsgApproveAdminProposalResponse, error)
+	// ProposeAdmin defines a rpc handler method for MsgProposeAdmin.
+	ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a rpc handler method for MsgAcceptAdmin.
+	AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error)
 	// SetBridgeInfo defines a rpc handler method for MsgSetBridgeInfo.
 	SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfo, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error)
 	// FinalizeTokenDeposit defines a rpc handler method for MsgFinalizeTokenDeposit.
 	FinalizeTokenDeposit(ctx context.Context, in *MsgFinalizeTokenDeposit, opts ...grpc.CallOption) (*MsgFinalizeTokenDepositResponse, error)
+	// FinalizeNftDeposit defines a rpc handler method for MsgFinalizeNftDeposit.
+	FinalizeNftDeposit(ctx context.Context, in *MsgFinalizeNftDeposit, opts ...grpc.CallOption) (*MsgFinalizeNftDepositResponse, error)
 	// InitiateTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
 	InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiateTokenWithdrawal, opts ...grpc.CallOption) (*MsgInitiateTokenWithdrawalResponse, error)
+	// InitiateNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	InitiateNftWithdrawal(ctx context.Context, in *MsgInitiateNftWithdrawal, opts ...grpc.CallOption) (*MsgInitiateNftWithdrawalResponse, error)
 	// AddValidator defines a rpc handler method for MsgAddValidator.
 	AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error)
 	// RemoveValidator defines a rpc handler method for MsgRemoveValidator.
 	RemoveValidator(ctx context.Context, in *MsgRemoveValidator, opts ...grpc.CallOption) (*MsgRemoveValidatorResponse, error)
+	// UnjailValidator defines a rpc handler method for MsgUnjailValidator.
+	UnjailValidator(ctx context.Context, in *MsgUnjailValidator, opts ...grpc.CallOption) (*MsgUnjailValidatorResponse, error)
+	// RotateConsPubKey defines a rpc handler method for MsgRotateConsPubKey.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// UpdateValidatorPower defines a rpc handler method for MsgUpdateValidatorPower.
+	UpdateValidatorPower(ctx context.Context, in *MsgUpdateValidatorPower, opts ...grpc.CallOption) (*MsgUpdateValidatorPowerResponse, error)
+	// SetValidatorSet defines a rpc handler method for MsgSetValidatorSet.
+	SetValidatorSet(ctx context.Context, in *MsgSetValidatorSet, opts ...grpc.CallOption) (*MsgSetValidatorSetResponse, error)
 	// UpdateParams defines an operation for updating the
 	// x/opchild module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -55,6 +91,15 @@ type MsgClient interface {
 	SpendFeePool(ctx context.Context, in *MsgSpendFeePool, opts ...grpc.CallOption) (*MsgSpendFeePoolResponse, error)
 	// UpdateOracle defines an operation that update oracle prices.
 	UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error)
+	// UpdateDenomMetadata defines an operation that corrects the denom metadata
+	// of a bridged token.
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// RegisterExecutorChangePlan defines an operation that registers a plan
+	// to change the validator and the bridge executors at a height.
+	RegisterExecutorChangePlan(ctx context.Context, in *MsgRegisterExecutorChangePlan, opts ...grpc.CallOption) (*MsgRegisterExecutorChangePlanResponse, error)
+	// CancelExecutorChangePlan defines an operation that cancels a registered
+	// executor change plan.
+	CancelExecutorChangePlan(ctx context.Context, in *MsgCancelExecutorChangePlan, opts ...grpc.CallOption) (*MsgCancelExecutorChangePlanResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +120,56 @@ func (c *msgClient) ExecuteMessages(ctx context.Context, in *MsgExecuteMessages,
 	return out, nil
 }
 
+func (c *msgClient) ExecuteScheduledMessages(ctx context.Context, in *MsgExecuteScheduledMessages, opts ...grpc.CallOption) (*MsgExecuteScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgExecuteScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecuteScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMessages(ctx context.Context, in *MsgCancelScheduledMessages, opts ...grpc.CallOption) (*MsgCancelScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveAdminProposal(ctx context.Context, in *MsgApproveAdminProposal, opts ...grpc.CallOption) (*MsgApproveAdminProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApproveAdminProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveAdminProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgProposeAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfo, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgSetBridgeInfoResponse)
@@ -95,6 +190,16 @@ func (c *msgClient) FinalizeTokenDeposit(ctx context.Context, in *MsgFinalizeTok
 	return out, nil
 }
 
+func (c *msgClient) FinalizeNftDeposit(ctx context.Context, in *MsgFinalizeNftDeposit, opts ...grpc.CallOption) (*MsgFinalizeNftDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFinalizeNftDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_FinalizeNftDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiateTokenWithdrawal, opts ...grpc.CallOption) (*MsgInitiateTokenWithdrawalResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgInitiateTokenWithdrawalResponse)
@@ -105,6 +210,16 @@ func (c *msgClient) InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiate
 	return out, nil
 }
 
+func (c *msgClient) InitiateNftWithdrawal(ctx context.Context, in *MsgInitiateNftWithdrawal, opts ...grpc.CallOption) (*MsgInitiateNftWithdrawalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgInitiateNftWithdrawalResponse)
+	err := c.cc.Invoke(ctx, Msg_InitiateNftWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgAddValidatorResponse)
@@ -125,6 +240,46 @@ func (c *msgClient) RemoveValidator(ctx context.Context, in *MsgRemoveValidator,
 	return out, nil
 }
 
+func (c *msgClient) UnjailValidator(ctx context.Context, in *MsgUnjailValidator, opts ...grpc.CallOption) (*MsgUnjailValidatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnjailValidatorResponse)
+	err := c.cc.Invoke(ctx, Msg_UnjailValidator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateConsPubKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorPower(ctx context.Context, in *MsgUpdateValidatorPower, opts ...grpc.CallOption) (*MsgUpdateValidatorPowerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateValidatorPowerResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateValidatorPower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorSet(ctx context.Context, in *MsgSetValidatorSet, opts ...grpc.CallOption) (*MsgSetValidatorSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetValidatorSetResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -155,6 +310,36 @@ func (c *msgClient) UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenomMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterExecutorChangePlan(ctx context.Context, in *MsgRegisterExecutorChangePlan, opts ...grpc.CallOption) (*MsgRegisterExecutorChangePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterExecutorChangePlanResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterExecutorChangePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelExecutorChangePlan(ctx context.Context, in *MsgCancelExecutorChangePlan, opts ...grpc.CallOption) (*MsgCancelExecutorChangePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelExecutorChangePlanResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelExecutorChangePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -163,16 +348,38 @@ func (c *msgClient) UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts
 type MsgServer interface {
 	// ExecuteMessages defines a rpc handler method for MsgExecuteMessages.
 	ExecuteMessages(context.Context, *MsgExecuteMessages) (*MsgExecuteMessagesResponse, error)
+	// ExecuteScheduledMessages defines a rpc handler method for MsgExecuteScheduledMessages.
+	ExecuteScheduledMessages(context.Context, *MsgExecuteScheduledMessages) (*MsgExecuteScheduledMessagesResponse, error)
+	// CancelScheduledMessages defines a rpc handler method for MsgCancelScheduledMessages.
+	CancelScheduledMessages(context.Context, *MsgCancelScheduledMessages) (*MsgCancelScheduledMessagesResponse, error)
+	// ApproveAdminProposal defines a rpc handler method for MsgApproveAdminProposal.
+	ApproveAdminProposal(context.Context, *MsgApproveAdminProposal) (*MsgApproveAdminProposalResponse, error)
+	// ProposeAdmin defines a rpc handler method for MsgProposeAdmin.
+	ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a rpc handler method for MsgAcceptAdmin.
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
 	// SetBridgeInfo defines a rpc handler method for MsgSetBridgeInfo.
 	SetBridgeInfo(context.Context, *MsgSetBridgeInfo) (*MsgSetBridgeInfoResponse, error)
 	// FinalizeTokenDeposit defines a rpc handler method for MsgFinalizeTokenDeposit.
 	FinalizeTokenDeposit(context.Context, *MsgFinalizeTokenDeposit) (*MsgFinalizeTokenDepositResponse, error)
+	// FinalizeNftDeposit defines a rpc handler method for MsgFinalizeNftDeposit.
+	FinalizeNftDeposit(context.Context, *MsgFinalizeNftDeposit) (*MsgFinalizeNftDepositResponse, error)
 	// InitiateTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
 	InitiateTokenWithdrawal(context.Context, *MsgInitiateTokenWithdrawal) (*MsgInitiateTokenWithdrawalResponse, error)
+	// InitiateNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	InitiateNftWithdrawal(context.Context, *MsgInitiateNftWithdrawal) (*MsgInitiateNftWithdrawalResponse, error)
 	// AddValidator defines a rpc handler method for MsgAddValidator.
 	AddValidator(context.Context, *MsgAddValidator) (*MsgAddValidatorResponse, error)
 	// RemoveValidator defines a rpc handler method for MsgRemoveValidator.
 	RemoveValidator(context.Context, *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error)
+	// UnjailValidator defines a rpc handler method for MsgUnjailValidator.
+	UnjailValidator(context.Context, *MsgUnjailValidator) (*MsgUnjailValidatorResponse, error)
+	// RotateConsPubKey defines a rpc handler method for MsgRotateConsPubKey.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// UpdateValidatorPower defines a rpc handler method for MsgUpdateValidatorPower.
+	UpdateValidatorPower(context.Context, *MsgUpdateValidatorPower) (*MsgUpdateValidatorPowerResponse, error)
+	// SetValidatorSet defines a rpc handler method for MsgSetValidatorSet.
+	SetValidatorSet(context.Context, *MsgSetValidatorSet) (*MsgSetValidatorSetResponse, error)
 	// UpdateParams defines an operation for updating the
 	// x/opchild module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -180,6 +387,15 @@ type MsgServer interface {
 	SpendFeePool(context.Context, *MsgSpendFeePool) (*MsgSpendFeePoolResponse, error)
 	// UpdateOracle defines an operation that update oracle prices.
 	UpdateOracle(context.Context, *MsgUpdateOracle) (*MsgUpdateOracleResponse, error)
+	// UpdateDenomMetadata defines an operation that corrects the denom metadata
+	// of a bridged token.
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// RegisterExecutorChangePlan defines an operation that registers a plan
+	// to change the validator and the bridge executors at a height.
+	RegisterExecutorChangePlan(context.Context, *MsgRegisterExecutorChangePlan) (*MsgRegisterExecutorChangePlanResponse, error)
+	// CancelExecutorChangePlan defines an operation that cancels a registered
+	// executor change plan.
+	CancelExecutorChangePlan(context.Context, *MsgCancelExecutorChangePlan) (*MsgCancelExecutorChangePlanResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -190,21 +406,54 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) ExecuteMessages(context.Context, *MsgExecuteMessages) (*MsgExecuteMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteMessages not implemented")
 }
+func (UnimplementedMsgServer) ExecuteScheduledMessages(context.Context, *MsgExecuteScheduledMessages) (*MsgExecuteScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScheduledMessages not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledMessages(context.Context, *MsgCancelScheduledMessages) (*MsgCancelScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessages not implemented")
+}
+func (UnimplementedMsgServer) ApproveAdminProposal(context.Context, *MsgApproveAdminProposal) (*MsgApproveAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAdminProposal not implemented")
+}
+func (UnimplementedMsgServer) ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdmin not implemented")
+}
+func (UnimplementedMsgServer) AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
 func (UnimplementedMsgServer) SetBridgeInfo(context.Context, *MsgSetBridgeInfo) (*MsgSetBridgeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeInfo not implemented")
 }
 func (UnimplementedMsgServer) FinalizeTokenDeposit(context.Context, *MsgFinalizeTokenDeposit) (*MsgFinalizeTokenDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTokenDeposit not implemented")
 }
+func (UnimplementedMsgServer) FinalizeNftDeposit(context.Context, *MsgFinalizeNftDeposit) (*MsgFinalizeNftDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeNftDeposit not implemented")
+}
 func (UnimplementedMsgServer) InitiateTokenWithdrawal(context.Context, *MsgInitiateTokenWithdrawal) (*MsgInitiateTokenWithdrawalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiateTokenWithdrawal not implemented")
 }
+func (UnimplementedMsgServer) InitiateNftWithdrawal(context.Context, *MsgInitiateNftWithdrawal) (*MsgInitiateNftWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateNftWithdrawal not implemented")
+}
 func (UnimplementedMsgServer) AddValidator(context.Context, *MsgAddValidator) (*MsgAddValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddValidator not implemented")
 }
 func (UnimplementedMsgServer) RemoveValidator(context.Context, *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveValidator not implemented")
 }
+func (UnimplementedMsgServer) UnjailValidator(context.Context, *MsgUnjailValidator) (*MsgUnjailValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailValidator not implemented")
+}
+func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
+func (UnimplementedMsgServer) UpdateValidatorPower(context.Context, *MsgUpdateValidatorPower) (*MsgUpdateValidatorPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorPower not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorSet(context.Context, *MsgSetValidatorSet) (*MsgSetValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorSet not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -214,6 +463,15 @@ func (UnimplementedMsgServer) SpendFeePool(context.Context, *MsgSpendFeePool) (*
 func (UnimplementedMsgServer) UpdateOracle(context.Context, *MsgUpdateOracle) (*MsgUpdateOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracle not implemented")
 }
+func (UnimplementedMsgServer) UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) RegisterExecutorChangePlan(context.Context, *MsgRegisterExecutorChangePlan) (*MsgRegisterExecutorChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterExecutorChangePlan not implemented")
+}
+func (UnimplementedMsgServer) CancelExecutorChangePlan(context.Context, *MsgCancelExecutorChangePlan) (*MsgCancelExecutorChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecutorChangePlan not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -245,6 +503,96 @@ func _Msg_ExecuteMessages_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecuteScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecuteScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteScheduledMessages(ctx, req.(*MsgExecuteScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMessages(ctx, req.(*MsgCancelScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAdminProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveAdminProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAdminProposal(ctx, req.(*MsgApproveAdminProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdmin(ctx, req.(*MsgProposeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetBridgeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetBridgeInfo)
 	if err := dec(in); err != nil {
@@ -281,6 +629,24 @@ func _Msg_FinalizeTokenDeposit_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FinalizeNftDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeNftDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeNftDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinalizeNftDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeNftDeposit(ctx, req.(*MsgFinalizeNftDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InitiateTokenWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInitiateTokenWithdrawal)
 	if err := dec(in); err != nil {
@@ -299,6 +665,24 @@ func _Msg_InitiateTokenWithdrawal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InitiateNftWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateNftWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateNftWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitiateNftWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateNftWithdrawal(ctx, req.(*MsgInitiateNftWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddValidator)
 	if err := dec(in); err != nil {
@@ -335,6 +719,78 @@ func _Msg_RemoveValidator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnjailValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailValidator(ctx, req.(*MsgUnjailValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateValidatorPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorPower(ctx, req.(*MsgUpdateValidatorPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorSet(ctx, req.(*MsgSetValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -389,6 +845,60 @@ func _Msg_UpdateOracle_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterExecutorChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterExecutorChangePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterExecutorChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterExecutorChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterExecutorChangePlan(ctx, req.(*MsgRegisterExecutorChangePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelExecutorChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelExecutorChangePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelExecutorChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelExecutorChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelExecutorChangePlan(ctx, req.(*MsgCancelExecutorChangePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -400,6 +910,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteMessages",
 			Handler:    _Msg_ExecuteMessages_Handler,
 		},
+		{
+			MethodName: "ExecuteScheduledMessages",
+			Handler:    _Msg_ExecuteScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessages",
+			Handler:    _Msg_CancelScheduledMessages_Handler,
+		},
+		{
+			MethodName: "ApproveAdminProposal",
+			Handler:    _Msg_ApproveAdminProposal_Handler,
+		},
+		{
+			MethodName: "ProposeAdmin",
+			Handler:    _Msg_ProposeAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
 		{
 			MethodName: "SetBridgeInfo",
 			Handler:    _Msg_SetBridgeInfo_Handler,
@@ -408,10 +938,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizeTokenDeposit",
 			Handler:    _Msg_FinalizeTokenDeposit_Handler,
 		},
+		{
+			MethodName: "FinalizeNftDeposit",
+			Handler:    _Msg_FinalizeNftDeposit_Handler,
+		},
 		{
 			MethodName: "InitiateTokenWithdrawal",
 			Handler:    _Msg_InitiateTokenWithdrawal_Handler,
 		},
+		{
+			MethodName: "InitiateNftWithdrawal",
+			Handler:    _Msg_InitiateNftWithdrawal_Handler,
+		},
 		{
 			MethodName: "AddValidator",
 			Handler:    _Msg_AddValidator_Handler,
@@ -420,6 +958,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveValidator",
 			Handler:    _Msg_RemoveValidator_Handler,
 		},
+		{
+			MethodName: "UnjailValidator",
+			Handler:    _Msg_UnjailValidator_Handler,
+		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorPower",
+			Handler:    _Msg_UpdateValidatorPower_Handler,
+		},
+		{
+			MethodName: "SetValidatorSet",
+			Handler:    _Msg_SetValidatorSet_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -432,6 +986,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOracle",
 			Handler:    _Msg_UpdateOracle_Handler,
 		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "RegisterExecutorChangePlan",
+			Handler:    _Msg_RegisterExecutorChangePlan_Handler,
+		},
+		{
+			MethodName: "CancelExecutorChangePlan",
+			Handler:    _Msg_CancelExecutorChangePlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "opinit/opchild/v1/tx.proto",
@@ -14,6 +14,7 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -162,14 +163,307 @@ func (x *_Params_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]string
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedExecuteMessages as it is not of Message kind"))
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]string
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AdminSigners as it is not of Message kind"))
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_15_list)(nil)
+
+type _Params_15_list struct {
+	list *[]string
+}
+
+func (x *_Params_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field DepositRelayers as it is not of Message kind"))
+}
+
+func (x *_Params_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]string
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field OracleRelayers as it is not of Message kind"))
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_17_list)(nil)
+
+type _Params_17_list struct {
+	list *[]string
+}
+
+func (x *_Params_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_17_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field BridgeInfoSetters as it is not of Message kind"))
+}
+
+func (x *_Params_17_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_17_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_22_list)(nil)
+
+type _Params_22_list struct {
+	list *[]string
+}
+
+func (x *_Params_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_22_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field L2NativeDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_22_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_22_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_22_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_max_validators     protoreflect.FieldDescriptor
-	fd_Params_historical_entries protoreflect.FieldDescriptor
-	fd_Params_min_gas_prices     protoreflect.FieldDescriptor
-	fd_Params_bridge_executors   protoreflect.FieldDescriptor
-	fd_Params_admin              protoreflect.FieldDescriptor
-	fd_Params_fee_whitelist      protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_max_validators                protoreflect.FieldDescriptor
+	fd_Params_historical_entries            protoreflect.FieldDescriptor
+	fd_Params_min_gas_prices                protoreflect.FieldDescriptor
+	fd_Params_bridge_executors              protoreflect.FieldDescriptor
+	fd_Params_admin                         protoreflect.FieldDescriptor
+	fd_Params_fee_whitelist                 protoreflect.FieldDescriptor
+	fd_Params_strict_deposit_ordering       protoreflect.FieldDescriptor
+	fd_Params_deposit_attestation_threshold protoreflect.FieldDescriptor
+	fd_Params_deposit_proof_required        protoreflect.FieldDescriptor
+	fd_Params_bridge_info_proof_required    protoreflect.FieldDescriptor
+	fd_Params_execute_messages_delay        protoreflect.FieldDescriptor
+	fd_Params_allowed_execute_messages      protoreflect.FieldDescriptor
+	fd_Params_admin_signers                 protoreflect.FieldDescriptor
+	fd_Params_admin_threshold               protoreflect.FieldDescriptor
+	fd_Params_deposit_relayers              protoreflect.FieldDescriptor
+	fd_Params_oracle_relayers               protoreflect.FieldDescriptor
+	fd_Params_bridge_info_setters           protoreflect.FieldDescriptor
+	fd_Params_signed_blocks_window          protoreflect.FieldDescriptor
+	fd_Params_min_signed_per_window         protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_enabled         protoreflect.FieldDescriptor
+	fd_Params_admin_proposal_expiry         protoreflect.FieldDescriptor
+	fd_Params_l2_native_denoms              protoreflect.FieldDescriptor
+	fd_Params_l1_client_id                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -181,6 +475,23 @@ func init() {
 	fd_Params_bridge_executors = md_Params.Fields().ByName("bridge_executors")
 	fd_Params_admin = md_Params.Fields().ByName("admin")
 	fd_Params_fee_whitelist = md_Params.Fields().ByName("fee_whitelist")
+	fd_Params_strict_deposit_ordering = md_Params.Fields().ByName("strict_deposit_ordering")
+	fd_Params_deposit_attestation_threshold = md_Params.Fields().ByName("deposit_attestation_threshold")
+	fd_Params_deposit_proof_required = md_Params.Fields().ByName("deposit_proof_required")
+	fd_Params_bridge_info_proof_required = md_Params.Fields().ByName("bridge_info_proof_required")
+	fd_Params_execute_messages_delay = md_Params.Fields().ByName("execute_messages_delay")
+	fd_Params_allowed_execute_messages = md_Params.Fields().ByName("allowed_execute_messages")
+	fd_Params_admin_signers = md_Params.Fields().ByName("admin_signers")
+	fd_Params_admin_threshold = md_Params.Fields().ByName("admin_threshold")
+	fd_Params_deposit_relayers = md_Params.Fields().ByName("deposit_relayers")
+	fd_Params_oracle_relayers = md_Params.Fields().ByName("oracle_relayers")
+	fd_Params_bridge_info_setters = md_Params.Fields().ByName("bridge_info_setters")
+	fd_Params_signed_blocks_window = md_Params.Fields().ByName("signed_blocks_window")
+	fd_Params_min_signed_per_window = md_Params.Fields().ByName("min_signed_per_window")
+	fd_Params_downtime_jail_enabled = md_Params.Fields().ByName("downtime_jail_enabled")
+	fd_Params_admin_proposal_expiry = md_Params.Fields().ByName("admin_proposal_expiry")
+	fd_Params_l2_native_denoms = md_Params.Fields().ByName("l2_native_denoms")
+	fd_Params_l1_client_id = md_Params.Fields().ByName("l1_client_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -284,53 +595,189 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.Params.max_validators":
-		return x.MaxValidators != uint32(0)
-	case "opinit.opchild.v1.Params.historical_entries":
-		return x.HistoricalEntries != uint32(0)
-	case "opinit.opchild.v1.Params.min_gas_prices":
-		return len(x.MinGasPrices) != 0
-	case "opinit.opchild.v1.Params.bridge_executors":
-		return len(x.BridgeExecutors) != 0
-	case "opinit.opchild.v1.Params.admin":
-		return x.Admin != ""
-	case "opinit.opchild.v1.Params.fee_whitelist":
-		return len(x.FeeWhitelist) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
+	if x.StrictDepositOrdering != false {
+		value := protoreflect.ValueOfBool(x.StrictDepositOrdering)
+		if !f(fd_Params_strict_deposit_ordering, value) {
+			return
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Params does not contain field %s", fd.FullName()))
 	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.Params.max_validators":
-		x.MaxValidators = uint32(0)
-	case "opinit.opchild.v1.Params.historical_entries":
-		x.HistoricalEntries = uint32(0)
+	if x.DepositAttestationThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DepositAttestationThreshold)
+		if !f(fd_Params_deposit_attestation_threshold, value) {
+			return
+		}
+	}
+	if x.DepositProofRequired != false {
+		value := protoreflect.ValueOfBool(x.DepositProofRequired)
+		if !f(fd_Params_deposit_proof_required, value) {
+			return
+		}
+	}
+	if x.BridgeInfoProofRequired != false {
+		value := protoreflect.ValueOfBool(x.BridgeInfoProofRequired)
+		if !f(fd_Params_bridge_info_proof_required, value) {
+			return
+		}
+	}
+	if x.ExecuteMessagesDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteMessagesDelay)
+		if !f(fd_Params_execute_messages_delay, value) {
+			return
+		}
+	}
+	if len(x.AllowedExecuteMessages) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.AllowedExecuteMessages})
+		if !f(fd_Params_allowed_execute_messages, value) {
+			return
+		}
+	}
+	if len(x.AdminSigners) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.AdminSigners})
+		if !f(fd_Params_admin_signers, value) {
+			return
+		}
+	}
+	if x.AdminThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AdminThreshold)
+		if !f(fd_Params_admin_threshold, value) {
+			return
+		}
+	}
+	if len(x.DepositRelayers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_15_list{list: &x.DepositRelayers})
+		if !f(fd_Params_deposit_relayers, value) {
+			return
+		}
+	}
+	if len(x.OracleRelayers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.OracleRelayers})
+		if !f(fd_Params_oracle_relayers, value) {
+			return
+		}
+	}
+	if len(x.BridgeInfoSetters) != 0 {
+		value := protoreflect.ValueOfList(&_Params_17_list{list: &x.BridgeInfoSetters})
+		if !f(fd_Params_bridge_info_setters, value) {
+			return
+		}
+	}
+	if x.SignedBlocksWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SignedBlocksWindow)
+		if !f(fd_Params_signed_blocks_window, value) {
+			return
+		}
+	}
+	if len(x.MinSignedPerWindow) != 0 {
+		value := protoreflect.ValueOfBytes(x.MinSignedPerWindow)
+		if !f(fd_Params_min_signed_per_window, value) {
+			return
+		}
+	}
+	if x.DowntimeJailEnabled != false {
+		value := protoreflect.ValueOfBool(x.DowntimeJailEnabled)
+		if !f(fd_Params_downtime_jail_enabled, value) {
+			return
+		}
+	}
+	if x.AdminProposalExpiry != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AdminProposalExpiry)
+		if !f(fd_Params_admin_proposal_expiry, value) {
+			return
+		}
+	}
+	if len(x.L2NativeDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_22_list{list: &x.L2NativeDenoms})
+		if !f(fd_Params_l2_native_denoms, value) {
+			return
+		}
+	}
+	if x.L1ClientId != "" {
+		value := protoreflect.ValueOfString(x.L1ClientId)
+		if !f(fd_Params_l1_client_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Params.max_validators":
+		return x.MaxValidators != uint32(0)
+	case "opinit.opchild.v1.Params.historical_entries":
+		return x.HistoricalEntries != uint32(0)
+	case "opinit.opchild.v1.Params.min_gas_prices":
+		return len(x.MinGasPrices) != 0
+	case "opinit.opchild.v1.Params.bridge_executors":
+		return len(x.BridgeExecutors) != 0
+	case "opinit.opchild.v1.Params.admin":
+		return x.Admin != ""
+	case "opinit.opchild.v1.Params.fee_whitelist":
+		return len(x.FeeWhitelist) != 0
+	case "opinit.opchild.v1.Params.strict_deposit_ordering":
+		return x.StrictDepositOrdering != false
+	case "opinit.opchild.v1.Params.deposit_attestation_threshold":
+		return x.DepositAttestationThreshold != uint32(0)
+	case "opinit.opchild.v1.Params.deposit_proof_required":
+		return x.DepositProofRequired != false
+	case "opinit.opchild.v1.Params.bridge_info_proof_required":
+		return x.BridgeInfoProofRequired != false
+	case "opinit.opchild.v1.Params.execute_messages_delay":
+		return x.ExecuteMessagesDelay != uint64(0)
+	case "opinit.opchild.v1.Params.allowed_execute_messages":
+		return len(x.AllowedExecuteMessages) != 0
+	case "opinit.opchild.v1.Params.admin_signers":
+		return len(x.AdminSigners) != 0
+	case "opinit.opchild.v1.Params.admin_threshold":
+		return x.AdminThreshold != uint32(0)
+	case "opinit.opchild.v1.Params.deposit_relayers":
+		return len(x.DepositRelayers) != 0
+	case "opinit.opchild.v1.Params.oracle_relayers":
+		return len(x.OracleRelayers) != 0
+	case "opinit.opchild.v1.Params.bridge_info_setters":
+		return len(x.BridgeInfoSetters) != 0
+	case "opinit.opchild.v1.Params.signed_blocks_window":
+		return x.SignedBlocksWindow != int64(0)
+	case "opinit.opchild.v1.Params.min_signed_per_window":
+		return len(x.MinSignedPerWindow) != 0
+	case "opinit.opchild.v1.Params.downtime_jail_enabled":
+		return x.DowntimeJailEnabled != false
+	case "opinit.opchild.v1.Params.admin_proposal_expiry":
+		return x.AdminProposalExpiry != uint64(0)
+	case "opinit.opchild.v1.Params.l2_native_denoms":
+		return len(x.L2NativeDenoms) != 0
+	case "opinit.opchild.v1.Params.l1_client_id":
+		return x.L1ClientId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Params.max_validators":
+		x.MaxValidators = uint32(0)
+	case "opinit.opchild.v1.Params.historical_entries":
+		x.HistoricalEntries = uint32(0)
 	case "opinit.opchild.v1.Params.min_gas_prices":
 		x.MinGasPrices = nil
 	case "opinit.opchild.v1.Params.bridge_executors":
@@ -339,6 +786,40 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.Admin = ""
 	case "opinit.opchild.v1.Params.fee_whitelist":
 		x.FeeWhitelist = nil
+	case "opinit.opchild.v1.Params.strict_deposit_ordering":
+		x.StrictDepositOrdering = false
+	case "opinit.opchild.v1.Params.deposit_attestation_threshold":
+		x.DepositAttestationThreshold = uint32(0)
+	case "opinit.opchild.v1.Params.deposit_proof_required":
+		x.DepositProofRequired = false
+	case "opinit.opchild.v1.Params.bridge_info_proof_required":
+		x.BridgeInfoProofRequired = false
+	case "opinit.opchild.v1.Params.execute_messages_delay":
+		x.ExecuteMessagesDelay = uint64(0)
+	case "opinit.opchild.v1.Params.allowed_execute_messages":
+		x.AllowedExecuteMessages = nil
+	case "opinit.opchild.v1.Params.admin_signers":
+		x.AdminSigners = nil
+	case "opinit.opchild.v1.Params.admin_threshold":
+		x.AdminThreshold = uint32(0)
+	case "opinit.opchild.v1.Params.deposit_relayers":
+		x.DepositRelayers = nil
+	case "opinit.opchild.v1.Params.oracle_relayers":
+		x.OracleRelayers = nil
+	case "opinit.opchild.v1.Params.bridge_info_setters":
+		x.BridgeInfoSetters = nil
+	case "opinit.opchild.v1.Params.signed_blocks_window":
+		x.SignedBlocksWindow = int64(0)
+	case "opinit.opchild.v1.Params.min_signed_per_window":
+		x.MinSignedPerWindow = nil
+	case "opinit.opchild.v1.Params.downtime_jail_enabled":
+		x.DowntimeJailEnabled = false
+	case "opinit.opchild.v1.Params.admin_proposal_expiry":
+		x.AdminProposalExpiry = uint64(0)
+	case "opinit.opchild.v1.Params.l2_native_denoms":
+		x.L2NativeDenoms = nil
+	case "opinit.opchild.v1.Params.l1_client_id":
+		x.L1ClientId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
@@ -382,6 +863,75 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_6_list{list: &x.FeeWhitelist}
 		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.strict_deposit_ordering":
+		value := x.StrictDepositOrdering
+		return protoreflect.ValueOfBool(value)
+	case "opinit.opchild.v1.Params.deposit_attestation_threshold":
+		value := x.DepositAttestationThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "opinit.opchild.v1.Params.deposit_proof_required":
+		value := x.DepositProofRequired
+		return protoreflect.ValueOfBool(value)
+	case "opinit.opchild.v1.Params.bridge_info_proof_required":
+		value := x.BridgeInfoProofRequired
+		return protoreflect.ValueOfBool(value)
+	case "opinit.opchild.v1.Params.execute_messages_delay":
+		value := x.ExecuteMessagesDelay
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.Params.allowed_execute_messages":
+		if len(x.AllowedExecuteMessages) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.AllowedExecuteMessages}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.admin_signers":
+		if len(x.AdminSigners) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.AdminSigners}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.admin_threshold":
+		value := x.AdminThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "opinit.opchild.v1.Params.deposit_relayers":
+		if len(x.DepositRelayers) == 0 {
+			return protoreflect.ValueOfList(&_Params_15_list{})
+		}
+		listValue := &_Params_15_list{list: &x.DepositRelayers}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.oracle_relayers":
+		if len(x.OracleRelayers) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.OracleRelayers}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.bridge_info_setters":
+		if len(x.BridgeInfoSetters) == 0 {
+			return protoreflect.ValueOfList(&_Params_17_list{})
+		}
+		listValue := &_Params_17_list{list: &x.BridgeInfoSetters}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.signed_blocks_window":
+		value := x.SignedBlocksWindow
+		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.Params.min_signed_per_window":
+		value := x.MinSignedPerWindow
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.opchild.v1.Params.downtime_jail_enabled":
+		value := x.DowntimeJailEnabled
+		return protoreflect.ValueOfBool(value)
+	case "opinit.opchild.v1.Params.admin_proposal_expiry":
+		value := x.AdminProposalExpiry
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.Params.l2_native_denoms":
+		if len(x.L2NativeDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_22_list{})
+		}
+		listValue := &_Params_22_list{list: &x.L2NativeDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.Params.l1_client_id":
+		value := x.L1ClientId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
@@ -420,6 +970,52 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_6_list)
 		x.FeeWhitelist = *clv.list
+	case "opinit.opchild.v1.Params.strict_deposit_ordering":
+		x.StrictDepositOrdering = value.Bool()
+	case "opinit.opchild.v1.Params.deposit_attestation_threshold":
+		x.DepositAttestationThreshold = uint32(value.Uint())
+	case "opinit.opchild.v1.Params.deposit_proof_required":
+		x.DepositProofRequired = value.Bool()
+	case "opinit.opchild.v1.Params.bridge_info_proof_required":
+		x.BridgeInfoProofRequired = value.Bool()
+	case "opinit.opchild.v1.Params.execute_messages_delay":
+		x.ExecuteMessagesDelay = value.Uint()
+	case "opinit.opchild.v1.Params.allowed_execute_messages":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.AllowedExecuteMessages = *clv.list
+	case "opinit.opchild.v1.Params.admin_signers":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.AdminSigners = *clv.list
+	case "opinit.opchild.v1.Params.admin_threshold":
+		x.AdminThreshold = uint32(value.Uint())
+	case "opinit.opchild.v1.Params.deposit_relayers":
+		lv := value.List()
+		clv := lv.(*_Params_15_list)
+		x.DepositRelayers = *clv.list
+	case "opinit.opchild.v1.Params.oracle_relayers":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.OracleRelayers = *clv.list
+	case "opinit.opchild.v1.Params.bridge_info_setters":
+		lv := value.List()
+		clv := lv.(*_Params_17_list)
+		x.BridgeInfoSetters = *clv.list
+	case "opinit.opchild.v1.Params.signed_blocks_window":
+		x.SignedBlocksWindow = value.Int()
+	case "opinit.opchild.v1.Params.min_signed_per_window":
+		x.MinSignedPerWindow = value.Bytes()
+	case "opinit.opchild.v1.Params.downtime_jail_enabled":
+		x.DowntimeJailEnabled = value.Bool()
+	case "opinit.opchild.v1.Params.admin_proposal_expiry":
+		x.AdminProposalExpiry = value.Uint()
+	case "opinit.opchild.v1.Params.l2_native_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_22_list)
+		x.L2NativeDenoms = *clv.list
+	case "opinit.opchild.v1.Params.l1_client_id":
+		x.L1ClientId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
@@ -458,12 +1054,70 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_6_list{list: &x.FeeWhitelist}
 		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.Params.allowed_execute_messages":
+		if x.AllowedExecuteMessages == nil {
+			x.AllowedExecuteMessages = []string{}
+		}
+		value := &_Params_12_list{list: &x.AllowedExecuteMessages}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.Params.admin_signers":
+		if x.AdminSigners == nil {
+			x.AdminSigners = []string{}
+		}
+		value := &_Params_13_list{list: &x.AdminSigners}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.Params.deposit_relayers":
+		if x.DepositRelayers == nil {
+			x.DepositRelayers = []string{}
+		}
+		value := &_Params_15_list{list: &x.DepositRelayers}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.Params.oracle_relayers":
+		if x.OracleRelayers == nil {
+			x.OracleRelayers = []string{}
+		}
+		value := &_Params_16_list{list: &x.OracleRelayers}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.Params.bridge_info_setters":
+		if x.BridgeInfoSetters == nil {
+			x.BridgeInfoSetters = []string{}
+		}
+		value := &_Params_17_list{list: &x.BridgeInfoSetters}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.Params.l2_native_denoms":
+		if x.L2NativeDenoms == nil {
+			x.L2NativeDenoms = []string{}
+		}
+		value := &_Params_22_list{list: &x.L2NativeDenoms}
+		return protoreflect.ValueOfList(value)
 	case "opinit.opchild.v1.Params.max_validators":
 		panic(fmt.Errorf("field max_validators of message opinit.opchild.v1.Params is not mutable"))
 	case "opinit.opchild.v1.Params.historical_entries":
 		panic(fmt.Errorf("field historical_entries of message opinit.opchild.v1.Params is not mutable"))
 	case "opinit.opchild.v1.Params.admin":
 		panic(fmt.Errorf("field admin of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.strict_deposit_ordering":
+		panic(fmt.Errorf("field strict_deposit_ordering of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.deposit_attestation_threshold":
+		panic(fmt.Errorf("field deposit_attestation_threshold of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.deposit_proof_required":
+		panic(fmt.Errorf("field deposit_proof_required of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.bridge_info_proof_required":
+		panic(fmt.Errorf("field bridge_info_proof_required of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.execute_messages_delay":
+		panic(fmt.Errorf("field execute_messages_delay of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.admin_threshold":
+		panic(fmt.Errorf("field admin_threshold of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.signed_blocks_window":
+		panic(fmt.Errorf("field signed_blocks_window of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.min_signed_per_window":
+		panic(fmt.Errorf("field min_signed_per_window of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.downtime_jail_enabled":
+		panic(fmt.Errorf("field downtime_jail_enabled of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.admin_proposal_expiry":
+		panic(fmt.Errorf("field admin_proposal_expiry of message opinit.opchild.v1.Params is not mutable"))
+	case "opinit.opchild.v1.Params.l1_client_id":
+		panic(fmt.Errorf("field l1_client_id of message opinit.opchild.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
@@ -492,6 +1146,46 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "opinit.opchild.v1.Params.fee_whitelist":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "opinit.opchild.v1.Params.strict_deposit_ordering":
+		return protoreflect.ValueOfBool(false)
+	case "opinit.opchild.v1.Params.deposit_attestation_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "opinit.opchild.v1.Params.deposit_proof_required":
+		return protoreflect.ValueOfBool(false)
+	case "opinit.opchild.v1.Params.bridge_info_proof_required":
+		return protoreflect.ValueOfBool(false)
+	case "opinit.opchild.v1.Params.execute_messages_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.Params.allowed_execute_messages":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "opinit.opchild.v1.Params.admin_signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "opinit.opchild.v1.Params.admin_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "opinit.opchild.v1.Params.deposit_relayers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_15_list{list: &list})
+	case "opinit.opchild.v1.Params.oracle_relayers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "opinit.opchild.v1.Params.bridge_info_setters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_17_list{list: &list})
+	case "opinit.opchild.v1.Params.signed_blocks_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.Params.min_signed_per_window":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.opchild.v1.Params.downtime_jail_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "opinit.opchild.v1.Params.admin_proposal_expiry":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.Params.l2_native_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_22_list{list: &list})
+	case "opinit.opchild.v1.Params.l1_client_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Params"))
@@ -589,6 +1283,77 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.StrictDepositOrdering {
+			n += 2
+		}
+		if x.DepositAttestationThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.DepositAttestationThreshold))
+		}
+		if x.DepositProofRequired {
+			n += 2
+		}
+		if x.BridgeInfoProofRequired {
+			n += 2
+		}
+		if x.ExecuteMessagesDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteMessagesDelay))
+		}
+		if len(x.AllowedExecuteMessages) > 0 {
+			for _, s := range x.AllowedExecuteMessages {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AdminSigners) > 0 {
+			for _, s := range x.AdminSigners {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AdminThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.AdminThreshold))
+		}
+		if len(x.DepositRelayers) > 0 {
+			for _, s := range x.DepositRelayers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OracleRelayers) > 0 {
+			for _, s := range x.OracleRelayers {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BridgeInfoSetters) > 0 {
+			for _, s := range x.BridgeInfoSetters {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SignedBlocksWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.SignedBlocksWindow))
+		}
+		l = len(x.MinSignedPerWindow)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeJailEnabled {
+			n += 3
+		}
+		if x.AdminProposalExpiry != 0 {
+			n += 2 + runtime.Sov(uint64(x.AdminProposalExpiry))
+		}
+		if len(x.L2NativeDenoms) > 0 {
+			for _, s := range x.L2NativeDenoms {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.L1ClientId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -618,6 +1383,155 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.L1ClientId) > 0 {
+			i -= len(x.L1ClientId)
+			copy(dAtA[i:], x.L1ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L1ClientId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.L2NativeDenoms) > 0 {
+			for iNdEx := len(x.L2NativeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.L2NativeDenoms[iNdEx])
+				copy(dAtA[i:], x.L2NativeDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L2NativeDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if x.AdminProposalExpiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AdminProposalExpiry))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.DowntimeJailEnabled {
+			i--
+			if x.DowntimeJailEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.MinSignedPerWindow) > 0 {
+			i -= len(x.MinSignedPerWindow)
+			copy(dAtA[i:], x.MinSignedPerWindow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSignedPerWindow)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.SignedBlocksWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignedBlocksWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.BridgeInfoSetters) > 0 {
+			for iNdEx := len(x.BridgeInfoSetters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BridgeInfoSetters[iNdEx])
+				copy(dAtA[i:], x.BridgeInfoSetters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BridgeInfoSetters[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.OracleRelayers) > 0 {
+			for iNdEx := len(x.OracleRelayers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OracleRelayers[iNdEx])
+				copy(dAtA[i:], x.OracleRelayers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleRelayers[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.DepositRelayers) > 0 {
+			for iNdEx := len(x.DepositRelayers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DepositRelayers[iNdEx])
+				copy(dAtA[i:], x.DepositRelayers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositRelayers[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.AdminThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AdminThreshold))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.AdminSigners) > 0 {
+			for iNdEx := len(x.AdminSigners) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AdminSigners[iNdEx])
+				copy(dAtA[i:], x.AdminSigners[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdminSigners[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.AllowedExecuteMessages) > 0 {
+			for iNdEx := len(x.AllowedExecuteMessages) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedExecuteMessages[iNdEx])
+				copy(dAtA[i:], x.AllowedExecuteMessages[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedExecuteMessages[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.ExecuteMessagesDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteMessagesDelay))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.BridgeInfoProofRequired {
+			i--
+			if x.BridgeInfoProofRequired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.DepositProofRequired {
+			i--
+			if x.DepositProofRequired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.DepositAttestationThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositAttestationThreshold))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.StrictDepositOrdering {
+			i--
+			if x.StrictDepositOrdering {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.FeeWhitelist) > 0 {
 			for iNdEx := len(x.FeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.FeeWhitelist[iNdEx])
@@ -886,17 +1800,7576 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.FeeWhitelist = append(x.FeeWhitelist, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrictDepositOrdering", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.StrictDepositOrdering = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositAttestationThreshold", wireType)
+				}
+				x.DepositAttestationThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DepositAttestationThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositProofRequired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DepositProofRequired = bool(v != 0)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeInfoProofRequired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BridgeInfoProofRequired = bool(v != 0)
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteMessagesDelay", wireType)
+				}
+				x.ExecuteMessagesDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteMessagesDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedExecuteMessages", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedExecuteMessages = append(x.AllowedExecuteMessages, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdminSigners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdminSigners = append(x.AdminSigners, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+				}
+				x.AdminThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AdminThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositRelayers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositRelayers = append(x.DepositRelayers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleRelayers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleRelayers = append(x.OracleRelayers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeInfoSetters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BridgeInfoSetters = append(x.BridgeInfoSetters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+				}
+				x.SignedBlocksWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignedBlocksWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinSignedPerWindow = append(x.MinSignedPerWindow[:0], dAtA[iNdEx:postIndex]...)
+				if x.MinSignedPerWindow == nil {
+					x.MinSignedPerWindow = []byte{}
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DowntimeJailEnabled = bool(v != 0)
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdminProposalExpiry", wireType)
+				}
+				x.AdminProposalExpiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AdminProposalExpiry |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2NativeDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L2NativeDenoms = append(x.L2NativeDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L1ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Validator                  protoreflect.MessageDescriptor
+	fd_Validator_moniker          protoreflect.FieldDescriptor
+	fd_Validator_operator_address protoreflect.FieldDescriptor
+	fd_Validator_consensus_pubkey protoreflect.FieldDescriptor
+	fd_Validator_cons_power       protoreflect.FieldDescriptor
+	fd_Validator_jailed           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_Validator = File_opinit_opchild_v1_types_proto.Messages().ByName("Validator")
+	fd_Validator_moniker = md_Validator.Fields().ByName("moniker")
+	fd_Validator_operator_address = md_Validator.Fields().ByName("operator_address")
+	fd_Validator_consensus_pubkey = md_Validator.Fields().ByName("consensus_pubkey")
+	fd_Validator_cons_power = md_Validator.Fields().ByName("cons_power")
+	fd_Validator_jailed = md_Validator.Fields().ByName("jailed")
+}
+
+var _ protoreflect.Message = (*fastReflection_Validator)(nil)
+
+type fastReflection_Validator Validator
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Validator)(x)
+}
+
+func (x *Validator) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Validator_messageType fastReflection_Validator_messageType
+var _ protoreflect.MessageType = fastReflection_Validator_messageType{}
+
+type fastReflection_Validator_messageType struct{}
+
+func (x fastReflection_Validator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Validator)(nil)
+}
+func (x fastReflection_Validator_messageType) New() protoreflect.Message {
+	return new(fastReflection_Validator)
+}
+func (x fastReflection_Validator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Validator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Validator) Descriptor() protoreflect.MessageDescriptor {
+	return md_Validator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Validator) Type() protoreflect.MessageType {
+	return _fastReflection_Validator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Validator) New() protoreflect.Message {
+	return new(fastReflection_Validator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Validator) Interface() protoreflect.ProtoMessage {
+	return (*Validator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Validator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Moniker != "" {
+		value := protoreflect.ValueOfString(x.Moniker)
+		if !f(fd_Validator_moniker, value) {
+			return
+		}
+	}
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_Validator_operator_address, value) {
+			return
+		}
+	}
+	if x.ConsensusPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
+		if !f(fd_Validator_consensus_pubkey, value) {
+			return
+		}
+	}
+	if x.ConsPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ConsPower)
+		if !f(fd_Validator_cons_power, value) {
+			return
+		}
+	}
+	if x.Jailed != false {
+		value := protoreflect.ValueOfBool(x.Jailed)
+		if !f(fd_Validator_jailed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Validator.moniker":
+		return x.Moniker != ""
+	case "opinit.opchild.v1.Validator.operator_address":
+		return x.OperatorAddress != ""
+	case "opinit.opchild.v1.Validator.consensus_pubkey":
+		return x.ConsensusPubkey != nil
+	case "opinit.opchild.v1.Validator.cons_power":
+		return x.ConsPower != int64(0)
+	case "opinit.opchild.v1.Validator.jailed":
+		return x.Jailed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Validator.moniker":
+		x.Moniker = ""
+	case "opinit.opchild.v1.Validator.operator_address":
+		x.OperatorAddress = ""
+	case "opinit.opchild.v1.Validator.consensus_pubkey":
+		x.ConsensusPubkey = nil
+	case "opinit.opchild.v1.Validator.cons_power":
+		x.ConsPower = int64(0)
+	case "opinit.opchild.v1.Validator.jailed":
+		x.Jailed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.Validator.moniker":
+		value := x.Moniker
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.Validator.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.Validator.consensus_pubkey":
+		value := x.ConsensusPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.opchild.v1.Validator.cons_power":
+		value := x.ConsPower
+		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.Validator.jailed":
+		value := x.Jailed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Validator.moniker":
+		x.Moniker = value.Interface().(string)
+	case "opinit.opchild.v1.Validator.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "opinit.opchild.v1.Validator.consensus_pubkey":
+		x.ConsensusPubkey = value.Message().Interface().(*anypb.Any)
+	case "opinit.opchild.v1.Validator.cons_power":
+		x.ConsPower = value.Int()
+	case "opinit.opchild.v1.Validator.jailed":
+		x.Jailed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Validator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Validator.consensus_pubkey":
+		if x.ConsensusPubkey == nil {
+			x.ConsensusPubkey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
+	case "opinit.opchild.v1.Validator.moniker":
+		panic(fmt.Errorf("field moniker of message opinit.opchild.v1.Validator is not mutable"))
+	case "opinit.opchild.v1.Validator.operator_address":
+		panic(fmt.Errorf("field operator_address of message opinit.opchild.v1.Validator is not mutable"))
+	case "opinit.opchild.v1.Validator.cons_power":
+		panic(fmt.Errorf("field cons_power of message opinit.opchild.v1.Validator is not mutable"))
+	case "opinit.opchild.v1.Validator.jailed":
+		panic(fmt.Errorf("field jailed of message opinit.opchild.v1.Validator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Validator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.Validator.moniker":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.Validator.operator_address":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.Validator.consensus_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.opchild.v1.Validator.cons_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.Validator.jailed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Validator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.Validator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Validator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Validator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Validator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Validator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Moniker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConsensusPubkey != nil {
+			l = options.Size(x.ConsensusPubkey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConsPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsPower))
+		}
+		if x.Jailed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Validator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Jailed {
+			i--
+			if x.Jailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ConsPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsPower))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ConsensusPubkey != nil {
+			encoded, err := options.Marshal(x.ConsensusPubkey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Moniker) > 0 {
+			i -= len(x.Moniker)
+			copy(dAtA[i:], x.Moniker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Validator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Moniker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ConsensusPubkey == nil {
+					x.ConsensusPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsensusPubkey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsPower", wireType)
+				}
+				x.ConsPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Jailed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorSigningInfo                       protoreflect.MessageDescriptor
+	fd_ValidatorSigningInfo_address               protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_start_height          protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_index_offset          protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_missed_blocks_counter protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_ValidatorSigningInfo = File_opinit_opchild_v1_types_proto.Messages().ByName("ValidatorSigningInfo")
+	fd_ValidatorSigningInfo_address = md_ValidatorSigningInfo.Fields().ByName("address")
+	fd_ValidatorSigningInfo_start_height = md_ValidatorSigningInfo.Fields().ByName("start_height")
+	fd_ValidatorSigningInfo_index_offset = md_ValidatorSigningInfo.Fields().ByName("index_offset")
+	fd_ValidatorSigningInfo_missed_blocks_counter = md_ValidatorSigningInfo.Fields().ByName("missed_blocks_counter")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorSigningInfo)(nil)
+
+type fastReflection_ValidatorSigningInfo ValidatorSigningInfo
+
+func (x *ValidatorSigningInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSigningInfo)(x)
+}
+
+func (x *ValidatorSigningInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorSigningInfo_messageType fastReflection_ValidatorSigningInfo_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSigningInfo_messageType{}
+
+type fastReflection_ValidatorSigningInfo_messageType struct{}
+
+func (x fastReflection_ValidatorSigningInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSigningInfo)(nil)
+}
+func (x fastReflection_ValidatorSigningInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSigningInfo)
+}
+func (x fastReflection_ValidatorSigningInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSigningInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorSigningInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSigningInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorSigningInfo) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSigningInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorSigningInfo) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSigningInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorSigningInfo) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSigningInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorSigningInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ValidatorSigningInfo_address, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_ValidatorSigningInfo_start_height, value) {
+			return
+		}
+	}
+	if x.IndexOffset != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IndexOffset)
+		if !f(fd_ValidatorSigningInfo_index_offset, value) {
+			return
+		}
+	}
+	if x.MissedBlocksCounter != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MissedBlocksCounter)
+		if !f(fd_ValidatorSigningInfo_missed_blocks_counter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorSigningInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorSigningInfo.address":
+		return x.Address != ""
+	case "opinit.opchild.v1.ValidatorSigningInfo.start_height":
+		return x.StartHeight != int64(0)
+	case "opinit.opchild.v1.ValidatorSigningInfo.index_offset":
+		return x.IndexOffset != int64(0)
+	case "opinit.opchild.v1.ValidatorSigningInfo.missed_blocks_counter":
+		return x.MissedBlocksCounter != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorSigningInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSigningInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorSigningInfo.address":
+		x.Address = ""
+	case "opinit.opchild.v1.ValidatorSigningInfo.start_height":
+		x.StartHeight = int64(0)
+	case "opinit.opchild.v1.ValidatorSigningInfo.index_offset":
+		x.IndexOffset = int64(0)
+	case "opinit.opchild.v1.ValidatorSigningInfo.missed_blocks_counter":
+		x.MissedBlocksCounter = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorSigningInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorSigningInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.ValidatorSigningInfo.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.ValidatorSigningInfo.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.ValidatorSigningInfo.index_offset":
+		value := x.IndexOffset
+		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.ValidatorSigningInfo.missed_blocks_counter":
+		value := x.MissedBlocksCounter
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorSigningInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorSigningInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSigningInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorSigningInfo.address":
+		x.Address = value.Interface().(string)
+	case "opinit.opchild.v1.ValidatorSigningInfo.start_height":
+		x.StartHeight = value.Int()
+	case "opinit.opchild.v1.ValidatorSigningInfo.index_offset":
+		x.IndexOffset = value.Int()
+	case "opinit.opchild.v1.ValidatorSigningInfo.missed_blocks_counter":
+		x.MissedBlocksCounter = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorSigningInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorSigningInfo.address":
+		panic(fmt.Errorf("field address of message opinit.opchild.v1.ValidatorSigningInfo is not mutable"))
+	case "opinit.opchild.v1.ValidatorSigningInfo.start_height":
+		panic(fmt.Errorf("field start_height of message opinit.opchild.v1.ValidatorSigningInfo is not mutable"))
+	case "opinit.opchild.v1.ValidatorSigningInfo.index_offset":
+		panic(fmt.Errorf("field index_offset of message opinit.opchild.v1.ValidatorSigningInfo is not mutable"))
+	case "opinit.opchild.v1.ValidatorSigningInfo.missed_blocks_counter":
+		panic(fmt.Errorf("field missed_blocks_counter of message opinit.opchild.v1.ValidatorSigningInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorSigningInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorSigningInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorSigningInfo.address":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.ValidatorSigningInfo.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.ValidatorSigningInfo.index_offset":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.ValidatorSigningInfo.missed_blocks_counter":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorSigningInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorSigningInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.ValidatorSigningInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorSigningInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSigningInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorSigningInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorSigningInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.IndexOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.IndexOffset))
+		}
+		if x.MissedBlocksCounter != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedBlocksCounter))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorSigningInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MissedBlocksCounter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedBlocksCounter))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IndexOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IndexOffset))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorSigningInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				}
+				x.IndexOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IndexOffset |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				}
+				x.MissedBlocksCounter = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedBlocksCounter |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorUpdates_1_list)(nil)
+
+type _ValidatorUpdates_1_list struct {
+	list *[]*abci.ValidatorUpdate
+}
+
+func (x *_ValidatorUpdates_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorUpdates_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorUpdates_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*abci.ValidatorUpdate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorUpdates_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*abci.ValidatorUpdate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorUpdates_1_list) AppendMutable() protoreflect.Value {
+	v := new(abci.ValidatorUpdate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorUpdates_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorUpdates_1_list) NewElement() protoreflect.Value {
+	v := new(abci.ValidatorUpdate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorUpdates_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorUpdates         protoreflect.MessageDescriptor
+	fd_ValidatorUpdates_updates protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_ValidatorUpdates = File_opinit_opchild_v1_types_proto.Messages().ByName("ValidatorUpdates")
+	fd_ValidatorUpdates_updates = md_ValidatorUpdates.Fields().ByName("updates")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorUpdates)(nil)
+
+type fastReflection_ValidatorUpdates ValidatorUpdates
+
+func (x *ValidatorUpdates) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorUpdates)(x)
+}
+
+func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorUpdates_messageType fastReflection_ValidatorUpdates_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorUpdates_messageType{}
+
+type fastReflection_ValidatorUpdates_messageType struct{}
+
+func (x fastReflection_ValidatorUpdates_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorUpdates)(nil)
+}
+func (x fastReflection_ValidatorUpdates_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorUpdates)
+}
+func (x fastReflection_ValidatorUpdates_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorUpdates
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorUpdates) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorUpdates
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorUpdates) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorUpdates_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorUpdates) New() protoreflect.Message {
+	return new(fastReflection_ValidatorUpdates)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorUpdates) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorUpdates)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorUpdates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Updates) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &x.Updates})
+		if !f(fd_ValidatorUpdates_updates, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorUpdates) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorUpdates.updates":
+		return len(x.Updates) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorUpdates.updates":
+		x.Updates = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorUpdates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.ValidatorUpdates.updates":
+		if len(x.Updates) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{})
+		}
+		listValue := &_ValidatorUpdates_1_list{list: &x.Updates}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorUpdates.updates":
+		lv := value.List()
+		clv := lv.(*_ValidatorUpdates_1_list)
+		x.Updates = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorUpdates.updates":
+		if x.Updates == nil {
+			x.Updates = []*abci.ValidatorUpdate{}
+		}
+		value := &_ValidatorUpdates_1_list{list: &x.Updates}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorUpdates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ValidatorUpdates.updates":
+		list := []*abci.ValidatorUpdate{}
+		return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorUpdates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.ValidatorUpdates", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorUpdates) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorUpdates) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorUpdates) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorUpdates)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Updates) > 0 {
+			for _, e := range x.Updates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorUpdates)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Updates) > 0 {
+			for iNdEx := len(x.Updates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Updates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorUpdates)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Updates = append(x.Updates, &abci.ValidatorUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Updates[len(x.Updates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BridgeInfo               protoreflect.MessageDescriptor
+	fd_BridgeInfo_bridge_id     protoreflect.FieldDescriptor
+	fd_BridgeInfo_bridge_addr   protoreflect.FieldDescriptor
+	fd_BridgeInfo_l1_chain_id   protoreflect.FieldDescriptor
+	fd_BridgeInfo_l1_client_id  protoreflect.FieldDescriptor
+	fd_BridgeInfo_bridge_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_BridgeInfo = File_opinit_opchild_v1_types_proto.Messages().ByName("BridgeInfo")
+	fd_BridgeInfo_bridge_id = md_BridgeInfo.Fields().ByName("bridge_id")
+	fd_BridgeInfo_bridge_addr = md_BridgeInfo.Fields().ByName("bridge_addr")
+	fd_BridgeInfo_l1_chain_id = md_BridgeInfo.Fields().ByName("l1_chain_id")
+	fd_BridgeInfo_l1_client_id = md_BridgeInfo.Fields().ByName("l1_client_id")
+	fd_BridgeInfo_bridge_config = md_BridgeInfo.Fields().ByName("bridge_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeInfo)(nil)
+
+type fastReflection_BridgeInfo BridgeInfo
+
+func (x *BridgeInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeInfo)(x)
+}
+
+func (x *BridgeInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeInfo_messageType fastReflection_BridgeInfo_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeInfo_messageType{}
+
+type fastReflection_BridgeInfo_messageType struct{}
+
+func (x fastReflection_BridgeInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeInfo)(nil)
+}
+func (x fastReflection_BridgeInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeInfo)
+}
+func (x fastReflection_BridgeInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeInfo) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeInfo) New() protoreflect.Message {
+	return new(fastReflection_BridgeInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeInfo) Interface() protoreflect.ProtoMessage {
+	return (*BridgeInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_BridgeInfo_bridge_id, value) {
+			return
+		}
+	}
+	if x.BridgeAddr != "" {
+		value := protoreflect.ValueOfString(x.BridgeAddr)
+		if !f(fd_BridgeInfo_bridge_addr, value) {
+			return
+		}
+	}
+	if x.L1ChainId != "" {
+		value := protoreflect.ValueOfString(x.L1ChainId)
+		if !f(fd_BridgeInfo_l1_chain_id, value) {
+			return
+		}
+	}
+	if x.L1ClientId != "" {
+		value := protoreflect.ValueOfString(x.L1ClientId)
+		if !f(fd_BridgeInfo_l1_client_id, value) {
+			return
+		}
+	}
+	if x.BridgeConfig != nil {
+		value := protoreflect.ValueOfMessage(x.BridgeConfig.ProtoReflect())
+		if !f(fd_BridgeInfo_bridge_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.BridgeInfo.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
+		return x.BridgeAddr != ""
+	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
+		return x.L1ChainId != ""
+	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
+		return x.L1ClientId != ""
+	case "opinit.opchild.v1.BridgeInfo.bridge_config":
+		return x.BridgeConfig != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.BridgeInfo.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
+		x.BridgeAddr = ""
+	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
+		x.L1ChainId = ""
+	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
+		x.L1ClientId = ""
+	case "opinit.opchild.v1.BridgeInfo.bridge_config":
+		x.BridgeConfig = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.BridgeInfo.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
+		value := x.BridgeAddr
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
+		value := x.L1ChainId
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
+		value := x.L1ClientId
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.BridgeInfo.bridge_config":
+		value := x.BridgeConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.BridgeInfo.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
+		x.BridgeAddr = value.Interface().(string)
+	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
+		x.L1ChainId = value.Interface().(string)
+	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
+		x.L1ClientId = value.Interface().(string)
+	case "opinit.opchild.v1.BridgeInfo.bridge_config":
+		x.BridgeConfig = value.Message().Interface().(*v1.BridgeConfig)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.BridgeInfo.bridge_config":
+		if x.BridgeConfig == nil {
+			x.BridgeConfig = new(v1.BridgeConfig)
+		}
+		return protoreflect.ValueOfMessage(x.BridgeConfig.ProtoReflect())
+	case "opinit.opchild.v1.BridgeInfo.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.opchild.v1.BridgeInfo is not mutable"))
+	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
+		panic(fmt.Errorf("field bridge_addr of message opinit.opchild.v1.BridgeInfo is not mutable"))
+	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
+		panic(fmt.Errorf("field l1_chain_id of message opinit.opchild.v1.BridgeInfo is not mutable"))
+	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
+		panic(fmt.Errorf("field l1_client_id of message opinit.opchild.v1.BridgeInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.BridgeInfo.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.BridgeInfo.bridge_config":
+		m := new(v1.BridgeConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.BridgeInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		l = len(x.BridgeAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.L1ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.L1ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BridgeConfig != nil {
+			l = options.Size(x.BridgeConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BridgeConfig != nil {
+			encoded, err := options.Marshal(x.BridgeConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.L1ClientId) > 0 {
+			i -= len(x.L1ClientId)
+			copy(dAtA[i:], x.L1ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L1ClientId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.L1ChainId) > 0 {
+			i -= len(x.L1ChainId)
+			copy(dAtA[i:], x.L1ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L1ChainId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BridgeAddr) > 0 {
+			i -= len(x.BridgeAddr)
+			copy(dAtA[i:], x.BridgeAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BridgeAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BridgeAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L1ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L1ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BridgeConfig == nil {
+					x.BridgeConfig = &v1.BridgeConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BridgeConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_WithdrawalLeaf               protoreflect.MessageDescriptor
+	fd_WithdrawalLeaf_sequence      protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_height        protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_from          protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_to            protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_base_denom    protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_amount        protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_base_class_id protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_token_id      protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_leaf_version  protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_hash          protoreflect.FieldDescriptor
+	fd_WithdrawalLeaf_l2_denom      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_WithdrawalLeaf = File_opinit_opchild_v1_types_proto.Messages().ByName("WithdrawalLeaf")
+	fd_WithdrawalLeaf_sequence = md_WithdrawalLeaf.Fields().ByName("sequence")
+	fd_WithdrawalLeaf_height = md_WithdrawalLeaf.Fields().ByName("height")
+	fd_WithdrawalLeaf_from = md_WithdrawalLeaf.Fields().ByName("from")
+	fd_WithdrawalLeaf_to = md_WithdrawalLeaf.Fields().ByName("to")
+	fd_WithdrawalLeaf_base_denom = md_WithdrawalLeaf.Fields().ByName("base_denom")
+	fd_WithdrawalLeaf_amount = md_WithdrawalLeaf.Fields().ByName("amount")
+	fd_WithdrawalLeaf_base_class_id = md_WithdrawalLeaf.Fields().ByName("base_class_id")
+	fd_WithdrawalLeaf_token_id = md_WithdrawalLeaf.Fields().ByName("token_id")
+	fd_WithdrawalLeaf_leaf_version = md_WithdrawalLeaf.Fields().ByName("leaf_version")
+	fd_WithdrawalLeaf_hash = md_WithdrawalLeaf.Fields().ByName("hash")
+	fd_WithdrawalLeaf_l2_denom = md_WithdrawalLeaf.Fields().ByName("l2_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_WithdrawalLeaf)(nil)
+
+type fastReflection_WithdrawalLeaf WithdrawalLeaf
+
+func (x *WithdrawalLeaf) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawalLeaf)(x)
+}
+
+func (x *WithdrawalLeaf) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WithdrawalLeaf_messageType fastReflection_WithdrawalLeaf_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawalLeaf_messageType{}
+
+type fastReflection_WithdrawalLeaf_messageType struct{}
+
+func (x fastReflection_WithdrawalLeaf_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawalLeaf)(nil)
+}
+func (x fastReflection_WithdrawalLeaf_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawalLeaf)
+}
+func (x fastReflection_WithdrawalLeaf_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawalLeaf
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WithdrawalLeaf) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawalLeaf
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WithdrawalLeaf) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawalLeaf_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WithdrawalLeaf) New() protoreflect.Message {
+	return new(fastReflection_WithdrawalLeaf)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WithdrawalLeaf) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawalLeaf)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WithdrawalLeaf) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_WithdrawalLeaf_sequence, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_WithdrawalLeaf_height, value) {
+			return
+		}
+	}
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_WithdrawalLeaf_from, value) {
+			return
+		}
+	}
+	if x.To != "" {
+		value := protoreflect.ValueOfString(x.To)
+		if !f(fd_WithdrawalLeaf_to, value) {
+			return
+		}
+	}
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_WithdrawalLeaf_base_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_WithdrawalLeaf_amount, value) {
+			return
+		}
+	}
+	if x.BaseClassId != "" {
+		value := protoreflect.ValueOfString(x.BaseClassId)
+		if !f(fd_WithdrawalLeaf_base_class_id, value) {
+			return
+		}
+	}
+	if x.TokenId != "" {
+		value := protoreflect.ValueOfString(x.TokenId)
+		if !f(fd_WithdrawalLeaf_token_id, value) {
+			return
+		}
+	}
+	if x.LeafVersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LeafVersion)
+		if !f(fd_WithdrawalLeaf_leaf_version, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_WithdrawalLeaf_hash, value) {
+			return
+		}
+	}
+	if x.L2Denom != "" {
+		value := protoreflect.ValueOfString(x.L2Denom)
+		if !f(fd_WithdrawalLeaf_l2_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WithdrawalLeaf) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalLeaf.sequence":
+		return x.Sequence != uint64(0)
+	case "opinit.opchild.v1.WithdrawalLeaf.height":
+		return x.Height != int64(0)
+	case "opinit.opchild.v1.WithdrawalLeaf.from":
+		return x.From != ""
+	case "opinit.opchild.v1.WithdrawalLeaf.to":
+		return x.To != ""
+	case "opinit.opchild.v1.WithdrawalLeaf.base_denom":
+		return x.BaseDenom != ""
+	case "opinit.opchild.v1.WithdrawalLeaf.amount":
+		return x.Amount != ""
+	case "opinit.opchild.v1.WithdrawalLeaf.base_class_id":
+		return x.BaseClassId != ""
+	case "opinit.opchild.v1.WithdrawalLeaf.token_id":
+		return x.TokenId != ""
+	case "opinit.opchild.v1.WithdrawalLeaf.leaf_version":
+		return x.LeafVersion != uint32(0)
+	case "opinit.opchild.v1.WithdrawalLeaf.hash":
+		return len(x.Hash) != 0
+	case "opinit.opchild.v1.WithdrawalLeaf.l2_denom":
+		return x.L2Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalLeaf"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalLeaf) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalLeaf.sequence":
+		x.Sequence = uint64(0)
+	case "opinit.opchild.v1.WithdrawalLeaf.height":
+		x.Height = int64(0)
+	case "opinit.opchild.v1.WithdrawalLeaf.from":
+		x.From = ""
+	case "opinit.opchild.v1.WithdrawalLeaf.to":
+		x.To = ""
+	case "opinit.opchild.v1.WithdrawalLeaf.base_denom":
+		x.BaseDenom = ""
+	case "opinit.opchild.v1.WithdrawalLeaf.amount":
+		x.Amount = ""
+	case "opinit.opchild.v1.WithdrawalLeaf.base_class_id":
+		x.BaseClassId = ""
+	case "opinit.opchild.v1.WithdrawalLeaf.token_id":
+		x.TokenId = ""
+	case "opinit.opchild.v1.WithdrawalLeaf.leaf_version":
+		x.LeafVersion = uint32(0)
+	case "opinit.opchild.v1.WithdrawalLeaf.hash":
+		x.Hash = nil
+	case "opinit.opchild.v1.WithdrawalLeaf.l2_denom":
+		x.L2Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalLeaf"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WithdrawalLeaf) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.WithdrawalLeaf.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.to":
+		value := x.To
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.base_class_id":
+		value := x.BaseClassId
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.token_id":
+		value := x.TokenId
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.leaf_version":
+		value := x.LeafVersion
+		return protoreflect.ValueOfUint32(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.opchild.v1.WithdrawalLeaf.l2_denom":
+		value := x.L2Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalLeaf"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalLeaf does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalLeaf) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalLeaf.sequence":
+		x.Sequence = value.Uint()
+	case "opinit.opchild.v1.WithdrawalLeaf.height":
+		x.Height = value.Int()
+	case "opinit.opchild.v1.WithdrawalLeaf.from":
+		x.From = value.Interface().(string)
+	case "opinit.opchild.v1.WithdrawalLeaf.to":
+		x.To = value.Interface().(string)
+	case "opinit.opchild.v1.WithdrawalLeaf.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "opinit.opchild.v1.WithdrawalLeaf.amount":
+		x.Amount = value.Interface().(string)
+	case "opinit.opchild.v1.WithdrawalLeaf.base_class_id":
+		x.BaseClassId = value.Interface().(string)
+	case "opinit.opchild.v1.WithdrawalLeaf.token_id":
+		x.TokenId = value.Interface().(string)
+	case "opinit.opchild.v1.WithdrawalLeaf.leaf_version":
+		x.LeafVersion = uint32(value.Uint())
+	case "opinit.opchild.v1.WithdrawalLeaf.hash":
+		x.Hash = value.Bytes()
+	case "opinit.opchild.v1.WithdrawalLeaf.l2_denom":
+		x.L2Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalLeaf"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalLeaf) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalLeaf.sequence":
+		panic(fmt.Errorf("field sequence of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.height":
+		panic(fmt.Errorf("field height of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.from":
+		panic(fmt.Errorf("field from of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.to":
+		panic(fmt.Errorf("field to of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.base_denom":
+		panic(fmt.Errorf("field base_denom of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.amount":
+		panic(fmt.Errorf("field amount of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.base_class_id":
+		panic(fmt.Errorf("field base_class_id of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.token_id":
+		panic(fmt.Errorf("field token_id of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.leaf_version":
+		panic(fmt.Errorf("field leaf_version of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.hash":
+		panic(fmt.Errorf("field hash of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	case "opinit.opchild.v1.WithdrawalLeaf.l2_denom":
+		panic(fmt.Errorf("field l2_denom of message opinit.opchild.v1.WithdrawalLeaf is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalLeaf"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WithdrawalLeaf) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalLeaf.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.WithdrawalLeaf.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.WithdrawalLeaf.from":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.WithdrawalLeaf.to":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.WithdrawalLeaf.base_denom":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.WithdrawalLeaf.amount":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.WithdrawalLeaf.base_class_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.WithdrawalLeaf.token_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.WithdrawalLeaf.leaf_version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "opinit.opchild.v1.WithdrawalLeaf.hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.opchild.v1.WithdrawalLeaf.l2_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalLeaf"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WithdrawalLeaf) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.WithdrawalLeaf", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WithdrawalLeaf) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalLeaf) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WithdrawalLeaf) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WithdrawalLeaf) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WithdrawalLeaf)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.To)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LeafVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeafVersion))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.L2Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawalLeaf)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.L2Denom) > 0 {
+			i -= len(x.L2Denom)
+			copy(dAtA[i:], x.L2Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L2Denom)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.LeafVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeafVersion))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.TokenId) > 0 {
+			i -= len(x.TokenId)
+			copy(dAtA[i:], x.TokenId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BaseClassId) > 0 {
+			i -= len(x.BaseClassId)
+			copy(dAtA[i:], x.BaseClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseClassId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.To) > 0 {
+			i -= len(x.To)
+			copy(dAtA[i:], x.To)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.To)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawalLeaf)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawalLeaf: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawalLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.To = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafVersion", wireType)
+				}
+				x.LeafVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeafVersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L2Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_WithdrawalCommitment                   protoreflect.MessageDescriptor
+	fd_WithdrawalCommitment_height            protoreflect.FieldDescriptor
+	fd_WithdrawalCommitment_start_sequence    protoreflect.FieldDescriptor
+	fd_WithdrawalCommitment_end_sequence      protoreflect.FieldDescriptor
+	fd_WithdrawalCommitment_storage_root      protoreflect.FieldDescriptor
+	fd_WithdrawalCommitment_window_end        protoreflect.FieldDescriptor
+	fd_WithdrawalCommitment_state_root        protoreflect.FieldDescriptor
+	fd_WithdrawalCommitment_latest_block_hash protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_WithdrawalCommitment = File_opinit_opchild_v1_types_proto.Messages().ByName("WithdrawalCommitment")
+	fd_WithdrawalCommitment_height = md_WithdrawalCommitment.Fields().ByName("height")
+	fd_WithdrawalCommitment_start_sequence = md_WithdrawalCommitment.Fields().ByName("start_sequence")
+	fd_WithdrawalCommitment_end_sequence = md_WithdrawalCommitment.Fields().ByName("end_sequence")
+	fd_WithdrawalCommitment_storage_root = md_WithdrawalCommitment.Fields().ByName("storage_root")
+	fd_WithdrawalCommitment_window_end = md_WithdrawalCommitment.Fields().ByName("window_end")
+	fd_WithdrawalCommitment_state_root = md_WithdrawalCommitment.Fields().ByName("state_root")
+	fd_WithdrawalCommitment_latest_block_hash = md_WithdrawalCommitment.Fields().ByName("latest_block_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_WithdrawalCommitment)(nil)
+
+type fastReflection_WithdrawalCommitment WithdrawalCommitment
+
+func (x *WithdrawalCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawalCommitment)(x)
+}
+
+func (x *WithdrawalCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WithdrawalCommitment_messageType fastReflection_WithdrawalCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawalCommitment_messageType{}
+
+type fastReflection_WithdrawalCommitment_messageType struct{}
+
+func (x fastReflection_WithdrawalCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawalCommitment)(nil)
+}
+func (x fastReflection_WithdrawalCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawalCommitment)
+}
+func (x fastReflection_WithdrawalCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawalCommitment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WithdrawalCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawalCommitment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WithdrawalCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawalCommitment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WithdrawalCommitment) New() protoreflect.Message {
+	return new(fastReflection_WithdrawalCommitment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WithdrawalCommitment) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawalCommitment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WithdrawalCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_WithdrawalCommitment_height, value) {
+			return
+		}
+	}
+	if x.StartSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartSequence)
+		if !f(fd_WithdrawalCommitment_start_sequence, value) {
+			return
+		}
+	}
+	if x.EndSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndSequence)
+		if !f(fd_WithdrawalCommitment_end_sequence, value) {
+			return
+		}
+	}
+	if len(x.StorageRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.StorageRoot)
+		if !f(fd_WithdrawalCommitment_storage_root, value) {
+			return
+		}
+	}
+	if x.WindowEnd != false {
+		value := protoreflect.ValueOfBool(x.WindowEnd)
+		if !f(fd_WithdrawalCommitment_window_end, value) {
+			return
+		}
+	}
+	if len(x.StateRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.StateRoot)
+		if !f(fd_WithdrawalCommitment_state_root, value) {
+			return
+		}
+	}
+	if len(x.LatestBlockHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.LatestBlockHash)
+		if !f(fd_WithdrawalCommitment_latest_block_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WithdrawalCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalCommitment.height":
+		return x.Height != int64(0)
+	case "opinit.opchild.v1.WithdrawalCommitment.start_sequence":
+		return x.StartSequence != uint64(0)
+	case "opinit.opchild.v1.WithdrawalCommitment.end_sequence":
+		return x.EndSequence != uint64(0)
+	case "opinit.opchild.v1.WithdrawalCommitment.storage_root":
+		return len(x.StorageRoot) != 0
+	case "opinit.opchild.v1.WithdrawalCommitment.window_end":
+		return x.WindowEnd != false
+	case "opinit.opchild.v1.WithdrawalCommitment.state_root":
+		return len(x.StateRoot) != 0
+	case "opinit.opchild.v1.WithdrawalCommitment.latest_block_hash":
+		return len(x.LatestBlockHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalCommitment.height":
+		x.Height = int64(0)
+	case "opinit.opchild.v1.WithdrawalCommitment.start_sequence":
+		x.StartSequence = uint64(0)
+	case "opinit.opchild.v1.WithdrawalCommitment.end_sequence":
+		x.EndSequence = uint64(0)
+	case "opinit.opchild.v1.WithdrawalCommitment.storage_root":
+		x.StorageRoot = nil
+	case "opinit.opchild.v1.WithdrawalCommitment.window_end":
+		x.WindowEnd = false
+	case "opinit.opchild.v1.WithdrawalCommitment.state_root":
+		x.StateRoot = nil
+	case "opinit.opchild.v1.WithdrawalCommitment.latest_block_hash":
+		x.LatestBlockHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WithdrawalCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.WithdrawalCommitment.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.WithdrawalCommitment.start_sequence":
+		value := x.StartSequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.WithdrawalCommitment.end_sequence":
+		value := x.EndSequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.WithdrawalCommitment.storage_root":
+		value := x.StorageRoot
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.opchild.v1.WithdrawalCommitment.window_end":
+		value := x.WindowEnd
+		return protoreflect.ValueOfBool(value)
+	case "opinit.opchild.v1.WithdrawalCommitment.state_root":
+		value := x.StateRoot
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.opchild.v1.WithdrawalCommitment.latest_block_hash":
+		value := x.LatestBlockHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalCommitment.height":
+		x.Height = value.Int()
+	case "opinit.opchild.v1.WithdrawalCommitment.start_sequence":
+		x.StartSequence = value.Uint()
+	case "opinit.opchild.v1.WithdrawalCommitment.end_sequence":
+		x.EndSequence = value.Uint()
+	case "opinit.opchild.v1.WithdrawalCommitment.storage_root":
+		x.StorageRoot = value.Bytes()
+	case "opinit.opchild.v1.WithdrawalCommitment.window_end":
+		x.WindowEnd = value.Bool()
+	case "opinit.opchild.v1.WithdrawalCommitment.state_root":
+		x.StateRoot = value.Bytes()
+	case "opinit.opchild.v1.WithdrawalCommitment.latest_block_hash":
+		x.LatestBlockHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalCommitment.height":
+		panic(fmt.Errorf("field height of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	case "opinit.opchild.v1.WithdrawalCommitment.start_sequence":
+		panic(fmt.Errorf("field start_sequence of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	case "opinit.opchild.v1.WithdrawalCommitment.end_sequence":
+		panic(fmt.Errorf("field end_sequence of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	case "opinit.opchild.v1.WithdrawalCommitment.storage_root":
+		panic(fmt.Errorf("field storage_root of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	case "opinit.opchild.v1.WithdrawalCommitment.window_end":
+		panic(fmt.Errorf("field window_end of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	case "opinit.opchild.v1.WithdrawalCommitment.state_root":
+		panic(fmt.Errorf("field state_root of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	case "opinit.opchild.v1.WithdrawalCommitment.latest_block_hash":
+		panic(fmt.Errorf("field latest_block_hash of message opinit.opchild.v1.WithdrawalCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WithdrawalCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalCommitment.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.WithdrawalCommitment.start_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.WithdrawalCommitment.end_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.WithdrawalCommitment.storage_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.opchild.v1.WithdrawalCommitment.window_end":
+		return protoreflect.ValueOfBool(false)
+	case "opinit.opchild.v1.WithdrawalCommitment.state_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.opchild.v1.WithdrawalCommitment.latest_block_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WithdrawalCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.WithdrawalCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WithdrawalCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WithdrawalCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WithdrawalCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WithdrawalCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.StartSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartSequence))
+		}
+		if x.EndSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndSequence))
+		}
+		l = len(x.StorageRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WindowEnd {
+			n += 2
+		}
+		l = len(x.StateRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LatestBlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawalCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LatestBlockHash) > 0 {
+			i -= len(x.LatestBlockHash)
+			copy(dAtA[i:], x.LatestBlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LatestBlockHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.StateRoot) > 0 {
+			i -= len(x.StateRoot)
+			copy(dAtA[i:], x.StateRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateRoot)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.WindowEnd {
+			i--
+			if x.WindowEnd {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.StorageRoot) > 0 {
+			i -= len(x.StorageRoot)
+			copy(dAtA[i:], x.StorageRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageRoot)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndSequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartSequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawalCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawalCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawalCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
+				}
+				x.StartSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndSequence", wireType)
+				}
+				x.EndSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageRoot = append(x.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.StorageRoot == nil {
+					x.StorageRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.WindowEnd = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateRoot = append(x.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.StateRoot == nil {
+					x.StateRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LatestBlockHash = append(x.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.LatestBlockHash == nil {
+					x.LatestBlockHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_WithdrawalWindow_4_list)(nil)
+
+type _WithdrawalWindow_4_list struct {
+	list *[][]byte
+}
+
+func (x *_WithdrawalWindow_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WithdrawalWindow_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_WithdrawalWindow_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WithdrawalWindow_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WithdrawalWindow_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WithdrawalWindow at list field Frontier as it is not of Message kind"))
+}
+
+func (x *_WithdrawalWindow_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WithdrawalWindow_4_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_WithdrawalWindow_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_WithdrawalWindow                    protoreflect.MessageDescriptor
+	fd_WithdrawalWindow_start_sequence     protoreflect.FieldDescriptor
+	fd_WithdrawalWindow_end_time           protoreflect.FieldDescriptor
+	fd_WithdrawalWindow_num_leaves         protoreflect.FieldDescriptor
+	fd_WithdrawalWindow_frontier           protoreflect.FieldDescriptor
+	fd_WithdrawalWindow_next_leaf_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_WithdrawalWindow = File_opinit_opchild_v1_types_proto.Messages().ByName("WithdrawalWindow")
+	fd_WithdrawalWindow_start_sequence = md_WithdrawalWindow.Fields().ByName("start_sequence")
+	fd_WithdrawalWindow_end_time = md_WithdrawalWindow.Fields().ByName("end_time")
+	fd_WithdrawalWindow_num_leaves = md_WithdrawalWindow.Fields().ByName("num_leaves")
+	fd_WithdrawalWindow_frontier = md_WithdrawalWindow.Fields().ByName("frontier")
+	fd_WithdrawalWindow_next_leaf_sequence = md_WithdrawalWindow.Fields().ByName("next_leaf_sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_WithdrawalWindow)(nil)
+
+type fastReflection_WithdrawalWindow WithdrawalWindow
+
+func (x *WithdrawalWindow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WithdrawalWindow)(x)
+}
+
+func (x *WithdrawalWindow) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WithdrawalWindow_messageType fastReflection_WithdrawalWindow_messageType
+var _ protoreflect.MessageType = fastReflection_WithdrawalWindow_messageType{}
+
+type fastReflection_WithdrawalWindow_messageType struct{}
+
+func (x fastReflection_WithdrawalWindow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WithdrawalWindow)(nil)
+}
+func (x fastReflection_WithdrawalWindow_messageType) New() protoreflect.Message {
+	return new(fastReflection_WithdrawalWindow)
+}
+func (x fastReflection_WithdrawalWindow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawalWindow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WithdrawalWindow) Descriptor() protoreflect.MessageDescriptor {
+	return md_WithdrawalWindow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WithdrawalWindow) Type() protoreflect.MessageType {
+	return _fastReflection_WithdrawalWindow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WithdrawalWindow) New() protoreflect.Message {
+	return new(fastReflection_WithdrawalWindow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WithdrawalWindow) Interface() protoreflect.ProtoMessage {
+	return (*WithdrawalWindow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WithdrawalWindow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartSequence)
+		if !f(fd_WithdrawalWindow_start_sequence, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_WithdrawalWindow_end_time, value) {
+			return
+		}
+	}
+	if x.NumLeaves != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumLeaves)
+		if !f(fd_WithdrawalWindow_num_leaves, value) {
+			return
+		}
+	}
+	if len(x.Frontier) != 0 {
+		value := protoreflect.ValueOfList(&_WithdrawalWindow_4_list{list: &x.Frontier})
+		if !f(fd_WithdrawalWindow_frontier, value) {
+			return
+		}
+	}
+	if x.NextLeafSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextLeafSequence)
+		if !f(fd_WithdrawalWindow_next_leaf_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WithdrawalWindow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalWindow.start_sequence":
+		return x.StartSequence != uint64(0)
+	case "opinit.opchild.v1.WithdrawalWindow.end_time":
+		return x.EndTime != nil
+	case "opinit.opchild.v1.WithdrawalWindow.num_leaves":
+		return x.NumLeaves != uint64(0)
+	case "opinit.opchild.v1.WithdrawalWindow.frontier":
+		return len(x.Frontier) != 0
+	case "opinit.opchild.v1.WithdrawalWindow.next_leaf_sequence":
+		return x.NextLeafSequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalWindow"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalWindow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalWindow.start_sequence":
+		x.StartSequence = uint64(0)
+	case "opinit.opchild.v1.WithdrawalWindow.end_time":
+		x.EndTime = nil
+	case "opinit.opchild.v1.WithdrawalWindow.num_leaves":
+		x.NumLeaves = uint64(0)
+	case "opinit.opchild.v1.WithdrawalWindow.frontier":
+		x.Frontier = nil
+	case "opinit.opchild.v1.WithdrawalWindow.next_leaf_sequence":
+		x.NextLeafSequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalWindow"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WithdrawalWindow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.WithdrawalWindow.start_sequence":
+		value := x.StartSequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.WithdrawalWindow.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.opchild.v1.WithdrawalWindow.num_leaves":
+		value := x.NumLeaves
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.WithdrawalWindow.frontier":
+		if len(x.Frontier) == 0 {
+			return protoreflect.ValueOfList(&_WithdrawalWindow_4_list{})
+		}
+		listValue := &_WithdrawalWindow_4_list{list: &x.Frontier}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.WithdrawalWindow.next_leaf_sequence":
+		value := x.NextLeafSequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalWindow"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalWindow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalWindow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalWindow.start_sequence":
+		x.StartSequence = value.Uint()
+	case "opinit.opchild.v1.WithdrawalWindow.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "opinit.opchild.v1.WithdrawalWindow.num_leaves":
+		x.NumLeaves = value.Uint()
+	case "opinit.opchild.v1.WithdrawalWindow.frontier":
+		lv := value.List()
+		clv := lv.(*_WithdrawalWindow_4_list)
+		x.Frontier = *clv.list
+	case "opinit.opchild.v1.WithdrawalWindow.next_leaf_sequence":
+		x.NextLeafSequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalWindow"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalWindow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalWindow.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "opinit.opchild.v1.WithdrawalWindow.frontier":
+		if x.Frontier == nil {
+			x.Frontier = [][]byte{}
+		}
+		value := &_WithdrawalWindow_4_list{list: &x.Frontier}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.WithdrawalWindow.start_sequence":
+		panic(fmt.Errorf("field start_sequence of message opinit.opchild.v1.WithdrawalWindow is not mutable"))
+	case "opinit.opchild.v1.WithdrawalWindow.num_leaves":
+		panic(fmt.Errorf("field num_leaves of message opinit.opchild.v1.WithdrawalWindow is not mutable"))
+	case "opinit.opchild.v1.WithdrawalWindow.next_leaf_sequence":
+		panic(fmt.Errorf("field next_leaf_sequence of message opinit.opchild.v1.WithdrawalWindow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalWindow"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WithdrawalWindow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.WithdrawalWindow.start_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.WithdrawalWindow.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.opchild.v1.WithdrawalWindow.num_leaves":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.WithdrawalWindow.frontier":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_WithdrawalWindow_4_list{list: &list})
+	case "opinit.opchild.v1.WithdrawalWindow.next_leaf_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.WithdrawalWindow"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.WithdrawalWindow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WithdrawalWindow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.WithdrawalWindow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WithdrawalWindow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WithdrawalWindow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WithdrawalWindow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WithdrawalWindow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WithdrawalWindow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartSequence))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumLeaves != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumLeaves))
+		}
+		if len(x.Frontier) > 0 {
+			for _, b := range x.Frontier {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextLeafSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextLeafSequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawalWindow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextLeafSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextLeafSequence))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Frontier) > 0 {
+			for iNdEx := len(x.Frontier) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Frontier[iNdEx])
+				copy(dAtA[i:], x.Frontier[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Frontier[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.NumLeaves != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumLeaves))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartSequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WithdrawalWindow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawalWindow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WithdrawalWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
+				}
+				x.StartSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumLeaves", wireType)
+				}
+				x.NumLeaves = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumLeaves |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frontier", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Frontier = append(x.Frontier, make([]byte, postIndex-iNdEx))
+				copy(x.Frontier[len(x.Frontier)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextLeafSequence", wireType)
+				}
+				x.NextLeafSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextLeafSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SequenceRange       protoreflect.MessageDescriptor
+	fd_SequenceRange_start protoreflect.FieldDescriptor
+	fd_SequenceRange_end   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_SequenceRange = File_opinit_opchild_v1_types_proto.Messages().ByName("SequenceRange")
+	fd_SequenceRange_start = md_SequenceRange.Fields().ByName("start")
+	fd_SequenceRange_end = md_SequenceRange.Fields().ByName("end")
+}
+
+var _ protoreflect.Message = (*fastReflection_SequenceRange)(nil)
+
+type fastReflection_SequenceRange SequenceRange
+
+func (x *SequenceRange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SequenceRange)(x)
+}
+
+func (x *SequenceRange) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SequenceRange_messageType fastReflection_SequenceRange_messageType
+var _ protoreflect.MessageType = fastReflection_SequenceRange_messageType{}
+
+type fastReflection_SequenceRange_messageType struct{}
+
+func (x fastReflection_SequenceRange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SequenceRange)(nil)
+}
+func (x fastReflection_SequenceRange_messageType) New() protoreflect.Message {
+	return new(fastReflection_SequenceRange)
+}
+func (x fastReflection_SequenceRange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SequenceRange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SequenceRange) Descriptor() protoreflect.MessageDescriptor {
+	return md_SequenceRange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SequenceRange) Type() protoreflect.MessageType {
+	return _fastReflection_SequenceRange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SequenceRange) New() protoreflect.Message {
+	return new(fastReflection_SequenceRange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SequenceRange) Interface() protoreflect.ProtoMessage {
+	return (*SequenceRange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SequenceRange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Start != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Start)
+		if !f(fd_SequenceRange_start, value) {
+			return
+		}
+	}
+	if x.End != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.End)
+		if !f(fd_SequenceRange_end, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SequenceRange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.SequenceRange.start":
+		return x.Start != uint64(0)
+	case "opinit.opchild.v1.SequenceRange.end":
+		return x.End != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.SequenceRange"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.SequenceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SequenceRange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.SequenceRange.start":
+		x.Start = uint64(0)
+	case "opinit.opchild.v1.SequenceRange.end":
+		x.End = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.SequenceRange"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.SequenceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SequenceRange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.SequenceRange.start":
+		value := x.Start
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.SequenceRange.end":
+		value := x.End
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.SequenceRange"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.SequenceRange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SequenceRange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.SequenceRange.start":
+		x.Start = value.Uint()
+	case "opinit.opchild.v1.SequenceRange.end":
+		x.End = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.SequenceRange"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.SequenceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SequenceRange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.SequenceRange.start":
+		panic(fmt.Errorf("field start of message opinit.opchild.v1.SequenceRange is not mutable"))
+	case "opinit.opchild.v1.SequenceRange.end":
+		panic(fmt.Errorf("field end of message opinit.opchild.v1.SequenceRange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.SequenceRange"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.SequenceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SequenceRange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.SequenceRange.start":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.SequenceRange.end":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.SequenceRange"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.SequenceRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SequenceRange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.SequenceRange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SequenceRange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SequenceRange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SequenceRange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SequenceRange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SequenceRange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Start != 0 {
+			n += 1 + runtime.Sov(uint64(x.Start))
+		}
+		if x.End != 0 {
+			n += 1 + runtime.Sov(uint64(x.End))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SequenceRange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.End != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.End))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Start != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Start))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SequenceRange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SequenceRange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SequenceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				}
+				x.Start = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Start |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				}
+				x.End = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.End |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ExecutorChangePlan_3_list)(nil)
+
+type _ExecutorChangePlan_3_list struct {
+	list *[]string
+}
+
+func (x *_ExecutorChangePlan_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecutorChangePlan_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ExecutorChangePlan_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecutorChangePlan_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecutorChangePlan_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ExecutorChangePlan at list field NextExecutors as it is not of Message kind"))
+}
+
+func (x *_ExecutorChangePlan_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecutorChangePlan_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ExecutorChangePlan_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ExecutorChangePlan                protoreflect.MessageDescriptor
+	fd_ExecutorChangePlan_proposal_id    protoreflect.FieldDescriptor
+	fd_ExecutorChangePlan_height         protoreflect.FieldDescriptor
+	fd_ExecutorChangePlan_next_executors protoreflect.FieldDescriptor
+	fd_ExecutorChangePlan_next_validator protoreflect.FieldDescriptor
+	fd_ExecutorChangePlan_info           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_ExecutorChangePlan = File_opinit_opchild_v1_types_proto.Messages().ByName("ExecutorChangePlan")
+	fd_ExecutorChangePlan_proposal_id = md_ExecutorChangePlan.Fields().ByName("proposal_id")
+	fd_ExecutorChangePlan_height = md_ExecutorChangePlan.Fields().ByName("height")
+	fd_ExecutorChangePlan_next_executors = md_ExecutorChangePlan.Fields().ByName("next_executors")
+	fd_ExecutorChangePlan_next_validator = md_ExecutorChangePlan.Fields().ByName("next_validator")
+	fd_ExecutorChangePlan_info = md_ExecutorChangePlan.Fields().ByName("info")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExecutorChangePlan)(nil)
+
+type fastReflection_ExecutorChangePlan ExecutorChangePlan
+
+func (x *ExecutorChangePlan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutorChangePlan)(x)
+}
+
+func (x *ExecutorChangePlan) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExecutorChangePlan_messageType fastReflection_ExecutorChangePlan_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutorChangePlan_messageType{}
+
+type fastReflection_ExecutorChangePlan_messageType struct{}
+
+func (x fastReflection_ExecutorChangePlan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutorChangePlan)(nil)
+}
+func (x fastReflection_ExecutorChangePlan_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutorChangePlan)
+}
+func (x fastReflection_ExecutorChangePlan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutorChangePlan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExecutorChangePlan) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutorChangePlan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExecutorChangePlan) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutorChangePlan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExecutorChangePlan) New() protoreflect.Message {
+	return new(fastReflection_ExecutorChangePlan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExecutorChangePlan) Interface() protoreflect.ProtoMessage {
+	return (*ExecutorChangePlan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExecutorChangePlan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_ExecutorChangePlan_proposal_id, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_ExecutorChangePlan_height, value) {
+			return
+		}
+	}
+	if len(x.NextExecutors) != 0 {
+		value := protoreflect.ValueOfList(&_ExecutorChangePlan_3_list{list: &x.NextExecutors})
+		if !f(fd_ExecutorChangePlan_next_executors, value) {
+			return
+		}
+	}
+	if x.NextValidator != nil {
+		value := protoreflect.ValueOfMessage(x.NextValidator.ProtoReflect())
+		if !f(fd_ExecutorChangePlan_next_validator, value) {
+			return
+		}
+	}
+	if x.Info != "" {
+		value := protoreflect.ValueOfString(x.Info)
+		if !f(fd_ExecutorChangePlan_info, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExecutorChangePlan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ExecutorChangePlan.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "opinit.opchild.v1.ExecutorChangePlan.height":
+		return x.Height != uint64(0)
+	case "opinit.opchild.v1.ExecutorChangePlan.next_executors":
+		return len(x.NextExecutors) != 0
+	case "opinit.opchild.v1.ExecutorChangePlan.next_validator":
+		return x.NextValidator != nil
+	case "opinit.opchild.v1.ExecutorChangePlan.info":
+		return x.Info != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ExecutorChangePlan"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ExecutorChangePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutorChangePlan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ExecutorChangePlan.proposal_id":
+		x.ProposalId = uint64(0)
+	case "opinit.opchild.v1.ExecutorChangePlan.height":
+		x.Height = uint64(0)
+	case "opinit.opchild.v1.ExecutorChangePlan.next_executors":
+		x.NextExecutors = nil
+	case "opinit.opchild.v1.ExecutorChangePlan.next_validator":
+		x.NextValidator = nil
+	case "opinit.opchild.v1.ExecutorChangePlan.info":
+		x.Info = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ExecutorChangePlan"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ExecutorChangePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExecutorChangePlan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.ExecutorChangePlan.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.ExecutorChangePlan.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.ExecutorChangePlan.next_executors":
+		if len(x.NextExecutors) == 0 {
+			return protoreflect.ValueOfList(&_ExecutorChangePlan_3_list{})
+		}
+		listValue := &_ExecutorChangePlan_3_list{list: &x.NextExecutors}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.ExecutorChangePlan.next_validator":
+		value := x.NextValidator
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.opchild.v1.ExecutorChangePlan.info":
+		value := x.Info
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ExecutorChangePlan"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ExecutorChangePlan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutorChangePlan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ExecutorChangePlan.proposal_id":
+		x.ProposalId = value.Uint()
+	case "opinit.opchild.v1.ExecutorChangePlan.height":
+		x.Height = value.Uint()
+	case "opinit.opchild.v1.ExecutorChangePlan.next_executors":
+		lv := value.List()
+		clv := lv.(*_ExecutorChangePlan_3_list)
+		x.NextExecutors = *clv.list
+	case "opinit.opchild.v1.ExecutorChangePlan.next_validator":
+		x.NextValidator = value.Message().Interface().(*Validator)
+	case "opinit.opchild.v1.ExecutorChangePlan.info":
+		x.Info = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ExecutorChangePlan"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ExecutorChangePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutorChangePlan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ExecutorChangePlan.next_executors":
+		if x.NextExecutors == nil {
+			x.NextExecutors = []string{}
+		}
+		value := &_ExecutorChangePlan_3_list{list: &x.NextExecutors}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.ExecutorChangePlan.next_validator":
+		if x.NextValidator == nil {
+			x.NextValidator = new(Validator)
+		}
+		return protoreflect.ValueOfMessage(x.NextValidator.ProtoReflect())
+	case "opinit.opchild.v1.ExecutorChangePlan.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message opinit.opchild.v1.ExecutorChangePlan is not mutable"))
+	case "opinit.opchild.v1.ExecutorChangePlan.height":
+		panic(fmt.Errorf("field height of message opinit.opchild.v1.ExecutorChangePlan is not mutable"))
+	case "opinit.opchild.v1.ExecutorChangePlan.info":
+		panic(fmt.Errorf("field info of message opinit.opchild.v1.ExecutorChangePlan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ExecutorChangePlan"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ExecutorChangePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExecutorChangePlan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.ExecutorChangePlan.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.ExecutorChangePlan.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.ExecutorChangePlan.next_executors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ExecutorChangePlan_3_list{list: &list})
+	case "opinit.opchild.v1.ExecutorChangePlan.next_validator":
+		m := new(Validator)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.opchild.v1.ExecutorChangePlan.info":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ExecutorChangePlan"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.ExecutorChangePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExecutorChangePlan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.ExecutorChangePlan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExecutorChangePlan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutorChangePlan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExecutorChangePlan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExecutorChangePlan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExecutorChangePlan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if len(x.NextExecutors) > 0 {
+			for _, s := range x.NextExecutors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextValidator != nil {
+			l = options.Size(x.NextValidator)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Info)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutorChangePlan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Info) > 0 {
+			i -= len(x.Info)
+			copy(dAtA[i:], x.Info)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Info)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.NextValidator != nil {
+			encoded, err := options.Marshal(x.NextValidator)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NextExecutors) > 0 {
+			for iNdEx := len(x.NextExecutors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NextExecutors[iNdEx])
+				copy(dAtA[i:], x.NextExecutors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextExecutors[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutorChangePlan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutorChangePlan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutorChangePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextExecutors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextExecutors = append(x.NextExecutors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextValidator", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextValidator == nil {
+					x.NextValidator = &Validator{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextValidator); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Info = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DepositAttestation_2_list)(nil)
+
+type _DepositAttestation_2_list struct {
+	list *[]*DepositAttestationVote
+}
+
+func (x *_DepositAttestation_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositAttestation_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DepositAttestation_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositAttestationVote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositAttestation_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositAttestationVote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositAttestation_2_list) AppendMutable() protoreflect.Value {
+	v := new(DepositAttestationVote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositAttestation_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositAttestation_2_list) NewElement() protoreflect.Value {
+	v := new(DepositAttestationVote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DepositAttestation_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DepositAttestation          protoreflect.MessageDescriptor
+	fd_DepositAttestation_sequence protoreflect.FieldDescriptor
+	fd_DepositAttestation_votes    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_DepositAttestation = File_opinit_opchild_v1_types_proto.Messages().ByName("DepositAttestation")
+	fd_DepositAttestation_sequence = md_DepositAttestation.Fields().ByName("sequence")
+	fd_DepositAttestation_votes = md_DepositAttestation.Fields().ByName("votes")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositAttestation)(nil)
+
+type fastReflection_DepositAttestation DepositAttestation
+
+func (x *DepositAttestation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositAttestation)(x)
+}
+
+func (x *DepositAttestation) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositAttestation_messageType fastReflection_DepositAttestation_messageType
+var _ protoreflect.MessageType = fastReflection_DepositAttestation_messageType{}
+
+type fastReflection_DepositAttestation_messageType struct{}
+
+func (x fastReflection_DepositAttestation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositAttestation)(nil)
+}
+func (x fastReflection_DepositAttestation_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositAttestation)
+}
+func (x fastReflection_DepositAttestation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositAttestation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositAttestation) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositAttestation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositAttestation) Type() protoreflect.MessageType {
+	return _fastReflection_DepositAttestation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositAttestation) New() protoreflect.Message {
+	return new(fastReflection_DepositAttestation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositAttestation) Interface() protoreflect.ProtoMessage {
+	return (*DepositAttestation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositAttestation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_DepositAttestation_sequence, value) {
+			return
+		}
+	}
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_DepositAttestation_2_list{list: &x.Votes})
+		if !f(fd_DepositAttestation_votes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositAttestation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestation.sequence":
+		return x.Sequence != uint64(0)
+	case "opinit.opchild.v1.DepositAttestation.votes":
+		return len(x.Votes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestation"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestation.sequence":
+		x.Sequence = uint64(0)
+	case "opinit.opchild.v1.DepositAttestation.votes":
+		x.Votes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestation"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositAttestation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.DepositAttestation.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.DepositAttestation.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_DepositAttestation_2_list{})
+		}
+		listValue := &_DepositAttestation_2_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestation"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestation.sequence":
+		x.Sequence = value.Uint()
+	case "opinit.opchild.v1.DepositAttestation.votes":
+		lv := value.List()
+		clv := lv.(*_DepositAttestation_2_list)
+		x.Votes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestation"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestation.votes":
+		if x.Votes == nil {
+			x.Votes = []*DepositAttestationVote{}
+		}
+		value := &_DepositAttestation_2_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.DepositAttestation.sequence":
+		panic(fmt.Errorf("field sequence of message opinit.opchild.v1.DepositAttestation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestation"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositAttestation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestation.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.DepositAttestation.votes":
+		list := []*DepositAttestationVote{}
+		return protoreflect.ValueOfList(&_DepositAttestation_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestation"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositAttestation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.DepositAttestation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositAttestation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositAttestation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositAttestation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositAttestation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositAttestation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositAttestation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositAttestation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &DepositAttestationVote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DepositAttestationVote_2_list)(nil)
+
+type _DepositAttestationVote_2_list struct {
+	list *[]string
+}
+
+func (x *_DepositAttestationVote_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DepositAttestationVote_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DepositAttestationVote_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DepositAttestationVote_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DepositAttestationVote_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DepositAttestationVote at list field Attesters as it is not of Message kind"))
+}
+
+func (x *_DepositAttestationVote_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DepositAttestationVote_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DepositAttestationVote_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DepositAttestationVote              protoreflect.MessageDescriptor
+	fd_DepositAttestationVote_deposit_hash protoreflect.FieldDescriptor
+	fd_DepositAttestationVote_attesters    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_opchild_v1_types_proto_init()
+	md_DepositAttestationVote = File_opinit_opchild_v1_types_proto.Messages().ByName("DepositAttestationVote")
+	fd_DepositAttestationVote_deposit_hash = md_DepositAttestationVote.Fields().ByName("deposit_hash")
+	fd_DepositAttestationVote_attesters = md_DepositAttestationVote.Fields().ByName("attesters")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositAttestationVote)(nil)
+
+type fastReflection_DepositAttestationVote DepositAttestationVote
+
+func (x *DepositAttestationVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositAttestationVote)(x)
+}
+
+func (x *DepositAttestationVote) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositAttestationVote_messageType fastReflection_DepositAttestationVote_messageType
+var _ protoreflect.MessageType = fastReflection_DepositAttestationVote_messageType{}
+
+type fastReflection_DepositAttestationVote_messageType struct{}
+
+func (x fastReflection_DepositAttestationVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositAttestationVote)(nil)
+}
+func (x fastReflection_DepositAttestationVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositAttestationVote)
+}
+func (x fastReflection_DepositAttestationVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositAttestationVote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositAttestationVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositAttestationVote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositAttestationVote) Type() protoreflect.MessageType {
+	return _fastReflection_DepositAttestationVote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositAttestationVote) New() protoreflect.Message {
+	return new(fastReflection_DepositAttestationVote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositAttestationVote) Interface() protoreflect.ProtoMessage {
+	return (*DepositAttestationVote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositAttestationVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DepositHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.DepositHash)
+		if !f(fd_DepositAttestationVote_deposit_hash, value) {
+			return
+		}
+	}
+	if len(x.Attesters) != 0 {
+		value := protoreflect.ValueOfList(&_DepositAttestationVote_2_list{list: &x.Attesters})
+		if !f(fd_DepositAttestationVote_attesters, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositAttestationVote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestationVote.deposit_hash":
+		return len(x.DepositHash) != 0
+	case "opinit.opchild.v1.DepositAttestationVote.attesters":
+		return len(x.Attesters) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestationVote"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestationVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestationVote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestationVote.deposit_hash":
+		x.DepositHash = nil
+	case "opinit.opchild.v1.DepositAttestationVote.attesters":
+		x.Attesters = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestationVote"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestationVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositAttestationVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.opchild.v1.DepositAttestationVote.deposit_hash":
+		value := x.DepositHash
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.opchild.v1.DepositAttestationVote.attesters":
+		if len(x.Attesters) == 0 {
+			return protoreflect.ValueOfList(&_DepositAttestationVote_2_list{})
+		}
+		listValue := &_DepositAttestationVote_2_list{list: &x.Attesters}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestationVote"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestationVote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestationVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestationVote.deposit_hash":
+		x.DepositHash = value.Bytes()
+	case "opinit.opchild.v1.DepositAttestationVote.attesters":
+		lv := value.List()
+		clv := lv.(*_DepositAttestationVote_2_list)
+		x.Attesters = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestationVote"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestationVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestationVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestationVote.attesters":
+		if x.Attesters == nil {
+			x.Attesters = []string{}
+		}
+		value := &_DepositAttestationVote_2_list{list: &x.Attesters}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.DepositAttestationVote.deposit_hash":
+		panic(fmt.Errorf("field deposit_hash of message opinit.opchild.v1.DepositAttestationVote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestationVote"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestationVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositAttestationVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.opchild.v1.DepositAttestationVote.deposit_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.opchild.v1.DepositAttestationVote.attesters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DepositAttestationVote_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.DepositAttestationVote"))
+		}
+		panic(fmt.Errorf("message opinit.opchild.v1.DepositAttestationVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositAttestationVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.DepositAttestationVote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositAttestationVote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositAttestationVote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositAttestationVote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositAttestationVote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositAttestationVote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DepositHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Attesters) > 0 {
+			for _, s := range x.Attesters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositAttestationVote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Attesters) > 0 {
+			for iNdEx := len(x.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Attesters[iNdEx])
+				copy(dAtA[i:], x.Attesters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attesters[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.DepositHash) > 0 {
+			i -= len(x.DepositHash)
+			copy(dAtA[i:], x.DepositHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositAttestationVote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositAttestationVote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositAttestationVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositHash = append(x.DepositHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.DepositHash == nil {
+					x.DepositHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attesters = append(x.Attesters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -921,33 +9394,84 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ScheduledMessages_4_list)(nil)
+
+type _ScheduledMessages_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_ScheduledMessages_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScheduledMessages_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScheduledMessages_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScheduledMessages_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScheduledMessages_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledMessages_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScheduledMessages_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledMessages_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Validator                  protoreflect.MessageDescriptor
-	fd_Validator_moniker          protoreflect.FieldDescriptor
-	fd_Validator_operator_address protoreflect.FieldDescriptor
-	fd_Validator_consensus_pubkey protoreflect.FieldDescriptor
-	fd_Validator_cons_power       protoreflect.FieldDescriptor
+	md_ScheduledMessages                  protoreflect.MessageDescriptor
+	fd_ScheduledMessages_id               protoreflect.FieldDescriptor
+	fd_ScheduledMessages_sender           protoreflect.FieldDescriptor
+	fd_ScheduledMessages_execution_height protoreflect.FieldDescriptor
+	fd_ScheduledMessages_messages         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_opchild_v1_types_proto_init()
-	md_Validator = File_opinit_opchild_v1_types_proto.Messages().ByName("Validator")
-	fd_Validator_moniker = md_Validator.Fields().ByName("moniker")
-	fd_Validator_operator_address = md_Validator.Fields().ByName("operator_address")
-	fd_Validator_consensus_pubkey = md_Validator.Fields().ByName("consensus_pubkey")
-	fd_Validator_cons_power = md_Validator.Fields().ByName("cons_power")
+	md_ScheduledMessages = File_opinit_opchild_v1_types_proto.Messages().ByName("ScheduledMessages")
+	fd_ScheduledMessages_id = md_ScheduledMessages.Fields().ByName("id")
+	fd_ScheduledMessages_sender = md_ScheduledMessages.Fields().ByName("sender")
+	fd_ScheduledMessages_execution_height = md_ScheduledMessages.Fields().ByName("execution_height")
+	fd_ScheduledMessages_messages = md_ScheduledMessages.Fields().ByName("messages")
 }
 
-var _ protoreflect.Message = (*fastReflection_Validator)(nil)
+var _ protoreflect.Message = (*fastReflection_ScheduledMessages)(nil)
 
-type fastReflection_Validator Validator
+type fastReflection_ScheduledMessages ScheduledMessages
 
-func (x *Validator) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Validator)(x)
+func (x *ScheduledMessages) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledMessages)(x)
 }
 
-func (x *Validator) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_opchild_v1_types_proto_msgTypes[1]
+func (x *ScheduledMessages) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,43 +9482,43 @@ func (x *Validator) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Validator_messageType fastReflection_Validator_messageType
-var _ protoreflect.MessageType = fastReflection_Validator_messageType{}
+var _fastReflection_ScheduledMessages_messageType fastReflection_ScheduledMessages_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledMessages_messageType{}
 
-type fastReflection_Validator_messageType struct{}
+type fastReflection_ScheduledMessages_messageType struct{}
 
-func (x fastReflection_Validator_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Validator)(nil)
+func (x fastReflection_ScheduledMessages_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledMessages)(nil)
 }
-func (x fastReflection_Validator_messageType) New() protoreflect.Message {
-	return new(fastReflection_Validator)
+func (x fastReflection_ScheduledMessages_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMessages)
 }
-func (x fastReflection_Validator_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Validator
+func (x fastReflection_ScheduledMessages_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMessages
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Validator) Descriptor() protoreflect.MessageDescriptor {
-	return md_Validator
+func (x *fastReflection_ScheduledMessages) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMessages
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Validator) Type() protoreflect.MessageType {
-	return _fastReflection_Validator_messageType
+func (x *fastReflection_ScheduledMessages) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledMessages_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Validator) New() protoreflect.Message {
-	return new(fastReflection_Validator)
+func (x *fastReflection_ScheduledMessages) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMessages)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Validator) Interface() protoreflect.ProtoMessage {
-	return (*Validator)(x)
+func (x *fastReflection_ScheduledMessages) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledMessages)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1002,28 +9526,28 @@ func (x *fastReflection_Validator) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Validator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Moniker != "" {
-		value := protoreflect.ValueOfString(x.Moniker)
-		if !f(fd_Validator_moniker, value) {
+func (x *fastReflection_ScheduledMessages) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledMessages_id, value) {
 			return
 		}
 	}
-	if x.OperatorAddress != "" {
-		value := protoreflect.ValueOfString(x.OperatorAddress)
-		if !f(fd_Validator_operator_address, value) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_ScheduledMessages_sender, value) {
 			return
 		}
 	}
-	if x.ConsensusPubkey != nil {
-		value := protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
-		if !f(fd_Validator_consensus_pubkey, value) {
+	if x.ExecutionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutionHeight)
+		if !f(fd_ScheduledMessages_execution_height, value) {
 			return
 		}
 	}
-	if x.ConsPower != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ConsPower)
-		if !f(fd_Validator_cons_power, value) {
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledMessages_4_list{list: &x.Messages})
+		if !f(fd_ScheduledMessages_messages, value) {
 			return
 		}
 	}
@@ -1040,21 +9564,21 @@ func (x *fastReflection_Validator) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ScheduledMessages) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.Validator.moniker":
-		return x.Moniker != ""
-	case "opinit.opchild.v1.Validator.operator_address":
-		return x.OperatorAddress != ""
-	case "opinit.opchild.v1.Validator.consensus_pubkey":
-		return x.ConsensusPubkey != nil
-	case "opinit.opchild.v1.Validator.cons_power":
-		return x.ConsPower != int64(0)
+	case "opinit.opchild.v1.ScheduledMessages.id":
+		return x.Id != uint64(0)
+	case "opinit.opchild.v1.ScheduledMessages.sender":
+		return x.Sender != ""
+	case "opinit.opchild.v1.ScheduledMessages.execution_height":
+		return x.ExecutionHeight != uint64(0)
+	case "opinit.opchild.v1.ScheduledMessages.messages":
+		return len(x.Messages) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ScheduledMessages"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.ScheduledMessages does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1064,21 +9588,21 @@ func (x *fastReflection_Validator) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ScheduledMessages) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.Validator.moniker":
-		x.Moniker = ""
-	case "opinit.opchild.v1.Validator.operator_address":
-		x.OperatorAddress = ""
-	case "opinit.opchild.v1.Validator.consensus_pubkey":
-		x.ConsensusPubkey = nil
-	case "opinit.opchild.v1.Validator.cons_power":
-		x.ConsPower = int64(0)
+	case "opinit.opchild.v1.ScheduledMessages.id":
+		x.Id = uint64(0)
+	case "opinit.opchild.v1.ScheduledMessages.sender":
+		x.Sender = ""
+	case "opinit.opchild.v1.ScheduledMessages.execution_height":
+		x.ExecutionHeight = uint64(0)
+	case "opinit.opchild.v1.ScheduledMessages.messages":
+		x.Messages = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ScheduledMessages"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.ScheduledMessages does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1088,25 +9612,28 @@ func (x *fastReflection_Validator) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledMessages) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.opchild.v1.Validator.moniker":
-		value := x.Moniker
-		return protoreflect.ValueOfString(value)
-	case "opinit.opchild.v1.Validator.operator_address":
-		value := x.OperatorAddress
+	case "opinit.opchild.v1.ScheduledMessages.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.ScheduledMessages.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "opinit.opchild.v1.Validator.consensus_pubkey":
-		value := x.ConsensusPubkey
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "opinit.opchild.v1.Validator.cons_power":
-		value := x.ConsPower
-		return protoreflect.ValueOfInt64(value)
+	case "opinit.opchild.v1.ScheduledMessages.execution_height":
+		value := x.ExecutionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.ScheduledMessages.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledMessages_4_list{})
+		}
+		listValue := &_ScheduledMessages_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ScheduledMessages"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.ScheduledMessages does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1120,21 +9647,23 @@ func (x *fastReflection_Validator) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ScheduledMessages) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.Validator.moniker":
-		x.Moniker = value.Interface().(string)
-	case "opinit.opchild.v1.Validator.operator_address":
-		x.OperatorAddress = value.Interface().(string)
-	case "opinit.opchild.v1.Validator.consensus_pubkey":
-		x.ConsensusPubkey = value.Message().Interface().(*anypb.Any)
-	case "opinit.opchild.v1.Validator.cons_power":
-		x.ConsPower = value.Int()
+	case "opinit.opchild.v1.ScheduledMessages.id":
+		x.Id = value.Uint()
+	case "opinit.opchild.v1.ScheduledMessages.sender":
+		x.Sender = value.Interface().(string)
+	case "opinit.opchild.v1.ScheduledMessages.execution_height":
+		x.ExecutionHeight = value.Uint()
+	case "opinit.opchild.v1.ScheduledMessages.messages":
+		lv := value.List()
+		clv := lv.(*_ScheduledMessages_4_list)
+		x.Messages = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ScheduledMessages"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.ScheduledMessages does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1148,56 +9677,57 @@ func (x *fastReflection_Validator) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledMessages) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.Validator.consensus_pubkey":
-		if x.ConsensusPubkey == nil {
-			x.ConsensusPubkey = new(anypb.Any)
+	case "opinit.opchild.v1.ScheduledMessages.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
 		}
-		return protoreflect.ValueOfMessage(x.ConsensusPubkey.ProtoReflect())
-	case "opinit.opchild.v1.Validator.moniker":
-		panic(fmt.Errorf("field moniker of message opinit.opchild.v1.Validator is not mutable"))
-	case "opinit.opchild.v1.Validator.operator_address":
-		panic(fmt.Errorf("field operator_address of message opinit.opchild.v1.Validator is not mutable"))
-	case "opinit.opchild.v1.Validator.cons_power":
-		panic(fmt.Errorf("field cons_power of message opinit.opchild.v1.Validator is not mutable"))
+		value := &_ScheduledMessages_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.ScheduledMessages.id":
+		panic(fmt.Errorf("field id of message opinit.opchild.v1.ScheduledMessages is not mutable"))
+	case "opinit.opchild.v1.ScheduledMessages.sender":
+		panic(fmt.Errorf("field sender of message opinit.opchild.v1.ScheduledMessages is not mutable"))
+	case "opinit.opchild.v1.ScheduledMessages.execution_height":
+		panic(fmt.Errorf("field execution_height of message opinit.opchild.v1.ScheduledMessages is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ScheduledMessages"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.ScheduledMessages does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Validator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledMessages) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.Validator.moniker":
-		return protoreflect.ValueOfString("")
-	case "opinit.opchild.v1.Validator.operator_address":
+	case "opinit.opchild.v1.ScheduledMessages.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.ScheduledMessages.sender":
 		return protoreflect.ValueOfString("")
-	case "opinit.opchild.v1.Validator.consensus_pubkey":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "opinit.opchild.v1.Validator.cons_power":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "opinit.opchild.v1.ScheduledMessages.execution_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.ScheduledMessages.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_ScheduledMessages_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.Validator"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ScheduledMessages"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.Validator does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.ScheduledMessages does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Validator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ScheduledMessages) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.Validator", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.ScheduledMessages", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1205,7 +9735,7 @@ func (x *fastReflection_Validator) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Validator) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ScheduledMessages) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1216,7 +9746,7 @@ func (x *fastReflection_Validator) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Validator) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ScheduledMessages) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1228,7 +9758,7 @@ func (x *fastReflection_Validator) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Validator) IsValid() bool {
+func (x *fastReflection_ScheduledMessages) IsValid() bool {
 	return x != nil
 }
 
@@ -1238,9 +9768,9 @@ func (x *fastReflection_Validator) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ScheduledMessages) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Validator)
+		x := input.Message.Interface().(*ScheduledMessages)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1252,20 +9782,21 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Moniker)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.OperatorAddress)
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ConsensusPubkey != nil {
-			l = options.Size(x.ConsensusPubkey)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ExecutionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionHeight))
 		}
-		if x.ConsPower != 0 {
-			n += 1 + runtime.Sov(uint64(x.ConsPower))
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1277,7 +9808,7 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Validator)
+		x := input.Message.Interface().(*ScheduledMessages)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1296,38 +9827,38 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ConsPower != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsPower))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.ConsensusPubkey != nil {
-			encoded, err := options.Marshal(x.ConsensusPubkey)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.ExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionHeight))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.OperatorAddress) > 0 {
-			i -= len(x.OperatorAddress)
-			copy(dAtA[i:], x.OperatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Moniker) > 0 {
-			i -= len(x.Moniker)
-			copy(dAtA[i:], x.Moniker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1340,7 +9871,7 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Validator)
+		x := input.Message.Interface().(*ScheduledMessages)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1372,17 +9903,17 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMessages: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1392,27 +9923,14 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Moniker = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1440,11 +9958,30 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+				}
+				x.ExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1471,32 +10008,11 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ConsensusPubkey == nil {
-					x.ConsensusPubkey = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsensusPubkey); err != nil {
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsPower", wireType)
-				}
-				x.ConsPower = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ConsPower |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1532,78 +10048,134 @@ func (x *fastReflection_Validator) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorUpdates_1_list)(nil)
+var _ protoreflect.List = (*_AdminProposal_4_list)(nil)
 
-type _ValidatorUpdates_1_list struct {
-	list *[]*abci.ValidatorUpdate
+type _AdminProposal_4_list struct {
+	list *[]*anypb.Any
 }
 
-func (x *_ValidatorUpdates_1_list) Len() int {
+func (x *_AdminProposal_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorUpdates_1_list) Get(i int) protoreflect.Value {
+func (x *_AdminProposal_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorUpdates_1_list) Set(i int, value protoreflect.Value) {
+func (x *_AdminProposal_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*abci.ValidatorUpdate)
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorUpdates_1_list) Append(value protoreflect.Value) {
+func (x *_AdminProposal_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*abci.ValidatorUpdate)
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorUpdates_1_list) AppendMutable() protoreflect.Value {
-	v := new(abci.ValidatorUpdate)
+func (x *_AdminProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorUpdates_1_list) Truncate(n int) {
+func (x *_AdminProposal_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorUpdates_1_list) NewElement() protoreflect.Value {
-	v := new(abci.ValidatorUpdate)
+func (x *_AdminProposal_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorUpdates_1_list) IsValid() bool {
+func (x *_AdminProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AdminProposal_5_list)(nil)
+
+type _AdminProposal_5_list struct {
+	list *[]string
+}
+
+func (x *_AdminProposal_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AdminProposal_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AdminProposal_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AdminProposal_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AdminProposal_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AdminProposal at list field Approvals as it is not of Message kind"))
+}
+
+func (x *_AdminProposal_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AdminProposal_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AdminProposal_5_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorUpdates         protoreflect.MessageDescriptor
-	fd_ValidatorUpdates_updates protoreflect.FieldDescriptor
+	md_AdminProposal                   protoreflect.MessageDescriptor
+	fd_AdminProposal_id                protoreflect.FieldDescriptor
+	fd_AdminProposal_proposer          protoreflect.FieldDescriptor
+	fd_AdminProposal_execution_height  protoreflect.FieldDescriptor
+	fd_AdminProposal_messages          protoreflect.FieldDescriptor
+	fd_AdminProposal_approvals         protoreflect.FieldDescriptor
+	fd_AdminProposal_expiration_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_opchild_v1_types_proto_init()
-	md_ValidatorUpdates = File_opinit_opchild_v1_types_proto.Messages().ByName("ValidatorUpdates")
-	fd_ValidatorUpdates_updates = md_ValidatorUpdates.Fields().ByName("updates")
+	md_AdminProposal = File_opinit_opchild_v1_types_proto.Messages().ByName("AdminProposal")
+	fd_AdminProposal_id = md_AdminProposal.Fields().ByName("id")
+	fd_AdminProposal_proposer = md_AdminProposal.Fields().ByName("proposer")
+	fd_AdminProposal_execution_height = md_AdminProposal.Fields().ByName("execution_height")
+	fd_AdminProposal_messages = md_AdminProposal.Fields().ByName("messages")
+	fd_AdminProposal_approvals = md_AdminProposal.Fields().ByName("approvals")
+	fd_AdminProposal_expiration_height = md_AdminProposal.Fields().ByName("expiration_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorUpdates)(nil)
+var _ protoreflect.Message = (*fastReflection_AdminProposal)(nil)
 
-type fastReflection_ValidatorUpdates ValidatorUpdates
+type fastReflection_AdminProposal AdminProposal
 
-func (x *ValidatorUpdates) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorUpdates)(x)
+func (x *AdminProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AdminProposal)(x)
 }
 
-func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_opchild_v1_types_proto_msgTypes[2]
+func (x *AdminProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_opchild_v1_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,43 +10186,43 @@ func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorUpdates_messageType fastReflection_ValidatorUpdates_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorUpdates_messageType{}
+var _fastReflection_AdminProposal_messageType fastReflection_AdminProposal_messageType
+var _ protoreflect.MessageType = fastReflection_AdminProposal_messageType{}
 
-type fastReflection_ValidatorUpdates_messageType struct{}
+type fastReflection_AdminProposal_messageType struct{}
 
-func (x fastReflection_ValidatorUpdates_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorUpdates)(nil)
+func (x fastReflection_AdminProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AdminProposal)(nil)
 }
-func (x fastReflection_ValidatorUpdates_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorUpdates)
+func (x fastReflection_AdminProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_AdminProposal)
 }
-func (x fastReflection_ValidatorUpdates_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorUpdates
+func (x fastReflection_AdminProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AdminProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorUpdates) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorUpdates
+func (x *fastReflection_AdminProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_AdminProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorUpdates) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorUpdates_messageType
+func (x *fastReflection_AdminProposal) Type() protoreflect.MessageType {
+	return _fastReflection_AdminProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorUpdates) New() protoreflect.Message {
-	return new(fastReflection_ValidatorUpdates)
+func (x *fastReflection_AdminProposal) New() protoreflect.Message {
+	return new(fastReflection_AdminProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorUpdates) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorUpdates)(x)
+func (x *fastReflection_AdminProposal) Interface() protoreflect.ProtoMessage {
+	return (*AdminProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1658,10 +10230,40 @@ func (x *fastReflection_ValidatorUpdates) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorUpdates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Updates) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &x.Updates})
-		if !f(fd_ValidatorUpdates_updates, value) {
+func (x *fastReflection_AdminProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_AdminProposal_id, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_AdminProposal_proposer, value) {
+			return
+		}
+	}
+	if x.ExecutionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutionHeight)
+		if !f(fd_AdminProposal_execution_height, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_AdminProposal_4_list{list: &x.Messages})
+		if !f(fd_AdminProposal_messages, value) {
+			return
+		}
+	}
+	if len(x.Approvals) != 0 {
+		value := protoreflect.ValueOfList(&_AdminProposal_5_list{list: &x.Approvals})
+		if !f(fd_AdminProposal_approvals, value) {
+			return
+		}
+	}
+	if x.ExpirationHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpirationHeight)
+		if !f(fd_AdminProposal_expiration_height, value) {
 			return
 		}
 	}
@@ -1678,15 +10280,25 @@ func (x *fastReflection_ValidatorUpdates) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorUpdates) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AdminProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.ValidatorUpdates.updates":
-		return len(x.Updates) != 0
+	case "opinit.opchild.v1.AdminProposal.id":
+		return x.Id != uint64(0)
+	case "opinit.opchild.v1.AdminProposal.proposer":
+		return x.Proposer != ""
+	case "opinit.opchild.v1.AdminProposal.execution_height":
+		return x.ExecutionHeight != uint64(0)
+	case "opinit.opchild.v1.AdminProposal.messages":
+		return len(x.Messages) != 0
+	case "opinit.opchild.v1.AdminProposal.approvals":
+		return len(x.Approvals) != 0
+	case "opinit.opchild.v1.AdminProposal.expiration_height":
+		return x.ExpirationHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.AdminProposal"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.AdminProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1696,15 +10308,25 @@ func (x *fastReflection_ValidatorUpdates) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AdminProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.ValidatorUpdates.updates":
-		x.Updates = nil
+	case "opinit.opchild.v1.AdminProposal.id":
+		x.Id = uint64(0)
+	case "opinit.opchild.v1.AdminProposal.proposer":
+		x.Proposer = ""
+	case "opinit.opchild.v1.AdminProposal.execution_height":
+		x.ExecutionHeight = uint64(0)
+	case "opinit.opchild.v1.AdminProposal.messages":
+		x.Messages = nil
+	case "opinit.opchild.v1.AdminProposal.approvals":
+		x.Approvals = nil
+	case "opinit.opchild.v1.AdminProposal.expiration_height":
+		x.ExpirationHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.AdminProposal"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.AdminProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1714,19 +10336,37 @@ func (x *fastReflection_ValidatorUpdates) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorUpdates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AdminProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.opchild.v1.ValidatorUpdates.updates":
-		if len(x.Updates) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{})
+	case "opinit.opchild.v1.AdminProposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.AdminProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "opinit.opchild.v1.AdminProposal.execution_height":
+		value := x.ExecutionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.opchild.v1.AdminProposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_AdminProposal_4_list{})
 		}
-		listValue := &_ValidatorUpdates_1_list{list: &x.Updates}
+		listValue := &_AdminProposal_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.AdminProposal.approvals":
+		if len(x.Approvals) == 0 {
+			return protoreflect.ValueOfList(&_AdminProposal_5_list{})
+		}
+		listValue := &_AdminProposal_5_list{list: &x.Approvals}
 		return protoreflect.ValueOfList(listValue)
+	case "opinit.opchild.v1.AdminProposal.expiration_height":
+		value := x.ExpirationHeight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.AdminProposal"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.AdminProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1740,17 +10380,29 @@ func (x *fastReflection_ValidatorUpdates) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AdminProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.ValidatorUpdates.updates":
+	case "opinit.opchild.v1.AdminProposal.id":
+		x.Id = value.Uint()
+	case "opinit.opchild.v1.AdminProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "opinit.opchild.v1.AdminProposal.execution_height":
+		x.ExecutionHeight = value.Uint()
+	case "opinit.opchild.v1.AdminProposal.messages":
 		lv := value.List()
-		clv := lv.(*_ValidatorUpdates_1_list)
-		x.Updates = *clv.list
+		clv := lv.(*_AdminProposal_4_list)
+		x.Messages = *clv.list
+	case "opinit.opchild.v1.AdminProposal.approvals":
+		lv := value.List()
+		clv := lv.(*_AdminProposal_5_list)
+		x.Approvals = *clv.list
+	case "opinit.opchild.v1.AdminProposal.expiration_height":
+		x.ExpirationHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.AdminProposal"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.AdminProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1764,45 +10416,70 @@ func (x *fastReflection_ValidatorUpdates) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AdminProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.ValidatorUpdates.updates":
-		if x.Updates == nil {
-			x.Updates = []*abci.ValidatorUpdate{}
+	case "opinit.opchild.v1.AdminProposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
 		}
-		value := &_ValidatorUpdates_1_list{list: &x.Updates}
+		value := &_AdminProposal_4_list{list: &x.Messages}
 		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.AdminProposal.approvals":
+		if x.Approvals == nil {
+			x.Approvals = []string{}
+		}
+		value := &_AdminProposal_5_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(value)
+	case "opinit.opchild.v1.AdminProposal.id":
+		panic(fmt.Errorf("field id of message opinit.opchild.v1.AdminProposal is not mutable"))
+	case "opinit.opchild.v1.AdminProposal.proposer":
+		panic(fmt.Errorf("field proposer of message opinit.opchild.v1.AdminProposal is not mutable"))
+	case "opinit.opchild.v1.AdminProposal.execution_height":
+		panic(fmt.Errorf("field execution_height of message opinit.opchild.v1.AdminProposal is not mutable"))
+	case "opinit.opchild.v1.AdminProposal.expiration_height":
+		panic(fmt.Errorf("field expiration_height of message opinit.opchild.v1.AdminProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.AdminProposal"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.AdminProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorUpdates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AdminProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.opchild.v1.ValidatorUpdates.updates":
-		list := []*abci.ValidatorUpdate{}
-		return protoreflect.ValueOfList(&_ValidatorUpdates_1_list{list: &list})
+	case "opinit.opchild.v1.AdminProposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.AdminProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "opinit.opchild.v1.AdminProposal.execution_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.opchild.v1.AdminProposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_AdminProposal_4_list{list: &list})
+	case "opinit.opchild.v1.AdminProposal.approvals":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AdminProposal_5_list{list: &list})
+	case "opinit.opchild.v1.AdminProposal.expiration_height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.ValidatorUpdates"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.AdminProposal"))
 		}
-		panic(fmt.Errorf("message opinit.opchild.v1.ValidatorUpdates does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.opchild.v1.AdminProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorUpdates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AdminProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.ValidatorUpdates", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.AdminProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1810,7 +10487,7 @@ func (x *fastReflection_ValidatorUpdates) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorUpdates) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AdminProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1821,7 +10498,7 @@ func (x *fastReflection_ValidatorUpdates) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorUpdates) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AdminProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1833,7 +10510,7 @@ func (x *fastReflection_ValidatorUpdates) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorUpdates) IsValid() bool {
+func (x *fastReflection_AdminProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -1843,9 +10520,9 @@ func (x *fastReflection_ValidatorUpdates) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AdminProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorUpdates)
+		x := input.Message.Interface().(*AdminProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1857,12 +10534,31 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Updates) > 0 {
-			for _, e := range x.Updates {
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionHeight))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Approvals) > 0 {
+			for _, s := range x.Approvals {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpirationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1873,7 +10569,7 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorUpdates)
+		x := input.Message.Interface().(*AdminProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1892,9 +10588,23 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Updates) > 0 {
-			for iNdEx := len(x.Updates) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Updates[iNdEx])
+		if x.ExpirationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Approvals) > 0 {
+			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Approvals[iNdEx])
+				copy(dAtA[i:], x.Approvals[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approvals[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1905,9 +10615,26 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x22
 			}
 		}
+		if x.ExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1919,7 +10646,7 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorUpdates)
+		x := input.Message.Interface().(*AdminProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1951,15 +10678,85 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AdminProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+				}
+				x.ExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1986,11 +10783,62 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Updates = append(x.Updates, &abci.ValidatorUpdate{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Updates[len(x.Updates)-1]); err != nil {
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Approvals = append(x.Approvals, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+				}
+				x.ExpirationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2026,959 +10874,1190 @@ func (x *fastReflection_ValidatorUpdates) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_BridgeInfo               protoreflect.MessageDescriptor
-	fd_BridgeInfo_bridge_id     protoreflect.FieldDescriptor
-	fd_BridgeInfo_bridge_addr   protoreflect.FieldDescriptor
-	fd_BridgeInfo_l1_chain_id   protoreflect.FieldDescriptor
-	fd_BridgeInfo_l1_client_id  protoreflect.FieldDescriptor
-	fd_BridgeInfo_bridge_config protoreflect.FieldDescriptor
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: opinit/opchild/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-func init() {
-	file_opinit_opchild_v1_types_proto_init()
-	md_BridgeInfo = File_opinit_opchild_v1_types_proto.Messages().ByName("BridgeInfo")
-	fd_BridgeInfo_bridge_id = md_BridgeInfo.Fields().ByName("bridge_id")
-	fd_BridgeInfo_bridge_addr = md_BridgeInfo.Fields().ByName("bridge_addr")
-	fd_BridgeInfo_l1_chain_id = md_BridgeInfo.Fields().ByName("l1_chain_id")
-	fd_BridgeInfo_l1_client_id = md_BridgeInfo.Fields().ByName("l1_client_id")
-	fd_BridgeInfo_bridge_config = md_BridgeInfo.Fields().ByName("bridge_config")
+// Params defines the set of opchild parameters.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_validators is the maximum number of validators.
+	MaxValidators uint32 `protobuf:"varint,1,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// historical_entries is the number of historical entries to persist.
+	HistoricalEntries uint32             `protobuf:"varint,2,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	MinGasPrices      []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices,omitempty"`
+	// the account address of bridge executor who can execute permissioned bridge
+	// messages.
+	BridgeExecutors []string `protobuf:"bytes,4,rep,name=bridge_executors,json=bridgeExecutors,proto3" json:"bridge_executors,omitempty"`
+	// the account address of admin who can execute permissioned cosmos messages.
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	// the list of addresses that are allowed to pay zero fee.
+	FeeWhitelist []string `protobuf:"bytes,6,rep,name=fee_whitelist,json=feeWhitelist,proto3" json:"fee_whitelist,omitempty"`
+	// strict_deposit_ordering enforces the deposits to be finalized in the l1
+	// sequence order; the deposits arrived out of order are buffered until
+	// the missing deposits are finalized.
+	StrictDepositOrdering bool `protobuf:"varint,7,opt,name=strict_deposit_ordering,json=strictDepositOrdering,proto3" json:"strict_deposit_ordering,omitempty"`
+	// deposit_attestation_threshold is the number of the deposit relayers
+	// required to submit the identical deposit before it is finalized; zero or
+	// one allows a single relayer to finalize the deposits.
+	DepositAttestationThreshold uint32 `protobuf:"varint,8,opt,name=deposit_attestation_threshold,json=depositAttestationThreshold,proto3" json:"deposit_attestation_threshold,omitempty"`
+	// deposit_proof_required rejects the deposits without a merkle proof of
+	// the l1 state, so the bridge executors are no longer trusted to mint.
+	DepositProofRequired bool `protobuf:"varint,9,opt,name=deposit_proof_required,json=depositProofRequired,proto3" json:"deposit_proof_required,omitempty"`
+	// bridge_info_proof_required rejects the bridge info updates without a
+	// merkle proof of the l1 bridge config.
+	BridgeInfoProofRequired bool `protobuf:"varint,10,opt,name=bridge_info_proof_required,json=bridgeInfoProofRequired,proto3" json:"bridge_info_proof_required,omitempty"`
+	// execute_messages_delay is the number of blocks the admin messages wait
+	// in the queue before the execution; zero executes them immediately.
+	ExecuteMessagesDelay uint64 `protobuf:"varint,11,opt,name=execute_messages_delay,json=executeMessagesDelay,proto3" json:"execute_messages_delay,omitempty"`
+	// allowed_execute_messages is the list of the message type urls the admin
+	// can execute with MsgExecuteMessages; empty denies all the messages.
+	AllowedExecuteMessages []string `protobuf:"bytes,12,rep,name=allowed_execute_messages,json=allowedExecuteMessages,proto3" json:"allowed_execute_messages,omitempty"`
+	// admin_signers are the additional addresses sharing the admin permission
+	// with the admin.
+	AdminSigners []string `protobuf:"bytes,13,rep,name=admin_signers,json=adminSigners,proto3" json:"admin_signers,omitempty"`
+	// admin_threshold is the number of the approvals of the admin and the
+	// admin signers required to execute the admin messages; zero or one allows
+	// a single signer to execute them.
+	AdminThreshold uint32 `protobuf:"varint,14,opt,name=admin_threshold,json=adminThreshold,proto3" json:"admin_threshold,omitempty"`
+	// deposit_relayers are the addresses allowed to finalize the deposits;
+	// empty grants the role to the bridge executors.
+	DepositRelayers []string `protobuf:"bytes,15,rep,name=deposit_relayers,json=depositRelayers,proto3" json:"deposit_relayers,omitempty"`
+	// oracle_relayers are the addresses allowed to update the oracle prices;
+	// empty grants the role to the bridge executors.
+	OracleRelayers []string `protobuf:"bytes,16,rep,name=oracle_relayers,json=oracleRelayers,proto3" json:"oracle_relayers,omitempty"`
+	// bridge_info_setters are the addresses allowed to set the bridge info;
+	// empty grants the role to the bridge executors.
+	BridgeInfoSetters []string `protobuf:"bytes,17,rep,name=bridge_info_setters,json=bridgeInfoSetters,proto3" json:"bridge_info_setters,omitempty"`
+	// signed_blocks_window is the number of the recent blocks used to track
+	// the validator liveness; zero disables the liveness tracking.
+	SignedBlocksWindow int64 `protobuf:"varint,18,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_per_window is the minimum ratio of the blocks a validator
+	// must sign within the signed blocks window.
+	MinSignedPerWindow []byte `protobuf:"bytes,19,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
+	// downtime_jail_enabled jails the validators signing less than the
+	// min_signed_per_window; otherwise the downtime only emits the events.
+	DowntimeJailEnabled bool `protobuf:"varint,20,opt,name=downtime_jail_enabled,json=downtimeJailEnabled,proto3" json:"downtime_jail_enabled,omitempty"`
+	// admin_proposal_expiry is the number of blocks an admin proposal waits
+	// for the approvals before it expires.
+	AdminProposalExpiry uint64 `protobuf:"varint,21,opt,name=admin_proposal_expiry,json=adminProposalExpiry,proto3" json:"admin_proposal_expiry,omitempty"`
+	// l2_native_denoms are the denoms originated from l2 which can be withdrawn
+	// to l1 as minted representations; the ibc vouchers and the bridged l1
+	// tokens are not allowed.
+	L2NativeDenoms []string `protobuf:"bytes,22,rep,name=l2_native_denoms,json=l2NativeDenoms,proto3" json:"l2_native_denoms,omitempty"`
+	// l1_client_id is the ibc client of l1 used to verify the deposit and the
+	// bridge info proofs; empty rejects all the proofs.
+	L1ClientId string `protobuf:"bytes,23,opt,name=l1_client_id,json=l1ClientId,proto3" json:"l1_client_id,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMaxValidators() uint32 {
+	if x != nil {
+		return x.MaxValidators
+	}
+	return 0
+}
+
+func (x *Params) GetHistoricalEntries() uint32 {
+	if x != nil {
+		return x.HistoricalEntries
+	}
+	return 0
+}
+
+func (x *Params) GetMinGasPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.MinGasPrices
+	}
+	return nil
+}
+
+func (x *Params) GetBridgeExecutors() []string {
+	if x != nil {
+		return x.BridgeExecutors
+	}
+	return nil
+}
+
+func (x *Params) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *Params) GetFeeWhitelist() []string {
+	if x != nil {
+		return x.FeeWhitelist
+	}
+	return nil
+}
+
+func (x *Params) GetStrictDepositOrdering() bool {
+	if x != nil {
+		return x.StrictDepositOrdering
+	}
+	return false
+}
+
+func (x *Params) GetDepositAttestationThreshold() uint32 {
+	if x != nil {
+		return x.DepositAttestationThreshold
+	}
+	return 0
+}
+
+func (x *Params) GetDepositProofRequired() bool {
+	if x != nil {
+		return x.DepositProofRequired
+	}
+	return false
+}
+
+func (x *Params) GetBridgeInfoProofRequired() bool {
+	if x != nil {
+		return x.BridgeInfoProofRequired
+	}
+	return false
+}
+
+func (x *Params) GetExecuteMessagesDelay() uint64 {
+	if x != nil {
+		return x.ExecuteMessagesDelay
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedExecuteMessages() []string {
+	if x != nil {
+		return x.AllowedExecuteMessages
+	}
+	return nil
+}
+
+func (x *Params) GetAdminSigners() []string {
+	if x != nil {
+		return x.AdminSigners
+	}
+	return nil
+}
+
+func (x *Params) GetAdminThreshold() uint32 {
+	if x != nil {
+		return x.AdminThreshold
+	}
+	return 0
 }
 
-var _ protoreflect.Message = (*fastReflection_BridgeInfo)(nil)
+func (x *Params) GetDepositRelayers() []string {
+	if x != nil {
+		return x.DepositRelayers
+	}
+	return nil
+}
 
-type fastReflection_BridgeInfo BridgeInfo
+func (x *Params) GetOracleRelayers() []string {
+	if x != nil {
+		return x.OracleRelayers
+	}
+	return nil
+}
 
-func (x *BridgeInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BridgeInfo)(x)
+func (x *Params) GetBridgeInfoSetters() []string {
+	if x != nil {
+		return x.BridgeInfoSetters
+	}
+	return nil
 }
 
-func (x *BridgeInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_opchild_v1_types_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Params) GetSignedBlocksWindow() int64 {
+	if x != nil {
+		return x.SignedBlocksWindow
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-var _fastReflection_BridgeInfo_messageType fastReflection_BridgeInfo_messageType
-var _ protoreflect.MessageType = fastReflection_BridgeInfo_messageType{}
+func (x *Params) GetMinSignedPerWindow() []byte {
+	if x != nil {
+		return x.MinSignedPerWindow
+	}
+	return nil
+}
 
-type fastReflection_BridgeInfo_messageType struct{}
+func (x *Params) GetDowntimeJailEnabled() bool {
+	if x != nil {
+		return x.DowntimeJailEnabled
+	}
+	return false
+}
 
-func (x fastReflection_BridgeInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BridgeInfo)(nil)
+func (x *Params) GetAdminProposalExpiry() uint64 {
+	if x != nil {
+		return x.AdminProposalExpiry
+	}
+	return 0
 }
-func (x fastReflection_BridgeInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_BridgeInfo)
+
+func (x *Params) GetL2NativeDenoms() []string {
+	if x != nil {
+		return x.L2NativeDenoms
+	}
+	return nil
 }
-func (x fastReflection_BridgeInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BridgeInfo
+
+func (x *Params) GetL1ClientId() string {
+	if x != nil {
+		return x.L1ClientId
+	}
+	return ""
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_BridgeInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_BridgeInfo
+// Validator defines a validator, together with the total amount of the
+// Validator's bond shares and their exchange rate to coins. Slashing results in
+// a decrease in the exchange rate, allowing correct calculation of future
+// undelegations without iterating over delegators. When coins are delegated to
+// this validator, the validator is credited with a delegation whose number of
+// bond shares is based on the amount of coins delegated divided by the current
+// exchange rate. Voting power can be calculated as total bonded shares
+// multiplied by exchange rate.
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// operator_address defines the address of the validator's operator;
+	// bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator,
+	// as a Protobuf Any.
+	ConsensusPubkey *anypb.Any `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	ConsPower       int64      `protobuf:"varint,4,opt,name=cons_power,json=consPower,proto3" json:"cons_power,omitempty"`
+	// jailed is true when the validator is removed from the consensus for the
+	// downtime; the cons_power is restored when the validator is unjailed.
+	Jailed bool `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BridgeInfo) Type() protoreflect.MessageType {
-	return _fastReflection_BridgeInfo_messageType
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BridgeInfo) New() protoreflect.Message {
-	return new(fastReflection_BridgeInfo)
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_BridgeInfo) Interface() protoreflect.ProtoMessage {
-	return (*BridgeInfo)(x)
+func (*Validator) ProtoMessage() {}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_BridgeInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BridgeId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_BridgeInfo_bridge_id, value) {
-			return
-		}
-	}
-	if x.BridgeAddr != "" {
-		value := protoreflect.ValueOfString(x.BridgeAddr)
-		if !f(fd_BridgeInfo_bridge_addr, value) {
-			return
-		}
-	}
-	if x.L1ChainId != "" {
-		value := protoreflect.ValueOfString(x.L1ChainId)
-		if !f(fd_BridgeInfo_l1_chain_id, value) {
-			return
-		}
+func (x *Validator) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
 	}
-	if x.L1ClientId != "" {
-		value := protoreflect.ValueOfString(x.L1ClientId)
-		if !f(fd_BridgeInfo_l1_client_id, value) {
-			return
-		}
+	return ""
+}
+
+func (x *Validator) GetOperatorAddress() string {
+	if x != nil {
+		return x.OperatorAddress
 	}
-	if x.BridgeConfig != nil {
-		value := protoreflect.ValueOfMessage(x.BridgeConfig.ProtoReflect())
-		if !f(fd_BridgeInfo_bridge_config, value) {
-			return
-		}
+	return ""
+}
+
+func (x *Validator) GetConsensusPubkey() *anypb.Any {
+	if x != nil {
+		return x.ConsensusPubkey
 	}
+	return nil
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_BridgeInfo) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.BridgeInfo.bridge_id":
-		return x.BridgeId != uint64(0)
-	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
-		return x.BridgeAddr != ""
-	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
-		return x.L1ChainId != ""
-	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
-		return x.L1ClientId != ""
-	case "opinit.opchild.v1.BridgeInfo.bridge_config":
-		return x.BridgeConfig != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
-		}
-		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+func (x *Validator) GetConsPower() int64 {
+	if x != nil {
+		return x.ConsPower
 	}
+	return 0
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeInfo) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.BridgeInfo.bridge_id":
-		x.BridgeId = uint64(0)
-	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
-		x.BridgeAddr = ""
-	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
-		x.L1ChainId = ""
-	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
-		x.L1ClientId = ""
-	case "opinit.opchild.v1.BridgeInfo.bridge_config":
-		x.BridgeConfig = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
-		}
-		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+func (x *Validator) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
 	}
+	return false
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BridgeInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "opinit.opchild.v1.BridgeInfo.bridge_id":
-		value := x.BridgeId
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
-		value := x.BridgeAddr
-		return protoreflect.ValueOfString(value)
-	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
-		value := x.L1ChainId
-		return protoreflect.ValueOfString(value)
-	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
-		value := x.L1ClientId
-		return protoreflect.ValueOfString(value)
-	case "opinit.opchild.v1.BridgeInfo.bridge_config":
-		value := x.BridgeConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
-		}
-		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", descriptor.FullName()))
+// ValidatorSigningInfo defines the liveness information of a validator.
+type ValidatorSigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// start_height is the height the liveness tracking of the validator started.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the index of the next block in the signed blocks window.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_blocks_counter is the number of the blocks missed in the signed
+	// blocks window.
+	MissedBlocksCounter int64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+}
+
+func (x *ValidatorSigningInfo) Reset() {
+	*x = ValidatorSigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.BridgeInfo.bridge_id":
-		x.BridgeId = value.Uint()
-	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
-		x.BridgeAddr = value.Interface().(string)
-	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
-		x.L1ChainId = value.Interface().(string)
-	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
-		x.L1ClientId = value.Interface().(string)
-	case "opinit.opchild.v1.BridgeInfo.bridge_config":
-		x.BridgeConfig = value.Message().Interface().(*v1.BridgeConfig)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
-		}
-		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+func (x *ValidatorSigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSigningInfo) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorSigningInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.BridgeInfo.bridge_config":
-		if x.BridgeConfig == nil {
-			x.BridgeConfig = new(v1.BridgeConfig)
-		}
-		return protoreflect.ValueOfMessage(x.BridgeConfig.ProtoReflect())
-	case "opinit.opchild.v1.BridgeInfo.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.opchild.v1.BridgeInfo is not mutable"))
-	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
-		panic(fmt.Errorf("field bridge_addr of message opinit.opchild.v1.BridgeInfo is not mutable"))
-	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
-		panic(fmt.Errorf("field l1_chain_id of message opinit.opchild.v1.BridgeInfo is not mutable"))
-	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
-		panic(fmt.Errorf("field l1_client_id of message opinit.opchild.v1.BridgeInfo is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
-		}
-		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+func (x *ValidatorSigningInfo) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
 	}
+	return 0
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BridgeInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "opinit.opchild.v1.BridgeInfo.bridge_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.opchild.v1.BridgeInfo.bridge_addr":
-		return protoreflect.ValueOfString("")
-	case "opinit.opchild.v1.BridgeInfo.l1_chain_id":
-		return protoreflect.ValueOfString("")
-	case "opinit.opchild.v1.BridgeInfo.l1_client_id":
-		return protoreflect.ValueOfString("")
-	case "opinit.opchild.v1.BridgeInfo.bridge_config":
-		m := new(v1.BridgeConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.opchild.v1.BridgeInfo"))
-		}
-		panic(fmt.Errorf("message opinit.opchild.v1.BridgeInfo does not contain field %s", fd.FullName()))
+func (x *ValidatorSigningInfo) GetIndexOffset() int64 {
+	if x != nil {
+		return x.IndexOffset
 	}
+	return 0
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BridgeInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.opchild.v1.BridgeInfo", d.FullName()))
+func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
+	if x != nil {
+		return x.MissedBlocksCounter
 	}
-	panic("unreachable")
+	return 0
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BridgeInfo) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
+// TODO: explore moving this to proto/cosmos/base to separate modules
+// from tendermint dependence
+type ValidatorUpdates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updates []*abci.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeInfo) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *ValidatorUpdates) Reset() {
+	*x = ValidatorUpdates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_BridgeInfo) IsValid() bool {
-	return x != nil
+func (x *ValidatorUpdates) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_BridgeInfo) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BridgeInfo)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.BridgeId != 0 {
-			n += 1 + runtime.Sov(uint64(x.BridgeId))
-		}
-		l = len(x.BridgeAddr)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.L1ChainId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.L1ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BridgeConfig != nil {
-			l = options.Size(x.BridgeConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
+func (*ValidatorUpdates) ProtoMessage() {}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BridgeInfo)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.BridgeConfig != nil {
-			encoded, err := options.Marshal(x.BridgeConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.L1ClientId) > 0 {
-			i -= len(x.L1ClientId)
-			copy(dAtA[i:], x.L1ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L1ClientId)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.L1ChainId) > 0 {
-			i -= len(x.L1ChainId)
-			copy(dAtA[i:], x.L1ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L1ChainId)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.BridgeAddr) > 0 {
-			i -= len(x.BridgeAddr)
-			copy(dAtA[i:], x.BridgeAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BridgeAddr)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.BridgeId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+// Deprecated: Use ValidatorUpdates.ProtoReflect.Descriptor instead.
+func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidatorUpdates) GetUpdates() []*abci.ValidatorUpdate {
+	if x != nil {
+		return x.Updates
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BridgeInfo)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeInfo: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
-				}
-				x.BridgeId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BridgeId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeAddr", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BridgeAddr = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1ChainId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.L1ChainId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1ClientId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.L1ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeConfig", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BridgeConfig == nil {
-					x.BridgeConfig = &v1.BridgeConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BridgeConfig); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return nil
+}
+
+// BridgeInfo defines the information of the bridge.
+type BridgeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bridge id is the unique identifier of the bridge which is assigned from l1.
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// bridge_addr is the address of the bridge on l1.
+	BridgeAddr string `protobuf:"bytes,2,opt,name=bridge_addr,json=bridgeAddr,proto3" json:"bridge_addr,omitempty"`
+	// l1_chain_id is the chain id of the l1 chain.
+	L1ChainId string `protobuf:"bytes,3,opt,name=l1_chain_id,json=l1ChainId,proto3" json:"l1_chain_id,omitempty"`
+	// l1_client_id is the IBC client ID, which is allocated for l1 chain, in l2 chain state.
+	// This is used to verify the validator set in oracle update messages.
+	L1ClientId string `protobuf:"bytes,4,opt,name=l1_client_id,json=l1ClientId,proto3" json:"l1_client_id,omitempty"`
+	// bridge_config is the configuration of the bridge.
+	BridgeConfig *v1.BridgeConfig `protobuf:"bytes,5,opt,name=bridge_config,json=bridgeConfig,proto3" json:"bridge_config,omitempty"`
+}
+
+func (x *BridgeInfo) Reset() {
+	*x = BridgeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeInfo) ProtoMessage() {}
+
+// Deprecated: Use BridgeInfo.ProtoReflect.Descriptor instead.
+func (*BridgeInfo) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BridgeInfo) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *BridgeInfo) GetBridgeAddr() string {
+	if x != nil {
+		return x.BridgeAddr
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *BridgeInfo) GetL1ChainId() string {
+	if x != nil {
+		return x.L1ChainId
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: opinit/opchild/v1/types.proto
+func (x *BridgeInfo) GetL1ClientId() string {
+	if x != nil {
+		return x.L1ClientId
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *BridgeInfo) GetBridgeConfig() *v1.BridgeConfig {
+	if x != nil {
+		return x.BridgeConfig
+	}
+	return nil
+}
 
-// Params defines the set of opchild parameters.
-type Params struct {
+// WithdrawalLeaf defines a withdrawal committed in the withdrawal tree.
+type WithdrawalLeaf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// max_validators is the maximum number of validators.
-	MaxValidators uint32 `protobuf:"varint,1,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
-	// historical_entries is the number of historical entries to persist.
-	HistoricalEntries uint32             `protobuf:"varint,2,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
-	MinGasPrices      []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices,omitempty"`
-	// the account address of bridge executor who can execute permissioned bridge
-	// messages.
-	BridgeExecutors []string `protobuf:"bytes,4,rep,name=bridge_executors,json=bridgeExecutors,proto3" json:"bridge_executors,omitempty"`
-	// the account address of admin who can execute permissioned cosmos messages.
-	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
-	// the list of addresses that are allowed to pay zero fee.
-	FeeWhitelist []string `protobuf:"bytes,6,rep,name=fee_whitelist,json=feeWhitelist,proto3" json:"fee_whitelist,omitempty"`
+	// sequence is the l2 sequence of the withdrawal.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the l2 block height of the withdrawal.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// from is the l2 sender address.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the l1 recipient address.
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// base_denom is the l1 denom of the withdrawn token.
+	BaseDenom string `protobuf:"bytes,5,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// amount is the withdrawn token amount.
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// base_class_id is the l1 class id of the withdrawn nft.
+	BaseClassId string `protobuf:"bytes,7,opt,name=base_class_id,json=baseClassId,proto3" json:"base_class_id,omitempty"`
+	// token_id is the id of the withdrawn nft.
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// leaf_version is the encoding of the token withdrawal leaf; zero for
+	// the nft withdrawals.
+	LeafVersion uint32 `protobuf:"varint,9,opt,name=leaf_version,json=leafVersion,proto3" json:"leaf_version,omitempty"`
+	// hash is the leaf hash of the withdrawal.
+	Hash []byte `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+	// l2_denom is the l2 denom of the withdrawn token originated from l2;
+	// empty for the tokens originated from l1.
+	L2Denom string `protobuf:"bytes,11,opt,name=l2_denom,json=l2Denom,proto3" json:"l2_denom,omitempty"`
+}
+
+func (x *WithdrawalLeaf) Reset() {
+	*x = WithdrawalLeaf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *WithdrawalLeaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalLeaf) ProtoMessage() {}
+
+// Deprecated: Use WithdrawalLeaf.ProtoReflect.Descriptor instead.
+func (*WithdrawalLeaf) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WithdrawalLeaf) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WithdrawalLeaf) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *WithdrawalLeaf) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WithdrawalLeaf) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *WithdrawalLeaf) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *WithdrawalLeaf) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawalLeaf) GetBaseClassId() string {
+	if x != nil {
+		return x.BaseClassId
+	}
+	return ""
+}
+
+func (x *WithdrawalLeaf) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *WithdrawalLeaf) GetLeafVersion() uint32 {
+	if x != nil {
+		return x.LeafVersion
+	}
+	return 0
+}
+
+func (x *WithdrawalLeaf) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *WithdrawalLeaf) GetL2Denom() string {
+	if x != nil {
+		return x.L2Denom
+	}
+	return ""
+}
+
+// WithdrawalCommitment defines the root of the withdrawal tree of the current
+// output window at a block.
+type WithdrawalCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the l2 block height of the commitment.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// start_sequence is the first l2 sequence of the output window.
+	StartSequence uint64 `protobuf:"varint,2,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// end_sequence is the last l2 sequence of the output window at the height.
+	EndSequence uint64 `protobuf:"varint,3,opt,name=end_sequence,json=endSequence,proto3" json:"end_sequence,omitempty"`
+	// storage_root is the root of the withdrawal tree; empty if the output
+	// window has no withdrawals.
+	StorageRoot []byte `protobuf:"bytes,4,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	// window_end is true if the output window is closed at the height.
+	WindowEnd bool `protobuf:"varint,5,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// state_root is the app hash after the block at the height; it is
+	// recorded at the next block.
+	StateRoot []byte `protobuf:"bytes,6,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// latest_block_hash is the hash of the block at the height.
+	LatestBlockHash []byte `protobuf:"bytes,7,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+}
+
+func (x *WithdrawalCommitment) Reset() {
+	*x = WithdrawalCommitment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_opchild_v1_types_proto_msgTypes[0]
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawalCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalCommitment) ProtoMessage() {}
+
+// Deprecated: Use WithdrawalCommitment.ProtoReflect.Descriptor instead.
+func (*WithdrawalCommitment) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WithdrawalCommitment) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *WithdrawalCommitment) GetStartSequence() uint64 {
+	if x != nil {
+		return x.StartSequence
+	}
+	return 0
+}
+
+func (x *WithdrawalCommitment) GetEndSequence() uint64 {
+	if x != nil {
+		return x.EndSequence
+	}
+	return 0
+}
+
+func (x *WithdrawalCommitment) GetStorageRoot() []byte {
+	if x != nil {
+		return x.StorageRoot
+	}
+	return nil
+}
+
+func (x *WithdrawalCommitment) GetWindowEnd() bool {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return false
+}
+
+func (x *WithdrawalCommitment) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *WithdrawalCommitment) GetLatestBlockHash() []byte {
+	if x != nil {
+		return x.LatestBlockHash
+	}
+	return nil
+}
+
+// WithdrawalWindow defines the output window of the withdrawal tree.
+type WithdrawalWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_sequence is the first l2 sequence of the window.
+	StartSequence uint64 `protobuf:"varint,1,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// end_time is the time at which the window is closed.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// num_leaves is the number of the leaves committed in the window.
+	NumLeaves uint64 `protobuf:"varint,3,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// frontier is the last complete left node of each level of the withdrawal
+	// tree, from which the root is computed without rebuilding the tree.
+	Frontier [][]byte `protobuf:"bytes,4,rep,name=frontier,proto3" json:"frontier,omitempty"`
+	// next_leaf_sequence is the first l2 sequence not appended to the tree.
+	NextLeafSequence uint64 `protobuf:"varint,5,opt,name=next_leaf_sequence,json=nextLeafSequence,proto3" json:"next_leaf_sequence,omitempty"`
+}
+
+func (x *WithdrawalWindow) Reset() {
+	*x = WithdrawalWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawalWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalWindow) ProtoMessage() {}
+
+// Deprecated: Use WithdrawalWindow.ProtoReflect.Descriptor instead.
+func (*WithdrawalWindow) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WithdrawalWindow) GetStartSequence() uint64 {
+	if x != nil {
+		return x.StartSequence
+	}
+	return 0
+}
+
+func (x *WithdrawalWindow) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *WithdrawalWindow) GetNumLeaves() uint64 {
+	if x != nil {
+		return x.NumLeaves
+	}
+	return 0
+}
+
+func (x *WithdrawalWindow) GetFrontier() [][]byte {
+	if x != nil {
+		return x.Frontier
+	}
+	return nil
+}
+
+func (x *WithdrawalWindow) GetNextLeafSequence() uint64 {
+	if x != nil {
+		return x.NextLeafSequence
+	}
+	return 0
+}
+
+// SequenceRange defines an inclusive range of sequences.
+type SequenceRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *SequenceRange) Reset() {
+	*x = SequenceRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SequenceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequenceRange) ProtoMessage() {}
+
+// Deprecated: Use SequenceRange.ProtoReflect.Descriptor instead.
+func (*SequenceRange) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SequenceRange) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SequenceRange) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// ExecutorChangePlan defines the validator and the bridge executors which
+// replace the current ones at the height.
+type ExecutorChangePlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the l1 governance proposal id.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the l2 height to apply the plan.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// next_executors are the bridge executors after the change.
+	NextExecutors []string `protobuf:"bytes,3,rep,name=next_executors,json=nextExecutors,proto3" json:"next_executors,omitempty"`
+	// next_validator is the validator after the change.
+	NextValidator *Validator `protobuf:"bytes,4,opt,name=next_validator,json=nextValidator,proto3" json:"next_validator,omitempty"`
+	// info is the additional information of the plan.
+	Info string `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ExecutorChangePlan) Reset() {
+	*x = ExecutorChangePlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *ExecutorChangePlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*ExecutorChangePlan) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ExecutorChangePlan.ProtoReflect.Descriptor instead.
+func (*ExecutorChangePlan) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Params) GetMaxValidators() uint32 {
+func (x *ExecutorChangePlan) GetProposalId() uint64 {
 	if x != nil {
-		return x.MaxValidators
+		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *Params) GetHistoricalEntries() uint32 {
+func (x *ExecutorChangePlan) GetHeight() uint64 {
 	if x != nil {
-		return x.HistoricalEntries
+		return x.Height
 	}
 	return 0
 }
 
-func (x *Params) GetMinGasPrices() []*v1beta1.DecCoin {
+func (x *ExecutorChangePlan) GetNextExecutors() []string {
 	if x != nil {
-		return x.MinGasPrices
+		return x.NextExecutors
 	}
 	return nil
 }
 
-func (x *Params) GetBridgeExecutors() []string {
+func (x *ExecutorChangePlan) GetNextValidator() *Validator {
 	if x != nil {
-		return x.BridgeExecutors
+		return x.NextValidator
 	}
 	return nil
 }
 
-func (x *Params) GetAdmin() string {
+func (x *ExecutorChangePlan) GetInfo() string {
 	if x != nil {
-		return x.Admin
+		return x.Info
 	}
 	return ""
 }
 
-func (x *Params) GetFeeWhitelist() []string {
-	if x != nil {
-		return x.FeeWhitelist
-	}
-	return nil
-}
-
-// Validator defines a validator, together with the total amount of the
-// Validator's bond shares and their exchange rate to coins. Slashing results in
-// a decrease in the exchange rate, allowing correct calculation of future
-// undelegations without iterating over delegators. When coins are delegated to
-// this validator, the validator is credited with a delegation whose number of
-// bond shares is based on the amount of coins delegated divided by the current
-// exchange rate. Voting power can be calculated as total bonded shares
-// multiplied by exchange rate.
-type Validator struct {
+// DepositAttestation defines the pending attestations of a deposit.
+type DepositAttestation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// operator_address defines the address of the validator's operator;
-	// bech encoded in JSON.
-	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// consensus_pubkey is the consensus public key of the validator,
-	// as a Protobuf Any.
-	ConsensusPubkey *anypb.Any `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
-	ConsPower       int64      `protobuf:"varint,4,opt,name=cons_power,json=consPower,proto3" json:"cons_power,omitempty"`
+	// sequence is the l1 sequence of the deposit.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// votes are the attestations grouped by the deposit contents.
+	Votes []*DepositAttestationVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (x *Validator) Reset() {
-	*x = Validator{}
+func (x *DepositAttestation) Reset() {
+	*x = DepositAttestation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_opchild_v1_types_proto_msgTypes[1]
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Validator) String() string {
+func (x *DepositAttestation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Validator) ProtoMessage() {}
+func (*DepositAttestation) ProtoMessage() {}
 
-// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
-func (*Validator) Descriptor() ([]byte, []int) {
-	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DepositAttestation.ProtoReflect.Descriptor instead.
+func (*DepositAttestation) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Validator) GetMoniker() string {
+func (x *DepositAttestation) GetSequence() uint64 {
 	if x != nil {
-		return x.Moniker
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (x *Validator) GetOperatorAddress() string {
+func (x *DepositAttestation) GetVotes() []*DepositAttestationVote {
 	if x != nil {
-		return x.OperatorAddress
+		return x.Votes
 	}
-	return ""
+	return nil
 }
 
-func (x *Validator) GetConsensusPubkey() *anypb.Any {
+// DepositAttestationVote defines the executors attested the same deposit
+// contents.
+type DepositAttestationVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deposit_hash is the hash of the deposit contents.
+	DepositHash []byte `protobuf:"bytes,1,opt,name=deposit_hash,json=depositHash,proto3" json:"deposit_hash,omitempty"`
+	// attesters are the bridge executors submitted the deposit contents.
+	Attesters []string `protobuf:"bytes,2,rep,name=attesters,proto3" json:"attesters,omitempty"`
+}
+
+func (x *DepositAttestationVote) Reset() {
+	*x = DepositAttestationVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAttestationVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAttestationVote) ProtoMessage() {}
+
+// Deprecated: Use DepositAttestationVote.ProtoReflect.Descriptor instead.
+func (*DepositAttestationVote) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DepositAttestationVote) GetDepositHash() []byte {
 	if x != nil {
-		return x.ConsensusPubkey
+		return x.DepositHash
 	}
 	return nil
 }
 
-func (x *Validator) GetConsPower() int64 {
+func (x *DepositAttestationVote) GetAttesters() []string {
 	if x != nil {
-		return x.ConsPower
+		return x.Attesters
 	}
-	return 0
+	return nil
 }
 
-// ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
-// TODO: explore moving this to proto/cosmos/base to separate modules
-// from tendermint dependence
-type ValidatorUpdates struct {
+// ScheduledMessages defines the admin messages queued for the execution
+// after the timelock.
+type ScheduledMessages struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Updates []*abci.ValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	// id is the unique identifier of the scheduled messages.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the admin who scheduled the messages.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// execution_height is the l2 height from which the messages can be executed.
+	ExecutionHeight uint64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// messages are the messages to be executed with the module authority.
+	Messages []*anypb.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *ValidatorUpdates) Reset() {
-	*x = ValidatorUpdates{}
+func (x *ScheduledMessages) Reset() {
+	*x = ScheduledMessages{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_opchild_v1_types_proto_msgTypes[2]
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorUpdates) String() string {
+func (x *ScheduledMessages) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorUpdates) ProtoMessage() {}
+func (*ScheduledMessages) ProtoMessage() {}
 
-// Deprecated: Use ValidatorUpdates.ProtoReflect.Descriptor instead.
-func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ScheduledMessages.ProtoReflect.Descriptor instead.
+func (*ScheduledMessages) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ValidatorUpdates) GetUpdates() []*abci.ValidatorUpdate {
+func (x *ScheduledMessages) GetId() uint64 {
 	if x != nil {
-		return x.Updates
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledMessages) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ScheduledMessages) GetExecutionHeight() uint64 {
+	if x != nil {
+		return x.ExecutionHeight
+	}
+	return 0
+}
+
+func (x *ScheduledMessages) GetMessages() []*anypb.Any {
+	if x != nil {
+		return x.Messages
 	}
 	return nil
 }
 
-// BridgeInfo defines the information of the bridge.
-type BridgeInfo struct {
+// AdminProposal defines the admin messages waiting for the approvals of the
+// admin signers.
+type AdminProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// bridge id is the unique identifier of the bridge which is assigned from l1.
-	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	// bridge_addr is the address of the bridge on l1.
-	BridgeAddr string `protobuf:"bytes,2,opt,name=bridge_addr,json=bridgeAddr,proto3" json:"bridge_addr,omitempty"`
-	// l1_chain_id is the chain id of the l1 chain.
-	L1ChainId string `protobuf:"bytes,3,opt,name=l1_chain_id,json=l1ChainId,proto3" json:"l1_chain_id,omitempty"`
-	// l1_client_id is the IBC client ID, which is allocated for l1 chain, in l2 chain state.
-	// This is used to verify the validator set in oracle update messages.
-	L1ClientId string `protobuf:"bytes,4,opt,name=l1_client_id,json=l1ClientId,proto3" json:"l1_client_id,omitempty"`
-	// bridge_config is the configuration of the bridge.
-	BridgeConfig *v1.BridgeConfig `protobuf:"bytes,5,opt,name=bridge_config,json=bridgeConfig,proto3" json:"bridge_config,omitempty"`
-}
-
-func (x *BridgeInfo) Reset() {
-	*x = BridgeInfo{}
+	// id is the unique identifier of the admin proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposer is the admin signer who submitted the proposal.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// execution_height is the requested execution height of the messages.
+	ExecutionHeight uint64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// messages are the messages to be executed with the module authority.
+	Messages []*anypb.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// approvals are the admin signers approved the proposal.
+	Approvals []string `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// expiration_height is the height from which the proposal can no longer
+	// be approved.
+	ExpirationHeight uint64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (x *AdminProposal) Reset() {
+	*x = AdminProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_opchild_v1_types_proto_msgTypes[3]
+		mi := &file_opinit_opchild_v1_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeInfo) String() string {
+func (x *AdminProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeInfo) ProtoMessage() {}
+func (*AdminProposal) ProtoMessage() {}
 
-// Deprecated: Use BridgeInfo.ProtoReflect.Descriptor instead.
-func (*BridgeInfo) Descriptor() ([]byte, []int) {
-	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AdminProposal.ProtoReflect.Descriptor instead.
+func (*AdminProposal) Descriptor() ([]byte, []int) {
+	return file_opinit_opchild_v1_types_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BridgeInfo) GetBridgeId() uint64 {
+func (x *AdminProposal) GetId() uint64 {
 	if x != nil {
-		return x.BridgeId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *BridgeInfo) GetBridgeAddr() string {
+func (x *AdminProposal) GetProposer() string {
 	if x != nil {
-		return x.BridgeAddr
+		return x.Proposer
 	}
 	return ""
 }
 
-func (x *BridgeInfo) GetL1ChainId() string {
+func (x *AdminProposal) GetExecutionHeight() uint64 {
 	if x != nil {
-		return x.L1ChainId
+		return x.ExecutionHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *BridgeInfo) GetL1ClientId() string {
+func (x *AdminProposal) GetMessages() []*anypb.Any {
 	if x != nil {
-		return x.L1ClientId
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-func (x *BridgeInfo) GetBridgeConfig() *v1.BridgeConfig {
+func (x *AdminProposal) GetApprovals() []string {
 	if x != nil {
-		return x.BridgeConfig
+		return x.Approvals
 	}
 	return nil
 }
 
+func (x *AdminProposal) GetExpirationHeight() uint64 {
+	if x != nil {
+		return x.ExpirationHeight
+	}
+	return 0
+}
+
 var File_opinit_opchild_v1_types_proto protoreflect.FileDescriptor
 
 var file_opinit_opchild_v1_types_proto_rawDesc = []byte{
@@ -2993,102 +12072,338 @@ var file_opinit_opchild_v1_types_proto_rawDesc = []byte{
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1b, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x62,
-	0x63, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x04, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x19, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x12, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x52, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4b,
-	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69,
-	0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xaa, 0xdf, 0x1f, 0x2b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
-	0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x10, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x38, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x22,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61,
+	0x62, 0x63, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x10,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x12, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x52, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x4b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xaa, 0xdf, 0x1f,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x10, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x38, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73,
+	0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x43, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d,
+	0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x43,
-	0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xf2,
-	0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x12, 0x5a, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x35, 0xf2, 0xde, 0x1f, 0x14,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x5a, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x35, 0xf2, 0xde, 0x1f,
+	0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x5a, 0x0a, 0x17, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x22, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x15, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x6c, 0x0a, 0x1d,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x28, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x1b, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x57, 0x0a, 0x16, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x52, 0x14, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x62, 0x0a, 0x1a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x52, 0x17,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x5d, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x5a, 0x0a, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x35, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22,
+	0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x63, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x42, 0x38, 0xf2, 0xde, 0x1f, 0x17,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37,
+	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x3b, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x3a,
-	0x1b, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0e, 0x6f, 0x70,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xbb, 0x02, 0x0a,
-	0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x6f,
-	0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xf2, 0xde, 0x1f,
-	0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x22, 0x52,
-	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52,
-	0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x74, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x42, 0x33, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0xca, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x22, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x0c, 0x88, 0xa0,
-	0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x5f, 0x0a, 0x10, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45,
-	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63,
-	0x69, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xf6, 0x01, 0x0a, 0x0a,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x31, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x31, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x31, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x31, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70,
-	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0xd0, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a,
-	0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50, 0x69,
-	0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f,
-	0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x11, 0x4f, 0x70, 0x69, 0x6e,
-	0x69, 0x74, 0x2e, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11,
-	0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1d, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x13, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x52, 0x11, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x22, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x56, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x12, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x54, 0x0a, 0x15, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x22, 0x52, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61,
+	0x69, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x15, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12,
+	0x45, 0x0a, 0x10, 0x6c, 0x32, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x32, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x52, 0x0e, 0x6c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x6c, 0x31, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde,
+	0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x31, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x6c, 0x31, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x3a, 0x1b, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0e,
+	0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe6,
+	0x02, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x07,
+	0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xf2,
+	0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72,
+	0x22, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x74, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x42, 0x33, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0xca,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xf2, 0xde,
+	0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x22, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11,
+	0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x22, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xc4, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x5f,
+	0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
+	0xf6, 0x01, 0x0a, 0x0a, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x31, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x31, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x31, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x31,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x96, 0x03, 0x0a, 0x0e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x28, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x32, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x32, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x22, 0x85, 0x02, 0x0a, 0x14, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x64,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xe3, 0x01, 0x0a, 0x10, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e,
+	0x65, 0x78, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x37, 0x0a, 0x0d, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x2f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x4e, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x7c, 0x0a,
+	0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x4a, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x16, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0xbc, 0x01, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
+	0xa1, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x00, 0x42, 0xd0, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x15,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50, 0x69, 0x6e,
+	0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x11, 0x4f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4f,
+	0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1d, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x13, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3103,27 +12418,43 @@ func file_opinit_opchild_v1_types_proto_rawDescGZIP() []byte {
 	return file_opinit_opchild_v1_types_proto_rawDescData
 }
 
-var file_opinit_opchild_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_opinit_opchild_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_opinit_opchild_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),               // 0: opinit.opchild.v1.Params
-	(*Validator)(nil),            // 1: opinit.opchild.v1.Validator
-	(*ValidatorUpdates)(nil),     // 2: opinit.opchild.v1.ValidatorUpdates
-	(*BridgeInfo)(nil),           // 3: opinit.opchild.v1.BridgeInfo
-	(*v1beta1.DecCoin)(nil),      // 4: cosmos.base.v1beta1.DecCoin
-	(*anypb.Any)(nil),            // 5: google.protobuf.Any
-	(*abci.ValidatorUpdate)(nil), // 6: tendermint.abci.ValidatorUpdate
-	(*v1.BridgeConfig)(nil),      // 7: opinit.ophost.v1.BridgeConfig
+	(*Params)(nil),                 // 0: opinit.opchild.v1.Params
+	(*Validator)(nil),              // 1: opinit.opchild.v1.Validator
+	(*ValidatorSigningInfo)(nil),   // 2: opinit.opchild.v1.ValidatorSigningInfo
+	(*ValidatorUpdates)(nil),       // 3: opinit.opchild.v1.ValidatorUpdates
+	(*BridgeInfo)(nil),             // 4: opinit.opchild.v1.BridgeInfo
+	(*WithdrawalLeaf)(nil),         // 5: opinit.opchild.v1.WithdrawalLeaf
+	(*WithdrawalCommitment)(nil),   // 6: opinit.opchild.v1.WithdrawalCommitment
+	(*WithdrawalWindow)(nil),       // 7: opinit.opchild.v1.WithdrawalWindow
+	(*SequenceRange)(nil),          // 8: opinit.opchild.v1.SequenceRange
+	(*ExecutorChangePlan)(nil),     // 9: opinit.opchild.v1.ExecutorChangePlan
+	(*DepositAttestation)(nil),     // 10: opinit.opchild.v1.DepositAttestation
+	(*DepositAttestationVote)(nil), // 11: opinit.opchild.v1.DepositAttestationVote
+	(*ScheduledMessages)(nil),      // 12: opinit.opchild.v1.ScheduledMessages
+	(*AdminProposal)(nil),          // 13: opinit.opchild.v1.AdminProposal
+	(*v1beta1.DecCoin)(nil),        // 14: cosmos.base.v1beta1.DecCoin
+	(*anypb.Any)(nil),              // 15: google.protobuf.Any
+	(*abci.ValidatorUpdate)(nil),   // 16: tendermint.abci.ValidatorUpdate
+	(*v1.BridgeConfig)(nil),        // 17: opinit.ophost.v1.BridgeConfig
+	(*timestamppb.Timestamp)(nil),  // 18: google.protobuf.Timestamp
 }
 var file_opinit_opchild_v1_types_proto_depIdxs = []int32{
-	4, // 0: opinit.opchild.v1.Params.min_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
-	5, // 1: opinit.opchild.v1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
-	6, // 2: opinit.opchild.v1.ValidatorUpdates.updates:type_name -> tendermint.abci.ValidatorUpdate
-	7, // 3: opinit.opchild.v1.BridgeInfo.bridge_config:type_name -> opinit.ophost.v1.BridgeConfig
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	14, // 0: opinit.opchild.v1.Params.min_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	15, // 1: opinit.opchild.v1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
+	16, // 2: opinit.opchild.v1.ValidatorUpdates.updates:type_name -> tendermint.abci.ValidatorUpdate
+	17, // 3: opinit.opchild.v1.BridgeInfo.bridge_config:type_name -> opinit.ophost.v1.BridgeConfig
+	18, // 4: opinit.opchild.v1.WithdrawalWindow.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 5: opinit.opchild.v1.ExecutorChangePlan.next_validator:type_name -> opinit.opchild.v1.Validator
+	11, // 6: opinit.opchild.v1.DepositAttestation.votes:type_name -> opinit.opchild.v1.DepositAttestationVote
+	15, // 7: opinit.opchild.v1.ScheduledMessages.messages:type_name -> google.protobuf.Any
+	15, // 8: opinit.opchild.v1.AdminProposal.messages:type_name -> google.protobuf.Any
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_opinit_opchild_v1_types_proto_init() }
@@ -3157,7 +12488,7 @@ func file_opinit_opchild_v1_types_proto_init() {
 			}
 		}
 		file_opinit_opchild_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorUpdates); i {
+			switch v := v.(*ValidatorSigningInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3169,6 +12500,18 @@ func file_opinit_opchild_v1_types_proto_init() {
 			}
 		}
 		file_opinit_opchild_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorUpdates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BridgeInfo); i {
 			case 0:
 				return &v.state
@@ -3180,6 +12523,114 @@ func file_opinit_opchild_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_opinit_opchild_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawalLeaf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawalCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawalWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutorChangePlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAttestationVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMessages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_opchild_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3187,7 +12638,7 @@ func file_opinit_opchild_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_opinit_opchild_v1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -834,16 +834,328 @@ func (x *_Bridge_8_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Bridge_9_list)(nil)
+
+type _Bridge_9_list struct {
+	list *[]*DepositCap
+}
+
+func (x *_Bridge_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositCap)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositCap)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_9_list) AppendMutable() protoreflect.Value {
+	v := new(DepositCap)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_9_list) NewElement() protoreflect.Value {
+	v := new(DepositCap)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_10_list)(nil)
+
+type _Bridge_10_list struct {
+	list *[]*BridgeAccounting
+}
+
+func (x *_Bridge_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeAccounting)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeAccounting)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_10_list) AppendMutable() protoreflect.Value {
+	v := new(BridgeAccounting)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_10_list) NewElement() protoreflect.Value {
+	v := new(BridgeAccounting)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_11_list)(nil)
+
+type _Bridge_11_list struct {
+	list *[]*TokenPair
+}
+
+func (x *_Bridge_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_11_list) AppendMutable() protoreflect.Value {
+	v := new(TokenPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_11_list) NewElement() protoreflect.Value {
+	v := new(TokenPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_12_list)(nil)
+
+type _Bridge_12_list struct {
+	list *[]*NftClassPair
+}
+
+func (x *_Bridge_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NftClassPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NftClassPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_12_list) AppendMutable() protoreflect.Value {
+	v := new(NftClassPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_12_list) NewElement() protoreflect.Value {
+	v := new(NftClassPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_13_list)(nil)
+
+type _Bridge_13_list struct {
+	list *[]*ProvenWithdrawalBitmap
+}
+
+func (x *_Bridge_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProvenWithdrawalBitmap)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProvenWithdrawalBitmap)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_13_list) AppendMutable() protoreflect.Value {
+	v := new(ProvenWithdrawalBitmap)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_13_list) NewElement() protoreflect.Value {
+	v := new(ProvenWithdrawalBitmap)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_14_list)(nil)
+
+type _Bridge_14_list struct {
+	list *[]*DepositCommitment
+}
+
+func (x *_Bridge_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositCommitment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DepositCommitment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_14_list) AppendMutable() protoreflect.Value {
+	v := new(DepositCommitment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_14_list) NewElement() protoreflect.Value {
+	v := new(DepositCommitment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Bridge                    protoreflect.MessageDescriptor
-	fd_Bridge_bridge_id          protoreflect.FieldDescriptor
-	fd_Bridge_next_l1_sequence   protoreflect.FieldDescriptor
-	fd_Bridge_next_output_index  protoreflect.FieldDescriptor
-	fd_Bridge_bridge_config      protoreflect.FieldDescriptor
-	fd_Bridge_token_pairs        protoreflect.FieldDescriptor
-	fd_Bridge_proven_withdrawals protoreflect.FieldDescriptor
-	fd_Bridge_proposals          protoreflect.FieldDescriptor
-	fd_Bridge_batch_infos        protoreflect.FieldDescriptor
+	md_Bridge                           protoreflect.MessageDescriptor
+	fd_Bridge_bridge_id                 protoreflect.FieldDescriptor
+	fd_Bridge_next_l1_sequence          protoreflect.FieldDescriptor
+	fd_Bridge_next_output_index         protoreflect.FieldDescriptor
+	fd_Bridge_bridge_config             protoreflect.FieldDescriptor
+	fd_Bridge_token_pairs               protoreflect.FieldDescriptor
+	fd_Bridge_proven_withdrawals        protoreflect.FieldDescriptor
+	fd_Bridge_proposals                 protoreflect.FieldDescriptor
+	fd_Bridge_batch_infos               protoreflect.FieldDescriptor
+	fd_Bridge_deposit_caps              protoreflect.FieldDescriptor
+	fd_Bridge_accountings               protoreflect.FieldDescriptor
+	fd_Bridge_l2_native_token_pairs     protoreflect.FieldDescriptor
+	fd_Bridge_nft_class_pairs           protoreflect.FieldDescriptor
+	fd_Bridge_proven_withdrawal_bitmaps protoreflect.FieldDescriptor
+	fd_Bridge_deposit_commitments       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -857,6 +1169,12 @@ func init() {
 	fd_Bridge_proven_withdrawals = md_Bridge.Fields().ByName("proven_withdrawals")
 	fd_Bridge_proposals = md_Bridge.Fields().ByName("proposals")
 	fd_Bridge_batch_infos = md_Bridge.Fields().ByName("batch_infos")
+	fd_Bridge_deposit_caps = md_Bridge.Fields().ByName("deposit_caps")
+	fd_Bridge_accountings = md_Bridge.Fields().ByName("accountings")
+	fd_Bridge_l2_native_token_pairs = md_Bridge.Fields().ByName("l2_native_token_pairs")
+	fd_Bridge_nft_class_pairs = md_Bridge.Fields().ByName("nft_class_pairs")
+	fd_Bridge_proven_withdrawal_bitmaps = md_Bridge.Fields().ByName("proven_withdrawal_bitmaps")
+	fd_Bridge_deposit_commitments = md_Bridge.Fields().ByName("deposit_commitments")
 }
 
 var _ protoreflect.Message = (*fastReflection_Bridge)(nil)
@@ -972,6 +1290,42 @@ func (x *fastReflection_Bridge) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.DepositCaps) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_9_list{list: &x.DepositCaps})
+		if !f(fd_Bridge_deposit_caps, value) {
+			return
+		}
+	}
+	if len(x.Accountings) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_10_list{list: &x.Accountings})
+		if !f(fd_Bridge_accountings, value) {
+			return
+		}
+	}
+	if len(x.L2NativeTokenPairs) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_11_list{list: &x.L2NativeTokenPairs})
+		if !f(fd_Bridge_l2_native_token_pairs, value) {
+			return
+		}
+	}
+	if len(x.NftClassPairs) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_12_list{list: &x.NftClassPairs})
+		if !f(fd_Bridge_nft_class_pairs, value) {
+			return
+		}
+	}
+	if len(x.ProvenWithdrawalBitmaps) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_13_list{list: &x.ProvenWithdrawalBitmaps})
+		if !f(fd_Bridge_proven_withdrawal_bitmaps, value) {
+			return
+		}
+	}
+	if len(x.DepositCommitments) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_14_list{list: &x.DepositCommitments})
+		if !f(fd_Bridge_deposit_commitments, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1003,6 +1357,18 @@ func (x *fastReflection_Bridge) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Proposals) != 0
 	case "opinit.ophost.v1.Bridge.batch_infos":
 		return len(x.BatchInfos) != 0
+	case "opinit.ophost.v1.Bridge.deposit_caps":
+		return len(x.DepositCaps) != 0
+	case "opinit.ophost.v1.Bridge.accountings":
+		return len(x.Accountings) != 0
+	case "opinit.ophost.v1.Bridge.l2_native_token_pairs":
+		return len(x.L2NativeTokenPairs) != 0
+	case "opinit.ophost.v1.Bridge.nft_class_pairs":
+		return len(x.NftClassPairs) != 0
+	case "opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps":
+		return len(x.ProvenWithdrawalBitmaps) != 0
+	case "opinit.ophost.v1.Bridge.deposit_commitments":
+		return len(x.DepositCommitments) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Bridge"))
@@ -1035,6 +1401,18 @@ func (x *fastReflection_Bridge) Clear(fd protoreflect.FieldDescriptor) {
 		x.Proposals = nil
 	case "opinit.ophost.v1.Bridge.batch_infos":
 		x.BatchInfos = nil
+	case "opinit.ophost.v1.Bridge.deposit_caps":
+		x.DepositCaps = nil
+	case "opinit.ophost.v1.Bridge.accountings":
+		x.Accountings = nil
+	case "opinit.ophost.v1.Bridge.l2_native_token_pairs":
+		x.L2NativeTokenPairs = nil
+	case "opinit.ophost.v1.Bridge.nft_class_pairs":
+		x.NftClassPairs = nil
+	case "opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps":
+		x.ProvenWithdrawalBitmaps = nil
+	case "opinit.ophost.v1.Bridge.deposit_commitments":
+		x.DepositCommitments = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Bridge"))
@@ -1087,6 +1465,42 @@ func (x *fastReflection_Bridge) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Bridge_8_list{list: &x.BatchInfos}
 		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Bridge.deposit_caps":
+		if len(x.DepositCaps) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_9_list{})
+		}
+		listValue := &_Bridge_9_list{list: &x.DepositCaps}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Bridge.accountings":
+		if len(x.Accountings) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_10_list{})
+		}
+		listValue := &_Bridge_10_list{list: &x.Accountings}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Bridge.l2_native_token_pairs":
+		if len(x.L2NativeTokenPairs) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_11_list{})
+		}
+		listValue := &_Bridge_11_list{list: &x.L2NativeTokenPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Bridge.nft_class_pairs":
+		if len(x.NftClassPairs) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_12_list{})
+		}
+		listValue := &_Bridge_12_list{list: &x.NftClassPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps":
+		if len(x.ProvenWithdrawalBitmaps) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_13_list{})
+		}
+		listValue := &_Bridge_13_list{list: &x.ProvenWithdrawalBitmaps}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Bridge.deposit_commitments":
+		if len(x.DepositCommitments) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_14_list{})
+		}
+		listValue := &_Bridge_14_list{list: &x.DepositCommitments}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Bridge"))
@@ -1131,6 +1545,30 @@ func (x *fastReflection_Bridge) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Bridge_8_list)
 		x.BatchInfos = *clv.list
+	case "opinit.ophost.v1.Bridge.deposit_caps":
+		lv := value.List()
+		clv := lv.(*_Bridge_9_list)
+		x.DepositCaps = *clv.list
+	case "opinit.ophost.v1.Bridge.accountings":
+		lv := value.List()
+		clv := lv.(*_Bridge_10_list)
+		x.Accountings = *clv.list
+	case "opinit.ophost.v1.Bridge.l2_native_token_pairs":
+		lv := value.List()
+		clv := lv.(*_Bridge_11_list)
+		x.L2NativeTokenPairs = *clv.list
+	case "opinit.ophost.v1.Bridge.nft_class_pairs":
+		lv := value.List()
+		clv := lv.(*_Bridge_12_list)
+		x.NftClassPairs = *clv.list
+	case "opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps":
+		lv := value.List()
+		clv := lv.(*_Bridge_13_list)
+		x.ProvenWithdrawalBitmaps = *clv.list
+	case "opinit.ophost.v1.Bridge.deposit_commitments":
+		lv := value.List()
+		clv := lv.(*_Bridge_14_list)
+		x.DepositCommitments = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Bridge"))
@@ -1180,6 +1618,42 @@ func (x *fastReflection_Bridge) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Bridge_8_list{list: &x.BatchInfos}
 		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Bridge.deposit_caps":
+		if x.DepositCaps == nil {
+			x.DepositCaps = []*DepositCap{}
+		}
+		value := &_Bridge_9_list{list: &x.DepositCaps}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Bridge.accountings":
+		if x.Accountings == nil {
+			x.Accountings = []*BridgeAccounting{}
+		}
+		value := &_Bridge_10_list{list: &x.Accountings}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Bridge.l2_native_token_pairs":
+		if x.L2NativeTokenPairs == nil {
+			x.L2NativeTokenPairs = []*TokenPair{}
+		}
+		value := &_Bridge_11_list{list: &x.L2NativeTokenPairs}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Bridge.nft_class_pairs":
+		if x.NftClassPairs == nil {
+			x.NftClassPairs = []*NftClassPair{}
+		}
+		value := &_Bridge_12_list{list: &x.NftClassPairs}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps":
+		if x.ProvenWithdrawalBitmaps == nil {
+			x.ProvenWithdrawalBitmaps = []*ProvenWithdrawalBitmap{}
+		}
+		value := &_Bridge_13_list{list: &x.ProvenWithdrawalBitmaps}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Bridge.deposit_commitments":
+		if x.DepositCommitments == nil {
+			x.DepositCommitments = []*DepositCommitment{}
+		}
+		value := &_Bridge_14_list{list: &x.DepositCommitments}
+		return protoreflect.ValueOfList(value)
 	case "opinit.ophost.v1.Bridge.bridge_id":
 		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.Bridge is not mutable"))
 	case "opinit.ophost.v1.Bridge.next_l1_sequence":
@@ -1220,6 +1694,24 @@ func (x *fastReflection_Bridge) NewField(fd protoreflect.FieldDescriptor) protor
 	case "opinit.ophost.v1.Bridge.batch_infos":
 		list := []*BatchInfoWithOutput{}
 		return protoreflect.ValueOfList(&_Bridge_8_list{list: &list})
+	case "opinit.ophost.v1.Bridge.deposit_caps":
+		list := []*DepositCap{}
+		return protoreflect.ValueOfList(&_Bridge_9_list{list: &list})
+	case "opinit.ophost.v1.Bridge.accountings":
+		list := []*BridgeAccounting{}
+		return protoreflect.ValueOfList(&_Bridge_10_list{list: &list})
+	case "opinit.ophost.v1.Bridge.l2_native_token_pairs":
+		list := []*TokenPair{}
+		return protoreflect.ValueOfList(&_Bridge_11_list{list: &list})
+	case "opinit.ophost.v1.Bridge.nft_class_pairs":
+		list := []*NftClassPair{}
+		return protoreflect.ValueOfList(&_Bridge_12_list{list: &list})
+	case "opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps":
+		list := []*ProvenWithdrawalBitmap{}
+		return protoreflect.ValueOfList(&_Bridge_13_list{list: &list})
+	case "opinit.ophost.v1.Bridge.deposit_commitments":
+		list := []*DepositCommitment{}
+		return protoreflect.ValueOfList(&_Bridge_14_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Bridge"))
@@ -1320,41 +1812,173 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.BatchInfos) > 0 {
-			for _, e := range x.BatchInfos {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.BatchInfos) > 0 {
+			for _, e := range x.BatchInfos {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DepositCaps) > 0 {
+			for _, e := range x.DepositCaps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Accountings) > 0 {
+			for _, e := range x.Accountings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.L2NativeTokenPairs) > 0 {
+			for _, e := range x.L2NativeTokenPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NftClassPairs) > 0 {
+			for _, e := range x.NftClassPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProvenWithdrawalBitmaps) > 0 {
+			for _, e := range x.ProvenWithdrawalBitmaps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DepositCommitments) > 0 {
+			for _, e := range x.DepositCommitments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Bridge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DepositCommitments) > 0 {
+			for iNdEx := len(x.DepositCommitments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DepositCommitments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.ProvenWithdrawalBitmaps) > 0 {
+			for iNdEx := len(x.ProvenWithdrawalBitmaps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProvenWithdrawalBitmaps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.NftClassPairs) > 0 {
+			for iNdEx := len(x.NftClassPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NftClassPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.L2NativeTokenPairs) > 0 {
+			for iNdEx := len(x.L2NativeTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.L2NativeTokenPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Accountings) > 0 {
+			for iNdEx := len(x.Accountings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accountings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.DepositCaps) > 0 {
+			for iNdEx := len(x.DepositCaps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DepositCaps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Bridge)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
 		if len(x.BatchInfos) > 0 {
 			for iNdEx := len(x.BatchInfos) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.BatchInfos[iNdEx])
@@ -1717,6 +2341,210 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCaps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositCaps = append(x.DepositCaps, &DepositCap{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositCaps[len(x.DepositCaps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accountings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accountings = append(x.Accountings, &BridgeAccounting{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accountings[len(x.Accountings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2NativeTokenPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L2NativeTokenPairs = append(x.L2NativeTokenPairs, &TokenPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.L2NativeTokenPairs[len(x.L2NativeTokenPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftClassPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftClassPairs = append(x.NftClassPairs, &NftClassPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NftClassPairs[len(x.NftClassPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProvenWithdrawalBitmaps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProvenWithdrawalBitmaps = append(x.ProvenWithdrawalBitmaps, &ProvenWithdrawalBitmap{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProvenWithdrawalBitmaps[len(x.ProvenWithdrawalBitmaps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCommitments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositCommitments = append(x.DepositCommitments, &DepositCommitment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositCommitments[len(x.DepositCommitments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2318,12 +3146,23 @@ type Bridge struct {
 	BridgeConfig *BridgeConfig `protobuf:"bytes,4,opt,name=bridge_config,json=bridgeConfig,proto3" json:"bridge_config,omitempty"`
 	// a list of (l1, l2) token pairs
 	TokenPairs []*TokenPair `protobuf:"bytes,5,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs,omitempty"`
-	// a list of l2 output proposals, which has been proven.
+	// a list of the legacy proven withdrawal hashes.
 	ProvenWithdrawals [][]byte `protobuf:"bytes,6,rep,name=proven_withdrawals,json=provenWithdrawals,proto3" json:"proven_withdrawals,omitempty"`
 	// a list of l2 output proposals.
 	Proposals []*WrappedOutput `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// a list of batch infos.
 	BatchInfos []*BatchInfoWithOutput `protobuf:"bytes,8,rep,name=batch_infos,json=batchInfos,proto3" json:"batch_infos,omitempty"`
+	// a list of deposit caps.
+	DepositCaps []*DepositCap `protobuf:"bytes,9,rep,name=deposit_caps,json=depositCaps,proto3" json:"deposit_caps,omitempty"`
+	// a list of the deposit and withdrawal totals by denom.
+	Accountings []*BridgeAccounting `protobuf:"bytes,10,rep,name=accountings,proto3" json:"accountings,omitempty"`
+	// token pairs of the tokens originated from l2.
+	L2NativeTokenPairs []*TokenPair    `protobuf:"bytes,11,rep,name=l2_native_token_pairs,json=l2NativeTokenPairs,proto3" json:"l2_native_token_pairs,omitempty"`
+	NftClassPairs      []*NftClassPair `protobuf:"bytes,12,rep,name=nft_class_pairs,json=nftClassPairs,proto3" json:"nft_class_pairs,omitempty"`
+	// the proven withdrawals by l2 sequence.
+	ProvenWithdrawalBitmaps []*ProvenWithdrawalBitmap `protobuf:"bytes,13,rep,name=proven_withdrawal_bitmaps,json=provenWithdrawalBitmaps,proto3" json:"proven_withdrawal_bitmaps,omitempty"`
+	// the deposit commitments to be proven on l2.
+	DepositCommitments []*DepositCommitment `protobuf:"bytes,14,rep,name=deposit_commitments,json=depositCommitments,proto3" json:"deposit_commitments,omitempty"`
 }
 
 func (x *Bridge) Reset() {
@@ -2402,6 +3241,48 @@ func (x *Bridge) GetBatchInfos() []*BatchInfoWithOutput {
 	return nil
 }
 
+func (x *Bridge) GetDepositCaps() []*DepositCap {
+	if x != nil {
+		return x.DepositCaps
+	}
+	return nil
+}
+
+func (x *Bridge) GetAccountings() []*BridgeAccounting {
+	if x != nil {
+		return x.Accountings
+	}
+	return nil
+}
+
+func (x *Bridge) GetL2NativeTokenPairs() []*TokenPair {
+	if x != nil {
+		return x.L2NativeTokenPairs
+	}
+	return nil
+}
+
+func (x *Bridge) GetNftClassPairs() []*NftClassPair {
+	if x != nil {
+		return x.NftClassPairs
+	}
+	return nil
+}
+
+func (x *Bridge) GetProvenWithdrawalBitmaps() []*ProvenWithdrawalBitmap {
+	if x != nil {
+		return x.ProvenWithdrawalBitmaps
+	}
+	return nil
+}
+
+func (x *Bridge) GetDepositCommitments() []*DepositCommitment {
+	if x != nil {
+		return x.DepositCommitments
+	}
+	return nil
+}
+
 type WrappedOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2466,7 +3347,7 @@ var file_opinit_ophost_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62,
 	0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62,
 	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x6e, 0x65, 0x78, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x22, 0xe0, 0x03, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x22, 0xfd, 0x07, 0x0a,
 	0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67,
 	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64,
 	0x67, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x31, 0x5f,
@@ -2496,28 +3377,62 @@ var file_opinit_ophost_v1_genesis_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69,
 	0x74, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
-	0x80, 0x01, 0x0a, 0x0d, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12,
+	0x4a, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x43, 0x61, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x73, 0x12, 0x4f, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x59, 0x0a, 0x15,
+	0x6c, 0x32, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x51, 0x0a, 0x0f, 0x6e, 0x66, 0x74, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x61, 0x69, 0x72,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6e, 0x66, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x6f, 0x0a, 0x19, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
 	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x42, 0xc3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
-	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c,
-	0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02,
-	0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73,
-	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x73, 0x12, 0x5f, 0x0a, 0x13, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x80, 0x01, 0x0a,
+	0x0d, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x4c, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42,
+	0xc3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e,
+	0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73,
+	0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x12, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x68, 0x6f, 0x73,
+	0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2534,28 +3449,39 @@ func file_opinit_ophost_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_opinit_ophost_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_opinit_ophost_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),        // 0: opinit.ophost.v1.GenesisState
-	(*Bridge)(nil),              // 1: opinit.ophost.v1.Bridge
-	(*WrappedOutput)(nil),       // 2: opinit.ophost.v1.WrappedOutput
-	(*Params)(nil),              // 3: opinit.ophost.v1.Params
-	(*BridgeConfig)(nil),        // 4: opinit.ophost.v1.BridgeConfig
-	(*TokenPair)(nil),           // 5: opinit.ophost.v1.TokenPair
-	(*BatchInfoWithOutput)(nil), // 6: opinit.ophost.v1.BatchInfoWithOutput
-	(*Output)(nil),              // 7: opinit.ophost.v1.Output
+	(*GenesisState)(nil),           // 0: opinit.ophost.v1.GenesisState
+	(*Bridge)(nil),                 // 1: opinit.ophost.v1.Bridge
+	(*WrappedOutput)(nil),          // 2: opinit.ophost.v1.WrappedOutput
+	(*Params)(nil),                 // 3: opinit.ophost.v1.Params
+	(*BridgeConfig)(nil),           // 4: opinit.ophost.v1.BridgeConfig
+	(*TokenPair)(nil),              // 5: opinit.ophost.v1.TokenPair
+	(*BatchInfoWithOutput)(nil),    // 6: opinit.ophost.v1.BatchInfoWithOutput
+	(*DepositCap)(nil),             // 7: opinit.ophost.v1.DepositCap
+	(*BridgeAccounting)(nil),       // 8: opinit.ophost.v1.BridgeAccounting
+	(*NftClassPair)(nil),           // 9: opinit.ophost.v1.NftClassPair
+	(*ProvenWithdrawalBitmap)(nil), // 10: opinit.ophost.v1.ProvenWithdrawalBitmap
+	(*DepositCommitment)(nil),      // 11: opinit.ophost.v1.DepositCommitment
+	(*Output)(nil),                 // 12: opinit.ophost.v1.Output
 }
 var file_opinit_ophost_v1_genesis_proto_depIdxs = []int32{
-	3, // 0: opinit.ophost.v1.GenesisState.params:type_name -> opinit.ophost.v1.Params
-	1, // 1: opinit.ophost.v1.GenesisState.bridges:type_name -> opinit.ophost.v1.Bridge
-	4, // 2: opinit.ophost.v1.Bridge.bridge_config:type_name -> opinit.ophost.v1.BridgeConfig
-	5, // 3: opinit.ophost.v1.Bridge.token_pairs:type_name -> opinit.ophost.v1.TokenPair
-	2, // 4: opinit.ophost.v1.Bridge.proposals:type_name -> opinit.ophost.v1.WrappedOutput
-	6, // 5: opinit.ophost.v1.Bridge.batch_infos:type_name -> opinit.ophost.v1.BatchInfoWithOutput
-	7, // 6: opinit.ophost.v1.WrappedOutput.output_proposal:type_name -> opinit.ophost.v1.Output
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3,  // 0: opinit.ophost.v1.GenesisState.params:type_name -> opinit.ophost.v1.Params
+	1,  // 1: opinit.ophost.v1.GenesisState.bridges:type_name -> opinit.ophost.v1.Bridge
+	4,  // 2: opinit.ophost.v1.Bridge.bridge_config:type_name -> opinit.ophost.v1.BridgeConfig
+	5,  // 3: opinit.ophost.v1.Bridge.token_pairs:type_name -> opinit.ophost.v1.TokenPair
+	2,  // 4: opinit.ophost.v1.Bridge.proposals:type_name -> opinit.ophost.v1.WrappedOutput
+	6,  // 5: opinit.ophost.v1.Bridge.batch_infos:type_name -> opinit.ophost.v1.BatchInfoWithOutput
+	7,  // 6: opinit.ophost.v1.Bridge.deposit_caps:type_name -> opinit.ophost.v1.DepositCap
+	8,  // 7: opinit.ophost.v1.Bridge.accountings:type_name -> opinit.ophost.v1.BridgeAccounting
+	5,  // 8: opinit.ophost.v1.Bridge.l2_native_token_pairs:type_name -> opinit.ophost.v1.TokenPair
+	9,  // 9: opinit.ophost.v1.Bridge.nft_class_pairs:type_name -> opinit.ophost.v1.NftClassPair
+	10, // 10: opinit.ophost.v1.Bridge.proven_withdrawal_bitmaps:type_name -> opinit.ophost.v1.ProvenWithdrawalBitmap
+	11, // 11: opinit.ophost.v1.Bridge.deposit_commitments:type_name -> opinit.ophost.v1.DepositCommitment
+	12, // 12: opinit.ophost.v1.WrappedOutput.output_proposal:type_name -> opinit.ophost.v1.Output
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_opinit_ophost_v1_genesis_proto_init() }
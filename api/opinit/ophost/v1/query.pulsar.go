@@ -4840,25 +4840,27 @@ func (x *fastReflection_QueryTokenPairsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryLastFinalizedOutputRequest           protoreflect.MessageDescriptor
-	fd_QueryLastFinalizedOutputRequest_bridge_id protoreflect.FieldDescriptor
+	md_QueryL2NativeTokenPairsRequest            protoreflect.MessageDescriptor
+	fd_QueryL2NativeTokenPairsRequest_bridge_id  protoreflect.FieldDescriptor
+	fd_QueryL2NativeTokenPairsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_query_proto_init()
-	md_QueryLastFinalizedOutputRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryLastFinalizedOutputRequest")
-	fd_QueryLastFinalizedOutputRequest_bridge_id = md_QueryLastFinalizedOutputRequest.Fields().ByName("bridge_id")
+	md_QueryL2NativeTokenPairsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryL2NativeTokenPairsRequest")
+	fd_QueryL2NativeTokenPairsRequest_bridge_id = md_QueryL2NativeTokenPairsRequest.Fields().ByName("bridge_id")
+	fd_QueryL2NativeTokenPairsRequest_pagination = md_QueryL2NativeTokenPairsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLastFinalizedOutputRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryL2NativeTokenPairsRequest)(nil)
 
-type fastReflection_QueryLastFinalizedOutputRequest QueryLastFinalizedOutputRequest
+type fastReflection_QueryL2NativeTokenPairsRequest QueryL2NativeTokenPairsRequest
 
-func (x *QueryLastFinalizedOutputRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLastFinalizedOutputRequest)(x)
+func (x *QueryL2NativeTokenPairsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryL2NativeTokenPairsRequest)(x)
 }
 
-func (x *QueryLastFinalizedOutputRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryL2NativeTokenPairsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4870,43 +4872,43 @@ func (x *QueryLastFinalizedOutputRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLastFinalizedOutputRequest_messageType fastReflection_QueryLastFinalizedOutputRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLastFinalizedOutputRequest_messageType{}
+var _fastReflection_QueryL2NativeTokenPairsRequest_messageType fastReflection_QueryL2NativeTokenPairsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryL2NativeTokenPairsRequest_messageType{}
 
-type fastReflection_QueryLastFinalizedOutputRequest_messageType struct{}
+type fastReflection_QueryL2NativeTokenPairsRequest_messageType struct{}
 
-func (x fastReflection_QueryLastFinalizedOutputRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLastFinalizedOutputRequest)(nil)
+func (x fastReflection_QueryL2NativeTokenPairsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryL2NativeTokenPairsRequest)(nil)
 }
-func (x fastReflection_QueryLastFinalizedOutputRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLastFinalizedOutputRequest)
+func (x fastReflection_QueryL2NativeTokenPairsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryL2NativeTokenPairsRequest)
 }
-func (x fastReflection_QueryLastFinalizedOutputRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLastFinalizedOutputRequest
+func (x fastReflection_QueryL2NativeTokenPairsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryL2NativeTokenPairsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLastFinalizedOutputRequest
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryL2NativeTokenPairsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLastFinalizedOutputRequest_messageType
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryL2NativeTokenPairsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLastFinalizedOutputRequest)
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryL2NativeTokenPairsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLastFinalizedOutputRequest)(x)
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryL2NativeTokenPairsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4914,10 +4916,16 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.BridgeId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_QueryLastFinalizedOutputRequest_bridge_id, value) {
+		if !f(fd_QueryL2NativeTokenPairsRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryL2NativeTokenPairsRequest_pagination, value) {
 			return
 		}
 	}
@@ -4934,15 +4942,17 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.bridge_id":
 		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4952,15 +4962,17 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.bridge_id":
 		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4970,16 +4982,19 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4993,15 +5008,17 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.bridge_id":
 		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5015,40 +5032,48 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryLastFinalizedOutputRequest is not mutable"))
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryL2NativeTokenPairsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.bridge_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryLastFinalizedOutputRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryL2NativeTokenPairsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5056,7 +5081,7 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5067,7 +5092,7 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5079,7 +5104,7 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) IsValid() bool {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5089,9 +5114,9 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryL2NativeTokenPairsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLastFinalizedOutputRequest)
+		x := input.Message.Interface().(*QueryL2NativeTokenPairsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5106,6 +5131,10 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 		if x.BridgeId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BridgeId))
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5116,7 +5145,7 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLastFinalizedOutputRequest)
+		x := input.Message.Interface().(*QueryL2NativeTokenPairsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5135,6 +5164,20 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.BridgeId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
 			i--
@@ -5151,7 +5194,7 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLastFinalizedOutputRequest)
+		x := input.Message.Interface().(*QueryL2NativeTokenPairsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5183,10 +5226,10 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryL2NativeTokenPairsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryL2NativeTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5208,6 +5251,42 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5243,28 +5322,79 @@ func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoif
 	}
 }
 
+var _ protoreflect.List = (*_QueryL2NativeTokenPairsResponse_1_list)(nil)
+
+type _QueryL2NativeTokenPairsResponse_1_list struct {
+	list *[]*TokenPair
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(TokenPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(TokenPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryL2NativeTokenPairsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryLastFinalizedOutputResponse                 protoreflect.MessageDescriptor
-	fd_QueryLastFinalizedOutputResponse_output_index    protoreflect.FieldDescriptor
-	fd_QueryLastFinalizedOutputResponse_output_proposal protoreflect.FieldDescriptor
+	md_QueryL2NativeTokenPairsResponse             protoreflect.MessageDescriptor
+	fd_QueryL2NativeTokenPairsResponse_token_pairs protoreflect.FieldDescriptor
+	fd_QueryL2NativeTokenPairsResponse_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_query_proto_init()
-	md_QueryLastFinalizedOutputResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryLastFinalizedOutputResponse")
-	fd_QueryLastFinalizedOutputResponse_output_index = md_QueryLastFinalizedOutputResponse.Fields().ByName("output_index")
-	fd_QueryLastFinalizedOutputResponse_output_proposal = md_QueryLastFinalizedOutputResponse.Fields().ByName("output_proposal")
+	md_QueryL2NativeTokenPairsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryL2NativeTokenPairsResponse")
+	fd_QueryL2NativeTokenPairsResponse_token_pairs = md_QueryL2NativeTokenPairsResponse.Fields().ByName("token_pairs")
+	fd_QueryL2NativeTokenPairsResponse_pagination = md_QueryL2NativeTokenPairsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLastFinalizedOutputResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryL2NativeTokenPairsResponse)(nil)
 
-type fastReflection_QueryLastFinalizedOutputResponse QueryLastFinalizedOutputResponse
+type fastReflection_QueryL2NativeTokenPairsResponse QueryL2NativeTokenPairsResponse
 
-func (x *QueryLastFinalizedOutputResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLastFinalizedOutputResponse)(x)
+func (x *QueryL2NativeTokenPairsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryL2NativeTokenPairsResponse)(x)
 }
 
-func (x *QueryLastFinalizedOutputResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryL2NativeTokenPairsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5276,43 +5406,43 @@ func (x *QueryLastFinalizedOutputResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLastFinalizedOutputResponse_messageType fastReflection_QueryLastFinalizedOutputResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLastFinalizedOutputResponse_messageType{}
+var _fastReflection_QueryL2NativeTokenPairsResponse_messageType fastReflection_QueryL2NativeTokenPairsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryL2NativeTokenPairsResponse_messageType{}
 
-type fastReflection_QueryLastFinalizedOutputResponse_messageType struct{}
+type fastReflection_QueryL2NativeTokenPairsResponse_messageType struct{}
 
-func (x fastReflection_QueryLastFinalizedOutputResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLastFinalizedOutputResponse)(nil)
+func (x fastReflection_QueryL2NativeTokenPairsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryL2NativeTokenPairsResponse)(nil)
 }
-func (x fastReflection_QueryLastFinalizedOutputResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLastFinalizedOutputResponse)
+func (x fastReflection_QueryL2NativeTokenPairsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryL2NativeTokenPairsResponse)
 }
-func (x fastReflection_QueryLastFinalizedOutputResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLastFinalizedOutputResponse
+func (x fastReflection_QueryL2NativeTokenPairsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryL2NativeTokenPairsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLastFinalizedOutputResponse
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryL2NativeTokenPairsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLastFinalizedOutputResponse_messageType
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryL2NativeTokenPairsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLastFinalizedOutputResponse)
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryL2NativeTokenPairsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLastFinalizedOutputResponse)(x)
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryL2NativeTokenPairsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5320,16 +5450,16 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_QueryLastFinalizedOutputResponse_output_index, value) {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TokenPairs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryL2NativeTokenPairsResponse_1_list{list: &x.TokenPairs})
+		if !f(fd_QueryL2NativeTokenPairsResponse_token_pairs, value) {
 			return
 		}
 	}
-	if x.OutputProposal != nil {
-		value := protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
-		if !f(fd_QueryLastFinalizedOutputResponse_output_proposal, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryL2NativeTokenPairsResponse_pagination, value) {
 			return
 		}
 	}
@@ -5346,17 +5476,17 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
-		return x.OutputProposal != nil
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs":
+		return len(x.TokenPairs) != 0
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5366,17 +5496,17 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
-		x.OutputProposal = nil
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs":
+		x.TokenPairs = nil
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5386,19 +5516,22 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
-		value := x.OutputProposal
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs":
+		if len(x.TokenPairs) == 0 {
+			return protoreflect.ValueOfList(&_QueryL2NativeTokenPairsResponse_1_list{})
+		}
+		listValue := &_QueryL2NativeTokenPairsResponse_1_list{list: &x.TokenPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5412,17 +5545,19 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
-		x.OutputProposal = value.Message().Interface().(*Output)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs":
+		lv := value.List()
+		clv := lv.(*_QueryL2NativeTokenPairsResponse_1_list)
+		x.TokenPairs = *clv.list
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5436,48 +5571,53 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
-		if x.OutputProposal == nil {
-			x.OutputProposal = new(Output)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs":
+		if x.TokenPairs == nil {
+			x.TokenPairs = []*TokenPair{}
 		}
-		return protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryLastFinalizedOutputResponse is not mutable"))
+		value := &_QueryL2NativeTokenPairsResponse_1_list{list: &x.TokenPairs}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
-		m := new(Output)
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs":
+		list := []*TokenPair{}
+		return protoreflect.ValueOfList(&_QueryL2NativeTokenPairsResponse_1_list{list: &list})
+	case "opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryL2NativeTokenPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryL2NativeTokenPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryLastFinalizedOutputResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryL2NativeTokenPairsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5485,7 +5625,7 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5496,7 +5636,7 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5508,7 +5648,7 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) IsValid() bool {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5518,9 +5658,9 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryL2NativeTokenPairsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLastFinalizedOutputResponse)
+		x := input.Message.Interface().(*QueryL2NativeTokenPairsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5532,11 +5672,14 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		if len(x.TokenPairs) > 0 {
+			for _, e := range x.TokenPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.OutputProposal != nil {
-			l = options.Size(x.OutputProposal)
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5549,7 +5692,7 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLastFinalizedOutputResponse)
+		x := input.Message.Interface().(*QueryL2NativeTokenPairsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5568,8 +5711,8 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OutputProposal != nil {
-			encoded, err := options.Marshal(x.OutputProposal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5582,10 +5725,21 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
-			i--
-			dAtA[i] = 0x8
+		if len(x.TokenPairs) > 0 {
+			for iNdEx := len(x.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5598,7 +5752,7 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLastFinalizedOutputResponse)
+		x := input.Message.Interface().(*QueryL2NativeTokenPairsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5630,17 +5784,17 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryL2NativeTokenPairsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryL2NativeTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
 				}
-				x.OutputIndex = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5650,14 +5804,29 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenPairs = append(x.TokenPairs, &TokenPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenPairs[len(x.TokenPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5684,10 +5853,10 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.OutputProposal == nil {
-					x.OutputProposal = &Output{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutputProposal); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5727,27 +5896,27 @@ func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_QueryOutputProposalRequest              protoreflect.MessageDescriptor
-	fd_QueryOutputProposalRequest_bridge_id    protoreflect.FieldDescriptor
-	fd_QueryOutputProposalRequest_output_index protoreflect.FieldDescriptor
+	md_QueryNftClassPairsRequest            protoreflect.MessageDescriptor
+	fd_QueryNftClassPairsRequest_bridge_id  protoreflect.FieldDescriptor
+	fd_QueryNftClassPairsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_query_proto_init()
-	md_QueryOutputProposalRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalRequest")
-	fd_QueryOutputProposalRequest_bridge_id = md_QueryOutputProposalRequest.Fields().ByName("bridge_id")
-	fd_QueryOutputProposalRequest_output_index = md_QueryOutputProposalRequest.Fields().ByName("output_index")
+	md_QueryNftClassPairsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryNftClassPairsRequest")
+	fd_QueryNftClassPairsRequest_bridge_id = md_QueryNftClassPairsRequest.Fields().ByName("bridge_id")
+	fd_QueryNftClassPairsRequest_pagination = md_QueryNftClassPairsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOutputProposalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryNftClassPairsRequest)(nil)
 
-type fastReflection_QueryOutputProposalRequest QueryOutputProposalRequest
+type fastReflection_QueryNftClassPairsRequest QueryNftClassPairsRequest
 
-func (x *QueryOutputProposalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalRequest)(x)
+func (x *QueryNftClassPairsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryNftClassPairsRequest)(x)
 }
 
-func (x *QueryOutputProposalRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryNftClassPairsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5759,43 +5928,43 @@ func (x *QueryOutputProposalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutputProposalRequest_messageType fastReflection_QueryOutputProposalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutputProposalRequest_messageType{}
+var _fastReflection_QueryNftClassPairsRequest_messageType fastReflection_QueryNftClassPairsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryNftClassPairsRequest_messageType{}
 
-type fastReflection_QueryOutputProposalRequest_messageType struct{}
+type fastReflection_QueryNftClassPairsRequest_messageType struct{}
 
-func (x fastReflection_QueryOutputProposalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalRequest)(nil)
+func (x fastReflection_QueryNftClassPairsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryNftClassPairsRequest)(nil)
 }
-func (x fastReflection_QueryOutputProposalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalRequest)
+func (x fastReflection_QueryNftClassPairsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryNftClassPairsRequest)
 }
-func (x fastReflection_QueryOutputProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalRequest
+func (x fastReflection_QueryNftClassPairsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNftClassPairsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutputProposalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalRequest
+func (x *fastReflection_QueryNftClassPairsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNftClassPairsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutputProposalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutputProposalRequest_messageType
+func (x *fastReflection_QueryNftClassPairsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryNftClassPairsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutputProposalRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalRequest)
+func (x *fastReflection_QueryNftClassPairsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryNftClassPairsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutputProposalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutputProposalRequest)(x)
+func (x *fastReflection_QueryNftClassPairsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryNftClassPairsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5803,16 +5972,16 @@ func (x *fastReflection_QueryOutputProposalRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutputProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryNftClassPairsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.BridgeId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_QueryOutputProposalRequest_bridge_id, value) {
+		if !f(fd_QueryNftClassPairsRequest_bridge_id, value) {
 			return
 		}
 	}
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_QueryOutputProposalRequest_output_index, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryNftClassPairsRequest_pagination, value) {
 			return
 		}
 	}
@@ -5829,17 +5998,17 @@ func (x *fastReflection_QueryOutputProposalRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutputProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryNftClassPairsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.bridge_id":
 		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
-		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5849,17 +6018,17 @@ func (x *fastReflection_QueryOutputProposalRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryNftClassPairsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.bridge_id":
 		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
-		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5869,19 +6038,19 @@ func (x *fastReflection_QueryOutputProposalRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutputProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryNftClassPairsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5895,17 +6064,17 @@ func (x *fastReflection_QueryOutputProposalRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryNftClassPairsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.bridge_id":
 		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
-		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5919,44 +6088,48 @@ func (x *fastReflection_QueryOutputProposalRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryNftClassPairsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryOutputProposalRequest is not mutable"))
-	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryOutputProposalRequest is not mutable"))
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryNftClassPairsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutputProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryNftClassPairsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.bridge_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryNftClassPairsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutputProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryNftClassPairsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryNftClassPairsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5964,7 +6137,7 @@ func (x *fastReflection_QueryOutputProposalRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutputProposalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryNftClassPairsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5975,7 +6148,7 @@ func (x *fastReflection_QueryOutputProposalRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryNftClassPairsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5987,7 +6160,7 @@ func (x *fastReflection_QueryOutputProposalRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutputProposalRequest) IsValid() bool {
+func (x *fastReflection_QueryNftClassPairsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5997,9 +6170,9 @@ func (x *fastReflection_QueryOutputProposalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryNftClassPairsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutputProposalRequest)
+		x := input.Message.Interface().(*QueryNftClassPairsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6014,8 +6187,9 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 		if x.BridgeId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BridgeId))
 		}
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6027,7 +6201,7 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalRequest)
+		x := input.Message.Interface().(*QueryNftClassPairsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6046,10 +6220,19 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if x.BridgeId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
@@ -6067,7 +6250,7 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalRequest)
+		x := input.Message.Interface().(*QueryNftClassPairsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6099,10 +6282,10 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNftClassPairsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNftClassPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6125,10 +6308,10 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.OutputIndex = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6138,11 +6321,28 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6178,30 +6378,79 @@ func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.M
 	}
 }
 
-var (
-	md_QueryOutputProposalResponse                 protoreflect.MessageDescriptor
-	fd_QueryOutputProposalResponse_bridge_id       protoreflect.FieldDescriptor
-	fd_QueryOutputProposalResponse_output_index    protoreflect.FieldDescriptor
-	fd_QueryOutputProposalResponse_output_proposal protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryNftClassPairsResponse_1_list)(nil)
 
-func init() {
-	file_opinit_ophost_v1_query_proto_init()
-	md_QueryOutputProposalResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalResponse")
-	fd_QueryOutputProposalResponse_bridge_id = md_QueryOutputProposalResponse.Fields().ByName("bridge_id")
-	fd_QueryOutputProposalResponse_output_index = md_QueryOutputProposalResponse.Fields().ByName("output_index")
-	fd_QueryOutputProposalResponse_output_proposal = md_QueryOutputProposalResponse.Fields().ByName("output_proposal")
+type _QueryNftClassPairsResponse_1_list struct {
+	list *[]*NftClassPair
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOutputProposalResponse)(nil)
+func (x *_QueryNftClassPairsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_QueryOutputProposalResponse QueryOutputProposalResponse
+func (x *_QueryNftClassPairsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *QueryOutputProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalResponse)(x)
+func (x *_QueryNftClassPairsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NftClassPair)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *QueryOutputProposalResponse) slowProtoReflect() protoreflect.Message {
+func (x *_QueryNftClassPairsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NftClassPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryNftClassPairsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(NftClassPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryNftClassPairsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryNftClassPairsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(NftClassPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryNftClassPairsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryNftClassPairsResponse             protoreflect.MessageDescriptor
+	fd_QueryNftClassPairsResponse_class_pairs protoreflect.FieldDescriptor
+	fd_QueryNftClassPairsResponse_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryNftClassPairsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryNftClassPairsResponse")
+	fd_QueryNftClassPairsResponse_class_pairs = md_QueryNftClassPairsResponse.Fields().ByName("class_pairs")
+	fd_QueryNftClassPairsResponse_pagination = md_QueryNftClassPairsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryNftClassPairsResponse)(nil)
+
+type fastReflection_QueryNftClassPairsResponse QueryNftClassPairsResponse
+
+func (x *QueryNftClassPairsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryNftClassPairsResponse)(x)
+}
+
+func (x *QueryNftClassPairsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6213,43 +6462,43 @@ func (x *QueryOutputProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutputProposalResponse_messageType fastReflection_QueryOutputProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutputProposalResponse_messageType{}
+var _fastReflection_QueryNftClassPairsResponse_messageType fastReflection_QueryNftClassPairsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryNftClassPairsResponse_messageType{}
 
-type fastReflection_QueryOutputProposalResponse_messageType struct{}
+type fastReflection_QueryNftClassPairsResponse_messageType struct{}
 
-func (x fastReflection_QueryOutputProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalResponse)(nil)
+func (x fastReflection_QueryNftClassPairsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryNftClassPairsResponse)(nil)
 }
-func (x fastReflection_QueryOutputProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalResponse)
+func (x fastReflection_QueryNftClassPairsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryNftClassPairsResponse)
 }
-func (x fastReflection_QueryOutputProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalResponse
+func (x fastReflection_QueryNftClassPairsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNftClassPairsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutputProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalResponse
+func (x *fastReflection_QueryNftClassPairsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNftClassPairsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutputProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutputProposalResponse_messageType
+func (x *fastReflection_QueryNftClassPairsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryNftClassPairsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutputProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalResponse)
+func (x *fastReflection_QueryNftClassPairsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryNftClassPairsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutputProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutputProposalResponse)(x)
+func (x *fastReflection_QueryNftClassPairsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryNftClassPairsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6257,22 +6506,16 @@ func (x *fastReflection_QueryOutputProposalResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutputProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BridgeId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_QueryOutputProposalResponse_bridge_id, value) {
-			return
-		}
-	}
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_QueryOutputProposalResponse_output_index, value) {
+func (x *fastReflection_QueryNftClassPairsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ClassPairs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryNftClassPairsResponse_1_list{list: &x.ClassPairs})
+		if !f(fd_QueryNftClassPairsResponse_class_pairs, value) {
 			return
 		}
 	}
-	if x.OutputProposal != nil {
-		value := protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
-		if !f(fd_QueryOutputProposalResponse_output_proposal, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryNftClassPairsResponse_pagination, value) {
 			return
 		}
 	}
@@ -6289,19 +6532,17 @@ func (x *fastReflection_QueryOutputProposalResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutputProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryNftClassPairsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
-		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
-		return x.OutputProposal != nil
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs":
+		return len(x.ClassPairs) != 0
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6311,19 +6552,17 @@ func (x *fastReflection_QueryOutputProposalResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryNftClassPairsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
-		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
-		x.OutputProposal = nil
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs":
+		x.ClassPairs = nil
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6333,22 +6572,22 @@ func (x *fastReflection_QueryOutputProposalResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutputProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryNftClassPairsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
-		value := x.BridgeId
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
-		value := x.OutputProposal
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs":
+		if len(x.ClassPairs) == 0 {
+			return protoreflect.ValueOfList(&_QueryNftClassPairsResponse_1_list{})
+		}
+		listValue := &_QueryNftClassPairsResponse_1_list{list: &x.ClassPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6362,19 +6601,19 @@ func (x *fastReflection_QueryOutputProposalResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryNftClassPairsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
-		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
-		x.OutputProposal = value.Message().Interface().(*Output)
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs":
+		lv := value.List()
+		clv := lv.(*_QueryNftClassPairsResponse_1_list)
+		x.ClassPairs = *clv.list
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6388,52 +6627,53 @@ func (x *fastReflection_QueryOutputProposalResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryNftClassPairsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
-		if x.OutputProposal == nil {
-			x.OutputProposal = new(Output)
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs":
+		if x.ClassPairs == nil {
+			x.ClassPairs = []*NftClassPair{}
 		}
-		return protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
-	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryOutputProposalResponse is not mutable"))
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryOutputProposalResponse is not mutable"))
+		value := &_QueryNftClassPairsResponse_1_list{list: &x.ClassPairs}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutputProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryNftClassPairsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
-		m := new(Output)
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs":
+		list := []*NftClassPair{}
+		return protoreflect.ValueOfList(&_QueryNftClassPairsResponse_1_list{list: &list})
+	case "opinit.ophost.v1.QueryNftClassPairsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryNftClassPairsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryNftClassPairsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutputProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryNftClassPairsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryNftClassPairsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6441,7 +6681,7 @@ func (x *fastReflection_QueryOutputProposalResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutputProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryNftClassPairsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6452,7 +6692,7 @@ func (x *fastReflection_QueryOutputProposalResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryNftClassPairsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6464,7 +6704,7 @@ func (x *fastReflection_QueryOutputProposalResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutputProposalResponse) IsValid() bool {
+func (x *fastReflection_QueryNftClassPairsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6474,9 +6714,9 @@ func (x *fastReflection_QueryOutputProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryNftClassPairsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutputProposalResponse)
+		x := input.Message.Interface().(*QueryNftClassPairsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6488,14 +6728,14 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.BridgeId != 0 {
-			n += 1 + runtime.Sov(uint64(x.BridgeId))
-		}
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		if len(x.ClassPairs) > 0 {
+			for _, e := range x.ClassPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.OutputProposal != nil {
-			l = options.Size(x.OutputProposal)
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6508,7 +6748,7 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalResponse)
+		x := input.Message.Interface().(*QueryNftClassPairsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6527,8 +6767,8 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OutputProposal != nil {
-			encoded, err := options.Marshal(x.OutputProposal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6539,17 +6779,23 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
-			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.BridgeId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
-			i--
-			dAtA[i] = 0x8
+		if len(x.ClassPairs) > 0 {
+			for iNdEx := len(x.ClassPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ClassPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6562,7 +6808,7 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalResponse)
+		x := input.Message.Interface().(*QueryNftClassPairsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6594,17 +6840,17 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNftClassPairsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNftClassPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassPairs", wireType)
 				}
-				x.BridgeId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6614,33 +6860,29 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BridgeId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.OutputIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 3:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassPairs = append(x.ClassPairs, &NftClassPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClassPairs[len(x.ClassPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6667,10 +6909,10 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.OutputProposal == nil {
-					x.OutputProposal = &Output{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutputProposal); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6710,29 +6952,25 @@ func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_QueryOutputProposalsRequest              protoreflect.MessageDescriptor
-	fd_QueryOutputProposalsRequest_bridge_id    protoreflect.FieldDescriptor
-	fd_QueryOutputProposalsRequest_output_index protoreflect.FieldDescriptor
-	fd_QueryOutputProposalsRequest_pagination   protoreflect.FieldDescriptor
+	md_QueryLastFinalizedOutputRequest           protoreflect.MessageDescriptor
+	fd_QueryLastFinalizedOutputRequest_bridge_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_query_proto_init()
-	md_QueryOutputProposalsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalsRequest")
-	fd_QueryOutputProposalsRequest_bridge_id = md_QueryOutputProposalsRequest.Fields().ByName("bridge_id")
-	fd_QueryOutputProposalsRequest_output_index = md_QueryOutputProposalsRequest.Fields().ByName("output_index")
-	fd_QueryOutputProposalsRequest_pagination = md_QueryOutputProposalsRequest.Fields().ByName("pagination")
+	md_QueryLastFinalizedOutputRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryLastFinalizedOutputRequest")
+	fd_QueryLastFinalizedOutputRequest_bridge_id = md_QueryLastFinalizedOutputRequest.Fields().ByName("bridge_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOutputProposalsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLastFinalizedOutputRequest)(nil)
 
-type fastReflection_QueryOutputProposalsRequest QueryOutputProposalsRequest
+type fastReflection_QueryLastFinalizedOutputRequest QueryLastFinalizedOutputRequest
 
-func (x *QueryOutputProposalsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalsRequest)(x)
-}
+func (x *QueryLastFinalizedOutputRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLastFinalizedOutputRequest)(x)
+}
 
-func (x *QueryOutputProposalsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryLastFinalizedOutputRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6744,43 +6982,43 @@ func (x *QueryOutputProposalsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutputProposalsRequest_messageType fastReflection_QueryOutputProposalsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutputProposalsRequest_messageType{}
+var _fastReflection_QueryLastFinalizedOutputRequest_messageType fastReflection_QueryLastFinalizedOutputRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLastFinalizedOutputRequest_messageType{}
 
-type fastReflection_QueryOutputProposalsRequest_messageType struct{}
+type fastReflection_QueryLastFinalizedOutputRequest_messageType struct{}
 
-func (x fastReflection_QueryOutputProposalsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalsRequest)(nil)
+func (x fastReflection_QueryLastFinalizedOutputRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLastFinalizedOutputRequest)(nil)
 }
-func (x fastReflection_QueryOutputProposalsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalsRequest)
+func (x fastReflection_QueryLastFinalizedOutputRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLastFinalizedOutputRequest)
 }
-func (x fastReflection_QueryOutputProposalsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalsRequest
+func (x fastReflection_QueryLastFinalizedOutputRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLastFinalizedOutputRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutputProposalsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalsRequest
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLastFinalizedOutputRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutputProposalsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutputProposalsRequest_messageType
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLastFinalizedOutputRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutputProposalsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalsRequest)
+func (x *fastReflection_QueryLastFinalizedOutputRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLastFinalizedOutputRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutputProposalsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutputProposalsRequest)(x)
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLastFinalizedOutputRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6788,22 +7026,10 @@ func (x *fastReflection_QueryOutputProposalsRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutputProposalsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.BridgeId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_QueryOutputProposalsRequest_bridge_id, value) {
-			return
-		}
-	}
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_QueryOutputProposalsRequest_output_index, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryOutputProposalsRequest_pagination, value) {
+		if !f(fd_QueryLastFinalizedOutputRequest_bridge_id, value) {
 			return
 		}
 	}
@@ -6820,19 +7046,15 @@ func (x *fastReflection_QueryOutputProposalsRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutputProposalsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
 		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6842,19 +7064,15 @@ func (x *fastReflection_QueryOutputProposalsRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
 		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6864,22 +7082,16 @@ func (x *fastReflection_QueryOutputProposalsRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutputProposalsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6893,19 +7105,15 @@ func (x *fastReflection_QueryOutputProposalsRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
 		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6919,52 +7127,40 @@ func (x *fastReflection_QueryOutputProposalsRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryOutputProposalsRequest is not mutable"))
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryOutputProposalsRequest is not mutable"))
+	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryLastFinalizedOutputRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutputProposalsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+	case "opinit.ophost.v1.QueryLastFinalizedOutputRequest.bridge_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutputProposalsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryLastFinalizedOutputRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6972,7 +7168,7 @@ func (x *fastReflection_QueryOutputProposalsRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutputProposalsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6983,7 +7179,7 @@ func (x *fastReflection_QueryOutputProposalsRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6995,7 +7191,7 @@ func (x *fastReflection_QueryOutputProposalsRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutputProposalsRequest) IsValid() bool {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7005,9 +7201,9 @@ func (x *fastReflection_QueryOutputProposalsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLastFinalizedOutputRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutputProposalsRequest)
+		x := input.Message.Interface().(*QueryLastFinalizedOutputRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7022,13 +7218,6 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 		if x.BridgeId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BridgeId))
 		}
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7039,7 +7228,7 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalsRequest)
+		x := input.Message.Interface().(*QueryLastFinalizedOutputRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7058,25 +7247,6 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
-			i--
-			dAtA[i] = 0x10
-		}
 		if x.BridgeId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
 			i--
@@ -7093,7 +7263,7 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalsRequest)
+		x := input.Message.Interface().(*QueryLastFinalizedOutputRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7125,10 +7295,10 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7150,61 +7320,6 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 						break
 					}
 				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-				}
-				x.OutputIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7240,79 +7355,28 @@ func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryOutputProposalsResponse_1_list)(nil)
+var (
+	md_QueryLastFinalizedOutputResponse                 protoreflect.MessageDescriptor
+	fd_QueryLastFinalizedOutputResponse_output_index    protoreflect.FieldDescriptor
+	fd_QueryLastFinalizedOutputResponse_output_proposal protoreflect.FieldDescriptor
+)
 
-type _QueryOutputProposalsResponse_1_list struct {
-	list *[]*QueryOutputProposalResponse
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryLastFinalizedOutputResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryLastFinalizedOutputResponse")
+	fd_QueryLastFinalizedOutputResponse_output_index = md_QueryLastFinalizedOutputResponse.Fields().ByName("output_index")
+	fd_QueryLastFinalizedOutputResponse_output_proposal = md_QueryLastFinalizedOutputResponse.Fields().ByName("output_proposal")
 }
 
-func (x *_QueryOutputProposalsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*QueryOutputProposalResponse)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*QueryOutputProposalResponse)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(QueryOutputProposalResponse)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(QueryOutputProposalResponse)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryOutputProposalsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryOutputProposalsResponse                  protoreflect.MessageDescriptor
-	fd_QueryOutputProposalsResponse_output_proposals protoreflect.FieldDescriptor
-	fd_QueryOutputProposalsResponse_pagination       protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_opinit_ophost_v1_query_proto_init()
-	md_QueryOutputProposalsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalsResponse")
-	fd_QueryOutputProposalsResponse_output_proposals = md_QueryOutputProposalsResponse.Fields().ByName("output_proposals")
-	fd_QueryOutputProposalsResponse_pagination = md_QueryOutputProposalsResponse.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryOutputProposalsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLastFinalizedOutputResponse)(nil)
 
-type fastReflection_QueryOutputProposalsResponse QueryOutputProposalsResponse
+type fastReflection_QueryLastFinalizedOutputResponse QueryLastFinalizedOutputResponse
 
-func (x *QueryOutputProposalsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalsResponse)(x)
+func (x *QueryLastFinalizedOutputResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLastFinalizedOutputResponse)(x)
 }
 
-func (x *QueryOutputProposalsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryLastFinalizedOutputResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7324,43 +7388,43 @@ func (x *QueryOutputProposalsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutputProposalsResponse_messageType fastReflection_QueryOutputProposalsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutputProposalsResponse_messageType{}
+var _fastReflection_QueryLastFinalizedOutputResponse_messageType fastReflection_QueryLastFinalizedOutputResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLastFinalizedOutputResponse_messageType{}
 
-type fastReflection_QueryOutputProposalsResponse_messageType struct{}
+type fastReflection_QueryLastFinalizedOutputResponse_messageType struct{}
 
-func (x fastReflection_QueryOutputProposalsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutputProposalsResponse)(nil)
+func (x fastReflection_QueryLastFinalizedOutputResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLastFinalizedOutputResponse)(nil)
 }
-func (x fastReflection_QueryOutputProposalsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalsResponse)
+func (x fastReflection_QueryLastFinalizedOutputResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLastFinalizedOutputResponse)
 }
-func (x fastReflection_QueryOutputProposalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalsResponse
+func (x fastReflection_QueryLastFinalizedOutputResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLastFinalizedOutputResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutputProposalsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutputProposalsResponse
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLastFinalizedOutputResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutputProposalsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutputProposalsResponse_messageType
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLastFinalizedOutputResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutputProposalsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOutputProposalsResponse)
+func (x *fastReflection_QueryLastFinalizedOutputResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLastFinalizedOutputResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutputProposalsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutputProposalsResponse)(x)
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLastFinalizedOutputResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7368,16 +7432,16 @@ func (x *fastReflection_QueryOutputProposalsResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutputProposalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.OutputProposals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryOutputProposalsResponse_1_list{list: &x.OutputProposals})
-		if !f(fd_QueryOutputProposalsResponse_output_proposals, value) {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_QueryLastFinalizedOutputResponse_output_index, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryOutputProposalsResponse_pagination, value) {
+	if x.OutputProposal != nil {
+		value := protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
+		if !f(fd_QueryLastFinalizedOutputResponse_output_proposal, value) {
 			return
 		}
 	}
@@ -7394,17 +7458,17 @@ func (x *fastReflection_QueryOutputProposalsResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutputProposalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
-		return len(x.OutputProposals) != 0
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
-		return x.Pagination != nil
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
+		return x.OutputProposal != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7414,17 +7478,17 @@ func (x *fastReflection_QueryOutputProposalsResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
-		x.OutputProposals = nil
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
-		x.Pagination = nil
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
+		x.OutputProposal = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7434,22 +7498,19 @@ func (x *fastReflection_QueryOutputProposalsResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutputProposalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
-		if len(x.OutputProposals) == 0 {
-			return protoreflect.ValueOfList(&_QueryOutputProposalsResponse_1_list{})
-		}
-		listValue := &_QueryOutputProposalsResponse_1_list{list: &x.OutputProposals}
-		return protoreflect.ValueOfList(listValue)
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
-		value := x.Pagination
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
+		value := x.OutputProposal
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7463,19 +7524,17 @@ func (x *fastReflection_QueryOutputProposalsResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
-		lv := value.List()
-		clv := lv.(*_QueryOutputProposalsResponse_1_list)
-		x.OutputProposals = *clv.list
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
+		x.OutputProposal = value.Message().Interface().(*Output)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7489,53 +7548,48 @@ func (x *fastReflection_QueryOutputProposalsResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
-		if x.OutputProposals == nil {
-			x.OutputProposals = []*QueryOutputProposalResponse{}
-		}
-		value := &_QueryOutputProposalsResponse_1_list{list: &x.OutputProposals}
-		return protoreflect.ValueOfList(value)
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
+		if x.OutputProposal == nil {
+			x.OutputProposal = new(Output)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryLastFinalizedOutputResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutputProposalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
-		list := []*QueryOutputProposalResponse{}
-		return protoreflect.ValueOfList(&_QueryOutputProposalsResponse_1_list{list: &list})
-	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal":
+		m := new(Output)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryLastFinalizedOutputResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryLastFinalizedOutputResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutputProposalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryLastFinalizedOutputResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7543,7 +7597,7 @@ func (x *fastReflection_QueryOutputProposalsResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutputProposalsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7554,7 +7608,7 @@ func (x *fastReflection_QueryOutputProposalsResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutputProposalsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7566,7 +7620,7 @@ func (x *fastReflection_QueryOutputProposalsResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutputProposalsResponse) IsValid() bool {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7576,9 +7630,9 @@ func (x *fastReflection_QueryOutputProposalsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLastFinalizedOutputResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutputProposalsResponse)
+		x := input.Message.Interface().(*QueryLastFinalizedOutputResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7590,14 +7644,11 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if len(x.OutputProposals) > 0 {
-			for _, e := range x.OutputProposals {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.OutputProposal != nil {
+			l = options.Size(x.OutputProposal)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7610,7 +7661,7 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalsResponse)
+		x := input.Message.Interface().(*QueryLastFinalizedOutputResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7629,8 +7680,8 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.OutputProposal != nil {
+			encoded, err := options.Marshal(x.OutputProposal)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7643,21 +7694,10 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.OutputProposals) > 0 {
-			for iNdEx := len(x.OutputProposals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.OutputProposals[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7670,7 +7710,7 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutputProposalsResponse)
+		x := input.Message.Interface().(*QueryLastFinalizedOutputResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7702,17 +7742,17 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLastFinalizedOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputProposals", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 				}
-				var msglen int
+				x.OutputIndex = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7722,29 +7762,14 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.OutputIndex |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OutputProposals = append(x.OutputProposals, &QueryOutputProposalResponse{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutputProposals[len(x.OutputProposals)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7771,10 +7796,10 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.OutputProposal == nil {
+					x.OutputProposal = &Output{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutputProposal); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7814,23 +7839,27 @@ func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryOutputProposalRequest              protoreflect.MessageDescriptor
+	fd_QueryOutputProposalRequest_bridge_id    protoreflect.FieldDescriptor
+	fd_QueryOutputProposalRequest_output_index protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_query_proto_init()
-	md_QueryParamsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryOutputProposalRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalRequest")
+	fd_QueryOutputProposalRequest_bridge_id = md_QueryOutputProposalRequest.Fields().ByName("bridge_id")
+	fd_QueryOutputProposalRequest_output_index = md_QueryOutputProposalRequest.Fields().ByName("output_index")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOutputProposalRequest)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryOutputProposalRequest QueryOutputProposalRequest
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryOutputProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalRequest)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOutputProposalRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7842,43 +7871,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryOutputProposalRequest_messageType fastReflection_QueryOutputProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutputProposalRequest_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryOutputProposalRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryOutputProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalRequest)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryOutputProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalRequest)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryOutputProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryOutputProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryOutputProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutputProposalRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryOutputProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryOutputProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutputProposalRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7886,7 +7915,19 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryOutputProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryOutputProposalRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_QueryOutputProposalRequest_output_index, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7900,13 +7941,17 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOutputProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+		return x.OutputIndex != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7916,13 +7961,17 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOutputProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+		x.OutputIndex = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7932,13 +7981,19 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutputProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7952,13 +8007,17 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOutputProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+		x.OutputIndex = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7972,36 +8031,44 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutputProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryOutputProposalRequest is not mutable"))
+	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryOutputProposalRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutputProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalRequest.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryOutputProposalRequest.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalRequest"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOutputProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8009,7 +8076,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOutputProposalRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8020,7 +8087,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOutputProposalRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8032,7 +8099,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryOutputProposalRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8042,9 +8109,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOutputProposalRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryOutputProposalRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8056,6 +8123,12 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8066,7 +8139,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryOutputProposalRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8085,6 +8158,16 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8096,7 +8179,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryOutputProposalRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8128,12 +8211,50 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8170,25 +8291,29 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryOutputProposalResponse                 protoreflect.MessageDescriptor
+	fd_QueryOutputProposalResponse_bridge_id       protoreflect.FieldDescriptor
+	fd_QueryOutputProposalResponse_output_index    protoreflect.FieldDescriptor
+	fd_QueryOutputProposalResponse_output_proposal protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_query_proto_init()
-	md_QueryParamsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+	md_QueryOutputProposalResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalResponse")
+	fd_QueryOutputProposalResponse_bridge_id = md_QueryOutputProposalResponse.Fields().ByName("bridge_id")
+	fd_QueryOutputProposalResponse_output_index = md_QueryOutputProposalResponse.Fields().ByName("output_index")
+	fd_QueryOutputProposalResponse_output_proposal = md_QueryOutputProposalResponse.Fields().ByName("output_proposal")
+}
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+var _ protoreflect.Message = (*fastReflection_QueryOutputProposalResponse)(nil)
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+type fastReflection_QueryOutputProposalResponse QueryOutputProposalResponse
+
+func (x *QueryOutputProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalResponse)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryOutputProposalResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8200,43 +8325,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryOutputProposalResponse_messageType fastReflection_QueryOutputProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutputProposalResponse_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryOutputProposalResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryOutputProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalResponse)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryOutputProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalResponse)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryOutputProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryOutputProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryOutputProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutputProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryOutputProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryOutputProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutputProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8244,10 +8369,22 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryOutputProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryOutputProposalResponse_bridge_id, value) {
+			return
+		}
+	}
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_QueryOutputProposalResponse_output_index, value) {
+			return
+		}
+	}
+	if x.OutputProposal != nil {
+		value := protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
+		if !f(fd_QueryOutputProposalResponse_output_proposal, value) {
 			return
 		}
 	}
@@ -8264,15 +8401,19 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOutputProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
+		return x.OutputProposal != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8282,15 +8423,19 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOutputProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
+		x.OutputProposal = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8300,16 +8445,22 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutputProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
+		value := x.OutputProposal
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8323,15 +8474,19 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOutputProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
+		x.OutputProposal = value.Message().Interface().(*Output)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8345,44 +8500,52 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutputProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
+		if x.OutputProposal == nil {
+			x.OutputProposal = new(Output)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.OutputProposal.ProtoReflect())
+	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryOutputProposalResponse is not mutable"))
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryOutputProposalResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutputProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "opinit.ophost.v1.QueryOutputProposalResponse.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryOutputProposalResponse.output_proposal":
+		m := new(Output)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOutputProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8390,7 +8553,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOutputProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8401,7 +8564,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOutputProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8413,7 +8576,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryOutputProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8423,9 +8586,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOutputProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryOutputProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8437,8 +8600,14 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if x.OutputProposal != nil {
+			l = options.Size(x.OutputProposal)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8451,7 +8620,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryOutputProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8470,8 +8639,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.OutputProposal != nil {
+			encoded, err := options.Marshal(x.OutputProposal)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8482,7 +8651,17 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8495,7 +8674,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryOutputProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8527,15 +8706,53 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8562,10 +8779,10 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.OutputProposal == nil {
+					x.OutputProposal = &Output{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutputProposal); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8604,705 +8821,7257 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
+var (
+	md_QueryOutputProposalsRequest              protoreflect.MessageDescriptor
+	fd_QueryOutputProposalsRequest_bridge_id    protoreflect.FieldDescriptor
+	fd_QueryOutputProposalsRequest_output_index protoreflect.FieldDescriptor
+	fd_QueryOutputProposalsRequest_pagination   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryOutputProposalsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalsRequest")
+	fd_QueryOutputProposalsRequest_bridge_id = md_QueryOutputProposalsRequest.Fields().ByName("bridge_id")
+	fd_QueryOutputProposalsRequest_output_index = md_QueryOutputProposalsRequest.Fields().ByName("output_index")
+	fd_QueryOutputProposalsRequest_pagination = md_QueryOutputProposalsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOutputProposalsRequest)(nil)
+
+type fastReflection_QueryOutputProposalsRequest QueryOutputProposalsRequest
+
+func (x *QueryOutputProposalsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalsRequest)(x)
+}
+
+func (x *QueryOutputProposalsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOutputProposalsRequest_messageType fastReflection_QueryOutputProposalsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutputProposalsRequest_messageType{}
+
+type fastReflection_QueryOutputProposalsRequest_messageType struct{}
+
+func (x fastReflection_QueryOutputProposalsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalsRequest)(nil)
+}
+func (x fastReflection_QueryOutputProposalsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalsRequest)
+}
+func (x fastReflection_QueryOutputProposalsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOutputProposalsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOutputProposalsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutputProposalsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOutputProposalsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOutputProposalsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutputProposalsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOutputProposalsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryOutputProposalsRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_QueryOutputProposalsRequest_output_index, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOutputProposalsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOutputProposalsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOutputProposalsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryOutputProposalsRequest is not mutable"))
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.QueryOutputProposalsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOutputProposalsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryOutputProposalsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOutputProposalsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOutputProposalsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOutputProposalsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOutputProposalsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOutputProposalsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOutputProposalsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOutputProposalsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryOutputProposalsResponse_1_list)(nil)
+
+type _QueryOutputProposalsResponse_1_list struct {
+	list *[]*QueryOutputProposalResponse
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryOutputProposalResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryOutputProposalResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(QueryOutputProposalResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(QueryOutputProposalResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOutputProposalsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryOutputProposalsResponse                  protoreflect.MessageDescriptor
+	fd_QueryOutputProposalsResponse_output_proposals protoreflect.FieldDescriptor
+	fd_QueryOutputProposalsResponse_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryOutputProposalsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryOutputProposalsResponse")
+	fd_QueryOutputProposalsResponse_output_proposals = md_QueryOutputProposalsResponse.Fields().ByName("output_proposals")
+	fd_QueryOutputProposalsResponse_pagination = md_QueryOutputProposalsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOutputProposalsResponse)(nil)
+
+type fastReflection_QueryOutputProposalsResponse QueryOutputProposalsResponse
+
+func (x *QueryOutputProposalsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalsResponse)(x)
+}
+
+func (x *QueryOutputProposalsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOutputProposalsResponse_messageType fastReflection_QueryOutputProposalsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutputProposalsResponse_messageType{}
+
+type fastReflection_QueryOutputProposalsResponse_messageType struct{}
+
+func (x fastReflection_QueryOutputProposalsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutputProposalsResponse)(nil)
+}
+func (x fastReflection_QueryOutputProposalsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalsResponse)
+}
+func (x fastReflection_QueryOutputProposalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOutputProposalsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutputProposalsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOutputProposalsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutputProposalsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOutputProposalsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOutputProposalsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOutputProposalsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutputProposalsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOutputProposalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OutputProposals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOutputProposalsResponse_1_list{list: &x.OutputProposals})
+		if !f(fd_QueryOutputProposalsResponse_output_proposals, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOutputProposalsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOutputProposalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
+		return len(x.OutputProposals) != 0
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
+		x.OutputProposals = nil
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOutputProposalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
+		if len(x.OutputProposals) == 0 {
+			return protoreflect.ValueOfList(&_QueryOutputProposalsResponse_1_list{})
+		}
+		listValue := &_QueryOutputProposalsResponse_1_list{list: &x.OutputProposals}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
+		lv := value.List()
+		clv := lv.(*_QueryOutputProposalsResponse_1_list)
+		x.OutputProposals = *clv.list
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
+		if x.OutputProposals == nil {
+			x.OutputProposals = []*QueryOutputProposalResponse{}
+		}
+		value := &_QueryOutputProposalsResponse_1_list{list: &x.OutputProposals}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOutputProposalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals":
+		list := []*QueryOutputProposalResponse{}
+		return protoreflect.ValueOfList(&_QueryOutputProposalsResponse_1_list{list: &list})
+	case "opinit.ophost.v1.QueryOutputProposalsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryOutputProposalsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryOutputProposalsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOutputProposalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryOutputProposalsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOutputProposalsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOutputProposalsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOutputProposalsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOutputProposalsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOutputProposalsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.OutputProposals) > 0 {
+			for _, e := range x.OutputProposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOutputProposalsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OutputProposals) > 0 {
+			for iNdEx := len(x.OutputProposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OutputProposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOutputProposalsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutputProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputProposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutputProposals = append(x.OutputProposals, &QueryOutputProposalResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutputProposals[len(x.OutputProposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDepositCapRequest           protoreflect.MessageDescriptor
+	fd_QueryDepositCapRequest_bridge_id protoreflect.FieldDescriptor
+	fd_QueryDepositCapRequest_denom     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryDepositCapRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryDepositCapRequest")
+	fd_QueryDepositCapRequest_bridge_id = md_QueryDepositCapRequest.Fields().ByName("bridge_id")
+	fd_QueryDepositCapRequest_denom = md_QueryDepositCapRequest.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositCapRequest)(nil)
+
+type fastReflection_QueryDepositCapRequest QueryDepositCapRequest
+
+func (x *QueryDepositCapRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapRequest)(x)
+}
+
+func (x *QueryDepositCapRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositCapRequest_messageType fastReflection_QueryDepositCapRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositCapRequest_messageType{}
+
+type fastReflection_QueryDepositCapRequest_messageType struct{}
+
+func (x fastReflection_QueryDepositCapRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapRequest)(nil)
+}
+func (x fastReflection_QueryDepositCapRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapRequest)
+}
+func (x fastReflection_QueryDepositCapRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositCapRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositCapRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositCapRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositCapRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositCapRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositCapRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositCapRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryDepositCapRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryDepositCapRequest_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositCapRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapRequest.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryDepositCapRequest.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapRequest.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryDepositCapRequest.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositCapRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapRequest.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryDepositCapRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapRequest.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryDepositCapRequest.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryDepositCapRequest is not mutable"))
+	case "opinit.ophost.v1.QueryDepositCapRequest.denom":
+		panic(fmt.Errorf("field denom of message opinit.ophost.v1.QueryDepositCapRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositCapRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapRequest.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryDepositCapRequest.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositCapRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryDepositCapRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositCapRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositCapRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositCapRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositCapRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDepositCapResponse               protoreflect.MessageDescriptor
+	fd_QueryDepositCapResponse_deposit_cap   protoreflect.FieldDescriptor
+	fd_QueryDepositCapResponse_locked_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryDepositCapResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryDepositCapResponse")
+	fd_QueryDepositCapResponse_deposit_cap = md_QueryDepositCapResponse.Fields().ByName("deposit_cap")
+	fd_QueryDepositCapResponse_locked_amount = md_QueryDepositCapResponse.Fields().ByName("locked_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositCapResponse)(nil)
+
+type fastReflection_QueryDepositCapResponse QueryDepositCapResponse
+
+func (x *QueryDepositCapResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapResponse)(x)
+}
+
+func (x *QueryDepositCapResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositCapResponse_messageType fastReflection_QueryDepositCapResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositCapResponse_messageType{}
+
+type fastReflection_QueryDepositCapResponse_messageType struct{}
+
+func (x fastReflection_QueryDepositCapResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapResponse)(nil)
+}
+func (x fastReflection_QueryDepositCapResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapResponse)
+}
+func (x fastReflection_QueryDepositCapResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositCapResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositCapResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositCapResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositCapResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositCapResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositCapResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositCapResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DepositCap != nil {
+		value := protoreflect.ValueOfMessage(x.DepositCap.ProtoReflect())
+		if !f(fd_QueryDepositCapResponse_deposit_cap, value) {
+			return
+		}
+	}
+	if x.LockedAmount != "" {
+		value := protoreflect.ValueOfString(x.LockedAmount)
+		if !f(fd_QueryDepositCapResponse_locked_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositCapResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapResponse.deposit_cap":
+		return x.DepositCap != nil
+	case "opinit.ophost.v1.QueryDepositCapResponse.locked_amount":
+		return x.LockedAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapResponse.deposit_cap":
+		x.DepositCap = nil
+	case "opinit.ophost.v1.QueryDepositCapResponse.locked_amount":
+		x.LockedAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositCapResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapResponse.deposit_cap":
+		value := x.DepositCap
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.ophost.v1.QueryDepositCapResponse.locked_amount":
+		value := x.LockedAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapResponse.deposit_cap":
+		x.DepositCap = value.Message().Interface().(*DepositCap)
+	case "opinit.ophost.v1.QueryDepositCapResponse.locked_amount":
+		x.LockedAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapResponse.deposit_cap":
+		if x.DepositCap == nil {
+			x.DepositCap = new(DepositCap)
+		}
+		return protoreflect.ValueOfMessage(x.DepositCap.ProtoReflect())
+	case "opinit.ophost.v1.QueryDepositCapResponse.locked_amount":
+		panic(fmt.Errorf("field locked_amount of message opinit.ophost.v1.QueryDepositCapResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositCapResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapResponse.deposit_cap":
+		m := new(DepositCap)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.ophost.v1.QueryDepositCapResponse.locked_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositCapResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryDepositCapResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositCapResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositCapResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositCapResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositCapResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DepositCap != nil {
+			l = options.Size(x.DepositCap)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LockedAmount) > 0 {
+			i -= len(x.LockedAmount)
+			copy(dAtA[i:], x.LockedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DepositCap != nil {
+			encoded, err := options.Marshal(x.DepositCap)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositCap == nil {
+					x.DepositCap = &DepositCap{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositCap); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDepositCapsRequest            protoreflect.MessageDescriptor
+	fd_QueryDepositCapsRequest_bridge_id  protoreflect.FieldDescriptor
+	fd_QueryDepositCapsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryDepositCapsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryDepositCapsRequest")
+	fd_QueryDepositCapsRequest_bridge_id = md_QueryDepositCapsRequest.Fields().ByName("bridge_id")
+	fd_QueryDepositCapsRequest_pagination = md_QueryDepositCapsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositCapsRequest)(nil)
+
+type fastReflection_QueryDepositCapsRequest QueryDepositCapsRequest
+
+func (x *QueryDepositCapsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapsRequest)(x)
+}
+
+func (x *QueryDepositCapsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositCapsRequest_messageType fastReflection_QueryDepositCapsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositCapsRequest_messageType{}
+
+type fastReflection_QueryDepositCapsRequest_messageType struct{}
+
+func (x fastReflection_QueryDepositCapsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapsRequest)(nil)
+}
+func (x fastReflection_QueryDepositCapsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapsRequest)
+}
+func (x fastReflection_QueryDepositCapsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositCapsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositCapsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositCapsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositCapsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositCapsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositCapsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositCapsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryDepositCapsRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDepositCapsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositCapsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsRequest.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryDepositCapsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsRequest.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryDepositCapsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositCapsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsRequest.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryDepositCapsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsRequest.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryDepositCapsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "opinit.ophost.v1.QueryDepositCapsRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryDepositCapsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositCapsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsRequest.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryDepositCapsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositCapsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryDepositCapsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositCapsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositCapsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositCapsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositCapsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDepositCapsResponse_1_list)(nil)
+
+type _QueryDepositCapsResponse_1_list struct {
+	list *[]*QueryDepositCapResponse
+}
+
+func (x *_QueryDepositCapsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDepositCapsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDepositCapsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryDepositCapResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDepositCapsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryDepositCapResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDepositCapsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(QueryDepositCapResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDepositCapsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDepositCapsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(QueryDepositCapResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDepositCapsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDepositCapsResponse              protoreflect.MessageDescriptor
+	fd_QueryDepositCapsResponse_deposit_caps protoreflect.FieldDescriptor
+	fd_QueryDepositCapsResponse_pagination   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryDepositCapsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryDepositCapsResponse")
+	fd_QueryDepositCapsResponse_deposit_caps = md_QueryDepositCapsResponse.Fields().ByName("deposit_caps")
+	fd_QueryDepositCapsResponse_pagination = md_QueryDepositCapsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDepositCapsResponse)(nil)
+
+type fastReflection_QueryDepositCapsResponse QueryDepositCapsResponse
+
+func (x *QueryDepositCapsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapsResponse)(x)
+}
+
+func (x *QueryDepositCapsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDepositCapsResponse_messageType fastReflection_QueryDepositCapsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDepositCapsResponse_messageType{}
+
+type fastReflection_QueryDepositCapsResponse_messageType struct{}
+
+func (x fastReflection_QueryDepositCapsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDepositCapsResponse)(nil)
+}
+func (x fastReflection_QueryDepositCapsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapsResponse)
+}
+func (x fastReflection_QueryDepositCapsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDepositCapsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDepositCapsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDepositCapsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDepositCapsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDepositCapsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDepositCapsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDepositCapsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDepositCapsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDepositCapsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DepositCaps) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDepositCapsResponse_1_list{list: &x.DepositCaps})
+		if !f(fd_QueryDepositCapsResponse_deposit_caps, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryDepositCapsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDepositCapsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps":
+		return len(x.DepositCaps) != 0
+	case "opinit.ophost.v1.QueryDepositCapsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps":
+		x.DepositCaps = nil
+	case "opinit.ophost.v1.QueryDepositCapsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDepositCapsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps":
+		if len(x.DepositCaps) == 0 {
+			return protoreflect.ValueOfList(&_QueryDepositCapsResponse_1_list{})
+		}
+		listValue := &_QueryDepositCapsResponse_1_list{list: &x.DepositCaps}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.QueryDepositCapsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps":
+		lv := value.List()
+		clv := lv.(*_QueryDepositCapsResponse_1_list)
+		x.DepositCaps = *clv.list
+	case "opinit.ophost.v1.QueryDepositCapsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps":
+		if x.DepositCaps == nil {
+			x.DepositCaps = []*QueryDepositCapResponse{}
+		}
+		value := &_QueryDepositCapsResponse_1_list{list: &x.DepositCaps}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.QueryDepositCapsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDepositCapsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps":
+		list := []*QueryDepositCapResponse{}
+		return protoreflect.ValueOfList(&_QueryDepositCapsResponse_1_list{list: &list})
+	case "opinit.ophost.v1.QueryDepositCapsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryDepositCapsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryDepositCapsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDepositCapsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryDepositCapsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDepositCapsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDepositCapsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDepositCapsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDepositCapsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDepositCapsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.DepositCaps) > 0 {
+			for _, e := range x.DepositCaps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DepositCaps) > 0 {
+			for iNdEx := len(x.DepositCaps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DepositCaps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDepositCapsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDepositCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCaps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositCaps = append(x.DepositCaps, &QueryDepositCapResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositCaps[len(x.DepositCaps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBridgeAccountingRequest           protoreflect.MessageDescriptor
+	fd_QueryBridgeAccountingRequest_bridge_id protoreflect.FieldDescriptor
+	fd_QueryBridgeAccountingRequest_denom     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryBridgeAccountingRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryBridgeAccountingRequest")
+	fd_QueryBridgeAccountingRequest_bridge_id = md_QueryBridgeAccountingRequest.Fields().ByName("bridge_id")
+	fd_QueryBridgeAccountingRequest_denom = md_QueryBridgeAccountingRequest.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBridgeAccountingRequest)(nil)
+
+type fastReflection_QueryBridgeAccountingRequest QueryBridgeAccountingRequest
+
+func (x *QueryBridgeAccountingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingRequest)(x)
+}
+
+func (x *QueryBridgeAccountingRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBridgeAccountingRequest_messageType fastReflection_QueryBridgeAccountingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBridgeAccountingRequest_messageType{}
+
+type fastReflection_QueryBridgeAccountingRequest_messageType struct{}
+
+func (x fastReflection_QueryBridgeAccountingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingRequest)(nil)
+}
+func (x fastReflection_QueryBridgeAccountingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingRequest)
+}
+func (x fastReflection_QueryBridgeAccountingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBridgeAccountingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBridgeAccountingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBridgeAccountingRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBridgeAccountingRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBridgeAccountingRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBridgeAccountingRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBridgeAccountingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryBridgeAccountingRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryBridgeAccountingRequest_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBridgeAccountingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBridgeAccountingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryBridgeAccountingRequest is not mutable"))
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.denom":
+		panic(fmt.Errorf("field denom of message opinit.ophost.v1.QueryBridgeAccountingRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBridgeAccountingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryBridgeAccountingRequest.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBridgeAccountingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryBridgeAccountingRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBridgeAccountingRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBridgeAccountingRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBridgeAccountingRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBridgeAccountingRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBridgeAccountingResponse               protoreflect.MessageDescriptor
+	fd_QueryBridgeAccountingResponse_accounting    protoreflect.FieldDescriptor
+	fd_QueryBridgeAccountingResponse_locked_amount protoreflect.FieldDescriptor
+	fd_QueryBridgeAccountingResponse_balance       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryBridgeAccountingResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryBridgeAccountingResponse")
+	fd_QueryBridgeAccountingResponse_accounting = md_QueryBridgeAccountingResponse.Fields().ByName("accounting")
+	fd_QueryBridgeAccountingResponse_locked_amount = md_QueryBridgeAccountingResponse.Fields().ByName("locked_amount")
+	fd_QueryBridgeAccountingResponse_balance = md_QueryBridgeAccountingResponse.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBridgeAccountingResponse)(nil)
+
+type fastReflection_QueryBridgeAccountingResponse QueryBridgeAccountingResponse
+
+func (x *QueryBridgeAccountingResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingResponse)(x)
+}
+
+func (x *QueryBridgeAccountingResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBridgeAccountingResponse_messageType fastReflection_QueryBridgeAccountingResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBridgeAccountingResponse_messageType{}
+
+type fastReflection_QueryBridgeAccountingResponse_messageType struct{}
+
+func (x fastReflection_QueryBridgeAccountingResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingResponse)(nil)
+}
+func (x fastReflection_QueryBridgeAccountingResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingResponse)
+}
+func (x fastReflection_QueryBridgeAccountingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBridgeAccountingResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBridgeAccountingResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBridgeAccountingResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBridgeAccountingResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBridgeAccountingResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBridgeAccountingResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBridgeAccountingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Accounting != nil {
+		value := protoreflect.ValueOfMessage(x.Accounting.ProtoReflect())
+		if !f(fd_QueryBridgeAccountingResponse_accounting, value) {
+			return
+		}
+	}
+	if x.LockedAmount != "" {
+		value := protoreflect.ValueOfString(x.LockedAmount)
+		if !f(fd_QueryBridgeAccountingResponse_locked_amount, value) {
+			return
+		}
+	}
+	if x.Balance != "" {
+		value := protoreflect.ValueOfString(x.Balance)
+		if !f(fd_QueryBridgeAccountingResponse_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBridgeAccountingResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.accounting":
+		return x.Accounting != nil
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.locked_amount":
+		return x.LockedAmount != ""
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.balance":
+		return x.Balance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.accounting":
+		x.Accounting = nil
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.locked_amount":
+		x.LockedAmount = ""
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.balance":
+		x.Balance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBridgeAccountingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.accounting":
+		value := x.Accounting
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.locked_amount":
+		value := x.LockedAmount
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.balance":
+		value := x.Balance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.accounting":
+		x.Accounting = value.Message().Interface().(*BridgeAccounting)
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.locked_amount":
+		x.LockedAmount = value.Interface().(string)
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.balance":
+		x.Balance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.accounting":
+		if x.Accounting == nil {
+			x.Accounting = new(BridgeAccounting)
+		}
+		return protoreflect.ValueOfMessage(x.Accounting.ProtoReflect())
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.locked_amount":
+		panic(fmt.Errorf("field locked_amount of message opinit.ophost.v1.QueryBridgeAccountingResponse is not mutable"))
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.balance":
+		panic(fmt.Errorf("field balance of message opinit.ophost.v1.QueryBridgeAccountingResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBridgeAccountingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.accounting":
+		m := new(BridgeAccounting)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.locked_amount":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.QueryBridgeAccountingResponse.balance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBridgeAccountingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryBridgeAccountingResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBridgeAccountingResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBridgeAccountingResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBridgeAccountingResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBridgeAccountingResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Accounting != nil {
+			l = options.Size(x.Accounting)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Balance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balance) > 0 {
+			i -= len(x.Balance)
+			copy(dAtA[i:], x.Balance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Balance)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LockedAmount) > 0 {
+			i -= len(x.LockedAmount)
+			copy(dAtA[i:], x.LockedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Accounting != nil {
+			encoded, err := options.Marshal(x.Accounting)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounting", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Accounting == nil {
+					x.Accounting = &BridgeAccounting{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accounting); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBridgeAccountingsRequest            protoreflect.MessageDescriptor
+	fd_QueryBridgeAccountingsRequest_bridge_id  protoreflect.FieldDescriptor
+	fd_QueryBridgeAccountingsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryBridgeAccountingsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryBridgeAccountingsRequest")
+	fd_QueryBridgeAccountingsRequest_bridge_id = md_QueryBridgeAccountingsRequest.Fields().ByName("bridge_id")
+	fd_QueryBridgeAccountingsRequest_pagination = md_QueryBridgeAccountingsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBridgeAccountingsRequest)(nil)
+
+type fastReflection_QueryBridgeAccountingsRequest QueryBridgeAccountingsRequest
+
+func (x *QueryBridgeAccountingsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingsRequest)(x)
+}
+
+func (x *QueryBridgeAccountingsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBridgeAccountingsRequest_messageType fastReflection_QueryBridgeAccountingsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBridgeAccountingsRequest_messageType{}
+
+type fastReflection_QueryBridgeAccountingsRequest_messageType struct{}
+
+func (x fastReflection_QueryBridgeAccountingsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingsRequest)(nil)
+}
+func (x fastReflection_QueryBridgeAccountingsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingsRequest)
+}
+func (x fastReflection_QueryBridgeAccountingsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBridgeAccountingsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBridgeAccountingsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBridgeAccountingsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_QueryBridgeAccountingsRequest_bridge_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryBridgeAccountingsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.QueryBridgeAccountingsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBridgeAccountingsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBridgeAccountingsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryBridgeAccountingsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBridgeAccountingsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBridgeAccountingsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBridgeAccountingsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBridgeAccountingsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryBridgeAccountingsResponse_1_list)(nil)
+
+type _QueryBridgeAccountingsResponse_1_list struct {
+	list *[]*QueryBridgeAccountingResponse
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryBridgeAccountingResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryBridgeAccountingResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(QueryBridgeAccountingResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(QueryBridgeAccountingResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBridgeAccountingsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryBridgeAccountingsResponse             protoreflect.MessageDescriptor
+	fd_QueryBridgeAccountingsResponse_accountings protoreflect.FieldDescriptor
+	fd_QueryBridgeAccountingsResponse_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryBridgeAccountingsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryBridgeAccountingsResponse")
+	fd_QueryBridgeAccountingsResponse_accountings = md_QueryBridgeAccountingsResponse.Fields().ByName("accountings")
+	fd_QueryBridgeAccountingsResponse_pagination = md_QueryBridgeAccountingsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBridgeAccountingsResponse)(nil)
+
+type fastReflection_QueryBridgeAccountingsResponse QueryBridgeAccountingsResponse
+
+func (x *QueryBridgeAccountingsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingsResponse)(x)
+}
+
+func (x *QueryBridgeAccountingsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBridgeAccountingsResponse_messageType fastReflection_QueryBridgeAccountingsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBridgeAccountingsResponse_messageType{}
+
+type fastReflection_QueryBridgeAccountingsResponse_messageType struct{}
+
+func (x fastReflection_QueryBridgeAccountingsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBridgeAccountingsResponse)(nil)
+}
+func (x fastReflection_QueryBridgeAccountingsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingsResponse)
+}
+func (x fastReflection_QueryBridgeAccountingsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBridgeAccountingsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBridgeAccountingsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBridgeAccountingsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBridgeAccountingsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBridgeAccountingsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Accountings) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBridgeAccountingsResponse_1_list{list: &x.Accountings})
+		if !f(fd_QueryBridgeAccountingsResponse_accountings, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryBridgeAccountingsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings":
+		return len(x.Accountings) != 0
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings":
+		x.Accountings = nil
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings":
+		if len(x.Accountings) == 0 {
+			return protoreflect.ValueOfList(&_QueryBridgeAccountingsResponse_1_list{})
+		}
+		listValue := &_QueryBridgeAccountingsResponse_1_list{list: &x.Accountings}
+		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings":
+		lv := value.List()
+		clv := lv.(*_QueryBridgeAccountingsResponse_1_list)
+		x.Accountings = *clv.list
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings":
+		if x.Accountings == nil {
+			x.Accountings = []*QueryBridgeAccountingResponse{}
+		}
+		value := &_QueryBridgeAccountingsResponse_1_list{list: &x.Accountings}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBridgeAccountingsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings":
+		list := []*QueryBridgeAccountingResponse{}
+		return protoreflect.ValueOfList(&_QueryBridgeAccountingsResponse_1_list{list: &list})
+	case "opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryBridgeAccountingsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryBridgeAccountingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBridgeAccountingsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryBridgeAccountingsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBridgeAccountingsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBridgeAccountingsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBridgeAccountingsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBridgeAccountingsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBridgeAccountingsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Accountings) > 0 {
+			for _, e := range x.Accountings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Accountings) > 0 {
+			for iNdEx := len(x.Accountings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accountings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBridgeAccountingsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBridgeAccountingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accountings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accountings = append(x.Accountings, &QueryBridgeAccountingResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accountings[len(x.Accountings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryParamsRequest = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryParamsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+
+type fastReflection_QueryParamsRequest QueryParamsRequest
+
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
+}
+
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+
+type fastReflection_QueryParamsRequest_messageType struct{}
+
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
+}
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
+}
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsRequest"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryParamsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_query_proto_init()
+	md_QueryParamsResponse = File_opinit_ophost_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+
+type fastReflection_QueryParamsResponse QueryParamsResponse
+
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
+}
+
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+
+type fastReflection_QueryParamsResponse_messageType struct{}
+
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
+}
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
+}
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryParamsResponse.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryParamsResponse.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.QueryParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.QueryParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.QueryParamsResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.QueryParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: opinit/ophost/v1/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryBridgeRequest is request type for Query/Bridge RPC method.
+type QueryBridgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+}
+
+func (x *QueryBridgeRequest) Reset() {
+	*x = QueryBridgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBridgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBridgeRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBridgeRequest.ProtoReflect.Descriptor instead.
+func (*QueryBridgeRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryBridgeRequest) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+// QueryBridgeResponse is response type for the Query/Bridge RPC method
+type QueryBridgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId     uint64        `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	BridgeAddr   string        `protobuf:"bytes,2,opt,name=bridge_addr,json=bridgeAddr,proto3" json:"bridge_addr,omitempty"`
+	BridgeConfig *BridgeConfig `protobuf:"bytes,3,opt,name=bridge_config,json=bridgeConfig,proto3" json:"bridge_config,omitempty"`
+}
+
+func (x *QueryBridgeResponse) Reset() {
+	*x = QueryBridgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBridgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBridgeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBridgeResponse.ProtoReflect.Descriptor instead.
+func (*QueryBridgeResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryBridgeResponse) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *QueryBridgeResponse) GetBridgeAddr() string {
+	if x != nil {
+		return x.BridgeAddr
+	}
+	return ""
+}
+
+func (x *QueryBridgeResponse) GetBridgeConfig() *BridgeConfig {
+	if x != nil {
+		return x.BridgeConfig
+	}
+	return nil
+}
+
+// QueryBridgesRequest is request type for Query/Bridges RPC method.
+type QueryBridgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines the pagination in the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryBridgesRequest) Reset() {
+	*x = QueryBridgesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBridgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBridgesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBridgesRequest.ProtoReflect.Descriptor instead.
+func (*QueryBridgesRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryBridgesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryBridgesResponse is response type for the Query/Bridges RPC method
+type QueryBridgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bridges []*QueryBridgeResponse `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryBridgesResponse) Reset() {
+	*x = QueryBridgesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBridgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBridgesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBridgesResponse.ProtoReflect.Descriptor instead.
+func (*QueryBridgesResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryBridgesResponse) GetBridges() []*QueryBridgeResponse {
+	if x != nil {
+		return x.Bridges
+	}
+	return nil
+}
+
+func (x *QueryBridgesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTokenPairByL1DenomRequest is response type for the Query/TokenPairByL1Denom RPC method
+type QueryTokenPairByL1DenomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	L1Denom  string `protobuf:"bytes,2,opt,name=l1_denom,json=l1Denom,proto3" json:"l1_denom,omitempty"`
+}
+
+func (x *QueryTokenPairByL1DenomRequest) Reset() {
+	*x = QueryTokenPairByL1DenomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTokenPairByL1DenomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenPairByL1DenomRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTokenPairByL1DenomRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenPairByL1DenomRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryTokenPairByL1DenomRequest) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *QueryTokenPairByL1DenomRequest) GetL1Denom() string {
+	if x != nil {
+		return x.L1Denom
+	}
+	return ""
+}
+
+// QueryTokenPairByL1DenomResponse is response type for the Query/TokenPairByL1Denom RPC method
+type QueryTokenPairByL1DenomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenPair *TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+}
+
+func (x *QueryTokenPairByL1DenomResponse) Reset() {
+	*x = QueryTokenPairByL1DenomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTokenPairByL1DenomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenPairByL1DenomResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTokenPairByL1DenomResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenPairByL1DenomResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryTokenPairByL1DenomResponse) GetTokenPair() *TokenPair {
+	if x != nil {
+		return x.TokenPair
+	}
+	return nil
+}
+
+// QueryTokenPairByL2DenomRequest is response type for the Query/TokenPairByL2Denom RPC method
+type QueryTokenPairByL2DenomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	L2Denom  string `protobuf:"bytes,2,opt,name=l2_denom,json=l2Denom,proto3" json:"l2_denom,omitempty"`
+}
+
+func (x *QueryTokenPairByL2DenomRequest) Reset() {
+	*x = QueryTokenPairByL2DenomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTokenPairByL2DenomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenPairByL2DenomRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTokenPairByL2DenomRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenPairByL2DenomRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryTokenPairByL2DenomRequest) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *QueryTokenPairByL2DenomRequest) GetL2Denom() string {
+	if x != nil {
+		return x.L2Denom
+	}
+	return ""
+}
+
+// QueryTokenPairByL2DenomResponse is response type for the Query/TokenPairByL2Denom RPC method
+type QueryTokenPairByL2DenomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenPair *TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+}
+
+func (x *QueryTokenPairByL2DenomResponse) Reset() {
+	*x = QueryTokenPairByL2DenomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-// QueryBridgeRequest is request type for Query/Bridge RPC method.
-type QueryBridgeRequest struct {
+func (x *QueryTokenPairByL2DenomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenPairByL2DenomResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTokenPairByL2DenomResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenPairByL2DenomResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryTokenPairByL2DenomResponse) GetTokenPair() *TokenPair {
+	if x != nil {
+		return x.TokenPair
+	}
+	return nil
+}
+
+// QueryTokenPairsRequest is response type for the Query/TokenPairs RPC method
+type QueryTokenPairsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryBridgeRequest) Reset() {
-	*x = QueryBridgeRequest{}
+func (x *QueryTokenPairsRequest) Reset() {
+	*x = QueryTokenPairsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[0]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBridgeRequest) String() string {
+func (x *QueryTokenPairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBridgeRequest) ProtoMessage() {}
+func (*QueryTokenPairsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryBridgeRequest.ProtoReflect.Descriptor instead.
-func (*QueryBridgeRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryTokenPairsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenPairsRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryBridgeRequest) GetBridgeId() uint64 {
+func (x *QueryTokenPairsRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-// QueryBridgeResponse is response type for the Query/Bridge RPC method
-type QueryBridgeResponse struct {
+func (x *QueryTokenPairsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTokenPairsResponse is response type for the Query/TokenPairs RPC method
+type QueryTokenPairsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BridgeId     uint64        `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	BridgeAddr   string        `protobuf:"bytes,2,opt,name=bridge_addr,json=bridgeAddr,proto3" json:"bridge_addr,omitempty"`
-	BridgeConfig *BridgeConfig `protobuf:"bytes,3,opt,name=bridge_config,json=bridgeConfig,proto3" json:"bridge_config,omitempty"`
+	TokenPairs []*TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryBridgeResponse) Reset() {
-	*x = QueryBridgeResponse{}
+func (x *QueryTokenPairsResponse) Reset() {
+	*x = QueryTokenPairsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[1]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBridgeResponse) String() string {
+func (x *QueryTokenPairsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBridgeResponse) ProtoMessage() {}
+func (*QueryTokenPairsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryBridgeResponse.ProtoReflect.Descriptor instead.
-func (*QueryBridgeResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryTokenPairsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryBridgeResponse) GetBridgeId() uint64 {
+func (x *QueryTokenPairsResponse) GetTokenPairs() []*TokenPair {
+	if x != nil {
+		return x.TokenPairs
+	}
+	return nil
+}
+
+func (x *QueryTokenPairsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryL2NativeTokenPairsRequest is request type for the Query/L2NativeTokenPairs RPC method
+type QueryL2NativeTokenPairsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryL2NativeTokenPairsRequest) Reset() {
+	*x = QueryL2NativeTokenPairsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryL2NativeTokenPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryL2NativeTokenPairsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryL2NativeTokenPairsRequest.ProtoReflect.Descriptor instead.
+func (*QueryL2NativeTokenPairsRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryL2NativeTokenPairsRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *QueryBridgeResponse) GetBridgeAddr() string {
+func (x *QueryL2NativeTokenPairsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.BridgeAddr
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryBridgeResponse) GetBridgeConfig() *BridgeConfig {
+// QueryL2NativeTokenPairsResponse is response type for the Query/L2NativeTokenPairs RPC method
+type QueryL2NativeTokenPairsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenPairs []*TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryL2NativeTokenPairsResponse) Reset() {
+	*x = QueryL2NativeTokenPairsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryL2NativeTokenPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryL2NativeTokenPairsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryL2NativeTokenPairsResponse.ProtoReflect.Descriptor instead.
+func (*QueryL2NativeTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryL2NativeTokenPairsResponse) GetTokenPairs() []*TokenPair {
 	if x != nil {
-		return x.BridgeConfig
+		return x.TokenPairs
 	}
 	return nil
 }
 
-// QueryBridgesRequest is request type for Query/Bridges RPC method.
-type QueryBridgesRequest struct {
+func (x *QueryL2NativeTokenPairsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryNftClassPairsRequest is request type for the Query/NftClassPairs RPC method
+type QueryNftClassPairsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 	// pagination defines the pagination in the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryBridgesRequest) Reset() {
-	*x = QueryBridgesRequest{}
+func (x *QueryNftClassPairsRequest) Reset() {
+	*x = QueryNftClassPairsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[2]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBridgesRequest) String() string {
+func (x *QueryNftClassPairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBridgesRequest) ProtoMessage() {}
+func (*QueryNftClassPairsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryBridgesRequest.ProtoReflect.Descriptor instead.
-func (*QueryBridgesRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryNftClassPairsRequest.ProtoReflect.Descriptor instead.
+func (*QueryNftClassPairsRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryBridgesRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryNftClassPairsRequest) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *QueryNftClassPairsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryBridgesResponse is response type for the Query/Bridges RPC method
-type QueryBridgesResponse struct {
+// QueryNftClassPairsResponse is response type for the Query/NftClassPairs RPC method
+type QueryNftClassPairsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bridges []*QueryBridgeResponse `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+	ClassPairs []*NftClassPair `protobuf:"bytes,1,rep,name=class_pairs,json=classPairs,proto3" json:"class_pairs,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryBridgesResponse) Reset() {
-	*x = QueryBridgesResponse{}
+func (x *QueryNftClassPairsResponse) Reset() {
+	*x = QueryNftClassPairsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNftClassPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNftClassPairsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryNftClassPairsResponse.ProtoReflect.Descriptor instead.
+func (*QueryNftClassPairsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryNftClassPairsResponse) GetClassPairs() []*NftClassPair {
+	if x != nil {
+		return x.ClassPairs
+	}
+	return nil
+}
+
+func (x *QueryNftClassPairsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryLastFinalizedOutputRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+}
+
+func (x *QueryLastFinalizedOutputRequest) Reset() {
+	*x = QueryLastFinalizedOutputRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLastFinalizedOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLastFinalizedOutputRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLastFinalizedOutputRequest.ProtoReflect.Descriptor instead.
+func (*QueryLastFinalizedOutputRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryLastFinalizedOutputRequest) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+type QueryLastFinalizedOutputResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutputIndex    uint64  `protobuf:"varint,1,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	OutputProposal *Output `protobuf:"bytes,2,opt,name=output_proposal,json=outputProposal,proto3" json:"output_proposal,omitempty"`
+}
+
+func (x *QueryLastFinalizedOutputResponse) Reset() {
+	*x = QueryLastFinalizedOutputResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[3]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBridgesResponse) String() string {
+func (x *QueryLastFinalizedOutputResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBridgesResponse) ProtoMessage() {}
+func (*QueryLastFinalizedOutputResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryBridgesResponse.ProtoReflect.Descriptor instead.
-func (*QueryBridgesResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryLastFinalizedOutputResponse.ProtoReflect.Descriptor instead.
+func (*QueryLastFinalizedOutputResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryBridgesResponse) GetBridges() []*QueryBridgeResponse {
+func (x *QueryLastFinalizedOutputResponse) GetOutputIndex() uint64 {
 	if x != nil {
-		return x.Bridges
+		return x.OutputIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryBridgesResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryLastFinalizedOutputResponse) GetOutputProposal() *Output {
 	if x != nil {
-		return x.Pagination
+		return x.OutputProposal
 	}
 	return nil
 }
 
-// QueryTokenPairByL1DenomRequest is response type for the Query/TokenPairByL1Denom RPC method
-type QueryTokenPairByL1DenomRequest struct {
+// QueryOutputProposalRequest is response type for the Query/OutputProposal RPC method
+type QueryOutputProposalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	L1Denom  string `protobuf:"bytes,2,opt,name=l1_denom,json=l1Denom,proto3" json:"l1_denom,omitempty"`
+	BridgeId    uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	OutputIndex uint64 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 }
 
-func (x *QueryTokenPairByL1DenomRequest) Reset() {
-	*x = QueryTokenPairByL1DenomRequest{}
+func (x *QueryOutputProposalRequest) Reset() {
+	*x = QueryOutputProposalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[4]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTokenPairByL1DenomRequest) String() string {
+func (x *QueryOutputProposalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenPairByL1DenomRequest) ProtoMessage() {}
+func (*QueryOutputProposalRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTokenPairByL1DenomRequest.ProtoReflect.Descriptor instead.
-func (*QueryTokenPairByL1DenomRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryOutputProposalRequest.ProtoReflect.Descriptor instead.
+func (*QueryOutputProposalRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryTokenPairByL1DenomRequest) GetBridgeId() uint64 {
+func (x *QueryOutputProposalRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *QueryTokenPairByL1DenomRequest) GetL1Denom() string {
+func (x *QueryOutputProposalRequest) GetOutputIndex() uint64 {
 	if x != nil {
-		return x.L1Denom
+		return x.OutputIndex
 	}
-	return ""
+	return 0
 }
 
-// QueryTokenPairByL1DenomResponse is response type for the Query/TokenPairByL1Denom RPC method
-type QueryTokenPairByL1DenomResponse struct {
+// QueryOutputProposalResponse is response type for the Query/OutputProposal RPC method
+type QueryOutputProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenPair *TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	BridgeId       uint64  `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	OutputIndex    uint64  `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	OutputProposal *Output `protobuf:"bytes,3,opt,name=output_proposal,json=outputProposal,proto3" json:"output_proposal,omitempty"`
 }
 
-func (x *QueryTokenPairByL1DenomResponse) Reset() {
-	*x = QueryTokenPairByL1DenomResponse{}
+func (x *QueryOutputProposalResponse) Reset() {
+	*x = QueryOutputProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[5]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTokenPairByL1DenomResponse) String() string {
+func (x *QueryOutputProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenPairByL1DenomResponse) ProtoMessage() {}
+func (*QueryOutputProposalResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTokenPairByL1DenomResponse.ProtoReflect.Descriptor instead.
-func (*QueryTokenPairByL1DenomResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryOutputProposalResponse.ProtoReflect.Descriptor instead.
+func (*QueryOutputProposalResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryTokenPairByL1DenomResponse) GetTokenPair() *TokenPair {
+func (x *QueryOutputProposalResponse) GetBridgeId() uint64 {
 	if x != nil {
-		return x.TokenPair
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *QueryOutputProposalResponse) GetOutputIndex() uint64 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *QueryOutputProposalResponse) GetOutputProposal() *Output {
+	if x != nil {
+		return x.OutputProposal
 	}
 	return nil
 }
 
-// QueryTokenPairByL2DenomRequest is response type for the Query/TokenPairByL2Denom RPC method
-type QueryTokenPairByL2DenomRequest struct {
+// QueryOutputProposalsRequest is response type for the Query/OutputProposals RPC method
+type QueryOutputProposalsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	L2Denom  string `protobuf:"bytes,2,opt,name=l2_denom,json=l2Denom,proto3" json:"l2_denom,omitempty"`
+	BridgeId    uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	OutputIndex uint64 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTokenPairByL2DenomRequest) Reset() {
-	*x = QueryTokenPairByL2DenomRequest{}
+func (x *QueryOutputProposalsRequest) Reset() {
+	*x = QueryOutputProposalsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[6]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTokenPairByL2DenomRequest) String() string {
+func (x *QueryOutputProposalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenPairByL2DenomRequest) ProtoMessage() {}
+func (*QueryOutputProposalsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTokenPairByL2DenomRequest.ProtoReflect.Descriptor instead.
-func (*QueryTokenPairByL2DenomRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryOutputProposalsRequest.ProtoReflect.Descriptor instead.
+func (*QueryOutputProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryTokenPairByL2DenomRequest) GetBridgeId() uint64 {
+func (x *QueryOutputProposalsRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *QueryTokenPairByL2DenomRequest) GetL2Denom() string {
+func (x *QueryOutputProposalsRequest) GetOutputIndex() uint64 {
 	if x != nil {
-		return x.L2Denom
+		return x.OutputIndex
 	}
-	return ""
+	return 0
 }
 
-// QueryTokenPairByL2DenomResponse is response type for the Query/TokenPairByL2Denom RPC method
-type QueryTokenPairByL2DenomResponse struct {
+func (x *QueryOutputProposalsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryOutputProposalsResponse is response type for the Query/OutputProposals RPC method
+type QueryOutputProposalsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenPair *TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	OutputProposals []*QueryOutputProposalResponse `protobuf:"bytes,1,rep,name=output_proposals,json=outputProposals,proto3" json:"output_proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTokenPairByL2DenomResponse) Reset() {
-	*x = QueryTokenPairByL2DenomResponse{}
+func (x *QueryOutputProposalsResponse) Reset() {
+	*x = QueryOutputProposalsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[7]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTokenPairByL2DenomResponse) String() string {
+func (x *QueryOutputProposalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenPairByL2DenomResponse) ProtoMessage() {}
+func (*QueryOutputProposalsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTokenPairByL2DenomResponse.ProtoReflect.Descriptor instead.
-func (*QueryTokenPairByL2DenomResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryOutputProposalsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOutputProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryTokenPairByL2DenomResponse) GetTokenPair() *TokenPair {
+func (x *QueryOutputProposalsResponse) GetOutputProposals() []*QueryOutputProposalResponse {
 	if x != nil {
-		return x.TokenPair
+		return x.OutputProposals
 	}
 	return nil
 }
 
-// QueryTokenPairsRequest is response type for the Query/TokenPairs RPC method
-type QueryTokenPairsRequest struct {
+func (x *QueryOutputProposalsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDepositCapRequest is request type for the Query/DepositCap RPC method
+type QueryDepositCapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	// pagination defines the pagination in the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *QueryTokenPairsRequest) Reset() {
-	*x = QueryTokenPairsRequest{}
+func (x *QueryDepositCapRequest) Reset() {
+	*x = QueryDepositCapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[8]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTokenPairsRequest) String() string {
+func (x *QueryDepositCapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenPairsRequest) ProtoMessage() {}
+func (*QueryDepositCapRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTokenPairsRequest.ProtoReflect.Descriptor instead.
-func (*QueryTokenPairsRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryDepositCapRequest.ProtoReflect.Descriptor instead.
+func (*QueryDepositCapRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryTokenPairsRequest) GetBridgeId() uint64 {
+func (x *QueryDepositCapRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *QueryTokenPairsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryDepositCapRequest) GetDenom() string {
 	if x != nil {
-		return x.Pagination
+		return x.Denom
 	}
-	return nil
+	return ""
 }
 
-// QueryTokenPairsResponse is response type for the Query/TokenPairs RPC method
-type QueryTokenPairsResponse struct {
+// QueryDepositCapResponse is response type for the Query/DepositCap RPC method
+type QueryDepositCapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenPairs []*TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DepositCap *DepositCap `protobuf:"bytes,1,opt,name=deposit_cap,json=depositCap,proto3" json:"deposit_cap,omitempty"`
+	// locked_amount is the current balance of the denom in the bridge account.
+	LockedAmount string `protobuf:"bytes,2,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
 }
 
-func (x *QueryTokenPairsResponse) Reset() {
-	*x = QueryTokenPairsResponse{}
+func (x *QueryDepositCapResponse) Reset() {
+	*x = QueryDepositCapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[9]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTokenPairsResponse) String() string {
+func (x *QueryDepositCapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenPairsResponse) ProtoMessage() {}
+func (*QueryDepositCapResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTokenPairsResponse.ProtoReflect.Descriptor instead.
-func (*QueryTokenPairsResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryDepositCapResponse.ProtoReflect.Descriptor instead.
+func (*QueryDepositCapResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryTokenPairsResponse) GetTokenPairs() []*TokenPair {
+func (x *QueryDepositCapResponse) GetDepositCap() *DepositCap {
 	if x != nil {
-		return x.TokenPairs
+		return x.DepositCap
 	}
 	return nil
 }
 
-func (x *QueryTokenPairsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryDepositCapResponse) GetLockedAmount() string {
 	if x != nil {
-		return x.Pagination
+		return x.LockedAmount
 	}
-	return nil
+	return ""
 }
 
-type QueryLastFinalizedOutputRequest struct {
+// QueryDepositCapsRequest is request type for the Query/DepositCaps RPC method
+type QueryDepositCapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryLastFinalizedOutputRequest) Reset() {
-	*x = QueryLastFinalizedOutputRequest{}
+func (x *QueryDepositCapsRequest) Reset() {
+	*x = QueryDepositCapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[10]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLastFinalizedOutputRequest) String() string {
+func (x *QueryDepositCapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLastFinalizedOutputRequest) ProtoMessage() {}
+func (*QueryDepositCapsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryLastFinalizedOutputRequest.ProtoReflect.Descriptor instead.
-func (*QueryLastFinalizedOutputRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryDepositCapsRequest.ProtoReflect.Descriptor instead.
+func (*QueryDepositCapsRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryLastFinalizedOutputRequest) GetBridgeId() uint64 {
+func (x *QueryDepositCapsRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-type QueryLastFinalizedOutputResponse struct {
+func (x *QueryDepositCapsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryDepositCapsResponse is response type for the Query/DepositCaps RPC method
+type QueryDepositCapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutputIndex    uint64  `protobuf:"varint,1,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
-	OutputProposal *Output `protobuf:"bytes,2,opt,name=output_proposal,json=outputProposal,proto3" json:"output_proposal,omitempty"`
+	DepositCaps []*QueryDepositCapResponse `protobuf:"bytes,1,rep,name=deposit_caps,json=depositCaps,proto3" json:"deposit_caps,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryLastFinalizedOutputResponse) Reset() {
-	*x = QueryLastFinalizedOutputResponse{}
+func (x *QueryDepositCapsResponse) Reset() {
+	*x = QueryDepositCapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[11]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLastFinalizedOutputResponse) String() string {
+func (x *QueryDepositCapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLastFinalizedOutputResponse) ProtoMessage() {}
+func (*QueryDepositCapsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLastFinalizedOutputResponse.ProtoReflect.Descriptor instead.
-func (*QueryLastFinalizedOutputResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryDepositCapsResponse.ProtoReflect.Descriptor instead.
+func (*QueryDepositCapsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryLastFinalizedOutputResponse) GetOutputIndex() uint64 {
+func (x *QueryDepositCapsResponse) GetDepositCaps() []*QueryDepositCapResponse {
 	if x != nil {
-		return x.OutputIndex
+		return x.DepositCaps
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryLastFinalizedOutputResponse) GetOutputProposal() *Output {
+func (x *QueryDepositCapsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.OutputProposal
+		return x.Pagination
 	}
 	return nil
 }
 
-// QueryOutputProposalRequest is response type for the Query/OutputProposal RPC method
-type QueryOutputProposalRequest struct {
+// QueryBridgeAccountingRequest is request type for the Query/BridgeAccounting RPC method
+type QueryBridgeAccountingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BridgeId    uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	OutputIndex uint64 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
-
-func (x *QueryOutputProposalRequest) Reset() {
-	*x = QueryOutputProposalRequest{}
+
+func (x *QueryBridgeAccountingRequest) Reset() {
+	*x = QueryBridgeAccountingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[12]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutputProposalRequest) String() string {
+func (x *QueryBridgeAccountingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutputProposalRequest) ProtoMessage() {}
+func (*QueryBridgeAccountingRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOutputProposalRequest.ProtoReflect.Descriptor instead.
-func (*QueryOutputProposalRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryBridgeAccountingRequest.ProtoReflect.Descriptor instead.
+func (*QueryBridgeAccountingRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryOutputProposalRequest) GetBridgeId() uint64 {
+func (x *QueryBridgeAccountingRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *QueryOutputProposalRequest) GetOutputIndex() uint64 {
+func (x *QueryBridgeAccountingRequest) GetDenom() string {
 	if x != nil {
-		return x.OutputIndex
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-// QueryOutputProposalResponse is response type for the Query/OutputProposal RPC method
-type QueryOutputProposalResponse struct {
+// QueryBridgeAccountingResponse is response type for the Query/BridgeAccounting RPC method
+type QueryBridgeAccountingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BridgeId       uint64  `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	OutputIndex    uint64  `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
-	OutputProposal *Output `protobuf:"bytes,3,opt,name=output_proposal,json=outputProposal,proto3" json:"output_proposal,omitempty"`
+	Accounting *BridgeAccounting `protobuf:"bytes,1,opt,name=accounting,proto3" json:"accounting,omitempty"`
+	// locked_amount is the deposited amount minus the withdrawn amount.
+	LockedAmount string `protobuf:"bytes,2,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
+	// balance is the current balance of the denom in the bridge account.
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *QueryOutputProposalResponse) Reset() {
-	*x = QueryOutputProposalResponse{}
+func (x *QueryBridgeAccountingResponse) Reset() {
+	*x = QueryBridgeAccountingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[13]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutputProposalResponse) String() string {
+func (x *QueryBridgeAccountingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutputProposalResponse) ProtoMessage() {}
+func (*QueryBridgeAccountingResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOutputProposalResponse.ProtoReflect.Descriptor instead.
-func (*QueryOutputProposalResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryBridgeAccountingResponse.ProtoReflect.Descriptor instead.
+func (*QueryBridgeAccountingResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryOutputProposalResponse) GetBridgeId() uint64 {
+func (x *QueryBridgeAccountingResponse) GetAccounting() *BridgeAccounting {
 	if x != nil {
-		return x.BridgeId
+		return x.Accounting
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryOutputProposalResponse) GetOutputIndex() uint64 {
+func (x *QueryBridgeAccountingResponse) GetLockedAmount() string {
 	if x != nil {
-		return x.OutputIndex
+		return x.LockedAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryOutputProposalResponse) GetOutputProposal() *Output {
+func (x *QueryBridgeAccountingResponse) GetBalance() string {
 	if x != nil {
-		return x.OutputProposal
+		return x.Balance
 	}
-	return nil
+	return ""
 }
 
-// QueryOutputProposalsRequest is response type for the Query/OutputProposals RPC method
-type QueryOutputProposalsRequest struct {
+// QueryBridgeAccountingsRequest is request type for the Query/BridgeAccountings RPC method
+type QueryBridgeAccountingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BridgeId    uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
-	OutputIndex uint64 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 	// pagination defines the pagination in the request.
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryOutputProposalsRequest) Reset() {
-	*x = QueryOutputProposalsRequest{}
+func (x *QueryBridgeAccountingsRequest) Reset() {
+	*x = QueryBridgeAccountingsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[14]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutputProposalsRequest) String() string {
+func (x *QueryBridgeAccountingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutputProposalsRequest) ProtoMessage() {}
+func (*QueryBridgeAccountingsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOutputProposalsRequest.ProtoReflect.Descriptor instead.
-func (*QueryOutputProposalsRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryBridgeAccountingsRequest.ProtoReflect.Descriptor instead.
+func (*QueryBridgeAccountingsRequest) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryOutputProposalsRequest) GetBridgeId() uint64 {
+func (x *QueryBridgeAccountingsRequest) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *QueryOutputProposalsRequest) GetOutputIndex() uint64 {
-	if x != nil {
-		return x.OutputIndex
-	}
-	return 0
-}
-
-func (x *QueryOutputProposalsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryBridgeAccountingsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryOutputProposalsResponse is response type for the Query/OutputProposals RPC method
-type QueryOutputProposalsResponse struct {
+// QueryBridgeAccountingsResponse is response type for the Query/BridgeAccountings RPC method
+type QueryBridgeAccountingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutputProposals []*QueryOutputProposalResponse `protobuf:"bytes,1,rep,name=output_proposals,json=outputProposals,proto3" json:"output_proposals,omitempty"`
+	Accountings []*QueryBridgeAccountingResponse `protobuf:"bytes,1,rep,name=accountings,proto3" json:"accountings,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryOutputProposalsResponse) Reset() {
-	*x = QueryOutputProposalsResponse{}
+func (x *QueryBridgeAccountingsResponse) Reset() {
+	*x = QueryBridgeAccountingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[15]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutputProposalsResponse) String() string {
+func (x *QueryBridgeAccountingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutputProposalsResponse) ProtoMessage() {}
+func (*QueryBridgeAccountingsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOutputProposalsResponse.ProtoReflect.Descriptor instead.
-func (*QueryOutputProposalsResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryBridgeAccountingsResponse.ProtoReflect.Descriptor instead.
+func (*QueryBridgeAccountingsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryOutputProposalsResponse) GetOutputProposals() []*QueryOutputProposalResponse {
+func (x *QueryBridgeAccountingsResponse) GetAccountings() []*QueryBridgeAccountingResponse {
 	if x != nil {
-		return x.OutputProposals
+		return x.Accountings
 	}
 	return nil
 }
 
-func (x *QueryOutputProposalsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryBridgeAccountingsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -9319,7 +16088,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[16]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9333,7 +16102,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 // QueryParamsResponse is response type for the Query/Params RPC method.
@@ -9349,7 +16118,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_query_proto_msgTypes[17]
+		mi := &file_opinit_ophost_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9363,7 +16132,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_opinit_ophost_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -9468,175 +16237,363 @@ var file_opinit_ophost_v1_query_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
 	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x32, 0x4e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74,
-	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x0f, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
-	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0xc8,
-	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x5c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xab, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x01, 0x0a,
-	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
-	0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
-	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x52, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
-	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xfb, 0x0b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x89, 0x01, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f,
-	0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x07,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
-	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x1f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x80, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x66, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x66, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x66, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x4c, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x5c, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xab, 0x01, 0x0a, 0x1b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x0f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xcc, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x63, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f,
+	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x4b, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x43, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xba, 0x01,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68,
-	0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0xc5,
-	0x01, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x31,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
-	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x31, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
-	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x31, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x88, 0xe7, 0xb0, 0x2a,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
-	0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x6c, 0x31,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0xc5, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x30, 0x2e,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x43, 0x61, 0x70, 0x12, 0x55, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7e, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x91, 0x02, 0x0a,
+	0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x84, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xc0, 0x14, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x89, 0x01, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x12, 0x24, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x88,
+	0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x80, 0x01, 0x0a, 0x07, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x25, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x88, 0xe7,
+	0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x70, 0x69, 0x6e,
+	0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61,
+	0x69, 0x72, 0x42, 0x79, 0x4c, 0x31, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x30, 0x2e, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c,
+	0x31, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
 	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42,
-	0x79, 0x4c, 0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69,
-	0x72, 0x42, 0x79, 0x4c, 0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x4a, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12,
-	0x3d, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69,
-	0x72, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x6c, 0x32, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0xa1,
-	0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x28, 0x2e,
+	0x79, 0x4c, 0x31, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4a, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x2f, 0x62, 0x79, 0x5f, 0x6c, 0x31, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0xc5, 0x01, 0x0a,
+	0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x32, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x79, 0x4c, 0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
+	0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x6c, 0x32, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
 	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
-	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3e, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12,
-	0x31, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69,
-	0x72, 0x73, 0x12, 0xc6, 0x01, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
+	0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0xc3, 0x01, 0x0a, 0x12, 0x4c, 0x32, 0x4e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0x30, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3d, 0x12, 0x3b, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x32, 0x5f, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0xae,
+	0x01, 0x0a, 0x0d, 0x4e, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
 	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x48, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b,
-	0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0xb8, 0x01, 0x0a, 0x0e,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c,
-	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6f,
-	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x88, 0xe7, 0xb0,
-	0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x66, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x88, 0xe7, 0xb0,
+	0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69,
 	0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64,
 	0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x66, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0xc6, 0x01, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x69,
 	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
+	0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48,
+	0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0xb8, 0x01, 0x0a, 0x0e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
 	0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x88, 0xe7, 0xb0, 0x2a, 0x01,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x24, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
-	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x88, 0xe7,
-	0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6f, 0x70, 0x69, 0x6e,
-	0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0xc1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
-	0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61,
-	0x62, 0x73, 0x2f, 0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70,
-	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
-	0x70, 0x68, 0x6f, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x10,
-	0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68,
-	0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x12, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x68,
-	0x6f, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
+	0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61,
+	0x70, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x43, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0xa5, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x73,
+	0x12, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x43, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f,
+	0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x73, 0x12, 0xbc, 0x01, 0x0a, 0x10, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
+	0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x62,
+	0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0xb6, 0x01, 0x0a, 0x11, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2f, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3e, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc1,
+	0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50,
+	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x4f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
+	0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9651,7 +16608,7 @@ func file_opinit_ophost_v1_query_proto_rawDescGZIP() []byte {
 	return file_opinit_ophost_v1_query_proto_rawDescData
 }
 
-var file_opinit_ophost_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_opinit_ophost_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_opinit_ophost_v1_query_proto_goTypes = []interface{}{
 	(*QueryBridgeRequest)(nil),               // 0: opinit.ophost.v1.QueryBridgeRequest
 	(*QueryBridgeResponse)(nil),              // 1: opinit.ophost.v1.QueryBridgeResponse
@@ -9663,60 +16620,101 @@ var file_opinit_ophost_v1_query_proto_goTypes = []interface{}{
 	(*QueryTokenPairByL2DenomResponse)(nil),  // 7: opinit.ophost.v1.QueryTokenPairByL2DenomResponse
 	(*QueryTokenPairsRequest)(nil),           // 8: opinit.ophost.v1.QueryTokenPairsRequest
 	(*QueryTokenPairsResponse)(nil),          // 9: opinit.ophost.v1.QueryTokenPairsResponse
-	(*QueryLastFinalizedOutputRequest)(nil),  // 10: opinit.ophost.v1.QueryLastFinalizedOutputRequest
-	(*QueryLastFinalizedOutputResponse)(nil), // 11: opinit.ophost.v1.QueryLastFinalizedOutputResponse
-	(*QueryOutputProposalRequest)(nil),       // 12: opinit.ophost.v1.QueryOutputProposalRequest
-	(*QueryOutputProposalResponse)(nil),      // 13: opinit.ophost.v1.QueryOutputProposalResponse
-	(*QueryOutputProposalsRequest)(nil),      // 14: opinit.ophost.v1.QueryOutputProposalsRequest
-	(*QueryOutputProposalsResponse)(nil),     // 15: opinit.ophost.v1.QueryOutputProposalsResponse
-	(*QueryParamsRequest)(nil),               // 16: opinit.ophost.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),              // 17: opinit.ophost.v1.QueryParamsResponse
-	(*BridgeConfig)(nil),                     // 18: opinit.ophost.v1.BridgeConfig
-	(*v1beta1.PageRequest)(nil),              // 19: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),             // 20: cosmos.base.query.v1beta1.PageResponse
-	(*TokenPair)(nil),                        // 21: opinit.ophost.v1.TokenPair
-	(*Output)(nil),                           // 22: opinit.ophost.v1.Output
-	(*Params)(nil),                           // 23: opinit.ophost.v1.Params
+	(*QueryL2NativeTokenPairsRequest)(nil),   // 10: opinit.ophost.v1.QueryL2NativeTokenPairsRequest
+	(*QueryL2NativeTokenPairsResponse)(nil),  // 11: opinit.ophost.v1.QueryL2NativeTokenPairsResponse
+	(*QueryNftClassPairsRequest)(nil),        // 12: opinit.ophost.v1.QueryNftClassPairsRequest
+	(*QueryNftClassPairsResponse)(nil),       // 13: opinit.ophost.v1.QueryNftClassPairsResponse
+	(*QueryLastFinalizedOutputRequest)(nil),  // 14: opinit.ophost.v1.QueryLastFinalizedOutputRequest
+	(*QueryLastFinalizedOutputResponse)(nil), // 15: opinit.ophost.v1.QueryLastFinalizedOutputResponse
+	(*QueryOutputProposalRequest)(nil),       // 16: opinit.ophost.v1.QueryOutputProposalRequest
+	(*QueryOutputProposalResponse)(nil),      // 17: opinit.ophost.v1.QueryOutputProposalResponse
+	(*QueryOutputProposalsRequest)(nil),      // 18: opinit.ophost.v1.QueryOutputProposalsRequest
+	(*QueryOutputProposalsResponse)(nil),     // 19: opinit.ophost.v1.QueryOutputProposalsResponse
+	(*QueryDepositCapRequest)(nil),           // 20: opinit.ophost.v1.QueryDepositCapRequest
+	(*QueryDepositCapResponse)(nil),          // 21: opinit.ophost.v1.QueryDepositCapResponse
+	(*QueryDepositCapsRequest)(nil),          // 22: opinit.ophost.v1.QueryDepositCapsRequest
+	(*QueryDepositCapsResponse)(nil),         // 23: opinit.ophost.v1.QueryDepositCapsResponse
+	(*QueryBridgeAccountingRequest)(nil),     // 24: opinit.ophost.v1.QueryBridgeAccountingRequest
+	(*QueryBridgeAccountingResponse)(nil),    // 25: opinit.ophost.v1.QueryBridgeAccountingResponse
+	(*QueryBridgeAccountingsRequest)(nil),    // 26: opinit.ophost.v1.QueryBridgeAccountingsRequest
+	(*QueryBridgeAccountingsResponse)(nil),   // 27: opinit.ophost.v1.QueryBridgeAccountingsResponse
+	(*QueryParamsRequest)(nil),               // 28: opinit.ophost.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),              // 29: opinit.ophost.v1.QueryParamsResponse
+	(*BridgeConfig)(nil),                     // 30: opinit.ophost.v1.BridgeConfig
+	(*v1beta1.PageRequest)(nil),              // 31: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),             // 32: cosmos.base.query.v1beta1.PageResponse
+	(*TokenPair)(nil),                        // 33: opinit.ophost.v1.TokenPair
+	(*NftClassPair)(nil),                     // 34: opinit.ophost.v1.NftClassPair
+	(*Output)(nil),                           // 35: opinit.ophost.v1.Output
+	(*DepositCap)(nil),                       // 36: opinit.ophost.v1.DepositCap
+	(*BridgeAccounting)(nil),                 // 37: opinit.ophost.v1.BridgeAccounting
+	(*Params)(nil),                           // 38: opinit.ophost.v1.Params
 }
 var file_opinit_ophost_v1_query_proto_depIdxs = []int32{
-	18, // 0: opinit.ophost.v1.QueryBridgeResponse.bridge_config:type_name -> opinit.ophost.v1.BridgeConfig
-	19, // 1: opinit.ophost.v1.QueryBridgesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	30, // 0: opinit.ophost.v1.QueryBridgeResponse.bridge_config:type_name -> opinit.ophost.v1.BridgeConfig
+	31, // 1: opinit.ophost.v1.QueryBridgesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
 	1,  // 2: opinit.ophost.v1.QueryBridgesResponse.bridges:type_name -> opinit.ophost.v1.QueryBridgeResponse
-	20, // 3: opinit.ophost.v1.QueryBridgesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	21, // 4: opinit.ophost.v1.QueryTokenPairByL1DenomResponse.token_pair:type_name -> opinit.ophost.v1.TokenPair
-	21, // 5: opinit.ophost.v1.QueryTokenPairByL2DenomResponse.token_pair:type_name -> opinit.ophost.v1.TokenPair
-	19, // 6: opinit.ophost.v1.QueryTokenPairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	21, // 7: opinit.ophost.v1.QueryTokenPairsResponse.token_pairs:type_name -> opinit.ophost.v1.TokenPair
-	20, // 8: opinit.ophost.v1.QueryTokenPairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	22, // 9: opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal:type_name -> opinit.ophost.v1.Output
-	22, // 10: opinit.ophost.v1.QueryOutputProposalResponse.output_proposal:type_name -> opinit.ophost.v1.Output
-	19, // 11: opinit.ophost.v1.QueryOutputProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	13, // 12: opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals:type_name -> opinit.ophost.v1.QueryOutputProposalResponse
-	20, // 13: opinit.ophost.v1.QueryOutputProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	23, // 14: opinit.ophost.v1.QueryParamsResponse.params:type_name -> opinit.ophost.v1.Params
-	0,  // 15: opinit.ophost.v1.Query.Bridge:input_type -> opinit.ophost.v1.QueryBridgeRequest
-	2,  // 16: opinit.ophost.v1.Query.Bridges:input_type -> opinit.ophost.v1.QueryBridgesRequest
-	4,  // 17: opinit.ophost.v1.Query.TokenPairByL1Denom:input_type -> opinit.ophost.v1.QueryTokenPairByL1DenomRequest
-	6,  // 18: opinit.ophost.v1.Query.TokenPairByL2Denom:input_type -> opinit.ophost.v1.QueryTokenPairByL2DenomRequest
-	8,  // 19: opinit.ophost.v1.Query.TokenPairs:input_type -> opinit.ophost.v1.QueryTokenPairsRequest
-	10, // 20: opinit.ophost.v1.Query.LastFinalizedOutput:input_type -> opinit.ophost.v1.QueryLastFinalizedOutputRequest
-	12, // 21: opinit.ophost.v1.Query.OutputProposal:input_type -> opinit.ophost.v1.QueryOutputProposalRequest
-	14, // 22: opinit.ophost.v1.Query.OutputProposals:input_type -> opinit.ophost.v1.QueryOutputProposalsRequest
-	16, // 23: opinit.ophost.v1.Query.Params:input_type -> opinit.ophost.v1.QueryParamsRequest
-	1,  // 24: opinit.ophost.v1.Query.Bridge:output_type -> opinit.ophost.v1.QueryBridgeResponse
-	3,  // 25: opinit.ophost.v1.Query.Bridges:output_type -> opinit.ophost.v1.QueryBridgesResponse
-	5,  // 26: opinit.ophost.v1.Query.TokenPairByL1Denom:output_type -> opinit.ophost.v1.QueryTokenPairByL1DenomResponse
-	7,  // 27: opinit.ophost.v1.Query.TokenPairByL2Denom:output_type -> opinit.ophost.v1.QueryTokenPairByL2DenomResponse
-	9,  // 28: opinit.ophost.v1.Query.TokenPairs:output_type -> opinit.ophost.v1.QueryTokenPairsResponse
-	11, // 29: opinit.ophost.v1.Query.LastFinalizedOutput:output_type -> opinit.ophost.v1.QueryLastFinalizedOutputResponse
-	13, // 30: opinit.ophost.v1.Query.OutputProposal:output_type -> opinit.ophost.v1.QueryOutputProposalResponse
-	15, // 31: opinit.ophost.v1.Query.OutputProposals:output_type -> opinit.ophost.v1.QueryOutputProposalsResponse
-	17, // 32: opinit.ophost.v1.Query.Params:output_type -> opinit.ophost.v1.QueryParamsResponse
-	24, // [24:33] is the sub-list for method output_type
-	15, // [15:24] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	32, // 3: opinit.ophost.v1.QueryBridgesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	33, // 4: opinit.ophost.v1.QueryTokenPairByL1DenomResponse.token_pair:type_name -> opinit.ophost.v1.TokenPair
+	33, // 5: opinit.ophost.v1.QueryTokenPairByL2DenomResponse.token_pair:type_name -> opinit.ophost.v1.TokenPair
+	31, // 6: opinit.ophost.v1.QueryTokenPairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	33, // 7: opinit.ophost.v1.QueryTokenPairsResponse.token_pairs:type_name -> opinit.ophost.v1.TokenPair
+	32, // 8: opinit.ophost.v1.QueryTokenPairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	31, // 9: opinit.ophost.v1.QueryL2NativeTokenPairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	33, // 10: opinit.ophost.v1.QueryL2NativeTokenPairsResponse.token_pairs:type_name -> opinit.ophost.v1.TokenPair
+	32, // 11: opinit.ophost.v1.QueryL2NativeTokenPairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	31, // 12: opinit.ophost.v1.QueryNftClassPairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	34, // 13: opinit.ophost.v1.QueryNftClassPairsResponse.class_pairs:type_name -> opinit.ophost.v1.NftClassPair
+	32, // 14: opinit.ophost.v1.QueryNftClassPairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	35, // 15: opinit.ophost.v1.QueryLastFinalizedOutputResponse.output_proposal:type_name -> opinit.ophost.v1.Output
+	35, // 16: opinit.ophost.v1.QueryOutputProposalResponse.output_proposal:type_name -> opinit.ophost.v1.Output
+	31, // 17: opinit.ophost.v1.QueryOutputProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	17, // 18: opinit.ophost.v1.QueryOutputProposalsResponse.output_proposals:type_name -> opinit.ophost.v1.QueryOutputProposalResponse
+	32, // 19: opinit.ophost.v1.QueryOutputProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	36, // 20: opinit.ophost.v1.QueryDepositCapResponse.deposit_cap:type_name -> opinit.ophost.v1.DepositCap
+	31, // 21: opinit.ophost.v1.QueryDepositCapsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	21, // 22: opinit.ophost.v1.QueryDepositCapsResponse.deposit_caps:type_name -> opinit.ophost.v1.QueryDepositCapResponse
+	32, // 23: opinit.ophost.v1.QueryDepositCapsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	37, // 24: opinit.ophost.v1.QueryBridgeAccountingResponse.accounting:type_name -> opinit.ophost.v1.BridgeAccounting
+	31, // 25: opinit.ophost.v1.QueryBridgeAccountingsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	25, // 26: opinit.ophost.v1.QueryBridgeAccountingsResponse.accountings:type_name -> opinit.ophost.v1.QueryBridgeAccountingResponse
+	32, // 27: opinit.ophost.v1.QueryBridgeAccountingsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 28: opinit.ophost.v1.QueryParamsResponse.params:type_name -> opinit.ophost.v1.Params
+	0,  // 29: opinit.ophost.v1.Query.Bridge:input_type -> opinit.ophost.v1.QueryBridgeRequest
+	2,  // 30: opinit.ophost.v1.Query.Bridges:input_type -> opinit.ophost.v1.QueryBridgesRequest
+	4,  // 31: opinit.ophost.v1.Query.TokenPairByL1Denom:input_type -> opinit.ophost.v1.QueryTokenPairByL1DenomRequest
+	6,  // 32: opinit.ophost.v1.Query.TokenPairByL2Denom:input_type -> opinit.ophost.v1.QueryTokenPairByL2DenomRequest
+	8,  // 33: opinit.ophost.v1.Query.TokenPairs:input_type -> opinit.ophost.v1.QueryTokenPairsRequest
+	10, // 34: opinit.ophost.v1.Query.L2NativeTokenPairs:input_type -> opinit.ophost.v1.QueryL2NativeTokenPairsRequest
+	12, // 35: opinit.ophost.v1.Query.NftClassPairs:input_type -> opinit.ophost.v1.QueryNftClassPairsRequest
+	14, // 36: opinit.ophost.v1.Query.LastFinalizedOutput:input_type -> opinit.ophost.v1.QueryLastFinalizedOutputRequest
+	16, // 37: opinit.ophost.v1.Query.OutputProposal:input_type -> opinit.ophost.v1.QueryOutputProposalRequest
+	18, // 38: opinit.ophost.v1.Query.OutputProposals:input_type -> opinit.ophost.v1.QueryOutputProposalsRequest
+	20, // 39: opinit.ophost.v1.Query.DepositCap:input_type -> opinit.ophost.v1.QueryDepositCapRequest
+	22, // 40: opinit.ophost.v1.Query.DepositCaps:input_type -> opinit.ophost.v1.QueryDepositCapsRequest
+	24, // 41: opinit.ophost.v1.Query.BridgeAccounting:input_type -> opinit.ophost.v1.QueryBridgeAccountingRequest
+	26, // 42: opinit.ophost.v1.Query.BridgeAccountings:input_type -> opinit.ophost.v1.QueryBridgeAccountingsRequest
+	28, // 43: opinit.ophost.v1.Query.Params:input_type -> opinit.ophost.v1.QueryParamsRequest
+	1,  // 44: opinit.ophost.v1.Query.Bridge:output_type -> opinit.ophost.v1.QueryBridgeResponse
+	3,  // 45: opinit.ophost.v1.Query.Bridges:output_type -> opinit.ophost.v1.QueryBridgesResponse
+	5,  // 46: opinit.ophost.v1.Query.TokenPairByL1Denom:output_type -> opinit.ophost.v1.QueryTokenPairByL1DenomResponse
+	7,  // 47: opinit.ophost.v1.Query.TokenPairByL2Denom:output_type -> opinit.ophost.v1.QueryTokenPairByL2DenomResponse
+	9,  // 48: opinit.ophost.v1.Query.TokenPairs:output_type -> opinit.ophost.v1.QueryTokenPairsResponse
+	11, // 49: opinit.ophost.v1.Query.L2NativeTokenPairs:output_type -> opinit.ophost.v1.QueryL2NativeTokenPairsResponse
+	13, // 50: opinit.ophost.v1.Query.NftClassPairs:output_type -> opinit.ophost.v1.QueryNftClassPairsResponse
+	15, // 51: opinit.ophost.v1.Query.LastFinalizedOutput:output_type -> opinit.ophost.v1.QueryLastFinalizedOutputResponse
+	17, // 52: opinit.ophost.v1.Query.OutputProposal:output_type -> opinit.ophost.v1.QueryOutputProposalResponse
+	19, // 53: opinit.ophost.v1.Query.OutputProposals:output_type -> opinit.ophost.v1.QueryOutputProposalsResponse
+	21, // 54: opinit.ophost.v1.Query.DepositCap:output_type -> opinit.ophost.v1.QueryDepositCapResponse
+	23, // 55: opinit.ophost.v1.Query.DepositCaps:output_type -> opinit.ophost.v1.QueryDepositCapsResponse
+	25, // 56: opinit.ophost.v1.Query.BridgeAccounting:output_type -> opinit.ophost.v1.QueryBridgeAccountingResponse
+	27, // 57: opinit.ophost.v1.Query.BridgeAccountings:output_type -> opinit.ophost.v1.QueryBridgeAccountingsResponse
+	29, // 58: opinit.ophost.v1.Query.Params:output_type -> opinit.ophost.v1.QueryParamsResponse
+	44, // [44:59] is the sub-list for method output_type
+	29, // [29:44] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_opinit_ophost_v1_query_proto_init() }
@@ -9847,7 +16845,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLastFinalizedOutputRequest); i {
+			switch v := v.(*QueryL2NativeTokenPairsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9859,7 +16857,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLastFinalizedOutputResponse); i {
+			switch v := v.(*QueryL2NativeTokenPairsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9871,7 +16869,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutputProposalRequest); i {
+			switch v := v.(*QueryNftClassPairsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9883,7 +16881,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutputProposalResponse); i {
+			switch v := v.(*QueryNftClassPairsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9895,7 +16893,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutputProposalsRequest); i {
+			switch v := v.(*QueryLastFinalizedOutputRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9907,7 +16905,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutputProposalsResponse); i {
+			switch v := v.(*QueryLastFinalizedOutputResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9919,7 +16917,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryOutputProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9931,6 +16929,150 @@ func file_opinit_ophost_v1_query_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOutputProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOutputProposalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOutputProposalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositCapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositCapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositCapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDepositCapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBridgeAccountingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBridgeAccountingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBridgeAccountingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBridgeAccountingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -9949,7 +17091,7 @@ func file_opinit_ophost_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_opinit_ophost_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
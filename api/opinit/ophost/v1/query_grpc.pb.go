@@ -24,9 +24,15 @@ const (
 	Query_TokenPairByL1Denom_FullMethodName  = "/opinit.ophost.v1.Query/TokenPairByL1Denom"
 	Query_TokenPairByL2Denom_FullMethodName  = "/opinit.ophost.v1.Query/TokenPairByL2Denom"
 	Query_TokenPairs_FullMethodName          = "/opinit.ophost.v1.Query/TokenPairs"
+	Query_L2NativeTokenPairs_FullMethodName  = "/opinit.ophost.v1.Query/L2NativeTokenPairs"
+	Query_NftClassPairs_FullMethodName       = "/opinit.ophost.v1.Query/NftClassPairs"
 	Query_LastFinalizedOutput_FullMethodName = "/opinit.ophost.v1.Query/LastFinalizedOutput"
 	Query_OutputProposal_FullMethodName      = "/opinit.ophost.v1.Query/OutputProposal"
 	Query_OutputProposals_FullMethodName     = "/opinit.ophost.v1.Query/OutputProposals"
+	Query_DepositCap_FullMethodName          = "/opinit.ophost.v1.Query/DepositCap"
+	Query_DepositCaps_FullMethodName         = "/opinit.ophost.v1.Query/DepositCaps"
+	Query_BridgeAccounting_FullMethodName    = "/opinit.ophost.v1.Query/BridgeAccounting"
+	Query_BridgeAccountings_FullMethodName   = "/opinit.ophost.v1.Query/BridgeAccountings"
 	Query_Params_FullMethodName              = "/opinit.ophost.v1.Query/Params"
 )
 
@@ -46,11 +52,24 @@ type QueryClient interface {
 	TokenPairByL2Denom(ctx context.Context, in *QueryTokenPairByL2DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL2DenomResponse, error)
 	// TokenPairs queries all (l1 denom, l2 denom) pair.
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
+	// L2NativeTokenPairs queries all (l1 representation denom, l2 native denom) pairs
+	// of the tokens originated from l2.
+	L2NativeTokenPairs(ctx context.Context, in *QueryL2NativeTokenPairsRequest, opts ...grpc.CallOption) (*QueryL2NativeTokenPairsResponse, error)
+	// NftClassPairs queries all (l1 class id, l2 class id) pairs.
+	NftClassPairs(ctx context.Context, in *QueryNftClassPairsRequest, opts ...grpc.CallOption) (*QueryNftClassPairsResponse, error)
 	LastFinalizedOutput(ctx context.Context, in *QueryLastFinalizedOutputRequest, opts ...grpc.CallOption) (*QueryLastFinalizedOutputResponse, error)
 	// OutputProposal queries output proposal by output index.
 	OutputProposal(ctx context.Context, in *QueryOutputProposalRequest, opts ...grpc.CallOption) (*QueryOutputProposalResponse, error)
 	// OutputProposals queries all output proposals.
 	OutputProposals(ctx context.Context, in *QueryOutputProposalsRequest, opts ...grpc.CallOption) (*QueryOutputProposalsResponse, error)
+	// DepositCap queries the deposit cap and the locked amount of a denom.
+	DepositCap(ctx context.Context, in *QueryDepositCapRequest, opts ...grpc.CallOption) (*QueryDepositCapResponse, error)
+	// DepositCaps queries all deposit caps and locked amounts of a bridge.
+	DepositCaps(ctx context.Context, in *QueryDepositCapsRequest, opts ...grpc.CallOption) (*QueryDepositCapsResponse, error)
+	// BridgeAccounting queries the deposited and withdrawn totals of a denom.
+	BridgeAccounting(ctx context.Context, in *QueryBridgeAccountingRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingResponse, error)
+	// BridgeAccountings queries the deposited and withdrawn totals of all denoms of a bridge.
+	BridgeAccountings(ctx context.Context, in *QueryBridgeAccountingsRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingsResponse, error)
 	// Parameters queries the rollup parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -113,6 +132,26 @@ func (c *queryClient) TokenPairs(ctx context.Context, in *QueryTokenPairsRequest
 	return out, nil
 }
 
+func (c *queryClient) L2NativeTokenPairs(ctx context.Context, in *QueryL2NativeTokenPairsRequest, opts ...grpc.CallOption) (*QueryL2NativeTokenPairsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryL2NativeTokenPairsResponse)
+	err := c.cc.Invoke(ctx, Query_L2NativeTokenPairs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NftClassPairs(ctx context.Context, in *QueryNftClassPairsRequest, opts ...grpc.CallOption) (*QueryNftClassPairsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNftClassPairsResponse)
+	err := c.cc.Invoke(ctx, Query_NftClassPairs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LastFinalizedOutput(ctx context.Context, in *QueryLastFinalizedOutputRequest, opts ...grpc.CallOption) (*QueryLastFinalizedOutputResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryLastFinalizedOutputResponse)
@@ -143,6 +182,46 @@ func (c *queryClient) OutputProposals(ctx context.Context, in *QueryOutputPropos
 	return out, nil
 }
 
+func (c *queryClient) DepositCap(ctx context.Context, in *QueryDepositCapRequest, opts ...grpc.CallOption) (*QueryDepositCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDepositCapResponse)
+	err := c.cc.Invoke(ctx, Query_DepositCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositCaps(ctx context.Context, in *QueryDepositCapsRequest, opts ...grpc.CallOption) (*QueryDepositCapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDepositCapsResponse)
+	err := c.cc.Invoke(ctx, Query_DepositCaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeAccounting(ctx context.Context, in *QueryBridgeAccountingRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBridgeAccountingResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeAccounting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeAccountings(ctx context.Context, in *QueryBridgeAccountingsRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBridgeAccountingsResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeAccountings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -169,11 +248,24 @@ type QueryServer interface {
 	TokenPairByL2Denom(context.Context, *QueryTokenPairByL2DenomRequest) (*QueryTokenPairByL2DenomResponse, error)
 	// TokenPairs queries all (l1 denom, l2 denom) pair.
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
+	// L2NativeTokenPairs queries all (l1 representation denom, l2 native denom) pairs
+	// of the tokens originated from l2.
+	L2NativeTokenPairs(context.Context, *QueryL2NativeTokenPairsRequest) (*QueryL2NativeTokenPairsResponse, error)
+	// NftClassPairs queries all (l1 class id, l2 class id) pairs.
+	NftClassPairs(context.Context, *QueryNftClassPairsRequest) (*QueryNftClassPairsResponse, error)
 	LastFinalizedOutput(context.Context, *QueryLastFinalizedOutputRequest) (*QueryLastFinalizedOutputResponse, error)
 	// OutputProposal queries output proposal by output index.
 	OutputProposal(context.Context, *QueryOutputProposalRequest) (*QueryOutputProposalResponse, error)
 	// OutputProposals queries all output proposals.
 	OutputProposals(context.Context, *QueryOutputProposalsRequest) (*QueryOutputProposalsResponse, error)
+	// DepositCap queries the deposit cap and the locked amount of a denom.
+	DepositCap(context.Context, *QueryDepositCapRequest) (*QueryDepositCapResponse, error)
+	// DepositCaps queries all deposit caps and locked amounts of a bridge.
+	DepositCaps(context.Context, *QueryDepositCapsRequest) (*QueryDepositCapsResponse, error)
+	// BridgeAccounting queries the deposited and withdrawn totals of a denom.
+	BridgeAccounting(context.Context, *QueryBridgeAccountingRequest) (*QueryBridgeAccountingResponse, error)
+	// BridgeAccountings queries the deposited and withdrawn totals of all denoms of a bridge.
+	BridgeAccountings(context.Context, *QueryBridgeAccountingsRequest) (*QueryBridgeAccountingsResponse, error)
 	// Parameters queries the rollup parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -198,6 +290,12 @@ func (UnimplementedQueryServer) TokenPairByL2Denom(context.Context, *QueryTokenP
 func (UnimplementedQueryServer) TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPairs not implemented")
 }
+func (UnimplementedQueryServer) L2NativeTokenPairs(context.Context, *QueryL2NativeTokenPairsRequest) (*QueryL2NativeTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method L2NativeTokenPairs not implemented")
+}
+func (UnimplementedQueryServer) NftClassPairs(context.Context, *QueryNftClassPairsRequest) (*QueryNftClassPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NftClassPairs not implemented")
+}
 func (UnimplementedQueryServer) LastFinalizedOutput(context.Context, *QueryLastFinalizedOutputRequest) (*QueryLastFinalizedOutputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastFinalizedOutput not implemented")
 }
@@ -207,6 +305,18 @@ func (UnimplementedQueryServer) OutputProposal(context.Context, *QueryOutputProp
 func (UnimplementedQueryServer) OutputProposals(context.Context, *QueryOutputProposalsRequest) (*QueryOutputProposalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutputProposals not implemented")
 }
+func (UnimplementedQueryServer) DepositCap(context.Context, *QueryDepositCapRequest) (*QueryDepositCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCap not implemented")
+}
+func (UnimplementedQueryServer) DepositCaps(context.Context, *QueryDepositCapsRequest) (*QueryDepositCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCaps not implemented")
+}
+func (UnimplementedQueryServer) BridgeAccounting(context.Context, *QueryBridgeAccountingRequest) (*QueryBridgeAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeAccounting not implemented")
+}
+func (UnimplementedQueryServer) BridgeAccountings(context.Context, *QueryBridgeAccountingsRequest) (*QueryBridgeAccountingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeAccountings not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -313,6 +423,42 @@ func _Query_TokenPairs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_L2NativeTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryL2NativeTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).L2NativeTokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_L2NativeTokenPairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).L2NativeTokenPairs(ctx, req.(*QueryL2NativeTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NftClassPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNftClassPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NftClassPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NftClassPairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NftClassPairs(ctx, req.(*QueryNftClassPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LastFinalizedOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLastFinalizedOutputRequest)
 	if err := dec(in); err != nil {
@@ -367,6 +513,78 @@ func _Query_OutputProposals_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DepositCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DepositCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositCap(ctx, req.(*QueryDepositCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositCapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DepositCaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositCaps(ctx, req.(*QueryDepositCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeAccounting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeAccounting(ctx, req.(*QueryBridgeAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeAccountings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeAccountingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeAccountings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeAccountings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeAccountings(ctx, req.(*QueryBridgeAccountingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -412,6 +630,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPairs",
 			Handler:    _Query_TokenPairs_Handler,
 		},
+		{
+			MethodName: "L2NativeTokenPairs",
+			Handler:    _Query_L2NativeTokenPairs_Handler,
+		},
+		{
+			MethodName: "NftClassPairs",
+			Handler:    _Query_NftClassPairs_Handler,
+		},
 		{
 			MethodName: "LastFinalizedOutput",
 			Handler:    _Query_LastFinalizedOutput_Handler,
@@ -424,6 +650,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OutputProposals",
 			Handler:    _Query_OutputProposals_Handler,
 		},
+		{
+			MethodName: "DepositCap",
+			Handler:    _Query_DepositCap_Handler,
+		},
+		{
+			MethodName: "DepositCaps",
+			Handler:    _Query_DepositCaps_Handler,
+		},
+		{
+			MethodName: "BridgeAccounting",
+			Handler:    _Query_BridgeAccounting_Handler,
+		},
+		{
+			MethodName: "BridgeAccountings",
+			Handler:    _Query_BridgeAccountings_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
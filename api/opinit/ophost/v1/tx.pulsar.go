@@ -3,6 +3,7 @@ package ophostv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta11 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	fmt "fmt"
@@ -1816,6 +1817,7 @@ var (
 	fd_MsgProposeOutput_bridge_id       protoreflect.FieldDescriptor
 	fd_MsgProposeOutput_l2_block_number protoreflect.FieldDescriptor
 	fd_MsgProposeOutput_output_root     protoreflect.FieldDescriptor
+	fd_MsgProposeOutput_version         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1825,6 +1827,7 @@ func init() {
 	fd_MsgProposeOutput_bridge_id = md_MsgProposeOutput.Fields().ByName("bridge_id")
 	fd_MsgProposeOutput_l2_block_number = md_MsgProposeOutput.Fields().ByName("l2_block_number")
 	fd_MsgProposeOutput_output_root = md_MsgProposeOutput.Fields().ByName("output_root")
+	fd_MsgProposeOutput_version = md_MsgProposeOutput.Fields().ByName("version")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgProposeOutput)(nil)
@@ -1916,6 +1919,12 @@ func (x *fastReflection_MsgProposeOutput) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if len(x.Version) != 0 {
+		value := protoreflect.ValueOfBytes(x.Version)
+		if !f(fd_MsgProposeOutput_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1939,6 +1948,8 @@ func (x *fastReflection_MsgProposeOutput) Has(fd protoreflect.FieldDescriptor) b
 		return x.L2BlockNumber != uint64(0)
 	case "opinit.ophost.v1.MsgProposeOutput.output_root":
 		return len(x.OutputRoot) != 0
+	case "opinit.ophost.v1.MsgProposeOutput.version":
+		return len(x.Version) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgProposeOutput"))
@@ -1963,6 +1974,8 @@ func (x *fastReflection_MsgProposeOutput) Clear(fd protoreflect.FieldDescriptor)
 		x.L2BlockNumber = uint64(0)
 	case "opinit.ophost.v1.MsgProposeOutput.output_root":
 		x.OutputRoot = nil
+	case "opinit.ophost.v1.MsgProposeOutput.version":
+		x.Version = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgProposeOutput"))
@@ -1991,6 +2004,9 @@ func (x *fastReflection_MsgProposeOutput) Get(descriptor protoreflect.FieldDescr
 	case "opinit.ophost.v1.MsgProposeOutput.output_root":
 		value := x.OutputRoot
 		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.MsgProposeOutput.version":
+		value := x.Version
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgProposeOutput"))
@@ -2019,6 +2035,8 @@ func (x *fastReflection_MsgProposeOutput) Set(fd protoreflect.FieldDescriptor, v
 		x.L2BlockNumber = value.Uint()
 	case "opinit.ophost.v1.MsgProposeOutput.output_root":
 		x.OutputRoot = value.Bytes()
+	case "opinit.ophost.v1.MsgProposeOutput.version":
+		x.Version = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgProposeOutput"))
@@ -2047,6 +2065,8 @@ func (x *fastReflection_MsgProposeOutput) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgProposeOutput is not mutable"))
 	case "opinit.ophost.v1.MsgProposeOutput.output_root":
 		panic(fmt.Errorf("field output_root of message opinit.ophost.v1.MsgProposeOutput is not mutable"))
+	case "opinit.ophost.v1.MsgProposeOutput.version":
+		panic(fmt.Errorf("field version of message opinit.ophost.v1.MsgProposeOutput is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgProposeOutput"))
@@ -2068,6 +2088,8 @@ func (x *fastReflection_MsgProposeOutput) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "opinit.ophost.v1.MsgProposeOutput.output_root":
 		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.MsgProposeOutput.version":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgProposeOutput"))
@@ -2151,6 +2173,10 @@ func (x *fastReflection_MsgProposeOutput) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2180,6 +2206,13 @@ func (x *fastReflection_MsgProposeOutput) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.OutputRoot) > 0 {
 			i -= len(x.OutputRoot)
 			copy(dAtA[i:], x.OutputRoot)
@@ -2357,6 +2390,40 @@ func (x *fastReflection_MsgProposeOutput) ProtoMethods() *protoiface.Methods {
 					x.OutputRoot = []byte{}
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = append(x.Version[:0], dAtA[iNdEx:postIndex]...)
+				if x.Version == nil {
+					x.Version = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5402,10 +5469,10 @@ func (x *fastReflection_MsgFinalizeTokenWithdrawal) ProtoMethods() *protoiface.M
 			i--
 			dAtA[i] = 0x30
 		}
-		if len(x.Receiver) > 0 {
-			i -= len(x.Receiver)
-			copy(dAtA[i:], x.Receiver)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
 			dAtA[i] = 0x2a
 		}
@@ -5428,10 +5495,10 @@ func (x *fastReflection_MsgFinalizeTokenWithdrawal) ProtoMethods() *protoiface.M
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5554,7 +5621,7 @@ func (x *fastReflection_MsgFinalizeTokenWithdrawal) ProtoMethods() *protoiface.M
 				x.WithdrawalProofs = append(x.WithdrawalProofs, make([]byte, postIndex-iNdEx))
 				copy(x.WithdrawalProofs[len(x.WithdrawalProofs)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 1:
+			case 5:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
@@ -5586,7 +5653,7 @@ func (x *fastReflection_MsgFinalizeTokenWithdrawal) ProtoMethods() *protoiface.M
 				}
 				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 1:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 				}
@@ -6201,29 +6268,33 @@ func (x *fastReflection_MsgFinalizeTokenWithdrawalResponse) ProtoMethods() *prot
 }
 
 var (
-	md_MsgUpdateProposer              protoreflect.MessageDescriptor
-	fd_MsgUpdateProposer_authority    protoreflect.FieldDescriptor
-	fd_MsgUpdateProposer_bridge_id    protoreflect.FieldDescriptor
-	fd_MsgUpdateProposer_new_proposer protoreflect.FieldDescriptor
+	md_MsgInitiateNftDeposit           protoreflect.MessageDescriptor
+	fd_MsgInitiateNftDeposit_sender    protoreflect.FieldDescriptor
+	fd_MsgInitiateNftDeposit_bridge_id protoreflect.FieldDescriptor
+	fd_MsgInitiateNftDeposit_to        protoreflect.FieldDescriptor
+	fd_MsgInitiateNftDeposit_class_id  protoreflect.FieldDescriptor
+	fd_MsgInitiateNftDeposit_token_id  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateProposer = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateProposer")
-	fd_MsgUpdateProposer_authority = md_MsgUpdateProposer.Fields().ByName("authority")
-	fd_MsgUpdateProposer_bridge_id = md_MsgUpdateProposer.Fields().ByName("bridge_id")
-	fd_MsgUpdateProposer_new_proposer = md_MsgUpdateProposer.Fields().ByName("new_proposer")
+	md_MsgInitiateNftDeposit = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgInitiateNftDeposit")
+	fd_MsgInitiateNftDeposit_sender = md_MsgInitiateNftDeposit.Fields().ByName("sender")
+	fd_MsgInitiateNftDeposit_bridge_id = md_MsgInitiateNftDeposit.Fields().ByName("bridge_id")
+	fd_MsgInitiateNftDeposit_to = md_MsgInitiateNftDeposit.Fields().ByName("to")
+	fd_MsgInitiateNftDeposit_class_id = md_MsgInitiateNftDeposit.Fields().ByName("class_id")
+	fd_MsgInitiateNftDeposit_token_id = md_MsgInitiateNftDeposit.Fields().ByName("token_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateProposer)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgInitiateNftDeposit)(nil)
 
-type fastReflection_MsgUpdateProposer MsgUpdateProposer
+type fastReflection_MsgInitiateNftDeposit MsgInitiateNftDeposit
 
-func (x *MsgUpdateProposer) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateProposer)(x)
+func (x *MsgInitiateNftDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitiateNftDeposit)(x)
 }
 
-func (x *MsgUpdateProposer) slowProtoReflect() protoreflect.Message {
+func (x *MsgInitiateNftDeposit) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6235,43 +6306,43 @@ func (x *MsgUpdateProposer) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateProposer_messageType fastReflection_MsgUpdateProposer_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateProposer_messageType{}
+var _fastReflection_MsgInitiateNftDeposit_messageType fastReflection_MsgInitiateNftDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitiateNftDeposit_messageType{}
 
-type fastReflection_MsgUpdateProposer_messageType struct{}
+type fastReflection_MsgInitiateNftDeposit_messageType struct{}
 
-func (x fastReflection_MsgUpdateProposer_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateProposer)(nil)
+func (x fastReflection_MsgInitiateNftDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitiateNftDeposit)(nil)
 }
-func (x fastReflection_MsgUpdateProposer_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateProposer)
+func (x fastReflection_MsgInitiateNftDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateNftDeposit)
 }
-func (x fastReflection_MsgUpdateProposer_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateProposer
+func (x fastReflection_MsgInitiateNftDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateNftDeposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateProposer) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateProposer
+func (x *fastReflection_MsgInitiateNftDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateNftDeposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateProposer) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateProposer_messageType
+func (x *fastReflection_MsgInitiateNftDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgInitiateNftDeposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateProposer) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateProposer)
+func (x *fastReflection_MsgInitiateNftDeposit) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateNftDeposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateProposer) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateProposer)(x)
+func (x *fastReflection_MsgInitiateNftDeposit) Interface() protoreflect.ProtoMessage {
+	return (*MsgInitiateNftDeposit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6279,22 +6350,34 @@ func (x *fastReflection_MsgUpdateProposer) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateProposer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateProposer_authority, value) {
+func (x *fastReflection_MsgInitiateNftDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgInitiateNftDeposit_sender, value) {
 			return
 		}
 	}
 	if x.BridgeId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_MsgUpdateProposer_bridge_id, value) {
+		if !f(fd_MsgInitiateNftDeposit_bridge_id, value) {
 			return
 		}
 	}
-	if x.NewProposer != "" {
-		value := protoreflect.ValueOfString(x.NewProposer)
-		if !f(fd_MsgUpdateProposer_new_proposer, value) {
+	if x.To != "" {
+		value := protoreflect.ValueOfString(x.To)
+		if !f(fd_MsgInitiateNftDeposit_to, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_MsgInitiateNftDeposit_class_id, value) {
+			return
+		}
+	}
+	if x.TokenId != "" {
+		value := protoreflect.ValueOfString(x.TokenId)
+		if !f(fd_MsgInitiateNftDeposit_token_id, value) {
 			return
 		}
 	}
@@ -6311,19 +6394,23 @@ func (x *fastReflection_MsgUpdateProposer) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateProposer) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgInitiateNftDeposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposer.authority":
-		return x.Authority != ""
-	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.sender":
+		return x.Sender != ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.bridge_id":
 		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
-		return x.NewProposer != ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.to":
+		return x.To != ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.class_id":
+		return x.ClassId != ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.token_id":
+		return x.TokenId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDeposit"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6333,19 +6420,23 @@ func (x *fastReflection_MsgUpdateProposer) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposer) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgInitiateNftDeposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposer.authority":
-		x.Authority = ""
-	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.sender":
+		x.Sender = ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.bridge_id":
 		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
-		x.NewProposer = ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.to":
+		x.To = ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.class_id":
+		x.ClassId = ""
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.token_id":
+		x.TokenId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDeposit"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6355,22 +6446,28 @@ func (x *fastReflection_MsgUpdateProposer) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateProposer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitiateNftDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposer.authority":
-		value := x.Authority
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
-		value := x.NewProposer
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.to":
+		value := x.To
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.token_id":
+		value := x.TokenId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDeposit"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDeposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6384,19 +6481,23 @@ func (x *fastReflection_MsgUpdateProposer) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgInitiateNftDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposer.authority":
-		x.Authority = value.Interface().(string)
-	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.sender":
+		x.Sender = value.Interface().(string)
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.bridge_id":
 		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
-		x.NewProposer = value.Interface().(string)
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.to":
+		x.To = value.Interface().(string)
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.class_id":
+		x.ClassId = value.Interface().(string)
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.token_id":
+		x.TokenId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDeposit"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6410,48 +6511,56 @@ func (x *fastReflection_MsgUpdateProposer) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitiateNftDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposer.authority":
-		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateProposer is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateProposer is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
-		panic(fmt.Errorf("field new_proposer of message opinit.ophost.v1.MsgUpdateProposer is not mutable"))
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.sender":
+		panic(fmt.Errorf("field sender of message opinit.ophost.v1.MsgInitiateNftDeposit is not mutable"))
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgInitiateNftDeposit is not mutable"))
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.to":
+		panic(fmt.Errorf("field to of message opinit.ophost.v1.MsgInitiateNftDeposit is not mutable"))
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.class_id":
+		panic(fmt.Errorf("field class_id of message opinit.ophost.v1.MsgInitiateNftDeposit is not mutable"))
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.token_id":
+		panic(fmt.Errorf("field token_id of message opinit.ophost.v1.MsgInitiateNftDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDeposit"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateProposer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitiateNftDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.sender":
 		return protoreflect.ValueOfString("")
-	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.bridge_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.to":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.class_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgInitiateNftDeposit.token_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDeposit"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateProposer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgInitiateNftDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateProposer", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgInitiateNftDeposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6459,7 +6568,7 @@ func (x *fastReflection_MsgUpdateProposer) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateProposer) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgInitiateNftDeposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6470,7 +6579,7 @@ func (x *fastReflection_MsgUpdateProposer) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposer) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgInitiateNftDeposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6482,7 +6591,7 @@ func (x *fastReflection_MsgUpdateProposer) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateProposer) IsValid() bool {
+func (x *fastReflection_MsgInitiateNftDeposit) IsValid() bool {
 	return x != nil
 }
 
@@ -6492,9 +6601,9 @@ func (x *fastReflection_MsgUpdateProposer) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgInitiateNftDeposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateProposer)
+		x := input.Message.Interface().(*MsgInitiateNftDeposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6506,14 +6615,22 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.BridgeId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BridgeId))
 		}
-		l = len(x.NewProposer)
+		l = len(x.To)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6527,7 +6644,7 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateProposer)
+		x := input.Message.Interface().(*MsgInitiateNftDeposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6546,10 +6663,24 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewProposer) > 0 {
-			i -= len(x.NewProposer)
-			copy(dAtA[i:], x.NewProposer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewProposer)))
+		if len(x.TokenId) > 0 {
+			i -= len(x.TokenId)
+			copy(dAtA[i:], x.TokenId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.To) > 0 {
+			i -= len(x.To)
+			copy(dAtA[i:], x.To)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.To)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6558,10 +6689,10 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6576,7 +6707,7 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateProposer)
+		x := input.Message.Interface().(*MsgInitiateNftDeposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6608,15 +6739,15 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposer: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateNftDeposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateNftDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6644,7 +6775,7 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
@@ -6667,7 +6798,7 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewProposer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6695,29 +6826,93 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewProposer = string(dAtA[iNdEx:postIndex])
+				x.To = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
@@ -6733,27 +6928,25 @@ func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateProposerResponse                 protoreflect.MessageDescriptor
-	fd_MsgUpdateProposerResponse_output_index    protoreflect.FieldDescriptor
-	fd_MsgUpdateProposerResponse_l2_block_number protoreflect.FieldDescriptor
+	md_MsgInitiateNftDepositResponse          protoreflect.MessageDescriptor
+	fd_MsgInitiateNftDepositResponse_sequence protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateProposerResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateProposerResponse")
-	fd_MsgUpdateProposerResponse_output_index = md_MsgUpdateProposerResponse.Fields().ByName("output_index")
-	fd_MsgUpdateProposerResponse_l2_block_number = md_MsgUpdateProposerResponse.Fields().ByName("l2_block_number")
+	md_MsgInitiateNftDepositResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgInitiateNftDepositResponse")
+	fd_MsgInitiateNftDepositResponse_sequence = md_MsgInitiateNftDepositResponse.Fields().ByName("sequence")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateProposerResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgInitiateNftDepositResponse)(nil)
 
-type fastReflection_MsgUpdateProposerResponse MsgUpdateProposerResponse
+type fastReflection_MsgInitiateNftDepositResponse MsgInitiateNftDepositResponse
 
-func (x *MsgUpdateProposerResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateProposerResponse)(x)
+func (x *MsgInitiateNftDepositResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgInitiateNftDepositResponse)(x)
 }
 
-func (x *MsgUpdateProposerResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgInitiateNftDepositResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6765,43 +6958,43 @@ func (x *MsgUpdateProposerResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateProposerResponse_messageType fastReflection_MsgUpdateProposerResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateProposerResponse_messageType{}
+var _fastReflection_MsgInitiateNftDepositResponse_messageType fastReflection_MsgInitiateNftDepositResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgInitiateNftDepositResponse_messageType{}
 
-type fastReflection_MsgUpdateProposerResponse_messageType struct{}
+type fastReflection_MsgInitiateNftDepositResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateProposerResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateProposerResponse)(nil)
+func (x fastReflection_MsgInitiateNftDepositResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgInitiateNftDepositResponse)(nil)
 }
-func (x fastReflection_MsgUpdateProposerResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateProposerResponse)
+func (x fastReflection_MsgInitiateNftDepositResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateNftDepositResponse)
 }
-func (x fastReflection_MsgUpdateProposerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateProposerResponse
+func (x fastReflection_MsgInitiateNftDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateNftDepositResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateProposerResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateProposerResponse
+func (x *fastReflection_MsgInitiateNftDepositResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgInitiateNftDepositResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateProposerResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateProposerResponse_messageType
+func (x *fastReflection_MsgInitiateNftDepositResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgInitiateNftDepositResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateProposerResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateProposerResponse)
+func (x *fastReflection_MsgInitiateNftDepositResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgInitiateNftDepositResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateProposerResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateProposerResponse)(x)
+func (x *fastReflection_MsgInitiateNftDepositResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgInitiateNftDepositResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6809,16 +7002,10 @@ func (x *fastReflection_MsgUpdateProposerResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateProposerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_MsgUpdateProposerResponse_output_index, value) {
-			return
-		}
-	}
-	if x.L2BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
-		if !f(fd_MsgUpdateProposerResponse_l2_block_number, value) {
+func (x *fastReflection_MsgInitiateNftDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MsgInitiateNftDepositResponse_sequence, value) {
 			return
 		}
 	}
@@ -6835,17 +7022,15 @@ func (x *fastReflection_MsgUpdateProposerResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateProposerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgInitiateNftDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
-		return x.L2BlockNumber != uint64(0)
+	case "opinit.ophost.v1.MsgInitiateNftDepositResponse.sequence":
+		return x.Sequence != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDepositResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6855,17 +7040,15 @@ func (x *fastReflection_MsgUpdateProposerResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposerResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgInitiateNftDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
-		x.L2BlockNumber = uint64(0)
+	case "opinit.ophost.v1.MsgInitiateNftDepositResponse.sequence":
+		x.Sequence = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDepositResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6875,19 +7058,16 @@ func (x *fastReflection_MsgUpdateProposerResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateProposerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitiateNftDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
-		value := x.L2BlockNumber
+	case "opinit.ophost.v1.MsgInitiateNftDepositResponse.sequence":
+		value := x.Sequence
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDepositResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDepositResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6901,17 +7081,15 @@ func (x *fastReflection_MsgUpdateProposerResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgInitiateNftDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
-		x.L2BlockNumber = value.Uint()
+	case "opinit.ophost.v1.MsgInitiateNftDepositResponse.sequence":
+		x.Sequence = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDepositResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6925,44 +7103,40 @@ func (x *fastReflection_MsgUpdateProposerResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitiateNftDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateProposerResponse is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
-		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateProposerResponse is not mutable"))
+	case "opinit.ophost.v1.MsgInitiateNftDepositResponse.sequence":
+		panic(fmt.Errorf("field sequence of message opinit.ophost.v1.MsgInitiateNftDepositResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDepositResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateProposerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgInitiateNftDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+	case "opinit.ophost.v1.MsgInitiateNftDepositResponse.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgInitiateNftDepositResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgInitiateNftDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateProposerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgInitiateNftDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateProposerResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgInitiateNftDepositResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6970,7 +7144,7 @@ func (x *fastReflection_MsgUpdateProposerResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateProposerResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgInitiateNftDepositResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6981,7 +7155,7 @@ func (x *fastReflection_MsgUpdateProposerResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProposerResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgInitiateNftDepositResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6993,7 +7167,7 @@ func (x *fastReflection_MsgUpdateProposerResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateProposerResponse) IsValid() bool {
+func (x *fastReflection_MsgInitiateNftDepositResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7003,9 +7177,9 @@ func (x *fastReflection_MsgUpdateProposerResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgInitiateNftDepositResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateProposerResponse)
+		x := input.Message.Interface().(*MsgInitiateNftDepositResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7017,11 +7191,8 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
-		}
-		if x.L2BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7033,7 +7204,7 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateProposerResponse)
+		x := input.Message.Interface().(*MsgInitiateNftDepositResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7052,13 +7223,8 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.L2BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -7073,7 +7239,7 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateProposerResponse)
+		x := input.Message.Interface().(*MsgInitiateNftDepositResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7105,36 +7271,17 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposerResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateNftDepositResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgInitiateNftDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-				}
-				x.OutputIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				x.L2BlockNumber = 0
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7144,7 +7291,7 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.L2BlockNumber |= uint64(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -7184,76 +7331,94 @@ func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_MsgUpdateChallenger_3_list)(nil)
+var _ protoreflect.List = (*_MsgFinalizeNftWithdrawal_4_list)(nil)
 
-type _MsgUpdateChallenger_3_list struct {
-	list *[]string
+type _MsgFinalizeNftWithdrawal_4_list struct {
+	list *[][]byte
 }
 
-func (x *_MsgUpdateChallenger_3_list) Len() int {
+func (x *_MsgFinalizeNftWithdrawal_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgUpdateChallenger_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_MsgFinalizeNftWithdrawal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
 }
 
-func (x *_MsgUpdateChallenger_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
+func (x *_MsgFinalizeNftWithdrawal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgUpdateChallenger_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
+func (x *_MsgFinalizeNftWithdrawal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgUpdateChallenger_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgUpdateChallenger at list field NewChallengers as it is not of Message kind"))
+func (x *_MsgFinalizeNftWithdrawal_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgFinalizeNftWithdrawal at list field WithdrawalProofs as it is not of Message kind"))
 }
 
-func (x *_MsgUpdateChallenger_3_list) Truncate(n int) {
+func (x *_MsgFinalizeNftWithdrawal_4_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgUpdateChallenger_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_MsgFinalizeNftWithdrawal_4_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
 }
 
-func (x *_MsgUpdateChallenger_3_list) IsValid() bool {
+func (x *_MsgFinalizeNftWithdrawal_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgUpdateChallenger                 protoreflect.MessageDescriptor
-	fd_MsgUpdateChallenger_authority       protoreflect.FieldDescriptor
-	fd_MsgUpdateChallenger_bridge_id       protoreflect.FieldDescriptor
-	fd_MsgUpdateChallenger_new_challengers protoreflect.FieldDescriptor
+	md_MsgFinalizeNftWithdrawal                   protoreflect.MessageDescriptor
+	fd_MsgFinalizeNftWithdrawal_bridge_id         protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_output_index      protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_withdrawal_proofs protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_sender            protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_receiver          protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_sequence          protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_class_id          protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_token_id          protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_version           protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_state_root        protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_storage_root      protoreflect.FieldDescriptor
+	fd_MsgFinalizeNftWithdrawal_latest_block_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateChallenger = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateChallenger")
-	fd_MsgUpdateChallenger_authority = md_MsgUpdateChallenger.Fields().ByName("authority")
-	fd_MsgUpdateChallenger_bridge_id = md_MsgUpdateChallenger.Fields().ByName("bridge_id")
-	fd_MsgUpdateChallenger_new_challengers = md_MsgUpdateChallenger.Fields().ByName("new_challengers")
+	md_MsgFinalizeNftWithdrawal = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgFinalizeNftWithdrawal")
+	fd_MsgFinalizeNftWithdrawal_bridge_id = md_MsgFinalizeNftWithdrawal.Fields().ByName("bridge_id")
+	fd_MsgFinalizeNftWithdrawal_output_index = md_MsgFinalizeNftWithdrawal.Fields().ByName("output_index")
+	fd_MsgFinalizeNftWithdrawal_withdrawal_proofs = md_MsgFinalizeNftWithdrawal.Fields().ByName("withdrawal_proofs")
+	fd_MsgFinalizeNftWithdrawal_sender = md_MsgFinalizeNftWithdrawal.Fields().ByName("sender")
+	fd_MsgFinalizeNftWithdrawal_receiver = md_MsgFinalizeNftWithdrawal.Fields().ByName("receiver")
+	fd_MsgFinalizeNftWithdrawal_sequence = md_MsgFinalizeNftWithdrawal.Fields().ByName("sequence")
+	fd_MsgFinalizeNftWithdrawal_class_id = md_MsgFinalizeNftWithdrawal.Fields().ByName("class_id")
+	fd_MsgFinalizeNftWithdrawal_token_id = md_MsgFinalizeNftWithdrawal.Fields().ByName("token_id")
+	fd_MsgFinalizeNftWithdrawal_version = md_MsgFinalizeNftWithdrawal.Fields().ByName("version")
+	fd_MsgFinalizeNftWithdrawal_state_root = md_MsgFinalizeNftWithdrawal.Fields().ByName("state_root")
+	fd_MsgFinalizeNftWithdrawal_storage_root = md_MsgFinalizeNftWithdrawal.Fields().ByName("storage_root")
+	fd_MsgFinalizeNftWithdrawal_latest_block_hash = md_MsgFinalizeNftWithdrawal.Fields().ByName("latest_block_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateChallenger)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgFinalizeNftWithdrawal)(nil)
 
-type fastReflection_MsgUpdateChallenger MsgUpdateChallenger
+type fastReflection_MsgFinalizeNftWithdrawal MsgFinalizeNftWithdrawal
 
-func (x *MsgUpdateChallenger) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateChallenger)(x)
+func (x *MsgFinalizeNftWithdrawal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFinalizeNftWithdrawal)(x)
 }
 
-func (x *MsgUpdateChallenger) slowProtoReflect() protoreflect.Message {
+func (x *MsgFinalizeNftWithdrawal) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7265,43 +7430,43 @@ func (x *MsgUpdateChallenger) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateChallenger_messageType fastReflection_MsgUpdateChallenger_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateChallenger_messageType{}
+var _fastReflection_MsgFinalizeNftWithdrawal_messageType fastReflection_MsgFinalizeNftWithdrawal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFinalizeNftWithdrawal_messageType{}
 
-type fastReflection_MsgUpdateChallenger_messageType struct{}
+type fastReflection_MsgFinalizeNftWithdrawal_messageType struct{}
 
-func (x fastReflection_MsgUpdateChallenger_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateChallenger)(nil)
+func (x fastReflection_MsgFinalizeNftWithdrawal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFinalizeNftWithdrawal)(nil)
 }
-func (x fastReflection_MsgUpdateChallenger_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateChallenger)
+func (x fastReflection_MsgFinalizeNftWithdrawal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFinalizeNftWithdrawal)
 }
-func (x fastReflection_MsgUpdateChallenger_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateChallenger
+func (x fastReflection_MsgFinalizeNftWithdrawal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFinalizeNftWithdrawal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateChallenger) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateChallenger
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFinalizeNftWithdrawal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateChallenger) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateChallenger_messageType
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFinalizeNftWithdrawal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateChallenger) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateChallenger)
+func (x *fastReflection_MsgFinalizeNftWithdrawal) New() protoreflect.Message {
+	return new(fastReflection_MsgFinalizeNftWithdrawal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateChallenger) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateChallenger)(x)
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Interface() protoreflect.ProtoMessage {
+	return (*MsgFinalizeNftWithdrawal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7309,51 +7474,123 @@ func (x *fastReflection_MsgUpdateChallenger) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateChallenger) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateChallenger_authority, value) {
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_MsgFinalizeNftWithdrawal_bridge_id, value) {
 			return
 		}
 	}
-	if x.BridgeId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_MsgUpdateChallenger_bridge_id, value) {
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_MsgFinalizeNftWithdrawal_output_index, value) {
 			return
 		}
 	}
-	if len(x.NewChallengers) != 0 {
-		value := protoreflect.ValueOfList(&_MsgUpdateChallenger_3_list{list: &x.NewChallengers})
-		if !f(fd_MsgUpdateChallenger_new_challengers, value) {
+	if len(x.WithdrawalProofs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgFinalizeNftWithdrawal_4_list{list: &x.WithdrawalProofs})
+		if !f(fd_MsgFinalizeNftWithdrawal_withdrawal_proofs, value) {
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateChallenger) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallenger.authority":
-		return x.Authority != ""
-	case "opinit.ophost.v1.MsgUpdateChallenger.bridge_id":
-		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateChallenger.new_challengers":
-		return len(x.NewChallengers) != 0
-	default:
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgFinalizeNftWithdrawal_sender, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_MsgFinalizeNftWithdrawal_receiver, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MsgFinalizeNftWithdrawal_sequence, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_MsgFinalizeNftWithdrawal_class_id, value) {
+			return
+		}
+	}
+	if x.TokenId != "" {
+		value := protoreflect.ValueOfString(x.TokenId)
+		if !f(fd_MsgFinalizeNftWithdrawal_token_id, value) {
+			return
+		}
+	}
+	if len(x.Version) != 0 {
+		value := protoreflect.ValueOfBytes(x.Version)
+		if !f(fd_MsgFinalizeNftWithdrawal_version, value) {
+			return
+		}
+	}
+	if len(x.StateRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.StateRoot)
+		if !f(fd_MsgFinalizeNftWithdrawal_state_root, value) {
+			return
+		}
+	}
+	if len(x.StorageRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.StorageRoot)
+		if !f(fd_MsgFinalizeNftWithdrawal_storage_root, value) {
+			return
+		}
+	}
+	if len(x.LatestBlockHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.LatestBlockHash)
+		if !f(fd_MsgFinalizeNftWithdrawal_latest_block_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.withdrawal_proofs":
+		return len(x.WithdrawalProofs) != 0
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sender":
+		return x.Sender != ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.receiver":
+		return x.Receiver != ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sequence":
+		return x.Sequence != uint64(0)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.class_id":
+		return x.ClassId != ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.token_id":
+		return x.TokenId != ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.version":
+		return len(x.Version) != 0
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.state_root":
+		return len(x.StateRoot) != 0
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.storage_root":
+		return len(x.StorageRoot) != 0
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.latest_block_hash":
+		return len(x.LatestBlockHash) != 0
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallenger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawal"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallenger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7363,19 +7600,37 @@ func (x *fastReflection_MsgUpdateChallenger) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallenger) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallenger.authority":
-		x.Authority = ""
-	case "opinit.ophost.v1.MsgUpdateChallenger.bridge_id":
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.bridge_id":
 		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateChallenger.new_challengers":
-		x.NewChallengers = nil
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.withdrawal_proofs":
+		x.WithdrawalProofs = nil
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sender":
+		x.Sender = ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.receiver":
+		x.Receiver = ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sequence":
+		x.Sequence = uint64(0)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.class_id":
+		x.ClassId = ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.token_id":
+		x.TokenId = ""
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.version":
+		x.Version = nil
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.state_root":
+		x.StateRoot = nil
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.storage_root":
+		x.StorageRoot = nil
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.latest_block_hash":
+		x.LatestBlockHash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallenger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawal"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallenger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7385,25 +7640,5366 @@ func (x *fastReflection_MsgUpdateChallenger) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateChallenger) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallenger.authority":
-		value := x.Authority
-		return protoreflect.ValueOfString(value)
-	case "opinit.ophost.v1.MsgUpdateChallenger.bridge_id":
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateChallenger.new_challengers":
-		if len(x.NewChallengers) == 0 {
-			return protoreflect.ValueOfList(&_MsgUpdateChallenger_3_list{})
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.withdrawal_proofs":
+		if len(x.WithdrawalProofs) == 0 {
+			return protoreflect.ValueOfList(&_MsgFinalizeNftWithdrawal_4_list{})
 		}
-		listValue := &_MsgUpdateChallenger_3_list{list: &x.NewChallengers}
+		listValue := &_MsgFinalizeNftWithdrawal_4_list{list: &x.WithdrawalProofs}
 		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.token_id":
+		value := x.TokenId
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.version":
+		value := x.Version
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.state_root":
+		value := x.StateRoot
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.storage_root":
+		value := x.StorageRoot
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.latest_block_hash":
+		value := x.LatestBlockHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawal"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.withdrawal_proofs":
+		lv := value.List()
+		clv := lv.(*_MsgFinalizeNftWithdrawal_4_list)
+		x.WithdrawalProofs = *clv.list
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sender":
+		x.Sender = value.Interface().(string)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.receiver":
+		x.Receiver = value.Interface().(string)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sequence":
+		x.Sequence = value.Uint()
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.class_id":
+		x.ClassId = value.Interface().(string)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.token_id":
+		x.TokenId = value.Interface().(string)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.version":
+		x.Version = value.Bytes()
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.state_root":
+		x.StateRoot = value.Bytes()
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.storage_root":
+		x.StorageRoot = value.Bytes()
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.latest_block_hash":
+		x.LatestBlockHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawal"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.withdrawal_proofs":
+		if x.WithdrawalProofs == nil {
+			x.WithdrawalProofs = [][]byte{}
+		}
+		value := &_MsgFinalizeNftWithdrawal_4_list{list: &x.WithdrawalProofs}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sender":
+		panic(fmt.Errorf("field sender of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.receiver":
+		panic(fmt.Errorf("field receiver of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sequence":
+		panic(fmt.Errorf("field sequence of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.class_id":
+		panic(fmt.Errorf("field class_id of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.token_id":
+		panic(fmt.Errorf("field token_id of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.version":
+		panic(fmt.Errorf("field version of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.state_root":
+		panic(fmt.Errorf("field state_root of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.storage_root":
+		panic(fmt.Errorf("field storage_root of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.latest_block_hash":
+		panic(fmt.Errorf("field latest_block_hash of message opinit.ophost.v1.MsgFinalizeNftWithdrawal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawal"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.withdrawal_proofs":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_MsgFinalizeNftWithdrawal_4_list{list: &list})
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sender":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.receiver":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.class_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.token_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.version":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.state_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.storage_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.MsgFinalizeNftWithdrawal.latest_block_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawal"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgFinalizeNftWithdrawal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFinalizeNftWithdrawal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFinalizeNftWithdrawal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if len(x.WithdrawalProofs) > 0 {
+			for _, b := range x.WithdrawalProofs {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StateRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StorageRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LatestBlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFinalizeNftWithdrawal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LatestBlockHash) > 0 {
+			i -= len(x.LatestBlockHash)
+			copy(dAtA[i:], x.LatestBlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LatestBlockHash)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.StorageRoot) > 0 {
+			i -= len(x.StorageRoot)
+			copy(dAtA[i:], x.StorageRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageRoot)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.StateRoot) > 0 {
+			i -= len(x.StateRoot)
+			copy(dAtA[i:], x.StateRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateRoot)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.TokenId) > 0 {
+			i -= len(x.TokenId)
+			copy(dAtA[i:], x.TokenId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.WithdrawalProofs) > 0 {
+			for iNdEx := len(x.WithdrawalProofs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.WithdrawalProofs[iNdEx])
+				copy(dAtA[i:], x.WithdrawalProofs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawalProofs[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFinalizeNftWithdrawal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFinalizeNftWithdrawal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFinalizeNftWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawalProofs", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawalProofs = append(x.WithdrawalProofs, make([]byte, postIndex-iNdEx))
+				copy(x.WithdrawalProofs[len(x.WithdrawalProofs)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = append(x.Version[:0], dAtA[iNdEx:postIndex]...)
+				if x.Version == nil {
+					x.Version = []byte{}
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateRoot = append(x.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.StateRoot == nil {
+					x.StateRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageRoot = append(x.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.StorageRoot == nil {
+					x.StorageRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LatestBlockHash = append(x.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.LatestBlockHash == nil {
+					x.LatestBlockHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFinalizeNftWithdrawalResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgFinalizeNftWithdrawalResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgFinalizeNftWithdrawalResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFinalizeNftWithdrawalResponse)(nil)
+
+type fastReflection_MsgFinalizeNftWithdrawalResponse MsgFinalizeNftWithdrawalResponse
+
+func (x *MsgFinalizeNftWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFinalizeNftWithdrawalResponse)(x)
+}
+
+func (x *MsgFinalizeNftWithdrawalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFinalizeNftWithdrawalResponse_messageType fastReflection_MsgFinalizeNftWithdrawalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFinalizeNftWithdrawalResponse_messageType{}
+
+type fastReflection_MsgFinalizeNftWithdrawalResponse_messageType struct{}
+
+func (x fastReflection_MsgFinalizeNftWithdrawalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFinalizeNftWithdrawalResponse)(nil)
+}
+func (x fastReflection_MsgFinalizeNftWithdrawalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFinalizeNftWithdrawalResponse)
+}
+func (x fastReflection_MsgFinalizeNftWithdrawalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFinalizeNftWithdrawalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFinalizeNftWithdrawalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFinalizeNftWithdrawalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgFinalizeNftWithdrawalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgFinalizeNftWithdrawalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFinalizeNftWithdrawalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFinalizeNftWithdrawalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFinalizeNftWithdrawalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFinalizeNftWithdrawalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFinalizeNftWithdrawalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFinalizeNftWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateProposer              protoreflect.MessageDescriptor
+	fd_MsgUpdateProposer_authority    protoreflect.FieldDescriptor
+	fd_MsgUpdateProposer_bridge_id    protoreflect.FieldDescriptor
+	fd_MsgUpdateProposer_new_proposer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateProposer = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateProposer")
+	fd_MsgUpdateProposer_authority = md_MsgUpdateProposer.Fields().ByName("authority")
+	fd_MsgUpdateProposer_bridge_id = md_MsgUpdateProposer.Fields().ByName("bridge_id")
+	fd_MsgUpdateProposer_new_proposer = md_MsgUpdateProposer.Fields().ByName("new_proposer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateProposer)(nil)
+
+type fastReflection_MsgUpdateProposer MsgUpdateProposer
+
+func (x *MsgUpdateProposer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposer)(x)
+}
+
+func (x *MsgUpdateProposer) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateProposer_messageType fastReflection_MsgUpdateProposer_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateProposer_messageType{}
+
+type fastReflection_MsgUpdateProposer_messageType struct{}
+
+func (x fastReflection_MsgUpdateProposer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposer)(nil)
+}
+func (x fastReflection_MsgUpdateProposer_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposer)
+}
+func (x fastReflection_MsgUpdateProposer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateProposer) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateProposer) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateProposer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateProposer) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateProposer) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateProposer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateProposer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateProposer_authority, value) {
+			return
+		}
+	}
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_MsgUpdateProposer_bridge_id, value) {
+			return
+		}
+	}
+	if x.NewProposer != "" {
+		value := protoreflect.ValueOfString(x.NewProposer)
+		if !f(fd_MsgUpdateProposer_new_proposer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateProposer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+		return x.Authority != ""
+	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+		return x.NewProposer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+		x.Authority = ""
+	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+		x.NewProposer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateProposer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+		value := x.NewProposer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+		x.Authority = value.Interface().(string)
+	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+		x.NewProposer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateProposer is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateProposer is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+		panic(fmt.Errorf("field new_proposer of message opinit.ophost.v1.MsgUpdateProposer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateProposer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposer.authority":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgUpdateProposer.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateProposer.new_proposer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposer"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateProposer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateProposer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateProposer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateProposer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateProposer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateProposer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		l = len(x.NewProposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateProposer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewProposer) > 0 {
+			i -= len(x.NewProposer)
+			copy(dAtA[i:], x.NewProposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewProposer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateProposer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewProposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewProposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateProposerResponse                 protoreflect.MessageDescriptor
+	fd_MsgUpdateProposerResponse_output_index    protoreflect.FieldDescriptor
+	fd_MsgUpdateProposerResponse_l2_block_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateProposerResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateProposerResponse")
+	fd_MsgUpdateProposerResponse_output_index = md_MsgUpdateProposerResponse.Fields().ByName("output_index")
+	fd_MsgUpdateProposerResponse_l2_block_number = md_MsgUpdateProposerResponse.Fields().ByName("l2_block_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateProposerResponse)(nil)
+
+type fastReflection_MsgUpdateProposerResponse MsgUpdateProposerResponse
+
+func (x *MsgUpdateProposerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposerResponse)(x)
+}
+
+func (x *MsgUpdateProposerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateProposerResponse_messageType fastReflection_MsgUpdateProposerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateProposerResponse_messageType{}
+
+type fastReflection_MsgUpdateProposerResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateProposerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposerResponse)(nil)
+}
+func (x fastReflection_MsgUpdateProposerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposerResponse)
+}
+func (x fastReflection_MsgUpdateProposerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateProposerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateProposerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateProposerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateProposerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateProposerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateProposerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateProposerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_MsgUpdateProposerResponse_output_index, value) {
+			return
+		}
+	}
+	if x.L2BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
+		if !f(fd_MsgUpdateProposerResponse_l2_block_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateProposerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+		return x.L2BlockNumber != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+		x.L2BlockNumber = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateProposerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+		value := x.L2BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+		x.L2BlockNumber = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateProposerResponse is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateProposerResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateProposerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateProposerResponse.l2_block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateProposerResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateProposerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateProposerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateProposerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateProposerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateProposerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateProposerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateProposerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if x.L2BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateProposerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.L2BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateProposerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+				}
+				x.L2BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.L2BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgUpdateChallengers_3_list)(nil)
+
+type _MsgUpdateChallengers_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgUpdateChallengers_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateChallengers_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgUpdateChallengers_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateChallengers_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateChallengers_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgUpdateChallengers at list field NewChallengers as it is not of Message kind"))
+}
+
+func (x *_MsgUpdateChallengers_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateChallengers_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgUpdateChallengers_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgUpdateChallengers                 protoreflect.MessageDescriptor
+	fd_MsgUpdateChallengers_authority       protoreflect.FieldDescriptor
+	fd_MsgUpdateChallengers_bridge_id       protoreflect.FieldDescriptor
+	fd_MsgUpdateChallengers_new_challengers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateChallengers = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateChallengers")
+	fd_MsgUpdateChallengers_authority = md_MsgUpdateChallengers.Fields().ByName("authority")
+	fd_MsgUpdateChallengers_bridge_id = md_MsgUpdateChallengers.Fields().ByName("bridge_id")
+	fd_MsgUpdateChallengers_new_challengers = md_MsgUpdateChallengers.Fields().ByName("new_challengers")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateChallengers)(nil)
+
+type fastReflection_MsgUpdateChallengers MsgUpdateChallengers
+
+func (x *MsgUpdateChallengers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateChallengers)(x)
+}
+
+func (x *MsgUpdateChallengers) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateChallengers_messageType fastReflection_MsgUpdateChallengers_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateChallengers_messageType{}
+
+type fastReflection_MsgUpdateChallengers_messageType struct{}
+
+func (x fastReflection_MsgUpdateChallengers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateChallengers)(nil)
+}
+func (x fastReflection_MsgUpdateChallengers_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateChallengers)
+}
+func (x fastReflection_MsgUpdateChallengers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateChallengers
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateChallengers) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateChallengers
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateChallengers) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateChallengers_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateChallengers) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateChallengers)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateChallengers) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateChallengers)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateChallengers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateChallengers_authority, value) {
+			return
+		}
+	}
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_MsgUpdateChallengers_bridge_id, value) {
+			return
+		}
+	}
+	if len(x.NewChallengers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateChallengers_3_list{list: &x.NewChallengers})
+		if !f(fd_MsgUpdateChallengers_new_challengers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateChallengers) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengers.authority":
+		return x.Authority != ""
+	case "opinit.ophost.v1.MsgUpdateChallengers.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateChallengers.new_challengers":
+		return len(x.NewChallengers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengers"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengers) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengers.authority":
+		x.Authority = ""
+	case "opinit.ophost.v1.MsgUpdateChallengers.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateChallengers.new_challengers":
+		x.NewChallengers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengers"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateChallengers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengers.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgUpdateChallengers.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateChallengers.new_challengers":
+		if len(x.NewChallengers) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateChallengers_3_list{})
+		}
+		listValue := &_MsgUpdateChallengers_3_list{list: &x.NewChallengers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengers"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengers does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengers.authority":
+		x.Authority = value.Interface().(string)
+	case "opinit.ophost.v1.MsgUpdateChallengers.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateChallengers.new_challengers":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateChallengers_3_list)
+		x.NewChallengers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengers"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengers.new_challengers":
+		if x.NewChallengers == nil {
+			x.NewChallengers = []string{}
+		}
+		value := &_MsgUpdateChallengers_3_list{list: &x.NewChallengers}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.MsgUpdateChallengers.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateChallengers is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateChallengers.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateChallengers is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengers"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengers does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateChallengers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengers.authority":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgUpdateChallengers.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateChallengers.new_challengers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgUpdateChallengers_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengers"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengers does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateChallengers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateChallengers", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateChallengers) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengers) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateChallengers) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateChallengers) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateChallengers)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if len(x.NewChallengers) > 0 {
+			for _, s := range x.NewChallengers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateChallengers)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewChallengers) > 0 {
+			for iNdEx := len(x.NewChallengers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NewChallengers[iNdEx])
+				copy(dAtA[i:], x.NewChallengers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewChallengers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateChallengers)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallengers: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallengers: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewChallengers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewChallengers = append(x.NewChallengers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateChallengersResponse                 protoreflect.MessageDescriptor
+	fd_MsgUpdateChallengersResponse_output_index    protoreflect.FieldDescriptor
+	fd_MsgUpdateChallengersResponse_l2_block_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateChallengersResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateChallengersResponse")
+	fd_MsgUpdateChallengersResponse_output_index = md_MsgUpdateChallengersResponse.Fields().ByName("output_index")
+	fd_MsgUpdateChallengersResponse_l2_block_number = md_MsgUpdateChallengersResponse.Fields().ByName("l2_block_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateChallengersResponse)(nil)
+
+type fastReflection_MsgUpdateChallengersResponse MsgUpdateChallengersResponse
+
+func (x *MsgUpdateChallengersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateChallengersResponse)(x)
+}
+
+func (x *MsgUpdateChallengersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateChallengersResponse_messageType fastReflection_MsgUpdateChallengersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateChallengersResponse_messageType{}
+
+type fastReflection_MsgUpdateChallengersResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateChallengersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateChallengersResponse)(nil)
+}
+func (x fastReflection_MsgUpdateChallengersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateChallengersResponse)
+}
+func (x fastReflection_MsgUpdateChallengersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateChallengersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateChallengersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateChallengersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateChallengersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateChallengersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateChallengersResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateChallengersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateChallengersResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateChallengersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateChallengersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_MsgUpdateChallengersResponse_output_index, value) {
+			return
+		}
+	}
+	if x.L2BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
+		if !f(fd_MsgUpdateChallengersResponse_l2_block_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateChallengersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.l2_block_number":
+		return x.L2BlockNumber != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengersResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.l2_block_number":
+		x.L2BlockNumber = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengersResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateChallengersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.l2_block_number":
+		value := x.L2BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengersResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.l2_block_number":
+		x.L2BlockNumber = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengersResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateChallengersResponse is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.l2_block_number":
+		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateChallengersResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengersResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateChallengersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateChallengersResponse.l2_block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengersResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateChallengersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateChallengersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateChallengersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateChallengersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateChallengersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateChallengersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateChallengersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if x.L2BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateChallengersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.L2BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateChallengersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallengersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallengersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+				}
+				x.L2BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.L2BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBatchInfo                protoreflect.MessageDescriptor
+	fd_MsgUpdateBatchInfo_authority      protoreflect.FieldDescriptor
+	fd_MsgUpdateBatchInfo_bridge_id      protoreflect.FieldDescriptor
+	fd_MsgUpdateBatchInfo_new_batch_info protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateBatchInfo = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateBatchInfo")
+	fd_MsgUpdateBatchInfo_authority = md_MsgUpdateBatchInfo.Fields().ByName("authority")
+	fd_MsgUpdateBatchInfo_bridge_id = md_MsgUpdateBatchInfo.Fields().ByName("bridge_id")
+	fd_MsgUpdateBatchInfo_new_batch_info = md_MsgUpdateBatchInfo.Fields().ByName("new_batch_info")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBatchInfo)(nil)
+
+type fastReflection_MsgUpdateBatchInfo MsgUpdateBatchInfo
+
+func (x *MsgUpdateBatchInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBatchInfo)(x)
+}
+
+func (x *MsgUpdateBatchInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBatchInfo_messageType fastReflection_MsgUpdateBatchInfo_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBatchInfo_messageType{}
+
+type fastReflection_MsgUpdateBatchInfo_messageType struct{}
+
+func (x fastReflection_MsgUpdateBatchInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBatchInfo)(nil)
+}
+func (x fastReflection_MsgUpdateBatchInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBatchInfo)
+}
+func (x fastReflection_MsgUpdateBatchInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBatchInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBatchInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBatchInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBatchInfo) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBatchInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBatchInfo) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBatchInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBatchInfo) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBatchInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBatchInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateBatchInfo_authority, value) {
+			return
+		}
+	}
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_MsgUpdateBatchInfo_bridge_id, value) {
+			return
+		}
+	}
+	if x.NewBatchInfo != nil {
+		value := protoreflect.ValueOfMessage(x.NewBatchInfo.ProtoReflect())
+		if !f(fd_MsgUpdateBatchInfo_new_batch_info, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBatchInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+		return x.Authority != ""
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
+		return x.NewBatchInfo != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+		x.Authority = ""
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
+		x.NewBatchInfo = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBatchInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
+		value := x.NewBatchInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+		x.Authority = value.Interface().(string)
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
+		x.NewBatchInfo = value.Message().Interface().(*BatchInfo)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
+		if x.NewBatchInfo == nil {
+			x.NewBatchInfo = new(BatchInfo)
+		}
+		return protoreflect.ValueOfMessage(x.NewBatchInfo.ProtoReflect())
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateBatchInfo is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateBatchInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBatchInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
+		m := new(BatchInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBatchInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateBatchInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBatchInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBatchInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBatchInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		if x.NewBatchInfo != nil {
+			l = options.Size(x.NewBatchInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBatchInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NewBatchInfo != nil {
+			encoded, err := options.Marshal(x.NewBatchInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBatchInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewBatchInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NewBatchInfo == nil {
+					x.NewBatchInfo = &BatchInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewBatchInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBatchInfoResponse                 protoreflect.MessageDescriptor
+	fd_MsgUpdateBatchInfoResponse_output_index    protoreflect.FieldDescriptor
+	fd_MsgUpdateBatchInfoResponse_l2_block_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateBatchInfoResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateBatchInfoResponse")
+	fd_MsgUpdateBatchInfoResponse_output_index = md_MsgUpdateBatchInfoResponse.Fields().ByName("output_index")
+	fd_MsgUpdateBatchInfoResponse_l2_block_number = md_MsgUpdateBatchInfoResponse.Fields().ByName("l2_block_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBatchInfoResponse)(nil)
+
+type fastReflection_MsgUpdateBatchInfoResponse MsgUpdateBatchInfoResponse
+
+func (x *MsgUpdateBatchInfoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBatchInfoResponse)(x)
+}
+
+func (x *MsgUpdateBatchInfoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBatchInfoResponse_messageType fastReflection_MsgUpdateBatchInfoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBatchInfoResponse_messageType{}
+
+type fastReflection_MsgUpdateBatchInfoResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateBatchInfoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBatchInfoResponse)(nil)
+}
+func (x fastReflection_MsgUpdateBatchInfoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBatchInfoResponse)
+}
+func (x fastReflection_MsgUpdateBatchInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBatchInfoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBatchInfoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBatchInfoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBatchInfoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBatchInfoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_MsgUpdateBatchInfoResponse_output_index, value) {
+			return
+		}
+	}
+	if x.L2BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
+		if !f(fd_MsgUpdateBatchInfoResponse_l2_block_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
+		return x.L2BlockNumber != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
+		x.L2BlockNumber = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
+		value := x.L2BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
+		x.L2BlockNumber = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateBatchInfoResponse is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
+		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateBatchInfoResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateBatchInfoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBatchInfoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if x.L2BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBatchInfoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.L2BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBatchInfoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+				}
+				x.L2BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.L2BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateMetadata           protoreflect.MessageDescriptor
+	fd_MsgUpdateMetadata_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateMetadata_bridge_id protoreflect.FieldDescriptor
+	fd_MsgUpdateMetadata_metadata  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateMetadata = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateMetadata")
+	fd_MsgUpdateMetadata_authority = md_MsgUpdateMetadata.Fields().ByName("authority")
+	fd_MsgUpdateMetadata_bridge_id = md_MsgUpdateMetadata.Fields().ByName("bridge_id")
+	fd_MsgUpdateMetadata_metadata = md_MsgUpdateMetadata.Fields().ByName("metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateMetadata)(nil)
+
+type fastReflection_MsgUpdateMetadata MsgUpdateMetadata
+
+func (x *MsgUpdateMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateMetadata)(x)
+}
+
+func (x *MsgUpdateMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateMetadata_messageType fastReflection_MsgUpdateMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateMetadata_messageType{}
+
+type fastReflection_MsgUpdateMetadata_messageType struct{}
+
+func (x fastReflection_MsgUpdateMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateMetadata)(nil)
+}
+func (x fastReflection_MsgUpdateMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateMetadata)
+}
+func (x fastReflection_MsgUpdateMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateMetadata) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateMetadata) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateMetadata_authority, value) {
+			return
+		}
+	}
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_MsgUpdateMetadata_bridge_id, value) {
+			return
+		}
+	}
+	if len(x.Metadata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Metadata)
+		if !f(fd_MsgUpdateMetadata_metadata, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+		return x.Authority != ""
+	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
+		return len(x.Metadata) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+		x.Authority = ""
+	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
+		x.Metadata = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+		x.Authority = value.Interface().(string)
+	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
+		x.Metadata = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateMetadata is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateMetadata is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
+		panic(fmt.Errorf("field metadata of message opinit.ophost.v1.MsgUpdateMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = append(x.Metadata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Metadata == nil {
+					x.Metadata = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateMetadataResponse                 protoreflect.MessageDescriptor
+	fd_MsgUpdateMetadataResponse_output_index    protoreflect.FieldDescriptor
+	fd_MsgUpdateMetadataResponse_l2_block_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateMetadataResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateMetadataResponse")
+	fd_MsgUpdateMetadataResponse_output_index = md_MsgUpdateMetadataResponse.Fields().ByName("output_index")
+	fd_MsgUpdateMetadataResponse_l2_block_number = md_MsgUpdateMetadataResponse.Fields().ByName("l2_block_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateMetadataResponse)(nil)
+
+type fastReflection_MsgUpdateMetadataResponse MsgUpdateMetadataResponse
+
+func (x *MsgUpdateMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateMetadataResponse)(x)
+}
+
+func (x *MsgUpdateMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateMetadataResponse_messageType fastReflection_MsgUpdateMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateMetadataResponse_messageType{}
+
+type fastReflection_MsgUpdateMetadataResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateMetadataResponse)(nil)
+}
+func (x fastReflection_MsgUpdateMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateMetadataResponse)
+}
+func (x fastReflection_MsgUpdateMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateMetadataResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateMetadataResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateMetadataResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateMetadataResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateMetadataResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OutputIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputIndex)
+		if !f(fd_MsgUpdateMetadataResponse_output_index, value) {
+			return
+		}
+	}
+	if x.L2BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
+		if !f(fd_MsgUpdateMetadataResponse_l2_block_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
+		return x.OutputIndex != uint64(0)
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
+		return x.L2BlockNumber != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
+		x.OutputIndex = uint64(0)
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
+		x.L2BlockNumber = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
+		value := x.OutputIndex
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
+		value := x.L2BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
+		x.OutputIndex = value.Uint()
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
+		x.L2BlockNumber = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
+		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateMetadataResponse is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
+		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateMetadataResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateMetadataResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateMetadataResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateMetadataResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateMetadataResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OutputIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutputIndex))
+		}
+		if x.L2BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateMetadataResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.L2BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OutputIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateMetadataResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadataResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				}
+				x.OutputIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+				}
+				x.L2BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.L2BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_tx_proto_init()
+	md_MsgUpdateParams = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+
+type fastReflection_MsgUpdateParams MsgUpdateParams
+
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
+}
+
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+
+type fastReflection_MsgUpdateParams_messageType struct{}
+
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
+}
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "opinit.ophost.v1.MsgUpdateParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "opinit.ophost.v1.MsgUpdateParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallenger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallenger does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7417,21 +13013,17 @@ func (x *fastReflection_MsgUpdateChallenger) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallenger) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallenger.authority":
+	case "opinit.ophost.v1.MsgUpdateParams.authority":
 		x.Authority = value.Interface().(string)
-	case "opinit.ophost.v1.MsgUpdateChallenger.bridge_id":
-		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateChallenger.new_challengers":
-		lv := value.List()
-		clv := lv.(*_MsgUpdateChallenger_3_list)
-		x.NewChallengers = *clv.list
+	case "opinit.ophost.v1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallenger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallenger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7445,53 +13037,48 @@ func (x *fastReflection_MsgUpdateChallenger) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallenger) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallenger.new_challengers":
-		if x.NewChallengers == nil {
-			x.NewChallengers = []string{}
+	case "opinit.ophost.v1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		value := &_MsgUpdateChallenger_3_list{list: &x.NewChallengers}
-		return protoreflect.ValueOfList(value)
-	case "opinit.ophost.v1.MsgUpdateChallenger.authority":
-		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateChallenger is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateChallenger.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateChallenger is not mutable"))
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "opinit.ophost.v1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallenger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallenger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateChallenger) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallenger.authority":
+	case "opinit.ophost.v1.MsgUpdateParams.authority":
 		return protoreflect.ValueOfString("")
-	case "opinit.ophost.v1.MsgUpdateChallenger.bridge_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateChallenger.new_challengers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgUpdateChallenger_3_list{list: &list})
+	case "opinit.ophost.v1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallenger"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallenger does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateChallenger) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateChallenger", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7499,7 +13086,7 @@ func (x *fastReflection_MsgUpdateChallenger) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateChallenger) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7510,7 +13097,7 @@ func (x *fastReflection_MsgUpdateChallenger) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallenger) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7522,7 +13109,7 @@ func (x *fastReflection_MsgUpdateChallenger) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateChallenger) IsValid() bool {
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 	return x != nil
 }
 
@@ -7532,9 +13119,9 @@ func (x *fastReflection_MsgUpdateChallenger) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateChallenger)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7550,14 +13137,9 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BridgeId != 0 {
-			n += 1 + runtime.Sov(uint64(x.BridgeId))
-		}
-		if len(x.NewChallengers) > 0 {
-			for _, s := range x.NewChallengers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7569,7 +13151,7 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateChallenger)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7588,19 +13170,19 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewChallengers) > 0 {
-			for iNdEx := len(x.NewChallengers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.NewChallengers[iNdEx])
-				copy(dAtA[i:], x.NewChallengers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewChallengers[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if x.BridgeId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if len(x.Authority) > 0 {
 			i -= len(x.Authority)
@@ -7620,7 +13202,7 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateChallenger)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7652,10 +13234,10 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallenger: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallenger: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7691,29 +13273,10 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
-				}
-				x.BridgeId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BridgeId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewChallengers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7723,23 +13286,27 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewChallengers = append(x.NewChallengers, string(dAtA[iNdEx:postIndex]))
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7777,28 +13344,24 @@ func (x *fastReflection_MsgUpdateChallenger) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgUpdateChallengerResponse                 protoreflect.MessageDescriptor
-	fd_MsgUpdateChallengerResponse_output_index    protoreflect.FieldDescriptor
-	fd_MsgUpdateChallengerResponse_l2_block_number protoreflect.FieldDescriptor
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateChallengerResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateChallengerResponse")
-	fd_MsgUpdateChallengerResponse_output_index = md_MsgUpdateChallengerResponse.Fields().ByName("output_index")
-	fd_MsgUpdateChallengerResponse_l2_block_number = md_MsgUpdateChallengerResponse.Fields().ByName("l2_block_number")
+	md_MsgUpdateParamsResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateChallengerResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
 
-type fastReflection_MsgUpdateChallengerResponse MsgUpdateChallengerResponse
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
 
-func (x *MsgUpdateChallengerResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateChallengerResponse)(x)
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
 }
 
-func (x *MsgUpdateChallengerResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[15]
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7809,43 +13372,43 @@ func (x *MsgUpdateChallengerResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateChallengerResponse_messageType fastReflection_MsgUpdateChallengerResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateChallengerResponse_messageType{}
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
 
-type fastReflection_MsgUpdateChallengerResponse_messageType struct{}
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateChallengerResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateChallengerResponse)(nil)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
 }
-func (x fastReflection_MsgUpdateChallengerResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateChallengerResponse)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
-func (x fastReflection_MsgUpdateChallengerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateChallengerResponse
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateChallengerResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateChallengerResponse
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateChallengerResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateChallengerResponse_messageType
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateChallengerResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateChallengerResponse)
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateChallengerResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateChallengerResponse)(x)
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7853,19 +13416,7 @@ func (x *fastReflection_MsgUpdateChallengerResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateChallengerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_MsgUpdateChallengerResponse_output_index, value) {
-			return
-		}
-	}
-	if x.L2BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
-		if !f(fd_MsgUpdateChallengerResponse_l2_block_number, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7879,17 +13430,13 @@ func (x *fastReflection_MsgUpdateChallengerResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateChallengerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.l2_block_number":
-		return x.L2BlockNumber != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7899,17 +13446,13 @@ func (x *fastReflection_MsgUpdateChallengerResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallengerResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.l2_block_number":
-		x.L2BlockNumber = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7919,19 +13462,13 @@ func (x *fastReflection_MsgUpdateChallengerResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateChallengerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.l2_block_number":
-		value := x.L2BlockNumber
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengerResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7945,17 +13482,13 @@ func (x *fastReflection_MsgUpdateChallengerResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallengerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.l2_block_number":
-		x.L2BlockNumber = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7969,44 +13502,36 @@ func (x *fastReflection_MsgUpdateChallengerResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallengerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateChallengerResponse is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.l2_block_number":
-		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateChallengerResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateChallengerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.output_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateChallengerResponse.l2_block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateChallengerResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateChallengerResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateChallengerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateChallengerResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8014,7 +13539,7 @@ func (x *fastReflection_MsgUpdateChallengerResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateChallengerResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8025,7 +13550,7 @@ func (x *fastReflection_MsgUpdateChallengerResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateChallengerResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8037,7 +13562,7 @@ func (x *fastReflection_MsgUpdateChallengerResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateChallengerResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8047,9 +13572,9 @@ func (x *fastReflection_MsgUpdateChallengerResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateChallengerResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8061,12 +13586,6 @@ func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
-		}
-		if x.L2BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8077,7 +13596,7 @@ func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateChallengerResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8096,16 +13615,6 @@ func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.L2BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8117,7 +13626,7 @@ func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateChallengerResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8149,50 +13658,12 @@ func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallengerResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateChallengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-				}
-				x.OutputIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-				}
-				x.L2BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.L2BlockNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8229,30 +13700,30 @@ func (x *fastReflection_MsgUpdateChallengerResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgUpdateBatchInfo                protoreflect.MessageDescriptor
-	fd_MsgUpdateBatchInfo_authority      protoreflect.FieldDescriptor
-	fd_MsgUpdateBatchInfo_bridge_id      protoreflect.FieldDescriptor
-	fd_MsgUpdateBatchInfo_new_batch_info protoreflect.FieldDescriptor
+	md_MsgUpdateDepositCap             protoreflect.MessageDescriptor
+	fd_MsgUpdateDepositCap_authority   protoreflect.FieldDescriptor
+	fd_MsgUpdateDepositCap_bridge_id   protoreflect.FieldDescriptor
+	fd_MsgUpdateDepositCap_deposit_cap protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateBatchInfo = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateBatchInfo")
-	fd_MsgUpdateBatchInfo_authority = md_MsgUpdateBatchInfo.Fields().ByName("authority")
-	fd_MsgUpdateBatchInfo_bridge_id = md_MsgUpdateBatchInfo.Fields().ByName("bridge_id")
-	fd_MsgUpdateBatchInfo_new_batch_info = md_MsgUpdateBatchInfo.Fields().ByName("new_batch_info")
+	md_MsgUpdateDepositCap = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateDepositCap")
+	fd_MsgUpdateDepositCap_authority = md_MsgUpdateDepositCap.Fields().ByName("authority")
+	fd_MsgUpdateDepositCap_bridge_id = md_MsgUpdateDepositCap.Fields().ByName("bridge_id")
+	fd_MsgUpdateDepositCap_deposit_cap = md_MsgUpdateDepositCap.Fields().ByName("deposit_cap")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateBatchInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateDepositCap)(nil)
 
-type fastReflection_MsgUpdateBatchInfo MsgUpdateBatchInfo
+type fastReflection_MsgUpdateDepositCap MsgUpdateDepositCap
 
-func (x *MsgUpdateBatchInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateBatchInfo)(x)
+func (x *MsgUpdateDepositCap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDepositCap)(x)
 }
 
-func (x *MsgUpdateBatchInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[16]
+func (x *MsgUpdateDepositCap) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8263,43 +13734,43 @@ func (x *MsgUpdateBatchInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateBatchInfo_messageType fastReflection_MsgUpdateBatchInfo_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateBatchInfo_messageType{}
+var _fastReflection_MsgUpdateDepositCap_messageType fastReflection_MsgUpdateDepositCap_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateDepositCap_messageType{}
 
-type fastReflection_MsgUpdateBatchInfo_messageType struct{}
+type fastReflection_MsgUpdateDepositCap_messageType struct{}
 
-func (x fastReflection_MsgUpdateBatchInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateBatchInfo)(nil)
+func (x fastReflection_MsgUpdateDepositCap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDepositCap)(nil)
 }
-func (x fastReflection_MsgUpdateBatchInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateBatchInfo)
+func (x fastReflection_MsgUpdateDepositCap_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDepositCap)
 }
-func (x fastReflection_MsgUpdateBatchInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateBatchInfo
+func (x fastReflection_MsgUpdateDepositCap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDepositCap
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateBatchInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateBatchInfo
+func (x *fastReflection_MsgUpdateDepositCap) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDepositCap
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateBatchInfo) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateBatchInfo_messageType
+func (x *fastReflection_MsgUpdateDepositCap) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateDepositCap_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateBatchInfo) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateBatchInfo)
+func (x *fastReflection_MsgUpdateDepositCap) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDepositCap)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateBatchInfo) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateBatchInfo)(x)
+func (x *fastReflection_MsgUpdateDepositCap) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateDepositCap)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8307,22 +13778,22 @@ func (x *fastReflection_MsgUpdateBatchInfo) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateBatchInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateDepositCap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateBatchInfo_authority, value) {
+		if !f(fd_MsgUpdateDepositCap_authority, value) {
 			return
 		}
 	}
 	if x.BridgeId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_MsgUpdateBatchInfo_bridge_id, value) {
+		if !f(fd_MsgUpdateDepositCap_bridge_id, value) {
 			return
 		}
 	}
-	if x.NewBatchInfo != nil {
-		value := protoreflect.ValueOfMessage(x.NewBatchInfo.ProtoReflect())
-		if !f(fd_MsgUpdateBatchInfo_new_batch_info, value) {
+	if x.DepositCap != nil {
+		value := protoreflect.ValueOfMessage(x.DepositCap.ProtoReflect())
+		if !f(fd_MsgUpdateDepositCap_deposit_cap, value) {
 			return
 		}
 	}
@@ -8339,19 +13810,19 @@ func (x *fastReflection_MsgUpdateBatchInfo) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateBatchInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateDepositCap) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.authority":
 		return x.Authority != ""
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.bridge_id":
 		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
-		return x.NewBatchInfo != nil
+	case "opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap":
+		return x.DepositCap != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCap"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCap does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8361,19 +13832,19 @@ func (x *fastReflection_MsgUpdateBatchInfo) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateDepositCap) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.authority":
 		x.Authority = ""
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.bridge_id":
 		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
-		x.NewBatchInfo = nil
+	case "opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap":
+		x.DepositCap = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCap"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCap does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8383,22 +13854,22 @@ func (x *fastReflection_MsgUpdateBatchInfo) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateBatchInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDepositCap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
-		value := x.NewBatchInfo
+	case "opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap":
+		value := x.DepositCap
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCap"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCap does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8412,19 +13883,19 @@ func (x *fastReflection_MsgUpdateBatchInfo) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateDepositCap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.authority":
 		x.Authority = value.Interface().(string)
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.bridge_id":
 		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
-		x.NewBatchInfo = value.Message().Interface().(*BatchInfo)
+	case "opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap":
+		x.DepositCap = value.Message().Interface().(*DepositCap)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCap"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCap does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8438,52 +13909,52 @@ func (x *fastReflection_MsgUpdateBatchInfo) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDepositCap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
-		if x.NewBatchInfo == nil {
-			x.NewBatchInfo = new(BatchInfo)
-		}
-		return protoreflect.ValueOfMessage(x.NewBatchInfo.ProtoReflect())
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
-		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateBatchInfo is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateBatchInfo is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap":
+		if x.DepositCap == nil {
+			x.DepositCap = new(DepositCap)
+		}
+		return protoreflect.ValueOfMessage(x.DepositCap.ProtoReflect())
+	case "opinit.ophost.v1.MsgUpdateDepositCap.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateDepositCap is not mutable"))
+	case "opinit.ophost.v1.MsgUpdateDepositCap.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateDepositCap is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCap"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCap does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateBatchInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDepositCap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.authority":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.authority":
 		return protoreflect.ValueOfString("")
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.bridge_id":
+	case "opinit.ophost.v1.MsgUpdateDepositCap.bridge_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info":
-		m := new(BatchInfo)
+	case "opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap":
+		m := new(DepositCap)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCap"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCap does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateBatchInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateDepositCap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateBatchInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateDepositCap", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8491,7 +13962,7 @@ func (x *fastReflection_MsgUpdateBatchInfo) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateBatchInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateDepositCap) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8502,7 +13973,7 @@ func (x *fastReflection_MsgUpdateBatchInfo) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateDepositCap) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8514,7 +13985,7 @@ func (x *fastReflection_MsgUpdateBatchInfo) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateBatchInfo) IsValid() bool {
+func (x *fastReflection_MsgUpdateDepositCap) IsValid() bool {
 	return x != nil
 }
 
@@ -8524,9 +13995,9 @@ func (x *fastReflection_MsgUpdateBatchInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateDepositCap) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateBatchInfo)
+		x := input.Message.Interface().(*MsgUpdateDepositCap)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8545,8 +14016,8 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 		if x.BridgeId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BridgeId))
 		}
-		if x.NewBatchInfo != nil {
-			l = options.Size(x.NewBatchInfo)
+		if x.DepositCap != nil {
+			l = options.Size(x.DepositCap)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8559,7 +14030,7 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateBatchInfo)
+		x := input.Message.Interface().(*MsgUpdateDepositCap)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8578,8 +14049,8 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.NewBatchInfo != nil {
-			encoded, err := options.Marshal(x.NewBatchInfo)
+		if x.DepositCap != nil {
+			encoded, err := options.Marshal(x.DepositCap)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8615,7 +14086,7 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateBatchInfo)
+		x := input.Message.Interface().(*MsgUpdateDepositCap)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8647,10 +14118,10 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDepositCap: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDepositCap: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8706,7 +14177,7 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewBatchInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8733,10 +14204,10 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.NewBatchInfo == nil {
-					x.NewBatchInfo = &BatchInfo{}
+				if x.DepositCap == nil {
+					x.DepositCap = &DepositCap{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewBatchInfo); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositCap); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8776,28 +14247,24 @@ func (x *fastReflection_MsgUpdateBatchInfo) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateBatchInfoResponse                 protoreflect.MessageDescriptor
-	fd_MsgUpdateBatchInfoResponse_output_index    protoreflect.FieldDescriptor
-	fd_MsgUpdateBatchInfoResponse_l2_block_number protoreflect.FieldDescriptor
+	md_MsgUpdateDepositCapResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateBatchInfoResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateBatchInfoResponse")
-	fd_MsgUpdateBatchInfoResponse_output_index = md_MsgUpdateBatchInfoResponse.Fields().ByName("output_index")
-	fd_MsgUpdateBatchInfoResponse_l2_block_number = md_MsgUpdateBatchInfoResponse.Fields().ByName("l2_block_number")
+	md_MsgUpdateDepositCapResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateDepositCapResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateBatchInfoResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateDepositCapResponse)(nil)
 
-type fastReflection_MsgUpdateBatchInfoResponse MsgUpdateBatchInfoResponse
+type fastReflection_MsgUpdateDepositCapResponse MsgUpdateDepositCapResponse
 
-func (x *MsgUpdateBatchInfoResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateBatchInfoResponse)(x)
+func (x *MsgUpdateDepositCapResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDepositCapResponse)(x)
 }
 
-func (x *MsgUpdateBatchInfoResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[17]
+func (x *MsgUpdateDepositCapResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8808,43 +14275,43 @@ func (x *MsgUpdateBatchInfoResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateBatchInfoResponse_messageType fastReflection_MsgUpdateBatchInfoResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateBatchInfoResponse_messageType{}
+var _fastReflection_MsgUpdateDepositCapResponse_messageType fastReflection_MsgUpdateDepositCapResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateDepositCapResponse_messageType{}
 
-type fastReflection_MsgUpdateBatchInfoResponse_messageType struct{}
+type fastReflection_MsgUpdateDepositCapResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateBatchInfoResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateBatchInfoResponse)(nil)
+func (x fastReflection_MsgUpdateDepositCapResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDepositCapResponse)(nil)
 }
-func (x fastReflection_MsgUpdateBatchInfoResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateBatchInfoResponse)
+func (x fastReflection_MsgUpdateDepositCapResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDepositCapResponse)
 }
-func (x fastReflection_MsgUpdateBatchInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateBatchInfoResponse
+func (x fastReflection_MsgUpdateDepositCapResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDepositCapResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateBatchInfoResponse
+func (x *fastReflection_MsgUpdateDepositCapResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDepositCapResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateBatchInfoResponse_messageType
+func (x *fastReflection_MsgUpdateDepositCapResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateDepositCapResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateBatchInfoResponse)
+func (x *fastReflection_MsgUpdateDepositCapResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDepositCapResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateBatchInfoResponse)(x)
+func (x *fastReflection_MsgUpdateDepositCapResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateDepositCapResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8852,19 +14319,7 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_MsgUpdateBatchInfoResponse_output_index, value) {
-			return
-		}
-	}
-	if x.L2BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
-		if !f(fd_MsgUpdateBatchInfoResponse_l2_block_number, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgUpdateDepositCapResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8878,17 +14333,13 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateDepositCapResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
-		return x.L2BlockNumber != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCapResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8898,17 +14349,13 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateDepositCapResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
-		x.L2BlockNumber = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCapResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8918,19 +14365,13 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDepositCapResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
-		value := x.L2BlockNumber
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCapResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCapResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8944,17 +14385,13 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateDepositCapResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
-		x.L2BlockNumber = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCapResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8968,44 +14405,36 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDepositCapResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateBatchInfoResponse is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
-		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateBatchInfoResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCapResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDepositCapResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.output_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateBatchInfoResponse.l2_block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateBatchInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateDepositCapResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateBatchInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateDepositCapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateDepositCapResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateBatchInfoResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateDepositCapResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9013,7 +14442,7 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateDepositCapResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9024,7 +14453,7 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateDepositCapResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9036,7 +14465,7 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateDepositCapResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9046,9 +14475,9 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateDepositCapResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateBatchInfoResponse)
+		x := input.Message.Interface().(*MsgUpdateDepositCapResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9060,12 +14489,6 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
-		}
-		if x.L2BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9076,7 +14499,7 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateBatchInfoResponse)
+		x := input.Message.Interface().(*MsgUpdateDepositCapResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9095,16 +14518,6 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.L2BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9116,7 +14529,7 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateBatchInfoResponse)
+		x := input.Message.Interface().(*MsgUpdateDepositCapResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9148,50 +14561,12 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfoResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDepositCapResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBatchInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDepositCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-				}
-				x.OutputIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-				}
-				x.L2BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.L2BlockNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9227,31 +14602,84 @@ func (x *fastReflection_MsgUpdateBatchInfoResponse) ProtoMethods() *protoiface.M
 	}
 }
 
+var _ protoreflect.List = (*_MsgRecoverBridgeFunds_4_list)(nil)
+
+type _MsgRecoverBridgeFunds_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRecoverBridgeFunds_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateMetadata           protoreflect.MessageDescriptor
-	fd_MsgUpdateMetadata_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateMetadata_bridge_id protoreflect.FieldDescriptor
-	fd_MsgUpdateMetadata_metadata  protoreflect.FieldDescriptor
+	md_MsgRecoverBridgeFunds           protoreflect.MessageDescriptor
+	fd_MsgRecoverBridgeFunds_authority protoreflect.FieldDescriptor
+	fd_MsgRecoverBridgeFunds_bridge_id protoreflect.FieldDescriptor
+	fd_MsgRecoverBridgeFunds_recipient protoreflect.FieldDescriptor
+	fd_MsgRecoverBridgeFunds_amount    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateMetadata = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateMetadata")
-	fd_MsgUpdateMetadata_authority = md_MsgUpdateMetadata.Fields().ByName("authority")
-	fd_MsgUpdateMetadata_bridge_id = md_MsgUpdateMetadata.Fields().ByName("bridge_id")
-	fd_MsgUpdateMetadata_metadata = md_MsgUpdateMetadata.Fields().ByName("metadata")
+	md_MsgRecoverBridgeFunds = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgRecoverBridgeFunds")
+	fd_MsgRecoverBridgeFunds_authority = md_MsgRecoverBridgeFunds.Fields().ByName("authority")
+	fd_MsgRecoverBridgeFunds_bridge_id = md_MsgRecoverBridgeFunds.Fields().ByName("bridge_id")
+	fd_MsgRecoverBridgeFunds_recipient = md_MsgRecoverBridgeFunds.Fields().ByName("recipient")
+	fd_MsgRecoverBridgeFunds_amount = md_MsgRecoverBridgeFunds.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRecoverBridgeFunds)(nil)
 
-type fastReflection_MsgUpdateMetadata MsgUpdateMetadata
+type fastReflection_MsgRecoverBridgeFunds MsgRecoverBridgeFunds
 
-func (x *MsgUpdateMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMetadata)(x)
+func (x *MsgRecoverBridgeFunds) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverBridgeFunds)(x)
 }
 
-func (x *MsgUpdateMetadata) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[18]
+func (x *MsgRecoverBridgeFunds) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9262,43 +14690,43 @@ func (x *MsgUpdateMetadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateMetadata_messageType fastReflection_MsgUpdateMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateMetadata_messageType{}
+var _fastReflection_MsgRecoverBridgeFunds_messageType fastReflection_MsgRecoverBridgeFunds_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverBridgeFunds_messageType{}
 
-type fastReflection_MsgUpdateMetadata_messageType struct{}
+type fastReflection_MsgRecoverBridgeFunds_messageType struct{}
 
-func (x fastReflection_MsgUpdateMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMetadata)(nil)
+func (x fastReflection_MsgRecoverBridgeFunds_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverBridgeFunds)(nil)
 }
-func (x fastReflection_MsgUpdateMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMetadata)
+func (x fastReflection_MsgRecoverBridgeFunds_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverBridgeFunds)
 }
-func (x fastReflection_MsgUpdateMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMetadata
+func (x fastReflection_MsgRecoverBridgeFunds_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverBridgeFunds
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMetadata
+func (x *fastReflection_MsgRecoverBridgeFunds) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverBridgeFunds
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateMetadata_messageType
+func (x *fastReflection_MsgRecoverBridgeFunds) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverBridgeFunds_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateMetadata) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMetadata)
+func (x *fastReflection_MsgRecoverBridgeFunds) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverBridgeFunds)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateMetadata) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateMetadata)(x)
+func (x *fastReflection_MsgRecoverBridgeFunds) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverBridgeFunds)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9306,22 +14734,28 @@ func (x *fastReflection_MsgUpdateMetadata) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRecoverBridgeFunds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateMetadata_authority, value) {
+		if !f(fd_MsgRecoverBridgeFunds_authority, value) {
 			return
 		}
 	}
 	if x.BridgeId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.BridgeId)
-		if !f(fd_MsgUpdateMetadata_bridge_id, value) {
+		if !f(fd_MsgRecoverBridgeFunds_bridge_id, value) {
 			return
 		}
 	}
-	if len(x.Metadata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Metadata)
-		if !f(fd_MsgUpdateMetadata_metadata, value) {
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_MsgRecoverBridgeFunds_recipient, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverBridgeFunds_4_list{list: &x.Amount})
+		if !f(fd_MsgRecoverBridgeFunds_amount, value) {
 			return
 		}
 	}
@@ -9338,19 +14772,21 @@ func (x *fastReflection_MsgUpdateMetadata) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRecoverBridgeFunds) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.authority":
 		return x.Authority != ""
-	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.bridge_id":
 		return x.BridgeId != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
-		return len(x.Metadata) != 0
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.recipient":
+		return x.Recipient != ""
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.amount":
+		return len(x.Amount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFunds"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFunds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9360,19 +14796,21 @@ func (x *fastReflection_MsgUpdateMetadata) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRecoverBridgeFunds) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.authority":
 		x.Authority = ""
-	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.bridge_id":
 		x.BridgeId = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
-		x.Metadata = nil
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.recipient":
+		x.Recipient = ""
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.amount":
+		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFunds"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFunds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9382,22 +14820,28 @@ func (x *fastReflection_MsgUpdateMetadata) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverBridgeFunds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.bridge_id":
 		value := x.BridgeId
 		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverBridgeFunds_4_list{})
+		}
+		listValue := &_MsgRecoverBridgeFunds_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFunds"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFunds does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9411,19 +14855,23 @@ func (x *fastReflection_MsgUpdateMetadata) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRecoverBridgeFunds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.authority":
 		x.Authority = value.Interface().(string)
-	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.bridge_id":
 		x.BridgeId = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
-		x.Metadata = value.Bytes()
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.recipient":
+		x.Recipient = value.Interface().(string)
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.amount":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverBridgeFunds_4_list)
+		x.Amount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFunds"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFunds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9437,48 +14885,57 @@ func (x *fastReflection_MsgUpdateMetadata) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverBridgeFunds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
-		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateMetadata is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
-		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgUpdateMetadata is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
-		panic(fmt.Errorf("field metadata of message opinit.ophost.v1.MsgUpdateMetadata is not mutable"))
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgRecoverBridgeFunds_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgRecoverBridgeFunds is not mutable"))
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgRecoverBridgeFunds is not mutable"))
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.recipient":
+		panic(fmt.Errorf("field recipient of message opinit.ophost.v1.MsgRecoverBridgeFunds is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFunds"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFunds does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverBridgeFunds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadata.authority":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.authority":
 		return protoreflect.ValueOfString("")
-	case "opinit.ophost.v1.MsgUpdateMetadata.bridge_id":
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.bridge_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateMetadata.metadata":
-		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.recipient":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgRecoverBridgeFunds.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgRecoverBridgeFunds_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFunds"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFunds does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRecoverBridgeFunds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgRecoverBridgeFunds", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9486,7 +14943,7 @@ func (x *fastReflection_MsgUpdateMetadata) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRecoverBridgeFunds) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9497,7 +14954,7 @@ func (x *fastReflection_MsgUpdateMetadata) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRecoverBridgeFunds) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9509,7 +14966,7 @@ func (x *fastReflection_MsgUpdateMetadata) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateMetadata) IsValid() bool {
+func (x *fastReflection_MsgRecoverBridgeFunds) IsValid() bool {
 	return x != nil
 }
 
@@ -9519,9 +14976,9 @@ func (x *fastReflection_MsgUpdateMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRecoverBridgeFunds) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateMetadata)
+		x := input.Message.Interface().(*MsgRecoverBridgeFunds)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9540,10 +14997,16 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 		if x.BridgeId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BridgeId))
 		}
-		l = len(x.Metadata)
+		l = len(x.Recipient)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9554,7 +15017,7 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMetadata)
+		x := input.Message.Interface().(*MsgRecoverBridgeFunds)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9573,10 +15036,26 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -9603,7 +15082,7 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMetadata)
+		x := input.Message.Interface().(*MsgRecoverBridgeFunds)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9635,10 +15114,10 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverBridgeFunds: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverBridgeFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -9694,9 +15173,9 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9706,24 +15185,56 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = append(x.Metadata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Metadata == nil {
-					x.Metadata = []byte{}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -9762,28 +15273,24 @@ func (x *fastReflection_MsgUpdateMetadata) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateMetadataResponse                 protoreflect.MessageDescriptor
-	fd_MsgUpdateMetadataResponse_output_index    protoreflect.FieldDescriptor
-	fd_MsgUpdateMetadataResponse_l2_block_number protoreflect.FieldDescriptor
+	md_MsgRecoverBridgeFundsResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateMetadataResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateMetadataResponse")
-	fd_MsgUpdateMetadataResponse_output_index = md_MsgUpdateMetadataResponse.Fields().ByName("output_index")
-	fd_MsgUpdateMetadataResponse_l2_block_number = md_MsgUpdateMetadataResponse.Fields().ByName("l2_block_number")
+	md_MsgRecoverBridgeFundsResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgRecoverBridgeFundsResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateMetadataResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRecoverBridgeFundsResponse)(nil)
 
-type fastReflection_MsgUpdateMetadataResponse MsgUpdateMetadataResponse
+type fastReflection_MsgRecoverBridgeFundsResponse MsgRecoverBridgeFundsResponse
 
-func (x *MsgUpdateMetadataResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMetadataResponse)(x)
+func (x *MsgRecoverBridgeFundsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverBridgeFundsResponse)(x)
 }
 
-func (x *MsgUpdateMetadataResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[19]
+func (x *MsgRecoverBridgeFundsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9794,43 +15301,43 @@ func (x *MsgUpdateMetadataResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateMetadataResponse_messageType fastReflection_MsgUpdateMetadataResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateMetadataResponse_messageType{}
+var _fastReflection_MsgRecoverBridgeFundsResponse_messageType fastReflection_MsgRecoverBridgeFundsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverBridgeFundsResponse_messageType{}
 
-type fastReflection_MsgUpdateMetadataResponse_messageType struct{}
+type fastReflection_MsgRecoverBridgeFundsResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateMetadataResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMetadataResponse)(nil)
+func (x fastReflection_MsgRecoverBridgeFundsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverBridgeFundsResponse)(nil)
 }
-func (x fastReflection_MsgUpdateMetadataResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMetadataResponse)
+func (x fastReflection_MsgRecoverBridgeFundsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverBridgeFundsResponse)
 }
-func (x fastReflection_MsgUpdateMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMetadataResponse
+func (x fastReflection_MsgRecoverBridgeFundsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverBridgeFundsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMetadataResponse
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverBridgeFundsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateMetadataResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateMetadataResponse_messageType
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverBridgeFundsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateMetadataResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMetadataResponse)
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverBridgeFundsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateMetadataResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateMetadataResponse)(x)
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverBridgeFundsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9838,19 +15345,7 @@ func (x *fastReflection_MsgUpdateMetadataResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OutputIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OutputIndex)
-		if !f(fd_MsgUpdateMetadataResponse_output_index, value) {
-			return
-		}
-	}
-	if x.L2BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
-		if !f(fd_MsgUpdateMetadataResponse_l2_block_number, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9864,17 +15359,13 @@ func (x *fastReflection_MsgUpdateMetadataResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
-		return x.OutputIndex != uint64(0)
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
-		return x.L2BlockNumber != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFundsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9884,17 +15375,13 @@ func (x *fastReflection_MsgUpdateMetadataResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
-		x.OutputIndex = uint64(0)
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
-		x.L2BlockNumber = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFundsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9904,19 +15391,13 @@ func (x *fastReflection_MsgUpdateMetadataResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
-		value := x.OutputIndex
-		return protoreflect.ValueOfUint64(value)
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
-		value := x.L2BlockNumber
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFundsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFundsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9930,17 +15411,13 @@ func (x *fastReflection_MsgUpdateMetadataResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
-		x.OutputIndex = value.Uint()
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
-		x.L2BlockNumber = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFundsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9954,44 +15431,36 @@ func (x *fastReflection_MsgUpdateMetadataResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
-		panic(fmt.Errorf("field output_index of message opinit.ophost.v1.MsgUpdateMetadataResponse is not mutable"))
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
-		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.MsgUpdateMetadataResponse is not mutable"))
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFundsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.output_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "opinit.ophost.v1.MsgUpdateMetadataResponse.l2_block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateMetadataResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRecoverBridgeFundsResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateMetadataResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRecoverBridgeFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateMetadataResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgRecoverBridgeFundsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9999,7 +15468,7 @@ func (x *fastReflection_MsgUpdateMetadataResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateMetadataResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10010,7 +15479,7 @@ func (x *fastReflection_MsgUpdateMetadataResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10022,7 +15491,7 @@ func (x *fastReflection_MsgUpdateMetadataResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateMetadataResponse) IsValid() bool {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10032,9 +15501,9 @@ func (x *fastReflection_MsgUpdateMetadataResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRecoverBridgeFundsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateMetadataResponse)
+		x := input.Message.Interface().(*MsgRecoverBridgeFundsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10046,12 +15515,6 @@ func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.OutputIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutputIndex))
-		}
-		if x.L2BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10062,7 +15525,7 @@ func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMetadataResponse)
+		x := input.Message.Interface().(*MsgRecoverBridgeFundsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10081,16 +15544,6 @@ func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.L2BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.OutputIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputIndex))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -10102,7 +15555,7 @@ func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMetadataResponse)
+		x := input.Message.Interface().(*MsgRecoverBridgeFundsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10134,50 +15587,12 @@ func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadataResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverBridgeFundsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverBridgeFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-				}
-				x.OutputIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutputIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-				}
-				x.L2BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.L2BlockNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10214,28 +15629,32 @@ func (x *fastReflection_MsgUpdateMetadataResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgRegisterL2NativeToken           protoreflect.MessageDescriptor
+	fd_MsgRegisterL2NativeToken_authority protoreflect.FieldDescriptor
+	fd_MsgRegisterL2NativeToken_bridge_id protoreflect.FieldDescriptor
+	fd_MsgRegisterL2NativeToken_l2_denom  protoreflect.FieldDescriptor
+	fd_MsgRegisterL2NativeToken_metadata  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateParams = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgRegisterL2NativeToken = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgRegisterL2NativeToken")
+	fd_MsgRegisterL2NativeToken_authority = md_MsgRegisterL2NativeToken.Fields().ByName("authority")
+	fd_MsgRegisterL2NativeToken_bridge_id = md_MsgRegisterL2NativeToken.Fields().ByName("bridge_id")
+	fd_MsgRegisterL2NativeToken_l2_denom = md_MsgRegisterL2NativeToken.Fields().ByName("l2_denom")
+	fd_MsgRegisterL2NativeToken_metadata = md_MsgRegisterL2NativeToken.Fields().ByName("metadata")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRegisterL2NativeToken)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgRegisterL2NativeToken MsgRegisterL2NativeToken
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgRegisterL2NativeToken) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterL2NativeToken)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[20]
+func (x *MsgRegisterL2NativeToken) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10246,43 +15665,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgRegisterL2NativeToken_messageType fastReflection_MsgRegisterL2NativeToken_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterL2NativeToken_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgRegisterL2NativeToken_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgRegisterL2NativeToken_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterL2NativeToken)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgRegisterL2NativeToken_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterL2NativeToken)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgRegisterL2NativeToken_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterL2NativeToken
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgRegisterL2NativeToken) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterL2NativeToken
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgRegisterL2NativeToken) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterL2NativeToken_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgRegisterL2NativeToken) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterL2NativeToken)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgRegisterL2NativeToken) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterL2NativeToken)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10290,16 +15709,28 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRegisterL2NativeToken) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+		if !f(fd_MsgRegisterL2NativeToken_authority, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if x.BridgeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BridgeId)
+		if !f(fd_MsgRegisterL2NativeToken_bridge_id, value) {
+			return
+		}
+	}
+	if x.L2Denom != "" {
+		value := protoreflect.ValueOfString(x.L2Denom)
+		if !f(fd_MsgRegisterL2NativeToken_l2_denom, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_MsgRegisterL2NativeToken_metadata, value) {
 			return
 		}
 	}
@@ -10316,17 +15747,21 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRegisterL2NativeToken) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateParams.authority":
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.authority":
 		return x.Authority != ""
-	case "opinit.ophost.v1.MsgUpdateParams.params":
-		return x.Params != nil
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.bridge_id":
+		return x.BridgeId != uint64(0)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.l2_denom":
+		return x.L2Denom != ""
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.metadata":
+		return x.Metadata != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeToken"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeToken does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10336,17 +15771,21 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRegisterL2NativeToken) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateParams.authority":
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.authority":
 		x.Authority = ""
-	case "opinit.ophost.v1.MsgUpdateParams.params":
-		x.Params = nil
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.bridge_id":
+		x.BridgeId = uint64(0)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.l2_denom":
+		x.L2Denom = ""
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.metadata":
+		x.Metadata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeToken"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeToken does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10356,19 +15795,25 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterL2NativeToken) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.MsgUpdateParams.authority":
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "opinit.ophost.v1.MsgUpdateParams.params":
-		value := x.Params
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.bridge_id":
+		value := x.BridgeId
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.l2_denom":
+		value := x.L2Denom
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeToken"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeToken does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10382,17 +15827,21 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRegisterL2NativeToken) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateParams.authority":
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.authority":
 		x.Authority = value.Interface().(string)
-	case "opinit.ophost.v1.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.bridge_id":
+		x.BridgeId = value.Uint()
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.l2_denom":
+		x.L2Denom = value.Interface().(string)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.metadata":
+		x.Metadata = value.Message().Interface().(*v1beta11.Metadata)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeToken"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeToken does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10406,48 +15855,56 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterL2NativeToken) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "opinit.ophost.v1.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgUpdateParams is not mutable"))
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(v1beta11.Metadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.authority":
+		panic(fmt.Errorf("field authority of message opinit.ophost.v1.MsgRegisterL2NativeToken is not mutable"))
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.bridge_id":
+		panic(fmt.Errorf("field bridge_id of message opinit.ophost.v1.MsgRegisterL2NativeToken is not mutable"))
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.l2_denom":
+		panic(fmt.Errorf("field l2_denom of message opinit.ophost.v1.MsgRegisterL2NativeToken is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeToken"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeToken does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterL2NativeToken) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.MsgUpdateParams.authority":
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.authority":
 		return protoreflect.ValueOfString("")
-	case "opinit.ophost.v1.MsgUpdateParams.params":
-		m := new(Params)
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.bridge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.l2_denom":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.MsgRegisterL2NativeToken.metadata":
+		m := new(v1beta11.Metadata)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeToken"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeToken does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRegisterL2NativeToken) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgRegisterL2NativeToken", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10455,7 +15912,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRegisterL2NativeToken) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10466,7 +15923,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRegisterL2NativeToken) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10478,7 +15935,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgRegisterL2NativeToken) IsValid() bool {
 	return x != nil
 }
 
@@ -10488,9 +15945,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRegisterL2NativeToken) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgRegisterL2NativeToken)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10506,8 +15963,15 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.BridgeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeId))
+		}
+		l = len(x.L2Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -10520,7 +15984,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgRegisterL2NativeToken)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10539,8 +16003,8 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10551,7 +16015,19 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
+		}
+		if len(x.L2Denom) > 0 {
+			i -= len(x.L2Denom)
+			copy(dAtA[i:], x.L2Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L2Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BridgeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeId))
+			i--
+			dAtA[i] = 0x10
 		}
 		if len(x.Authority) > 0 {
 			i -= len(x.Authority)
@@ -10571,7 +16047,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgRegisterL2NativeToken)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10603,10 +16079,10 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterL2NativeToken: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterL2NativeToken: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -10642,8 +16118,59 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				}
+				x.BridgeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.L2Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10670,10 +16197,10 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.Metadata == nil {
+					x.Metadata = &v1beta11.Metadata{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10713,24 +16240,24 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+	md_MsgRegisterL2NativeTokenResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgRegisterL2NativeTokenResponse = File_opinit_ophost_v1_tx_proto.Messages().ByName("MsgRegisterL2NativeTokenResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRegisterL2NativeTokenResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgRegisterL2NativeTokenResponse MsgRegisterL2NativeTokenResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgRegisterL2NativeTokenResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterL2NativeTokenResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[21]
+func (x *MsgRegisterL2NativeTokenResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_tx_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10741,43 +16268,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgRegisterL2NativeTokenResponse_messageType fastReflection_MsgRegisterL2NativeTokenResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterL2NativeTokenResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgRegisterL2NativeTokenResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgRegisterL2NativeTokenResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterL2NativeTokenResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgRegisterL2NativeTokenResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterL2NativeTokenResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgRegisterL2NativeTokenResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterL2NativeTokenResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterL2NativeTokenResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterL2NativeTokenResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterL2NativeTokenResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterL2NativeTokenResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10785,7 +16312,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -10799,13 +16326,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeTokenResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10815,13 +16342,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeTokenResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10831,13 +16358,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeTokenResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10851,13 +16378,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeTokenResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10871,36 +16398,36 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeTokenResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.MsgRegisterL2NativeTokenResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.MsgRegisterL2NativeTokenResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10908,7 +16435,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10919,7 +16446,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10931,7 +16458,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10941,9 +16468,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRegisterL2NativeTokenResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgRegisterL2NativeTokenResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10965,7 +16492,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgRegisterL2NativeTokenResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10995,7 +16522,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgRegisterL2NativeTokenResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11027,10 +16554,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterL2NativeTokenResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterL2NativeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -11252,6 +16779,8 @@ type MsgProposeOutput struct {
 	BridgeId      uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 	L2BlockNumber uint64 `protobuf:"varint,3,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty"`
 	OutputRoot    []byte `protobuf:"bytes,4,opt,name=output_root,json=outputRoot,proto3" json:"output_root,omitempty"`
+	// version of the output root, which selects the output root scheme.
+	Version []byte `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *MsgProposeOutput) Reset() {
@@ -11302,6 +16831,13 @@ func (x *MsgProposeOutput) GetOutputRoot() []byte {
 	return nil
 }
 
+func (x *MsgProposeOutput) GetVersion() []byte {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
 // MsgProposeOutputResponse returns deposit result data
 type MsgProposeOutputResponse struct {
 	state         protoimpl.MessageState
@@ -11531,8 +17067,8 @@ type MsgFinalizeTokenWithdrawal struct {
 	BridgeId         uint64        `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 	OutputIndex      uint64        `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 	WithdrawalProofs [][]byte      `protobuf:"bytes,4,rep,name=withdrawal_proofs,json=withdrawalProofs,proto3" json:"withdrawal_proofs,omitempty"`
-	Sender           string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver         string        `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Sender           string        `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver         string        `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	Sequence         uint64        `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Amount           *v1beta1.Coin `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
 	// version of the output root
@@ -11606,64 +17142,323 @@ func (x *MsgFinalizeTokenWithdrawal) GetSequence() uint64 {
 
 func (x *MsgFinalizeTokenWithdrawal) GetAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.Amount
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *MsgFinalizeTokenWithdrawal) GetVersion() []byte {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *MsgFinalizeTokenWithdrawal) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *MsgFinalizeTokenWithdrawal) GetStorageRoot() []byte {
+	if x != nil {
+		return x.StorageRoot
+	}
+	return nil
+}
+
+func (x *MsgFinalizeTokenWithdrawal) GetLatestBlockHash() []byte {
+	if x != nil {
+		return x.LatestBlockHash
+	}
+	return nil
+}
+
+// MsgFinalizeTokenWithdrawalResponse returns a message handle result.
+type MsgFinalizeTokenWithdrawalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgFinalizeTokenWithdrawalResponse) Reset() {
+	*x = MsgFinalizeTokenWithdrawalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFinalizeTokenWithdrawalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFinalizeTokenWithdrawalResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgFinalizeTokenWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*MsgFinalizeTokenWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgInitiateNftDeposit is a message to lock a nft in the bridge account
+// and mint the wrapped nft on l2.
+type MsgInitiateNftDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	BridgeId uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	To       string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	ClassId  string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	TokenId  string `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *MsgInitiateNftDeposit) Reset() {
+	*x = MsgInitiateNftDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInitiateNftDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInitiateNftDeposit) ProtoMessage() {}
+
+// Deprecated: Use MsgInitiateNftDeposit.ProtoReflect.Descriptor instead.
+func (*MsgInitiateNftDeposit) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgInitiateNftDeposit) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgInitiateNftDeposit) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *MsgInitiateNftDeposit) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MsgInitiateNftDeposit) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *MsgInitiateNftDeposit) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// MsgInitiateNftDepositResponse returns a message handle result.
+type MsgInitiateNftDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *MsgInitiateNftDepositResponse) Reset() {
+	*x = MsgInitiateNftDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInitiateNftDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInitiateNftDepositResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgInitiateNftDepositResponse.ProtoReflect.Descriptor instead.
+func (*MsgInitiateNftDepositResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgInitiateNftDepositResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// MsgFinalizeNftWithdrawal is a message to release a nft locked in the bridge
+// account with the withdrawal proofs of the burned wrapped nft on l2.
+type MsgFinalizeNftWithdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeId         uint64   `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	OutputIndex      uint64   `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	WithdrawalProofs [][]byte `protobuf:"bytes,4,rep,name=withdrawal_proofs,json=withdrawalProofs,proto3" json:"withdrawal_proofs,omitempty"`
+	Sender           string   `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver         string   `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Sequence         uint64   `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// class_id is the l1 class id of the nft.
+	ClassId string `protobuf:"bytes,7,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// version of the output root
+	Version         []byte `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
+	StateRoot       []byte `protobuf:"bytes,10,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	StorageRoot     []byte `protobuf:"bytes,11,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	LatestBlockHash []byte `protobuf:"bytes,12,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+}
+
+func (x *MsgFinalizeNftWithdrawal) Reset() {
+	*x = MsgFinalizeNftWithdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFinalizeNftWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFinalizeNftWithdrawal) ProtoMessage() {}
+
+// Deprecated: Use MsgFinalizeNftWithdrawal.ProtoReflect.Descriptor instead.
+func (*MsgFinalizeNftWithdrawal) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetOutputIndex() uint64 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetWithdrawalProofs() [][]byte {
+	if x != nil {
+		return x.WithdrawalProofs
+	}
+	return nil
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *MsgFinalizeNftWithdrawal) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgFinalizeTokenWithdrawal) GetVersion() []byte {
+func (x *MsgFinalizeNftWithdrawal) GetVersion() []byte {
 	if x != nil {
 		return x.Version
 	}
 	return nil
 }
 
-func (x *MsgFinalizeTokenWithdrawal) GetStateRoot() []byte {
+func (x *MsgFinalizeNftWithdrawal) GetStateRoot() []byte {
 	if x != nil {
 		return x.StateRoot
 	}
 	return nil
 }
 
-func (x *MsgFinalizeTokenWithdrawal) GetStorageRoot() []byte {
+func (x *MsgFinalizeNftWithdrawal) GetStorageRoot() []byte {
 	if x != nil {
 		return x.StorageRoot
 	}
 	return nil
 }
 
-func (x *MsgFinalizeTokenWithdrawal) GetLatestBlockHash() []byte {
+func (x *MsgFinalizeNftWithdrawal) GetLatestBlockHash() []byte {
 	if x != nil {
 		return x.LatestBlockHash
 	}
 	return nil
 }
 
-// MsgFinalizeTokenWithdrawalResponse returns a message handle result.
-type MsgFinalizeTokenWithdrawalResponse struct {
+// MsgFinalizeNftWithdrawalResponse returns a message handle result.
+type MsgFinalizeNftWithdrawalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgFinalizeTokenWithdrawalResponse) Reset() {
-	*x = MsgFinalizeTokenWithdrawalResponse{}
+func (x *MsgFinalizeNftWithdrawalResponse) Reset() {
+	*x = MsgFinalizeNftWithdrawalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[11]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFinalizeTokenWithdrawalResponse) String() string {
+func (x *MsgFinalizeNftWithdrawalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFinalizeTokenWithdrawalResponse) ProtoMessage() {}
+func (*MsgFinalizeNftWithdrawalResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgFinalizeTokenWithdrawalResponse.ProtoReflect.Descriptor instead.
-func (*MsgFinalizeTokenWithdrawalResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgFinalizeNftWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*MsgFinalizeNftWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
 // MsgUpdateProposer is a message to change a proposer
@@ -11682,7 +17477,7 @@ type MsgUpdateProposer struct {
 func (x *MsgUpdateProposer) Reset() {
 	*x = MsgUpdateProposer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[12]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11696,7 +17491,7 @@ func (*MsgUpdateProposer) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateProposer.ProtoReflect.Descriptor instead.
 func (*MsgUpdateProposer) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{12}
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MsgUpdateProposer) GetAuthority() string {
@@ -11735,7 +17530,7 @@ type MsgUpdateProposerResponse struct {
 func (x *MsgUpdateProposerResponse) Reset() {
 	*x = MsgUpdateProposerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[13]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11749,7 +17544,7 @@ func (*MsgUpdateProposerResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateProposerResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateProposerResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{13}
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MsgUpdateProposerResponse) GetOutputIndex() uint64 {
@@ -11767,62 +17562,63 @@ func (x *MsgUpdateProposerResponse) GetL2BlockNumber() uint64 {
 }
 
 // MsgUpdateChallenger is a message to change a challenger
-type MsgUpdateChallenger struct {
+type MsgUpdateChallengers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// authority is the address that controls the module (defaults to x/gov unless overwritten)
-	// or the current challenger address.(supports a single challenger that can replace the existing challenger,
-	// excluding their own address)
+	// or the current challenger address.
+	//
+	// If the given authority is a challenger address, it has the ability to replace itself with another address.
 	Authority      string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	BridgeId       uint64   `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 	NewChallengers []string `protobuf:"bytes,3,rep,name=new_challengers,json=newChallengers,proto3" json:"new_challengers,omitempty"`
 }
 
-func (x *MsgUpdateChallenger) Reset() {
-	*x = MsgUpdateChallenger{}
+func (x *MsgUpdateChallengers) Reset() {
+	*x = MsgUpdateChallengers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[14]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateChallenger) String() string {
+func (x *MsgUpdateChallengers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateChallenger) ProtoMessage() {}
+func (*MsgUpdateChallengers) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateChallenger.ProtoReflect.Descriptor instead.
-func (*MsgUpdateChallenger) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgUpdateChallengers.ProtoReflect.Descriptor instead.
+func (*MsgUpdateChallengers) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgUpdateChallenger) GetAuthority() string {
+func (x *MsgUpdateChallengers) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateChallenger) GetBridgeId() uint64 {
+func (x *MsgUpdateChallengers) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *MsgUpdateChallenger) GetNewChallengers() []string {
+func (x *MsgUpdateChallengers) GetNewChallengers() []string {
 	if x != nil {
 		return x.NewChallengers
 	}
 	return nil
 }
 
-// MsgUpdateChallengerResponse returns a message handle result.
-type MsgUpdateChallengerResponse struct {
+// MsgUpdateChallengersResponse returns a message handle result.
+type MsgUpdateChallengersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -11833,34 +17629,34 @@ type MsgUpdateChallengerResponse struct {
 	L2BlockNumber uint64 `protobuf:"varint,2,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty"`
 }
 
-func (x *MsgUpdateChallengerResponse) Reset() {
-	*x = MsgUpdateChallengerResponse{}
+func (x *MsgUpdateChallengersResponse) Reset() {
+	*x = MsgUpdateChallengersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[15]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateChallengerResponse) String() string {
+func (x *MsgUpdateChallengersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateChallengerResponse) ProtoMessage() {}
+func (*MsgUpdateChallengersResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateChallengerResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateChallengerResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MsgUpdateChallengersResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateChallengersResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgUpdateChallengerResponse) GetOutputIndex() uint64 {
+func (x *MsgUpdateChallengersResponse) GetOutputIndex() uint64 {
 	if x != nil {
 		return x.OutputIndex
 	}
 	return 0
 }
 
-func (x *MsgUpdateChallengerResponse) GetL2BlockNumber() uint64 {
+func (x *MsgUpdateChallengersResponse) GetL2BlockNumber() uint64 {
 	if x != nil {
 		return x.L2BlockNumber
 	}
@@ -11883,7 +17679,7 @@ type MsgUpdateBatchInfo struct {
 func (x *MsgUpdateBatchInfo) Reset() {
 	*x = MsgUpdateBatchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[16]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11897,7 +17693,7 @@ func (*MsgUpdateBatchInfo) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateBatchInfo.ProtoReflect.Descriptor instead.
 func (*MsgUpdateBatchInfo) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{16}
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgUpdateBatchInfo) GetAuthority() string {
@@ -11936,7 +17732,7 @@ type MsgUpdateBatchInfoResponse struct {
 func (x *MsgUpdateBatchInfoResponse) Reset() {
 	*x = MsgUpdateBatchInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[17]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11950,7 +17746,7 @@ func (*MsgUpdateBatchInfoResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateBatchInfoResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateBatchInfoResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{17}
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MsgUpdateBatchInfoResponse) GetOutputIndex() uint64 {
@@ -11974,8 +17770,10 @@ type MsgUpdateMetadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	// authority is the address that controls the module (defaults to x/gov unless overwritten)
-	// or the current challenger address.(supports a single challenger that can replace the existing challenger,
-	// excluding their own address))
+	// or the current challenger address.
+	//
+	// If the given authority is a challenger address, it has the ability to replace oneself to another address or remove
+	// oneself.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	BridgeId  uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 	Metadata  []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -11984,7 +17782,7 @@ type MsgUpdateMetadata struct {
 func (x *MsgUpdateMetadata) Reset() {
 	*x = MsgUpdateMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[18]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11998,78 +17796,328 @@ func (*MsgUpdateMetadata) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateMetadata.ProtoReflect.Descriptor instead.
 func (*MsgUpdateMetadata) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{18}
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgUpdateMetadata) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateMetadata) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *MsgUpdateMetadata) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MsgUpdateMetadataResponse returns a message handle result.
+type MsgUpdateMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// last finalized output index
+	OutputIndex uint64 `protobuf:"varint,1,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// last finalized l2 block number
+	L2BlockNumber uint64 `protobuf:"varint,2,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty"`
+}
+
+func (x *MsgUpdateMetadataResponse) Reset() {
+	*x = MsgUpdateMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateMetadataResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateMetadataResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MsgUpdateMetadataResponse) GetOutputIndex() uint64 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *MsgUpdateMetadataResponse) GetL2BlockNumber() uint64 {
+	if x != nil {
+		return x.L2BlockNumber
+	}
+	return 0
+}
+
+// MsgUpdateParams is a message to update parameters
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the arbitrary parameters to be updated.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse returns a message handle result.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{25}
+}
+
+// MsgUpdateDepositCap is a message to set or remove a deposit cap of a bridge
+type MsgUpdateDepositCap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	BridgeId  uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// deposit_cap is the new deposit cap of the denom. The cap is removed
+	// when both limits are zero.
+	DepositCap *DepositCap `protobuf:"bytes,3,opt,name=deposit_cap,json=depositCap,proto3" json:"deposit_cap,omitempty"`
+}
+
+func (x *MsgUpdateDepositCap) Reset() {
+	*x = MsgUpdateDepositCap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateDepositCap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateDepositCap) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateDepositCap.ProtoReflect.Descriptor instead.
+func (*MsgUpdateDepositCap) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MsgUpdateDepositCap) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateDepositCap) GetBridgeId() uint64 {
+	if x != nil {
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *MsgUpdateDepositCap) GetDepositCap() *DepositCap {
+	if x != nil {
+		return x.DepositCap
+	}
+	return nil
+}
+
+// MsgUpdateDepositCapResponse returns a message handle result.
+type MsgUpdateDepositCapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateDepositCapResponse) Reset() {
+	*x = MsgUpdateDepositCapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateDepositCapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateDepositCapResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateDepositCapResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateDepositCapResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{27}
+}
+
+// MsgRecoverBridgeFunds is a message to release the funds which were sent
+// directly to a bridge account without going through the bridge. Only the
+// surplus above the tracked locked amount can be recovered.
+type MsgRecoverBridgeFunds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	BridgeId  uint64          `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	Recipient string          `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgRecoverBridgeFunds) Reset() {
+	*x = MsgRecoverBridgeFunds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRecoverBridgeFunds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRecoverBridgeFunds) ProtoMessage() {}
+
+// Deprecated: Use MsgRecoverBridgeFunds.ProtoReflect.Descriptor instead.
+func (*MsgRecoverBridgeFunds) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgUpdateMetadata) GetAuthority() string {
+func (x *MsgRecoverBridgeFunds) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateMetadata) GetBridgeId() uint64 {
+func (x *MsgRecoverBridgeFunds) GetBridgeId() uint64 {
 	if x != nil {
 		return x.BridgeId
 	}
 	return 0
 }
 
-func (x *MsgUpdateMetadata) GetMetadata() []byte {
+func (x *MsgRecoverBridgeFunds) GetRecipient() string {
 	if x != nil {
-		return x.Metadata
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *MsgRecoverBridgeFunds) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-// MsgUpdateMetadataResponse returns a message handle result.
-type MsgUpdateMetadataResponse struct {
+// MsgRecoverBridgeFundsResponse returns a message handle result.
+type MsgRecoverBridgeFundsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// last finalized output index
-	OutputIndex uint64 `protobuf:"varint,1,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
-	// last finalized l2 block number
-	L2BlockNumber uint64 `protobuf:"varint,2,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty"`
 }
 
-func (x *MsgUpdateMetadataResponse) Reset() {
-	*x = MsgUpdateMetadataResponse{}
+func (x *MsgRecoverBridgeFundsResponse) Reset() {
+	*x = MsgRecoverBridgeFundsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[19]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateMetadataResponse) String() string {
+func (x *MsgRecoverBridgeFundsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateMetadataResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgUpdateMetadataResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *MsgUpdateMetadataResponse) GetOutputIndex() uint64 {
-	if x != nil {
-		return x.OutputIndex
-	}
-	return 0
-}
+func (*MsgRecoverBridgeFundsResponse) ProtoMessage() {}
 
-func (x *MsgUpdateMetadataResponse) GetL2BlockNumber() uint64 {
-	if x != nil {
-		return x.L2BlockNumber
-	}
-	return 0
+// Deprecated: Use MsgRecoverBridgeFundsResponse.ProtoReflect.Descriptor instead.
+func (*MsgRecoverBridgeFundsResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
-// MsgUpdateParams is a message to update parameters
-type MsgUpdateParams struct {
+// MsgRegisterL2NativeToken is a message to register the l1 representation
+// of a token originated from l2, which is minted on the withdrawals of the
+// token and burned on its deposits.
+type MsgRegisterL2NativeToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -12077,69 +18125,87 @@ type MsgUpdateParams struct {
 	// authority is the address that controls the module
 	// (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params are the arbitrary parameters to be updated.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	BridgeId  uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// l2_denom is the native l2 denom of the token.
+	L2Denom string `protobuf:"bytes,3,opt,name=l2_denom,json=l2Denom,proto3" json:"l2_denom,omitempty"`
+	// metadata is the denom metadata of the l1 representation denom, whose
+	// base must be the representation denom of the l2 denom.
+	Metadata *v1beta11.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgRegisterL2NativeToken) Reset() {
+	*x = MsgRegisterL2NativeToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[20]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgRegisterL2NativeToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgRegisterL2NativeToken) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgRegisterL2NativeToken.ProtoReflect.Descriptor instead.
+func (*MsgRegisterL2NativeToken) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgRegisterL2NativeToken) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgRegisterL2NativeToken) GetBridgeId() uint64 {
 	if x != nil {
-		return x.Params
+		return x.BridgeId
+	}
+	return 0
+}
+
+func (x *MsgRegisterL2NativeToken) GetL2Denom() string {
+	if x != nil {
+		return x.L2Denom
+	}
+	return ""
+}
+
+func (x *MsgRegisterL2NativeToken) GetMetadata() *v1beta11.Metadata {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-// MsgUpdateParamsResponse returns a message handle result.
-type MsgUpdateParamsResponse struct {
+// MsgRegisterL2NativeTokenResponse returns a message handle result.
+type MsgRegisterL2NativeTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgRegisterL2NativeTokenResponse) Reset() {
+	*x = MsgRegisterL2NativeTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[21]
+		mi := &file_opinit_ophost_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgRegisterL2NativeTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgRegisterL2NativeTokenResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MsgRegisterL2NativeTokenResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterL2NativeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 var File_opinit_ophost_v1_tx_proto protoreflect.FileDescriptor
@@ -12149,6 +18215,8 @@ var file_opinit_ophost_v1_tx_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x69,
 	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61,
 	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f,
@@ -12191,7 +18259,7 @@ var file_opinit_ophost_v1_tx_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67,
 	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x69,
 	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x50, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x22, 0xe4, 0x02, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x50, 0x72,
 	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x47, 0x0a, 0x08, 0x70,
 	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xf2,
 	0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
@@ -12208,107 +18276,186 @@ var file_opinit_ophost_v1_tx_proto_rawDesc = []byte{
 	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
 	0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x75, 0x74, 0x70,
 	0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x52, 0x6f, 0x6f, 0x74, 0x3a, 0x29, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d,
-	0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0x3d, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xfb,
-	0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x3a, 0x29, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x3d, 0x0a,
+	0x18, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xfb, 0x01, 0x0a,
+	0x0f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x4d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12,
+	0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x2a,
+	0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x16, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfb, 0x02, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x09, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74,
+	0x6f, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x4d,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x18, 0xc8, 0xde, 0x1f,
+	0x01, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x36, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x22, 0x3d, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0xf4, 0x05, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
 	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64,
 	0x67, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69,
 	0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13,
 	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
 	0x65, 0x78, 0x22, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x3a, 0x2a, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x19, 0x0a, 0x17,
-	0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfb, 0x02, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x12, 0x36, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x00, 0x52, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xf2,
+	0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x17,
+	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x0f, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x3a, 0x33,
+	0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x21, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xda, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x4e,
+	0x66, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x0d, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x09,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f,
+	0x09, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x07, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x3a, 0x34, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x65, 0x4e, 0x66, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x3b, 0x0a, 0x1d,
+	0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x4e, 0x66, 0x74, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xfb, 0x05, 0x0a, 0x18, 0x4d, 0x73,
+	0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4e, 0x66, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79,
 	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52,
-	0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x09, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x74, 0x6f, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x74, 0x6f,
-	0x12, 0x4d, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1a, 0xc8, 0xde, 0x1f,
-	0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x18, 0xc8,
-	0xde, 0x1f, 0x01, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x36, 0x88,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73,
-	0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x3d, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x22, 0xee, 0x05, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde,
-	0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x36, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
-	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x09, 0xc8,
-	0xde, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x00, 0x52, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x0d,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a,
-	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x2b, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x08, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52,
-	0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x21, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x61, 0x6c, 0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x36, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x00, 0x52, 0x10, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x41, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2,
+	0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x47, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xf2, 0xde, 0x1f,
+	0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xf2, 0xde,
+	0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x22, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xf2, 0xde,
+	0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64,
+	0x22, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x12, 0xf2, 0xde, 0x1f,
+	0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x15, 0xf2, 0xde,
+	0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x22, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a,
+	0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x0b, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x22, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x3a, 0x31, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d,
+	0x73, 0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4e, 0x66, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4e, 0x66, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
 	0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x11,
 	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
 	0x72, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
@@ -12333,58 +18480,33 @@ var file_opinit_ophost_v1_tx_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65,
 	0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
 	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa0, 0x02, 0x0a, 0x13, 0x4d, 0x73,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa2, 0x02, 0x0a, 0x14, 0x4d, 0x73,
 	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a,
-	0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64,
-	0x12, 0x5b, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x32, 0xf2, 0xde, 0x1f, 0x16, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6e,
-	0x65, 0x77, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x3a, 0x2d, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x1a, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x1b,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26,
-	0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa8, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde,
-	0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x0e,
-	0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x67, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xf0, 0x01, 0x0a, 0x11, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x72, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31,
+	0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x5b, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x32, 0xf2, 0xde, 0x1f, 0x16,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e,
+	0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x3a, 0x2e,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1b, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0x69,
+	0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa8, 0x02, 0x0a, 0x12, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
 	0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
 	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
@@ -12393,117 +18515,250 @@ var file_opinit_ophost_v1_tx_proto_rawDesc = []byte{
 	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
 	0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67,
 	0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
-	0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x2b, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x66, 0x0a,
-	0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a,
-	0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde,
-	0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
-	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x22, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x29, 0x82, 0xe7, 0xb0, 0x2a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x6f,
-	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xf0, 0x08, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x59, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
-	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
+	0x65, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f, 0x70, 0x68, 0x6f, 0x73,
+	0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x67, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6c, 0x32, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xf0, 0x01,
+	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x3a, 0x2b, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x66, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x29, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x16, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x12, 0x4a, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c,
+	0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22,
+	0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x5e, 0x0a, 0x0b, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x42, 0x1f, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x15, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31,
+	0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x79, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
+	0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x18, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xf2, 0xde, 0x1f, 0x10,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x32, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x32, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x07, 0x6c,
+	0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
+	0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x32, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x1f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xaf, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x59, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20,
+	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x1a, 0x29, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
 	0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
-	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f,
-	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f,
-	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
-	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x74, 0x0a, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
-	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
-	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
-	0x6c, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x2a, 0x2e,
+	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
+	0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x29, 0x2e, 0x6f,
+	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a,
+	0x17, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x12,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x4e, 0x66, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x4e, 0x66, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x4e, 0x66, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x15,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4e, 0x66, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f,
+	0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x4e, 0x66, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x1a,
-	0x34, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x4e, 0x66, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
 	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74,
 	0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x1a, 0x2b, 0x2e, 0x6f,
 	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
 	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x10, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x25, 0x2e,
-	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x72, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x2c, 0x2e, 0x6f,
-	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x6f,
-	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x26,
 	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
+	0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x2c, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x23, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73,
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7,
-	0xb0, 0x2a, 0x01, 0x42, 0xc2, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
-	0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c,
-	0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02,
-	0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73,
-	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70,
-	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x68, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x43, 0x61, 0x70, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x12, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x27, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x1a, 0x2f, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x75, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x15, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4c, 0x32, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x32,
+	0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x32, 0x4e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xc2, 0x01, 0xc8, 0xe1, 0x1e, 0x00,
+	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74,
+	0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4f, 0x70, 0x69,
+	0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4f, 0x70, 0x69, 0x6e,
+	0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12518,7 +18773,7 @@ func file_opinit_ophost_v1_tx_proto_rawDescGZIP() []byte {
 	return file_opinit_ophost_v1_tx_proto_rawDescData
 }
 
-var file_opinit_ophost_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_opinit_ophost_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_opinit_ophost_v1_tx_proto_goTypes = []interface{}{
 	(*MsgRecordBatch)(nil),                     // 0: opinit.ophost.v1.MsgRecordBatch
 	(*MsgRecordBatchResponse)(nil),             // 1: opinit.ophost.v1.MsgRecordBatchResponse
@@ -12532,54 +18787,79 @@ var file_opinit_ophost_v1_tx_proto_goTypes = []interface{}{
 	(*MsgInitiateTokenDepositResponse)(nil),    // 9: opinit.ophost.v1.MsgInitiateTokenDepositResponse
 	(*MsgFinalizeTokenWithdrawal)(nil),         // 10: opinit.ophost.v1.MsgFinalizeTokenWithdrawal
 	(*MsgFinalizeTokenWithdrawalResponse)(nil), // 11: opinit.ophost.v1.MsgFinalizeTokenWithdrawalResponse
-	(*MsgUpdateProposer)(nil),                  // 12: opinit.ophost.v1.MsgUpdateProposer
-	(*MsgUpdateProposerResponse)(nil),          // 13: opinit.ophost.v1.MsgUpdateProposerResponse
-	(*MsgUpdateChallenger)(nil),                // 14: opinit.ophost.v1.MsgUpdateChallenger
-	(*MsgUpdateChallengerResponse)(nil),        // 15: opinit.ophost.v1.MsgUpdateChallengerResponse
-	(*MsgUpdateBatchInfo)(nil),                 // 16: opinit.ophost.v1.MsgUpdateBatchInfo
-	(*MsgUpdateBatchInfoResponse)(nil),         // 17: opinit.ophost.v1.MsgUpdateBatchInfoResponse
-	(*MsgUpdateMetadata)(nil),                  // 18: opinit.ophost.v1.MsgUpdateMetadata
-	(*MsgUpdateMetadataResponse)(nil),          // 19: opinit.ophost.v1.MsgUpdateMetadataResponse
-	(*MsgUpdateParams)(nil),                    // 20: opinit.ophost.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),            // 21: opinit.ophost.v1.MsgUpdateParamsResponse
-	(*BridgeConfig)(nil),                       // 22: opinit.ophost.v1.BridgeConfig
-	(*v1beta1.Coin)(nil),                       // 23: cosmos.base.v1beta1.Coin
-	(*BatchInfo)(nil),                          // 24: opinit.ophost.v1.BatchInfo
-	(*Params)(nil),                             // 25: opinit.ophost.v1.Params
+	(*MsgInitiateNftDeposit)(nil),              // 12: opinit.ophost.v1.MsgInitiateNftDeposit
+	(*MsgInitiateNftDepositResponse)(nil),      // 13: opinit.ophost.v1.MsgInitiateNftDepositResponse
+	(*MsgFinalizeNftWithdrawal)(nil),           // 14: opinit.ophost.v1.MsgFinalizeNftWithdrawal
+	(*MsgFinalizeNftWithdrawalResponse)(nil),   // 15: opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse
+	(*MsgUpdateProposer)(nil),                  // 16: opinit.ophost.v1.MsgUpdateProposer
+	(*MsgUpdateProposerResponse)(nil),          // 17: opinit.ophost.v1.MsgUpdateProposerResponse
+	(*MsgUpdateChallengers)(nil),               // 18: opinit.ophost.v1.MsgUpdateChallengers
+	(*MsgUpdateChallengersResponse)(nil),       // 19: opinit.ophost.v1.MsgUpdateChallengersResponse
+	(*MsgUpdateBatchInfo)(nil),                 // 20: opinit.ophost.v1.MsgUpdateBatchInfo
+	(*MsgUpdateBatchInfoResponse)(nil),         // 21: opinit.ophost.v1.MsgUpdateBatchInfoResponse
+	(*MsgUpdateMetadata)(nil),                  // 22: opinit.ophost.v1.MsgUpdateMetadata
+	(*MsgUpdateMetadataResponse)(nil),          // 23: opinit.ophost.v1.MsgUpdateMetadataResponse
+	(*MsgUpdateParams)(nil),                    // 24: opinit.ophost.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),            // 25: opinit.ophost.v1.MsgUpdateParamsResponse
+	(*MsgUpdateDepositCap)(nil),                // 26: opinit.ophost.v1.MsgUpdateDepositCap
+	(*MsgUpdateDepositCapResponse)(nil),        // 27: opinit.ophost.v1.MsgUpdateDepositCapResponse
+	(*MsgRecoverBridgeFunds)(nil),              // 28: opinit.ophost.v1.MsgRecoverBridgeFunds
+	(*MsgRecoverBridgeFundsResponse)(nil),      // 29: opinit.ophost.v1.MsgRecoverBridgeFundsResponse
+	(*MsgRegisterL2NativeToken)(nil),           // 30: opinit.ophost.v1.MsgRegisterL2NativeToken
+	(*MsgRegisterL2NativeTokenResponse)(nil),   // 31: opinit.ophost.v1.MsgRegisterL2NativeTokenResponse
+	(*BridgeConfig)(nil),                       // 32: opinit.ophost.v1.BridgeConfig
+	(*v1beta1.Coin)(nil),                       // 33: cosmos.base.v1beta1.Coin
+	(*BatchInfo)(nil),                          // 34: opinit.ophost.v1.BatchInfo
+	(*Params)(nil),                             // 35: opinit.ophost.v1.Params
+	(*DepositCap)(nil),                         // 36: opinit.ophost.v1.DepositCap
+	(*v1beta11.Metadata)(nil),                  // 37: cosmos.bank.v1beta1.Metadata
 }
 var file_opinit_ophost_v1_tx_proto_depIdxs = []int32{
-	22, // 0: opinit.ophost.v1.MsgCreateBridge.config:type_name -> opinit.ophost.v1.BridgeConfig
-	23, // 1: opinit.ophost.v1.MsgInitiateTokenDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	23, // 2: opinit.ophost.v1.MsgFinalizeTokenWithdrawal.amount:type_name -> cosmos.base.v1beta1.Coin
-	24, // 3: opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info:type_name -> opinit.ophost.v1.BatchInfo
-	25, // 4: opinit.ophost.v1.MsgUpdateParams.params:type_name -> opinit.ophost.v1.Params
-	0,  // 5: opinit.ophost.v1.Msg.RecordBatch:input_type -> opinit.ophost.v1.MsgRecordBatch
-	2,  // 6: opinit.ophost.v1.Msg.CreateBridge:input_type -> opinit.ophost.v1.MsgCreateBridge
-	4,  // 7: opinit.ophost.v1.Msg.ProposeOutput:input_type -> opinit.ophost.v1.MsgProposeOutput
-	6,  // 8: opinit.ophost.v1.Msg.DeleteOutput:input_type -> opinit.ophost.v1.MsgDeleteOutput
-	8,  // 9: opinit.ophost.v1.Msg.InitiateTokenDeposit:input_type -> opinit.ophost.v1.MsgInitiateTokenDeposit
-	10, // 10: opinit.ophost.v1.Msg.FinalizeTokenWithdrawal:input_type -> opinit.ophost.v1.MsgFinalizeTokenWithdrawal
-	12, // 11: opinit.ophost.v1.Msg.UpdateProposer:input_type -> opinit.ophost.v1.MsgUpdateProposer
-	14, // 12: opinit.ophost.v1.Msg.UpdateChallenger:input_type -> opinit.ophost.v1.MsgUpdateChallenger
-	16, // 13: opinit.ophost.v1.Msg.UpdateBatchInfo:input_type -> opinit.ophost.v1.MsgUpdateBatchInfo
-	18, // 14: opinit.ophost.v1.Msg.UpdateMetadata:input_type -> opinit.ophost.v1.MsgUpdateMetadata
-	20, // 15: opinit.ophost.v1.Msg.UpdateParams:input_type -> opinit.ophost.v1.MsgUpdateParams
-	1,  // 16: opinit.ophost.v1.Msg.RecordBatch:output_type -> opinit.ophost.v1.MsgRecordBatchResponse
-	3,  // 17: opinit.ophost.v1.Msg.CreateBridge:output_type -> opinit.ophost.v1.MsgCreateBridgeResponse
-	5,  // 18: opinit.ophost.v1.Msg.ProposeOutput:output_type -> opinit.ophost.v1.MsgProposeOutputResponse
-	7,  // 19: opinit.ophost.v1.Msg.DeleteOutput:output_type -> opinit.ophost.v1.MsgDeleteOutputResponse
-	9,  // 20: opinit.ophost.v1.Msg.InitiateTokenDeposit:output_type -> opinit.ophost.v1.MsgInitiateTokenDepositResponse
-	11, // 21: opinit.ophost.v1.Msg.FinalizeTokenWithdrawal:output_type -> opinit.ophost.v1.MsgFinalizeTokenWithdrawalResponse
-	13, // 22: opinit.ophost.v1.Msg.UpdateProposer:output_type -> opinit.ophost.v1.MsgUpdateProposerResponse
-	15, // 23: opinit.ophost.v1.Msg.UpdateChallenger:output_type -> opinit.ophost.v1.MsgUpdateChallengerResponse
-	17, // 24: opinit.ophost.v1.Msg.UpdateBatchInfo:output_type -> opinit.ophost.v1.MsgUpdateBatchInfoResponse
-	19, // 25: opinit.ophost.v1.Msg.UpdateMetadata:output_type -> opinit.ophost.v1.MsgUpdateMetadataResponse
-	21, // 26: opinit.ophost.v1.Msg.UpdateParams:output_type -> opinit.ophost.v1.MsgUpdateParamsResponse
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	32, // 0: opinit.ophost.v1.MsgCreateBridge.config:type_name -> opinit.ophost.v1.BridgeConfig
+	33, // 1: opinit.ophost.v1.MsgInitiateTokenDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	33, // 2: opinit.ophost.v1.MsgFinalizeTokenWithdrawal.amount:type_name -> cosmos.base.v1beta1.Coin
+	34, // 3: opinit.ophost.v1.MsgUpdateBatchInfo.new_batch_info:type_name -> opinit.ophost.v1.BatchInfo
+	35, // 4: opinit.ophost.v1.MsgUpdateParams.params:type_name -> opinit.ophost.v1.Params
+	36, // 5: opinit.ophost.v1.MsgUpdateDepositCap.deposit_cap:type_name -> opinit.ophost.v1.DepositCap
+	33, // 6: opinit.ophost.v1.MsgRecoverBridgeFunds.amount:type_name -> cosmos.base.v1beta1.Coin
+	37, // 7: opinit.ophost.v1.MsgRegisterL2NativeToken.metadata:type_name -> cosmos.bank.v1beta1.Metadata
+	0,  // 8: opinit.ophost.v1.Msg.RecordBatch:input_type -> opinit.ophost.v1.MsgRecordBatch
+	2,  // 9: opinit.ophost.v1.Msg.CreateBridge:input_type -> opinit.ophost.v1.MsgCreateBridge
+	4,  // 10: opinit.ophost.v1.Msg.ProposeOutput:input_type -> opinit.ophost.v1.MsgProposeOutput
+	6,  // 11: opinit.ophost.v1.Msg.DeleteOutput:input_type -> opinit.ophost.v1.MsgDeleteOutput
+	8,  // 12: opinit.ophost.v1.Msg.InitiateTokenDeposit:input_type -> opinit.ophost.v1.MsgInitiateTokenDeposit
+	10, // 13: opinit.ophost.v1.Msg.FinalizeTokenWithdrawal:input_type -> opinit.ophost.v1.MsgFinalizeTokenWithdrawal
+	12, // 14: opinit.ophost.v1.Msg.InitiateNftDeposit:input_type -> opinit.ophost.v1.MsgInitiateNftDeposit
+	14, // 15: opinit.ophost.v1.Msg.FinalizeNftWithdrawal:input_type -> opinit.ophost.v1.MsgFinalizeNftWithdrawal
+	16, // 16: opinit.ophost.v1.Msg.UpdateProposer:input_type -> opinit.ophost.v1.MsgUpdateProposer
+	18, // 17: opinit.ophost.v1.Msg.UpdateChallengers:input_type -> opinit.ophost.v1.MsgUpdateChallengers
+	20, // 18: opinit.ophost.v1.Msg.UpdateBatchInfo:input_type -> opinit.ophost.v1.MsgUpdateBatchInfo
+	22, // 19: opinit.ophost.v1.Msg.UpdateMetadata:input_type -> opinit.ophost.v1.MsgUpdateMetadata
+	24, // 20: opinit.ophost.v1.Msg.UpdateParams:input_type -> opinit.ophost.v1.MsgUpdateParams
+	26, // 21: opinit.ophost.v1.Msg.UpdateDepositCap:input_type -> opinit.ophost.v1.MsgUpdateDepositCap
+	28, // 22: opinit.ophost.v1.Msg.RecoverBridgeFunds:input_type -> opinit.ophost.v1.MsgRecoverBridgeFunds
+	30, // 23: opinit.ophost.v1.Msg.RegisterL2NativeToken:input_type -> opinit.ophost.v1.MsgRegisterL2NativeToken
+	1,  // 24: opinit.ophost.v1.Msg.RecordBatch:output_type -> opinit.ophost.v1.MsgRecordBatchResponse
+	3,  // 25: opinit.ophost.v1.Msg.CreateBridge:output_type -> opinit.ophost.v1.MsgCreateBridgeResponse
+	5,  // 26: opinit.ophost.v1.Msg.ProposeOutput:output_type -> opinit.ophost.v1.MsgProposeOutputResponse
+	7,  // 27: opinit.ophost.v1.Msg.DeleteOutput:output_type -> opinit.ophost.v1.MsgDeleteOutputResponse
+	9,  // 28: opinit.ophost.v1.Msg.InitiateTokenDeposit:output_type -> opinit.ophost.v1.MsgInitiateTokenDepositResponse
+	11, // 29: opinit.ophost.v1.Msg.FinalizeTokenWithdrawal:output_type -> opinit.ophost.v1.MsgFinalizeTokenWithdrawalResponse
+	13, // 30: opinit.ophost.v1.Msg.InitiateNftDeposit:output_type -> opinit.ophost.v1.MsgInitiateNftDepositResponse
+	15, // 31: opinit.ophost.v1.Msg.FinalizeNftWithdrawal:output_type -> opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse
+	17, // 32: opinit.ophost.v1.Msg.UpdateProposer:output_type -> opinit.ophost.v1.MsgUpdateProposerResponse
+	19, // 33: opinit.ophost.v1.Msg.UpdateChallengers:output_type -> opinit.ophost.v1.MsgUpdateChallengersResponse
+	21, // 34: opinit.ophost.v1.Msg.UpdateBatchInfo:output_type -> opinit.ophost.v1.MsgUpdateBatchInfoResponse
+	23, // 35: opinit.ophost.v1.Msg.UpdateMetadata:output_type -> opinit.ophost.v1.MsgUpdateMetadataResponse
+	25, // 36: opinit.ophost.v1.Msg.UpdateParams:output_type -> opinit.ophost.v1.MsgUpdateParamsResponse
+	27, // 37: opinit.ophost.v1.Msg.UpdateDepositCap:output_type -> opinit.ophost.v1.MsgUpdateDepositCapResponse
+	29, // 38: opinit.ophost.v1.Msg.RecoverBridgeFunds:output_type -> opinit.ophost.v1.MsgRecoverBridgeFundsResponse
+	31, // 39: opinit.ophost.v1.Msg.RegisterL2NativeToken:output_type -> opinit.ophost.v1.MsgRegisterL2NativeTokenResponse
+	24, // [24:40] is the sub-list for method output_type
+	8,  // [8:24] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_opinit_ophost_v1_tx_proto_init() }
@@ -12734,7 +19014,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateProposer); i {
+			switch v := v.(*MsgInitiateNftDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12746,7 +19026,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateProposerResponse); i {
+			switch v := v.(*MsgInitiateNftDepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12758,7 +19038,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateChallenger); i {
+			switch v := v.(*MsgFinalizeNftWithdrawal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12770,7 +19050,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateChallengerResponse); i {
+			switch v := v.(*MsgFinalizeNftWithdrawalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12782,7 +19062,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateBatchInfo); i {
+			switch v := v.(*MsgUpdateProposer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12794,7 +19074,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateBatchInfoResponse); i {
+			switch v := v.(*MsgUpdateProposerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12806,7 +19086,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateMetadata); i {
+			switch v := v.(*MsgUpdateChallengers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12818,7 +19098,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateMetadataResponse); i {
+			switch v := v.(*MsgUpdateChallengersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12830,7 +19110,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgUpdateBatchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12842,6 +19122,54 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBatchInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -12853,6 +19181,78 @@ func file_opinit_ophost_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateDepositCap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateDepositCapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRecoverBridgeFunds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRecoverBridgeFundsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterL2NativeToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterL2NativeTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -12860,7 +19260,7 @@ func file_opinit_ophost_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_opinit_ophost_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,11 +25,16 @@ const (
 	Msg_DeleteOutput_FullMethodName            = "/opinit.ophost.v1.Msg/DeleteOutput"
 	Msg_InitiateTokenDeposit_FullMethodName    = "/opinit.ophost.v1.Msg/InitiateTokenDeposit"
 	Msg_FinalizeTokenWithdrawal_FullMethodName = "/opinit.ophost.v1.Msg/FinalizeTokenWithdrawal"
+	Msg_InitiateNftDeposit_FullMethodName      = "/opinit.ophost.v1.Msg/InitiateNftDeposit"
+	Msg_FinalizeNftWithdrawal_FullMethodName   = "/opinit.ophost.v1.Msg/FinalizeNftWithdrawal"
 	Msg_UpdateProposer_FullMethodName          = "/opinit.ophost.v1.Msg/UpdateProposer"
-	Msg_UpdateChallenger_FullMethodName        = "/opinit.ophost.v1.Msg/UpdateChallenger"
+	Msg_UpdateChallengers_FullMethodName       = "/opinit.ophost.v1.Msg/UpdateChallengers"
 	Msg_UpdateBatchInfo_FullMethodName         = "/opinit.ophost.v1.Msg/UpdateBatchInfo"
 	Msg_UpdateMetadata_FullMethodName          = "/opinit.ophost.v1.Msg/UpdateMetadata"
 	Msg_UpdateParams_FullMethodName            = "/opinit.ophost.v1.Msg/UpdateParams"
+	Msg_UpdateDepositCap_FullMethodName        = "/opinit.ophost.v1.Msg/UpdateDepositCap"
+	Msg_RecoverBridgeFunds_FullMethodName      = "/opinit.ophost.v1.Msg/RecoverBridgeFunds"
+	Msg_RegisterL2NativeToken_FullMethodName   = "/opinit.ophost.v1.Msg/RegisterL2NativeToken"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,10 +55,14 @@ type MsgClient interface {
 	InitiateTokenDeposit(ctx context.Context, in *MsgInitiateTokenDeposit, opts ...grpc.CallOption) (*MsgInitiateTokenDepositResponse, error)
 	// FinalizeTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
 	FinalizeTokenWithdrawal(ctx context.Context, in *MsgFinalizeTokenWithdrawal, opts ...grpc.CallOption) (*MsgFinalizeTokenWithdrawalResponse, error)
+	// InitiateNftDeposit defines a user facing l1 => l2 nft transfer interface.
+	InitiateNftDeposit(ctx context.Context, in *MsgInitiateNftDeposit, opts ...grpc.CallOption) (*MsgInitiateNftDepositResponse, error)
+	// FinalizeNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	FinalizeNftWithdrawal(ctx context.Context, in *MsgFinalizeNftWithdrawal, opts ...grpc.CallOption) (*MsgFinalizeNftWithdrawalResponse, error)
 	// UpdateProposer defines a rpc handler method for MsgUpdateProposer.
 	UpdateProposer(ctx context.Context, in *MsgUpdateProposer, opts ...grpc.CallOption) (*MsgUpdateProposerResponse, error)
-	// UpdateChallenger defines a rpc handler method for MsgUpdateChallenger.
-	UpdateChallenger(ctx context.Context, in *MsgUpdateChallenger, opts ...grpc.CallOption) (*MsgUpdateChallengerResponse, error)
+	// UpdateChallengers defines a rpc handler method for MsgUpdateChallengers.
+	UpdateChallengers(ctx context.Context, in *MsgUpdateChallengers, opts ...grpc.CallOption) (*MsgUpdateChallengersResponse, error)
 	// UpdateBatchInfo defines a rpc handler method for MsgUpdateBatchInfo.
 	UpdateBatchInfo(ctx context.Context, in *MsgUpdateBatchInfo, opts ...grpc.CallOption) (*MsgUpdateBatchInfoResponse, error)
 	// UpdateMetadata defines a rpc handler method for MsgUpdateMetadata.
@@ -61,6 +70,12 @@ type MsgClient interface {
 	// UpdateParams defines an operation for updating the
 	// x/opchild module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateDepositCap defines a rpc handler method for MsgUpdateDepositCap.
+	UpdateDepositCap(ctx context.Context, in *MsgUpdateDepositCap, opts ...grpc.CallOption) (*MsgUpdateDepositCapResponse, error)
+	// RecoverBridgeFunds defines a rpc handler method for MsgRecoverBridgeFunds.
+	RecoverBridgeFunds(ctx context.Context, in *MsgRecoverBridgeFunds, opts ...grpc.CallOption) (*MsgRecoverBridgeFundsResponse, error)
+	// RegisterL2NativeToken defines a rpc handler method for MsgRegisterL2NativeToken.
+	RegisterL2NativeToken(ctx context.Context, in *MsgRegisterL2NativeToken, opts ...grpc.CallOption) (*MsgRegisterL2NativeTokenResponse, error)
 }
 
 type msgClient struct {
@@ -131,6 +146,26 @@ func (c *msgClient) FinalizeTokenWithdrawal(ctx context.Context, in *MsgFinalize
 	return out, nil
 }
 
+func (c *msgClient) InitiateNftDeposit(ctx context.Context, in *MsgInitiateNftDeposit, opts ...grpc.CallOption) (*MsgInitiateNftDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgInitiateNftDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_InitiateNftDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeNftWithdrawal(ctx context.Context, in *MsgFinalizeNftWithdrawal, opts ...grpc.CallOption) (*MsgFinalizeNftWithdrawalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFinalizeNftWithdrawalResponse)
+	err := c.cc.Invoke(ctx, Msg_FinalizeNftWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateProposer(ctx context.Context, in *MsgUpdateProposer, opts ...grpc.CallOption) (*MsgUpdateProposerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateProposerResponse)
@@ -141,10 +176,10 @@ func (c *msgClient) UpdateProposer(ctx context.Context, in *MsgUpdateProposer, o
 	return out, nil
 }
 
-func (c *msgClient) UpdateChallenger(ctx context.Context, in *MsgUpdateChallenger, opts ...grpc.CallOption) (*MsgUpdateChallengerResponse, error) {
+func (c *msgClient) UpdateChallengers(ctx context.Context, in *MsgUpdateChallengers, opts ...grpc.CallOption) (*MsgUpdateChallengersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MsgUpdateChallengerResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateChallenger_FullMethodName, in, out, cOpts...)
+	out := new(MsgUpdateChallengersResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateChallengers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +216,36 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateDepositCap(ctx context.Context, in *MsgUpdateDepositCap, opts ...grpc.CallOption) (*MsgUpdateDepositCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateDepositCapResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDepositCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverBridgeFunds(ctx context.Context, in *MsgRecoverBridgeFunds, opts ...grpc.CallOption) (*MsgRecoverBridgeFundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRecoverBridgeFundsResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverBridgeFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterL2NativeToken(ctx context.Context, in *MsgRegisterL2NativeToken, opts ...grpc.CallOption) (*MsgRegisterL2NativeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterL2NativeTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterL2NativeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -199,10 +264,14 @@ type MsgServer interface {
 	InitiateTokenDeposit(context.Context, *MsgInitiateTokenDeposit) (*MsgInitiateTokenDepositResponse, error)
 	// FinalizeTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
 	FinalizeTokenWithdrawal(context.Context, *MsgFinalizeTokenWithdrawal) (*MsgFinalizeTokenWithdrawalResponse, error)
+	// InitiateNftDeposit defines a user facing l1 => l2 nft transfer interface.
+	InitiateNftDeposit(context.Context, *MsgInitiateNftDeposit) (*MsgInitiateNftDepositResponse, error)
+	// FinalizeNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	FinalizeNftWithdrawal(context.Context, *MsgFinalizeNftWithdrawal) (*MsgFinalizeNftWithdrawalResponse, error)
 	// UpdateProposer defines a rpc handler method for MsgUpdateProposer.
 	UpdateProposer(context.Context, *MsgUpdateProposer) (*MsgUpdateProposerResponse, error)
-	// UpdateChallenger defines a rpc handler method for MsgUpdateChallenger.
-	UpdateChallenger(context.Context, *MsgUpdateChallenger) (*MsgUpdateChallengerResponse, error)
+	// UpdateChallengers defines a rpc handler method for MsgUpdateChallengers.
+	UpdateChallengers(context.Context, *MsgUpdateChallengers) (*MsgUpdateChallengersResponse, error)
 	// UpdateBatchInfo defines a rpc handler method for MsgUpdateBatchInfo.
 	UpdateBatchInfo(context.Context, *MsgUpdateBatchInfo) (*MsgUpdateBatchInfoResponse, error)
 	// UpdateMetadata defines a rpc handler method for MsgUpdateMetadata.
@@ -210,6 +279,12 @@ type MsgServer interface {
 	// UpdateParams defines an operation for updating the
 	// x/opchild module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateDepositCap defines a rpc handler method for MsgUpdateDepositCap.
+	UpdateDepositCap(context.Context, *MsgUpdateDepositCap) (*MsgUpdateDepositCapResponse, error)
+	// RecoverBridgeFunds defines a rpc handler method for MsgRecoverBridgeFunds.
+	RecoverBridgeFunds(context.Context, *MsgRecoverBridgeFunds) (*MsgRecoverBridgeFundsResponse, error)
+	// RegisterL2NativeToken defines a rpc handler method for MsgRegisterL2NativeToken.
+	RegisterL2NativeToken(context.Context, *MsgRegisterL2NativeToken) (*MsgRegisterL2NativeTokenResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -235,11 +310,17 @@ func (UnimplementedMsgServer) InitiateTokenDeposit(context.Context, *MsgInitiate
 func (UnimplementedMsgServer) FinalizeTokenWithdrawal(context.Context, *MsgFinalizeTokenWithdrawal) (*MsgFinalizeTokenWithdrawalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTokenWithdrawal not implemented")
 }
+func (UnimplementedMsgServer) InitiateNftDeposit(context.Context, *MsgInitiateNftDeposit) (*MsgInitiateNftDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateNftDeposit not implemented")
+}
+func (UnimplementedMsgServer) FinalizeNftWithdrawal(context.Context, *MsgFinalizeNftWithdrawal) (*MsgFinalizeNftWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeNftWithdrawal not implemented")
+}
 func (UnimplementedMsgServer) UpdateProposer(context.Context, *MsgUpdateProposer) (*MsgUpdateProposerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposer not implemented")
 }
-func (UnimplementedMsgServer) UpdateChallenger(context.Context, *MsgUpdateChallenger) (*MsgUpdateChallengerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChallenger not implemented")
+func (UnimplementedMsgServer) UpdateChallengers(context.Context, *MsgUpdateChallengers) (*MsgUpdateChallengersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChallengers not implemented")
 }
 func (UnimplementedMsgServer) UpdateBatchInfo(context.Context, *MsgUpdateBatchInfo) (*MsgUpdateBatchInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchInfo not implemented")
@@ -250,6 +331,15 @@ func (UnimplementedMsgServer) UpdateMetadata(context.Context, *MsgUpdateMetadata
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateDepositCap(context.Context, *MsgUpdateDepositCap) (*MsgUpdateDepositCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepositCap not implemented")
+}
+func (UnimplementedMsgServer) RecoverBridgeFunds(context.Context, *MsgRecoverBridgeFunds) (*MsgRecoverBridgeFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverBridgeFunds not implemented")
+}
+func (UnimplementedMsgServer) RegisterL2NativeToken(context.Context, *MsgRegisterL2NativeToken) (*MsgRegisterL2NativeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterL2NativeToken not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -371,6 +461,42 @@ func _Msg_FinalizeTokenWithdrawal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InitiateNftDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateNftDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateNftDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitiateNftDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateNftDeposit(ctx, req.(*MsgInitiateNftDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeNftWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeNftWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeNftWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinalizeNftWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeNftWithdrawal(ctx, req.(*MsgFinalizeNftWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateProposer)
 	if err := dec(in); err != nil {
@@ -389,20 +515,20 @@ func _Msg_UpdateProposer_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateChallenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateChallenger)
+func _Msg_UpdateChallengers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChallengers)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateChallenger(ctx, in)
+		return srv.(MsgServer).UpdateChallengers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_UpdateChallenger_FullMethodName,
+		FullMethod: Msg_UpdateChallengers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateChallenger(ctx, req.(*MsgUpdateChallenger))
+		return srv.(MsgServer).UpdateChallengers(ctx, req.(*MsgUpdateChallengers))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -461,6 +587,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDepositCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDepositCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDepositCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDepositCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDepositCap(ctx, req.(*MsgUpdateDepositCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverBridgeFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverBridgeFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverBridgeFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverBridgeFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverBridgeFunds(ctx, req.(*MsgRecoverBridgeFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterL2NativeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterL2NativeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterL2NativeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterL2NativeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterL2NativeToken(ctx, req.(*MsgRegisterL2NativeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -492,13 +672,21 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizeTokenWithdrawal",
 			Handler:    _Msg_FinalizeTokenWithdrawal_Handler,
 		},
+		{
+			MethodName: "InitiateNftDeposit",
+			Handler:    _Msg_InitiateNftDeposit_Handler,
+		},
+		{
+			MethodName: "FinalizeNftWithdrawal",
+			Handler:    _Msg_FinalizeNftWithdrawal_Handler,
+		},
 		{
 			MethodName: "UpdateProposer",
 			Handler:    _Msg_UpdateProposer_Handler,
 		},
 		{
-			MethodName: "UpdateChallenger",
-			Handler:    _Msg_UpdateChallenger_Handler,
+			MethodName: "UpdateChallengers",
+			Handler:    _Msg_UpdateChallengers_Handler,
 		},
 		{
 			MethodName: "UpdateBatchInfo",
@@ -512,6 +700,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateDepositCap",
+			Handler:    _Msg_UpdateDepositCap_Handler,
+		},
+		{
+			MethodName: "RecoverBridgeFunds",
+			Handler:    _Msg_RecoverBridgeFunds_Handler,
+		},
+		{
+			MethodName: "RegisterL2NativeToken",
+			Handler:    _Msg_RegisterL2NativeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "opinit/ophost/v1/tx.proto",
@@ -70,14 +70,16 @@ func (x *_Params_1_list) IsValid() bool {
 }
 
 var (
-	md_Params                  protoreflect.MessageDescriptor
-	fd_Params_registration_fee protoreflect.FieldDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_registration_fee             protoreflect.FieldDescriptor
+	fd_Params_deposit_commitment_retention protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_types_proto_init()
 	md_Params = File_opinit_ophost_v1_types_proto.Messages().ByName("Params")
 	fd_Params_registration_fee = md_Params.Fields().ByName("registration_fee")
+	fd_Params_deposit_commitment_retention = md_Params.Fields().ByName("deposit_commitment_retention")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -151,6 +153,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DepositCommitmentRetention != nil {
+		value := protoreflect.ValueOfMessage(x.DepositCommitmentRetention.ProtoReflect())
+		if !f(fd_Params_deposit_commitment_retention, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -168,6 +176,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "opinit.ophost.v1.Params.registration_fee":
 		return len(x.RegistrationFee) != 0
+	case "opinit.ophost.v1.Params.deposit_commitment_retention":
+		return x.DepositCommitmentRetention != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Params"))
@@ -186,6 +196,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "opinit.ophost.v1.Params.registration_fee":
 		x.RegistrationFee = nil
+	case "opinit.ophost.v1.Params.deposit_commitment_retention":
+		x.DepositCommitmentRetention = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Params"))
@@ -208,6 +220,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_1_list{list: &x.RegistrationFee}
 		return protoreflect.ValueOfList(listValue)
+	case "opinit.ophost.v1.Params.deposit_commitment_retention":
+		value := x.DepositCommitmentRetention
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Params"))
@@ -232,6 +247,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_1_list)
 		x.RegistrationFee = *clv.list
+	case "opinit.ophost.v1.Params.deposit_commitment_retention":
+		x.DepositCommitmentRetention = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Params"))
@@ -258,6 +275,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.RegistrationFee}
 		return protoreflect.ValueOfList(value)
+	case "opinit.ophost.v1.Params.deposit_commitment_retention":
+		if x.DepositCommitmentRetention == nil {
+			x.DepositCommitmentRetention = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DepositCommitmentRetention.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Params"))
@@ -274,6 +296,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "opinit.ophost.v1.Params.registration_fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "opinit.ophost.v1.Params.deposit_commitment_retention":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Params"))
@@ -349,6 +374,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.DepositCommitmentRetention != nil {
+			l = options.Size(x.DepositCommitmentRetention)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -378,6 +407,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DepositCommitmentRetention != nil {
+			encoded, err := options.Marshal(x.DepositCommitmentRetention)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.RegistrationFee) > 0 {
 			for iNdEx := len(x.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RegistrationFee[iNdEx])
@@ -477,6 +520,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCommitmentRetention", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositCommitmentRetention == nil {
+					x.DepositCommitmentRetention = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositCommitmentRetention); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2407,29 +2486,27 @@ func (x *fastReflection_TokenPair) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Output                 protoreflect.MessageDescriptor
-	fd_Output_output_root     protoreflect.FieldDescriptor
-	fd_Output_l1_block_time   protoreflect.FieldDescriptor
-	fd_Output_l2_block_number protoreflect.FieldDescriptor
+	md_NftClassPair             protoreflect.MessageDescriptor
+	fd_NftClassPair_l1_class_id protoreflect.FieldDescriptor
+	fd_NftClassPair_l2_class_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_types_proto_init()
-	md_Output = File_opinit_ophost_v1_types_proto.Messages().ByName("Output")
-	fd_Output_output_root = md_Output.Fields().ByName("output_root")
-	fd_Output_l1_block_time = md_Output.Fields().ByName("l1_block_time")
-	fd_Output_l2_block_number = md_Output.Fields().ByName("l2_block_number")
+	md_NftClassPair = File_opinit_ophost_v1_types_proto.Messages().ByName("NftClassPair")
+	fd_NftClassPair_l1_class_id = md_NftClassPair.Fields().ByName("l1_class_id")
+	fd_NftClassPair_l2_class_id = md_NftClassPair.Fields().ByName("l2_class_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_Output)(nil)
+var _ protoreflect.Message = (*fastReflection_NftClassPair)(nil)
 
-type fastReflection_Output Output
+type fastReflection_NftClassPair NftClassPair
 
-func (x *Output) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Output)(x)
+func (x *NftClassPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NftClassPair)(x)
 }
 
-func (x *Output) slowProtoReflect() protoreflect.Message {
+func (x *NftClassPair) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_types_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2441,43 +2518,43 @@ func (x *Output) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Output_messageType fastReflection_Output_messageType
-var _ protoreflect.MessageType = fastReflection_Output_messageType{}
+var _fastReflection_NftClassPair_messageType fastReflection_NftClassPair_messageType
+var _ protoreflect.MessageType = fastReflection_NftClassPair_messageType{}
 
-type fastReflection_Output_messageType struct{}
+type fastReflection_NftClassPair_messageType struct{}
 
-func (x fastReflection_Output_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Output)(nil)
+func (x fastReflection_NftClassPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NftClassPair)(nil)
 }
-func (x fastReflection_Output_messageType) New() protoreflect.Message {
-	return new(fastReflection_Output)
+func (x fastReflection_NftClassPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_NftClassPair)
 }
-func (x fastReflection_Output_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Output
+func (x fastReflection_NftClassPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NftClassPair
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Output) Descriptor() protoreflect.MessageDescriptor {
-	return md_Output
+func (x *fastReflection_NftClassPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_NftClassPair
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Output) Type() protoreflect.MessageType {
-	return _fastReflection_Output_messageType
+func (x *fastReflection_NftClassPair) Type() protoreflect.MessageType {
+	return _fastReflection_NftClassPair_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Output) New() protoreflect.Message {
-	return new(fastReflection_Output)
+func (x *fastReflection_NftClassPair) New() protoreflect.Message {
+	return new(fastReflection_NftClassPair)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
-	return (*Output)(x)
+func (x *fastReflection_NftClassPair) Interface() protoreflect.ProtoMessage {
+	return (*NftClassPair)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2485,22 +2562,16 @@ func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.OutputRoot) != 0 {
-		value := protoreflect.ValueOfBytes(x.OutputRoot)
-		if !f(fd_Output_output_root, value) {
-			return
-		}
-	}
-	if x.L1BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.L1BlockTime.ProtoReflect())
-		if !f(fd_Output_l1_block_time, value) {
+func (x *fastReflection_NftClassPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.L1ClassId != "" {
+		value := protoreflect.ValueOfString(x.L1ClassId)
+		if !f(fd_NftClassPair_l1_class_id, value) {
 			return
 		}
 	}
-	if x.L2BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
-		if !f(fd_Output_l2_block_number, value) {
+	if x.L2ClassId != "" {
+		value := protoreflect.ValueOfString(x.L2ClassId)
+		if !f(fd_NftClassPair_l2_class_id, value) {
 			return
 		}
 	}
@@ -2517,19 +2588,17 @@ func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_NftClassPair) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.Output.output_root":
-		return len(x.OutputRoot) != 0
-	case "opinit.ophost.v1.Output.l1_block_time":
-		return x.L1BlockTime != nil
-	case "opinit.ophost.v1.Output.l2_block_number":
-		return x.L2BlockNumber != uint64(0)
+	case "opinit.ophost.v1.NftClassPair.l1_class_id":
+		return x.L1ClassId != ""
+	case "opinit.ophost.v1.NftClassPair.l2_class_id":
+		return x.L2ClassId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.NftClassPair"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.NftClassPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2539,19 +2608,17 @@ func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_NftClassPair) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.Output.output_root":
-		x.OutputRoot = nil
-	case "opinit.ophost.v1.Output.l1_block_time":
-		x.L1BlockTime = nil
-	case "opinit.ophost.v1.Output.l2_block_number":
-		x.L2BlockNumber = uint64(0)
+	case "opinit.ophost.v1.NftClassPair.l1_class_id":
+		x.L1ClassId = ""
+	case "opinit.ophost.v1.NftClassPair.l2_class_id":
+		x.L2ClassId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.NftClassPair"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.NftClassPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2561,22 +2628,19 @@ func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NftClassPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.Output.output_root":
-		value := x.OutputRoot
-		return protoreflect.ValueOfBytes(value)
-	case "opinit.ophost.v1.Output.l1_block_time":
-		value := x.L1BlockTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "opinit.ophost.v1.Output.l2_block_number":
-		value := x.L2BlockNumber
-		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.NftClassPair.l1_class_id":
+		value := x.L1ClassId
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.NftClassPair.l2_class_id":
+		value := x.L2ClassId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.NftClassPair"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.NftClassPair does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2590,19 +2654,17 @@ func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_NftClassPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.Output.output_root":
-		x.OutputRoot = value.Bytes()
-	case "opinit.ophost.v1.Output.l1_block_time":
-		x.L1BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "opinit.ophost.v1.Output.l2_block_number":
-		x.L2BlockNumber = value.Uint()
+	case "opinit.ophost.v1.NftClassPair.l1_class_id":
+		x.L1ClassId = value.Interface().(string)
+	case "opinit.ophost.v1.NftClassPair.l2_class_id":
+		x.L2ClassId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.NftClassPair"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.NftClassPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2616,52 +2678,44 @@ func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NftClassPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.Output.l1_block_time":
-		if x.L1BlockTime == nil {
-			x.L1BlockTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.L1BlockTime.ProtoReflect())
-	case "opinit.ophost.v1.Output.output_root":
-		panic(fmt.Errorf("field output_root of message opinit.ophost.v1.Output is not mutable"))
-	case "opinit.ophost.v1.Output.l2_block_number":
-		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.Output is not mutable"))
+	case "opinit.ophost.v1.NftClassPair.l1_class_id":
+		panic(fmt.Errorf("field l1_class_id of message opinit.ophost.v1.NftClassPair is not mutable"))
+	case "opinit.ophost.v1.NftClassPair.l2_class_id":
+		panic(fmt.Errorf("field l2_class_id of message opinit.ophost.v1.NftClassPair is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.NftClassPair"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.NftClassPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Output) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NftClassPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.Output.output_root":
-		return protoreflect.ValueOfBytes(nil)
-	case "opinit.ophost.v1.Output.l1_block_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "opinit.ophost.v1.Output.l2_block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.NftClassPair.l1_class_id":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.NftClassPair.l2_class_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.NftClassPair"))
 		}
-		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message opinit.ophost.v1.NftClassPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_NftClassPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.Output", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.NftClassPair", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2669,7 +2723,7 @@ func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_NftClassPair) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2680,7 +2734,7 @@ func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_NftClassPair) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2692,7 +2746,7 @@ func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Output) IsValid() bool {
+func (x *fastReflection_NftClassPair) IsValid() bool {
 	return x != nil
 }
 
@@ -2702,9 +2756,9 @@ func (x *fastReflection_Output) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_NftClassPair) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*NftClassPair)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2716,17 +2770,14 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.OutputRoot)
+		l = len(x.L1ClassId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.L1BlockTime != nil {
-			l = options.Size(x.L1BlockTime)
+		l = len(x.L2ClassId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.L2BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2737,7 +2788,7 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*NftClassPair)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2756,29 +2807,17 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.L2BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.L1BlockTime != nil {
-			encoded, err := options.Marshal(x.L1BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.L2ClassId) > 0 {
+			i -= len(x.L2ClassId)
+			copy(dAtA[i:], x.L2ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L2ClassId)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.OutputRoot) > 0 {
-			i -= len(x.OutputRoot)
-			copy(dAtA[i:], x.OutputRoot)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutputRoot)))
+		if len(x.L1ClassId) > 0 {
+			i -= len(x.L1ClassId)
+			copy(dAtA[i:], x.L1ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.L1ClassId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2793,7 +2832,7 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Output)
+		x := input.Message.Interface().(*NftClassPair)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2825,17 +2864,17 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NftClassPair: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NftClassPair: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1ClassId", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2845,31 +2884,29 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OutputRoot = append(x.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
-				if x.OutputRoot == nil {
-					x.OutputRoot = []byte{}
-				}
+				x.L1ClassId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1BlockTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2ClassId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2879,47 +2916,24 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.L1BlockTime == nil {
-					x.L1BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.L1BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.L2ClassId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-				}
-				x.L2BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.L2BlockNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2956,27 +2970,27 @@ func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_BatchInfoWithOutput           protoreflect.MessageDescriptor
-	fd_BatchInfoWithOutput_batchInfo protoreflect.FieldDescriptor
-	fd_BatchInfoWithOutput_output    protoreflect.FieldDescriptor
+	md_ProvenWithdrawalBitmap        protoreflect.MessageDescriptor
+	fd_ProvenWithdrawalBitmap_index  protoreflect.FieldDescriptor
+	fd_ProvenWithdrawalBitmap_bitmap protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_opinit_ophost_v1_types_proto_init()
-	md_BatchInfoWithOutput = File_opinit_ophost_v1_types_proto.Messages().ByName("BatchInfoWithOutput")
-	fd_BatchInfoWithOutput_batchInfo = md_BatchInfoWithOutput.Fields().ByName("batchInfo")
-	fd_BatchInfoWithOutput_output = md_BatchInfoWithOutput.Fields().ByName("output")
+	md_ProvenWithdrawalBitmap = File_opinit_ophost_v1_types_proto.Messages().ByName("ProvenWithdrawalBitmap")
+	fd_ProvenWithdrawalBitmap_index = md_ProvenWithdrawalBitmap.Fields().ByName("index")
+	fd_ProvenWithdrawalBitmap_bitmap = md_ProvenWithdrawalBitmap.Fields().ByName("bitmap")
 }
 
-var _ protoreflect.Message = (*fastReflection_BatchInfoWithOutput)(nil)
+var _ protoreflect.Message = (*fastReflection_ProvenWithdrawalBitmap)(nil)
 
-type fastReflection_BatchInfoWithOutput BatchInfoWithOutput
+type fastReflection_ProvenWithdrawalBitmap ProvenWithdrawalBitmap
 
-func (x *BatchInfoWithOutput) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BatchInfoWithOutput)(x)
+func (x *ProvenWithdrawalBitmap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProvenWithdrawalBitmap)(x)
 }
 
-func (x *BatchInfoWithOutput) slowProtoReflect() protoreflect.Message {
+func (x *ProvenWithdrawalBitmap) slowProtoReflect() protoreflect.Message {
 	mi := &file_opinit_ophost_v1_types_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2988,7 +3002,2961 @@ func (x *BatchInfoWithOutput) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BatchInfoWithOutput_messageType fastReflection_BatchInfoWithOutput_messageType
+var _fastReflection_ProvenWithdrawalBitmap_messageType fastReflection_ProvenWithdrawalBitmap_messageType
+var _ protoreflect.MessageType = fastReflection_ProvenWithdrawalBitmap_messageType{}
+
+type fastReflection_ProvenWithdrawalBitmap_messageType struct{}
+
+func (x fastReflection_ProvenWithdrawalBitmap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProvenWithdrawalBitmap)(nil)
+}
+func (x fastReflection_ProvenWithdrawalBitmap_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProvenWithdrawalBitmap)
+}
+func (x fastReflection_ProvenWithdrawalBitmap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvenWithdrawalBitmap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProvenWithdrawalBitmap) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvenWithdrawalBitmap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProvenWithdrawalBitmap) Type() protoreflect.MessageType {
+	return _fastReflection_ProvenWithdrawalBitmap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProvenWithdrawalBitmap) New() protoreflect.Message {
+	return new(fastReflection_ProvenWithdrawalBitmap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProvenWithdrawalBitmap) Interface() protoreflect.ProtoMessage {
+	return (*ProvenWithdrawalBitmap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProvenWithdrawalBitmap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_ProvenWithdrawalBitmap_index, value) {
+			return
+		}
+	}
+	if len(x.Bitmap) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bitmap)
+		if !f(fd_ProvenWithdrawalBitmap_bitmap, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProvenWithdrawalBitmap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.index":
+		return x.Index != uint64(0)
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.bitmap":
+		return len(x.Bitmap) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.ProvenWithdrawalBitmap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.ProvenWithdrawalBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvenWithdrawalBitmap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.index":
+		x.Index = uint64(0)
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.bitmap":
+		x.Bitmap = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.ProvenWithdrawalBitmap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.ProvenWithdrawalBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProvenWithdrawalBitmap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.bitmap":
+		value := x.Bitmap
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.ProvenWithdrawalBitmap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.ProvenWithdrawalBitmap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvenWithdrawalBitmap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.index":
+		x.Index = value.Uint()
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.bitmap":
+		x.Bitmap = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.ProvenWithdrawalBitmap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.ProvenWithdrawalBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvenWithdrawalBitmap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.index":
+		panic(fmt.Errorf("field index of message opinit.ophost.v1.ProvenWithdrawalBitmap is not mutable"))
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.bitmap":
+		panic(fmt.Errorf("field bitmap of message opinit.ophost.v1.ProvenWithdrawalBitmap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.ProvenWithdrawalBitmap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.ProvenWithdrawalBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProvenWithdrawalBitmap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.ProvenWithdrawalBitmap.bitmap":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.ProvenWithdrawalBitmap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.ProvenWithdrawalBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProvenWithdrawalBitmap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.ProvenWithdrawalBitmap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProvenWithdrawalBitmap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvenWithdrawalBitmap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProvenWithdrawalBitmap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProvenWithdrawalBitmap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProvenWithdrawalBitmap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Bitmap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProvenWithdrawalBitmap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Bitmap) > 0 {
+			i -= len(x.Bitmap)
+			copy(dAtA[i:], x.Bitmap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bitmap)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProvenWithdrawalBitmap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvenWithdrawalBitmap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvenWithdrawalBitmap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bitmap = append(x.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bitmap == nil {
+					x.Bitmap = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DepositCommitment            protoreflect.MessageDescriptor
+	fd_DepositCommitment_sequence   protoreflect.FieldDescriptor
+	fd_DepositCommitment_commitment protoreflect.FieldDescriptor
+	fd_DepositCommitment_time       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_types_proto_init()
+	md_DepositCommitment = File_opinit_ophost_v1_types_proto.Messages().ByName("DepositCommitment")
+	fd_DepositCommitment_sequence = md_DepositCommitment.Fields().ByName("sequence")
+	fd_DepositCommitment_commitment = md_DepositCommitment.Fields().ByName("commitment")
+	fd_DepositCommitment_time = md_DepositCommitment.Fields().ByName("time")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositCommitment)(nil)
+
+type fastReflection_DepositCommitment DepositCommitment
+
+func (x *DepositCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositCommitment)(x)
+}
+
+func (x *DepositCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositCommitment_messageType fastReflection_DepositCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_DepositCommitment_messageType{}
+
+type fastReflection_DepositCommitment_messageType struct{}
+
+func (x fastReflection_DepositCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositCommitment)(nil)
+}
+func (x fastReflection_DepositCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositCommitment)
+}
+func (x fastReflection_DepositCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositCommitment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositCommitment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_DepositCommitment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositCommitment) New() protoreflect.Message {
+	return new(fastReflection_DepositCommitment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositCommitment) Interface() protoreflect.ProtoMessage {
+	return (*DepositCommitment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_DepositCommitment_sequence, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_DepositCommitment_commitment, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_DepositCommitment_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCommitment.sequence":
+		return x.Sequence != uint64(0)
+	case "opinit.ophost.v1.DepositCommitment.commitment":
+		return len(x.Commitment) != 0
+	case "opinit.ophost.v1.DepositCommitment.time":
+		return x.Time != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCommitment.sequence":
+		x.Sequence = uint64(0)
+	case "opinit.ophost.v1.DepositCommitment.commitment":
+		x.Commitment = nil
+	case "opinit.ophost.v1.DepositCommitment.time":
+		x.Time = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.DepositCommitment.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.DepositCommitment.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.DepositCommitment.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCommitment.sequence":
+		x.Sequence = value.Uint()
+	case "opinit.ophost.v1.DepositCommitment.commitment":
+		x.Commitment = value.Bytes()
+	case "opinit.ophost.v1.DepositCommitment.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCommitment.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "opinit.ophost.v1.DepositCommitment.sequence":
+		panic(fmt.Errorf("field sequence of message opinit.ophost.v1.DepositCommitment is not mutable"))
+	case "opinit.ophost.v1.DepositCommitment.commitment":
+		panic(fmt.Errorf("field commitment of message opinit.ophost.v1.DepositCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCommitment.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.DepositCommitment.commitment":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.DepositCommitment.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCommitment"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.DepositCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Output                 protoreflect.MessageDescriptor
+	fd_Output_output_root     protoreflect.FieldDescriptor
+	fd_Output_l1_block_time   protoreflect.FieldDescriptor
+	fd_Output_l2_block_number protoreflect.FieldDescriptor
+	fd_Output_version         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_types_proto_init()
+	md_Output = File_opinit_ophost_v1_types_proto.Messages().ByName("Output")
+	fd_Output_output_root = md_Output.Fields().ByName("output_root")
+	fd_Output_l1_block_time = md_Output.Fields().ByName("l1_block_time")
+	fd_Output_l2_block_number = md_Output.Fields().ByName("l2_block_number")
+	fd_Output_version = md_Output.Fields().ByName("version")
+}
+
+var _ protoreflect.Message = (*fastReflection_Output)(nil)
+
+type fastReflection_Output Output
+
+func (x *Output) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Output)(x)
+}
+
+func (x *Output) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Output_messageType fastReflection_Output_messageType
+var _ protoreflect.MessageType = fastReflection_Output_messageType{}
+
+type fastReflection_Output_messageType struct{}
+
+func (x fastReflection_Output_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Output)(nil)
+}
+func (x fastReflection_Output_messageType) New() protoreflect.Message {
+	return new(fastReflection_Output)
+}
+func (x fastReflection_Output_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Output
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Output) Descriptor() protoreflect.MessageDescriptor {
+	return md_Output
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Output) Type() protoreflect.MessageType {
+	return _fastReflection_Output_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Output) New() protoreflect.Message {
+	return new(fastReflection_Output)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Output) Interface() protoreflect.ProtoMessage {
+	return (*Output)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Output) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OutputRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.OutputRoot)
+		if !f(fd_Output_output_root, value) {
+			return
+		}
+	}
+	if x.L1BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.L1BlockTime.ProtoReflect())
+		if !f(fd_Output_l1_block_time, value) {
+			return
+		}
+	}
+	if x.L2BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.L2BlockNumber)
+		if !f(fd_Output_l2_block_number, value) {
+			return
+		}
+	}
+	if len(x.Version) != 0 {
+		value := protoreflect.ValueOfBytes(x.Version)
+		if !f(fd_Output_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Output) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.Output.output_root":
+		return len(x.OutputRoot) != 0
+	case "opinit.ophost.v1.Output.l1_block_time":
+		return x.L1BlockTime != nil
+	case "opinit.ophost.v1.Output.l2_block_number":
+		return x.L2BlockNumber != uint64(0)
+	case "opinit.ophost.v1.Output.version":
+		return len(x.Version) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Output) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.Output.output_root":
+		x.OutputRoot = nil
+	case "opinit.ophost.v1.Output.l1_block_time":
+		x.L1BlockTime = nil
+	case "opinit.ophost.v1.Output.l2_block_number":
+		x.L2BlockNumber = uint64(0)
+	case "opinit.ophost.v1.Output.version":
+		x.Version = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Output) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.Output.output_root":
+		value := x.OutputRoot
+		return protoreflect.ValueOfBytes(value)
+	case "opinit.ophost.v1.Output.l1_block_time":
+		value := x.L1BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "opinit.ophost.v1.Output.l2_block_number":
+		value := x.L2BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.Output.version":
+		value := x.Version
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Output) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.Output.output_root":
+		x.OutputRoot = value.Bytes()
+	case "opinit.ophost.v1.Output.l1_block_time":
+		x.L1BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "opinit.ophost.v1.Output.l2_block_number":
+		x.L2BlockNumber = value.Uint()
+	case "opinit.ophost.v1.Output.version":
+		x.Version = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Output) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.Output.l1_block_time":
+		if x.L1BlockTime == nil {
+			x.L1BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.L1BlockTime.ProtoReflect())
+	case "opinit.ophost.v1.Output.output_root":
+		panic(fmt.Errorf("field output_root of message opinit.ophost.v1.Output is not mutable"))
+	case "opinit.ophost.v1.Output.l2_block_number":
+		panic(fmt.Errorf("field l2_block_number of message opinit.ophost.v1.Output is not mutable"))
+	case "opinit.ophost.v1.Output.version":
+		panic(fmt.Errorf("field version of message opinit.ophost.v1.Output is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Output) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.Output.output_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "opinit.ophost.v1.Output.l1_block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "opinit.ophost.v1.Output.l2_block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.Output.version":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.Output"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.Output does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Output) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.Output", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Output) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Output) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Output) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Output) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Output)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OutputRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.L1BlockTime != nil {
+			l = options.Size(x.L1BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.L2BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.L2BlockNumber))
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Output)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.L2BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.L2BlockNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.L1BlockTime != nil {
+			encoded, err := options.Marshal(x.L1BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OutputRoot) > 0 {
+			i -= len(x.OutputRoot)
+			copy(dAtA[i:], x.OutputRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutputRoot)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Output)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutputRoot = append(x.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.OutputRoot == nil {
+					x.OutputRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L1BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.L1BlockTime == nil {
+					x.L1BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.L1BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+				}
+				x.L2BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.L2BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = append(x.Version[:0], dAtA[iNdEx:postIndex]...)
+				if x.Version == nil {
+					x.Version = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DepositCap                  protoreflect.MessageDescriptor
+	fd_DepositCap_denom            protoreflect.FieldDescriptor
+	fd_DepositCap_max_total_locked protoreflect.FieldDescriptor
+	fd_DepositCap_max_per_deposit  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_types_proto_init()
+	md_DepositCap = File_opinit_ophost_v1_types_proto.Messages().ByName("DepositCap")
+	fd_DepositCap_denom = md_DepositCap.Fields().ByName("denom")
+	fd_DepositCap_max_total_locked = md_DepositCap.Fields().ByName("max_total_locked")
+	fd_DepositCap_max_per_deposit = md_DepositCap.Fields().ByName("max_per_deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_DepositCap)(nil)
+
+type fastReflection_DepositCap DepositCap
+
+func (x *DepositCap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositCap)(x)
+}
+
+func (x *DepositCap) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DepositCap_messageType fastReflection_DepositCap_messageType
+var _ protoreflect.MessageType = fastReflection_DepositCap_messageType{}
+
+type fastReflection_DepositCap_messageType struct{}
+
+func (x fastReflection_DepositCap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositCap)(nil)
+}
+func (x fastReflection_DepositCap_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositCap)
+}
+func (x fastReflection_DepositCap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositCap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DepositCap) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositCap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DepositCap) Type() protoreflect.MessageType {
+	return _fastReflection_DepositCap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DepositCap) New() protoreflect.Message {
+	return new(fastReflection_DepositCap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DepositCap) Interface() protoreflect.ProtoMessage {
+	return (*DepositCap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DepositCap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DepositCap_denom, value) {
+			return
+		}
+	}
+	if x.MaxTotalLocked != "" {
+		value := protoreflect.ValueOfString(x.MaxTotalLocked)
+		if !f(fd_DepositCap_max_total_locked, value) {
+			return
+		}
+	}
+	if x.MaxPerDeposit != "" {
+		value := protoreflect.ValueOfString(x.MaxPerDeposit)
+		if !f(fd_DepositCap_max_per_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DepositCap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCap.denom":
+		return x.Denom != ""
+	case "opinit.ophost.v1.DepositCap.max_total_locked":
+		return x.MaxTotalLocked != ""
+	case "opinit.ophost.v1.DepositCap.max_per_deposit":
+		return x.MaxPerDeposit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCap.denom":
+		x.Denom = ""
+	case "opinit.ophost.v1.DepositCap.max_total_locked":
+		x.MaxTotalLocked = ""
+	case "opinit.ophost.v1.DepositCap.max_per_deposit":
+		x.MaxPerDeposit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DepositCap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.DepositCap.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.DepositCap.max_total_locked":
+		value := x.MaxTotalLocked
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.DepositCap.max_per_deposit":
+		value := x.MaxPerDeposit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCap.denom":
+		x.Denom = value.Interface().(string)
+	case "opinit.ophost.v1.DepositCap.max_total_locked":
+		x.MaxTotalLocked = value.Interface().(string)
+	case "opinit.ophost.v1.DepositCap.max_per_deposit":
+		x.MaxPerDeposit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCap.denom":
+		panic(fmt.Errorf("field denom of message opinit.ophost.v1.DepositCap is not mutable"))
+	case "opinit.ophost.v1.DepositCap.max_total_locked":
+		panic(fmt.Errorf("field max_total_locked of message opinit.ophost.v1.DepositCap is not mutable"))
+	case "opinit.ophost.v1.DepositCap.max_per_deposit":
+		panic(fmt.Errorf("field max_per_deposit of message opinit.ophost.v1.DepositCap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DepositCap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.DepositCap.denom":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.DepositCap.max_total_locked":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.DepositCap.max_per_deposit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.DepositCap"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.DepositCap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DepositCap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.DepositCap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DepositCap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositCap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DepositCap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DepositCap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DepositCap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxTotalLocked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPerDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DepositCap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPerDeposit) > 0 {
+			i -= len(x.MaxPerDeposit)
+			copy(dAtA[i:], x.MaxPerDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPerDeposit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MaxTotalLocked) > 0 {
+			i -= len(x.MaxTotalLocked)
+			copy(dAtA[i:], x.MaxTotalLocked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTotalLocked)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositCap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositCap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositCap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTotalLocked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTotalLocked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPerDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPerDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BridgeAccounting                  protoreflect.MessageDescriptor
+	fd_BridgeAccounting_denom            protoreflect.FieldDescriptor
+	fd_BridgeAccounting_deposited_amount protoreflect.FieldDescriptor
+	fd_BridgeAccounting_withdrawn_amount protoreflect.FieldDescriptor
+	fd_BridgeAccounting_deposit_count    protoreflect.FieldDescriptor
+	fd_BridgeAccounting_withdrawal_count protoreflect.FieldDescriptor
+	fd_BridgeAccounting_minted_amount    protoreflect.FieldDescriptor
+	fd_BridgeAccounting_burned_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_types_proto_init()
+	md_BridgeAccounting = File_opinit_ophost_v1_types_proto.Messages().ByName("BridgeAccounting")
+	fd_BridgeAccounting_denom = md_BridgeAccounting.Fields().ByName("denom")
+	fd_BridgeAccounting_deposited_amount = md_BridgeAccounting.Fields().ByName("deposited_amount")
+	fd_BridgeAccounting_withdrawn_amount = md_BridgeAccounting.Fields().ByName("withdrawn_amount")
+	fd_BridgeAccounting_deposit_count = md_BridgeAccounting.Fields().ByName("deposit_count")
+	fd_BridgeAccounting_withdrawal_count = md_BridgeAccounting.Fields().ByName("withdrawal_count")
+	fd_BridgeAccounting_minted_amount = md_BridgeAccounting.Fields().ByName("minted_amount")
+	fd_BridgeAccounting_burned_amount = md_BridgeAccounting.Fields().ByName("burned_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeAccounting)(nil)
+
+type fastReflection_BridgeAccounting BridgeAccounting
+
+func (x *BridgeAccounting) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeAccounting)(x)
+}
+
+func (x *BridgeAccounting) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeAccounting_messageType fastReflection_BridgeAccounting_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeAccounting_messageType{}
+
+type fastReflection_BridgeAccounting_messageType struct{}
+
+func (x fastReflection_BridgeAccounting_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeAccounting)(nil)
+}
+func (x fastReflection_BridgeAccounting_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeAccounting)
+}
+func (x fastReflection_BridgeAccounting_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeAccounting
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeAccounting) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeAccounting
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeAccounting) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeAccounting_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeAccounting) New() protoreflect.Message {
+	return new(fastReflection_BridgeAccounting)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeAccounting) Interface() protoreflect.ProtoMessage {
+	return (*BridgeAccounting)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeAccounting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_BridgeAccounting_denom, value) {
+			return
+		}
+	}
+	if x.DepositedAmount != "" {
+		value := protoreflect.ValueOfString(x.DepositedAmount)
+		if !f(fd_BridgeAccounting_deposited_amount, value) {
+			return
+		}
+	}
+	if x.WithdrawnAmount != "" {
+		value := protoreflect.ValueOfString(x.WithdrawnAmount)
+		if !f(fd_BridgeAccounting_withdrawn_amount, value) {
+			return
+		}
+	}
+	if x.DepositCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DepositCount)
+		if !f(fd_BridgeAccounting_deposit_count, value) {
+			return
+		}
+	}
+	if x.WithdrawalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WithdrawalCount)
+		if !f(fd_BridgeAccounting_withdrawal_count, value) {
+			return
+		}
+	}
+	if x.MintedAmount != "" {
+		value := protoreflect.ValueOfString(x.MintedAmount)
+		if !f(fd_BridgeAccounting_minted_amount, value) {
+			return
+		}
+	}
+	if x.BurnedAmount != "" {
+		value := protoreflect.ValueOfString(x.BurnedAmount)
+		if !f(fd_BridgeAccounting_burned_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeAccounting) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.BridgeAccounting.denom":
+		return x.Denom != ""
+	case "opinit.ophost.v1.BridgeAccounting.deposited_amount":
+		return x.DepositedAmount != ""
+	case "opinit.ophost.v1.BridgeAccounting.withdrawn_amount":
+		return x.WithdrawnAmount != ""
+	case "opinit.ophost.v1.BridgeAccounting.deposit_count":
+		return x.DepositCount != uint64(0)
+	case "opinit.ophost.v1.BridgeAccounting.withdrawal_count":
+		return x.WithdrawalCount != uint64(0)
+	case "opinit.ophost.v1.BridgeAccounting.minted_amount":
+		return x.MintedAmount != ""
+	case "opinit.ophost.v1.BridgeAccounting.burned_amount":
+		return x.BurnedAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.BridgeAccounting"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.BridgeAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeAccounting) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.BridgeAccounting.denom":
+		x.Denom = ""
+	case "opinit.ophost.v1.BridgeAccounting.deposited_amount":
+		x.DepositedAmount = ""
+	case "opinit.ophost.v1.BridgeAccounting.withdrawn_amount":
+		x.WithdrawnAmount = ""
+	case "opinit.ophost.v1.BridgeAccounting.deposit_count":
+		x.DepositCount = uint64(0)
+	case "opinit.ophost.v1.BridgeAccounting.withdrawal_count":
+		x.WithdrawalCount = uint64(0)
+	case "opinit.ophost.v1.BridgeAccounting.minted_amount":
+		x.MintedAmount = ""
+	case "opinit.ophost.v1.BridgeAccounting.burned_amount":
+		x.BurnedAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.BridgeAccounting"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.BridgeAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeAccounting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "opinit.ophost.v1.BridgeAccounting.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.BridgeAccounting.deposited_amount":
+		value := x.DepositedAmount
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.BridgeAccounting.withdrawn_amount":
+		value := x.WithdrawnAmount
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.BridgeAccounting.deposit_count":
+		value := x.DepositCount
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.BridgeAccounting.withdrawal_count":
+		value := x.WithdrawalCount
+		return protoreflect.ValueOfUint64(value)
+	case "opinit.ophost.v1.BridgeAccounting.minted_amount":
+		value := x.MintedAmount
+		return protoreflect.ValueOfString(value)
+	case "opinit.ophost.v1.BridgeAccounting.burned_amount":
+		value := x.BurnedAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.BridgeAccounting"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.BridgeAccounting does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeAccounting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.BridgeAccounting.denom":
+		x.Denom = value.Interface().(string)
+	case "opinit.ophost.v1.BridgeAccounting.deposited_amount":
+		x.DepositedAmount = value.Interface().(string)
+	case "opinit.ophost.v1.BridgeAccounting.withdrawn_amount":
+		x.WithdrawnAmount = value.Interface().(string)
+	case "opinit.ophost.v1.BridgeAccounting.deposit_count":
+		x.DepositCount = value.Uint()
+	case "opinit.ophost.v1.BridgeAccounting.withdrawal_count":
+		x.WithdrawalCount = value.Uint()
+	case "opinit.ophost.v1.BridgeAccounting.minted_amount":
+		x.MintedAmount = value.Interface().(string)
+	case "opinit.ophost.v1.BridgeAccounting.burned_amount":
+		x.BurnedAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.BridgeAccounting"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.BridgeAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeAccounting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.BridgeAccounting.denom":
+		panic(fmt.Errorf("field denom of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	case "opinit.ophost.v1.BridgeAccounting.deposited_amount":
+		panic(fmt.Errorf("field deposited_amount of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	case "opinit.ophost.v1.BridgeAccounting.withdrawn_amount":
+		panic(fmt.Errorf("field withdrawn_amount of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	case "opinit.ophost.v1.BridgeAccounting.deposit_count":
+		panic(fmt.Errorf("field deposit_count of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	case "opinit.ophost.v1.BridgeAccounting.withdrawal_count":
+		panic(fmt.Errorf("field withdrawal_count of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	case "opinit.ophost.v1.BridgeAccounting.minted_amount":
+		panic(fmt.Errorf("field minted_amount of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	case "opinit.ophost.v1.BridgeAccounting.burned_amount":
+		panic(fmt.Errorf("field burned_amount of message opinit.ophost.v1.BridgeAccounting is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.BridgeAccounting"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.BridgeAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeAccounting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "opinit.ophost.v1.BridgeAccounting.denom":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.BridgeAccounting.deposited_amount":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.BridgeAccounting.withdrawn_amount":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.BridgeAccounting.deposit_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.BridgeAccounting.withdrawal_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "opinit.ophost.v1.BridgeAccounting.minted_amount":
+		return protoreflect.ValueOfString("")
+	case "opinit.ophost.v1.BridgeAccounting.burned_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: opinit.ophost.v1.BridgeAccounting"))
+		}
+		panic(fmt.Errorf("message opinit.ophost.v1.BridgeAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeAccounting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in opinit.ophost.v1.BridgeAccounting", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeAccounting) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeAccounting) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeAccounting) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeAccounting) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeAccounting)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DepositedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WithdrawnAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DepositCount))
+		}
+		if x.WithdrawalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.WithdrawalCount))
+		}
+		l = len(x.MintedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BurnedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeAccounting)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BurnedAmount) > 0 {
+			i -= len(x.BurnedAmount)
+			copy(dAtA[i:], x.BurnedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnedAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MintedAmount) > 0 {
+			i -= len(x.MintedAmount)
+			copy(dAtA[i:], x.MintedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintedAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.WithdrawalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WithdrawalCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DepositCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.WithdrawnAmount) > 0 {
+			i -= len(x.WithdrawnAmount)
+			copy(dAtA[i:], x.WithdrawnAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawnAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DepositedAmount) > 0 {
+			i -= len(x.DepositedAmount)
+			copy(dAtA[i:], x.DepositedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositedAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeAccounting)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeAccounting: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawnAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+				}
+				x.DepositCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DepositCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+				}
+				x.WithdrawalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WithdrawalCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BatchInfoWithOutput            protoreflect.MessageDescriptor
+	fd_BatchInfoWithOutput_batch_info protoreflect.FieldDescriptor
+	fd_BatchInfoWithOutput_output     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_opinit_ophost_v1_types_proto_init()
+	md_BatchInfoWithOutput = File_opinit_ophost_v1_types_proto.Messages().ByName("BatchInfoWithOutput")
+	fd_BatchInfoWithOutput_batch_info = md_BatchInfoWithOutput.Fields().ByName("batch_info")
+	fd_BatchInfoWithOutput_output = md_BatchInfoWithOutput.Fields().ByName("output")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchInfoWithOutput)(nil)
+
+type fastReflection_BatchInfoWithOutput BatchInfoWithOutput
+
+func (x *BatchInfoWithOutput) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchInfoWithOutput)(x)
+}
+
+func (x *BatchInfoWithOutput) slowProtoReflect() protoreflect.Message {
+	mi := &file_opinit_ophost_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchInfoWithOutput_messageType fastReflection_BatchInfoWithOutput_messageType
 var _ protoreflect.MessageType = fastReflection_BatchInfoWithOutput_messageType{}
 
 type fastReflection_BatchInfoWithOutput_messageType struct{}
@@ -3035,7 +6003,7 @@ func (x *fastReflection_BatchInfoWithOutput) Interface() protoreflect.ProtoMessa
 func (x *fastReflection_BatchInfoWithOutput) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.BatchInfo != nil {
 		value := protoreflect.ValueOfMessage(x.BatchInfo.ProtoReflect())
-		if !f(fd_BatchInfoWithOutput_batchInfo, value) {
+		if !f(fd_BatchInfoWithOutput_batch_info, value) {
 			return
 		}
 	}
@@ -3060,7 +6028,7 @@ func (x *fastReflection_BatchInfoWithOutput) Range(f func(protoreflect.FieldDesc
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_BatchInfoWithOutput) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.BatchInfoWithOutput.batchInfo":
+	case "opinit.ophost.v1.BatchInfoWithOutput.batch_info":
 		return x.BatchInfo != nil
 	case "opinit.ophost.v1.BatchInfoWithOutput.output":
 		return x.Output != nil
@@ -3080,7 +6048,7 @@ func (x *fastReflection_BatchInfoWithOutput) Has(fd protoreflect.FieldDescriptor
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_BatchInfoWithOutput) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.BatchInfoWithOutput.batchInfo":
+	case "opinit.ophost.v1.BatchInfoWithOutput.batch_info":
 		x.BatchInfo = nil
 	case "opinit.ophost.v1.BatchInfoWithOutput.output":
 		x.Output = nil
@@ -3100,7 +6068,7 @@ func (x *fastReflection_BatchInfoWithOutput) Clear(fd protoreflect.FieldDescript
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_BatchInfoWithOutput) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "opinit.ophost.v1.BatchInfoWithOutput.batchInfo":
+	case "opinit.ophost.v1.BatchInfoWithOutput.batch_info":
 		value := x.BatchInfo
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	case "opinit.ophost.v1.BatchInfoWithOutput.output":
@@ -3126,7 +6094,7 @@ func (x *fastReflection_BatchInfoWithOutput) Get(descriptor protoreflect.FieldDe
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_BatchInfoWithOutput) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.BatchInfoWithOutput.batchInfo":
+	case "opinit.ophost.v1.BatchInfoWithOutput.batch_info":
 		x.BatchInfo = value.Message().Interface().(*BatchInfo)
 	case "opinit.ophost.v1.BatchInfoWithOutput.output":
 		x.Output = value.Message().Interface().(*Output)
@@ -3150,7 +6118,7 @@ func (x *fastReflection_BatchInfoWithOutput) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_BatchInfoWithOutput) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.BatchInfoWithOutput.batchInfo":
+	case "opinit.ophost.v1.BatchInfoWithOutput.batch_info":
 		if x.BatchInfo == nil {
 			x.BatchInfo = new(BatchInfo)
 		}
@@ -3173,7 +6141,7 @@ func (x *fastReflection_BatchInfoWithOutput) Mutable(fd protoreflect.FieldDescri
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_BatchInfoWithOutput) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "opinit.ophost.v1.BatchInfoWithOutput.batchInfo":
+	case "opinit.ophost.v1.BatchInfoWithOutput.batch_info":
 		m := new(BatchInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "opinit.ophost.v1.BatchInfoWithOutput.output":
@@ -3490,6 +6458,9 @@ type Params struct {
 
 	// The amount to be paid by l2 creator.
 	RegistrationFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
+	// The period for which a deposit commitment is kept to be proven on l2.
+	// Zero keeps the commitments forever.
+	DepositCommitmentRetention *durationpb.Duration `protobuf:"bytes,2,opt,name=deposit_commitment_retention,json=depositCommitmentRetention,proto3" json:"deposit_commitment_retention,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -3519,6 +6490,13 @@ func (x *Params) GetRegistrationFee() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetDepositCommitmentRetention() *durationpb.Duration {
+	if x != nil {
+		return x.DepositCommitmentRetention
+	}
+	return nil
+}
+
 // BridgeConfig defines the set of bridge config.
 type BridgeConfig struct {
 	state         protoimpl.MessageState
@@ -3702,6 +6680,149 @@ func (x *TokenPair) GetL2Denom() string {
 	return ""
 }
 
+// NftClassPair defines l1 and l2 nft class pair
+type NftClassPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	L1ClassId string `protobuf:"bytes,1,opt,name=l1_class_id,json=l1ClassId,proto3" json:"l1_class_id,omitempty"`
+	L2ClassId string `protobuf:"bytes,2,opt,name=l2_class_id,json=l2ClassId,proto3" json:"l2_class_id,omitempty"`
+}
+
+func (x *NftClassPair) Reset() {
+	*x = NftClassPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NftClassPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NftClassPair) ProtoMessage() {}
+
+// Deprecated: Use NftClassPair.ProtoReflect.Descriptor instead.
+func (*NftClassPair) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NftClassPair) GetL1ClassId() string {
+	if x != nil {
+		return x.L1ClassId
+	}
+	return ""
+}
+
+func (x *NftClassPair) GetL2ClassId() string {
+	if x != nil {
+		return x.L2ClassId
+	}
+	return ""
+}
+
+// ProvenWithdrawalBitmap defines the proven flags of 256 l2 sequences
+// starting from index * 256.
+type ProvenWithdrawalBitmap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Bitmap []byte `protobuf:"bytes,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (x *ProvenWithdrawalBitmap) Reset() {
+	*x = ProvenWithdrawalBitmap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvenWithdrawalBitmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvenWithdrawalBitmap) ProtoMessage() {}
+
+// Deprecated: Use ProvenWithdrawalBitmap.ProtoReflect.Descriptor instead.
+func (*ProvenWithdrawalBitmap) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProvenWithdrawalBitmap) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ProvenWithdrawalBitmap) GetBitmap() []byte {
+	if x != nil {
+		return x.Bitmap
+	}
+	return nil
+}
+
+// DepositCommitment defines the hash of a deposit committed on l1, which
+// allows l2 to verify the deposit with a merkle proof of the l1 state.
+type DepositCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence   uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The l1 block time of the deposit, which starts the retention period.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *DepositCommitment) Reset() {
+	*x = DepositCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositCommitment) ProtoMessage() {}
+
+// Deprecated: Use DepositCommitment.ProtoReflect.Descriptor instead.
+func (*DepositCommitment) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DepositCommitment) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DepositCommitment) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *DepositCommitment) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
 // Output is a l2 block submitted by proposer.
 type Output struct {
 	state         protoimpl.MessageState
@@ -3714,12 +6835,15 @@ type Output struct {
 	L1BlockTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=l1_block_time,json=l1BlockTime,proto3" json:"l1_block_time,omitempty"`
 	// The l2 block number that the output root was submitted in.
 	L2BlockNumber uint64 `protobuf:"varint,3,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty"`
+	// The version of the output root. Empty for the outputs proposed
+	// before the versions are recorded.
+	Version []byte `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Output) Reset() {
 	*x = Output{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_types_proto_msgTypes[4]
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3733,7 +6857,7 @@ func (*Output) ProtoMessage() {}
 
 // Deprecated: Use Output.ProtoReflect.Descriptor instead.
 func (*Output) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{4}
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Output) GetOutputRoot() []byte {
@@ -3757,20 +6881,179 @@ func (x *Output) GetL2BlockNumber() uint64 {
 	return 0
 }
 
+func (x *Output) GetVersion() []byte {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// DepositCap defines the deposit limits of a denom for a bridge.
+// Zero values mean the corresponding limit is disabled.
+type DepositCap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The l1 denom of the capped token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The maximum amount which can be locked in the bridge account.
+	MaxTotalLocked string `protobuf:"bytes,2,opt,name=max_total_locked,json=maxTotalLocked,proto3" json:"max_total_locked,omitempty"`
+	// The maximum amount which can be deposited in a single deposit.
+	MaxPerDeposit string `protobuf:"bytes,3,opt,name=max_per_deposit,json=maxPerDeposit,proto3" json:"max_per_deposit,omitempty"`
+}
+
+func (x *DepositCap) Reset() {
+	*x = DepositCap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositCap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositCap) ProtoMessage() {}
+
+// Deprecated: Use DepositCap.ProtoReflect.Descriptor instead.
+func (*DepositCap) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DepositCap) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DepositCap) GetMaxTotalLocked() string {
+	if x != nil {
+		return x.MaxTotalLocked
+	}
+	return ""
+}
+
+func (x *DepositCap) GetMaxPerDeposit() string {
+	if x != nil {
+		return x.MaxPerDeposit
+	}
+	return ""
+}
+
+// BridgeAccounting defines the running totals of a denom which has
+// flowed through a bridge.
+type BridgeAccounting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The l1 denom of the token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The total amount of the l1 native token deposited through
+	// InitiateTokenDeposit.
+	DepositedAmount string `protobuf:"bytes,2,opt,name=deposited_amount,json=depositedAmount,proto3" json:"deposited_amount,omitempty"`
+	// The total amount of the l1 native token withdrawn through
+	// FinalizeTokenWithdrawal.
+	WithdrawnAmount string `protobuf:"bytes,3,opt,name=withdrawn_amount,json=withdrawnAmount,proto3" json:"withdrawn_amount,omitempty"`
+	// The number of deposits.
+	DepositCount uint64 `protobuf:"varint,4,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	// The number of withdrawals.
+	WithdrawalCount uint64 `protobuf:"varint,5,opt,name=withdrawal_count,json=withdrawalCount,proto3" json:"withdrawal_count,omitempty"`
+	// The total amount of the l1 representation of the token originated from
+	// l2 minted through FinalizeTokenWithdrawal.
+	MintedAmount string `protobuf:"bytes,6,opt,name=minted_amount,json=mintedAmount,proto3" json:"minted_amount,omitempty"`
+	// The total amount of the l1 representation of the token originated from
+	// l2 burned through InitiateTokenDeposit.
+	BurnedAmount string `protobuf:"bytes,7,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+}
+
+func (x *BridgeAccounting) Reset() {
+	*x = BridgeAccounting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeAccounting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeAccounting) ProtoMessage() {}
+
+// Deprecated: Use BridgeAccounting.ProtoReflect.Descriptor instead.
+func (*BridgeAccounting) Descriptor() ([]byte, []int) {
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BridgeAccounting) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *BridgeAccounting) GetDepositedAmount() string {
+	if x != nil {
+		return x.DepositedAmount
+	}
+	return ""
+}
+
+func (x *BridgeAccounting) GetWithdrawnAmount() string {
+	if x != nil {
+		return x.WithdrawnAmount
+	}
+	return ""
+}
+
+func (x *BridgeAccounting) GetDepositCount() uint64 {
+	if x != nil {
+		return x.DepositCount
+	}
+	return 0
+}
+
+func (x *BridgeAccounting) GetWithdrawalCount() uint64 {
+	if x != nil {
+		return x.WithdrawalCount
+	}
+	return 0
+}
+
+func (x *BridgeAccounting) GetMintedAmount() string {
+	if x != nil {
+		return x.MintedAmount
+	}
+	return ""
+}
+
+func (x *BridgeAccounting) GetBurnedAmount() string {
+	if x != nil {
+		return x.BurnedAmount
+	}
+	return ""
+}
+
 // BatchInfoWithOutput defines the batch information with output.
 type BatchInfoWithOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchInfo *BatchInfo `protobuf:"bytes,1,opt,name=batchInfo,proto3" json:"batchInfo,omitempty"`
+	BatchInfo *BatchInfo `protobuf:"bytes,1,opt,name=batch_info,json=batchInfo,proto3" json:"batch_info,omitempty"`
 	Output    *Output    `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
 }
 
 func (x *BatchInfoWithOutput) Reset() {
 	*x = BatchInfoWithOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_opinit_ophost_v1_types_proto_msgTypes[5]
+		mi := &file_opinit_ophost_v1_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3784,7 +7067,7 @@ func (*BatchInfoWithOutput) ProtoMessage() {}
 
 // Deprecated: Use BatchInfoWithOutput.ProtoReflect.Descriptor instead.
 func (*BatchInfoWithOutput) Descriptor() ([]byte, []int) {
-	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{5}
+	return file_opinit_ophost_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchInfoWithOutput) GetBatchInfo() *BatchInfo {
@@ -3817,7 +7100,7 @@ var file_opinit_ophost_v1_types_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x7b, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
@@ -3825,86 +7108,157 @@ var file_opinit_ophost_v1_types_proto_rawDesc = []byte{
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x3a, 0x1a, 0x98,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x70, 0x68, 0x6f,
-	0x73, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xba, 0x04, 0x0a, 0x0c, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0xc8,
-	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x7a, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2e, 0xc8, 0xde, 0x1f,
-	0x00, 0xea, 0xde, 0x1f, 0x1d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
-	0x7a, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x6a, 0x0a,
+	0x1c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x1a, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xba, 0x04, 0x0a, 0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x7a, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
 	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
-	0x1d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x98, 0xdf,
-	0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5d, 0x0a, 0x15, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x41, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x31, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x31, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x19, 0x0a, 0x08, 0x6c, 0x32, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6c, 0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xa0, 0x01, 0x0a, 0x06, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4d, 0x0a, 0x0d, 0x6c, 0x31, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
-	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6c, 0x31, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
-	0x6c, 0x32, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x98, 0x01,
-	0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69,
-	0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70,
-	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0xc9, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8,
-	0xe2, 0x1e, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x4f, 0x50, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69,
-	0x74, 0x2f, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x68, 0x6f,
-	0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4f, 0x70, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10,
-	0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x12, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x98, 0xdf,
+	0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x7a, 0x0a, 0x13, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1d, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5d, 0x0a, 0x15, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x13, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x3f, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x22, 0x41, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x31, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x31, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x32, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x32, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x4e, 0x0a, 0x0c, 0x4e, 0x66, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x31, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x31, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x32, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x32, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0x8e,
+	0x01, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0xba, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4d, 0x0a, 0x0d, 0x6c,
+	0x31, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6c,
+	0x31, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x32,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x32, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a,
+	0x0a, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x5a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x58, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xe0, 0x03, 0x0a, 0x10, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x5b, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x5b, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0d,
+	0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x69, 0x6e,
+	0x69, 0x74, 0x2e, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0xc9, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e,
+	0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x6f, 0x70,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x4f, 0x50,
+	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x2f,
+	0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x70, 0x68, 0x6f, 0x73, 0x74,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4f, 0x70, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x4f, 0x70,
+	0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
+	0x4f, 0x70, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x70, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3919,32 +7273,39 @@ func file_opinit_ophost_v1_types_proto_rawDescGZIP() []byte {
 	return file_opinit_ophost_v1_types_proto_rawDescData
 }
 
-var file_opinit_ophost_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_opinit_ophost_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_opinit_ophost_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),                // 0: opinit.ophost.v1.Params
-	(*BridgeConfig)(nil),          // 1: opinit.ophost.v1.BridgeConfig
-	(*BatchInfo)(nil),             // 2: opinit.ophost.v1.BatchInfo
-	(*TokenPair)(nil),             // 3: opinit.ophost.v1.TokenPair
-	(*Output)(nil),                // 4: opinit.ophost.v1.Output
-	(*BatchInfoWithOutput)(nil),   // 5: opinit.ophost.v1.BatchInfoWithOutput
-	(*v1beta1.Coin)(nil),          // 6: cosmos.base.v1beta1.Coin
-	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*Params)(nil),                 // 0: opinit.ophost.v1.Params
+	(*BridgeConfig)(nil),           // 1: opinit.ophost.v1.BridgeConfig
+	(*BatchInfo)(nil),              // 2: opinit.ophost.v1.BatchInfo
+	(*TokenPair)(nil),              // 3: opinit.ophost.v1.TokenPair
+	(*NftClassPair)(nil),           // 4: opinit.ophost.v1.NftClassPair
+	(*ProvenWithdrawalBitmap)(nil), // 5: opinit.ophost.v1.ProvenWithdrawalBitmap
+	(*DepositCommitment)(nil),      // 6: opinit.ophost.v1.DepositCommitment
+	(*Output)(nil),                 // 7: opinit.ophost.v1.Output
+	(*DepositCap)(nil),             // 8: opinit.ophost.v1.DepositCap
+	(*BridgeAccounting)(nil),       // 9: opinit.ophost.v1.BridgeAccounting
+	(*BatchInfoWithOutput)(nil),    // 10: opinit.ophost.v1.BatchInfoWithOutput
+	(*v1beta1.Coin)(nil),           // 11: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),    // 12: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
 }
 var file_opinit_ophost_v1_types_proto_depIdxs = []int32{
-	6, // 0: opinit.ophost.v1.Params.registration_fee:type_name -> cosmos.base.v1beta1.Coin
-	2, // 1: opinit.ophost.v1.BridgeConfig.batch_info:type_name -> opinit.ophost.v1.BatchInfo
-	7, // 2: opinit.ophost.v1.BridgeConfig.submission_interval:type_name -> google.protobuf.Duration
-	7, // 3: opinit.ophost.v1.BridgeConfig.finalization_period:type_name -> google.protobuf.Duration
-	8, // 4: opinit.ophost.v1.BridgeConfig.submission_start_time:type_name -> google.protobuf.Timestamp
-	8, // 5: opinit.ophost.v1.Output.l1_block_time:type_name -> google.protobuf.Timestamp
-	2, // 6: opinit.ophost.v1.BatchInfoWithOutput.batchInfo:type_name -> opinit.ophost.v1.BatchInfo
-	4, // 7: opinit.ophost.v1.BatchInfoWithOutput.output:type_name -> opinit.ophost.v1.Output
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	11, // 0: opinit.ophost.v1.Params.registration_fee:type_name -> cosmos.base.v1beta1.Coin
+	12, // 1: opinit.ophost.v1.Params.deposit_commitment_retention:type_name -> google.protobuf.Duration
+	2,  // 2: opinit.ophost.v1.BridgeConfig.batch_info:type_name -> opinit.ophost.v1.BatchInfo
+	12, // 3: opinit.ophost.v1.BridgeConfig.submission_interval:type_name -> google.protobuf.Duration
+	12, // 4: opinit.ophost.v1.BridgeConfig.finalization_period:type_name -> google.protobuf.Duration
+	13, // 5: opinit.ophost.v1.BridgeConfig.submission_start_time:type_name -> google.protobuf.Timestamp
+	13, // 6: opinit.ophost.v1.DepositCommitment.time:type_name -> google.protobuf.Timestamp
+	13, // 7: opinit.ophost.v1.Output.l1_block_time:type_name -> google.protobuf.Timestamp
+	2,  // 8: opinit.ophost.v1.BatchInfoWithOutput.batch_info:type_name -> opinit.ophost.v1.BatchInfo
+	7,  // 9: opinit.ophost.v1.BatchInfoWithOutput.output:type_name -> opinit.ophost.v1.Output
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_opinit_ophost_v1_types_proto_init() }
@@ -4002,7 +7363,7 @@ func file_opinit_ophost_v1_types_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Output); i {
+			switch v := v.(*NftClassPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4014,6 +7375,66 @@ func file_opinit_ophost_v1_types_proto_init() {
 			}
 		}
 		file_opinit_ophost_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvenWithdrawalBitmap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositCap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeAccounting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_opinit_ophost_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchInfoWithOutput); i {
 			case 0:
 				return &v.state
@@ -4032,7 +7453,7 @@ func file_opinit_ophost_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_opinit_ophost_v1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -84,6 +84,28 @@ type L2Config struct {
 
 	// BridgeID will be generated after the launch.
 	BridgeID uint64 `json:"bridge_id,omitempty"`
+
+	// BridgeRoles scopes the bridge executor permissions; the roles not
+	// assigned to any address are granted to the bridge executor.
+	BridgeRoles *BridgeRoles `json:"bridge_roles,omitempty"`
+}
+
+type BridgeRoles struct {
+	DepositRelayers   []string `json:"deposit_relayers,omitempty"`
+	OracleRelayers    []string `json:"oracle_relayers,omitempty"`
+	BridgeInfoSetters []string `json:"bridge_info_setters,omitempty"`
+}
+
+func (bridgeRoles *BridgeRoles) Finalize() error {
+	for _, addrs := range [][]string{bridgeRoles.DepositRelayers, bridgeRoles.OracleRelayers, bridgeRoles.BridgeInfoSetters} {
+		for _, addr := range addrs {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("invalid bridge role address: %s", addr))
+			}
+		}
+	}
+
+	return nil
 }
 
 func (l2config *L2Config) Finalize() error {
@@ -99,6 +121,13 @@ func (l2config *L2Config) Finalize() error {
 		l2config.Moniker = "operator"
 	}
 
+	if l2config.BridgeRoles == nil {
+		l2config.BridgeRoles = &BridgeRoles{}
+	}
+	if err := l2config.BridgeRoles.Finalize(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
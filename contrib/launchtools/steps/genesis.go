@@ -3,6 +3,7 @@ package steps
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"cosmossdk.io/log"
@@ -194,6 +195,27 @@ func initializeGenesis(
 
 	genesisAppState[opchildtypes.ModuleName] = cdc.MustMarshalJSON(opChildState)
 
+	// Step 4-1 -----------------------------------------------------------------------------------------
+	// Scope the bridge executor permissions in the genesis parameter
+	bridgeRoles := config.L2Config.BridgeRoles
+	log.Info("setting bridge roles",
+		"deposit-relayers", strings.Join(bridgeRoles.DepositRelayers, ","),
+		"oracle-relayers", strings.Join(bridgeRoles.OracleRelayers, ","),
+		"bridge-info-setters", strings.Join(bridgeRoles.BridgeInfoSetters, ","),
+	)
+
+	// the bridge info is set by the bridge executor at launch
+	if len(bridgeRoles.BridgeInfoSetters) != 0 && !slices.Contains(bridgeRoles.BridgeInfoSetters, config.SystemKeys.BridgeExecutor.Address) {
+		return nil, errors.New("bridge info setters must include the bridge executor")
+	}
+
+	opChildState, err = setOpChildBridgeRoles(cdc, genesisAppState, bridgeRoles)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set bridge roles")
+	}
+
+	genesisAppState[opchildtypes.ModuleName] = cdc.MustMarshalJSON(opChildState)
+
 	// Step 5 -------------------------------------------------------------------------------------------
 	// Set admin address in the genesis parameter
 	log.Info("setting admin address",
@@ -345,3 +367,15 @@ func setMultiOpChildBridgeExecutorsAddress(cdc codec.Codec, genesisAppState map[
 
 	return opchildState, nil
 }
+
+func setOpChildBridgeRoles(cdc codec.Codec, genesisAppState map[string]json.RawMessage, bridgeRoles *launchtools.BridgeRoles) (
+	*opchildtypes.GenesisState,
+	error,
+) {
+	opchildState := opchildtypes.GetGenesisStateFromAppState(cdc, genesisAppState)
+	opchildState.Params.DepositRelayers = bridgeRoles.DepositRelayers
+	opchildState.Params.OracleRelayers = bridgeRoles.OracleRelayers
+	opchildState.Params.BridgeInfoSetters = bridgeRoles.BridgeInfoSetters
+
+	return opchildState, nil
+}
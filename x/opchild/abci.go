@@ -10,14 +10,20 @@ import (
 
 	"github.com/initia-labs/OPinit/x/opchild/keeper"
 	"github.com/initia-labs/OPinit/x/opchild/types"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
 // and prune the oldest entry based on the HistoricalEntries parameter
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	if err := k.RecordWithdrawalStateRoot(ctx); err != nil {
+		return err
+	}
+
+	if err := k.HandleValidatorSignatures(ctx); err != nil {
+		return err
+	}
+
 	return k.TrackHistoricalInfo(ctx)
 }
 
@@ -25,14 +31,20 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	height := sdkCtx.BlockHeight()
+	if err := k.ApplyExecutorChangePlan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.CommitWithdrawals(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.PruneWithdrawals(ctx); err != nil {
+		return nil, err
+	}
 
-	if plan, found := k.ExecutorChangePlans[uint64(height)]; found {
-		err := k.ChangeExecutor(ctx, plan)
-		if err != nil {
-			return nil, err
-		}
+	if err := k.PruneExpiredAdminProposals(ctx); err != nil {
+		return nil, err
 	}
 
 	return k.BlockValidatorUpdates(ctx)
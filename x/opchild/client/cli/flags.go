@@ -7,4 +7,9 @@ const (
 	FlagIP      = "ip"
 	FlagP2PPort = "p2p-port"
 	FlagHookMsg = "hook-msg"
+
+	FlagProof       = "proof"
+	FlagProofHeight = "proof-height"
+
+	FlagExecutionHeight = "execution-height"
 )
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -28,6 +29,20 @@ func GetQueryCmd(vc address.Codec) *cobra.Command {
 		GetCmdQueryValidator(vc),
 		GetCmdQueryValidators(),
 		GetCmdQueryParams(),
+		GetCmdQueryWithdrawalCommitment(),
+		GetCmdQueryWithdrawalProof(),
+		GetCmdQueryFinalizeWithdrawalMsg(),
+		GetCmdQueryDepositStatus(),
+		GetCmdQueryDepositAttestations(),
+		GetCmdQueryExecutorChangePlan(),
+		GetCmdQueryExecutorChangePlans(),
+		GetCmdQueryScheduledMessages(),
+		GetCmdQueryScheduledMessagesList(),
+		GetCmdQueryPendingAdmin(),
+		GetCmdQueryAdminProposal(),
+		GetCmdQueryAdminProposals(),
+		GetCmdQuerySigningInfo(),
+		GetCmdQuerySigningInfos(),
 	)
 
 	return opchildQueryCmd
@@ -156,3 +171,561 @@ $ %s query opchild params
 
 	return cmd
 }
+
+// GetCmdQueryWithdrawalCommitment implements the withdrawal commitment query command.
+func GetCmdQueryWithdrawalCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-commitment [height]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the withdrawal tree root at the height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the withdrawal tree root of the output window at the height.
+The latest height is used if the height is not given.
+
+Example:
+$ %s query opchild withdrawal-commitment 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var height int64
+			if len(args) == 1 {
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.WithdrawalCommitment(cmd.Context(), &types.QueryWithdrawalCommitmentRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Commitment)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryWithdrawalProof implements the withdrawal proof query command.
+func GetCmdQueryWithdrawalProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-proof [sequence]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the merkle proof of a withdrawal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the withdrawal leaf and its merkle proof to the withdrawal tree root.
+
+Example:
+$ %s query opchild withdrawal-proof 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WithdrawalProof(cmd.Context(), &types.QueryWithdrawalProofRequest{Sequence: sequence})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFinalizeWithdrawalMsg implements the command to build the l1
+// message finalizing a withdrawal.
+func GetCmdQueryFinalizeWithdrawalMsg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-withdrawal-msg [sequence] [output-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Build the l1 message finalizing a withdrawal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build the MsgFinalizeTokenWithdrawal (or MsgFinalizeNftWithdrawal) of the
+withdrawal with its merkle proof and output root preimage. The output index is
+the index of the l1 output proposed with the withdrawal tree root.
+
+The printed message can be signed by the receiver on l1.
+
+Example:
+$ %s query opchild finalize-withdrawal-msg 1 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			outputIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WithdrawalProof(cmd.Context(), &types.QueryWithdrawalProofRequest{Sequence: sequence})
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgFinalizeWithdrawal(res, outputIndex)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDepositStatus implements the deposit status query command.
+func GetCmdQueryDepositStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-status",
+		Args:  cobra.NoArgs,
+		Short: "Query the finalization status of the deposits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the highest contiguous finalized l1 sequence, the missing l1 sequence
+ranges and the buffered l1 sequences.
+
+Example:
+$ %s query opchild deposit-status
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositStatus(cmd.Context(), &types.QueryDepositStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDepositAttestations implements the pending deposit attestations query command.
+func GetCmdQueryDepositAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-attestations",
+		Args:  cobra.NoArgs,
+		Short: "Query the deposits waiting for the attestations of the bridge executors",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposits waiting for the attestations of the bridge executors,
+grouped by the deposit contents submitted.
+
+Example:
+$ %s query opchild deposit-attestations
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DepositAttestations(cmd.Context(), &types.QueryDepositAttestationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposit attestations")
+
+	return cmd
+}
+
+// GetCmdQueryExecutorChangePlan implements the executor change plan query command.
+func GetCmdQueryExecutorChangePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executor-change-plan [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the executor change plan at the height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator and the bridge executors to be applied at the height.
+
+Example:
+$ %s query opchild executor-change-plan 1000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutorChangePlan(cmd.Context(), &types.QueryExecutorChangePlanRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExecutorChangePlans implements the executor change plans query command.
+func GetCmdQueryExecutorChangePlans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executor-change-plans",
+		Args:  cobra.NoArgs,
+		Short: "Query all the executor change plans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the executor change plans not applied yet.
+
+Example:
+$ %s query opchild executor-change-plans
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutorChangePlans(cmd.Context(), &types.QueryExecutorChangePlansRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executor change plans")
+
+	return cmd
+}
+
+// GetCmdQueryScheduledMessages implements the scheduled messages query command.
+func GetCmdQueryScheduledMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-messages [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the scheduled messages of the id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the admin messages waiting for the execution height.
+
+Example:
+$ %s query opchild scheduled-messages 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledMessages(cmd.Context(), &types.QueryScheduledMessagesRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledMessagesList implements the scheduled messages list query command.
+func GetCmdQueryScheduledMessagesList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-messages-list",
+		Args:  cobra.NoArgs,
+		Short: "Query all the scheduled messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the admin messages waiting for the execution.
+
+Example:
+$ %s query opchild scheduled-messages-list
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledMessagesList(cmd.Context(), &types.QueryScheduledMessagesListRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled messages")
+
+	return cmd
+}
+
+// GetCmdQueryPendingAdmin implements the pending admin query command.
+func GetCmdQueryPendingAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-admin",
+		Args:  cobra.NoArgs,
+		Short: "Query the address proposed to be the next admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingAdmin(cmd.Context(), &types.QueryPendingAdminRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAdminProposal implements the admin proposal query command.
+func GetCmdQueryAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-proposal [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the admin proposal of the id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the admin messages waiting for the approvals of the admin signers.
+
+Example:
+$ %s query opchild admin-proposal 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AdminProposal(cmd.Context(), &types.QueryAdminProposalRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAdminProposals implements the admin proposals query command.
+func GetCmdQueryAdminProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-proposals",
+		Args:  cobra.NoArgs,
+		Short: "Query all the admin proposals waiting for the approvals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AdminProposals(cmd.Context(), &types.QueryAdminProposalsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "admin proposals")
+
+	return cmd
+}
+
+// GetCmdQuerySigningInfo implements the signing info query command.
+func GetCmdQuerySigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info [cons-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liveness information of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the blocks missed by a validator in the signed blocks window.
+
+Example:
+$ %s query opchild signing-info initvalcons1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfo(cmd.Context(), &types.QuerySigningInfoRequest{ConsAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySigningInfos implements the signing infos query command.
+func GetCmdQuerySigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Args:  cobra.NoArgs,
+		Short: "Query the liveness information of all the validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningInfos(cmd.Context(), &types.QuerySigningInfosRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signing infos")
+
+	return cmd
+}
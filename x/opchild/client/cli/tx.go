@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -35,8 +36,14 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 
 	opchildTxCmd.AddCommand(
 		NewExecuteMessagesCmd(ac),
+		NewExecuteScheduledMessagesCmd(ac),
+		NewCancelScheduledMessagesCmd(ac),
+		NewApproveAdminProposalCmd(ac),
+		NewProposeAdminCmd(ac),
+		NewAcceptAdminCmd(ac),
 		NewDepositCmd(ac),
 		NewWithdrawCmd(ac),
+		NewWithdrawNftCmd(ac),
 		NewSetBridgeInfoCmd(ac),
 		NewUpdateOracleCmd(ac),
 	)
@@ -92,10 +99,24 @@ func NewDepositCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			proofHex, err := cmd.Flags().GetString(FlagProof)
+			if err != nil {
+				return err
+			}
+			proof, err := hex.DecodeString(proofHex)
+			if err != nil {
+				return err
+			}
+
+			proofHeight, err := cmd.Flags().GetUint64(FlagProofHeight)
+			if err != nil {
+				return err
+			}
+
 			txf, msg, err := newBuildDepositMsg(
 				clientCtx, ac, txf, sequence, height,
 				from, to, amount, baseDenom,
-				[]byte(hookMsg),
+				[]byte(hookMsg), proofHeight, proof,
 			)
 			if err != nil {
 				return err
@@ -106,6 +127,8 @@ func NewDepositCmd(ac address.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(FlagHookMsg, "", "Hook message passed from the upper layer")
+	cmd.Flags().String(FlagProof, "", "Hex encoded merkle proof of the deposit commitment in the l1 ophost store")
+	cmd.Flags().Uint64(FlagProofHeight, 0, "L1 height of the deposit proof")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -151,6 +174,41 @@ func NewWithdrawCmd(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewWithdrawNftCmd returns a CLI command handler for the transaction withdrawing a wrapped nft to L1.
+func NewWithdrawNftCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-nft [to_l1] [class_id] [token_id]",
+		Short: "withdraw a nft from L2 to L1",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInitiateNftWithdrawal(fromAddr, args[0], args[1], args[2])
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpdateOracleCmd returns a CLI command handler for the transaction updating oracle data.
 func NewUpdateOracleCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -218,6 +276,9 @@ Where proposal.json contains:
     }
   ],
 }
+
+The messages are queued instead of executed immediately when the
+execute_messages_delay param is set or the --execution-height flag is given.
 `,
 				version.AppName,
 			),
@@ -244,6 +305,182 @@ Where proposal.json contains:
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			msg.ExecutionHeight, err = cmd.Flags().GetUint64(FlagExecutionHeight)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagExecutionHeight, 0, "L2 height to execute the messages; zero to follow the execute_messages_delay param")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewExecuteScheduledMessagesCmd returns a CLI command handler for transaction to executing the scheduled messages.
+func NewExecuteScheduledMessagesCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-scheduled-messages [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "execute the scheduled messages after the execution height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteScheduledMessages(fromAddr, id)
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelScheduledMessagesCmd returns a CLI command handler for transaction to cancelling the scheduled messages.
+func NewCancelScheduledMessagesCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-messages [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "cancel the scheduled messages",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScheduledMessages(fromAddr, id)
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewApproveAdminProposalCmd returns a CLI command handler for transaction to approving an admin proposal.
+func NewApproveAdminProposalCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-admin-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "approve the admin proposal as an admin signer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveAdminProposal(fromAddr, proposalId)
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProposeAdminCmd returns a CLI command handler for transaction to proposing the next admin.
+func NewProposeAdminCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [new-admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "propose the next admin, who has to accept the admin role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeAdmin(fromAddr, args[0])
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAcceptAdminCmd returns a CLI command handler for transaction to accepting the admin role.
+func NewAcceptAdminCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin",
+		Args:  cobra.NoArgs,
+		Short: "accept the admin role as the proposed admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptAdmin(fromAddr)
 			if err := msg.Validate(ac); err != nil {
 				return err
 			}
@@ -341,6 +578,20 @@ func NewSetBridgeInfoCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			proofHex, err := cmd.Flags().GetString(FlagProof)
+			if err != nil {
+				return err
+			}
+			proof, err := hex.DecodeString(proofHex)
+			if err != nil {
+				return err
+			}
+
+			proofHeight, err := cmd.Flags().GetUint64(FlagProofHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetBridgeInfo(fromAddr, types.BridgeInfo{
 				BridgeId:     bridgeId,
 				BridgeAddr:   bridgeAddr,
@@ -348,6 +599,8 @@ func NewSetBridgeInfoCmd(ac address.Codec) *cobra.Command {
 				L1ClientId:   l1ClientId,
 				BridgeConfig: bridgeConfig,
 			})
+			msg.ProofHeight = proofHeight
+			msg.Proof = proof
 			if err = msg.Validate(ac); err != nil {
 				return err
 			}
@@ -356,6 +609,8 @@ func NewSetBridgeInfoCmd(ac address.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagProof, "", "Hex encoded merkle proof of the bridge config in the l1 ophost store")
+	cmd.Flags().Uint64(FlagProofHeight, 0, "L1 height of the bridge config proof")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -391,6 +646,8 @@ func newBuildDepositMsg(
 	amount sdk.Coin,
 	baseDenom string,
 	hookMsg []byte,
+	proofHeight uint64,
+	proof []byte,
 ) (tx.Factory, *types.MsgFinalizeTokenDeposit, error) {
 	sender := clientCtx.GetFromAddress()
 	senderAddr, err := ac.BytesToString(sender)
@@ -409,6 +666,8 @@ func newBuildDepositMsg(
 	}
 
 	msg := types.NewMsgFinalizeTokenDeposit(senderAddr, fromAddr, toAddr, amount, sequence, height, baseDenom, hookMsg)
+	msg.ProofHeight = proofHeight
+	msg.Proof = proof
 	if err := msg.Validate(ac); err != nil {
 		return txf, nil, err
 	}
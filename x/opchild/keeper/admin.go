@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// GetPendingAdmin returns the address proposed to be the next admin; empty
+// when no admin transfer is proposed.
+func (k Keeper) GetPendingAdmin(ctx context.Context) (string, error) {
+	pendingAdmin, err := k.PendingAdmin.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+
+	return pendingAdmin, err
+}
+
+// AcceptAdmin hands over the admin role to the pending admin and returns the
+// previous admin.
+func (k Keeper) AcceptAdmin(ctx context.Context, sender string) (string, error) {
+	pendingAdmin, err := k.GetPendingAdmin(ctx)
+	if err != nil {
+		return "", err
+	} else if pendingAdmin == "" {
+		return "", types.ErrNoPendingAdmin
+	} else if pendingAdmin != sender {
+		return "", sdkerrors.ErrUnauthorized.Wrapf("expected pending admin %s, got %s", pendingAdmin, sender)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	prevAdmin := params.Admin
+	params.Admin = sender
+	if err := params.Validate(k.addressCodec); err != nil {
+		return "", err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return "", err
+	}
+
+	if err := k.PendingAdmin.Remove(ctx); err != nil {
+		return "", err
+	}
+
+	return prevAdmin, nil
+}
+
+// SetNextAdminProposalId sets the id of the next admin proposal.
+func (k Keeper) SetNextAdminProposalId(ctx context.Context, nextId uint64) error {
+	return k.NextAdminProposalId.Set(ctx, nextId)
+}
+
+// GetNextAdminProposalId returns the id of the next admin proposal.
+func (k Keeper) GetNextAdminProposalId(ctx context.Context) (uint64, error) {
+	nextId, err := k.NextAdminProposalId.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if nextId == collections.DefaultSequenceStart {
+		return types.DefaultAdminProposalIdStart, nil
+	}
+
+	return nextId, nil
+}
+
+// IncreaseNextAdminProposalId returns the current id and increases it.
+func (k Keeper) IncreaseNextAdminProposalId(ctx context.Context) (uint64, error) {
+	nextId, err := k.NextAdminProposalId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if nextId == collections.DefaultSequenceStart {
+		if err := k.NextAdminProposalId.Set(ctx, types.DefaultAdminProposalIdStart+1); err != nil {
+			return 0, err
+		}
+
+		return types.DefaultAdminProposalIdStart, nil
+	}
+
+	return nextId, nil
+}
+
+// SubmitAdminProposal validates the admin messages and stores them with the
+// approval of the proposer.
+func (k Keeper) SubmitAdminProposal(ctx context.Context, proposer string, executionHeight uint64, messages []*codectypes.Any) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	proposal := types.AdminProposal{
+		Proposer:         proposer,
+		ExecutionHeight:  executionHeight,
+		Messages:         messages,
+		Approvals:        []string{proposer},
+		ExpirationHeight: height + params.AdminProposalExpiry,
+	}
+
+	// reject the messages which can never be executed
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return 0, err
+	}
+	for _, msg := range msgs {
+		if err := k.validateExecuteMessage(params, msg); err != nil {
+			return 0, err
+		}
+	}
+
+	proposal.Id, err = k.IncreaseNextAdminProposalId(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.AdminProposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return 0, err
+	}
+
+	return proposal.Id, nil
+}
+
+// GetAdminProposal returns the admin proposal of the id.
+func (k Keeper) GetAdminProposal(ctx context.Context, id uint64) (types.AdminProposal, error) {
+	return k.AdminProposals.Get(ctx, id)
+}
+
+// IterateAdminProposals iterates the admin proposals in the id order.
+func (k Keeper) IterateAdminProposals(ctx context.Context, cb func(proposal types.AdminProposal) (stop bool, err error)) error {
+	return k.AdminProposals.Walk(ctx, nil, func(_ uint64, proposal types.AdminProposal) (stop bool, err error) {
+		return cb(proposal)
+	})
+}
+
+// ApproveAdminProposal records the approval of the signer and returns true
+// if the approvals of the current admin signers reached the threshold, in
+// which case the proposal is removed to be executed by the caller.
+func (k Keeper) ApproveAdminProposal(ctx context.Context, signer string, id uint64) (types.AdminProposal, bool, error) {
+	proposal, err := k.AdminProposals.Get(ctx, id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.AdminProposal{}, false, types.ErrAdminProposalNotFound
+	} else if err != nil {
+		return types.AdminProposal{}, false, err
+	}
+
+	if height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()); proposal.IsExpired(height) {
+		return types.AdminProposal{}, false, errorsmod.Wrapf(types.ErrAdminProposalExpired, "expired at height %d", proposal.ExpirationHeight)
+	}
+
+	if slices.Contains(proposal.Approvals, signer) {
+		return types.AdminProposal{}, false, types.ErrAdminProposalAlreadyApproved
+	}
+	proposal.Approvals = append(proposal.Approvals, signer)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.AdminProposal{}, false, err
+	}
+
+	// the approvals of the removed signers are not counted
+	approvals := 0
+	for _, approval := range proposal.Approvals {
+		if params.IsAdminSigner(approval) {
+			approvals++
+		}
+	}
+
+	if approvals < int(params.AdminThreshold) {
+		return proposal, false, k.AdminProposals.Set(ctx, id, proposal)
+	}
+
+	return proposal, true, k.AdminProposals.Remove(ctx, id)
+}
+
+// PruneExpiredAdminProposals removes the admin proposals which did not get
+// enough approvals before the expiration height.
+// Called in each EndBlock
+func (k Keeper) PruneExpiredAdminProposals(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	var expired []uint64
+	if err := k.IterateAdminProposals(ctx, func(proposal types.AdminProposal) (stop bool, err error) {
+		if proposal.IsExpired(height) {
+			expired = append(expired, proposal.Id)
+		}
+
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		if err := k.AdminProposals.Remove(ctx, id); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpireAdminProposal,
+			sdk.NewAttribute(types.AttributeKeyAdminProposal, strconv.FormatUint(id, 10)),
+		))
+	}
+
+	return nil
+}
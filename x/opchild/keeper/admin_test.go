@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_MsgServer_AdminTransfer(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.Admin = addrsStr[0]
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	// no pending admin
+	_, err = ms.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrsStr[1]))
+	require.ErrorIs(t, err, types.ErrNoPendingAdmin)
+
+	// only the admin can propose
+	_, err = ms.ProposeAdmin(ctx, types.NewMsgProposeAdmin(addrsStr[1], addrsStr[1]))
+	require.Error(t, err)
+	_, err = ms.ProposeAdmin(ctx, types.NewMsgProposeAdmin(addrsStr[0], addrsStr[1]))
+	require.NoError(t, err)
+
+	res, err := querier.PendingAdmin(ctx, &types.QueryPendingAdminRequest{})
+	require.NoError(t, err)
+	require.Equal(t, addrsStr[1], res.PendingAdmin)
+
+	genState := input.OPChildKeeper.ExportGenesis(ctx)
+	require.Equal(t, addrsStr[1], genState.PendingAdmin)
+
+	// only the pending admin can accept
+	_, err = ms.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrsStr[2]))
+	require.Error(t, err)
+	_, err = ms.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrsStr[1]))
+	require.NoError(t, err)
+
+	params, err = ms.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addrsStr[1], params.Admin)
+
+	res, err = querier.PendingAdmin(ctx, &types.QueryPendingAdminRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.PendingAdmin)
+}
+
+func Test_MsgServer_AdminProposal(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	// 2 of 3 admin signers
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.Admin = addrsStr[0]
+	params.AdminSigners = []string{addrsStr[1], addrsStr[2]}
+	params.AdminThreshold = 2
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	addMsg, err := types.NewMsgAddValidator("val1", moduleAddr, valAddrsStr[0], valPubKeys[0])
+	require.NoError(t, err)
+
+	// not an admin signer
+	msg, err := types.NewMsgExecuteMessages(addrsStr[3], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+	_, err = ms.ExecuteMessages(ctx, msg)
+	require.Error(t, err)
+
+	// an admin signer submits the proposal
+	msg, err = types.NewMsgExecuteMessages(addrsStr[1], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+	res, err := ms.ExecuteMessages(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.AdminProposalId)
+	require.Empty(t, res.Results)
+
+	vals, err := input.OPChildKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Empty(t, vals)
+
+	proposalRes, err := querier.AdminProposal(ctx, &types.QueryAdminProposalRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{addrsStr[1]}, proposalRes.Proposal.Approvals)
+
+	genState := input.OPChildKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.AdminProposals, 1)
+	require.Equal(t, uint64(2), genState.NextAdminProposalId)
+	require.NoError(t, types.ValidateGenesis(genState, input.AccountKeeper.AddressCodec()))
+
+	// duplicate approval
+	_, err = ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[1], 1))
+	require.ErrorIs(t, err, types.ErrAdminProposalAlreadyApproved)
+
+	// not an admin signer
+	_, err = ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[3], 1))
+	require.Error(t, err)
+
+	// the second approval executes the messages
+	approveRes, err := ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[0], 1))
+	require.NoError(t, err)
+	require.True(t, approveRes.Passed)
+	require.Len(t, approveRes.Results, 1)
+
+	vals, err = input.OPChildKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+
+	proposalsRes, err := querier.AdminProposals(ctx, &types.QueryAdminProposalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, proposalsRes.Proposals)
+
+	_, err = ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[2], 1))
+	require.ErrorIs(t, err, types.ErrAdminProposalNotFound)
+
+	// the threshold cannot exceed the number of the signers
+	params.AdminThreshold = 4
+	require.ErrorIs(t, params.Validate(input.AccountKeeper.AddressCodec()), types.ErrInvalidAdminThreshold)
+}
+
+func Test_MsgServer_AdminTransfer_Threshold(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	// 2 of 3 admin signers with the timelock and a restricted allowlist
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.Admin = addrsStr[0]
+	params.AdminSigners = []string{addrsStr[1], addrsStr[2]}
+	params.AdminThreshold = 2
+	params.ExecuteMessagesDelay = 100
+	params.AllowedExecuteMessages = []string{sdk.MsgTypeURL(&types.MsgAddValidator{})}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	// the admin transfer must be allowed
+	_, err = ms.ProposeAdmin(ctx, types.NewMsgProposeAdmin(addrsStr[0], addrsStr[3]))
+	require.ErrorIs(t, err, types.ErrExecuteMsgNotAllowed)
+
+	params.AllowedExecuteMessages = append(params.AllowedExecuteMessages, sdk.MsgTypeURL(&types.MsgProposeAdmin{}))
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	// not an admin signer
+	_, err = ms.ProposeAdmin(ctx, types.NewMsgProposeAdmin(addrsStr[3], addrsStr[3]))
+	require.Error(t, err)
+
+	// a single signer only submits the proposal
+	res, err := ms.ProposeAdmin(ctx, types.NewMsgProposeAdmin(addrsStr[0], addrsStr[3]))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.AdminProposalId)
+
+	pendingAdmin, err := input.OPChildKeeper.GetPendingAdmin(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingAdmin)
+
+	// the approval schedules the admin transfer behind the timelock
+	approveRes, err := ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[2], 1))
+	require.NoError(t, err)
+	require.True(t, approveRes.Passed)
+	require.NotZero(t, approveRes.ScheduledId)
+
+	pendingAdmin, err = input.OPChildKeeper.GetPendingAdmin(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingAdmin)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ctx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 100)
+	_, err = ms.ExecuteScheduledMessages(ctx, types.NewMsgExecuteScheduledMessages(addrsStr[3], approveRes.ScheduledId))
+	require.NoError(t, err)
+
+	pendingAdmin, err = input.OPChildKeeper.GetPendingAdmin(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addrsStr[3], pendingAdmin)
+
+	_, err = ms.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrsStr[3]))
+	require.NoError(t, err)
+
+	params, err = ms.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, addrsStr[3], params.Admin)
+}
+
+func Test_AdminProposal_Expiry(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	params, err := ms.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.Admin = addrsStr[0]
+	params.AdminSigners = []string{addrsStr[1], addrsStr[2]}
+	params.AdminThreshold = 2
+	params.AdminProposalExpiry = 10
+	require.NoError(t, ms.SetParams(sdkCtx, params))
+
+	res, err := ms.ProposeAdmin(sdkCtx, types.NewMsgProposeAdmin(addrsStr[0], addrsStr[3]))
+	require.NoError(t, err)
+
+	proposal, err := input.OPChildKeeper.GetAdminProposal(sdkCtx, res.AdminProposalId)
+	require.NoError(t, err)
+	require.Equal(t, uint64(sdkCtx.BlockHeight())+10, proposal.ExpirationHeight)
+
+	// kept until the expiration height
+	sdkCtx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 9)
+	require.NoError(t, input.OPChildKeeper.PruneExpiredAdminProposals(sdkCtx))
+	_, err = input.OPChildKeeper.GetAdminProposal(sdkCtx, res.AdminProposalId)
+	require.NoError(t, err)
+
+	// cannot be approved once expired
+	sdkCtx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 1)
+	_, err = ms.ApproveAdminProposal(sdkCtx, types.NewMsgApproveAdminProposal(addrsStr[1], res.AdminProposalId))
+	require.ErrorIs(t, err, types.ErrAdminProposalExpired)
+
+	require.NoError(t, input.OPChildKeeper.PruneExpiredAdminProposals(sdkCtx))
+	_, err = input.OPChildKeeper.GetAdminProposal(sdkCtx, res.AdminProposalId)
+	require.Error(t, err)
+
+	// the expiry must be positive
+	params.AdminProposalExpiry = 0
+	require.ErrorIs(t, params.Validate(input.AccountKeeper.AddressCodec()), types.ErrInvalidAdminThreshold)
+}
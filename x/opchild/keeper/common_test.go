@@ -18,6 +18,8 @@ import (
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/tx/signing"
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -203,6 +205,7 @@ func (f *TestFaucet) NewFundedAccount(ctx context.Context, amounts ...sdk.Coin)
 type TestKeepers struct {
 	AccountKeeper  authkeeper.AccountKeeper
 	BankKeeper     bankkeeper.Keeper
+	NftKeeper      nftkeeper.Keeper
 	OPChildKeeper  opchildkeeper.Keeper
 	OracleKeeper   *oraclekeeper.Keeper
 	BridgeHook     *bridgeHook
@@ -242,7 +245,7 @@ func _createTestInput(
 	db dbm.DB,
 ) (context.Context, TestKeepers) {
 	keys := storetypes.NewKVStoreKeys(
-		authtypes.StoreKey, banktypes.StoreKey, opchildtypes.StoreKey, oracletypes.StoreKey,
+		authtypes.StoreKey, banktypes.StoreKey, nft.StoreKey, opchildtypes.StoreKey, oracletypes.StoreKey,
 	)
 	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	for _, v := range keys {
@@ -269,6 +272,7 @@ func _createTestInput(
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		opchildtypes.ModuleName:        {authtypes.Burner, authtypes.Minter},
+		nft.ModuleName:                 nil,
 
 		// for testing
 		authtypes.Minter: {authtypes.Minter, authtypes.Burner},
@@ -297,6 +301,13 @@ func _createTestInput(
 	)
 	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
 
+	nftKeeper := nftkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[nft.StoreKey]),
+		appCodec,
+		accountKeeper,
+		bankKeeper,
+	)
+
 	msgRouter := baseapp.NewMsgServiceRouter()
 	msgRouter.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
 
@@ -313,6 +324,7 @@ func _createTestInput(
 		runtime.NewKVStoreService(keys[opchildtypes.StoreKey]),
 		accountKeeper,
 		bankKeeper,
+		nftKeeper,
 		bridgeHook.Hook,
 		&oracleKeeper,
 		msgRouter,
@@ -336,6 +348,7 @@ func _createTestInput(
 	keepers := TestKeepers{
 		AccountKeeper:  accountKeeper,
 		BankKeeper:     bankKeeper,
+		NftKeeper:      nftKeeper,
 		OPChildKeeper:  *opchildKeeper,
 		OracleKeeper:   &oracleKeeper,
 		BridgeHook:     bridgeHook,
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// attestDeposit records the deposit contents submitted by the deposit relayer
+// and returns true if the identical contents are submitted by the threshold
+// number of the deposit relayers. It always returns true if the threshold
+// mode is disabled.
+func (k Keeper) attestDeposit(ctx context.Context, l1Sequence uint64, sender string, depositHash []byte) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	threshold := params.DepositAttestationThreshold
+	if threshold <= 1 {
+		return true, nil
+	}
+
+	attestation, err := k.DepositAttestations.Get(ctx, l1Sequence)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		attestation = types.DepositAttestation{Sequence: l1Sequence}
+	} else if err != nil {
+		return false, err
+	}
+
+	voteIndex := -1
+	for i, vote := range attestation.Votes {
+		for _, attester := range vote.Attesters {
+			if attester == sender {
+				return false, types.ErrDepositAlreadyAttested
+			}
+		}
+
+		if bytes.Equal(vote.DepositHash, depositHash) {
+			voteIndex = i
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if voteIndex == -1 {
+		// the executors disagree on the deposit contents
+		if len(attestation.Votes) > 0 {
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeAttestationMismatch,
+				sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(l1Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeySender, sender),
+				sdk.NewAttribute(types.AttributeKeyDepositHash, hex.EncodeToString(depositHash)),
+			))
+		}
+
+		attestation.Votes = append(attestation.Votes, types.DepositAttestationVote{DepositHash: depositHash})
+		voteIndex = len(attestation.Votes) - 1
+	}
+
+	vote := &attestation.Votes[voteIndex]
+	vote.Attesters = append(vote.Attesters, sender)
+
+	// only the attestations of the current deposit relayers are counted
+	attestations, err := k.countExecutorAttestations(ctx, vote.Attesters)
+	if err != nil {
+		return false, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAttestDeposit,
+		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(l1Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyDepositHash, hex.EncodeToString(depositHash)),
+		sdk.NewAttribute(types.AttributeKeyAttestations, strconv.FormatUint(uint64(attestations), 10)),
+	))
+
+	if attestations >= threshold {
+		return true, k.DepositAttestations.Remove(ctx, l1Sequence)
+	}
+
+	return false, k.DepositAttestations.Set(ctx, l1Sequence, attestation)
+}
+
+func (k Keeper) countExecutorAttestations(ctx context.Context, attesters []string) (uint32, error) {
+	depositRelayers, err := k.DepositRelayers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := uint32(0)
+	for _, attester := range attesters {
+		attesterAddr, err := k.authKeeper.AddressCodec().StringToBytes(attester)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, depositRelayer := range depositRelayers {
+			if bytes.Equal(depositRelayer, attesterAddr) {
+				count++
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
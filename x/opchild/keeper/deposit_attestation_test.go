@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_DepositAttestation(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ctx := sdk.UnwrapSDKContext(_ctx)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{addrsStr[0], addrsStr[1], addrsStr[2]}
+	params.DepositAttestationThreshold = 2
+	require.NoError(t, params.Validate(input.AccountKeeper.AddressCodec()))
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	deposit := func(sender string, amount int64) error {
+		msg := types.NewMsgFinalizeTokenDeposit(sender, addrsStr[3], addrsStr[4], sdk.NewCoin(denom, math.NewInt(amount)), 1, 1, "test_token", nil)
+		_, err := ms.FinalizeTokenDeposit(ctx, msg)
+		return err
+	}
+
+	// a single executor cannot finalize the deposit
+	require.NoError(t, deposit(addrsStr[0], 100))
+	require.True(t, input.BankKeeper.GetBalance(ctx, addrs[4], denom).IsZero())
+	require.ErrorIs(t, deposit(addrsStr[0], 100), types.ErrDepositAlreadyAttested)
+
+	// the mismatched deposit contents are not counted together
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, deposit(addrsStr[1], 1_000_000))
+	require.True(t, input.BankKeeper.GetBalance(ctx, addrs[4], denom).IsZero())
+
+	mismatched := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAttestationMismatch {
+			mismatched = true
+		}
+	}
+	require.True(t, mismatched)
+
+	res, err := querier.DepositAttestations(ctx, &types.QueryDepositAttestationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 1)
+	require.Equal(t, uint64(1), res.Attestations[0].Sequence)
+	require.Len(t, res.Attestations[0].Votes, 2)
+	require.Equal(t, []string{addrsStr[0]}, res.Attestations[0].Votes[0].Attesters)
+	require.Equal(t, []string{addrsStr[1]}, res.Attestations[0].Votes[1].Attesters)
+
+	// the deposit is finalized with the threshold of the identical contents
+	require.NoError(t, deposit(addrsStr[2], 100))
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, addrs[4], denom).Amount)
+	require.ErrorIs(t, deposit(addrsStr[1], 100), types.ErrDepositAlreadyFinalized)
+
+	res, err = querier.DepositAttestations(ctx, &types.QueryDepositAttestationsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Attestations)
+
+	// non executors cannot attest
+	require.Error(t, deposit(addrsStr[3], 100))
+}
+
+func Test_DepositAttestationThreshold_Validate(t *testing.T) {
+	_, input := createDefaultTestInput(t)
+
+	params := types.DefaultParams()
+	params.Admin = addrsStr[0]
+	params.BridgeExecutors = []string{addrsStr[0], addrsStr[1]}
+	params.DepositAttestationThreshold = 2
+	require.NoError(t, params.Validate(input.AccountKeeper.AddressCodec()))
+
+	params.DepositAttestationThreshold = 3
+	require.ErrorIs(t, params.Validate(input.AccountKeeper.AddressCodec()), types.ErrInvalidAttestationThreshold)
+}
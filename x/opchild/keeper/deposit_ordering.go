@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// shouldBufferDeposit returns true if the deposit of the l1 sequence has to be
+// buffered until the missing deposits are finalized. The l1 sequence must not
+// be finalized yet.
+func (k Keeper) shouldBufferDeposit(ctx context.Context, l1Sequence uint64) (bool, error) {
+	nextL1Sequence, err := k.GetNextL1Sequence(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// the buffered deposit can be submitted again once it is the next one,
+	// in case it failed to be finalized with the missing deposits.
+	if l1Sequence == nextL1Sequence {
+		return false, nil
+	}
+
+	if ok, err := k.BufferedDeposits.Has(ctx, l1Sequence); err != nil {
+		return false, err
+	} else if ok {
+		return false, types.ErrDepositAlreadyBuffered
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.StrictDepositOrdering && l1Sequence > nextL1Sequence, nil
+}
+
+// bufferDeposit stores the deposit arrived out of order.
+func (k Keeper) bufferDeposit(ctx context.Context, l1Sequence uint64, msg sdk.Msg) error {
+	if err := k.BufferedDeposits.Set(ctx, l1Sequence, msg); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBufferDeposit,
+		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(l1Sequence, 10)),
+	))
+
+	return nil
+}
+
+// GetDepositStatus returns the highest contiguous finalized l1 sequence, the
+// missing l1 sequence ranges below the highest known l1 sequence and the
+// buffered l1 sequences.
+func (k Keeper) GetDepositStatus(ctx context.Context) (uint64, []types.SequenceRange, []uint64, error) {
+	nextL1Sequence, err := k.GetNextL1Sequence(ctx)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	// the known l1 sequences above the contiguous ones
+	known := []uint64{}
+	ranger := new(collections.Range[uint64]).StartInclusive(nextL1Sequence)
+	if err := k.FinalizedL1Sequence.Walk(ctx, ranger, func(l1Sequence uint64, _ bool) (stop bool, err error) {
+		known = append(known, l1Sequence)
+		return false, nil
+	}); err != nil {
+		return 0, nil, nil, err
+	}
+
+	buffered := []uint64{}
+	if err := k.BufferedDeposits.Walk(ctx, nil, func(l1Sequence uint64, _ sdk.Msg) (stop bool, err error) {
+		buffered = append(buffered, l1Sequence)
+		return false, nil
+	}); err != nil {
+		return 0, nil, nil, err
+	}
+
+	known = append(known, buffered...)
+	sort.Slice(known, func(i, j int) bool { return known[i] < known[j] })
+
+	missing := []types.SequenceRange{}
+	start := nextL1Sequence
+	for _, l1Sequence := range known {
+		if l1Sequence > start {
+			missing = append(missing, types.SequenceRange{Start: start, End: l1Sequence - 1})
+		}
+
+		start = l1Sequence + 1
+	}
+
+	return nextL1Sequence - 1, missing, buffered, nil
+}
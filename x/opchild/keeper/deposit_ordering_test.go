@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_StrictDepositOrdering(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.StrictDepositOrdering = true
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	deposit := func(sequence uint64) error {
+		msg := types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), sequence, 1, "test_token", nil)
+		_, err := ms.FinalizeTokenDeposit(ctx, msg)
+		return err
+	}
+
+	require.NoError(t, deposit(1))
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+
+	// out of order deposits are buffered
+	require.NoError(t, deposit(3))
+	require.NoError(t, deposit(4))
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+	require.ErrorIs(t, deposit(3), types.ErrDepositAlreadyBuffered)
+
+	res, err := querier.DepositStatus(ctx, &types.QueryDepositStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.HighestContiguousSequence)
+	require.Equal(t, []types.SequenceRange{{Start: 2, End: 2}}, res.MissingRanges)
+	require.Equal(t, []uint64{3, 4}, res.BufferedSequences)
+
+	// filling the gap finalizes the buffered deposits
+	require.NoError(t, deposit(2))
+	require.Equal(t, math.NewInt(400), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+	require.ErrorIs(t, deposit(3), types.ErrDepositAlreadyFinalized)
+
+	res, err = querier.DepositStatus(ctx, &types.QueryDepositStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.HighestContiguousSequence)
+	require.Empty(t, res.MissingRanges)
+	require.Empty(t, res.BufferedSequences)
+}
+
+func Test_StrictDepositOrdering_FailedBufferedDeposit(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.StrictDepositOrdering = true
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	deposit := func(sequence uint64) error {
+		msg := types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), sequence, 1, "test_token", nil)
+		_, err := ms.FinalizeTokenDeposit(ctx, msg)
+		return err
+	}
+
+	require.NoError(t, deposit(1))
+	require.NoError(t, deposit(3))
+
+	// the buffered deposit cannot be finalized
+	invalid := types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], "invalid", sdk.NewCoin(denom, math.NewInt(100)), 3, 1, "test_token", nil)
+	require.NoError(t, input.OPChildKeeper.BufferedDeposits.Set(ctx, 3, invalid))
+
+	// the failed deposit is consumed and does not block the later sequences
+	require.NoError(t, deposit(2))
+	require.Equal(t, math.NewInt(200), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+	require.ErrorIs(t, deposit(3), types.ErrDepositAlreadyFinalized)
+
+	has, err := input.OPChildKeeper.BufferedDeposits.Has(ctx, 3)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, deposit(4))
+	require.Equal(t, math.NewInt(300), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+
+	nextL1Sequence, err := input.OPChildKeeper.GetNextL1Sequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), nextL1Sequence)
+}
+
+func Test_DepositStatus_NonStrict(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	denom := ophosttypes.L2Denom(1, "test_token")
+	for _, sequence := range []uint64{1, 2, 5, 8} {
+		msg := types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), sequence, 1, "test_token", nil)
+		_, err := ms.FinalizeTokenDeposit(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// deposits are finalized in any order, but the gaps are exposed
+	require.Equal(t, math.NewInt(400), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+
+	res, err := querier.DepositStatus(ctx, &types.QueryDepositStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.HighestContiguousSequence)
+	require.Equal(t, []types.SequenceRange{{Start: 3, End: 4}, {Start: 6, End: 7}}, res.MissingRanges)
+	require.Empty(t, res.BufferedSequences)
+}
@@ -2,39 +2,30 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
+// RegisterExecutorChangePlan registers a plan with the json encoded
+// consensus pubkey, which is used by the chain upgrade handlers.
 func (k Keeper) RegisterExecutorChangePlan(
+	ctx context.Context,
 	proposalID, height uint64,
 	nextValidator, moniker, consensusPubKey, info string, nextExecutors []string,
 ) error {
-	if proposalID <= 0 {
-		return errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "invalid proposal id")
-	}
-
-	if height <= 0 {
-		return errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "invalid height")
-	}
-
-	if _, found := k.ExecutorChangePlans[height]; found {
-		return types.ErrAlreadyRegisteredHeight
-	}
-
 	valAddr, err := k.validatorAddressCodec.StringToBytes(nextValidator)
 	if err != nil {
 		return err
 	}
-	for _, nextExecutor := range nextExecutors {
-		_, err = k.addressCodec.StringToBytes(nextExecutor)
-		if err != nil {
-			return err
-		}
-	}
 
 	var pubKey cryptotypes.PubKey
 	err = k.cdc.UnmarshalInterfaceJSON([]byte(consensusPubKey), &pubKey)
@@ -47,14 +38,127 @@ func (k Keeper) RegisterExecutorChangePlan(
 		return err
 	}
 
-	k.ExecutorChangePlans[height] = types.ExecutorChangePlan{
+	return k.SetExecutorChangePlan(ctx, types.ExecutorChangePlan{
 		ProposalID:    proposalID,
 		Height:        height,
 		NextExecutors: nextExecutors,
 		NextValidator: validator,
 		Info:          info,
+	})
+}
+
+// SetExecutorChangePlan stores the plan to be applied at the plan height.
+func (k Keeper) SetExecutorChangePlan(ctx context.Context, plan types.ExecutorChangePlan) error {
+	if err := plan.Validate(k.addressCodec); err != nil {
+		return err
+	}
+
+	if plan.Height <= uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return errorsmod.Wrap(types.ErrInvalidExecutorChangePlan, "height must be in the future")
+	}
+
+	if found, err := k.ExecutorChangePlans.Has(ctx, plan.Height); err != nil {
+		return err
+	} else if found {
+		return types.ErrAlreadyRegisteredHeight
+	}
+
+	// the plan must not leave the params invalid, otherwise the chain halts
+	// at the plan height
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.validateExecutorChangePlan(params, plan); err != nil {
+		return err
+	}
+
+	return k.ExecutorChangePlans.Set(ctx, plan.Height, plan)
+}
+
+// ValidateExecutorChangePlans checks the registered plans can be applied on
+// top of the params.
+func (k Keeper) ValidateExecutorChangePlans(ctx context.Context, params types.Params) error {
+	return k.IterateExecutorChangePlans(ctx, func(plan types.ExecutorChangePlan) (stop bool, err error) {
+		return false, k.validateExecutorChangePlan(params, plan)
+	})
+}
+
+func (k Keeper) validateExecutorChangePlan(params types.Params, plan types.ExecutorChangePlan) error {
+	if err := executorChangeParams(params, plan).Validate(k.addressCodec); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidExecutorChangePlan, "plan at height %d: %s", plan.Height, err)
+	}
+
+	return nil
+}
+
+// executorChangeParams returns the params after the plan is applied. The
+// outgoing executors are removed from the scoped roles too, and the roles
+// left empty fall back to the next executors.
+func executorChangeParams(params types.Params, plan types.ExecutorChangePlan) types.Params {
+	isOutgoing := func(addr string) bool {
+		return slices.Contains(params.BridgeExecutors, addr) && !slices.Contains(plan.NextExecutors, addr)
 	}
 
+	params.DepositRelayers = slices.DeleteFunc(slices.Clone(params.DepositRelayers), isOutgoing)
+	params.OracleRelayers = slices.DeleteFunc(slices.Clone(params.OracleRelayers), isOutgoing)
+	params.BridgeInfoSetters = slices.DeleteFunc(slices.Clone(params.BridgeInfoSetters), isOutgoing)
+	params.BridgeExecutors = plan.NextExecutors
+	return params
+}
+
+// GetExecutorChangePlan returns the plan registered at the height.
+func (k Keeper) GetExecutorChangePlan(ctx context.Context, height uint64) (types.ExecutorChangePlan, error) {
+	return k.ExecutorChangePlans.Get(ctx, height)
+}
+
+// CancelExecutorChangePlan removes the plan registered at the height.
+func (k Keeper) CancelExecutorChangePlan(ctx context.Context, height uint64) error {
+	if found, err := k.ExecutorChangePlans.Has(ctx, height); err != nil {
+		return err
+	} else if !found {
+		return types.ErrExecutorChangePlanNotFound
+	}
+
+	return k.ExecutorChangePlans.Remove(ctx, height)
+}
+
+// IterateExecutorChangePlans iterates the plans in the height order.
+func (k Keeper) IterateExecutorChangePlans(ctx context.Context, cb func(plan types.ExecutorChangePlan) (stop bool, err error)) error {
+	return k.ExecutorChangePlans.Walk(ctx, nil, func(_ uint64, plan types.ExecutorChangePlan) (stop bool, err error) {
+		return cb(plan)
+	})
+}
+
+// ApplyExecutorChangePlan applies and removes the plan registered at the
+// current height.
+func (k Keeper) ApplyExecutorChangePlan(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	plan, err := k.ExecutorChangePlans.Get(ctx, height)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.ChangeExecutor(ctx, plan); err != nil {
+		return err
+	}
+
+	if err := k.ExecutorChangePlans.Remove(ctx, height); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteExecutorChange,
+		sdk.NewAttribute(types.AttributeKeyProposalId, strconv.FormatUint(plan.ProposalID, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(plan.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyValidator, plan.NextValidator.OperatorAddress),
+		sdk.NewAttribute(types.AttributeKeyExecutors, strings.Join(plan.NextExecutors, ",")),
+	))
+
 	return nil
 }
 
@@ -76,9 +180,6 @@ func (k Keeper) ChangeExecutor(ctx context.Context, plan types.ExecutorChangePla
 	if err != nil {
 		return err
 	}
-	params.BridgeExecutors = plan.NextExecutors
-	if err := k.SetParams(ctx, params); err != nil {
-		return err
-	}
-	return nil
+
+	return k.SetParams(ctx, executorChangeParams(params, plan))
 }
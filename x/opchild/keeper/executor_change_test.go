@@ -7,17 +7,22 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	"github.com/initia-labs/OPinit/x/opchild/types"
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
 func Test_RegisterExecutorChangePlan(t *testing.T) {
 	// Setup
-	_, input := createTestInput(t, false)
+	ctx, input := createTestInput(t, false)
 
 	// Arguments
-	l1ProposalID := uint64(rand.Uint64())
-	height := uint64(rand.Uint64())
+	l1ProposalID := uint64(rand.Uint64()%1_000_000) + 1
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + rand.Uint64()%1_000_000 + 1
 	nextValAddr := valAddrsStr[0]
 	nextExecutorAddr := []string{addrsStr[0], addrsStr[1]}
 	consensusPubKey := "l7aqGv+Zjbm0rallfqfqz+3iN31iOmgJCafWV5pGs6o="
@@ -25,14 +30,13 @@ func Test_RegisterExecutorChangePlan(t *testing.T) {
 	info := "info"
 
 	err := input.OPChildKeeper.RegisterExecutorChangePlan(
-		l1ProposalID, height, nextValAddr,
+		ctx, l1ProposalID, height, nextValAddr,
 		moniker,
 		fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`, consensusPubKey),
 		info,
 		nextExecutorAddr,
 	)
 	require.NoError(t, err)
-	require.Len(t, input.OPChildKeeper.ExecutorChangePlans, 1)
 
 	consensusPubKeyBytes, err := base64.StdEncoding.DecodeString(consensusPubKey)
 	require.NoError(t, err)
@@ -41,11 +45,203 @@ func Test_RegisterExecutorChangePlan(t *testing.T) {
 		Key: consensusPubKeyBytes,
 	}, moniker)
 	require.NoError(t, err)
+
+	plan, err := input.OPChildKeeper.GetExecutorChangePlan(ctx, height)
+	require.NoError(t, err)
 	require.Equal(t, types.ExecutorChangePlan{
 		ProposalID:    l1ProposalID,
 		Height:        height,
 		NextExecutors: []string{addrsStr[0], addrsStr[1]},
 		NextValidator: expectedValidator,
 		Info:          info,
-	}, input.OPChildKeeper.ExecutorChangePlans[height])
+	}, plan)
+
+	// the plan at the same height cannot be registered twice
+	err = input.OPChildKeeper.RegisterExecutorChangePlan(
+		ctx, l1ProposalID, height, nextValAddr,
+		moniker,
+		fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`, consensusPubKey),
+		info,
+		nextExecutorAddr,
+	)
+	require.ErrorIs(t, err, types.ErrAlreadyRegisteredHeight)
+}
+
+func Test_MsgServer_ExecutorChangePlan(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ctx := sdk.UnwrapSDKContext(_ctx).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(3)
+	nextExecutors := []string{addrsStr[1], addrsStr[2]}
+	msg, err := types.NewMsgRegisterExecutorChangePlan(moduleAddr, 1, 20, nextExecutors, "next", valAddrsStr[1], valPubKeys[1], "info")
+	require.NoError(t, err)
+
+	// invalid authority
+	invalidMsg := *msg
+	invalidMsg.Authority = addrsStr[0]
+	_, err = ms.RegisterExecutorChangePlan(ctx, &invalidMsg)
+	require.Error(t, err)
+
+	// the plan height must be in the future
+	pastMsg := *msg
+	pastMsg.Height = 10
+	_, err = ms.RegisterExecutorChangePlan(ctx, &pastMsg)
+	require.ErrorIs(t, err, types.ErrInvalidExecutorChangePlan)
+
+	_, err = ms.RegisterExecutorChangePlan(ctx, msg)
+	require.NoError(t, err)
+
+	cancelMsg, err := types.NewMsgRegisterExecutorChangePlan(moduleAddr, 2, 30, nextExecutors, "next", valAddrsStr[2], valPubKeys[2], "info")
+	require.NoError(t, err)
+	_, err = ms.RegisterExecutorChangePlan(ctx, cancelMsg)
+	require.NoError(t, err)
+
+	res, err := querier.ExecutorChangePlans(ctx, &types.QueryExecutorChangePlansRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Plans, 2)
+	require.Equal(t, uint64(20), res.Plans[0].Height)
+	require.Equal(t, uint64(30), res.Plans[1].Height)
+
+	// cancel the plan
+	_, err = ms.CancelExecutorChangePlan(ctx, types.NewMsgCancelExecutorChangePlan(moduleAddr, 30))
+	require.NoError(t, err)
+	_, err = ms.CancelExecutorChangePlan(ctx, types.NewMsgCancelExecutorChangePlan(moduleAddr, 30))
+	require.ErrorIs(t, err, types.ErrExecutorChangePlanNotFound)
+
+	_, err = querier.ExecutorChangePlan(ctx, &types.QueryExecutorChangePlanRequest{Height: 30})
+	require.Error(t, err)
+
+	planRes, err := querier.ExecutorChangePlan(ctx, &types.QueryExecutorChangePlanRequest{Height: 20})
+	require.NoError(t, err)
+	require.Equal(t, valAddrsStr[1], planRes.Plan.NextValidator.OperatorAddress)
+
+	// the plan survives the genesis export and import
+	genState := input.OPChildKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.ExecutorChangePlans, 1)
+	require.NoError(t, types.ValidateGenesis(genState, input.AccountKeeper.AddressCodec()))
+
+	// nothing happens before the plan height
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx.WithBlockHeight(19)))
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{addrsStr[0]}, params.BridgeExecutors)
+
+	// the plan is applied and removed at the plan height
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx))
+
+	params, err = input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, nextExecutors, params.BridgeExecutors)
+
+	_, found := input.OPChildKeeper.GetValidator(ctx, valAddrs[1])
+	require.True(t, found)
+
+	_, err = input.OPChildKeeper.GetExecutorChangePlan(ctx, 20)
+	require.Error(t, err)
+
+	executed := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExecuteExecutorChange {
+			executed = true
+		}
+	}
+	require.True(t, executed)
+}
+
+func Test_ExecutorChangePlan_ScopedRoles(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ctx := sdk.UnwrapSDKContext(_ctx).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	// the deposit relayers fall back to the executors
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{addrsStr[0], addrsStr[1]}
+	params.OracleRelayers = []string{addrsStr[3]}
+	params.BridgeInfoSetters = []string{addrsStr[0], addrsStr[1], addrsStr[4]}
+	params.DepositAttestationThreshold = 2
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(2)
+
+	// fewer executors than the attestation threshold
+	msg, err := types.NewMsgRegisterExecutorChangePlan(moduleAddr, 1, 20, []string{addrsStr[1]}, "next", valAddrsStr[1], valPubKeys[1], "info")
+	require.NoError(t, err)
+	_, err = ms.RegisterExecutorChangePlan(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidExecutorChangePlan)
+
+	nextExecutors := []string{addrsStr[1], addrsStr[2]}
+	msg, err = types.NewMsgRegisterExecutorChangePlan(moduleAddr, 1, 20, nextExecutors, "next", valAddrsStr[1], valPubKeys[1], "info")
+	require.NoError(t, err)
+	_, err = ms.RegisterExecutorChangePlan(ctx, msg)
+	require.NoError(t, err)
+
+	// the params update cannot invalidate the registered plan
+	invalidParams := params
+	invalidParams.BridgeExecutors = []string{addrsStr[0], addrsStr[1], addrsStr[2]}
+	invalidParams.DepositAttestationThreshold = 3
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(moduleAddr, &invalidParams))
+	require.ErrorIs(t, err, types.ErrInvalidExecutorChangePlan)
+
+	// the scoped roles survive the executor change without the outgoing
+	// executors
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx.WithBlockHeight(20)))
+
+	params, err = input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, nextExecutors, params.BridgeExecutors)
+	require.Equal(t, []string{addrsStr[3]}, params.OracleRelayers)
+	require.Equal(t, []string{addrsStr[1], addrsStr[4]}, params.BridgeInfoSetters)
+	require.Equal(t, nextExecutors, params.DepositRelayerAddrs())
+	require.Equal(t, uint32(2), params.DepositAttestationThreshold)
+}
+
+func Test_ExecutorChangePlan_OutgoingRoles(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ctx := sdk.UnwrapSDKContext(_ctx).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	// the outgoing executor holds all the scoped roles
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{addrsStr[0]}
+	params.DepositRelayers = []string{addrsStr[0]}
+	params.OracleRelayers = []string{addrsStr[0]}
+	params.BridgeInfoSetters = []string{addrsStr[0]}
+	params.DepositAttestationThreshold = 1
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(2)
+	msg, err := types.NewMsgRegisterExecutorChangePlan(moduleAddr, 1, 20, []string{addrsStr[1]}, "next", valAddrsStr[1], valPubKeys[1], "info")
+	require.NoError(t, err)
+	_, err = ms.RegisterExecutorChangePlan(ctx, msg)
+	require.NoError(t, err)
+
+	require.NoError(t, input.OPChildKeeper.ApplyExecutorChangePlan(ctx.WithBlockHeight(20)))
+
+	// the roles fall back to the next executors
+	params, err = input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Empty(t, params.DepositRelayers)
+	require.Empty(t, params.OracleRelayers)
+	require.Empty(t, params.BridgeInfoSetters)
+	require.Equal(t, []string{addrsStr[1]}, params.DepositRelayerAddrs())
+	require.Equal(t, []string{addrsStr[1]}, params.OracleRelayerAddrs())
+	require.Equal(t, []string{addrsStr[1]}, params.BridgeInfoSetterAddrs())
+
+	depositRelayers, err := input.OPChildKeeper.DepositRelayers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addrs[1]}, depositRelayers)
 }
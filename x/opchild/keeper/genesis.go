@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -71,6 +72,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	if data.NextL1Sequence != 0 {
+		if err := k.SetNextL1Sequence(ctx, data.NextL1Sequence); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, finalizedL1Sequence := range data.FinalizedL1Sequences {
 		if err := k.RecordFinalizedL1Sequence(ctx, finalizedL1Sequence); err != nil {
 			panic(err)
@@ -91,6 +98,66 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, plan := range data.ExecutorChangePlans {
+		if err := k.ExecutorChangePlans.Set(ctx, plan.Height, plan); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.NextScheduledMessagesId != 0 {
+		if err := k.SetNextScheduledMessagesId(ctx, data.NextScheduledMessagesId); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, sm := range data.ScheduledMessages {
+		if err := k.ScheduledMessages.Set(ctx, sm.Id, sm); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.PendingAdmin != "" {
+		if err := k.PendingAdmin.Set(ctx, data.PendingAdmin); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.NextAdminProposalId != 0 {
+		if err := k.SetNextAdminProposalId(ctx, data.NextAdminProposalId); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, proposal := range data.AdminProposals {
+		if err := k.AdminProposals.Set(ctx, proposal.Id, proposal); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, info := range data.SigningInfos {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(info.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetValidatorSigningInfo(ctx, consAddr, info); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, missed := range data.MissedBlocks {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(missed.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, index := range missed.MissedIndexes {
+			if err := k.ValidatorMissedBlocks.Set(ctx, collections.Join(consAddr, index)); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	return res
 }
 
@@ -131,6 +198,11 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	nextL1Sequence, err := k.GetNextL1Sequence(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	var bridgeInfo *types.BridgeInfo
 	if ok, err := k.BridgeInfo.Has(ctx); err != nil {
 		panic(err)
@@ -143,13 +215,89 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		bridgeInfo = &bridgeInfo_
 	}
 
+	var executorChangePlans []types.ExecutorChangePlan
+	err = k.IterateExecutorChangePlans(ctx, func(plan types.ExecutorChangePlan) (bool, error) {
+		executorChangePlans = append(executorChangePlans, plan)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextScheduledMessagesId, err := k.GetNextScheduledMessagesId(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var scheduledMessages []types.ScheduledMessages
+	err = k.IterateScheduledMessages(ctx, func(sm types.ScheduledMessages) (bool, error) {
+		scheduledMessages = append(scheduledMessages, sm)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	pendingAdmin, err := k.GetPendingAdmin(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextAdminProposalId, err := k.GetNextAdminProposalId(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var adminProposals []types.AdminProposal
+	err = k.IterateAdminProposals(ctx, func(proposal types.AdminProposal) (bool, error) {
+		adminProposals = append(adminProposals, proposal)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var signingInfos []types.ValidatorSigningInfo
+	var missedBlocks []types.ValidatorMissedBlocks
+	err = k.IterateValidatorSigningInfos(ctx, func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (bool, error) {
+		signingInfos = append(signingInfos, info)
+
+		missedIndexes, err := k.GetMissedBlockIndexes(ctx, consAddr)
+		if err != nil {
+			return true, err
+		}
+		if len(missedIndexes) > 0 {
+			missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
+				Address:       info.Address,
+				MissedIndexes: missedIndexes,
+			})
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastValidatorPowers:  lastValidatorPowers,
 		Validators:           validators,
 		Exported:             true,
 		FinalizedL1Sequences: finalizedL1Sequences,
+		NextL1Sequence:       nextL1Sequence,
 		NextL2Sequence:       nextL2Sequence,
 		BridgeInfo:           bridgeInfo,
+		ExecutorChangePlans:  executorChangePlans,
+
+		ScheduledMessages:       scheduledMessages,
+		NextScheduledMessagesId: nextScheduledMessagesId,
+
+		PendingAdmin:        pendingAdmin,
+		AdminProposals:      adminProposals,
+		NextAdminProposalId: nextAdminProposalId,
+
+		SigningInfos: signingInfos,
+		MissedBlocks: missedBlocks,
 	}
 }
@@ -28,6 +28,8 @@ func Test_GenesisImportExport(t *testing.T) {
 
 	genState := input.OPChildKeeper.ExportGenesis(ctx)
 	require.Nil(t, genState.BridgeInfo)
+	require.Equal(t, uint64(3), genState.NextL1Sequence)
+	require.Empty(t, genState.FinalizedL1Sequences)
 
 	// set bridge info
 	genState.BridgeInfo = &types.BridgeInfo{
@@ -28,8 +28,13 @@ type Keeper struct {
 
 	authKeeper types.AccountKeeper
 	bankKeeper types.BankKeeper
+	nftKeeper  types.NftKeeper
 	bridgeHook types.BridgeHook
 
+	// l1 client keeper to verify the deposit proofs; set after the ibc keeper
+	// is created because the ibc keeper depends on this keeper.
+	clientKeeper types.ClientKeeper
+
 	// Msg server router
 	router *baseapp.MsgServiceRouter
 
@@ -46,13 +51,40 @@ type Keeper struct {
 	Params               collections.Item[types.Params]
 	BridgeInfo           collections.Item[types.BridgeInfo]
 	FinalizedL1Sequence  collections.Map[uint64, bool]
+	NextL1Sequence       collections.Item[uint64]
+	BufferedDeposits     collections.Map[uint64, sdk.Msg]
+	DepositAttestations  collections.Map[uint64, types.DepositAttestation]
 	LastValidatorPowers  collections.Map[[]byte, int64]
 	Validators           collections.Map[[]byte, types.Validator]
 	ValidatorsByConsAddr collections.Map[[]byte, []byte]
 	HistoricalInfos      collections.Map[int64, cosmostypes.HistoricalInfo]
 	DenomPairs           collections.Map[string, string]
+	NftClassPairs        collections.Map[string, string]
+
+	WithdrawalLeaves      collections.Map[uint64, types.WithdrawalLeaf]
+	WithdrawalCommitments collections.Map[int64, types.WithdrawalCommitment]
+	WithdrawalWindow      collections.Item[types.WithdrawalWindow]
+	// closing height -> closed output window
+	ClosedWithdrawalWindows collections.Map[int64, types.WithdrawalWindow]
+
+	// height => executor change plan
+	ExecutorChangePlans collections.Map[uint64, types.ExecutorChangePlan]
+
+	// id => timelocked admin messages
+	ScheduledMessages       collections.Map[uint64, types.ScheduledMessages]
+	NextScheduledMessagesId collections.Sequence
+
+	// admin transfer and multisig approvals
+	PendingAdmin        collections.Item[string]
+	AdminProposals      collections.Map[uint64, types.AdminProposal]
+	NextAdminProposalId collections.Sequence
+
+	// consensus address => validator liveness
+	ValidatorSigningInfos collections.Map[[]byte, types.ValidatorSigningInfo]
+	ValidatorMissedBlocks collections.KeySet[collections.Pair[[]byte, int64]]
 
-	ExecutorChangePlans map[uint64]types.ExecutorChangePlan
+	// operator address => validator before the consensus pubkey rotation
+	RotatedValidators collections.Map[[]byte, types.Validator]
 
 	l2OracleHandler    *L2OracleHandler
 	HostValidatorStore *HostValidatorStore
@@ -63,6 +95,7 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	nk types.NftKeeper,
 	bh types.BridgeHook,
 	ok *oraclekeeper.Keeper,
 	router *baseapp.MsgServiceRouter,
@@ -90,28 +123,49 @@ func NewKeeper(
 	)
 
 	k := &Keeper{
-		cdc:                   cdc,
-		storeService:          storeService,
-		authKeeper:            ak,
-		bankKeeper:            bk,
-		bridgeHook:            bh,
-		router:                router,
-		authority:             authority,
-		addressCodec:          addressCodec,
-		validatorAddressCodec: validatorAddressCodec,
-		consensusAddressCodec: consensusAddressCodec,
-		NextL2Sequence:        collections.NewSequence(sb, types.NextL2SequenceKey, "next_l2_sequence"),
-		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		BridgeInfo:            collections.NewItem(sb, types.BridgeInfoKey, "bridge_info", codec.CollValue[types.BridgeInfo](cdc)),
-		FinalizedL1Sequence:   collections.NewMap(sb, types.FinalizedL1SequencePrefix, "finalized_l1_sequence", collections.Uint64Key, collections.BoolValue),
-		LastValidatorPowers:   collections.NewMap(sb, types.LastValidatorPowerPrefix, "last_validator_powers", collections.BytesKey, collections.Int64Value),
-		Validators:            collections.NewMap(sb, types.ValidatorsPrefix, "validators", collections.BytesKey, codec.CollValue[types.Validator](cdc)),
-		ValidatorsByConsAddr:  collections.NewMap(sb, types.ValidatorsByConsAddrPrefix, "validators_by_cons_addr", collections.BytesKey, collections.BytesValue),
-		HistoricalInfos:       collections.NewMap(sb, types.HistoricalInfoPrefix, "historical_infos", collections.Int64Key, codec.CollValue[cosmostypes.HistoricalInfo](cdc)),
-		DenomPairs:            collections.NewMap(sb, types.DenomPairPrefix, "denom_pairs", collections.StringKey, collections.StringValue),
-
-		ExecutorChangePlans: make(map[uint64]types.ExecutorChangePlan),
-		HostValidatorStore:  hostValidatorStore,
+		cdc:                     cdc,
+		storeService:            storeService,
+		authKeeper:              ak,
+		bankKeeper:              bk,
+		nftKeeper:               nk,
+		bridgeHook:              bh,
+		router:                  router,
+		authority:               authority,
+		addressCodec:            addressCodec,
+		validatorAddressCodec:   validatorAddressCodec,
+		consensusAddressCodec:   consensusAddressCodec,
+		NextL2Sequence:          collections.NewSequence(sb, types.NextL2SequenceKey, "next_l2_sequence"),
+		Params:                  collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BridgeInfo:              collections.NewItem(sb, types.BridgeInfoKey, "bridge_info", codec.CollValue[types.BridgeInfo](cdc)),
+		FinalizedL1Sequence:     collections.NewMap(sb, types.FinalizedL1SequencePrefix, "finalized_l1_sequence", collections.Uint64Key, collections.BoolValue),
+		NextL1Sequence:          collections.NewItem(sb, types.NextL1SequenceKey, "next_l1_sequence", collections.Uint64Value),
+		BufferedDeposits:        collections.NewMap(sb, types.BufferedDepositPrefix, "buffered_deposits", collections.Uint64Key, codec.CollInterfaceValue[sdk.Msg](cdc)),
+		DepositAttestations:     collections.NewMap(sb, types.DepositAttestationPrefix, "deposit_attestations", collections.Uint64Key, codec.CollValue[types.DepositAttestation](cdc)),
+		LastValidatorPowers:     collections.NewMap(sb, types.LastValidatorPowerPrefix, "last_validator_powers", collections.BytesKey, collections.Int64Value),
+		Validators:              collections.NewMap(sb, types.ValidatorsPrefix, "validators", collections.BytesKey, codec.CollValue[types.Validator](cdc)),
+		ValidatorsByConsAddr:    collections.NewMap(sb, types.ValidatorsByConsAddrPrefix, "validators_by_cons_addr", collections.BytesKey, collections.BytesValue),
+		HistoricalInfos:         collections.NewMap(sb, types.HistoricalInfoPrefix, "historical_infos", collections.Int64Key, codec.CollValue[cosmostypes.HistoricalInfo](cdc)),
+		DenomPairs:              collections.NewMap(sb, types.DenomPairPrefix, "denom_pairs", collections.StringKey, collections.StringValue),
+		NftClassPairs:           collections.NewMap(sb, types.NftClassPairPrefix, "nft_class_pairs", collections.StringKey, collections.StringValue),
+		WithdrawalLeaves:        collections.NewMap(sb, types.WithdrawalLeafPrefix, "withdrawal_leaves", collections.Uint64Key, codec.CollValue[types.WithdrawalLeaf](cdc)),
+		WithdrawalCommitments:   collections.NewMap(sb, types.WithdrawalCommitmentPrefix, "withdrawal_commitments", collections.Int64Key, codec.CollValue[types.WithdrawalCommitment](cdc)),
+		WithdrawalWindow:        collections.NewItem(sb, types.WithdrawalWindowKey, "withdrawal_window", codec.CollValue[types.WithdrawalWindow](cdc)),
+		ClosedWithdrawalWindows: collections.NewMap(sb, types.ClosedWithdrawalWindowPrefix, "closed_withdrawal_windows", collections.Int64Key, codec.CollValue[types.WithdrawalWindow](cdc)),
+		ExecutorChangePlans:     collections.NewMap(sb, types.ExecutorChangePlanPrefix, "executor_change_plans", collections.Uint64Key, codec.CollValue[types.ExecutorChangePlan](cdc)),
+
+		ScheduledMessages:       collections.NewMap(sb, types.ScheduledMessagesPrefix, "scheduled_messages", collections.Uint64Key, codec.CollValue[types.ScheduledMessages](cdc)),
+		NextScheduledMessagesId: collections.NewSequence(sb, types.NextScheduledMessagesIdKey, "next_scheduled_messages_id"),
+
+		PendingAdmin:        collections.NewItem(sb, types.PendingAdminKey, "pending_admin", collections.StringValue),
+		AdminProposals:      collections.NewMap(sb, types.AdminProposalPrefix, "admin_proposals", collections.Uint64Key, codec.CollValue[types.AdminProposal](cdc)),
+		NextAdminProposalId: collections.NewSequence(sb, types.NextAdminProposalIdKey, "next_admin_proposal_id"),
+
+		ValidatorSigningInfos: collections.NewMap(sb, types.ValidatorSigningInfoPrefix, "validator_signing_infos", collections.BytesKey, codec.CollValue[types.ValidatorSigningInfo](cdc)),
+		ValidatorMissedBlocks: collections.NewKeySet(sb, types.ValidatorMissedBlockPrefix, "validator_missed_blocks", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key)),
+
+		RotatedValidators: collections.NewMap(sb, types.RotatedValidatorPrefix, "rotated_validators", collections.BytesKey, codec.CollValue[types.Validator](cdc)),
+
+		HostValidatorStore: hostValidatorStore,
 	}
 
 	schema, err := sb.Build()
@@ -140,8 +194,24 @@ func (keeper Keeper) Router() *baseapp.MsgServiceRouter {
 	return keeper.router
 }
 
-// setDenomMetadata sets an OPinit token's denomination metadata
-func (k Keeper) setDenomMetadata(ctx context.Context, baseDenom, denom string) {
+// SetClientKeeper sets the ibc client keeper used to verify the deposit
+// proofs against the l1 state. It must be called before the msg server is
+// registered.
+func (k *Keeper) SetClientKeeper(ck types.ClientKeeper) {
+	k.clientKeeper = ck
+}
+
+// setDenomMetadata sets an OPinit token's denomination metadata. If the l1
+// metadata is provided, its denom units, display and symbol are propagated
+// with the base unit renamed to the l2 denom.
+func (k Keeper) setDenomMetadata(ctx context.Context, baseDenom, denom string, l1Metadata *banktypes.Metadata) {
+	if l1Metadata != nil {
+		if metadata, ok := l2DenomMetadata(baseDenom, denom, *l1Metadata); ok {
+			k.bankKeeper.SetDenomMetaData(ctx, metadata)
+			return
+		}
+	}
+
 	metadata := banktypes.Metadata{
 		Base:        denom,
 		Display:     baseDenom,
@@ -159,6 +229,48 @@ func (k Keeper) setDenomMetadata(ctx context.Context, baseDenom, denom string) {
 	k.bankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
+// l2DenomMetadata converts the l1 metadata of the base denom to the metadata
+// of the l2 denom. It returns false if the converted metadata is invalid.
+func l2DenomMetadata(baseDenom, denom string, l1Metadata banktypes.Metadata) (banktypes.Metadata, bool) {
+	metadata := banktypes.Metadata{
+		Description: l1Metadata.Description,
+		Base:        denom,
+		Display:     l1Metadata.Display,
+		Name:        l1Metadata.Name,
+		Symbol:      l1Metadata.Symbol,
+		URI:         l1Metadata.URI,
+		URIHash:     l1Metadata.URIHash,
+	}
+
+	for _, unit := range l1Metadata.DenomUnits {
+		if unit == nil {
+			return banktypes.Metadata{}, false
+		}
+
+		l2Unit := &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		}
+		if unit.Denom == baseDenom {
+			l2Unit.Denom = denom
+			l2Unit.Aliases = append([]string{baseDenom}, unit.Aliases...)
+		}
+
+		metadata.DenomUnits = append(metadata.DenomUnits, l2Unit)
+	}
+
+	if metadata.Display == baseDenom {
+		metadata.Display = denom
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, false
+	}
+
+	return metadata, true
+}
+
 // UpdateHostValidatorSet updates the host validator set.
 func (k Keeper) UpdateHostValidatorSet(ctx context.Context, clientID string, height int64, validatorSet *cmtproto.ValidatorSet) error {
 	if clientID == "" {
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// checkDepositRelayer checks the sender is allowed to relay the deposit. The
+// proven deposits can be relayed by any account, otherwise the sender must be
+// a deposit relayer and the proof must not be required.
+func (ms MsgServer) checkDepositRelayer(ctx context.Context, sender string, proven bool) error {
+	if proven {
+		return nil
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.DepositProofRequired {
+		return types.ErrDepositProofRequired
+	}
+
+	return ms.checkBridgeExecutorPermission(ctx, sender, ms.DepositRelayers)
+}
+
+// verifyDepositProof verifies the deposit commitment is stored in the l1
+// ophost store at the given l1 height.
+func (k Keeper) verifyDepositProof(ctx context.Context, info types.BridgeInfo, l1Sequence, proofHeight uint64, proof []byte, commitment [32]byte) error {
+	key := ophosttypes.DepositCommitmentKey(info.BridgeId, l1Sequence)
+	if err := k.verifyL1Membership(ctx, info.L1ChainId, proofHeight, proof, key, commitment[:]); err != nil {
+		return types.ErrInvalidDepositProof.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// verifyBridgeInfoProof verifies the bridge config of the bridge info is
+// stored in the l1 ophost store at the given l1 height.
+func (k Keeper) verifyBridgeInfoProof(ctx context.Context, info types.BridgeInfo, proofHeight uint64, proof []byte) error {
+	value, err := k.cdc.Marshal(&info.BridgeConfig)
+	if err != nil {
+		return err
+	}
+
+	key := ophosttypes.BridgeConfigKey(info.BridgeId)
+	if err := k.verifyL1Membership(ctx, info.L1ChainId, proofHeight, proof, key, value); err != nil {
+		return types.ErrInvalidBridgeInfoProof.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// chainIdClientState is implemented by the client states tracking a chain
+// with the chain id, such as the tendermint client.
+type chainIdClientState interface {
+	GetChainID() string
+}
+
+// verifyL1Membership verifies the value is stored under the key of the l1
+// ophost store at the given l1 height. The proof is verified with the l1 ibc
+// client of the params, which must track the l1 chain id.
+func (k Keeper) verifyL1Membership(ctx context.Context, l1ChainId string, proofHeight uint64, proof, key, value []byte) error {
+	if k.clientKeeper == nil {
+		return types.ErrInvalidL1Proof.Wrap("l1 client keeper is not set")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientId := params.L1ClientId
+	if clientId == "" {
+		return types.ErrInvalidL1Proof.Wrap("l1 client id is not set")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	clientState, found := k.clientKeeper.GetClientState(sdkCtx, clientId)
+	if !found {
+		return types.ErrInvalidL1Proof.Wrapf("l1 client %s not found", clientId)
+	}
+
+	if cs, ok := clientState.(chainIdClientState); !ok {
+		return types.ErrInvalidL1Proof.Wrapf("l1 client %s does not track a chain id", clientId)
+	} else if cs.GetChainID() != l1ChainId {
+		return types.ErrInvalidL1Proof.Wrapf("l1 client %s tracks chain %s, expected %s", clientId, cs.GetChainID(), l1ChainId)
+	}
+
+	clientStore := k.clientKeeper.ClientStore(sdkCtx, clientId)
+	if status := clientState.Status(sdkCtx, clientStore, k.cdc); status != ibcexported.Active {
+		return types.ErrInvalidL1Proof.Wrapf("l1 client %s is not active: %s", clientId, status)
+	}
+
+	// the proof height is in the current revision of the l1 chain
+	height := clienttypes.NewHeight(clientState.GetLatestHeight().GetRevisionNumber(), proofHeight)
+	path := commitmenttypes.NewMerklePath(ophosttypes.StoreKey, string(key))
+	return clientState.VerifyMembership(sdkCtx, clientStore, k.cdc, height, 0, 0, proof, path, value)
+}
@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+const (
+	testClientId     = "test-client-id"
+	attackerClientId = "attacker-client-id"
+)
+
+var _ types.ClientKeeper = &mockClientKeeper{}
+
+// mockClientState accepts the proofs of the values stored at the l1 heights.
+type mockClientState struct {
+	ibcexported.ClientState
+
+	chainId string
+	height  uint64
+	values  map[string][]byte
+}
+
+func (cs *mockClientState) GetChainID() string {
+	return cs.chainId
+}
+
+func (cs *mockClientState) GetLatestHeight() ibcexported.Height {
+	return clienttypes.NewHeight(1, cs.height)
+}
+
+func (cs *mockClientState) Status(sdk.Context, storetypes.KVStore, codec.BinaryCodec) ibcexported.Status {
+	return ibcexported.Active
+}
+
+func (cs *mockClientState) VerifyMembership(
+	_ sdk.Context, _ storetypes.KVStore, _ codec.BinaryCodec,
+	height ibcexported.Height, _, _ uint64,
+	proof []byte, path ibcexported.Path, value []byte,
+) error {
+	if height.GetRevisionHeight() > cs.height || !bytes.Equal(proof, []byte("proof")) {
+		return errors.New("invalid proof")
+	}
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return errors.New("invalid path")
+	}
+	if stored, ok := cs.values[merklePath.String()]; !ok || !bytes.Equal(stored, value) {
+		return errors.New("value mismatch")
+	}
+
+	return nil
+}
+
+type mockClientKeeper struct {
+	clientStates map[string]*mockClientState
+}
+
+func (ck *mockClientKeeper) GetClientState(_ sdk.Context, clientID string) (ibcexported.ClientState, bool) {
+	clientState, found := ck.clientStates[clientID]
+	if !found {
+		return nil, false
+	}
+
+	return clientState, true
+}
+
+// setupL1Client registers the l1 client state under the test client id and
+// sets it to the params.
+func setupL1Client(t *testing.T, ctx context.Context, input *TestKeepers) *mockClientState {
+	clientState := &mockClientState{chainId: "test-chain-id", height: 100, values: make(map[string][]byte)}
+	input.OPChildKeeper.SetClientKeeper(&mockClientKeeper{map[string]*mockClientState{testClientId: clientState}})
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.L1ClientId = testClientId
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	return clientState
+}
+
+func (ck *mockClientKeeper) ClientStore(sdk.Context, string) storetypes.KVStore {
+	return nil
+}
+
+func Test_DepositProof(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	clientState := setupL1Client(t, ctx, &input)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: testClientId,
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+		},
+	}))
+
+	// commit the deposit in the l1 store
+	denom := ophosttypes.L2Denom(1, "test_token")
+	commitment := ophosttypes.GenerateTokenDepositHash(1, 1, 10, addrsStr[1], addrsStr[2], "test_token", denom, math.NewInt(100), nil, nil)
+	path := commitmenttypes.NewMerklePath(ophosttypes.StoreKey, string(ophosttypes.DepositCommitmentKey(1, 1)))
+	clientState.values[path.String()] = commitment[:]
+
+	// the deposit is relayed by a non executor account with the proof
+	msg := types.NewMsgFinalizeTokenDeposit(addrsStr[3], addrsStr[1], addrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), 1, 10, "test_token", nil)
+	msg.ProofHeight = 100
+
+	msg.Proof = []byte("invalid")
+	_, err := ms.FinalizeTokenDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidDepositProof)
+
+	// the deposit contents must match the l1 commitment
+	msg.Proof = []byte("proof")
+	msg.Amount = sdk.NewCoin(denom, math.NewInt(1_000_000))
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidDepositProof)
+
+	msg.Amount = sdk.NewCoin(denom, math.NewInt(100))
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+
+	// the executors cannot finalize deposits without the proof in the proof required mode
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.DepositProofRequired = true
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	msg = types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[2], sdk.NewCoin(denom, math.NewInt(100)), 2, 10, "test_token", nil)
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDepositProofRequired)
+
+	// the non executors cannot finalize deposits without the proof
+	params.DepositProofRequired = false
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	msg.Sender = addrsStr[3]
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.Error(t, err)
+}
+
+func Test_DepositProof_Nft(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	clientState := setupL1Client(t, ctx, &input)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	require.NoError(t, input.OPChildKeeper.BridgeInfo.Set(ctx, types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: testClientId,
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+		},
+	}))
+
+	classId := ophosttypes.L2ClassId(1, "l1class")
+	commitment := ophosttypes.GenerateNftDepositHash(1, 1, 10, addrsStr[1], addrsStr[2], "l1class", classId, "name", "symbol", "class_uri", "token1", "token_uri")
+	path := commitmenttypes.NewMerklePath(ophosttypes.StoreKey, string(ophosttypes.DepositCommitmentKey(1, 1)))
+	clientState.values[path.String()] = commitment[:]
+
+	msg := types.NewMsgFinalizeNftDeposit(addrsStr[3], addrsStr[1], addrsStr[2], classId, "token1", 1, 10, "l1class")
+	msg.ClassName, msg.ClassSymbol, msg.ClassUri, msg.TokenUri = "name", "symbol", "class_uri", "token_uri"
+	msg.ProofHeight = 200
+	msg.Proof = []byte("proof")
+	_, err := ms.FinalizeNftDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidDepositProof)
+
+	msg.ProofHeight = 100
+	_, err = ms.FinalizeNftDeposit(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, addrs[2], input.NftKeeper.GetOwner(ctx, classId, "token1"))
+}
+
+func Test_BridgeInfoProof(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	clientState := setupL1Client(t, ctx, &input)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: testClientId,
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+		},
+	}
+
+	// store the bridge config in the l1 store
+	bz, err := input.EncodingConfig.Marshaler.Marshal(&info.BridgeConfig)
+	require.NoError(t, err)
+	path := commitmenttypes.NewMerklePath(ophosttypes.StoreKey, string(ophosttypes.BridgeConfigKey(1)))
+	clientState.values[path.String()] = bz
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeInfoProofRequired = true
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	msg := types.NewMsgSetBridgeInfo(addrsStr[0], info)
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.ErrorIs(t, err, types.ErrBridgeInfoProofRequired)
+
+	// the bridge config diverged from l1
+	msg.ProofHeight = 100
+	msg.Proof = []byte("proof")
+	msg.BridgeInfo.BridgeConfig.FinalizationPeriod = time.Minute
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBridgeInfoProof)
+
+	msg.BridgeInfo.BridgeConfig.FinalizationPeriod = time.Hour
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.NoError(t, err)
+
+	stored, err := input.OPChildKeeper.BridgeInfo.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, stored.BridgeConfig.FinalizationPeriod)
+}
+
+func Test_BridgeInfoProof_AttackerClient(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	clientState := setupL1Client(t, ctx, &input)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: testClientId,
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+		},
+	}
+
+	// an attacker creates a client of the same chain id and commits a forged
+	// bridge config in it
+	forged := info
+	forged.L1ClientId = attackerClientId
+	forged.BridgeConfig.Proposer = addrsStr[0]
+	bz, err := input.EncodingConfig.Marshaler.Marshal(&forged.BridgeConfig)
+	require.NoError(t, err)
+	path := commitmenttypes.NewMerklePath(ophosttypes.StoreKey, string(ophosttypes.BridgeConfigKey(1)))
+	attackerClient := &mockClientState{chainId: "test-chain-id", height: 100, values: map[string][]byte{path.String(): bz}}
+	input.OPChildKeeper.SetClientKeeper(&mockClientKeeper{map[string]*mockClientState{
+		testClientId:     clientState,
+		attackerClientId: attackerClient,
+	}})
+
+	// the bridge info setter relays the forged config with the attacker client
+	msg := types.NewMsgSetBridgeInfo(addrsStr[0], forged)
+	msg.ProofHeight = 100
+	msg.Proof = []byte("proof")
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBridgeInfo)
+
+	// the forged config is not stored in the client of the params
+	msg.BridgeInfo.L1ClientId = testClientId
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBridgeInfoProof)
+
+	// the client of the params must track the l1 chain id
+	clientState.values[path.String()] = bz
+	clientState.chainId = "other-chain-id"
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBridgeInfoProof)
+
+	clientState.chainId = "test-chain-id"
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.NoError(t, err)
+
+	// the proofs are rejected without the l1 client id
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.L1ClientId = ""
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	_, err = ms.SetBridgeInfo(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBridgeInfoProof)
+
+	// the proof required modes need the l1 client id
+	params.BridgeInfoProofRequired = true
+	require.ErrorIs(t, input.OPChildKeeper.SetParams(ctx, params), types.ErrInvalidL1ClientId)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// GetBaseDenom returns the l1 denom of the l2 denom. For the tokens
+// originated from l2, which must be listed in the l2 native denoms param,
+// the deterministic l1 representation denom is returned with isL2Native set
+// to true.
+func (k Keeper) GetBaseDenom(ctx context.Context, denom string) (baseDenom string, isL2Native bool, err error) {
+	baseDenom, err = k.DenomPairs.Get(ctx, denom)
+	if err == nil {
+		return baseDenom, false, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return "", false, err
+	}
+
+	// l2 native tokens can be withdrawn only after the bridge info is set
+	info, err := k.BridgeInfo.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return "", false, types.ErrNonL1Token
+	} else if err != nil {
+		return "", false, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	if !params.IsL2NativeDenom(denom) {
+		return "", false, types.ErrNonL1Token.Wrapf("%s is neither bridged from l1 nor an l2 native denom", denom)
+	}
+
+	return ophosttypes.L1Denom(info.BridgeId, denom), true, nil
+}
+
+// isL2NativeDeposit returns true if the deposit is returning a token
+// originated from l2, which is escrowed in the module account.
+func (k Keeper) isL2NativeDeposit(ctx context.Context, denom, baseDenom string) (bool, error) {
+	if ok, err := k.DenomPairs.Has(ctx, denom); err != nil {
+		return false, err
+	} else if ok {
+		return false, nil
+	}
+
+	info, err := k.BridgeInfo.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return ophosttypes.L1Denom(info.BridgeId, denom) == baseDenom, nil
+}
@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// GetValidatorSigningInfo returns the liveness information of the validator
+// with the consensus address.
+func (k Keeper) GetValidatorSigningInfo(ctx context.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, error) {
+	info, err := k.ValidatorSigningInfos.Get(ctx, consAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorSigningInfo{}, types.ErrNoSigningInfoFound.Wrap(consAddr.String())
+	}
+
+	return info, err
+}
+
+// SetValidatorSigningInfo stores the liveness information of the validator.
+func (k Keeper) SetValidatorSigningInfo(ctx context.Context, consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) error {
+	return k.ValidatorSigningInfos.Set(ctx, consAddr, info)
+}
+
+// IterateValidatorSigningInfos iterates over the liveness information of all
+// the validators.
+func (k Keeper) IterateValidatorSigningInfos(ctx context.Context, cb func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (bool, error)) error {
+	return k.ValidatorSigningInfos.Walk(ctx, nil, func(consAddr []byte, info types.ValidatorSigningInfo) (bool, error) {
+		return cb(consAddr, info)
+	})
+}
+
+// GetMissedBlockIndexes returns the indexes of the blocks missed by the
+// validator in the signed blocks window.
+func (k Keeper) GetMissedBlockIndexes(ctx context.Context, consAddr sdk.ConsAddress) ([]int64, error) {
+	indexes := []int64{}
+	err := k.ValidatorMissedBlocks.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](consAddr), func(key collections.Pair[[]byte, int64]) (bool, error) {
+		indexes = append(indexes, key.K2())
+		return false, nil
+	})
+
+	return indexes, err
+}
+
+// RemoveValidatorSigningInfo removes the liveness information of the validator.
+func (k Keeper) RemoveValidatorSigningInfo(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if err := k.clearMissedBlocks(ctx, consAddr); err != nil {
+		return err
+	}
+
+	return k.ValidatorSigningInfos.Remove(ctx, consAddr)
+}
+
+// moveValidatorSigningInfo moves the liveness information of the validator
+// to the new consensus address.
+func (k Keeper) moveValidatorSigningInfo(ctx context.Context, oldConsAddr, newConsAddr sdk.ConsAddress) error {
+	info, err := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if errors.Is(err, types.ErrNoSigningInfoFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	indexes, err := k.GetMissedBlockIndexes(ctx, oldConsAddr)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if err := k.ValidatorMissedBlocks.Set(ctx, collections.Join([]byte(newConsAddr), index)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RemoveValidatorSigningInfo(ctx, oldConsAddr); err != nil {
+		return err
+	}
+
+	info.Address, err = k.consensusAddressCodec.BytesToString(newConsAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.SetValidatorSigningInfo(ctx, newConsAddr, info)
+}
+
+func (k Keeper) clearMissedBlocks(ctx context.Context, consAddr sdk.ConsAddress) error {
+	indexes, err := k.GetMissedBlockIndexes(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		if err := k.ValidatorMissedBlocks.Remove(ctx, collections.Join([]byte(consAddr), index)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleValidatorSignatures records the signatures of the last commit and
+// jails the validators missing too many blocks in the signed blocks window.
+// Called in each BeginBlock
+func (k Keeper) HandleValidatorSignatures(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// liveness tracking disabled
+	if params.SignedBlocksWindow == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, voteInfo := range sdkCtx.VoteInfos() {
+		missed := comet.BlockIDFlag(voteInfo.BlockIdFlag) == comet.BlockIDFlagAbsent
+		if err := k.handleValidatorSignature(ctx, params, voteInfo.Validator.Address, missed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) handleValidatorSignature(ctx context.Context, params types.Params, addr []byte, missed bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+	consAddr := sdk.ConsAddress(addr)
+
+	// the validator can be removed after the last commit
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found || validator.Jailed {
+		return nil
+	}
+
+	consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	info, err := k.GetValidatorSigningInfo(ctx, consAddr)
+	if errors.Is(err, types.ErrNoSigningInfoFound) {
+		info = types.NewValidatorSigningInfo(consAddrStr, height)
+	} else if err != nil {
+		return err
+	}
+
+	// the index of the block in the signed blocks window
+	index := info.IndexOffset % params.SignedBlocksWindow
+	info.IndexOffset++
+
+	key := collections.Join([]byte(consAddr), index)
+	previous, err := k.ValidatorMissedBlocks.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !previous && missed:
+		if err := k.ValidatorMissedBlocks.Set(ctx, key); err != nil {
+			return err
+		}
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		if err := k.ValidatorMissedBlocks.Remove(ctx, key); err != nil {
+			return err
+		}
+		info.MissedBlocksCounter--
+	}
+
+	if missed {
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLiveness,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyConsAddress, consAddrStr),
+			sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(info.MissedBlocksCounter, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		))
+	}
+
+	// wait a full window before jailing the validator
+	minHeight := info.StartHeight + params.SignedBlocksWindow
+	if !params.DowntimeJailEnabled || height <= minHeight || info.MissedBlocksCounter <= params.MaxMissedBlocks() {
+		return k.SetValidatorSigningInfo(ctx, consAddr, info)
+	}
+
+	// never jail the last validator, otherwise the chain halts
+	activeValidators, err := k.countActiveValidators(ctx)
+	if err != nil {
+		return err
+	} else if activeValidators <= 1 {
+		k.Logger(ctx).Error("skip jailing the last active validator", "validator", validator.OperatorAddress)
+		return k.SetValidatorSigningInfo(ctx, consAddr, info)
+	}
+
+	validator.Jailed = true
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeJailValidator,
+		sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		sdk.NewAttribute(types.AttributeKeyConsAddress, consAddrStr),
+		sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(info.MissedBlocksCounter, 10)),
+	))
+
+	k.Logger(ctx).Info("validator jailed for downtime", "validator", validator.OperatorAddress, "missed_blocks", info.MissedBlocksCounter)
+
+	// reset the signing window; the tracking restarts when the validator
+	// is unjailed
+	if err := k.clearMissedBlocks(ctx, consAddr); err != nil {
+		return err
+	}
+
+	info.IndexOffset = 0
+	info.MissedBlocksCounter = 0
+	return k.SetValidatorSigningInfo(ctx, consAddr, info)
+}
+
+// countActiveValidators returns the number of the bonded validators which are
+// not jailed.
+func (k Keeper) countActiveValidators(ctx context.Context) (int, error) {
+	validators, err := k.GetLastValidators(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, validator := range validators {
+		if validator.ConsensusPower() > 0 {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// UnjailValidator restores the consensus power of the jailed validator and
+// restarts its liveness tracking.
+func (k Keeper) UnjailValidator(ctx context.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	} else if !validator.Jailed {
+		return types.ErrValidatorNotJailed
+	}
+
+	validator.Jailed = false
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clearMissedBlocks(ctx, consAddr); err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddrStr, height))
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func setLivenessTestValidators(t *testing.T, ctx sdk.Context, input TestKeepers, n int) []types.Validator {
+	valPubKeys := testutilsims.CreateTestPubKeys(n)
+
+	validators := make([]types.Validator, n)
+	for i := 0; i < n; i++ {
+		val, err := types.NewValidator(valAddrs[i+1], valPubKeys[i], "validator")
+		require.NoError(t, err)
+		require.NoError(t, input.OPChildKeeper.SetValidator(ctx, val))
+		require.NoError(t, input.OPChildKeeper.SetValidatorByConsAddr(ctx, val))
+		validators[i] = val
+	}
+
+	_, err := input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.SignedBlocksWindow = 10
+	params.MinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	params.DowntimeJailEnabled = true
+	require.NoError(t, input.OPChildKeeper.SetParams(ctx, params))
+
+	return validators
+}
+
+func voteInfo(t *testing.T, val types.Validator, flag cmtproto.BlockIDFlag) abci.VoteInfo {
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+
+	return abci.VoteInfo{
+		Validator:   abci.Validator{Address: consAddr, Power: val.ConsensusPower()},
+		BlockIdFlag: flag,
+	}
+}
+
+func Test_HandleValidatorSignatures(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validators := setLivenessTestValidators(t, sdkCtx, input, 2)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	consAddrStr := consAddr.String()
+
+	// validator 0 stops signing
+	startHeight := sdkCtx.BlockHeight()
+	for i := int64(0); i <= 10; i++ {
+		sdkCtx = sdkCtx.WithBlockHeight(startHeight + i).WithVoteInfos([]abci.VoteInfo{
+			voteInfo(t, validators[0], cmtproto.BlockIDFlagAbsent),
+			voteInfo(t, validators[1], cmtproto.BlockIDFlagCommit),
+		})
+		require.NoError(t, input.OPChildKeeper.HandleValidatorSignatures(sdkCtx))
+
+		// not jailed until the full window passes
+		val, found := input.OPChildKeeper.GetValidator(sdkCtx, valAddrs[1])
+		require.True(t, found)
+		require.False(t, val.Jailed)
+	}
+
+	res, err := querier.SigningInfo(sdkCtx, &types.QuerySigningInfoRequest{ConsAddress: consAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, startHeight, res.SigningInfo.StartHeight)
+	require.Equal(t, int64(10), res.SigningInfo.MissedBlocksCounter)
+
+	// jailed after the window
+	sdkCtx = sdkCtx.WithBlockHeight(startHeight + 11)
+	require.NoError(t, input.OPChildKeeper.HandleValidatorSignatures(sdkCtx))
+
+	val, found := input.OPChildKeeper.GetValidator(sdkCtx, valAddrs[1])
+	require.True(t, found)
+	require.True(t, val.Jailed)
+	require.Equal(t, int64(1), val.ConsPower)
+
+	res, err = querier.SigningInfo(sdkCtx, &types.QuerySigningInfoRequest{ConsAddress: consAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.SigningInfo.MissedBlocksCounter)
+
+	// removed from the consensus but kept in the store
+	updates, err := input.OPChildKeeper.BlockValidatorUpdates(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{validators[0].ABCIValidatorUpdateZero()}, updates)
+
+	_, found = input.OPChildKeeper.GetValidator(sdkCtx, valAddrs[1])
+	require.True(t, found)
+
+	// only the authority can unjail
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	_, err = ms.UnjailValidator(sdkCtx, types.NewMsgUnjailValidator(addrsStr[0], valAddrsStr[1]))
+	require.Error(t, err)
+
+	_, err = ms.UnjailValidator(sdkCtx, types.NewMsgUnjailValidator(moduleAddr, valAddrsStr[2]))
+	require.ErrorIs(t, err, types.ErrValidatorNotJailed)
+
+	_, err = ms.UnjailValidator(sdkCtx, types.NewMsgUnjailValidator(moduleAddr, valAddrsStr[1]))
+	require.NoError(t, err)
+
+	updates, err = input.OPChildKeeper.BlockValidatorUpdates(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{validators[0].ABCIValidatorUpdate()}, updates)
+
+	// the liveness tracking restarts
+	res, err = querier.SigningInfo(sdkCtx, &types.QuerySigningInfoRequest{ConsAddress: consAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, sdkCtx.BlockHeight(), res.SigningInfo.StartHeight)
+	require.Equal(t, int64(0), res.SigningInfo.IndexOffset)
+
+	infos, err := querier.SigningInfos(sdkCtx, &types.QuerySigningInfosRequest{})
+	require.NoError(t, err)
+	require.Len(t, infos.SigningInfos, 2)
+}
+
+func Test_HandleValidatorSignatures_LastValidator(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validators := setLivenessTestValidators(t, sdkCtx, input, 1)
+
+	startHeight := sdkCtx.BlockHeight()
+	for i := int64(0); i <= 20; i++ {
+		sdkCtx = sdkCtx.WithBlockHeight(startHeight + i).WithVoteInfos([]abci.VoteInfo{
+			voteInfo(t, validators[0], cmtproto.BlockIDFlagAbsent),
+		})
+		require.NoError(t, input.OPChildKeeper.HandleValidatorSignatures(sdkCtx))
+	}
+
+	// the last validator is never jailed
+	val, found := input.OPChildKeeper.GetValidator(sdkCtx, valAddrs[1])
+	require.True(t, found)
+	require.False(t, val.Jailed)
+}
+
+func Test_HandleValidatorSignatures_JailDisabled(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validators := setLivenessTestValidators(t, sdkCtx, input, 2)
+
+	params, err := input.OPChildKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.DowntimeJailEnabled = false
+	require.NoError(t, input.OPChildKeeper.SetParams(sdkCtx, params))
+
+	startHeight := sdkCtx.BlockHeight()
+	for i := int64(0); i <= 20; i++ {
+		sdkCtx = sdkCtx.WithBlockHeight(startHeight + i).WithVoteInfos([]abci.VoteInfo{
+			voteInfo(t, validators[0], cmtproto.BlockIDFlagAbsent),
+			voteInfo(t, validators[1], cmtproto.BlockIDFlagCommit),
+		})
+		require.NoError(t, input.OPChildKeeper.HandleValidatorSignatures(sdkCtx))
+	}
+
+	val, found := input.OPChildKeeper.GetValidator(sdkCtx, valAddrs[1])
+	require.True(t, found)
+	require.False(t, val.Jailed)
+
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	info, err := input.OPChildKeeper.GetValidatorSigningInfo(sdkCtx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), info.MissedBlocksCounter)
+}
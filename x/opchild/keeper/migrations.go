@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the finalized l1 sequences from the full set to the
+// next contiguous l1 sequence with the sparse set above it, and sets the
+// defaults of the params added since the version 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	nextL1Sequence := uint64(ophosttypes.DefaultL1SequenceStart)
+
+	var contiguous []uint64
+	if err := m.keeper.FinalizedL1Sequence.Walk(ctx, nil, func(l1Sequence uint64, _ bool) (stop bool, err error) {
+		if l1Sequence > nextL1Sequence {
+			return true, nil
+		}
+
+		contiguous = append(contiguous, l1Sequence)
+		nextL1Sequence = l1Sequence + 1
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, l1Sequence := range contiguous {
+		if err := m.keeper.FinalizedL1Sequence.Remove(ctx, l1Sequence); err != nil {
+			return err
+		}
+	}
+
+	if err := m.keeper.SetNextL1Sequence(ctx, nextL1Sequence); err != nil {
+		return err
+	}
+
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// an empty allowlist no longer allows all the messages
+	params.AllowedExecuteMessages = types.DefaultAllowedExecuteMessages()
+	params.AdminProposalExpiry = types.DefaultAdminProposalExpiry
+	params.SignedBlocksWindow = types.DefaultSignedBlocksWindow
+	params.MinSignedPerWindow = types.DefaultMinSignedPerWindow
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -3,9 +3,15 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"slices"
 	"strconv"
+	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +19,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 type MsgServer struct {
@@ -26,28 +33,28 @@ func NewMsgServerImpl(k Keeper) MsgServer {
 	return MsgServer{k}
 }
 
-// checkAdminPermission checks if the sender is the admin
+// checkAdminPermission checks if the sender is the admin or one of the admin signers
 func (ms MsgServer) checkAdminPermission(ctx context.Context, sender string) error {
 	params, err := ms.GetParams(ctx)
 	if err != nil {
 		return err
 	}
 
-	if params.Admin != sender {
-		return errors.Wrapf(sdkerrors.ErrUnauthorized, "the message is allowed to be executed by admin %s", params.Admin)
+	if !params.IsAdminSigner(sender) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "the message is allowed to be executed by admin %s or admin signers", params.Admin)
 	}
 
 	return nil
 }
 
-// checkBridgeExecutorPermission checks if the sender is the registered bridge executor to send messages
-func (ms MsgServer) checkBridgeExecutorPermission(ctx context.Context, sender string) error {
+// checkBridgeExecutorPermission checks if the sender is granted the bridge executor role to send messages
+func (ms MsgServer) checkBridgeExecutorPermission(ctx context.Context, sender string, role func(context.Context) ([]sdk.AccAddress, error)) error {
 	senderAddr, err := ms.authKeeper.AddressCodec().StringToBytes(sender)
 	if err != nil {
 		return err
 	}
 
-	bridgeExecutors, err := ms.BridgeExecutors(ctx)
+	bridgeExecutors, err := role(ctx)
 	if err != nil {
 		return err
 	}
@@ -77,60 +84,261 @@ func (ms MsgServer) ExecuteMessages(ctx context.Context, req *types.MsgExecuteMe
 		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	cacheCtx, writeCache := sdkCtx.CacheContext()
-	messages, err := req.GetMsgs()
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// wait for the approvals of the other admin signers
+	if params.AdminThreshold > 1 {
+		proposalId, err := ms.SubmitAdminProposal(ctx, req.Sender, req.ExecutionHeight, req.Messages)
+		if err != nil {
+			return nil, err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubmitAdminProposal,
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyAdminProposal, strconv.FormatUint(proposalId, 10)),
+		))
+
+		return &types.MsgExecuteMessagesResponse{AdminProposalId: proposalId}, nil
+	}
+
+	scheduledId, results, err := ms.dispatchExecuteMessages(ctx, req.Sender, req.ExecutionHeight, req.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteMessagesResponse{ScheduledId: scheduledId, Results: results}, nil
+}
+
+// ExecuteScheduledMessages implements executing the scheduled messages after the execution height
+func (ms MsgServer) ExecuteScheduledMessages(ctx context.Context, req *types.MsgExecuteScheduledMessages) (*types.MsgExecuteScheduledMessagesResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	// anyone can trigger the execution once the timelock is over
+	results, err := ms.Keeper.ExecuteScheduledMessages(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	authority, err := ms.authKeeper.AddressCodec().StringToBytes(ms.authority)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteScheduled,
+		sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyScheduledId, strconv.FormatUint(req.Id, 10)),
+	))
+
+	return &types.MsgExecuteScheduledMessagesResponse{Results: results}, nil
+}
+
+// CancelScheduledMessages implements cancelling the scheduled messages by the admin
+func (ms MsgServer) CancelScheduledMessages(ctx context.Context, req *types.MsgCancelScheduledMessages) (*types.MsgCancelScheduledMessagesResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	events := sdk.EmptyEvents()
-	for _, msg := range messages {
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return nil, errors.Wrap(types.ErrInvalidExecuteMsg, err.Error())
+	// executed by MsgExecuteMessages, which checks the allowed execute
+	// messages and the admin threshold
+	if req.Sender != ms.authority {
+		// permission check
+		if err := ms.checkAdminPermission(ctx, req.Sender); err != nil {
+			return nil, err
+		}
+
+		// wait for the approvals of the other admin signers
+		if params.AdminThreshold > 1 {
+			if found, err := ms.ScheduledMessages.Has(ctx, req.Id); err != nil {
+				return nil, err
+			} else if !found {
+				return nil, types.ErrScheduledMessagesNotFound
+			}
+
+			msg, err := codectypes.NewAnyWithValue(types.NewMsgCancelScheduledMessages(ms.authority, req.Id))
+			if err != nil {
+				return nil, err
+			}
+
+			proposalId, err := ms.SubmitAdminProposal(ctx, req.Sender, 0, []*codectypes.Any{msg})
+			if err != nil {
+				return nil, err
 			}
+
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSubmitAdminProposal,
+				sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+				sdk.NewAttribute(types.AttributeKeyAdminProposal, strconv.FormatUint(proposalId, 10)),
+			))
+
+			return &types.MsgCancelScheduledMessagesResponse{AdminProposalId: proposalId}, nil
+		}
+	}
+
+	if err := ms.Keeper.CancelScheduledMessages(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelScheduled,
+		sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyScheduledId, strconv.FormatUint(req.Id, 10)),
+	))
+
+	return &types.MsgCancelScheduledMessagesResponse{}, nil
+}
+
+// ApproveAdminProposal implements approving the admin proposal by an admin signer
+func (ms MsgServer) ApproveAdminProposal(ctx context.Context, req *types.MsgApproveAdminProposal) (*types.MsgApproveAdminProposalResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	// permission check
+	if err := ms.checkAdminPermission(ctx, req.Sender); err != nil {
+		return nil, err
+	}
+
+	proposal, passed, err := ms.Keeper.ApproveAdminProposal(ctx, req.Sender, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApproveAdminProposal,
+		sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyAdminProposal, strconv.FormatUint(req.ProposalId, 10)),
+		sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(proposal.Approvals))),
+		sdk.NewAttribute(types.AttributeKeyProposalPassed, strconv.FormatBool(passed)),
+	))
+
+	if !passed {
+		return &types.MsgApproveAdminProposalResponse{}, nil
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	// the cancellations take effect without waiting for the timelock
+	if !slices.ContainsFunc(msgs, func(msg sdk.Msg) bool { return !types.IsCancelScheduledMessagesMsg(msg) }) {
+		results, err := ms.executeMessages(ctx, msgs)
+		if err != nil {
+			return nil, err
 		}
 
-		signers, _, err := ms.cdc.GetMsgV1Signers(msg)
+		return &types.MsgApproveAdminProposalResponse{Passed: true, Results: results}, nil
+	}
+
+	// the requested height may have passed while collecting the approvals
+	executionHeight := proposal.ExecutionHeight
+	if executionHeight != 0 {
+		params, err := ms.GetParams(ctx)
 		if err != nil {
 			return nil, err
 		}
-		if len(signers) != 1 {
-			return nil, types.ErrInvalidSigner
+
+		earliestHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + params.ExecuteMessagesDelay
+		if executionHeight < earliestHeight {
+			executionHeight = earliestHeight
 		}
+	}
+
+	scheduledId, results, err := ms.dispatchExecuteMessages(ctx, proposal.Proposer, executionHeight, proposal.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveAdminProposalResponse{
+		Passed:      true,
+		ScheduledId: scheduledId,
+		Results:     results,
+	}, nil
+}
+
+// ProposeAdmin implements proposing the next admin by the current admin
+func (ms MsgServer) ProposeAdmin(ctx context.Context, req *types.MsgProposeAdmin) (*types.MsgProposeAdminResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
 
-		// assert that the opchild module account is the only signer for ExecuteMessages message
-		if !bytes.Equal(signers[0], authority) {
-			return nil, errors.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	// executed by MsgExecuteMessages, which checks the allowed execute
+	// messages and the admin threshold
+	case req.Sender == ms.authority:
+
+	// wait for the approvals of the other admin signers
+	case params.AdminThreshold > 1:
+		if err := ms.checkAdminPermission(ctx, req.Sender); err != nil {
+			return nil, err
 		}
 
-		handler := ms.Router().Handler(msg)
-		if handler == nil {
-			return nil, errors.Wrap(types.ErrUnroutableExecuteMsg, sdk.MsgTypeURL(msg))
+		msg, err := codectypes.NewAnyWithValue(types.NewMsgProposeAdmin(ms.authority, req.NewAdmin))
+		if err != nil {
+			return nil, err
 		}
 
-		var res *sdk.Result
-		res, err = handler(cacheCtx, msg)
+		proposalId, err := ms.SubmitAdminProposal(ctx, req.Sender, 0, []*codectypes.Any{msg})
 		if err != nil {
 			return nil, err
 		}
 
-		events = append(events, res.GetEvents()...)
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubmitAdminProposal,
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyAdminProposal, strconv.FormatUint(proposalId, 10)),
+		))
+
+		return &types.MsgProposeAdminResponse{AdminProposalId: proposalId}, nil
+
+	// only the admin can hand over the admin role
+	case params.Admin != req.Sender:
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "the message is allowed to be executed by admin %s", params.Admin)
+	}
+
+	if err := ms.PendingAdmin.Set(ctx, req.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeAdmin,
+		sdk.NewAttribute(types.AttributeKeyAdmin, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, req.NewAdmin),
+	))
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+// AcceptAdmin implements accepting the admin role by the proposed admin
+func (ms MsgServer) AcceptAdmin(ctx context.Context, req *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
 	}
 
-	writeCache()
+	prevAdmin, err := ms.Keeper.AcceptAdmin(ctx, req.Sender)
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO - merge events of MsgExecuteMessages itself
-	sdkCtx.EventManager().EmitEvents(events)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAcceptAdmin,
+		sdk.NewAttribute(types.AttributeKeyAdmin, prevAdmin),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, req.Sender),
+	))
 
-	return &types.MsgExecuteMessagesResponse{}, nil
+	return &types.MsgAcceptAdminResponse{}, nil
 }
 
 //////////////////////////////////////////////
@@ -176,26 +384,12 @@ func (ms MsgServer) AddValidator(ctx context.Context, req *types.MsgAddValidator
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
 	}
 
-	if _, found := ms.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
-		return nil, types.ErrValidatorPubKeyExists
+	if err := ms.checkConsAddrAvailable(ctx, valAddr, sdk.GetConsAddress(pk)); err != nil {
+		return nil, err
 	}
 
-	cp := sdkCtx.ConsensusParams()
-	if cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, errors.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := validateConsPubKeyType(sdkCtx, pk); err != nil {
+		return nil, err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, req.Moniker)
@@ -220,6 +414,29 @@ func (ms MsgServer) AddValidator(ctx context.Context, req *types.MsgAddValidator
 	return &types.MsgAddValidatorResponse{}, nil
 }
 
+// validateConsPubKeyType checks the pubkey type is allowed by the consensus params
+func validateConsPubKeyType(sdkCtx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := sdkCtx.ConsensusParams()
+	if cp.Validator != nil {
+		pkType := pk.Type()
+		hasKeyType := false
+		for _, keyType := range cp.Validator.PubKeyTypes {
+			if pkType == keyType {
+				hasKeyType = true
+				break
+			}
+		}
+		if !hasKeyType {
+			return errors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	return nil
+}
+
 // RemoveValidator implements removing a validator from the designated validator set
 func (ms MsgServer) RemoveValidator(ctx context.Context, req *types.MsgRemoveValidator) (*types.MsgRemoveValidatorResponse, error) {
 	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
@@ -258,14 +475,9 @@ func (ms MsgServer) RemoveValidator(ctx context.Context, req *types.MsgRemoveVal
 	return &types.MsgRemoveValidatorResponse{}, nil
 }
 
-// UpdateParams implements updating the parameters
-func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	// sort the min gas prices
-	if req.Params != nil && req.Params.MinGasPrices != nil {
-		req.Params.MinGasPrices = req.Params.MinGasPrices.Sort()
-	}
-
-	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+// UnjailValidator implements restoring the consensus power of a jailed validator
+func (ms MsgServer) UnjailValidator(ctx context.Context, req *types.MsgUnjailValidator) (*types.MsgUnjailValidatorResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
 		return nil, err
 	}
 
@@ -273,16 +485,26 @@ func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
 	}
 
-	if err := ms.SetParams(ctx, *req.Params); err != nil {
+	valAddr, err := ms.Keeper.validatorAddressCodec.StringToBytes(req.ValidatorAddress)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	if err := ms.Keeper.UnjailValidator(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnjailValidator,
+		sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+	))
+
+	return &types.MsgUnjailValidatorResponse{}, nil
 }
 
-// SpendFeePool implements MsgServer interface.
-func (ms MsgServer) SpendFeePool(ctx context.Context, req *types.MsgSpendFeePool) (*types.MsgSpendFeePoolResponse, error) {
-	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+// RotateConsPubKey implements replacing the consensus pubkey of a validator
+func (ms MsgServer) RotateConsPubKey(ctx context.Context, req *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
 		return nil, err
 	}
 
@@ -290,139 +512,374 @@ func (ms MsgServer) SpendFeePool(ctx context.Context, req *types.MsgSpendFeePool
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
 	}
 
-	recipientAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := ms.Keeper.validatorAddressCodec.StringToBytes(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	// send collected fees to the recipient address
-	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipientAddr, req.Amount); err != nil {
-		return nil, err
+	pk, ok := req.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
 	}
 
-	return &types.MsgSpendFeePoolResponse{}, nil
-}
-
-/////////////////////////////////////////////////////
-// The messages for Bridge Executor
-
-func (ms MsgServer) SetBridgeInfo(ctx context.Context, req *types.MsgSetBridgeInfo) (*types.MsgSetBridgeInfoResponse, error) {
-	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+	if err := validateConsPubKeyType(sdkCtx, pk); err != nil {
 		return nil, err
 	}
 
-	// permission check
-	if err := ms.checkBridgeExecutorPermission(ctx, req.Sender); err != nil {
+	oldConsAddr, err := ms.Keeper.RotateConsPubKey(ctx, valAddr, pk)
+	if err != nil {
 		return nil, err
 	}
 
-	// check bridge id and addr consistency
-	if ok, err := ms.BridgeInfo.Has(ctx); err != nil {
+	oldConsAddrStr, err := ms.consensusAddressCodec.BytesToString(oldConsAddr)
+	if err != nil {
 		return nil, err
-	} else if ok {
-		info, err := ms.BridgeInfo.Get(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		if info.BridgeId != req.BridgeInfo.BridgeId {
-			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected bridge id %d, got %d", info.BridgeId, req.BridgeInfo.BridgeId)
-		}
-
-		if info.BridgeAddr != req.BridgeInfo.BridgeAddr {
-			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected bridge addr %s, got %s", info.BridgeAddr, req.BridgeInfo.BridgeAddr)
-		}
-
-		if info.L1ChainId != req.BridgeInfo.L1ChainId {
-			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected l1 chain id %s, got %s", info.L1ChainId, req.BridgeInfo.L1ChainId)
-		}
-
-		if info.L1ClientId != "" && info.L1ClientId != req.BridgeInfo.L1ClientId {
-			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected l1 client id %s, got %s", info.L1ClientId, req.BridgeInfo.L1ClientId)
-		}
 	}
-
-	// set bridge info
-	if err := ms.BridgeInfo.Set(ctx, req.BridgeInfo); err != nil {
+	consAddrStr, err := ms.consensusAddressCodec.BytesToString(sdk.GetConsAddress(pk))
+	if err != nil {
 		return nil, err
 	}
 
-	// emit event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSetBridgeInfo,
-			sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(req.BridgeInfo.BridgeId, 10)),
-			sdk.NewAttribute(types.AttributeKeyBridgeAddr, req.BridgeInfo.BridgeAddr),
-			sdk.NewAttribute(types.AttributeKeyL1ChainId, req.BridgeInfo.L1ChainId),
-			sdk.NewAttribute(types.AttributeKeyL1ClientId, req.BridgeInfo.L1ClientId),
-		),
-	)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRotateConsPubKey,
+		sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+		sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddrStr),
+		sdk.NewAttribute(types.AttributeKeyConsAddress, consAddrStr),
+	))
 
-	return &types.MsgSetBridgeInfoResponse{}, nil
+	return &types.MsgRotateConsPubKeyResponse{}, nil
 }
 
-// FinalizeTokenDeposit implements send a deposit from the upper layer to the recipient
-func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFinalizeTokenDeposit) (*types.MsgFinalizeTokenDepositResponse, error) {
-	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
-		return nil, err
-	}
-
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	coin := req.Amount
-
-	// permission check
-	if err := ms.checkBridgeExecutorPermission(ctx, req.Sender); err != nil {
+// UpdateValidatorPower implements changing the consensus power of a validator
+func (ms MsgServer) UpdateValidatorPower(ctx context.Context, req *types.MsgUpdateValidatorPower) (*types.MsgUpdateValidatorPowerResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
 		return nil, err
 	}
 
-	// check already finalized
-	if ok, err := ms.HasFinalizedL1Sequence(ctx, req.Sequence); err != nil {
-		return nil, err
-	} else if ok {
-		return nil, types.ErrDepositAlreadyFinalized
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
 	}
 
-	fromAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.From)
+	valAddr, err := ms.Keeper.validatorAddressCodec.StringToBytes(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	toAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.To)
-	if err != nil {
+	if err := ms.Keeper.UpdateValidatorPower(ctx, valAddr, req.Power); err != nil {
 		return nil, err
 	}
 
-	coins := sdk.NewCoins(coin)
-	if err := ms.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateValidatorPower,
+		sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+		sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(req.Power, 10)),
+	))
+
+	return &types.MsgUpdateValidatorPowerResponse{}, nil
+}
+
+// SetValidatorSet implements replacing the validator set with the target set
+func (ms MsgServer) SetValidatorSet(ctx context.Context, req *types.MsgSetValidatorSet) (*types.MsgSetValidatorSetResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
 		return nil, err
 	}
 
-	err = ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, coins)
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, val := range req.Validators {
+		pk, ok := val.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+		}
+
+		if err := validateConsPubKeyType(sdkCtx, pk); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.Keeper.SetValidatorSet(ctx, req.Validators); err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, len(req.Validators))
+	for i, val := range req.Validators {
+		validators[i] = val.ValidatorAddress
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetValidatorSet,
+		sdk.NewAttribute(types.AttributeKeyValidators, strings.Join(validators, ",")),
+	))
+
+	return &types.MsgSetValidatorSetResponse{}, nil
+}
+
+// UpdateParams implements updating the parameters
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// sort the min gas prices
+	if req.Params != nil && req.Params.MinGasPrices != nil {
+		req.Params.MinGasPrices = req.Params.MinGasPrices.Sort()
+	}
+
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	// the registered executor change plans must stay applicable
+	if err := ms.ValidateExecutorChangePlans(ctx, *req.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetParams(ctx, *req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SpendFeePool implements MsgServer interface.
+func (ms MsgServer) SpendFeePool(ctx context.Context, req *types.MsgSpendFeePool) (*types.MsgSpendFeePoolResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	recipientAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.Recipient)
 	if err != nil {
-		ms.Logger(ctx).Error("failed to finalize token deposit", "error", err)
+		return nil, err
+	}
 
-		// refund the deposit to the sender address
-		if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fromAddr, coins); err != nil {
+	// send collected fees to the recipient address
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipientAddr, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSpendFeePoolResponse{}, nil
+}
+
+/////////////////////////////////////////////////////
+// The messages for Bridge Executor
+
+func (ms MsgServer) SetBridgeInfo(ctx context.Context, req *types.MsgSetBridgeInfo) (*types.MsgSetBridgeInfoResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	// permission check
+	if err := ms.checkBridgeExecutorPermission(ctx, req.Sender, ms.BridgeInfoSetters); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the l1 client is fixed by the params
+	if params.L1ClientId != "" && params.L1ClientId != req.BridgeInfo.L1ClientId {
+		return nil, types.ErrInvalidBridgeInfo.Wrapf("expected l1 client id %s, got %s", params.L1ClientId, req.BridgeInfo.L1ClientId)
+	}
+
+	// verify the bridge config against the l1 state
+	if len(req.Proof) > 0 {
+		if err := ms.verifyBridgeInfoProof(ctx, req.BridgeInfo, req.ProofHeight, req.Proof); err != nil {
 			return nil, err
 		}
+	} else if params.BridgeInfoProofRequired {
+		return nil, types.ErrBridgeInfoProofRequired
 	}
 
-	if err := ms.RecordFinalizedL1Sequence(ctx, req.Sequence); err != nil {
+	// check bridge id and addr consistency
+	configChanged := false
+	if ok, err := ms.BridgeInfo.Has(ctx); err != nil {
 		return nil, err
+	} else if ok {
+		info, err := ms.BridgeInfo.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.BridgeId != req.BridgeInfo.BridgeId {
+			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected bridge id %d, got %d", info.BridgeId, req.BridgeInfo.BridgeId)
+		}
+
+		if info.BridgeAddr != req.BridgeInfo.BridgeAddr {
+			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected bridge addr %s, got %s", info.BridgeAddr, req.BridgeInfo.BridgeAddr)
+		}
+
+		if info.L1ChainId != req.BridgeInfo.L1ChainId {
+			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected l1 chain id %s, got %s", info.L1ChainId, req.BridgeInfo.L1ChainId)
+		}
+
+		if info.L1ClientId != "" && info.L1ClientId != req.BridgeInfo.L1ClientId {
+			return nil, types.ErrInvalidBridgeInfo.Wrapf("expected l1 client id %s, got %s", info.L1ClientId, req.BridgeInfo.L1ClientId)
+		}
+
+		configChanged = info.BridgeConfig.SubmissionInterval != req.BridgeInfo.BridgeConfig.SubmissionInterval ||
+			!info.BridgeConfig.SubmissionStartTime.Equal(req.BridgeInfo.BridgeConfig.SubmissionStartTime)
 	}
 
-	// register denom metadata
-	if ok := ms.bankKeeper.HasDenomMetaData(ctx, coin.Denom); !ok {
-		ms.setDenomMetadata(ctx, req.BaseDenom, coin.Denom)
+	// set bridge info
+	if err := ms.BridgeInfo.Set(ctx, req.BridgeInfo); err != nil {
+		return nil, err
 	}
 
-	// register denom pair
-	if ok, err := ms.DenomPairs.Has(ctx, coin.Denom); err != nil {
+	// the current output window ends at the next submission time of the
+	// updated config
+	if configChanged {
+		if err := ms.updateWithdrawalWindowEndTime(ctx, req.BridgeInfo.BridgeConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	// emit event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBridgeInfo,
+			sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(req.BridgeInfo.BridgeId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBridgeAddr, req.BridgeInfo.BridgeAddr),
+			sdk.NewAttribute(types.AttributeKeyL1ChainId, req.BridgeInfo.L1ChainId),
+			sdk.NewAttribute(types.AttributeKeyL1ClientId, req.BridgeInfo.L1ClientId),
+		),
+	)
+
+	return &types.MsgSetBridgeInfoResponse{}, nil
+}
+
+// FinalizeTokenDeposit implements send a deposit from the upper layer to the recipient
+func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFinalizeTokenDeposit) (*types.MsgFinalizeTokenDepositResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
 		return nil, err
-	} else if !ok {
-		if err := ms.DenomPairs.Set(ctx, coin.Denom, req.BaseDenom); err != nil {
+	}
+
+	// permission check
+	proven := len(req.Proof) > 0
+	if err := ms.checkDepositRelayer(ctx, req.Sender, proven); err != nil {
+		return nil, err
+	}
+
+	// check already finalized
+	if ok, err := ms.HasFinalizedL1Sequence(ctx, req.Sequence); err != nil {
+		return nil, err
+	} else if ok {
+		return nil, types.ErrDepositAlreadyFinalized
+	}
+
+	if proven {
+		// verify the deposit against the l1 state
+		info, err := ms.BridgeInfo.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		commitment, err := req.DepositCommitment(info.BridgeId)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.verifyDepositProof(ctx, info, req.Sequence, req.ProofHeight, req.Proof, commitment); err != nil {
+			return nil, err
+		}
+	} else {
+		// wait for the other bridge executors in the threshold mode
+		depositHash, err := req.AttestationHash()
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := ms.attestDeposit(ctx, req.Sequence, req.Sender, depositHash); err != nil {
 			return nil, err
+		} else if !ok {
+			return &types.MsgFinalizeTokenDepositResponse{}, nil
+		}
+	}
+
+	// buffer the deposit arrived out of order in the strict ordering mode
+	if ok, err := ms.shouldBufferDeposit(ctx, req.Sequence); err != nil {
+		return nil, err
+	} else if ok {
+		if err := ms.bufferDeposit(ctx, req.Sequence, req); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgFinalizeTokenDepositResponse{}, nil
+	}
+
+	if err := ms.finalizeTokenDeposit(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := ms.finalizeBufferedDeposits(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinalizeTokenDepositResponse{}, nil
+}
+
+// finalizeTokenDeposit mints or releases the deposited tokens to the recipient.
+func (ms MsgServer) finalizeTokenDeposit(ctx context.Context, req *types.MsgFinalizeTokenDeposit) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	coin := req.Amount
+
+	fromAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.From)
+	if err != nil {
+		return err
+	}
+
+	toAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.To)
+	if err != nil {
+		return err
+	}
+
+	isL2Native, err := ms.isL2NativeDeposit(ctx, coin.Denom, req.BaseDenom)
+	if err != nil {
+		return err
+	}
+
+	// tokens originated from l2 are released from the escrow instead of minting
+	coins := sdk.NewCoins(coin)
+	if !isL2Native {
+		if err := ms.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	err = ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, coins)
+	if err != nil {
+		ms.Logger(ctx).Error("failed to finalize token deposit", "error", err)
+
+		// refund the deposit to the sender address
+		if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fromAddr, coins); err != nil {
+			return err
+		}
+	}
+
+	if err := ms.RecordFinalizedL1Sequence(ctx, req.Sequence); err != nil {
+		return err
+	}
+
+	if !isL2Native {
+		// register denom metadata
+		if ok := ms.bankKeeper.HasDenomMetaData(ctx, coin.Denom); !ok {
+			ms.setDenomMetadata(ctx, req.BaseDenom, coin.Denom, req.Metadata)
+		}
+
+		// register denom pair
+		if ok, err := ms.DenomPairs.Has(ctx, coin.Denom); err != nil {
+			return err
+		} else if !ok {
+			if err := ms.DenomPairs.Set(ctx, coin.Denom, req.BaseDenom); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -450,7 +907,183 @@ func (ms MsgServer) FinalizeTokenDeposit(ctx context.Context, req *types.MsgFina
 	}
 	sdkCtx.EventManager().EmitEvent(event)
 
-	return &types.MsgFinalizeTokenDepositResponse{}, nil
+	return nil
+}
+
+// FinalizeNftDeposit implements minting a wrapped nft of the nft locked on the l1 bridge
+func (ms MsgServer) FinalizeNftDeposit(ctx context.Context, req *types.MsgFinalizeNftDeposit) (*types.MsgFinalizeNftDepositResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	// permission check
+	proven := len(req.Proof) > 0
+	if err := ms.checkDepositRelayer(ctx, req.Sender, proven); err != nil {
+		return nil, err
+	}
+
+	// check already finalized
+	if ok, err := ms.HasFinalizedL1Sequence(ctx, req.Sequence); err != nil {
+		return nil, err
+	} else if ok {
+		return nil, types.ErrDepositAlreadyFinalized
+	}
+
+	// the wrapped class id must be derived from the l1 class id
+	info, err := ms.BridgeInfo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ophosttypes.L2ClassId(info.BridgeId, req.BaseClassId) != req.ClassId {
+		return nil, types.ErrInvalidNft.Wrapf("class id %s is not derived from %s", req.ClassId, req.BaseClassId)
+	}
+
+	if proven {
+		// verify the deposit against the l1 state
+		if err := ms.verifyDepositProof(ctx, info, req.Sequence, req.ProofHeight, req.Proof, req.DepositCommitment(info.BridgeId)); err != nil {
+			return nil, err
+		}
+	} else {
+		// wait for the other bridge executors in the threshold mode
+		depositHash, err := req.AttestationHash()
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := ms.attestDeposit(ctx, req.Sequence, req.Sender, depositHash); err != nil {
+			return nil, err
+		} else if !ok {
+			return &types.MsgFinalizeNftDepositResponse{}, nil
+		}
+	}
+
+	// buffer the deposit arrived out of order in the strict ordering mode
+	if ok, err := ms.shouldBufferDeposit(ctx, req.Sequence); err != nil {
+		return nil, err
+	} else if ok {
+		if err := ms.bufferDeposit(ctx, req.Sequence, req); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgFinalizeNftDepositResponse{}, nil
+	}
+
+	if err := ms.finalizeNftDeposit(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := ms.finalizeBufferedDeposits(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinalizeNftDepositResponse{}, nil
+}
+
+// finalizeNftDeposit mints the wrapped nft to the recipient. A failed mint
+// still finalizes the deposit, so the following deposits are not blocked in
+// the strict ordering mode, and is recorded with the mint_success attribute.
+func (ms MsgServer) finalizeNftDeposit(ctx context.Context, req *types.MsgFinalizeNftDeposit) error {
+	toAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.To)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	mintCtx, write := sdkCtx.CacheContext()
+	mintErr := ms.mintNft(mintCtx, req, toAddr)
+	if mintErr != nil {
+		ms.Logger(ctx).Error("failed to finalize nft deposit", "l1_sequence", req.Sequence, "error", mintErr)
+	} else {
+		write()
+	}
+
+	if err := ms.RecordFinalizedL1Sequence(ctx, req.Sequence); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFinalizeNftDeposit,
+		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(req.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, req.From),
+		sdk.NewAttribute(types.AttributeKeyRecipient, req.To),
+		sdk.NewAttribute(types.AttributeKeyClassId, req.ClassId),
+		sdk.NewAttribute(types.AttributeKeyBaseClassId, req.BaseClassId),
+		sdk.NewAttribute(types.AttributeKeyTokenId, req.TokenId),
+		sdk.NewAttribute(types.AttributeKeyFinalizeHeight, strconv.FormatUint(req.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyMintSuccess, strconv.FormatBool(mintErr == nil)),
+	))
+
+	return nil
+}
+
+// mintNft mints the wrapped nft to the recipient, creating the wrapped class
+// on the first deposit of the class.
+func (ms MsgServer) mintNft(ctx context.Context, req *types.MsgFinalizeNftDeposit, toAddr sdk.AccAddress) error {
+	if !ms.nftKeeper.HasClass(ctx, req.ClassId) {
+		if err := ms.nftKeeper.SaveClass(ctx, nft.Class{
+			Id:     req.ClassId,
+			Name:   req.ClassName,
+			Symbol: req.ClassSymbol,
+			Uri:    req.ClassUri,
+		}); err != nil {
+			return err
+		}
+
+		if err := ms.NftClassPairs.Set(ctx, req.ClassId, req.BaseClassId); err != nil {
+			return err
+		}
+	}
+
+	return ms.nftKeeper.Mint(ctx, nft.NFT{
+		ClassId: req.ClassId,
+		Id:      req.TokenId,
+		Uri:     req.TokenUri,
+	}, toAddr)
+}
+
+// finalizeBufferedDeposits finalizes the buffered deposits following the
+// contiguous finalized deposits. A buffered deposit failed to be finalized
+// is consumed with a fail_buffered_deposit event, so it does not block the
+// following deposits in the strict ordering mode.
+func (ms MsgServer) finalizeBufferedDeposits(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for {
+		nextL1Sequence, err := ms.GetNextL1Sequence(ctx)
+		if err != nil {
+			return err
+		}
+
+		msg, err := ms.BufferedDeposits.Get(ctx, nextL1Sequence)
+		if err != nil && errors.IsOf(err, collections.ErrNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		switch msg := msg.(type) {
+		case *types.MsgFinalizeTokenDeposit:
+			err = ms.finalizeTokenDeposit(cacheCtx, msg)
+		case *types.MsgFinalizeNftDeposit:
+			err = ms.finalizeNftDeposit(cacheCtx, msg)
+		default:
+			err = sdkerrors.ErrInvalidType.Wrapf("unexpected buffered deposit %T", msg)
+		}
+		if err == nil {
+			write()
+			continue
+		}
+
+		ms.Logger(ctx).Error("failed to finalize buffered deposit", "l1_sequence", nextL1Sequence, "error", err)
+		if err := ms.RecordFinalizedL1Sequence(ctx, nextL1Sequence); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFailBufferedDeposit,
+			sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(nextL1Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+	}
 }
 
 /////////////////////////////////////////////////////
@@ -465,11 +1098,10 @@ func (ms MsgServer) InitiateTokenWithdrawal(ctx context.Context, req *types.MsgI
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	coin := req.Amount
 
-	// check denom pair existence
-	if ok, err := ms.DenomPairs.Has(ctx, coin.Denom); err != nil {
+	// tokens without a denom pair are originated from l2
+	baseDenom, isL2Native, err := ms.GetBaseDenom(ctx, coin.Denom)
+	if err != nil {
 		return nil, err
-	} else if !ok {
-		return nil, types.ErrNonL1Token
 	}
 
 	senderAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
@@ -485,7 +1117,26 @@ func (ms MsgServer) InitiateTokenWithdrawal(ctx context.Context, req *types.MsgI
 	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, coins); err != nil {
 		return nil, err
 	}
-	if err := ms.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+
+	// tokens originated from l2 are escrowed in the module account
+	if !isL2Native {
+		if err := ms.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+	}
+
+	leaf := types.WithdrawalLeaf{
+		Sequence:    l2Sequence,
+		From:        req.Sender,
+		To:          req.To,
+		BaseDenom:   baseDenom,
+		Amount:      coin.Amount,
+		LeafVersion: uint32(ophosttypes.WithdrawalLeafVersionV2),
+	}
+	if isL2Native {
+		leaf.L2Denom = coin.Denom
+	}
+	if err := ms.recordWithdrawalLeaf(ctx, leaf); err != nil {
 		return nil, err
 	}
 
@@ -494,6 +1145,7 @@ func (ms MsgServer) InitiateTokenWithdrawal(ctx context.Context, req *types.MsgI
 		sdk.NewAttribute(types.AttributeKeyFrom, req.Sender),
 		sdk.NewAttribute(types.AttributeKeyTo, req.To),
 		sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+		sdk.NewAttribute(types.AttributeKeyBaseDenom, baseDenom),
 		sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
 		sdk.NewAttribute(types.AttributeKeyL2Sequence, strconv.FormatUint(l2Sequence, 10)),
 	))
@@ -501,13 +1153,109 @@ func (ms MsgServer) InitiateTokenWithdrawal(ctx context.Context, req *types.MsgI
 	return &types.MsgInitiateTokenWithdrawalResponse{}, nil
 }
 
+// UpdateDenomMetadata implements correcting the denom metadata of a bridged token.
+func (ms MsgServer) UpdateDenomMetadata(ctx context.Context, req *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	// only the metadata of bridged tokens can be updated
+	denom := req.Metadata.Base
+	if ok, err := ms.DenomPairs.Has(ctx, denom); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, types.ErrInvalidDenomMetadata.Wrapf("%s is not a bridged denom", denom)
+	}
+
+	ms.bankKeeper.SetDenomMetaData(ctx, req.Metadata)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateDenomMetadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+	))
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+// RegisterExecutorChangePlan implements registering a plan to change the validator and the bridge executors
+func (ms MsgServer) RegisterExecutorChangePlan(ctx context.Context, req *types.MsgRegisterExecutorChangePlan) (*types.MsgRegisterExecutorChangePlanResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec(), ms.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	valAddr, err := ms.validatorAddressCodec.StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := req.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	validator, err := types.NewValidator(valAddr, pk, req.Moniker)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetExecutorChangePlan(ctx, types.ExecutorChangePlan{
+		ProposalID:    req.ProposalID,
+		Height:        req.Height,
+		NextExecutors: req.NextExecutors,
+		NextValidator: validator,
+		Info:          req.Info,
+	}); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterExecutorChange,
+		sdk.NewAttribute(types.AttributeKeyProposalId, strconv.FormatUint(req.ProposalID, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(req.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+		sdk.NewAttribute(types.AttributeKeyExecutors, strings.Join(req.NextExecutors, ",")),
+	))
+
+	return &types.MsgRegisterExecutorChangePlanResponse{}, nil
+}
+
+// CancelExecutorChangePlan implements cancelling a registered executor change plan
+func (ms MsgServer) CancelExecutorChangePlan(ctx context.Context, req *types.MsgCancelExecutorChangePlan) (*types.MsgCancelExecutorChangePlanResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	if err := ms.Keeper.CancelExecutorChangePlan(ctx, req.Height); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelExecutorChange,
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(req.Height, 10)),
+	))
+
+	return &types.MsgCancelExecutorChangePlanResponse{}, nil
+}
+
 func (ms MsgServer) UpdateOracle(ctx context.Context, req *types.MsgUpdateOracle) (*types.MsgUpdateOracleResponse, error) {
 	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
 		return nil, err
 	}
 
 	// permission check
-	if err := ms.checkBridgeExecutorPermission(ctx, req.Sender); err != nil {
+	if err := ms.checkBridgeExecutorPermission(ctx, req.Sender, ms.OracleRelayers); err != nil {
 		return nil, err
 	}
 
@@ -525,3 +1273,58 @@ func (ms MsgServer) UpdateOracle(ctx context.Context, req *types.MsgUpdateOracle
 
 	return &types.MsgUpdateOracleResponse{}, nil
 }
+
+// InitiateNftWithdrawal implements burning a wrapped nft to release the original nft on l1
+func (ms MsgServer) InitiateNftWithdrawal(ctx context.Context, req *types.MsgInitiateNftWithdrawal) (*types.MsgInitiateNftWithdrawalResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	// only the wrapped nfts can be withdrawn
+	baseClassId, err := ms.GetBaseClassId(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner := ms.nftKeeper.GetOwner(ctx, req.ClassId, req.TokenId); !owner.Equals(sdk.AccAddress(senderAddr)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the owner of nft %s/%s", req.Sender, req.ClassId, req.TokenId)
+	}
+
+	l2Sequence, err := ms.IncreaseNextL2Sequence(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.nftKeeper.Burn(ctx, req.ClassId, req.TokenId); err != nil {
+		return nil, err
+	}
+
+	if err := ms.recordWithdrawalLeaf(ctx, types.WithdrawalLeaf{
+		Sequence:    l2Sequence,
+		From:        req.Sender,
+		To:          req.To,
+		Amount:      math.ZeroInt(),
+		BaseClassId: baseClassId,
+		TokenId:     req.TokenId,
+	}); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInitiateNftWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyFrom, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyTo, req.To),
+		sdk.NewAttribute(types.AttributeKeyClassId, req.ClassId),
+		sdk.NewAttribute(types.AttributeKeyBaseClassId, baseClassId),
+		sdk.NewAttribute(types.AttributeKeyTokenId, req.TokenId),
+		sdk.NewAttribute(types.AttributeKeyL2Sequence, strconv.FormatUint(l2Sequence, 10)),
+	))
+
+	return &types.MsgInitiateNftWithdrawalResponse{
+		Sequence: l2Sequence,
+	}, nil
+}
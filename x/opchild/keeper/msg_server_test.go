@@ -10,9 +10,12 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
 	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -105,6 +108,69 @@ func Test_MsgServer_ExecuteMessages(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_MsgServer_ExecuteMessages_AllowedMessages(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+
+	// admin to 0 and only the validator messages are allowed
+	params.Admin = addrsStr[0]
+	params.AllowedExecuteMessages = []string{sdk.MsgTypeURL(&types.MsgAddValidator{})}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	// not allowed
+	updateParamsMsg := types.NewMsgUpdateParams(moduleAddr, &params)
+	msg, err := types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{updateParamsMsg})
+	require.NoError(t, err)
+	_, err = ms.ExecuteMessages(ctx, msg)
+	require.ErrorIs(t, err, types.ErrExecuteMsgNotAllowed)
+
+	// allowed, and the results are returned per message
+	addMsg, err := types.NewMsgAddValidator("val1", moduleAddr, valAddrsStr[0], valPubKeys[0])
+	require.NoError(t, err)
+	msg, err = types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+	res, err := ms.ExecuteMessages(sdkCtx, msg)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 1)
+	require.Equal(t, sdk.MsgTypeURL(addMsg), res.Results[0].TypeUrl)
+	require.Len(t, res.Results[0].MsgResponses, 1)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgAddValidatorResponse{}), res.Results[0].MsgResponses[0].TypeUrl)
+
+	// the inner events are attributed with the message index
+	found := false
+	for _, event := range sdkCtx.EventManager().Events() {
+		if event.Type != types.EventTypeAddValidator {
+			continue
+		}
+
+		found = true
+		attr, ok := event.GetAttribute(types.AttributeKeyMsgIndex)
+		require.True(t, ok)
+		require.Equal(t, "0", attr.Value)
+	}
+	require.True(t, found)
+
+	// empty allowlist denies all the messages
+	params.AllowedExecuteMessages = []string{}
+	require.NoError(t, ms.SetParams(ctx, params))
+	_, err = ms.ExecuteMessages(ctx, msg)
+	require.ErrorIs(t, err, types.ErrExecuteMsgNotAllowed)
+
+	// invalid allowlist
+	params.AllowedExecuteMessages = []string{"opchild.MsgAddValidator"}
+	require.Error(t, params.Validate(input.AccountKeeper.AddressCodec()))
+}
+
 /////////////////////////////////////////
 // The messages for Authority
 
@@ -211,6 +277,250 @@ func Test_MsgServer_RemoveValidator(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_MsgServer_RotateConsPubKey(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(4)
+
+	// register and bond validators
+	val1, err := types.NewValidator(valAddrs[0], valPubKeys[0], "val1")
+	require.NoError(t, err)
+	val2, err := types.NewValidator(valAddrs[1], valPubKeys[1], "val2")
+	require.NoError(t, err)
+	for _, val := range []types.Validator{val1, val2} {
+		require.NoError(t, input.OPChildKeeper.SetValidator(ctx, val))
+		require.NoError(t, input.OPChildKeeper.SetValidatorByConsAddr(ctx, val))
+	}
+	_, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	// invalid signer
+	msg, err := types.NewMsgRotateConsPubKey(addrsStr[0], valAddrsStr[0], valPubKeys[2])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.Error(t, err)
+
+	// pubkey used by another validator
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[0], valPubKeys[1])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	// rotate twice in the same block
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[0], valPubKeys[2])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[0], valPubKeys[3])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	_, found := input.OPChildKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(valPubKeys[0]))
+	require.False(t, found)
+	_, found = input.OPChildKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(valPubKeys[2]))
+	require.False(t, found)
+	rotated, found := input.OPChildKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(valPubKeys[3]))
+	require.True(t, found)
+	require.Equal(t, valAddrsStr[0], rotated.OperatorAddress)
+
+	// the pubkey rotated away stays known to the consensus engine until the
+	// end of the block
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[1], valPubKeys[0])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	addMsg, err := types.NewMsgAddValidator("val3", moduleAddr, valAddrsStr[2], valPubKeys[0])
+	require.NoError(t, err)
+	_, err = ms.AddValidator(ctx, addMsg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	// the pubkey known to the consensus engine is replaced in the same block
+	updates, err := input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []cometabci.ValidatorUpdate{val1.ABCIValidatorUpdateZero(), rotated.ABCIValidatorUpdate()}, updates)
+
+	updates, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// rotating back in the same block has no updates
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[1], valPubKeys[0])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[1], valPubKeys[1])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	updates, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// removed in the same block removes the previous pubkey
+	msg, err = types.NewMsgRotateConsPubKey(moduleAddr, valAddrsStr[1], valPubKeys[2])
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	removeMsg, err := types.NewMsgRemoveValidator(moduleAddr, valAddrsStr[1])
+	require.NoError(t, err)
+	_, err = ms.RemoveValidator(ctx, removeMsg)
+	require.NoError(t, err)
+
+	updates, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []cometabci.ValidatorUpdate{val2.ABCIValidatorUpdateZero()}, updates)
+}
+
+func Test_MsgServer_UpdateValidatorPower(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	val, err := types.NewValidator(valAddrs[0], valPubKeys[0], "val1")
+	require.NoError(t, err)
+	require.NoError(t, input.OPChildKeeper.SetValidator(ctx, val))
+	_, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	// invalid signer
+	_, err = ms.UpdateValidatorPower(ctx, types.NewMsgUpdateValidatorPower(addrsStr[0], valAddrsStr[0], 10))
+	require.Error(t, err)
+
+	// zero power
+	_, err = ms.UpdateValidatorPower(ctx, types.NewMsgUpdateValidatorPower(moduleAddr, valAddrsStr[0], 0))
+	require.ErrorIs(t, err, types.ErrInvalidValidatorPower)
+
+	// not existing validator
+	_, err = ms.UpdateValidatorPower(ctx, types.NewMsgUpdateValidatorPower(moduleAddr, valAddrsStr[1], 10))
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+
+	ctx = sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+	_, err = ms.UpdateValidatorPower(ctx, types.NewMsgUpdateValidatorPower(moduleAddr, valAddrsStr[0], 10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeUpdateValidatorPower,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddrsStr[0]),
+		sdk.NewAttribute(types.AttributeKeyPower, "10"),
+	)}, sdk.UnwrapSDKContext(ctx).EventManager().Events())
+
+	updates, err := input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	val.ConsPower = 10
+	require.Equal(t, []cometabci.ValidatorUpdate{val.ABCIValidatorUpdate()}, updates)
+}
+
+func Test_MsgServer_SetValidatorSet(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	valPubKeys := testutilsims.CreateTestPubKeys(4)
+	val1, err := types.NewValidator(valAddrs[0], valPubKeys[0], "val1")
+	require.NoError(t, err)
+	val2, err := types.NewValidator(valAddrs[1], valPubKeys[1], "val2")
+	require.NoError(t, err)
+	for _, val := range []types.Validator{val1, val2} {
+		require.NoError(t, input.OPChildKeeper.SetValidator(ctx, val))
+		require.NoError(t, input.OPChildKeeper.SetValidatorByConsAddr(ctx, val))
+	}
+	_, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	target1, err := types.NewValidatorPower("", valAddrsStr[0], valPubKeys[0], 5)
+	require.NoError(t, err)
+	target3, err := types.NewValidatorPower("val3", valAddrsStr[2], valPubKeys[2], 3)
+	require.NoError(t, err)
+
+	// invalid signer
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(addrsStr[0], []types.ValidatorPower{target1, target3}))
+	require.Error(t, err)
+
+	// empty set
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(moduleAddr, nil))
+	require.ErrorIs(t, err, types.ErrInvalidValidatorSet)
+
+	// duplicate pubkey
+	duplicate, err := types.NewValidatorPower("", valAddrsStr[2], valPubKeys[0], 3)
+	require.NoError(t, err)
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(moduleAddr, []types.ValidatorPower{target1, duplicate}))
+	require.ErrorIs(t, err, types.ErrInvalidValidatorSet)
+
+	// exceeds max validators
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxValidators = 2
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	target4, err := types.NewValidatorPower("val4", valAddrsStr[3], valPubKeys[3], 1)
+	require.NoError(t, err)
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(moduleAddr, []types.ValidatorPower{target1, target3, target4}))
+	require.ErrorIs(t, err, types.ErrMaxValidatorsExceeded)
+
+	// val1 updated, val2 removed and val3 added
+	ctx = sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(moduleAddr, []types.ValidatorPower{target1, target3}))
+	require.NoError(t, err)
+
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeSetValidatorSet,
+		sdk.NewAttribute(types.AttributeKeyValidators, valAddrsStr[0]+","+valAddrsStr[2]),
+	), events[len(events)-1])
+
+	val3, found := input.OPChildKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, "val3", val3.Moniker)
+
+	updates, err := input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	val1.ConsPower = 5
+	require.ElementsMatch(t, []cometabci.ValidatorUpdate{
+		val1.ABCIValidatorUpdate(),
+		val2.ABCIValidatorUpdateZero(),
+		val3.ABCIValidatorUpdate(),
+	}, updates)
+
+	_, found = input.OPChildKeeper.GetValidator(ctx, valAddrs[1])
+	require.False(t, found)
+
+	// the changed pubkey is rotated
+	target1, err = types.NewValidatorPower("", valAddrsStr[0], valPubKeys[1], 5)
+	require.NoError(t, err)
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(moduleAddr, []types.ValidatorPower{target1, target3}))
+	require.NoError(t, err)
+
+	rotated, found := input.OPChildKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, "val1", rotated.Moniker)
+
+	updates, err = input.OPChildKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []cometabci.ValidatorUpdate{val1.ABCIValidatorUpdateZero(), rotated.ABCIValidatorUpdate()}, updates)
+
+	// the pubkey rotated away in the same block cannot be taken
+	target1, err = types.NewValidatorPower("", valAddrsStr[0], valPubKeys[3], 5)
+	require.NoError(t, err)
+	target3, err = types.NewValidatorPower("", valAddrsStr[2], valPubKeys[1], 3)
+	require.NoError(t, err)
+	_, err = ms.SetValidatorSet(ctx, types.NewMsgSetValidatorSet(moduleAddr, []types.ValidatorPower{target1, target3}))
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+}
+
 func Test_MsgServer_UpdateParams(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
@@ -302,21 +612,102 @@ func Test_MsgServer_Withdraw(t *testing.T) {
 	_, err = ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", nil))
 	require.NoError(t, err)
 
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.L2NativeDenoms = []string{"foo"}
+	require.NoError(t, ms.SetParams(ctx, params))
+
 	coins := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1_000_000_000)), sdk.NewCoin(denom, math.NewInt(1_000_000_000)))
 	// fund asset
 	account := input.Faucet.NewFundedAccount(ctx, coins...)
 	accountAddr, err := input.AccountKeeper.AddressCodec().BytesToString(account)
 	require.NoError(t, err)
 
-	// not token from l1
+	// token originated from l2 is escrowed
+	moduleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
 	msg := types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin("foo", math.NewInt(100)))
 	_, err = ms.InitiateTokenWithdrawal(ctx, msg)
-	require.Error(t, err)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, moduleAddr, "foo").Amount)
 
 	// valid
 	msg = types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin(denom, math.NewInt(100)))
 	_, err = ms.InitiateTokenWithdrawal(ctx, msg)
 	require.NoError(t, err)
+	require.True(t, input.BankKeeper.GetBalance(ctx, moduleAddr, denom).IsZero())
+}
+
+func Test_MsgServer_Withdraw_L2NativeToken(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	account := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin("foo", math.NewInt(1_000)))
+	accountAddr, err := input.AccountKeeper.AddressCodec().BytesToString(account)
+	require.NoError(t, err)
+
+	// bridge info is not set yet
+	msg := types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin("foo", math.NewInt(100)))
+	_, err = ms.InitiateTokenWithdrawal(ctx, msg)
+	require.ErrorIs(t, err, types.ErrNonL1Token)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+			Metadata:            []byte("metadata"),
+		},
+	}
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	// not listed in the l2 native denoms
+	_, err = ms.InitiateTokenWithdrawal(ctx, msg)
+	require.ErrorIs(t, err, types.ErrNonL1Token)
+
+	// the ibc vouchers and the bridged l1 tokens cannot be listed
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.L2NativeDenoms = []string{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+	require.ErrorIs(t, params.Validate(input.AccountKeeper.AddressCodec()), types.ErrInvalidL2NativeDenom)
+	params.L2NativeDenoms = []string{ophosttypes.L2Denom(1, "uinit")}
+	require.ErrorIs(t, params.Validate(input.AccountKeeper.AddressCodec()), types.ErrInvalidL2NativeDenom)
+
+	params.L2NativeDenoms = []string{"foo"}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	baseDenom, isL2Native, err := input.OPChildKeeper.GetBaseDenom(ctx, "foo")
+	require.NoError(t, err)
+	require.True(t, isL2Native)
+	require.Equal(t, ophosttypes.L1Denom(1, "foo"), baseDenom)
+
+	_, err = ms.InitiateTokenWithdrawal(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(900), input.BankKeeper.GetBalance(ctx, account, "foo").Amount)
+
+	supply := input.BankKeeper.GetSupply(ctx, "foo")
+
+	// return deposit releases the escrow without minting
+	_, err = ms.FinalizeTokenDeposit(ctx, types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[2], sdk.NewCoin("foo", math.NewInt(60)), 1, 1, baseDenom, nil))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), input.BankKeeper.GetBalance(ctx, addrs[2], "foo").Amount)
+	require.Equal(t, math.NewInt(40), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), "foo").Amount)
+	require.Equal(t, supply, input.BankKeeper.GetSupply(ctx, "foo"))
+
+	// no denom pair is registered for the l2 native token
+	ok, err := input.OPChildKeeper.DenomPairs.Has(ctx, "foo")
+	require.NoError(t, err)
+	require.False(t, ok)
 }
 
 /////////////////////////////////////////
@@ -413,6 +804,65 @@ func Test_MsgServer_Deposit_ToModuleAccount(t *testing.T) {
 	require.Equal(t, math.ZeroInt(), afterModuleBalance.Amount)
 }
 
+func Test_MsgServer_BridgeRoles(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	// the bridge info setter role falls back to the bridge executor
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.BridgeExecutors = []string{addrsStr[0]}
+	params.DepositRelayers = []string{addrsStr[1]}
+	params.OracleRelayers = []string{addrsStr[2]}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	bz := sha3.Sum256([]byte("test_token"))
+	denom := "l2/" + hex.EncodeToString(bz[:])
+
+	// only the deposit relayer can finalize the deposit
+	for _, sender := range []string{addrsStr[0], addrsStr[2]} {
+		msg := types.NewMsgFinalizeTokenDeposit(sender, addrsStr[1], addrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", nil)
+		_, err = ms.FinalizeTokenDeposit(ctx, msg)
+		require.Error(t, err)
+	}
+
+	msg := types.NewMsgFinalizeTokenDeposit(addrsStr[1], addrsStr[1], addrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "test_token", nil)
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, addrs[1], denom).Amount)
+
+	// the oracle relayer cannot set the bridge info
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+		},
+	}
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[2], info))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	// the deposit relayer cannot update the oracle
+	_, err = ms.UpdateOracle(ctx, types.NewMsgUpdateOracle(addrsStr[1], 1, []byte("data")))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the attestation threshold is bounded by the deposit relayers
+	params.DepositAttestationThreshold = 2
+	require.ErrorIs(t, params.Validate(input.AccountKeeper.AddressCodec()), types.ErrInvalidAttestationThreshold)
+}
+
 func Test_MsgServer_Deposit_NoHook(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
@@ -608,3 +1058,197 @@ func Test_MsgServer_UpdateOracle(t *testing.T) {
 	_, err = ms.UpdateOracle(ctx, types.NewMsgUpdateOracle(addrsStr[1], 11, extCommitBz))
 	require.Error(t, err)
 }
+
+func Test_MsgServer_Deposit_DenomMetadata(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	denom := ophosttypes.L2Denom(1, "uinit")
+	l1Metadata := banktypes.Metadata{
+		Description: "The native token of Initia",
+		Base:        "uinit",
+		Display:     "INIT",
+		Name:        "Initia",
+		Symbol:      "INIT",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uinit", Exponent: 0},
+			{Denom: "INIT", Exponent: 6},
+		},
+	}
+
+	// metadata base must be the base denom
+	msg := types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "uother", nil)
+	msg.Metadata = &l1Metadata
+	_, err := ms.FinalizeTokenDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidDenomMetadata)
+
+	msg = types.NewMsgFinalizeTokenDeposit(addrsStr[0], addrsStr[1], addrsStr[1], sdk.NewCoin(denom, math.NewInt(100)), 1, 1, "uinit", nil)
+	msg.Metadata = &l1Metadata
+	_, err = ms.FinalizeTokenDeposit(ctx, msg)
+	require.NoError(t, err)
+
+	metadata, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, denom, metadata.Base)
+	require.Equal(t, "INIT", metadata.Display)
+	require.Equal(t, "INIT", metadata.Symbol)
+	require.Equal(t, []*banktypes.DenomUnit{
+		{Denom: denom, Exponent: 0, Aliases: []string{"uinit"}},
+		{Denom: "INIT", Exponent: 6},
+	}, metadata.DenomUnits)
+
+	// correct the metadata
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	metadata.Display = "init"
+	metadata.DenomUnits[1].Denom = "init"
+	_, err = ms.UpdateDenomMetadata(ctx, types.NewMsgUpdateDenomMetadata(addrsStr[0], metadata))
+	require.Error(t, err)
+
+	_, err = ms.UpdateDenomMetadata(ctx, types.NewMsgUpdateDenomMetadata(moduleAddr, metadata))
+	require.NoError(t, err)
+
+	res, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, "init", res.Display)
+
+	// not a bridged denom
+	metadata.Base = "foo"
+	metadata.DenomUnits[0].Denom = "foo"
+	_, err = ms.UpdateDenomMetadata(ctx, types.NewMsgUpdateDenomMetadata(moduleAddr, metadata))
+	require.ErrorIs(t, err, types.ErrInvalidDenomMetadata)
+}
+
+func Test_MsgServer_NftBridging(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+			Metadata:            []byte("metadata"),
+		},
+	}
+	_, err := ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	classId := ophosttypes.L2ClassId(1, "kitty")
+
+	// class id must be derived from the base class id
+	msg := types.NewMsgFinalizeNftDeposit(addrsStr[0], addrsStr[1], addrsStr[2], classId, "1", 1, 1, "puppy")
+	_, err = ms.FinalizeNftDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidNft)
+
+	// only the bridge executor can finalize
+	msg = types.NewMsgFinalizeNftDeposit(addrsStr[1], addrsStr[1], addrsStr[2], classId, "1", 1, 1, "kitty")
+	msg.ClassName = "Kitty"
+	_, err = ms.FinalizeNftDeposit(ctx, msg)
+	require.Error(t, err)
+
+	msg.Sender = addrsStr[0]
+	_, err = ms.FinalizeNftDeposit(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, addrs[2], input.NftKeeper.GetOwner(ctx, classId, "1"))
+
+	class, found := input.NftKeeper.GetClass(ctx, classId)
+	require.True(t, found)
+	require.Equal(t, "Kitty", class.Name)
+
+	// cannot finalize the same sequence twice
+	_, err = ms.FinalizeNftDeposit(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDepositAlreadyFinalized)
+
+	// only the owner can withdraw
+	_, err = ms.InitiateNftWithdrawal(ctx, types.NewMsgInitiateNftWithdrawal(addrsStr[1], addrsStr[1], classId, "1"))
+	require.Error(t, err)
+
+	res, err := ms.InitiateNftWithdrawal(ctx, types.NewMsgInitiateNftWithdrawal(addrsStr[2], addrsStr[1], classId, "1"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+	require.False(t, input.NftKeeper.HasNFT(ctx, classId, "1"))
+
+	// nfts created on l2 cannot be withdrawn
+	require.NoError(t, input.NftKeeper.SaveClass(ctx, nft.Class{Id: "native"}))
+	require.NoError(t, input.NftKeeper.Mint(ctx, nft.NFT{ClassId: "native", Id: "1"}, addrs[2]))
+	_, err = ms.InitiateNftWithdrawal(ctx, types.NewMsgInitiateNftWithdrawal(addrsStr[2], addrsStr[1], "native", "1"))
+	require.ErrorIs(t, err, types.ErrNonL1Nft)
+
+	// wrapped class ids do not share the namespace with the bridged denoms
+	require.NotEqual(t, ophosttypes.L2Denom(1, "kitty"), classId)
+}
+
+func Test_MsgServer_NftBridging_FailedMint(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.StrictDepositOrdering = true
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: time.Now().UTC(),
+			Metadata:            []byte("metadata"),
+		},
+	}
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	classId := ophosttypes.L2ClassId(1, "kitty")
+	_, err = ms.FinalizeNftDeposit(ctx, types.NewMsgFinalizeNftDeposit(addrsStr[0], addrsStr[1], addrsStr[2], classId, "1", 1, 1, "kitty"))
+	require.NoError(t, err)
+
+	// the same token id cannot be minted again, but the deposit is consumed
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+	_, err = ms.FinalizeNftDeposit(sdkCtx, types.NewMsgFinalizeNftDeposit(addrsStr[0], addrsStr[1], addrsStr[3], classId, "1", 2, 1, "kitty"))
+	require.NoError(t, err)
+	require.Equal(t, addrs[2], input.NftKeeper.GetOwner(ctx, classId, "1"))
+
+	found := false
+	for _, event := range sdkCtx.EventManager().Events() {
+		if event.Type != types.EventTypeFinalizeNftDeposit {
+			continue
+		}
+
+		found = true
+		attr, ok := event.GetAttribute(types.AttributeKeyMintSuccess)
+		require.True(t, ok)
+		require.Equal(t, "false", attr.Value)
+	}
+	require.True(t, found)
+
+	// the following deposit is not blocked
+	_, err = ms.FinalizeNftDeposit(ctx, types.NewMsgFinalizeNftDeposit(addrsStr[0], addrsStr[1], addrsStr[3], classId, "2", 3, 1, "kitty"))
+	require.NoError(t, err)
+	require.Equal(t, addrs[3], input.NftKeeper.GetOwner(ctx, classId, "2"))
+
+	nextL1Sequence, err := input.OPChildKeeper.GetNextL1Sequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), nextL1Sequence)
+}
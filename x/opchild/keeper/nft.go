@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// GetBaseClassId returns the l1 class id of the wrapped nft class.
+func (k Keeper) GetBaseClassId(ctx context.Context, classId string) (string, error) {
+	baseClassId, err := k.NftClassPairs.Get(ctx, classId)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return "", types.ErrNonL1Nft
+	} else if err != nil {
+		return "", err
+	}
+
+	return baseClassId, nil
+}
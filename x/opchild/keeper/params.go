@@ -13,10 +13,44 @@ func (k Keeper) BridgeExecutors(ctx context.Context) ([]sdk.AccAddress, error) {
 	if err != nil {
 		return nil, err
 	}
-	var addrs []sdk.AccAddress
 
-	for _, be := range params.BridgeExecutors {
-		addr, err := k.authKeeper.AddressCodec().StringToBytes(be)
+	return k.accAddresses(params.BridgeExecutors)
+}
+
+// DepositRelayers returns the addresses allowed to finalize the deposits
+func (k Keeper) DepositRelayers(ctx context.Context) ([]sdk.AccAddress, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.accAddresses(params.DepositRelayerAddrs())
+}
+
+// OracleRelayers returns the addresses allowed to update the oracle
+func (k Keeper) OracleRelayers(ctx context.Context) ([]sdk.AccAddress, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.accAddresses(params.OracleRelayerAddrs())
+}
+
+// BridgeInfoSetters returns the addresses allowed to set the bridge info
+func (k Keeper) BridgeInfoSetters(ctx context.Context) ([]sdk.AccAddress, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.accAddresses(params.BridgeInfoSetterAddrs())
+}
+
+func (k Keeper) accAddresses(addrStrs []string) ([]sdk.AccAddress, error) {
+	var addrs []sdk.AccAddress
+	for _, addrStr := range addrStrs {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(addrStr)
 		if err != nil {
 			return nil, err
 		}
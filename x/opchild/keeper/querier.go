@@ -2,12 +2,17 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 type Querier struct {
@@ -75,3 +80,217 @@ func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*ty
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (q Querier) WithdrawalCommitment(ctx context.Context, req *types.QueryWithdrawalCommitmentRequest) (*types.QueryWithdrawalCommitmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+
+	commitment, err := q.GetWithdrawalCommitment(ctx, height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalCommitmentResponse{Commitment: commitment}, nil
+}
+
+func (q Querier) WithdrawalProof(ctx context.Context, req *types.QueryWithdrawalProofRequest) (*types.QueryWithdrawalProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	leaf, proofs, commitment, err := q.GetWithdrawalProof(ctx, req.Sequence)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "withdrawal %d not found", req.Sequence)
+	} else if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	bridgeInfo, err := q.Keeper.BridgeInfo.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryWithdrawalProofResponse{
+		Leaf:             leaf,
+		WithdrawalProofs: proofs,
+		Commitment:       commitment,
+		BridgeId:         bridgeInfo.BridgeId,
+		Version:          ophosttypes.OutputVersionV2,
+	}
+	if len(commitment.StateRoot) != 0 {
+		outputRoot := ophosttypes.NewOutputRootV1(res.Version, commitment.StateRoot, commitment.StorageRoot, commitment.LatestBlockHash).Compute()
+		res.OutputRoot = outputRoot[:]
+	}
+
+	return res, nil
+}
+
+func (q Querier) DepositStatus(ctx context.Context, req *types.QueryDepositStatusRequest) (*types.QueryDepositStatusResponse, error) {
+	highest, missing, buffered, err := q.GetDepositStatus(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositStatusResponse{
+		HighestContiguousSequence: highest,
+		MissingRanges:             missing,
+		BufferedSequences:         buffered,
+	}, nil
+}
+
+func (q Querier) DepositAttestations(ctx context.Context, req *types.QueryDepositAttestationsRequest) (*types.QueryDepositAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	attestations, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.DepositAttestations, req.Pagination, func(_ uint64, attestation types.DepositAttestation) (types.DepositAttestation, error) {
+		return attestation, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (q Querier) ExecutorChangePlan(ctx context.Context, req *types.QueryExecutorChangePlanRequest) (*types.QueryExecutorChangePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	plan, err := q.GetExecutorChangePlan(ctx, req.Height)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "executor change plan at height %d not found", req.Height)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutorChangePlanResponse{Plan: plan}, nil
+}
+
+func (q Querier) ExecutorChangePlans(ctx context.Context, req *types.QueryExecutorChangePlansRequest) (*types.QueryExecutorChangePlansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	plans, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ExecutorChangePlans, req.Pagination, func(_ uint64, plan types.ExecutorChangePlan) (types.ExecutorChangePlan, error) {
+		return plan, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutorChangePlansResponse{Plans: plans, Pagination: pageRes}, nil
+}
+
+func (q Querier) ScheduledMessages(ctx context.Context, req *types.QueryScheduledMessagesRequest) (*types.QueryScheduledMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sm, err := q.GetScheduledMessages(ctx, req.Id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "scheduled messages %d not found", req.Id)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledMessagesResponse{ScheduledMessages: sm}, nil
+}
+
+func (q Querier) ScheduledMessagesList(ctx context.Context, req *types.QueryScheduledMessagesListRequest) (*types.QueryScheduledMessagesListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sms, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ScheduledMessages, req.Pagination, func(_ uint64, sm types.ScheduledMessages) (types.ScheduledMessages, error) {
+		return sm, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledMessagesListResponse{ScheduledMessages: sms, Pagination: pageRes}, nil
+}
+
+func (q Querier) PendingAdmin(ctx context.Context, req *types.QueryPendingAdminRequest) (*types.QueryPendingAdminResponse, error) {
+	pendingAdmin, err := q.GetPendingAdmin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingAdminResponse{PendingAdmin: pendingAdmin}, nil
+}
+
+func (q Querier) AdminProposal(ctx context.Context, req *types.QueryAdminProposalRequest) (*types.QueryAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	proposal, err := q.GetAdminProposal(ctx, req.Id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "admin proposal %d not found", req.Id)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAdminProposalResponse{Proposal: proposal}, nil
+}
+
+func (q Querier) AdminProposals(ctx context.Context, req *types.QueryAdminProposalsRequest) (*types.QueryAdminProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.AdminProposals, req.Pagination, func(_ uint64, proposal types.AdminProposal) (types.AdminProposal, error) {
+		return proposal, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAdminProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// SigningInfo queries the liveness information of a validator
+func (q Querier) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := q.consensusAddressCodec.StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	info, err := q.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil && errors.Is(err, types.ErrNoSigningInfoFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningInfoResponse{SigningInfo: info}, nil
+}
+
+// SigningInfos queries the liveness information of all the validators
+func (q Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	infos, pageRes, err := query.CollectionPaginate(ctx, q.ValidatorSigningInfos, req.Pagination, func(_ []byte, info types.ValidatorSigningInfo) (types.ValidatorSigningInfo, error) {
+		return info, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningInfosResponse{SigningInfos: infos, Pagination: pageRes}, nil
+}
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// SetNextScheduledMessagesId sets the id of the next scheduled messages.
+func (k Keeper) SetNextScheduledMessagesId(ctx context.Context, nextId uint64) error {
+	return k.NextScheduledMessagesId.Set(ctx, nextId)
+}
+
+// GetNextScheduledMessagesId returns the id of the next scheduled messages.
+func (k Keeper) GetNextScheduledMessagesId(ctx context.Context) (uint64, error) {
+	nextId, err := k.NextScheduledMessagesId.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if nextId == collections.DefaultSequenceStart {
+		return types.DefaultScheduledMessagesIdStart, nil
+	}
+
+	return nextId, nil
+}
+
+// IncreaseNextScheduledMessagesId returns the current id and increases it.
+func (k Keeper) IncreaseNextScheduledMessagesId(ctx context.Context) (uint64, error) {
+	nextId, err := k.NextScheduledMessagesId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if nextId == collections.DefaultSequenceStart {
+		if err := k.NextScheduledMessagesId.Set(ctx, types.DefaultScheduledMessagesIdStart+1); err != nil {
+			return 0, err
+		}
+
+		return types.DefaultScheduledMessagesIdStart, nil
+	}
+
+	return nextId, nil
+}
+
+// ScheduleMessages validates the admin messages and queues them to be
+// executed from the execution height. Zero execution height schedules the
+// messages after the execute_messages_delay of params.
+func (k Keeper) ScheduleMessages(ctx context.Context, sender string, executionHeight uint64, messages []*codectypes.Any) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	earliestHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + params.ExecuteMessagesDelay
+	if executionHeight == 0 {
+		executionHeight = earliestHeight
+	} else if executionHeight < earliestHeight {
+		return 0, errorsmod.Wrapf(types.ErrInvalidExecutionHeight, "execution height must be at least %d", earliestHeight)
+	}
+
+	sm := types.ScheduledMessages{
+		Sender:          sender,
+		ExecutionHeight: executionHeight,
+		Messages:        messages,
+	}
+
+	// reject the messages which can never be executed
+	msgs, err := sm.GetMsgs()
+	if err != nil {
+		return 0, err
+	}
+	for _, msg := range msgs {
+		if err := k.validateExecuteMessage(params, msg); err != nil {
+			return 0, err
+		}
+	}
+
+	sm.Id, err = k.IncreaseNextScheduledMessagesId(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.ScheduledMessages.Set(ctx, sm.Id, sm); err != nil {
+		return 0, err
+	}
+
+	return sm.Id, nil
+}
+
+// dispatchExecuteMessages executes the admin messages immediately, or queues
+// them if the timelock is enabled or requested.
+func (k Keeper) dispatchExecuteMessages(ctx context.Context, sender string, executionHeight uint64, messages []*codectypes.Any) (uint64, []types.ExecuteMessageResult, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if executionHeight != 0 || params.ExecuteMessagesDelay != 0 {
+		id, err := k.ScheduleMessages(ctx, sender, executionHeight, messages)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		sm, err := k.GetScheduledMessages(ctx, id)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeScheduleMessages,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyScheduledId, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(sm.ExecutionHeight, 10)),
+		))
+
+		return id, nil, nil
+	}
+
+	msgs, err := sdktx.GetMsgs(messages, "sdk.MsgProposal")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	results, err := k.executeMessages(ctx, msgs)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return 0, results, nil
+}
+
+// GetScheduledMessages returns the scheduled messages of the id.
+func (k Keeper) GetScheduledMessages(ctx context.Context, id uint64) (types.ScheduledMessages, error) {
+	return k.ScheduledMessages.Get(ctx, id)
+}
+
+// CancelScheduledMessages removes the scheduled messages of the id.
+func (k Keeper) CancelScheduledMessages(ctx context.Context, id uint64) error {
+	if found, err := k.ScheduledMessages.Has(ctx, id); err != nil {
+		return err
+	} else if !found {
+		return types.ErrScheduledMessagesNotFound
+	}
+
+	return k.ScheduledMessages.Remove(ctx, id)
+}
+
+// IterateScheduledMessages iterates the scheduled messages in the id order.
+func (k Keeper) IterateScheduledMessages(ctx context.Context, cb func(sm types.ScheduledMessages) (stop bool, err error)) error {
+	return k.ScheduledMessages.Walk(ctx, nil, func(_ uint64, sm types.ScheduledMessages) (stop bool, err error) {
+		return cb(sm)
+	})
+}
+
+// ExecuteScheduledMessages executes and removes the scheduled messages of
+// the id once the execution height is reached.
+func (k Keeper) ExecuteScheduledMessages(ctx context.Context, id uint64) ([]types.ExecuteMessageResult, error) {
+	sm, err := k.ScheduledMessages.Get(ctx, id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, types.ErrScheduledMessagesNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()); height < sm.ExecutionHeight {
+		return nil, errorsmod.Wrapf(types.ErrScheduledMessagesNotReady, "executable from height %d", sm.ExecutionHeight)
+	}
+
+	msgs, err := sm.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.executeMessages(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ScheduledMessages.Remove(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// validateExecuteMessage checks the message is allowed, routable and signed
+// only by the module authority.
+func (k Keeper) validateExecuteMessage(params types.Params, msg sdk.Msg) error {
+	if typeURL := sdk.MsgTypeURL(msg); !params.IsExecuteMessageAllowed(typeURL) {
+		return errorsmod.Wrap(types.ErrExecuteMsgNotAllowed, typeURL)
+	}
+
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidExecuteMsg, err.Error())
+		}
+	}
+
+	authority, err := k.authKeeper.AddressCodec().StringToBytes(k.authority)
+	if err != nil {
+		return err
+	}
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	// assert that the opchild module account is the only signer for ExecuteMessages message
+	if !bytes.Equal(signers[0], authority) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
+	}
+
+	if k.Router().Handler(msg) == nil {
+		return errorsmod.Wrap(types.ErrUnroutableExecuteMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return nil
+}
+
+// executeMessages executes the messages with the module authority and
+// returns the results in order; the state changes are committed only when
+// all the messages succeed.
+func (k Keeper) executeMessages(ctx context.Context, messages []sdk.Msg) ([]types.ExecuteMessageResult, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	results := make([]types.ExecuteMessageResult, 0, len(messages))
+	events := sdk.EmptyEvents()
+	for i, msg := range messages {
+		if err := k.validateExecuteMessage(params, msg); err != nil {
+			return nil, err
+		}
+
+		res, err := k.Router().Handler(msg)(cacheCtx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "message %d", i)
+		}
+
+		typeURL := sdk.MsgTypeURL(msg)
+		results = append(results, types.ExecuteMessageResult{
+			TypeUrl:      typeURL,
+			Data:         res.Data,
+			MsgResponses: res.MsgResponses,
+		})
+
+		// attribute the events to the inner message like the baseapp does
+		msgIndex := sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.Itoa(i))
+		events = append(events, sdk.NewEvent(
+			types.EventTypeExecuteMessages,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			msgIndex,
+		))
+		for _, event := range res.GetEvents() {
+			events = append(events, sdk.Event(event).AppendAttributes(msgIndex))
+		}
+	}
+
+	writeCache()
+	sdkCtx.EventManager().EmitEvents(events)
+
+	return results, nil
+}
@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testutilsims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func Test_MsgServer_ScheduledMessages(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+
+	// admin to 0 with the timelock of 10 blocks
+	params.Admin = addrsStr[0]
+	params.ExecuteMessagesDelay = 10
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	addMsg, err := types.NewMsgAddValidator("val1", moduleAddr, valAddrsStr[0], valPubKeys[0])
+	require.NoError(t, err)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	// the messages are queued instead of executed
+	msg, err := types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+	res, err := ms.ExecuteMessages(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.ScheduledId)
+
+	vals, err := input.OPChildKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Empty(t, vals)
+
+	queryRes, err := querier.ScheduledMessages(ctx, &types.QueryScheduledMessagesRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, addrsStr[0], queryRes.ScheduledMessages.Sender)
+	require.Equal(t, height+10, queryRes.ScheduledMessages.ExecutionHeight)
+
+	// execution height earlier than the delay
+	msg.ExecutionHeight = height + 5
+	_, err = ms.ExecuteMessages(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidExecutionHeight)
+
+	// messages not signed by the authority are rejected at schedule
+	invalidMsg, err := types.NewMsgAddValidator("val1", addrsStr[0], valAddrsStr[0], valPubKeys[0])
+	require.NoError(t, err)
+	msg, err = types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{invalidMsg})
+	require.NoError(t, err)
+	_, err = ms.ExecuteMessages(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// not ready yet
+	_, err = ms.ExecuteScheduledMessages(ctx, types.NewMsgExecuteScheduledMessages(addrsStr[1], 1))
+	require.ErrorIs(t, err, types.ErrScheduledMessagesNotReady)
+
+	// schedule another one at the explicit height
+	msg, err = types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+	msg.ExecutionHeight = height + 20
+	res, err = ms.ExecuteMessages(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.ScheduledId)
+
+	listRes, err := querier.ScheduledMessagesList(ctx, &types.QueryScheduledMessagesListRequest{})
+	require.NoError(t, err)
+	require.Len(t, listRes.ScheduledMessages, 2)
+
+	genState := input.OPChildKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.ScheduledMessages, 2)
+	require.Equal(t, uint64(3), genState.NextScheduledMessagesId)
+	require.NoError(t, types.ValidateGenesis(genState, input.AccountKeeper.AddressCodec()))
+
+	// only the admin can cancel
+	_, err = ms.CancelScheduledMessages(ctx, types.NewMsgCancelScheduledMessages(addrsStr[1], 2))
+	require.Error(t, err)
+	_, err = ms.CancelScheduledMessages(ctx, types.NewMsgCancelScheduledMessages(addrsStr[0], 2))
+	require.NoError(t, err)
+	_, err = ms.CancelScheduledMessages(ctx, types.NewMsgCancelScheduledMessages(addrsStr[0], 2))
+	require.ErrorIs(t, err, types.ErrScheduledMessagesNotFound)
+
+	// anyone can execute after the delay
+	ctx = sdkCtx.WithBlockHeight(int64(height + 10))
+	_, err = ms.ExecuteScheduledMessages(ctx, types.NewMsgExecuteScheduledMessages(addrsStr[1], 1))
+	require.NoError(t, err)
+
+	vals, err = input.OPChildKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	require.Equal(t, "val1", vals[0].Moniker)
+
+	_, err = querier.ScheduledMessages(ctx, &types.QueryScheduledMessagesRequest{Id: 1})
+	require.Error(t, err)
+	_, err = ms.ExecuteScheduledMessages(ctx, types.NewMsgExecuteScheduledMessages(addrsStr[1], 1))
+	require.ErrorIs(t, err, types.ErrScheduledMessagesNotFound)
+}
+
+func Test_MsgServer_CancelScheduledMessages_Threshold(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	// 2 of 3 admin signers with the timelock of 10 blocks
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.Admin = addrsStr[0]
+	params.AdminSigners = []string{addrsStr[1], addrsStr[2]}
+	params.AdminThreshold = 2
+	params.ExecuteMessagesDelay = 10
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	addMsg, err := types.NewMsgAddValidator("val1", moduleAddr, valAddrsStr[0], valPubKeys[0])
+	require.NoError(t, err)
+
+	// schedule the messages with the approvals
+	msg, err := types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+	res, err := ms.ExecuteMessages(ctx, msg)
+	require.NoError(t, err)
+	approveRes, err := ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[1], res.AdminProposalId))
+	require.NoError(t, err)
+	require.True(t, approveRes.Passed)
+	scheduledId := approveRes.ScheduledId
+	require.NotZero(t, scheduledId)
+
+	// not found
+	_, err = ms.CancelScheduledMessages(ctx, types.NewMsgCancelScheduledMessages(addrsStr[0], scheduledId+1))
+	require.ErrorIs(t, err, types.ErrScheduledMessagesNotFound)
+
+	// not an admin signer
+	_, err = ms.CancelScheduledMessages(ctx, types.NewMsgCancelScheduledMessages(addrsStr[3], scheduledId))
+	require.Error(t, err)
+
+	// a single signer only submits the proposal
+	cancelRes, err := ms.CancelScheduledMessages(ctx, types.NewMsgCancelScheduledMessages(addrsStr[2], scheduledId))
+	require.NoError(t, err)
+	require.NotZero(t, cancelRes.AdminProposalId)
+
+	has, err := input.OPChildKeeper.ScheduledMessages.Has(ctx, scheduledId)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the approval cancels the messages without waiting for the timelock
+	approveRes, err = ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[0], cancelRes.AdminProposalId))
+	require.NoError(t, err)
+	require.True(t, approveRes.Passed)
+	require.Zero(t, approveRes.ScheduledId)
+
+	has, err = input.OPChildKeeper.ScheduledMessages.Has(ctx, scheduledId)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func Test_MsgServer_CancelScheduledMessages_Authority(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+
+	// 2 of 3 admin signers with the timelock of 10 blocks
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.Admin = addrsStr[0]
+	params.AdminSigners = []string{addrsStr[1], addrsStr[2]}
+	params.AdminThreshold = 2
+	params.ExecuteMessagesDelay = 10
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	valPubKeys := testutilsims.CreateTestPubKeys(1)
+	moduleAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	addMsg, err := types.NewMsgAddValidator("val1", moduleAddr, valAddrsStr[0], valPubKeys[0])
+	require.NoError(t, err)
+
+	msg, err := types.NewMsgExecuteMessages(addrsStr[0], []sdk.Msg{addMsg})
+	require.NoError(t, err)
+	res, err := ms.ExecuteMessages(ctx, msg)
+	require.NoError(t, err)
+	approveRes, err := ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[1], res.AdminProposalId))
+	require.NoError(t, err)
+	scheduledId := approveRes.ScheduledId
+	require.NotZero(t, scheduledId)
+
+	// a single signer cannot cancel with the authority as the sender
+	msg, err = types.NewMsgExecuteMessages(addrsStr[2], []sdk.Msg{types.NewMsgCancelScheduledMessages(moduleAddr, scheduledId)})
+	require.NoError(t, err)
+	res, err = ms.ExecuteMessages(ctx, msg)
+	require.NoError(t, err)
+	require.NotZero(t, res.AdminProposalId)
+	require.Empty(t, res.Results)
+
+	has, err := input.OPChildKeeper.ScheduledMessages.Has(ctx, scheduledId)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the cancellation must be allowed
+	params.AllowedExecuteMessages = []string{sdk.MsgTypeURL(&types.MsgAddValidator{})}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	_, err = ms.ApproveAdminProposal(cacheCtx, types.NewMsgApproveAdminProposal(addrsStr[0], res.AdminProposalId))
+	require.ErrorIs(t, err, types.ErrExecuteMsgNotAllowed)
+
+	params.AllowedExecuteMessages = types.DefaultAllowedExecuteMessages()
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	approveRes, err = ms.ApproveAdminProposal(ctx, types.NewMsgApproveAdminProposal(addrsStr[0], res.AdminProposalId))
+	require.NoError(t, err)
+	require.True(t, approveRes.Passed)
+	require.Zero(t, approveRes.ScheduledId)
+
+	has, err = input.OPChildKeeper.ScheduledMessages.Has(ctx, scheduledId)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -4,6 +4,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
 func Test_FinalizedL1Sequence(t *testing.T) {
@@ -22,15 +29,92 @@ func Test_FinalizedL1Sequence(t *testing.T) {
 func Test_IterateFinalizedL1Sequences(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
-	sequences := []uint64{1, 2, 4}
-	for _, v := range sequences {
+	for _, v := range []uint64{1, 2, 4, 6} {
 		input.OPChildKeeper.RecordFinalizedL1Sequence(ctx, v)
 	}
+
+	// only the sequences above the contiguous ones are stored
+	sequences := []uint64{4, 6}
 	require.NoError(t, input.OPChildKeeper.IterateFinalizedL1Sequences(ctx, func(l1Sequence uint64) (bool, error) {
 		require.Equal(t, sequences[0], l1Sequence)
 		sequences = sequences[1:]
 		return false, nil
 	}))
+	require.Empty(t, sequences)
+}
+
+func Test_FinalizedL1Sequence_Watermark(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	for _, v := range []uint64{3, 1, 5, 2} {
+		require.NoError(t, input.OPChildKeeper.RecordFinalizedL1Sequence(ctx, v))
+	}
+
+	next, err := input.OPChildKeeper.GetNextL1Sequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), next)
+
+	for v, finalized := range map[uint64]bool{1: true, 2: true, 3: true, 4: false, 5: true, 6: false} {
+		res, err := input.OPChildKeeper.HasFinalizedL1Sequence(ctx, v)
+		require.NoError(t, err)
+		require.Equal(t, finalized, res, "sequence %d", v)
+	}
+
+	// the sparse set is pruned as the watermark advances
+	require.NoError(t, input.OPChildKeeper.RecordFinalizedL1Sequence(ctx, 4))
+	next, err = input.OPChildKeeper.GetNextL1Sequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), next)
+
+	iter, err := input.OPChildKeeper.FinalizedL1Sequence.Iterate(ctx, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
+
+func Test_Migrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// legacy state stores every finalized sequence
+	for _, v := range []uint64{1, 2, 3, 5, 7} {
+		require.NoError(t, input.OPChildKeeper.FinalizedL1Sequence.Set(ctx, v, true))
+	}
+
+	// legacy params have none of the new params
+	params, err := input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.AllowedExecuteMessages = nil
+	params.AdminProposalExpiry = 0
+	params.SignedBlocksWindow = 0
+	params.MinSignedPerWindow = math.LegacyDec{}
+	require.NoError(t, input.OPChildKeeper.Params.Set(ctx, params))
+
+	m := keeper.NewMigrator(input.OPChildKeeper)
+	require.NoError(t, m.Migrate1to2(sdk.UnwrapSDKContext(ctx)))
+
+	next, err := input.OPChildKeeper.GetNextL1Sequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), next)
+
+	sequences := []uint64{}
+	require.NoError(t, input.OPChildKeeper.IterateFinalizedL1Sequences(ctx, func(l1Sequence uint64) (bool, error) {
+		sequences = append(sequences, l1Sequence)
+		return false, nil
+	}))
+	require.Equal(t, []uint64{5, 7}, sequences)
+
+	for v, finalized := range map[uint64]bool{1: true, 3: true, 4: false, 5: true, 6: false, 7: true} {
+		res, err := input.OPChildKeeper.HasFinalizedL1Sequence(ctx, v)
+		require.NoError(t, err)
+		require.Equal(t, finalized, res, "sequence %d", v)
+	}
+
+	params, err = input.OPChildKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultAllowedExecuteMessages(), params.AllowedExecuteMessages)
+	require.Equal(t, types.DefaultAdminProposalExpiry, params.AdminProposalExpiry)
+	require.Equal(t, types.DefaultSignedBlocksWindow, params.SignedBlocksWindow)
+	require.Equal(t, types.DefaultMinSignedPerWindow, params.MinSignedPerWindow)
 }
 
 func Test_SetAndSetNextL2Sequence(t *testing.T) {
@@ -2,19 +2,85 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
 	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
+// RecordFinalizedL1Sequence records the l1 sequence as finalized. The finalized
+// l1 sequences are stored as the next contiguous l1 sequence (watermark) and
+// the sparse set of the l1 sequences finalized above the watermark.
 func (k Keeper) RecordFinalizedL1Sequence(ctx context.Context, l1Sequence uint64) error {
-	return k.FinalizedL1Sequence.Set(ctx, l1Sequence, true)
+	if err := k.BufferedDeposits.Remove(ctx, l1Sequence); err != nil {
+		return err
+	}
+
+	if err := k.DepositAttestations.Remove(ctx, l1Sequence); err != nil {
+		return err
+	}
+
+	nextL1Sequence, err := k.GetNextL1Sequence(ctx)
+	if err != nil {
+		return err
+	}
+
+	if l1Sequence < nextL1Sequence {
+		return nil
+	} else if l1Sequence > nextL1Sequence {
+		return k.FinalizedL1Sequence.Set(ctx, l1Sequence, true)
+	}
+
+	// advance the watermark and prune the sparse set below it
+	nextL1Sequence++
+	for {
+		if ok, err := k.FinalizedL1Sequence.Has(ctx, nextL1Sequence); err != nil {
+			return err
+		} else if !ok {
+			break
+		}
+
+		if err := k.FinalizedL1Sequence.Remove(ctx, nextL1Sequence); err != nil {
+			return err
+		}
+
+		nextL1Sequence++
+	}
+
+	return k.NextL1Sequence.Set(ctx, nextL1Sequence)
+}
+
+// GetNextL1Sequence returns the l1 sequence next to the highest contiguous
+// finalized l1 sequence.
+func (k Keeper) GetNextL1Sequence(ctx context.Context) (uint64, error) {
+	nextL1Sequence, err := k.NextL1Sequence.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return ophosttypes.DefaultL1SequenceStart, nil
+	}
+
+	return nextL1Sequence, err
+}
+
+// SetNextL1Sequence sets the l1 sequence next to the highest contiguous
+// finalized l1 sequence.
+func (k Keeper) SetNextL1Sequence(ctx context.Context, l1Sequence uint64) error {
+	return k.NextL1Sequence.Set(ctx, l1Sequence)
 }
 
 func (k Keeper) HasFinalizedL1Sequence(ctx context.Context, l1Sequence uint64) (bool, error) {
+	nextL1Sequence, err := k.GetNextL1Sequence(ctx)
+	if err != nil {
+		return false, err
+	} else if l1Sequence < nextL1Sequence {
+		return true, nil
+	}
+
 	return k.FinalizedL1Sequence.Has(ctx, l1Sequence)
 }
 
+// IterateFinalizedL1Sequences iterates the l1 sequences finalized above the
+// next contiguous l1 sequence.
 func (k Keeper) IterateFinalizedL1Sequences(ctx context.Context, cb func(l1Sequence uint64) (stop bool, err error)) error {
 	return k.FinalizedL1Sequence.Walk(ctx, nil, func(l1sequence uint64, _ bool) (stop bool, err error) {
 		return cb(l1sequence)
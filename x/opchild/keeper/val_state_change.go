@@ -31,12 +31,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]abci.V
 	}
 
 	updates := []abci.ValidatorUpdate{}
-	maxValidators, err := k.MaxValidators(ctx)
+
+	// walk all the validators, since the validators removed in the block can
+	// coexist with the new ones until the updates are applied
+	validators, err := k.GetAllValidators(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	validators, err := k.GetValidators(ctx, maxValidators)
+	rotated, err := k.getRotatedValidatorsByAddr(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +55,24 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]abci.V
 
 		// zero power validator removed from validator set
 		if newPower <= 0 {
+			// the validator removed while jailed is not bonded anymore
+			if !found && validator.ConsPower <= 0 {
+				if err := k.RemoveValidator(ctx, valAddr); err != nil {
+					return nil, err
+				}
+			}
+
 			continue
 		}
 
-		if !found || oldPower != newPower {
+		if rotatedValidator, isRotated := rotated[validator.GetOperator()]; found && isRotated {
+			// replace the previous consensus pubkey with the new one
+			updates = append(updates, rotatedValidator.ABCIValidatorUpdateZero(), validator.ABCIValidatorUpdate())
+
+			if err := k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
+				return nil, err
+			}
+		} else if !found || oldPower != newPower {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			if err := k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
@@ -73,7 +90,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]abci.V
 
 	for _, valAddrBytes := range noLongerBonded {
 		validator := k.mustGetValidator(ctx, sdk.ValAddress(valAddrBytes))
-		if validator.ConsPower > 0 {
+		if validator.ConsensusPower() > 0 {
 			return nil, errors.New("deleting validator cannot have positive power")
 		}
 
@@ -82,19 +99,44 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]abci.V
 			return nil, err
 		}
 
-		if err := k.RemoveValidator(ctx, valAddr); err != nil {
-			return nil, err
+		// keep the jailed validator to be unjailed later
+		if !validator.Jailed || validator.ConsPower <= 0 {
+			if err := k.RemoveValidator(ctx, valAddr); err != nil {
+				return nil, err
+			}
 		}
 
 		if err := k.DeleteLastValidatorPower(ctx, valAddr); err != nil {
 			return nil, err
 		}
 
-		updates = append(updates, validator.ABCIValidatorUpdate())
+		// remove the consensus pubkey known to the consensus engine
+		if rotatedValidator, isRotated := rotated[validator.GetOperator()]; isRotated {
+			validator = rotatedValidator
+		}
+
+		updates = append(updates, validator.ABCIValidatorUpdateZero())
+	}
+
+	if err := k.RotatedValidators.Clear(ctx, nil); err != nil {
+		return nil, err
 	}
+
 	return updates, nil
 }
 
+// get the validators rotated the consensus pubkey in the current block
+func (k Keeper) getRotatedValidatorsByAddr(ctx context.Context) (map[string]types.Validator, error) {
+	rotated := make(map[string]types.Validator)
+
+	err := k.RotatedValidators.Walk(ctx, nil, func(_ []byte, validator types.Validator) (stop bool, err error) {
+		rotated[validator.GetOperator()] = validator
+		return false, nil
+	})
+
+	return rotated, err
+}
+
 // map of operator bech32-addresses to serialized power
 // We use bech32 strings here, because we can't have slices as keys: map[[]byte][]byte
 type validatorsByAddr map[string]int64
@@ -7,6 +7,8 @@ import (
 
 	"cosmossdk.io/collections"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/OPinit/x/opchild/types"
@@ -87,7 +89,90 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
-	return nil
+	return k.RemoveValidatorSigningInfo(ctx, valConsAddr)
+}
+
+// RotateConsPubKey replaces the consensus pubkey of the validator and returns
+// the previous consensus address. The previous pubkey is kept until the
+// validator set updates of the block replace it with the new one.
+func (k Keeper) RotateConsPubKey(ctx context.Context, valAddr sdk.ValAddress, pubKey cryptotypes.PubKey) (sdk.ConsAddress, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	newConsAddr := sdk.GetConsAddress(pubKey)
+	if err := k.checkConsAddrAvailable(ctx, valAddr, newConsAddr); err != nil {
+		return nil, err
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the pubkey known to the consensus engine, which is the one before
+	// the first rotation in the block
+	rotated, err := k.RotatedValidators.Get(ctx, valAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		if err := k.RotatedValidators.Set(ctx, valAddr, validator); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else if rotatedConsAddr, err := rotated.GetConsAddr(); err != nil {
+		return nil, err
+	} else if rotatedConsAddr.Equals(newConsAddr) {
+		// rotated back to the pubkey known to the consensus engine
+		if err := k.RotatedValidators.Remove(ctx, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	validator.ConsensusPubkey = pkAny
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return nil, err
+	}
+	if err := k.ValidatorsByConsAddr.Remove(ctx, oldConsAddr); err != nil {
+		return nil, err
+	}
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return nil, err
+	}
+
+	if err := k.moveValidatorSigningInfo(ctx, oldConsAddr, newConsAddr); err != nil {
+		return nil, err
+	}
+
+	return oldConsAddr, nil
+}
+
+// checkConsAddrAvailable returns an error if the consensus address is used by
+// another validator, including the pubkeys rotated away in the current block
+// which are known to the consensus engine until the end of the block.
+func (k Keeper) checkConsAddrAvailable(ctx context.Context, valAddr sdk.ValAddress, consAddr sdk.ConsAddress) error {
+	if _, found := k.GetValidatorByConsAddr(ctx, consAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	return k.RotatedValidators.Walk(ctx, nil, func(key []byte, rotated types.Validator) (stop bool, err error) {
+		rotatedConsAddr, err := rotated.GetConsAddr()
+		if err != nil {
+			return true, err
+		}
+
+		// the validator can rotate back to its own pubkey
+		if rotatedConsAddr.Equals(consAddr) && !valAddr.Equals(sdk.ValAddress(key)) {
+			return true, types.ErrValidatorPubKeyExists.Wrap("the pubkey is rotated away in the current block")
+		}
+
+		return false, nil
+	})
 }
 
 // get groups of validators
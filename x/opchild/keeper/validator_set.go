@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// UpdateValidatorPower changes the consensus power of the validator; the power
+// of a jailed validator is applied when the validator is unjailed.
+func (k Keeper) UpdateValidatorPower(ctx context.Context, valAddr sdk.ValAddress, power int64) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	} else if validator.ConsPower <= 0 {
+		return errorsmod.Wrap(types.ErrNoValidatorFound, "validator is being removed")
+	}
+
+	validator.ConsPower = power
+	return k.SetValidator(ctx, validator)
+}
+
+// SetValidatorSet replaces the validator set with the target set. The
+// validators in the target set are added or updated, rotating the consensus
+// pubkey if changed, and the others are removed at the end of the block.
+func (k Keeper) SetValidatorSet(ctx context.Context, targets []types.ValidatorPower) error {
+	maxValidators, err := k.MaxValidators(ctx)
+	if err != nil {
+		return err
+	}
+	if len(targets) > int(maxValidators) {
+		return types.ErrMaxValidatorsExceeded
+	}
+
+	allValidators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the chain halts without an active validator
+	targetOperators := make(map[string]bool, len(targets))
+	hasActive := false
+	for _, target := range targets {
+		targetOperators[target.ValidatorAddress] = true
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(target.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if validator, found := k.GetValidator(ctx, valAddr); !found || !validator.Jailed {
+			hasActive = true
+		}
+	}
+	if !hasActive {
+		return types.ErrInvalidValidatorSet.Wrap("no active validator in the target set")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, validator := range allValidators {
+		if targetOperators[validator.OperatorAddress] || validator.ConsPower <= 0 {
+			continue
+		}
+
+		// set validator consensus power `0`,
+		// then `val_state_change` will execute `k.RemoveValidator`.
+		validator.ConsPower = 0
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRemoveValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		))
+	}
+
+	for _, target := range targets {
+		if err := k.setTargetValidator(ctx, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setTargetValidator(ctx context.Context, target types.ValidatorPower) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(target.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	pk, ok := target.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		if err := k.checkConsAddrAvailable(ctx, valAddr, sdk.GetConsAddress(pk)); err != nil {
+			return err
+		}
+
+		validator, err := types.NewValidator(valAddr, pk, target.Moniker)
+		if err != nil {
+			return err
+		}
+
+		validator.ConsPower = target.Power
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+		if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+			return err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAddValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, target.ValidatorAddress),
+		))
+
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if !consAddr.Equals(sdk.GetConsAddress(pk)) {
+		if _, err := k.RotateConsPubKey(ctx, valAddr, pk); err != nil {
+			return err
+		}
+
+		// reload the validator with the new pubkey
+		validator, _ = k.GetValidator(ctx, valAddr)
+	}
+
+	if target.Moniker != "" {
+		validator.Moniker = target.Moniker
+	}
+
+	// the validator being removed in the block is restored
+	validator.ConsPower = target.Power
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateValidatorPower,
+		sdk.NewAttribute(types.AttributeKeyValidator, target.ValidatorAddress),
+		sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(target.Power, 10)),
+	))
+
+	return nil
+}
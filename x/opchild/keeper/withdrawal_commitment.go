@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
+)
+
+// recordWithdrawalLeaf stores the withdrawal as a leaf of the withdrawal tree.
+// The withdrawals are rejected before the bridge info is set, as they cannot
+// be proven on l1.
+func (k Keeper) recordWithdrawalLeaf(ctx context.Context, leaf types.WithdrawalLeaf) error {
+	info, err := k.BridgeInfo.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.ErrBridgeInfoNotExists
+	} else if err != nil {
+		return err
+	}
+
+	from, err := k.authKeeper.AddressCodec().StringToBytes(leaf.From)
+	if err != nil {
+		return err
+	}
+	to, err := k.authKeeper.AddressCodec().StringToBytes(leaf.To)
+	if err != nil {
+		return err
+	}
+
+	hash, err := leaf.ComputeHash(info.BridgeId, from, to)
+	if err != nil {
+		return err
+	}
+
+	leaf.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	leaf.Hash = hash[:]
+	return k.WithdrawalLeaves.Set(ctx, leaf.Sequence, leaf)
+}
+
+func (k Keeper) getWithdrawalWindow(ctx context.Context) (types.WithdrawalWindow, error) {
+	window, err := k.WithdrawalWindow.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.WithdrawalWindow{StartSequence: types.DefaultL2SequenceStart}, nil
+	}
+
+	return window, err
+}
+
+// getWithdrawalLeafHashes returns the leaf hashes of the withdrawals in
+// [startSequence, endSequence].
+func (k Keeper) getWithdrawalLeafHashes(ctx context.Context, startSequence, endSequence uint64) ([][merkle.HashSize]byte, []uint64, error) {
+	var hashes [][merkle.HashSize]byte
+	var sequences []uint64
+	if startSequence > endSequence {
+		return hashes, sequences, nil
+	}
+
+	ranger := new(collections.Range[uint64]).StartInclusive(startSequence).EndInclusive(endSequence)
+	err := k.WithdrawalLeaves.Walk(ctx, ranger, func(sequence uint64, leaf types.WithdrawalLeaf) (stop bool, err error) {
+		hashes = append(hashes, [merkle.HashSize]byte(leaf.Hash))
+		sequences = append(sequences, sequence)
+		return false, nil
+	})
+
+	return hashes, sequences, err
+}
+
+// CommitWithdrawals records the root of the withdrawal tree of the current
+// output window if the tree is changed in the block, and closes the window
+// at the output submission time of the bridge.
+func (k Keeper) CommitWithdrawals(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	window, err := k.getWithdrawalWindow(ctx)
+	if err != nil {
+		return err
+	}
+
+	nextL2Sequence, err := k.GetNextL2Sequence(ctx)
+	if err != nil {
+		return err
+	}
+
+	info, err := k.BridgeInfo.Get(ctx)
+	hasBridgeInfo := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// append the leaves recorded after the last commitment to the tree
+	hashes, _, err := k.getWithdrawalLeafHashes(ctx, max(window.StartSequence, window.NextLeafSequence), nextL2Sequence-1)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		window.Frontier = merkle.AppendFrontier(window.Frontier, window.NumLeaves, hash)
+		window.NumLeaves++
+	}
+	window.NextLeafSequence = nextL2Sequence
+
+	closing := hasBridgeInfo && !window.EndTime.IsZero() && !sdkCtx.BlockTime().Before(window.EndTime)
+	if len(hashes) > 0 || closing {
+		commitment := types.WithdrawalCommitment{
+			Height:        sdkCtx.BlockHeight(),
+			StartSequence: window.StartSequence,
+			EndSequence:   nextL2Sequence - 1,
+			WindowEnd:     closing,
+			// the state root is recorded at the next block
+			LatestBlockHash: sdkCtx.HeaderHash(),
+		}
+
+		if window.NumLeaves > 0 {
+			root, err := merkle.FrontierRoot(window.Frontier, window.NumLeaves)
+			if err != nil {
+				return err
+			}
+
+			commitment.StorageRoot = root[:]
+		}
+
+		if err := k.WithdrawalCommitments.Set(ctx, commitment.Height, commitment); err != nil {
+			return err
+		}
+	}
+
+	if closing {
+		// keep the closed window until its output can be finalized on l1
+		window.Frontier = nil
+		if err := k.ClosedWithdrawalWindows.Set(ctx, sdkCtx.BlockHeight(), window); err != nil {
+			return err
+		}
+
+		window = types.WithdrawalWindow{StartSequence: nextL2Sequence, NextLeafSequence: nextL2Sequence}
+	}
+
+	if hasBridgeInfo && window.EndTime.IsZero() {
+		window.EndTime = nextSubmissionTime(info.BridgeConfig, sdkCtx.BlockTime())
+	}
+
+	return k.WithdrawalWindow.Set(ctx, window)
+}
+
+// PruneWithdrawals removes the leaves and the commitments of the closed output
+// windows whose output can be finalized on l1. The closing commitment of the
+// last pruned window is kept to answer the commitment queries of the heights
+// after it.
+// Called in each EndBlock
+func (k Keeper) PruneWithdrawals(ctx context.Context) error {
+	info, err := k.BridgeInfo.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// the output of the window is proposed within the next submission
+	// interval, and finalized after the finalization period
+	retention := info.BridgeConfig.SubmissionInterval + info.BridgeConfig.FinalizationPeriod
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var heights []int64
+	if err := k.ClosedWithdrawalWindows.Walk(ctx, nil, func(height int64, window types.WithdrawalWindow) (stop bool, err error) {
+		if blockTime.Before(window.EndTime.Add(retention)) {
+			return true, nil
+		}
+
+		heights = append(heights, height)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, height := range heights {
+		commitment, err := k.WithdrawalCommitments.Get(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		if err := k.WithdrawalLeaves.Clear(ctx, new(collections.Range[uint64]).EndInclusive(commitment.EndSequence)); err != nil {
+			return err
+		}
+		if err := k.WithdrawalCommitments.Clear(ctx, new(collections.Range[int64]).EndExclusive(height)); err != nil {
+			return err
+		}
+		if err := k.ClosedWithdrawalWindows.Remove(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecordWithdrawalStateRoot records the app hash of the previous block to
+// the commitment of the block, which completes its output root preimage.
+func (k Keeper) RecordWithdrawalStateRoot(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	height := sdkCtx.BlockHeight() - 1
+	commitment, err := k.WithdrawalCommitments.Get(ctx, height)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	commitment.StateRoot = sdkCtx.HeaderInfo().AppHash
+	return k.WithdrawalCommitments.Set(ctx, height, commitment)
+}
+
+// updateWithdrawalWindowEndTime recomputes the end time of the current
+// output window with the updated bridge config.
+func (k Keeper) updateWithdrawalWindowEndTime(ctx context.Context, config ophosttypes.BridgeConfig) error {
+	window, err := k.getWithdrawalWindow(ctx)
+	if err != nil {
+		return err
+	}
+
+	window.EndTime = nextSubmissionTime(config, sdk.UnwrapSDKContext(ctx).BlockTime())
+	return k.WithdrawalWindow.Set(ctx, window)
+}
+
+// nextSubmissionTime returns the first output submission time after t.
+func nextSubmissionTime(config ophosttypes.BridgeConfig, t time.Time) time.Time {
+	start := config.SubmissionStartTime
+	if config.SubmissionInterval <= 0 || t.Before(start) {
+		return start
+	}
+
+	intervals := t.Sub(start) / config.SubmissionInterval
+	return start.Add((intervals + 1) * config.SubmissionInterval)
+}
+
+// GetWithdrawalCommitment returns the withdrawal tree root at the height.
+func (k Keeper) GetWithdrawalCommitment(ctx context.Context, height int64) (types.WithdrawalCommitment, error) {
+	var commitment types.WithdrawalCommitment
+	found := false
+
+	ranger := new(collections.Range[int64]).EndInclusive(height).Descending()
+	if err := k.WithdrawalCommitments.Walk(ctx, ranger, func(_ int64, c types.WithdrawalCommitment) (stop bool, err error) {
+		commitment, found = c, true
+		return true, nil
+	}); err != nil {
+		return types.WithdrawalCommitment{}, err
+	}
+
+	if !found {
+		return types.WithdrawalCommitment{Height: height, StartSequence: types.DefaultL2SequenceStart}, nil
+	}
+
+	// the window of the commitment is closed before the height
+	if commitment.WindowEnd && commitment.Height < height {
+		return types.WithdrawalCommitment{
+			Height:        height,
+			StartSequence: commitment.EndSequence + 1,
+			EndSequence:   commitment.EndSequence,
+		}, nil
+	}
+
+	return commitment, nil
+}
+
+// GetWithdrawalProof returns the withdrawal leaf and its merkle proof to the
+// latest commitment of the output window of the withdrawal.
+func (k Keeper) GetWithdrawalProof(ctx context.Context, sequence uint64) (types.WithdrawalLeaf, [][]byte, types.WithdrawalCommitment, error) {
+	leaf, err := k.WithdrawalLeaves.Get(ctx, sequence)
+	if err != nil {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, err
+	}
+
+	var commitment types.WithdrawalCommitment
+	found := false
+
+	ranger := new(collections.Range[int64]).StartInclusive(leaf.Height)
+	if err := k.WithdrawalCommitments.Walk(ctx, ranger, func(_ int64, c types.WithdrawalCommitment) (stop bool, err error) {
+		if c.StartSequence > sequence {
+			return true, nil
+		}
+
+		commitment, found = c, true
+		return c.WindowEnd, nil
+	}); err != nil {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, err
+	}
+
+	if !found {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, types.ErrWithdrawalNotCommitted
+	}
+
+	hashes, sequences, err := k.getWithdrawalLeafHashes(ctx, commitment.StartSequence, commitment.EndSequence)
+	if err != nil {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, err
+	}
+
+	tree, err := merkle.NewTree(hashes)
+	if err != nil {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, err
+	}
+
+	index := slices.Index(sequences, sequence)
+	if index == -1 {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, types.ErrWithdrawalNotCommitted.Wrapf("withdrawal %d is not in the commitment", sequence)
+	}
+
+	proof, err := tree.Proof(index)
+	if err != nil {
+		return types.WithdrawalLeaf{}, nil, types.WithdrawalCommitment{}, err
+	}
+
+	return leaf, proof, commitment, nil
+}
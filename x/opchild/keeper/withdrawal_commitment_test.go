@@ -0,0 +1,338 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/opchild/keeper"
+	"github.com/initia-labs/OPinit/x/opchild/types"
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
+)
+
+func Test_WithdrawalCommitment(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	startTime := time.Now().UTC().Truncate(time.Second)
+	ctx := sdk.UnwrapSDKContext(_ctx).WithBlockHeight(1).WithBlockTime(startTime)
+
+	account := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin("foo", math.NewInt(1_000)))
+	accountAddr, err := input.AccountKeeper.AddressCodec().BytesToString(account)
+	require.NoError(t, err)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: startTime,
+			Metadata:            []byte("metadata"),
+		},
+	}
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.L2NativeDenoms = []string{"foo"}
+	require.NoError(t, ms.SetParams(ctx, params))
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	withdraw := func() {
+		msg := types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin("foo", math.NewInt(100)))
+		_, err := ms.InitiateTokenWithdrawal(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// three withdrawals in the first window
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(10 * time.Second))
+	withdraw()
+	withdraw()
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	// not committed until the end of the block
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(20 * time.Second))
+	withdraw()
+	_, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 3})
+	require.Error(t, err)
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	res, err := querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Commitment.StartSequence)
+	require.Equal(t, uint64(3), res.Commitment.EndSequence)
+	require.False(t, res.Commitment.WindowEnd)
+
+	// the root at height 2 only contains the first two withdrawals
+	res2, err := querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res2.Commitment.EndSequence)
+	require.NotEqual(t, res.Commitment.StorageRoot, res2.Commitment.StorageRoot)
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		proofRes, err := querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: sequence})
+		require.NoError(t, err)
+		require.Equal(t, res.Commitment, proofRes.Commitment)
+		require.Equal(t, uint32(ophosttypes.WithdrawalLeafVersionV2), proofRes.Leaf.LeafVersion)
+
+		hash, err := ophosttypes.GenerateWithdrawalHash(ophosttypes.WithdrawalLeafVersionV2, 1, sequence, account, addrs[1], proofRes.Leaf.BaseDenom, math.NewInt(100))
+		require.NoError(t, err)
+		require.Equal(t, hash[:], proofRes.Leaf.Hash)
+		require.True(t, merkle.VerifyProof(res.Commitment.StorageRoot, hash, proofRes.WithdrawalProofs))
+	}
+
+	// the window is closed at the submission time
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(time.Minute))
+	withdraw()
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	res, err = querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 4})
+	require.NoError(t, err)
+	require.True(t, res.Commitment.WindowEnd)
+	require.Equal(t, uint64(4), res.Commitment.EndSequence)
+
+	// the proof of the earlier withdrawal follows the closed window
+	proofRes, err := querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, res.Commitment, proofRes.Commitment)
+	require.True(t, merkle.VerifyProof(res.Commitment.StorageRoot, [merkle.HashSize]byte(proofRes.Leaf.Hash), proofRes.WithdrawalProofs))
+
+	// the next window starts empty
+	res, err = querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 5})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Commitment.StartSequence)
+	require.Empty(t, res.Commitment.StorageRoot)
+
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(startTime.Add(70 * time.Second))
+	withdraw()
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	proofRes, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 5})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), proofRes.Commitment.Height)
+	require.Equal(t, uint64(5), proofRes.Commitment.StartSequence)
+	require.Empty(t, proofRes.WithdrawalProofs)
+	require.Equal(t, proofRes.Leaf.Hash, proofRes.Commitment.StorageRoot)
+
+	// the closed window is kept until its output can be finalized
+	ctx = ctx.WithBlockHeight(6).WithBlockTime(startTime.Add(time.Minute + time.Hour))
+	require.NoError(t, input.OPChildKeeper.PruneWithdrawals(ctx))
+	_, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 1})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(7).WithBlockTime(startTime.Add(2*time.Minute + time.Hour))
+	require.NoError(t, input.OPChildKeeper.PruneWithdrawals(ctx))
+	for sequence := uint64(1); sequence <= 4; sequence++ {
+		_, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: sequence})
+		require.Error(t, err)
+	}
+	for height := int64(1); height < 4; height++ {
+		has, err := input.OPChildKeeper.WithdrawalCommitments.Has(ctx, height)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+
+	// the closing commitment is kept as the anchor of the next window
+	res, err = querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 4})
+	require.NoError(t, err)
+	require.True(t, res.Commitment.WindowEnd)
+	require.Equal(t, uint64(4), res.Commitment.EndSequence)
+
+	// the open window is not affected
+	proofRes, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 5})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), proofRes.Commitment.Height)
+}
+
+func Test_WithdrawalCommitment_BridgeConfigChange(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	startTime := time.Now().UTC().Truncate(time.Second)
+	ctx := sdk.UnwrapSDKContext(_ctx).WithBlockHeight(1).WithBlockTime(startTime)
+
+	account := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin("foo", math.NewInt(1_000)))
+	accountAddr, err := input.AccountKeeper.AddressCodec().BytesToString(account)
+	require.NoError(t, err)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.L2NativeDenoms = []string{"foo"}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	withdraw := func() error {
+		msg := types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin("foo", math.NewInt(100)))
+		_, err := ms.InitiateTokenWithdrawal(ctx, msg)
+		return err
+	}
+
+	// the withdrawals cannot be proven before the bridge info is set
+	l2Denom := ophosttypes.L2Denom(1, "uinit")
+	require.NoError(t, input.OPChildKeeper.DenomPairs.Set(ctx, l2Denom, "uinit"))
+	input.Faucet.Fund(ctx, account, sdk.NewCoin(l2Denom, math.NewInt(100)))
+	_, err = ms.InitiateTokenWithdrawal(ctx, types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin(l2Denom, math.NewInt(100))))
+	require.ErrorIs(t, err, types.ErrBridgeInfoNotExists)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: startTime,
+			Metadata:            []byte("metadata"),
+		},
+	}
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	window, err := input.OPChildKeeper.WithdrawalWindow.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(time.Minute), window.EndTime)
+
+	// the window end follows the updated submission interval
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(10 * time.Second))
+	info.BridgeConfig.SubmissionInterval = 10 * time.Minute
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	window, err = input.OPChildKeeper.WithdrawalWindow.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(10*time.Minute), window.EndTime)
+
+	require.NoError(t, withdraw())
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(time.Minute))
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+	res, err := querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 3})
+	require.NoError(t, err)
+	require.False(t, res.Commitment.WindowEnd)
+
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(10 * time.Minute))
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+	res, err = querier.WithdrawalCommitment(ctx, &types.QueryWithdrawalCommitmentRequest{Height: 4})
+	require.NoError(t, err)
+	require.True(t, res.Commitment.WindowEnd)
+
+	// a leaf outside of its commitment has no proof
+	require.NoError(t, input.OPChildKeeper.WithdrawalLeaves.Set(ctx, 10, types.WithdrawalLeaf{Sequence: 10, Height: 2}))
+	_, _, _, err = input.OPChildKeeper.GetWithdrawalProof(ctx, 10)
+	require.ErrorIs(t, err, types.ErrWithdrawalNotCommitted)
+}
+
+func Test_WithdrawalProof_FinalizeMsg(t *testing.T) {
+	_ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPChildKeeper)
+	querier := keeper.NewQuerier(input.OPChildKeeper)
+
+	startTime := time.Now().UTC().Truncate(time.Second)
+	ctx := sdk.UnwrapSDKContext(_ctx).WithBlockHeight(1).WithBlockTime(startTime)
+
+	account := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin("foo", math.NewInt(1_000)))
+	accountAddr, err := input.AccountKeeper.AddressCodec().BytesToString(account)
+	require.NoError(t, err)
+
+	info := types.BridgeInfo{
+		BridgeId:   1,
+		BridgeAddr: addrsStr[1],
+		L1ChainId:  "test-chain-id",
+		L1ClientId: "test-client-id",
+		BridgeConfig: ophosttypes.BridgeConfig{
+			Challengers: []string{addrsStr[2]},
+			Proposer:    addrsStr[3],
+			BatchInfo: ophosttypes.BatchInfo{
+				Submitter: addrsStr[4],
+				Chain:     "l1",
+			},
+			SubmissionInterval:  time.Minute,
+			FinalizationPeriod:  time.Hour,
+			SubmissionStartTime: startTime,
+			Metadata:            []byte("metadata"),
+		},
+	}
+	_, err = ms.SetBridgeInfo(ctx, types.NewMsgSetBridgeInfo(addrsStr[0], info))
+	require.NoError(t, err)
+
+	params, err := ms.GetParams(ctx)
+	require.NoError(t, err)
+	params.L2NativeDenoms = []string{"foo"}
+	require.NoError(t, ms.SetParams(ctx, params))
+
+	blockHash := []byte("block_hash_00000000000000000000_")
+	ctx = ctx.WithBlockHeight(2).WithHeaderHash(blockHash)
+	msg := types.NewMsgInitiateTokenWithdrawal(accountAddr, addrsStr[1], sdk.NewCoin("foo", math.NewInt(100)))
+	_, err = ms.InitiateTokenWithdrawal(ctx, msg)
+	require.NoError(t, err)
+	require.NoError(t, input.OPChildKeeper.CommitWithdrawals(ctx))
+
+	// the state root is not recorded until the next block
+	res, err := querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Empty(t, res.OutputRoot)
+	_, err = types.NewMsgFinalizeWithdrawal(res, 1)
+	require.ErrorIs(t, err, types.ErrWithdrawalNotCommitted)
+
+	stateRoot := []byte("state_root_00000000000000000000_")
+	ctx = ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, AppHash: stateRoot})
+	require.NoError(t, input.OPChildKeeper.RecordWithdrawalStateRoot(ctx))
+
+	res, err = querier.WithdrawalProof(ctx, &types.QueryWithdrawalProofRequest{Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.BridgeId)
+	require.Equal(t, ophosttypes.OutputVersionV2, res.Version)
+	require.Equal(t, stateRoot, res.Commitment.StateRoot)
+	require.Equal(t, blockHash, res.Commitment.LatestBlockHash)
+	require.Equal(t, "foo", res.Leaf.L2Denom)
+
+	outputRoot := ophosttypes.NewOutputRootV1(ophosttypes.OutputVersionV2, stateRoot, res.Commitment.StorageRoot, blockHash).Compute()
+	require.Equal(t, outputRoot[:], res.OutputRoot)
+
+	finalizeMsg, err := types.NewMsgFinalizeWithdrawal(res, 7)
+	require.NoError(t, err)
+
+	tokenMsg, ok := finalizeMsg.(*ophosttypes.MsgFinalizeTokenWithdrawal)
+	require.True(t, ok)
+	require.NoError(t, tokenMsg.Validate(input.AccountKeeper.AddressCodec()))
+	require.Equal(t, uint64(7), tokenMsg.OutputIndex)
+	require.Equal(t, accountAddr, tokenMsg.Sender)
+	require.Equal(t, addrsStr[1], tokenMsg.Receiver)
+	require.Equal(t, sdk.NewCoin(ophosttypes.L1Denom(1, "foo"), math.NewInt(100)), tokenMsg.Amount)
+
+	// the message is provable against the output root
+	scheme, err := ophosttypes.GetOutputRootScheme(tokenMsg.Version)
+	require.NoError(t, err)
+	hash, err := ophosttypes.GenerateWithdrawalHash(
+		scheme.WithdrawalLeafVersion, tokenMsg.BridgeId, tokenMsg.Sequence,
+		account, addrs[1], tokenMsg.Amount.Denom, tokenMsg.Amount.Amount,
+	)
+	require.NoError(t, err)
+	require.True(t, merkle.VerifyProof(tokenMsg.StorageRoot, hash, tokenMsg.WithdrawalProofs))
+}
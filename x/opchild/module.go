@@ -3,6 +3,7 @@ package opchild
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -23,7 +24,7 @@ import (
 	"github.com/initia-labs/OPinit/x/opchild/types"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -124,6 +125,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 	compatibilityQuerier := keeper.CompatibilityQuerier{Keeper: am.keeper}
 	stakingtypes.RegisterQueryServer(cfg.QueryServer(), compatibilityQuerier)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the move module invariants.
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// DefaultAdminProposalIdStart is the id of the first admin proposal.
+const DefaultAdminProposalIdStart = 1
+
+var _ codectypes.UnpackInterfacesMessage = AdminProposal{}
+
+// GetMsgs unpacks the messages Any's into sdk.Msg's
+func (p AdminProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
+}
+
+// Validate performs basic AdminProposal validation.
+func (p AdminProposal) Validate(ac address.Codec) error {
+	if p.Id < DefaultAdminProposalIdStart {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid id")
+	}
+
+	if _, err := ac.StringToBytes(p.Proposer); err != nil {
+		return err
+	}
+
+	if len(p.Messages) == 0 {
+		return errorsmod.Wrap(govtypes.ErrNoProposalMsgs, "Msgs length must be non-zero")
+	}
+
+	approvals := make(map[string]bool, len(p.Approvals))
+	for _, approval := range p.Approvals {
+		if _, err := ac.StringToBytes(approval); err != nil {
+			return err
+		}
+		if approvals[approval] {
+			return ErrAdminProposalAlreadyApproved.Wrap(approval)
+		}
+		approvals[approval] = true
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the proposal can no longer be approved at the
+// height.
+func (p AdminProposal) IsExpired(height uint64) bool {
+	return p.ExpirationHeight != 0 && height >= p.ExpirationHeight
+}
+
+// IsCancelScheduledMessagesMsg returns true if the message cancels the
+// scheduled messages, which takes effect as soon as the admin proposal passes
+// so the cancellation is not delayed past the cancelled messages.
+func IsCancelScheduledMessagesMsg(msg sdk.Msg) bool {
+	_, ok := msg.(*MsgCancelScheduledMessages)
+	return ok
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p AdminProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"crypto/sha256"
+)
+
+// AttestationHash returns the hash of the deposit contents without the
+// submitter and the proof, which is compared between the bridge executors.
+func (msg MsgFinalizeTokenDeposit) AttestationHash() ([]byte, error) {
+	msg.Sender = ""
+	msg.ProofHeight, msg.Proof = 0, nil
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// AttestationHash returns the hash of the deposit contents without the
+// submitter and the proof, which is compared between the bridge executors.
+func (msg MsgFinalizeNftDeposit) AttestationHash() ([]byte, error) {
+	msg.Sender = ""
+	msg.ProofHeight, msg.Proof = 0, nil
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
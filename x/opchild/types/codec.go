@@ -14,10 +14,24 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgExecuteMessages{}, "opchild/MsgExecuteMessages")
 	legacy.RegisterAminoMsg(cdc, &MsgAddValidator{}, "opchild/MsgAddValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveValidator{}, "opchild/MsgRemoveAddValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgUnjailValidator{}, "opchild/MsgUnjailValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "opchild/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateValidatorPower{}, "opchild/MsgUpdateValidatorPower")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorSet{}, "opchild/MsgSetValidatorSet")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "opchild/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgFinalizeTokenDeposit{}, "opchild/MsgFinalizeTokenDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgInitiateTokenWithdrawal{}, "opchild/MsgInitiateTokenWithdrawal")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateOracle{}, "opchild/MsgUpdateOracle")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenomMetadata{}, "opchild/MsgUpdateDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgFinalizeNftDeposit{}, "opchild/MsgFinalizeNftDeposit")
+	legacy.RegisterAminoMsg(cdc, &MsgInitiateNftWithdrawal{}, "opchild/MsgInitiateNftWithdrawal")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterExecutorChangePlan{}, "opchild/MsgRegisterExecutorChangePlan")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelExecutorChangePlan{}, "opchild/MsgCancelExecutorChangePlan")
+	legacy.RegisterAminoMsg(cdc, &MsgExecuteScheduledMessages{}, "opchild/MsgExecuteScheduledMessages")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledMessages{}, "opchild/MsgCancelScheduledMessages")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveAdminProposal{}, "opchild/MsgApproveAdminProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeAdmin{}, "opchild/MsgProposeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptAdmin{}, "opchild/MsgAcceptAdmin")
 
 	cdc.RegisterConcrete(Params{}, "opchild/Params", nil)
 }
@@ -28,10 +42,24 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgExecuteMessages{},
 		&MsgAddValidator{},
 		&MsgRemoveValidator{},
+		&MsgUnjailValidator{},
+		&MsgRotateConsPubKey{},
+		&MsgUpdateValidatorPower{},
+		&MsgSetValidatorSet{},
 		&MsgUpdateParams{},
 		&MsgFinalizeTokenDeposit{},
 		&MsgInitiateTokenWithdrawal{},
 		&MsgUpdateOracle{},
+		&MsgUpdateDenomMetadata{},
+		&MsgFinalizeNftDeposit{},
+		&MsgInitiateNftWithdrawal{},
+		&MsgRegisterExecutorChangePlan{},
+		&MsgCancelExecutorChangePlan{},
+		&MsgExecuteScheduledMessages{},
+		&MsgCancelScheduledMessages{},
+		&MsgApproveAdminProposal{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// DepositCommitment returns the commitment of the token deposit stored in
+// the l1 ophost store.
+func (msg MsgFinalizeTokenDeposit) DepositCommitment(bridgeId uint64) ([32]byte, error) {
+	var metadata []byte
+	if msg.Metadata != nil {
+		bz, err := msg.Metadata.Marshal()
+		if err != nil {
+			return [32]byte{}, err
+		}
+
+		metadata = bz
+	}
+
+	return ophosttypes.GenerateTokenDepositHash(
+		bridgeId, msg.Sequence, msg.Height,
+		msg.From, msg.To, msg.BaseDenom, msg.Amount.Denom, msg.Amount.Amount,
+		msg.Data, metadata,
+	), nil
+}
+
+// DepositCommitment returns the commitment of the nft deposit stored in
+// the l1 ophost store.
+func (msg MsgFinalizeNftDeposit) DepositCommitment(bridgeId uint64) [32]byte {
+	return ophosttypes.GenerateNftDepositHash(
+		bridgeId, msg.Sequence, msg.Height,
+		msg.From, msg.To, msg.BaseClassId, msg.ClassId,
+		msg.ClassName, msg.ClassSymbol, msg.ClassUri,
+		msg.TokenId, msg.TokenUri,
+	)
+}
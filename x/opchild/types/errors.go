@@ -28,4 +28,34 @@ var (
 	ErrMaxValidatorsExceeded           = errorsmod.Register(ModuleName, 21, "max validators exceeded")
 	ErrMaxValidatorsLowerThanCurrent   = errorsmod.Register(ModuleName, 22, "max validators cannot be lower than current number of validators")
 	ErrNonL1Token                      = errorsmod.Register(ModuleName, 23, "token is not from L1")
+	ErrInvalidDenomMetadata            = errorsmod.Register(ModuleName, 24, "invalid denom metadata")
+	ErrNonL1Nft                        = errorsmod.Register(ModuleName, 25, "nft is not from L1")
+	ErrInvalidNft                      = errorsmod.Register(ModuleName, 26, "invalid nft")
+	ErrWithdrawalNotCommitted          = errorsmod.Register(ModuleName, 27, "withdrawal is not committed yet")
+	ErrDepositAlreadyBuffered          = errorsmod.Register(ModuleName, 28, "deposit already buffered")
+	ErrDepositAlreadyAttested          = errorsmod.Register(ModuleName, 29, "deposit already attested by the executor")
+	ErrInvalidAttestationThreshold     = errorsmod.Register(ModuleName, 30, "invalid deposit attestation threshold")
+	ErrInvalidDepositProof             = errorsmod.Register(ModuleName, 31, "invalid deposit proof")
+	ErrDepositProofRequired            = errorsmod.Register(ModuleName, 32, "deposit proof required")
+	ErrInvalidBridgeInfoProof          = errorsmod.Register(ModuleName, 33, "invalid bridge info proof")
+	ErrBridgeInfoProofRequired         = errorsmod.Register(ModuleName, 34, "bridge info proof required")
+	ErrExecutorChangePlanNotFound      = errorsmod.Register(ModuleName, 35, "executor change plan not found")
+	ErrInvalidExecutionHeight          = errorsmod.Register(ModuleName, 36, "invalid execution height")
+	ErrScheduledMessagesNotFound       = errorsmod.Register(ModuleName, 37, "scheduled messages not found")
+	ErrScheduledMessagesNotReady       = errorsmod.Register(ModuleName, 38, "scheduled messages not ready to be executed")
+	ErrExecuteMsgNotAllowed            = errorsmod.Register(ModuleName, 39, "message type not allowed to be executed")
+	ErrNoPendingAdmin                  = errorsmod.Register(ModuleName, 40, "no pending admin")
+	ErrAdminProposalNotFound           = errorsmod.Register(ModuleName, 41, "admin proposal not found")
+	ErrAdminProposalAlreadyApproved    = errorsmod.Register(ModuleName, 42, "admin proposal already approved by the signer")
+	ErrInvalidAdminThreshold           = errorsmod.Register(ModuleName, 43, "invalid admin threshold")
+	ErrInvalidSigningParams            = errorsmod.Register(ModuleName, 44, "invalid liveness params")
+	ErrValidatorNotJailed              = errorsmod.Register(ModuleName, 45, "validator not jailed")
+	ErrNoSigningInfoFound              = errorsmod.Register(ModuleName, 46, "no validator signing info found")
+	ErrInvalidValidatorPower           = errorsmod.Register(ModuleName, 47, "invalid validator power")
+	ErrInvalidValidatorSet             = errorsmod.Register(ModuleName, 48, "invalid validator set")
+	ErrAdminProposalExpired            = errorsmod.Register(ModuleName, 49, "admin proposal expired")
+	ErrInvalidL2NativeDenom            = errorsmod.Register(ModuleName, 50, "invalid l2 native denom")
+	ErrBridgeInfoNotExists             = errorsmod.Register(ModuleName, 51, "bridge info not exists")
+	ErrInvalidL1Proof                  = errorsmod.Register(ModuleName, 52, "invalid l1 proof")
+	ErrInvalidL1ClientId               = errorsmod.Register(ModuleName, 53, "invalid l1 client id")
 )
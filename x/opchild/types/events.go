@@ -11,6 +11,30 @@ const (
 	EventTypeParams                  = "params"
 	EventTypeUpdateOracle            = "update_oracle"
 	EventTypeSetBridgeInfo           = "set_bridge_info"
+	EventTypeUpdateDenomMetadata     = "update_denom_metadata"
+	EventTypeFinalizeNftDeposit      = "finalize_nft_deposit"
+	EventTypeInitiateNftWithdrawal   = "initiate_nft_withdrawal"
+	EventTypeBufferDeposit           = "buffer_deposit"
+	EventTypeAttestDeposit           = "attest_deposit"
+	EventTypeAttestationMismatch     = "deposit_attestation_mismatch"
+	EventTypeRegisterExecutorChange  = "register_executor_change_plan"
+	EventTypeCancelExecutorChange    = "cancel_executor_change_plan"
+	EventTypeExecuteExecutorChange   = "execute_executor_change_plan"
+	EventTypeScheduleMessages        = "schedule_messages"
+	EventTypeExecuteScheduled        = "execute_scheduled_messages"
+	EventTypeCancelScheduled         = "cancel_scheduled_messages"
+	EventTypeSubmitAdminProposal     = "submit_admin_proposal"
+	EventTypeApproveAdminProposal    = "approve_admin_proposal"
+	EventTypeExpireAdminProposal     = "expire_admin_proposal"
+	EventTypeProposeAdmin            = "propose_admin"
+	EventTypeAcceptAdmin             = "accept_admin"
+	EventTypeLiveness                = "liveness"
+	EventTypeJailValidator           = "jail_validator"
+	EventTypeUnjailValidator         = "unjail_validator"
+	EventTypeRotateConsPubKey        = "rotate_cons_pubkey"
+	EventTypeUpdateValidatorPower    = "update_validator_power"
+	EventTypeFailBufferedDeposit     = "fail_buffered_deposit"
+	EventTypeSetValidatorSet         = "set_validator_set"
 
 	AttributeKeySender         = "sender"
 	AttributeKeyBridgeId       = "bridge_id"
@@ -20,14 +44,37 @@ const (
 	AttributeKeyRecipient      = "recipient"
 	AttributeKeyAmount         = "amount"
 	AttributeKeyDenom          = "denom"
+	AttributeKeyBaseDenom      = "base_denom"
+	AttributeKeyClassId        = "class_id"
+	AttributeKeyBaseClassId    = "base_class_id"
+	AttributeKeyTokenId        = "token_id"
 	AttributeKeyStructTag      = "struct_tag"
 	AttributeKeyValidator      = "validator"
 	AttributeKeyMetadata       = "metadata"
 	AttributeKeyL1Sequence     = "l1_sequence"
 	AttributeKeyFinalizeHeight = "finalize_height"
 	AttributeKeyHookSuccess    = "hook_success"
+	AttributeKeyMintSuccess    = "mint_success"
 	AttributeKeyFrom           = "from"
 	AttributeKeyTo             = "to"
 	AttributeKeyL2Sequence     = "l2_sequence"
 	AttributeKeyHeight         = "height"
+	AttributeKeyDepositHash    = "deposit_hash"
+	AttributeKeyAttestations   = "attestations"
+	AttributeKeyProposalId     = "proposal_id"
+	AttributeKeyExecutors      = "executors"
+	AttributeKeyScheduledId    = "scheduled_id"
+	AttributeKeyMsgIndex       = "msg_index"
+	AttributeKeyMsgTypeURL     = "msg_type_url"
+	AttributeKeyAdmin          = "admin"
+	AttributeKeyAdminProposal  = "admin_proposal_id"
+	AttributeKeyNewAdmin       = "new_admin"
+	AttributeKeyProposalPassed = "proposal_passed"
+	AttributeKeyApprovals      = "approvals"
+	AttributeKeyConsAddress    = "cons_address"
+	AttributeKeyMissedBlocks   = "missed_blocks"
+	AttributeKeyOldConsAddress = "old_cons_address"
+	AttributeKeyPower          = "power"
+	AttributeKeyReason         = "reason"
+	AttributeKeyValidators     = "validators"
 )
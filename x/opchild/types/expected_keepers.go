@@ -4,9 +4,12 @@ import (
 	context "context"
 
 	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
@@ -51,6 +54,21 @@ type BankKeeper interface {
 	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 }
 
+// NftKeeper defines the expected interface needed to mint and burn wrapped nfts.
+type NftKeeper interface {
+	HasClass(ctx context.Context, classID string) bool
+	SaveClass(ctx context.Context, class nft.Class) error
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+}
+
+// ClientKeeper defines the expected ibc client keeper to verify the l1 state.
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
+}
+
 type OracleKeeper interface {
 	GetAllCurrencyPairs(ctx sdk.Context) []slinkytypes.CurrencyPair
 	GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (oracletypes.QuotePrice, error)
@@ -7,6 +7,8 @@ import (
 	"cosmossdk.io/core/address"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 const DefaultL2SequenceStart = 1
@@ -30,8 +32,12 @@ func DefaultGenesisState() *GenesisState {
 		Validators:           []Validator{},
 		Exported:             false,
 		NextL2Sequence:       DefaultL2SequenceStart,
+		NextL1Sequence:       ophosttypes.DefaultL1SequenceStart,
 		FinalizedL1Sequences: []uint64{},
 		BridgeInfo:           nil,
+
+		NextScheduledMessagesId: DefaultScheduledMessagesIdStart,
+		NextAdminProposalId:     DefaultAdminProposalIdStart,
 	}
 }
 
@@ -52,6 +58,72 @@ func ValidateGenesis(data *GenesisState, ac address.Codec) error {
 		}
 	}
 
+	planHeights := make(map[uint64]bool, len(data.ExecutorChangePlans))
+	for _, plan := range data.ExecutorChangePlans {
+		if err := plan.Validate(ac); err != nil {
+			return err
+		}
+
+		if planHeights[plan.Height] {
+			return ErrAlreadyRegisteredHeight
+		}
+		planHeights[plan.Height] = true
+	}
+
+	scheduledIds := make(map[uint64]bool, len(data.ScheduledMessages))
+	for _, sm := range data.ScheduledMessages {
+		if err := sm.Validate(ac); err != nil {
+			return err
+		}
+
+		if scheduledIds[sm.Id] {
+			return fmt.Errorf("duplicate scheduled messages in genesis state: id %d", sm.Id)
+		}
+		if data.NextScheduledMessagesId != 0 && sm.Id >= data.NextScheduledMessagesId {
+			return fmt.Errorf("scheduled messages id %d is not below the next id %d", sm.Id, data.NextScheduledMessagesId)
+		}
+		scheduledIds[sm.Id] = true
+	}
+
+	if data.PendingAdmin != "" {
+		if _, err := ac.StringToBytes(data.PendingAdmin); err != nil {
+			return err
+		}
+	}
+
+	proposalIds := make(map[uint64]bool, len(data.AdminProposals))
+	for _, proposal := range data.AdminProposals {
+		if err := proposal.Validate(ac); err != nil {
+			return err
+		}
+
+		if proposalIds[proposal.Id] {
+			return fmt.Errorf("duplicate admin proposal in genesis state: id %d", proposal.Id)
+		}
+		if data.NextAdminProposalId != 0 && proposal.Id >= data.NextAdminProposalId {
+			return fmt.Errorf("admin proposal id %d is not below the next id %d", proposal.Id, data.NextAdminProposalId)
+		}
+		proposalIds[proposal.Id] = true
+	}
+
+	signingInfos := make(map[string]bool, len(data.SigningInfos))
+	for _, info := range data.SigningInfos {
+		if err := info.Validate(); err != nil {
+			return err
+		}
+
+		if signingInfos[info.Address] {
+			return fmt.Errorf("duplicate signing info in genesis state: address %s", info.Address)
+		}
+		signingInfos[info.Address] = true
+	}
+
+	for _, missed := range data.MissedBlocks {
+		if !signingInfos[missed.Address] {
+			return fmt.Errorf("missed blocks without signing info in genesis state: address %s", missed.Address)
+		}
+	}
+
 	return data.Params.Validate(ac)
 }
 
@@ -100,5 +172,20 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.ExecutorChangePlans {
+		if err := g.ExecutorChangePlans[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+	for i := range g.ScheduledMessages {
+		if err := g.ScheduledMessages[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+	for i := range g.AdminProposals {
+		if err := g.AdminProposals[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -33,11 +33,33 @@ type GenesisState struct {
 	// of the last-block's bonded validators.
 	LastValidatorPowers []LastValidatorPower `protobuf:"bytes,2,rep,name=last_validator_powers,json=lastValidatorPowers,proto3" json:"last_validator_powers"`
 	// delegations defines the validator set at genesis.
-	Validators           []Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
-	Exported             bool        `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
-	NextL2Sequence       uint64      `protobuf:"varint,6,opt,name=next_l2_sequence,json=nextL2Sequence,proto3" json:"next_l2_sequence,omitempty"`
+	Validators     []Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+	Exported       bool        `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
+	NextL2Sequence uint64      `protobuf:"varint,6,opt,name=next_l2_sequence,json=nextL2Sequence,proto3" json:"next_l2_sequence,omitempty"`
+	// finalized_l1_sequences are the l1 sequences finalized above the
+	// next_l1_sequence.
 	FinalizedL1Sequences []uint64    `protobuf:"varint,7,rep,packed,name=finalized_l1_sequences,json=finalizedL1Sequences,proto3" json:"finalized_l1_sequences,omitempty"`
 	BridgeInfo           *BridgeInfo `protobuf:"bytes,8,opt,name=bridge_info,json=bridgeInfo,proto3" json:"bridge_info,omitempty"`
+	// next_l1_sequence is the l1 sequence next to the highest contiguous
+	// finalized l1 sequence; zero to derive it from finalized_l1_sequences.
+	NextL1Sequence uint64 `protobuf:"varint,9,opt,name=next_l1_sequence,json=nextL1Sequence,proto3" json:"next_l1_sequence,omitempty"`
+	// executor_change_plans are the executor change plans not applied yet.
+	ExecutorChangePlans []ExecutorChangePlan `protobuf:"bytes,10,rep,name=executor_change_plans,json=executorChangePlans,proto3" json:"executor_change_plans"`
+	// scheduled_messages are the admin messages waiting for the execution.
+	ScheduledMessages []ScheduledMessages `protobuf:"bytes,11,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages"`
+	// next_scheduled_messages_id is the id of the next scheduled messages.
+	NextScheduledMessagesId uint64 `protobuf:"varint,12,opt,name=next_scheduled_messages_id,json=nextScheduledMessagesId,proto3" json:"next_scheduled_messages_id,omitempty"`
+	// pending_admin is the address proposed to be the next admin.
+	PendingAdmin string `protobuf:"bytes,13,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
+	// admin_proposals are the admin proposals waiting for the approvals.
+	AdminProposals []AdminProposal `protobuf:"bytes,14,rep,name=admin_proposals,json=adminProposals,proto3" json:"admin_proposals"`
+	// next_admin_proposal_id is the id of the next admin proposal.
+	NextAdminProposalId uint64 `protobuf:"varint,15,opt,name=next_admin_proposal_id,json=nextAdminProposalId,proto3" json:"next_admin_proposal_id,omitempty"`
+	// signing_infos are the liveness information of the validators.
+	SigningInfos []ValidatorSigningInfo `protobuf:"bytes,16,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	// missed_blocks are the blocks missed by the validators in the signed
+	// blocks window.
+	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,17,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +144,125 @@ func (m *GenesisState) GetBridgeInfo() *BridgeInfo {
 	return nil
 }
 
+func (m *GenesisState) GetNextL1Sequence() uint64 {
+	if m != nil {
+		return m.NextL1Sequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetExecutorChangePlans() []ExecutorChangePlan {
+	if m != nil {
+		return m.ExecutorChangePlans
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledMessages() []ScheduledMessages {
+	if m != nil {
+		return m.ScheduledMessages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledMessagesId() uint64 {
+	if m != nil {
+		return m.NextScheduledMessagesId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingAdmin() string {
+	if m != nil {
+		return m.PendingAdmin
+	}
+	return ""
+}
+
+func (m *GenesisState) GetAdminProposals() []AdminProposal {
+	if m != nil {
+		return m.AdminProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAdminProposalId() uint64 {
+	if m != nil {
+		return m.NextAdminProposalId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSigningInfos() []ValidatorSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+// ValidatorMissedBlocks contains the indexes of the missed blocks of a
+// validator in the signed blocks window.
+type ValidatorMissedBlocks struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// missed_indexes are the indexes of the missed blocks.
+	MissedIndexes []int64 `protobuf:"varint,2,rep,packed,name=missed_indexes,json=missedIndexes,proto3" json:"missed_indexes,omitempty"`
+}
+
+func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
+func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlocks) ProtoMessage()    {}
+func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08c29689c0e7bd55, []int{1}
+}
+func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlocks.Merge(m, src)
+}
+func (m *ValidatorMissedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlocks proto.InternalMessageInfo
+
+func (m *ValidatorMissedBlocks) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorMissedBlocks) GetMissedIndexes() []int64 {
+	if m != nil {
+		return m.MissedIndexes
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -134,7 +275,7 @@ func (m *LastValidatorPower) Reset()         { *m = LastValidatorPower{} }
 func (m *LastValidatorPower) String() string { return proto.CompactTextString(m) }
 func (*LastValidatorPower) ProtoMessage()    {}
 func (*LastValidatorPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08c29689c0e7bd55, []int{1}
+	return fileDescriptor_08c29689c0e7bd55, []int{2}
 }
 func (m *LastValidatorPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,44 +306,61 @@ var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "opinit.opchild.v1.GenesisState")
+	proto.RegisterType((*ValidatorMissedBlocks)(nil), "opinit.opchild.v1.ValidatorMissedBlocks")
 	proto.RegisterType((*LastValidatorPower)(nil), "opinit.opchild.v1.LastValidatorPower")
 }
 
 func init() { proto.RegisterFile("opinit/opchild/v1/genesis.proto", fileDescriptor_08c29689c0e7bd55) }
 
 var fileDescriptor_08c29689c0e7bd55 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x9b, 0xdd, 0x6e, 0x77, 0x2a, 0xe2, 0x8e, 0x55, 0x66, 0x8b, 0x9b, 0x86, 0x05,
-	0x21, 0x08, 0x4d, 0x68, 0xf4, 0x24, 0x22, 0xd8, 0x4b, 0x11, 0x0a, 0x96, 0x14, 0x3c, 0x78, 0x09,
-	0x93, 0x66, 0x9a, 0x0e, 0xa4, 0x33, 0x31, 0x33, 0x5b, 0xab, 0x4f, 0xe0, 0xd1, 0x47, 0xd8, 0xa3,
-	0x47, 0x0f, 0x82, 0xaf, 0xb0, 0xc7, 0xe2, 0xc9, 0x93, 0x48, 0x7b, 0xd0, 0xc7, 0x90, 0x4e, 0xd2,
-	0x50, 0x68, 0x2f, 0x61, 0xbe, 0xef, 0xfb, 0xfd, 0xff, 0x33, 0x99, 0xf9, 0xc3, 0xb6, 0xc8, 0x18,
-	0x67, 0xca, 0x13, 0xd9, 0x78, 0xca, 0xd2, 0xd8, 0x9b, 0x77, 0xbd, 0x84, 0x72, 0x2a, 0x99, 0x74,
-	0xb3, 0x5c, 0x28, 0x81, 0xce, 0x0b, 0xc0, 0x2d, 0x01, 0x77, 0xde, 0x6d, 0x9d, 0x93, 0x19, 0xe3,
-	0xc2, 0xd3, 0xdf, 0x82, 0x6a, 0x5d, 0x8c, 0x85, 0x9c, 0x09, 0x19, 0xea, 0xca, 0x2b, 0x8a, 0x72,
-	0xd4, 0x4c, 0x44, 0x22, 0x8a, 0xfe, 0x66, 0x55, 0x76, 0x2f, 0xf7, 0xf7, 0x55, 0x1f, 0x33, 0x5a,
-	0x8a, 0xae, 0x7e, 0x98, 0xf0, 0x4e, 0xbf, 0x38, 0xc7, 0x48, 0x11, 0x45, 0xd1, 0x0b, 0x58, 0xcb,
-	0x48, 0x4e, 0x66, 0x12, 0x03, 0x1b, 0x38, 0x0d, 0xff, 0xc2, 0xdd, 0x3b, 0x97, 0x3b, 0xd4, 0x40,
-	0xef, 0xec, 0xf6, 0x77, 0xdb, 0xf8, 0xfa, 0xf7, 0xdb, 0x13, 0x10, 0x94, 0x1a, 0x14, 0xc3, 0x07,
-	0x29, 0x91, 0x2a, 0x9c, 0x93, 0x94, 0xc5, 0x44, 0x89, 0x3c, 0xcc, 0xc4, 0x07, 0x9a, 0x4b, 0x7c,
-	0x64, 0x9b, 0x4e, 0xc3, 0x7f, 0x7c, 0xc0, 0x6c, 0x40, 0xa4, 0x7a, 0xbb, 0xc5, 0x87, 0x1b, 0x7a,
-	0xd7, 0xf8, 0x7e, 0xba, 0x37, 0x96, 0xa8, 0x0f, 0x61, 0xb5, 0x81, 0xc4, 0xa6, 0xb6, 0x7e, 0x74,
-	0xc0, 0xba, 0xd2, 0xed, 0x3a, 0xee, 0x48, 0x51, 0x0b, 0xd6, 0xe9, 0x22, 0x13, 0xb9, 0xa2, 0x31,
-	0x3e, 0xb1, 0x81, 0x53, 0x0f, 0xaa, 0x1a, 0x39, 0xf0, 0x1e, 0xa7, 0x0b, 0x15, 0xa6, 0x7e, 0x28,
-	0xe9, 0xfb, 0x6b, 0xca, 0xc7, 0x14, 0xd7, 0x6c, 0xe0, 0x1c, 0x07, 0x77, 0x37, 0xfd, 0x81, 0x3f,
-	0x2a, 0xbb, 0xe8, 0x19, 0x7c, 0x38, 0x61, 0x9c, 0xa4, 0xec, 0x13, 0x8d, 0xc3, 0xb4, 0x5b, 0xe1,
-	0x12, 0x9f, 0xda, 0xa6, 0x73, 0x1c, 0x34, 0xab, 0xe9, 0xa0, 0xbb, 0x15, 0x49, 0xf4, 0x12, 0x36,
-	0xa2, 0x9c, 0xc5, 0x09, 0x0d, 0x19, 0x9f, 0x08, 0x5c, 0xd7, 0xb7, 0x7d, 0x79, 0xe0, 0x2f, 0x7a,
-	0x9a, 0x7a, 0xcd, 0x27, 0x22, 0x80, 0x51, 0xb5, 0xbe, 0x9a, 0x42, 0xb4, 0x7f, 0x75, 0xc8, 0x87,
-	0xa7, 0x24, 0x8e, 0x73, 0x2a, 0x8b, 0xf7, 0x3b, 0xeb, 0xe1, 0x9f, 0xdf, 0x3b, 0xcd, 0x32, 0x27,
-	0xaf, 0x8a, 0xc9, 0x48, 0xe5, 0x8c, 0x27, 0xc1, 0x16, 0x44, 0x4d, 0x78, 0xa2, 0x5f, 0x09, 0x1f,
-	0xd9, 0xc0, 0x31, 0x83, 0xa2, 0x78, 0x5e, 0xff, 0x7c, 0xd3, 0x36, 0xfe, 0xdd, 0xb4, 0x8d, 0x5e,
-	0xff, 0x76, 0x65, 0x81, 0xe5, 0xca, 0x02, 0x7f, 0x56, 0x16, 0xf8, 0xb2, 0xb6, 0x8c, 0xe5, 0xda,
-	0x32, 0x7e, 0xad, 0x2d, 0xe3, 0x5d, 0x27, 0x61, 0x6a, 0x7a, 0x1d, 0xb9, 0x63, 0x31, 0xf3, 0x36,
-	0xc7, 0x66, 0xa4, 0x93, 0x92, 0x48, 0x7a, 0x6f, 0x86, 0x3a, 0x73, 0x8b, 0x2a, 0x75, 0x3a, 0x72,
-	0x51, 0x4d, 0x67, 0xee, 0xe9, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x0d, 0xf0, 0x1f, 0x0c,
-	0x03, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x9b, 0x76, 0x9b, 0x4c, 0x7e, 0xb4, 0x99, 0xa6, 0x65, 0x1a, 0xd1, 0xc4, 0x5a, 0xa8,
+	0xb0, 0x90, 0x36, 0x56, 0x52, 0x4e, 0x80, 0x90, 0x1a, 0x84, 0x56, 0x91, 0xb6, 0x22, 0x72, 0x10,
+	0x20, 0x0e, 0x58, 0x13, 0xcf, 0xac, 0x33, 0xc2, 0x9e, 0x31, 0x7e, 0xce, 0x12, 0xf8, 0x0b, 0x38,
+	0xf2, 0x27, 0xec, 0x91, 0x23, 0x07, 0xfe, 0x07, 0xf6, 0xb8, 0xe2, 0xc4, 0x09, 0xa1, 0xdd, 0x03,
+	0xfc, 0x19, 0xc8, 0x63, 0xc7, 0xeb, 0x90, 0xb0, 0x87, 0x5e, 0x22, 0xbf, 0xf7, 0x7d, 0xef, 0xfb,
+	0x3e, 0x7b, 0x26, 0x0f, 0x0d, 0x54, 0x24, 0xa4, 0x48, 0x6c, 0x15, 0x79, 0x4b, 0x11, 0x30, 0xfb,
+	0x6c, 0x64, 0xfb, 0x5c, 0x72, 0x10, 0x30, 0x8c, 0x62, 0x95, 0x28, 0xdc, 0xc9, 0x08, 0xc3, 0x9c,
+	0x30, 0x3c, 0x1b, 0xf5, 0x3a, 0x34, 0x14, 0x52, 0xd9, 0xfa, 0x37, 0x63, 0xf5, 0x9e, 0x7a, 0x0a,
+	0x42, 0x05, 0xae, 0xae, 0xec, 0xac, 0xc8, 0xa1, 0xae, 0xaf, 0x7c, 0x95, 0xf5, 0xd3, 0xa7, 0xbc,
+	0xfb, 0x6c, 0xd7, 0x37, 0xf9, 0x3e, 0xe2, 0xf9, 0xd0, 0xe1, 0x6f, 0x35, 0xd4, 0x3c, 0xce, 0x72,
+	0xcc, 0x13, 0x9a, 0x70, 0xfc, 0x21, 0x3a, 0x88, 0x68, 0x4c, 0x43, 0x20, 0x86, 0x69, 0x58, 0x8d,
+	0xf1, 0xd3, 0xe1, 0x4e, 0xae, 0xe1, 0x4c, 0x13, 0x26, 0xf5, 0x8b, 0x3f, 0x07, 0x95, 0x9f, 0xff,
+	0xfe, 0xe5, 0x5d, 0xc3, 0xc9, 0x67, 0x30, 0x43, 0x8f, 0x03, 0x0a, 0x89, 0x7b, 0x46, 0x03, 0xc1,
+	0x68, 0xa2, 0x62, 0x37, 0x52, 0xdf, 0xf1, 0x18, 0xc8, 0x1d, 0xb3, 0x6a, 0x35, 0xc6, 0xcf, 0xf7,
+	0x88, 0x9d, 0x50, 0x48, 0x3e, 0xdf, 0xd0, 0x67, 0x29, 0xbb, 0x2c, 0xfc, 0x28, 0xd8, 0x81, 0x01,
+	0x1f, 0x23, 0x54, 0x18, 0x00, 0xa9, 0x6a, 0xe9, 0x37, 0xf7, 0x48, 0x17, 0x73, 0x65, 0xc5, 0xd2,
+	0x28, 0xee, 0xa1, 0x1a, 0x5f, 0x47, 0x2a, 0x4e, 0x38, 0x23, 0xf7, 0x4c, 0xc3, 0xaa, 0x39, 0x45,
+	0x8d, 0x2d, 0xf4, 0x50, 0xf2, 0x75, 0xe2, 0x06, 0x63, 0x17, 0xf8, 0xb7, 0x2b, 0x2e, 0x3d, 0x4e,
+	0x0e, 0x4c, 0xc3, 0xba, 0xeb, 0xb4, 0xd3, 0xfe, 0xc9, 0x78, 0x9e, 0x77, 0xf1, 0x7b, 0xe8, 0xc9,
+	0xa9, 0x90, 0x34, 0x10, 0x3f, 0x70, 0xe6, 0x06, 0xa3, 0x82, 0x0e, 0xe4, 0xbe, 0x59, 0xb5, 0xee,
+	0x3a, 0xdd, 0x02, 0x3d, 0x19, 0x6d, 0x86, 0x00, 0x7f, 0x84, 0x1a, 0x8b, 0x58, 0x30, 0x9f, 0xbb,
+	0x42, 0x9e, 0x2a, 0x52, 0xd3, 0x5f, 0xfb, 0xd9, 0x9e, 0xb7, 0x98, 0x68, 0xd6, 0x54, 0x9e, 0x2a,
+	0x07, 0x2d, 0x8a, 0xe7, 0x9b, 0x7c, 0x37, 0x86, 0xa4, 0x5e, 0xca, 0x57, 0x58, 0xa5, 0x87, 0xc2,
+	0xd7, 0xdc, 0x5b, 0xa5, 0xc7, 0xe1, 0x2d, 0xa9, 0xf4, 0xb9, 0x1b, 0x05, 0x54, 0x02, 0x41, 0xff,
+	0x7b, 0x28, 0x9f, 0xe4, 0xfc, 0x8f, 0x35, 0x7d, 0x16, 0x50, 0xb9, 0x75, 0x28, 0x7c, 0x07, 0x06,
+	0xfc, 0x35, 0xc2, 0xe0, 0x2d, 0x39, 0x5b, 0x05, 0x9c, 0xb9, 0x21, 0x07, 0xa0, 0x3e, 0x07, 0xd2,
+	0xd0, 0x16, 0x6f, 0xef, 0xb1, 0x98, 0x6f, 0xc8, 0xaf, 0x72, 0x6e, 0xd9, 0xa1, 0x03, 0xff, 0x45,
+	0xf1, 0x07, 0xa8, 0xa7, 0xdf, 0x77, 0xd7, 0xc4, 0x15, 0x8c, 0x34, 0xf5, 0x9b, 0xbf, 0x91, 0x32,
+	0x76, 0x84, 0xa7, 0x0c, 0xbf, 0x85, 0x5a, 0x11, 0x97, 0x4c, 0x48, 0xdf, 0xa5, 0x2c, 0x14, 0x92,
+	0xb4, 0x4c, 0xc3, 0xaa, 0x3b, 0xcd, 0xbc, 0xf9, 0x32, 0xed, 0xe1, 0xcf, 0xd0, 0x03, 0x0d, 0xa6,
+	0x7f, 0xae, 0x48, 0x01, 0x0d, 0x80, 0xb4, 0x75, 0x7c, 0x73, 0x4f, 0x7c, 0x3d, 0x32, 0xcb, 0x89,
+	0xe5, 0xe8, 0x6d, 0x5a, 0x46, 0x00, 0xbf, 0x40, 0x4f, 0x74, 0xee, 0x6d, 0xe9, 0x34, 0xf3, 0x03,
+	0x9d, 0xf9, 0x51, 0x8a, 0x6e, 0xa9, 0x4d, 0x19, 0xfe, 0x02, 0xb5, 0x40, 0xf8, 0x32, 0xcd, 0x9b,
+	0xde, 0x0e, 0x20, 0x0f, 0x75, 0x90, 0x77, 0x6e, 0xbb, 0xe4, 0xf3, 0x6c, 0x20, 0xbd, 0x1c, 0xe5,
+	0x3c, 0x4d, 0xb8, 0xe9, 0x03, 0xfe, 0x12, 0xb5, 0x42, 0x01, 0xc0, 0x99, 0xbb, 0x08, 0x94, 0xf7,
+	0x0d, 0x90, 0x8e, 0x16, 0xb6, 0x6e, 0x13, 0x7e, 0xa5, 0x07, 0x26, 0x9a, 0xbf, 0xa5, 0x1c, 0x96,
+	0x80, 0xc3, 0x18, 0x3d, 0xde, 0x3b, 0x81, 0xc7, 0xe8, 0x3e, 0x65, 0x2c, 0xe6, 0x90, 0xad, 0x94,
+	0xfa, 0x84, 0xfc, 0xfe, 0xeb, 0x51, 0x37, 0x5f, 0x5d, 0x2f, 0x33, 0x64, 0x9e, 0xc4, 0x42, 0xfa,
+	0xce, 0x86, 0x88, 0x9f, 0xa3, 0x76, 0x1e, 0x53, 0x48, 0xc6, 0xd7, 0x3c, 0x5b, 0x20, 0x55, 0x27,
+	0x0f, 0x3f, 0xcd, 0x9a, 0x87, 0x4b, 0x84, 0x77, 0xd7, 0xc7, 0x6b, 0x19, 0x76, 0xd1, 0x3d, 0xbd,
+	0xa9, 0xc8, 0x1d, 0xd3, 0xb0, 0xaa, 0x4e, 0x56, 0xbc, 0x5f, 0xfb, 0xf1, 0x7c, 0x50, 0xf9, 0xe7,
+	0x7c, 0x50, 0x99, 0x1c, 0x5f, 0x5c, 0xf5, 0x8d, 0xcb, 0xab, 0xbe, 0xf1, 0xd7, 0x55, 0xdf, 0xf8,
+	0xe9, 0xba, 0x5f, 0xb9, 0xbc, 0xee, 0x57, 0xfe, 0xb8, 0xee, 0x57, 0xbe, 0x3a, 0xf2, 0x45, 0xb2,
+	0x5c, 0x2d, 0x86, 0x9e, 0x0a, 0xed, 0xf4, 0x13, 0x0a, 0x7a, 0x14, 0xd0, 0x05, 0xd8, 0x9f, 0xce,
+	0xd2, 0xca, 0x5e, 0x17, 0x9b, 0x57, 0xaf, 0xdd, 0xc5, 0x81, 0xde, 0xbb, 0x2f, 0xfe, 0x1d, 0x00,
+	0x58, 0x96, 0x2d, 0x08, 0x10, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -225,6 +383,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NextAdminProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAdminProposalId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AdminProposals) > 0 {
+		for iNdEx := len(m.AdminProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.NextScheduledMessagesId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledMessagesId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ScheduledMessages) > 0 {
+		for iNdEx := len(m.ScheduledMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExecutorChangePlans) > 0 {
+		for iNdEx := len(m.ExecutorChangePlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutorChangePlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextL1Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextL1Sequence))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.BridgeInfo != nil {
 		{
 			size, err := m.BridgeInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -311,6 +565,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorMissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.MissedIndexes)*10)
+		var j5 int
+		for _, num1 := range m.MissedIndexes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastValidatorPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -394,6 +697,69 @@ func (m *GenesisState) Size() (n int) {
 		l = m.BridgeInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.NextL1Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.NextL1Sequence))
+	}
+	if len(m.ExecutorChangePlans) > 0 {
+		for _, e := range m.ExecutorChangePlans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledMessages) > 0 {
+		for _, e := range m.ScheduledMessages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledMessagesId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledMessagesId))
+	}
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AdminProposals) > 0 {
+		for _, e := range m.AdminProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAdminProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextAdminProposalId))
+	}
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMissedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MissedIndexes) > 0 {
+		l = 0
+		for _, e := range m.MissedIndexes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -700,6 +1066,423 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextL1Sequence", wireType)
+			}
+			m.NextL1Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextL1Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorChangePlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorChangePlans = append(m.ExecutorChangePlans, ExecutorChangePlan{})
+			if err := m.ExecutorChangePlans[len(m.ExecutorChangePlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMessages = append(m.ScheduledMessages, ScheduledMessages{})
+			if err := m.ScheduledMessages[len(m.ScheduledMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMessagesId", wireType)
+			}
+			m.NextScheduledMessagesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledMessagesId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminProposals = append(m.AdminProposals, AdminProposal{})
+			if err := m.AdminProposals[len(m.AdminProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAdminProposalId", wireType)
+			}
+			m.NextAdminProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAdminProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, ValidatorSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedIndexes = append(m.MissedIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedIndexes) == 0 {
+					m.MissedIndexes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedIndexes = append(m.MissedIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedIndexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,10 +22,37 @@ var (
 	ValidatorsPrefix           = []byte{0x31} // prefix for each key to a validator
 	ValidatorsByConsAddrPrefix = []byte{0x41} // prefix for each key to a validator index, by pubkey
 	FinalizedL1SequencePrefix  = []byte{0x51} // prefix for finalized deposit sequences
+	BufferedDepositPrefix      = []byte{0x52} // prefix for the deposits arrived out of order
+	NextL1SequenceKey          = []byte{0x53} // key for the next contiguous deposit sequence
+	DepositAttestationPrefix   = []byte{0x54} // prefix for the pending deposit attestations
 	HistoricalInfoPrefix       = []byte{0x61} // prefix for the historical info
 	DenomPairPrefix            = []byte{0x71} // prefix for the denom pair
+	NftClassPairPrefix         = []byte{0x72} // prefix for the nft class pair
 
 	// HostValidatorStore keys
 	HostHeightKey        = []byte{0x81}
 	HostValidatorsPrefix = []byte{0x82}
+
+	// withdrawal tree keys
+	WithdrawalLeafPrefix         = []byte{0x91} // prefix for the withdrawal leaves
+	WithdrawalCommitmentPrefix   = []byte{0x92} // prefix for the withdrawal tree roots
+	WithdrawalWindowKey          = []byte{0x93} // key for the current output window
+	ClosedWithdrawalWindowPrefix = []byte{0x94} // prefix for the closed output windows waiting to be pruned
+
+	ExecutorChangePlanPrefix = []byte{0xa1} // prefix for the executor change plans
+
+	// timelock keys
+	ScheduledMessagesPrefix    = []byte{0xb1} // prefix for the scheduled admin messages
+	NextScheduledMessagesIdKey = []byte{0xb2} // key for the next scheduled messages id
+
+	// admin keys
+	PendingAdminKey        = []byte{0xc1} // key for the proposed next admin
+	AdminProposalPrefix    = []byte{0xc2} // prefix for the admin proposals
+	NextAdminProposalIdKey = []byte{0xc3} // key for the next admin proposal id
+
+	// liveness keys
+	ValidatorSigningInfoPrefix = []byte{0xd1} // prefix for the validator signing infos
+	ValidatorMissedBlockPrefix = []byte{0xd2} // prefix for the validator missed blocks
+
+	RotatedValidatorPrefix = []byte{0xe1} // prefix for the validators rotated the consensus pubkey in the current block
 )
@@ -1,20 +1,33 @@
 package types
 
 import (
+	"slices"
+	"strings"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"gopkg.in/yaml.v3"
+
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
 )
 
+// ibcDenomPrefix is the prefix of the ibc voucher denoms.
+const ibcDenomPrefix = "ibc/"
+
 var (
 	DefaultMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(15, 2))) // 0.15
+
+	DefaultSignedBlocksWindow = int64(100)
+	DefaultMinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1) // 0.5
+
+	DefaultAdminProposalExpiry = uint64(1_000_000)
 )
 
 // DefaultParams returns default move parameters
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		"",
 		[]string{""},
 		DefaultMaxValidators,
@@ -22,6 +35,32 @@ func DefaultParams() Params {
 		DefaultMinGasPrices,
 		[]string{},
 	)
+	params.SignedBlocksWindow = DefaultSignedBlocksWindow
+	params.MinSignedPerWindow = DefaultMinSignedPerWindow
+	params.AdminProposalExpiry = DefaultAdminProposalExpiry
+	params.AllowedExecuteMessages = DefaultAllowedExecuteMessages()
+
+	return params
+}
+
+// DefaultAllowedExecuteMessages returns the opchild authority messages, which
+// include MsgUpdateParams to extend the allowlist.
+func DefaultAllowedExecuteMessages() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgAddValidator{}),
+		sdk.MsgTypeURL(&MsgRemoveValidator{}),
+		sdk.MsgTypeURL(&MsgUnjailValidator{}),
+		sdk.MsgTypeURL(&MsgRotateConsPubKey{}),
+		sdk.MsgTypeURL(&MsgUpdateValidatorPower{}),
+		sdk.MsgTypeURL(&MsgSetValidatorSet{}),
+		sdk.MsgTypeURL(&MsgUpdateParams{}),
+		sdk.MsgTypeURL(&MsgSpendFeePool{}),
+		sdk.MsgTypeURL(&MsgUpdateDenomMetadata{}),
+		sdk.MsgTypeURL(&MsgRegisterExecutorChangePlan{}),
+		sdk.MsgTypeURL(&MsgCancelExecutorChangePlan{}),
+		sdk.MsgTypeURL(&MsgProposeAdmin{}),
+		sdk.MsgTypeURL(&MsgCancelScheduledMessages{}),
+	}
 }
 
 // NewParams creates a new Params instance
@@ -64,6 +103,73 @@ func (p Params) Validate(ac address.Codec) error {
 		return ErrZeroMaxValidators
 	}
 
+	for _, roleAddrs := range [][]string{p.DepositRelayers, p.OracleRelayers, p.BridgeInfoSetters} {
+		for _, addr := range roleAddrs {
+			if _, err := ac.StringToBytes(addr); err != nil {
+				return err
+			}
+		}
+	}
+
+	if depositRelayers := p.DepositRelayerAddrs(); int(p.DepositAttestationThreshold) > len(depositRelayers) {
+		return ErrInvalidAttestationThreshold.Wrapf("threshold %d exceeds the number of deposit relayers %d", p.DepositAttestationThreshold, len(depositRelayers))
+	}
+
+	allowedMsgs := make(map[string]bool, len(p.AllowedExecuteMessages))
+	for _, typeURL := range p.AllowedExecuteMessages {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return ErrInvalidExecuteMsg.Wrapf("invalid allowed message type url %q", typeURL)
+		}
+		if allowedMsgs[typeURL] {
+			return ErrInvalidExecuteMsg.Wrapf("duplicate allowed message type url %q", typeURL)
+		}
+		allowedMsgs[typeURL] = true
+	}
+
+	l2NativeDenoms := make(map[string]bool, len(p.L2NativeDenoms))
+	for _, denom := range p.L2NativeDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if strings.HasPrefix(denom, ibcDenomPrefix) || strings.HasPrefix(denom, ophosttypes.L2_DENOM_PREFIX) {
+			return ErrInvalidL2NativeDenom.Wrapf("%s is not originated from l2", denom)
+		}
+		if l2NativeDenoms[denom] {
+			return ErrInvalidL2NativeDenom.Wrapf("duplicate l2 native denom %s", denom)
+		}
+		l2NativeDenoms[denom] = true
+	}
+
+	if (p.DepositProofRequired || p.BridgeInfoProofRequired) && p.L1ClientId == "" {
+		return ErrInvalidL1ClientId.Wrap("l1 client id is required to require the proofs")
+	}
+
+	signers := make(map[string]bool, len(p.AdminSigners)+1)
+	signers[p.Admin] = true
+	for _, signer := range p.AdminSigners {
+		if _, err := ac.StringToBytes(signer); err != nil {
+			return err
+		}
+		if signers[signer] {
+			return ErrInvalidAdminThreshold.Wrapf("duplicate admin signer %s", signer)
+		}
+		signers[signer] = true
+	}
+
+	if int(p.AdminThreshold) > len(signers) {
+		return ErrInvalidAdminThreshold.Wrapf("threshold %d exceeds the number of admin signers %d", p.AdminThreshold, len(signers))
+	}
+	if p.AdminProposalExpiry == 0 {
+		return ErrInvalidAdminThreshold.Wrap("admin proposal expiry must be positive")
+	}
+
+	if p.SignedBlocksWindow < 0 {
+		return ErrInvalidSigningParams.Wrapf("signed blocks window must not be negative: %d", p.SignedBlocksWindow)
+	}
+	if minSigned := p.MinSignedPerWindow; !minSigned.IsNil() && (minSigned.IsNegative() || minSigned.GT(math.LegacyOneDec())) {
+		return ErrInvalidSigningParams.Wrapf("min signed per window must be between 0 and 1: %s", minSigned)
+	}
+
 	// Validate fee whitelist addresses
 	for _, addr := range p.FeeWhitelist {
 		if _, err := ac.StringToBytes(addr); err != nil {
@@ -73,3 +179,62 @@ func (p Params) Validate(ac address.Codec) error {
 
 	return nil
 }
+
+// IsExecuteMessageAllowed returns true if the admin can execute the message
+// type url with MsgExecuteMessages. An empty allowlist denies all the messages.
+func (p Params) IsExecuteMessageAllowed(typeURL string) bool {
+	return slices.Contains(p.AllowedExecuteMessages, typeURL)
+}
+
+// IsL2NativeDenom returns true if the denom is originated from l2 and can be
+// withdrawn to l1 as a minted representation.
+func (p Params) IsL2NativeDenom(denom string) bool {
+	return slices.Contains(p.L2NativeDenoms, denom)
+}
+
+// IsAdminSigner returns true if the address is the admin or one of the admin
+// signers.
+func (p Params) IsAdminSigner(addr string) bool {
+	return p.Admin == addr || slices.Contains(p.AdminSigners, addr)
+}
+
+// DepositRelayerAddrs returns the addresses allowed to finalize the deposits.
+func (p Params) DepositRelayerAddrs() []string {
+	return orBridgeExecutors(p.DepositRelayers, p.BridgeExecutors)
+}
+
+// OracleRelayerAddrs returns the addresses allowed to update the oracle.
+func (p Params) OracleRelayerAddrs() []string {
+	return orBridgeExecutors(p.OracleRelayers, p.BridgeExecutors)
+}
+
+// BridgeInfoSetterAddrs returns the addresses allowed to set the bridge info.
+func (p Params) BridgeInfoSetterAddrs() []string {
+	return orBridgeExecutors(p.BridgeInfoSetters, p.BridgeExecutors)
+}
+
+// orBridgeExecutors falls back to the bridge executors for the roles not
+// assigned to any address.
+func orBridgeExecutors(roleAddrs, bridgeExecutors []string) []string {
+	if len(roleAddrs) == 0 {
+		return bridgeExecutors
+	}
+
+	return roleAddrs
+}
+
+// MinSignedBlocks returns the minimum number of the blocks a validator must
+// sign within the signed blocks window.
+func (p Params) MinSignedBlocks() int64 {
+	if p.MinSignedPerWindow.IsNil() {
+		return 0
+	}
+
+	return p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+}
+
+// MaxMissedBlocks returns the maximum number of the blocks a validator can
+// miss within the signed blocks window.
+func (p Params) MaxMissedBlocks() int64 {
+	return p.SignedBlocksWindow - p.MinSignedBlocks()
+}
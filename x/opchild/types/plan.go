@@ -1,18 +1,37 @@
 package types
 
-type ExecutorChangePlan struct {
-	// L1 governance proposal id
-	ProposalID uint64
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
 
-	// Upgrade height
-	Height uint64
+var _ codectypes.UnpackInterfacesMessage = ExecutorChangePlan{}
 
-	// Next executor addresses
-	NextExecutors []string
+// Validate performs basic ExecutorChangePlan validation.
+func (plan ExecutorChangePlan) Validate(ac address.Codec) error {
+	if plan.ProposalID == 0 {
+		return errorsmod.Wrap(ErrInvalidExecutorChangePlan, "invalid proposal id")
+	}
 
-	// Next validator
-	NextValidator Validator
+	if plan.Height == 0 {
+		return errorsmod.Wrap(ErrInvalidExecutorChangePlan, "invalid height")
+	}
 
-	// Additional information
-	Info string
+	for _, nextExecutor := range plan.NextExecutors {
+		if _, err := ac.StringToBytes(nextExecutor); err != nil {
+			return err
+		}
+	}
+
+	if plan.NextValidator.ConsensusPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (plan ExecutorChangePlan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return plan.NextValidator.UnpackInterfaces(unpacker)
 }
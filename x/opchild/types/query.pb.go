@@ -391,638 +391,6220 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryValidatorsRequest)(nil), "opinit.opchild.v1.QueryValidatorsRequest")
-	proto.RegisterType((*QueryValidatorsResponse)(nil), "opinit.opchild.v1.QueryValidatorsResponse")
-	proto.RegisterType((*QueryValidatorRequest)(nil), "opinit.opchild.v1.QueryValidatorRequest")
-	proto.RegisterType((*QueryValidatorResponse)(nil), "opinit.opchild.v1.QueryValidatorResponse")
-	proto.RegisterType((*QueryBridgeInfoRequest)(nil), "opinit.opchild.v1.QueryBridgeInfoRequest")
-	proto.RegisterType((*QueryBridgeInfoResponse)(nil), "opinit.opchild.v1.QueryBridgeInfoResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "opinit.opchild.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "opinit.opchild.v1.QueryParamsResponse")
+// QueryWithdrawalCommitmentRequest is request type for the Query/WithdrawalCommitment RPC method.
+type QueryWithdrawalCommitmentRequest struct {
+	// height is the l2 block height; zero for the latest height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() { proto.RegisterFile("opinit/opchild/v1/query.proto", fileDescriptor_15cfbb5d02a763ec) }
-
-var fileDescriptor_15cfbb5d02a763ec = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x8b, 0xd3, 0x4e,
-	0x18, 0xc7, 0x9b, 0xfd, 0xfd, 0x2c, 0x74, 0x8a, 0xc2, 0x8e, 0x55, 0xdb, 0xee, 0x36, 0x5b, 0x02,
-	0xd6, 0x6e, 0xa5, 0x19, 0x5a, 0x6f, 0x22, 0x88, 0x05, 0x5d, 0xf6, 0x64, 0xed, 0x82, 0x88, 0x97,
-	0x3a, 0x69, 0x66, 0xb3, 0x03, 0x6d, 0x26, 0x9b, 0xa4, 0xc5, 0x45, 0x44, 0xf0, 0xe4, 0xcd, 0x05,
-	0xaf, 0xbe, 0x00, 0xc1, 0x8b, 0x07, 0x5f, 0xc4, 0x1e, 0x3c, 0x2c, 0x7a, 0xf1, 0x24, 0xd2, 0x0a,
-	0xbe, 0x0d, 0xc9, 0xcc, 0x34, 0x7f, 0x6c, 0x6a, 0x7b, 0x29, 0xcd, 0xf3, 0x3c, 0xdf, 0xe7, 0xfb,
-	0x99, 0x67, 0x9e, 0x04, 0x54, 0x98, 0x43, 0x6d, 0xea, 0x23, 0xe6, 0x0c, 0x8e, 0xe8, 0xd0, 0x44,
-	0x93, 0x16, 0x3a, 0x1e, 0x13, 0xf7, 0x44, 0x77, 0x5c, 0xe6, 0x33, 0xb8, 0x29, 0xd2, 0xba, 0x4c,
-	0xeb, 0x93, 0x56, 0x79, 0x13, 0x8f, 0xa8, 0xcd, 0x10, 0xff, 0x15, 0x55, 0xe5, 0xc6, 0x80, 0x79,
-	0x23, 0xe6, 0x21, 0x03, 0x7b, 0x44, 0xc8, 0xd1, 0xa4, 0x65, 0x10, 0x1f, 0xb7, 0x90, 0x83, 0x2d,
-	0x6a, 0x63, 0x9f, 0x32, 0x5b, 0xd6, 0x6e, 0xc9, 0xda, 0x79, 0x59, 0xdc, 0xae, 0x5c, 0x12, 0xc9,
-	0x3e, 0x7f, 0x42, 0xe2, 0x41, 0xa6, 0x0a, 0x16, 0xb3, 0x98, 0x88, 0x07, 0xff, 0x64, 0x74, 0xdb,
-	0x62, 0xcc, 0x1a, 0x12, 0x84, 0x1d, 0x8a, 0xb0, 0x6d, 0x33, 0x9f, 0x5b, 0xcd, 0x35, 0x29, 0x87,
-	0xf3, 0x4f, 0x1c, 0x22, 0xd3, 0xda, 0x33, 0x70, 0xf5, 0x51, 0x60, 0xfe, 0x18, 0x0f, 0xa9, 0x89,
-	0x7d, 0xe6, 0x7a, 0x3d, 0x72, 0x3c, 0x26, 0x9e, 0x0f, 0x1f, 0x00, 0x10, 0x81, 0x17, 0x95, 0xaa,
-	0x52, 0xcf, 0xb7, 0x6b, 0xba, 0xe4, 0x09, 0x4e, 0xa9, 0x0b, 0x6a, 0x79, 0x4a, 0xbd, 0x8b, 0x2d,
-	0x22, 0xb5, 0xbd, 0x98, 0x52, 0xfb, 0xa8, 0x80, 0x6b, 0x0b, 0x16, 0x9e, 0xc3, 0x6c, 0x8f, 0xc0,
-	0x3d, 0x00, 0x26, 0x61, 0xb4, 0xa8, 0x54, 0xff, 0xab, 0xe7, 0xdb, 0xdb, 0xfa, 0xc2, 0xbc, 0xf5,
-	0x50, 0xda, 0xc9, 0x9d, 0xfd, 0xd8, 0xc9, 0x7c, 0xf8, 0xfd, 0xa9, 0xa1, 0xf4, 0x62, 0xd2, 0xa0,
-	0x51, 0x0c, 0x76, 0x83, 0xc3, 0xde, 0x58, 0x09, 0x2b, 0x28, 0x12, 0xb4, 0x4f, 0xc0, 0x95, 0x24,
-	0xec, 0x7c, 0x1c, 0x77, 0xc1, 0xa5, 0xd0, 0xaf, 0x8f, 0x4d, 0xd3, 0xe5, 0x23, 0xc9, 0x75, 0x8a,
-	0x5f, 0x3f, 0x37, 0x0b, 0xd2, 0xe8, 0x9e, 0x69, 0xba, 0xc4, 0xf3, 0x0e, 0x7c, 0x97, 0xda, 0x56,
-	0xef, 0x62, 0x58, 0x1f, 0xc4, 0xb5, 0xfe, 0xdf, 0x93, 0x0e, 0xa7, 0x70, 0x1f, 0xe4, 0xc2, 0x52,
-	0x39, 0xe8, 0xb5, 0x87, 0x10, 0x29, 0xb5, 0xa2, 0x34, 0xe8, 0xb8, 0xd4, 0xb4, 0xc8, 0xbe, 0x7d,
-	0xc8, 0x24, 0xbb, 0x66, 0xca, 0x1b, 0x88, 0x67, 0xa4, 0xf7, 0x3e, 0xc8, 0x1b, 0x3c, 0xda, 0xa7,
-	0xf6, 0x21, 0x93, 0xee, 0x95, 0x14, 0xf7, 0x48, 0x9b, 0xb8, 0x03, 0x23, 0x0c, 0x6b, 0x05, 0x00,
-	0xb9, 0x4b, 0x17, 0xbb, 0x78, 0x34, 0x5f, 0x23, 0xed, 0x00, 0x5c, 0x4e, 0x44, 0xa5, 0xef, 0x1d,
-	0x90, 0x75, 0x78, 0x44, 0x5a, 0x96, 0x52, 0x2c, 0x85, 0x24, 0x6e, 0x27, 0x35, 0xed, 0x2f, 0xff,
-	0x83, 0x0b, 0xbc, 0x2b, 0x7c, 0xab, 0x00, 0x10, 0x2d, 0x16, 0xdc, 0x4d, 0x69, 0x93, 0xbe, 0xdf,
-	0xe5, 0xc6, 0x3a, 0xa5, 0x82, 0x56, 0x6b, 0xbc, 0x09, 0xec, 0x5f, 0x7f, 0xfb, 0xf5, 0x6e, 0x63,
-	0x07, 0x56, 0xd0, 0xe2, 0x2b, 0x15, 0x5b, 0xc5, 0xf7, 0x0a, 0xc8, 0x85, 0x2d, 0x60, 0x7d, 0xa5,
-	0xcb, 0x9c, 0x67, 0x77, 0x8d, 0x4a, 0x89, 0x73, 0x3b, 0xc2, 0x41, 0xb0, 0xf9, 0x2f, 0x1c, 0xf4,
-	0x22, 0xb9, 0xb4, 0x2f, 0xe1, 0xa9, 0x02, 0x40, 0x74, 0x97, 0xcb, 0x07, 0xb6, 0xb0, 0x45, 0xcb,
-	0x07, 0xb6, 0xb8, 0x56, 0xda, 0xcd, 0x88, 0xb0, 0x0a, 0xd5, 0x14, 0xc2, 0xd8, 0xd2, 0xc1, 0x57,
-	0x20, 0x2b, 0xae, 0x1a, 0x5e, 0x5f, 0x66, 0x91, 0xd8, 0xa9, 0x72, 0x6d, 0x55, 0x99, 0xa4, 0xa8,
-	0x45, 0x14, 0x5b, 0xb0, 0x94, 0x42, 0x21, 0xd6, 0xa9, 0xb3, 0x77, 0x36, 0x55, 0x95, 0xf3, 0xa9,
-	0xaa, 0xfc, 0x9c, 0xaa, 0xca, 0xe9, 0x4c, 0xcd, 0x9c, 0xcf, 0xd4, 0xcc, 0xf7, 0x99, 0x9a, 0x79,
-	0xda, 0xb4, 0xa8, 0x7f, 0x34, 0x36, 0xf4, 0x01, 0x1b, 0xa1, 0x40, 0x4c, 0x71, 0x73, 0x88, 0x0d,
-	0x0f, 0x3d, 0xec, 0xf2, 0x56, 0xcf, 0xc3, 0x66, 0xfc, 0x9b, 0x6a, 0x64, 0xf9, 0x47, 0xf5, 0xd6,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0xcf, 0xbf, 0x6e, 0x52, 0x06, 0x00, 0x00,
+func (m *QueryWithdrawalCommitmentRequest) Reset()         { *m = QueryWithdrawalCommitmentRequest{} }
+func (m *QueryWithdrawalCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalCommitmentRequest) ProtoMessage()    {}
+func (*QueryWithdrawalCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{8}
+}
+func (m *QueryWithdrawalCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawalCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalCommitmentRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalCommitmentRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryWithdrawalCommitmentRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Validators queries all validators
-	//
-	// When called from another module, this query might consume a high amount of
-	// gas if the pagination field is incorrectly set.
-	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
-	// BridgeInfo queries the bridge information.
-	BridgeInfo(ctx context.Context, in *QueryBridgeInfoRequest, opts ...grpc.CallOption) (*QueryBridgeInfoResponse, error)
-	// Parameters queries the rollup parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryWithdrawalCommitmentRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryWithdrawalCommitmentResponse is response type for the Query/WithdrawalCommitment RPC method.
+type QueryWithdrawalCommitmentResponse struct {
+	Commitment WithdrawalCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWithdrawalCommitmentResponse) Reset()         { *m = QueryWithdrawalCommitmentResponse{} }
+func (m *QueryWithdrawalCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalCommitmentResponse) ProtoMessage()    {}
+func (*QueryWithdrawalCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{9}
 }
-
-func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error) {
-	out := new(QueryValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawalCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryWithdrawalCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalCommitmentResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalCommitmentResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
-	out := new(QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalCommitmentResponse) GetCommitment() WithdrawalCommitment {
+	if m != nil {
+		return m.Commitment
 	}
-	return out, nil
+	return WithdrawalCommitment{}
 }
 
-func (c *queryClient) BridgeInfo(ctx context.Context, in *QueryBridgeInfoRequest, opts ...grpc.CallOption) (*QueryBridgeInfoResponse, error) {
-	out := new(QueryBridgeInfoResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/BridgeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryWithdrawalProofRequest is request type for the Query/WithdrawalProof RPC method.
+type QueryWithdrawalProofRequest struct {
+	// sequence is the l2 sequence of the withdrawal.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawalProofRequest) Reset()         { *m = QueryWithdrawalProofRequest{} }
+func (m *QueryWithdrawalProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalProofRequest) ProtoMessage()    {}
+func (*QueryWithdrawalProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{10}
+}
+func (m *QueryWithdrawalProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryWithdrawalProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalProofRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalProofRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Validators queries all validators
-	//
-	// When called from another module, this query might consume a high amount of
-	// gas if the pagination field is incorrectly set.
-	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
-	// BridgeInfo queries the bridge information.
-	BridgeInfo(context.Context, *QueryBridgeInfoRequest) (*QueryBridgeInfoResponse, error)
-	// Parameters queries the rollup parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryWithdrawalProofRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalProofRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryWithdrawalProofResponse is response type for the Query/WithdrawalProof RPC method.
+type QueryWithdrawalProofResponse struct {
+	Leaf WithdrawalLeaf `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf"`
+	// withdrawal_proofs is the merkle proof from the leaf to the storage root.
+	WithdrawalProofs [][]byte `protobuf:"bytes,2,rep,name=withdrawal_proofs,json=withdrawalProofs,proto3" json:"withdrawal_proofs,omitempty"`
+	// commitment is the latest commitment of the output window of the withdrawal.
+	Commitment WithdrawalCommitment `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment"`
+	// bridge_id is the id of the bridge on l1.
+	BridgeId uint64 `protobuf:"varint,4,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// version is the output root version of the commitment.
+	Version []byte `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// output_root is the output root computed from the version, the state
+	// root, the storage root and the latest block hash of the commitment;
+	// empty until the state root is recorded.
+	OutputRoot []byte `protobuf:"bytes,6,opt,name=output_root,json=outputRoot,proto3" json:"output_root,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QueryWithdrawalProofResponse) Reset()         { *m = QueryWithdrawalProofResponse{} }
+func (m *QueryWithdrawalProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalProofResponse) ProtoMessage()    {}
+func (*QueryWithdrawalProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{11}
 }
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+func (m *QueryWithdrawalProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BridgeInfo(ctx context.Context, req *QueryBridgeInfoRequest) (*QueryBridgeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeInfo not implemented")
+func (m *QueryWithdrawalProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryWithdrawalProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalProofResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryWithdrawalProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalProofResponse.DiscardUnknown(m)
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*QueryValidatorsRequest))
+var xxx_messageInfo_QueryWithdrawalProofResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalProofResponse) GetLeaf() WithdrawalLeaf {
+	if m != nil {
+		return m.Leaf
 	}
-	return interceptor(ctx, in, info, handler)
+	return WithdrawalLeaf{}
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+func (m *QueryWithdrawalProofResponse) GetWithdrawalProofs() [][]byte {
+	if m != nil {
+		return m.WithdrawalProofs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BridgeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BridgeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Query/BridgeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BridgeInfo(ctx, req.(*QueryBridgeInfoRequest))
+func (m *QueryWithdrawalProofResponse) GetCommitment() WithdrawalCommitment {
+	if m != nil {
+		return m.Commitment
 	}
-	return interceptor(ctx, in, info, handler)
+	return WithdrawalCommitment{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryWithdrawalProofResponse) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "opinit.opchild.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "BridgeInfo",
-			Handler:    _Query_BridgeInfo_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "opinit/opchild/v1/query.proto",
+func (m *QueryWithdrawalProofResponse) GetVersion() []byte {
+	if m != nil {
+		return m.Version
+	}
+	return nil
 }
 
-func (m *QueryValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawalProofResponse) GetOutputRoot() []byte {
+	if m != nil {
+		return m.OutputRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositStatusRequest is request type for the Query/DepositStatus RPC method.
+type QueryDepositStatusRequest struct {
 }
 
-func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositStatusRequest) Reset()         { *m = QueryDepositStatusRequest{} }
+func (m *QueryDepositStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositStatusRequest) ProtoMessage()    {}
+func (*QueryDepositStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{12}
+}
+func (m *QueryDepositStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositStatusRequest.Merge(m, src)
+}
+func (m *QueryDepositStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDepositStatusRequest proto.InternalMessageInfo
+
+// QueryDepositStatusResponse is response type for the Query/DepositStatus RPC method.
+type QueryDepositStatusResponse struct {
+	// highest_contiguous_sequence is the highest l1 sequence below which all
+	// the deposits are finalized; zero if no deposit is finalized.
+	HighestContiguousSequence uint64 `protobuf:"varint,1,opt,name=highest_contiguous_sequence,json=highestContiguousSequence,proto3" json:"highest_contiguous_sequence,omitempty"`
+	// missing_ranges are the l1 sequence ranges which are neither finalized nor
+	// buffered below the highest known l1 sequence.
+	MissingRanges []SequenceRange `protobuf:"bytes,2,rep,name=missing_ranges,json=missingRanges,proto3" json:"missing_ranges"`
+	// buffered_sequences are the l1 sequences of the deposits waiting for the
+	// missing deposits to be finalized.
+	BufferedSequences []uint64 `protobuf:"varint,3,rep,packed,name=buffered_sequences,json=bufferedSequences,proto3" json:"buffered_sequences,omitempty"`
 }
 
-func (m *QueryValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositStatusResponse) Reset()         { *m = QueryDepositStatusResponse{} }
+func (m *QueryDepositStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositStatusResponse) ProtoMessage()    {}
+func (*QueryDepositStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{13}
+}
+func (m *QueryDepositStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositStatusResponse.Merge(m, src)
+}
+func (m *QueryDepositStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDepositStatusResponse proto.InternalMessageInfo
+
+func (m *QueryDepositStatusResponse) GetHighestContiguousSequence() uint64 {
+	if m != nil {
+		return m.HighestContiguousSequence
+	}
+	return 0
 }
 
-func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDepositStatusResponse) GetMissingRanges() []SequenceRange {
+	if m != nil {
+		return m.MissingRanges
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositStatusResponse) GetBufferedSequences() []uint64 {
+	if m != nil {
+		return m.BufferedSequences
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositAttestationsRequest is request type for the Query/DepositAttestations RPC method.
+type QueryDepositAttestationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDepositAttestationsRequest) Reset()         { *m = QueryDepositAttestationsRequest{} }
+func (m *QueryDepositAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAttestationsRequest) ProtoMessage()    {}
+func (*QueryDepositAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{14}
+}
+func (m *QueryDepositAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBridgeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAttestationsRequest.Merge(m, src)
 }
-
-func (m *QueryBridgeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositAttestationsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBridgeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDepositAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAttestationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBridgeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryDepositAttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBridgeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositAttestationsResponse is response type for the Query/DepositAttestations RPC method.
+type QueryDepositAttestationsResponse struct {
+	Attestations []DepositAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBridgeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDepositAttestationsResponse) Reset()         { *m = QueryDepositAttestationsResponse{} }
+func (m *QueryDepositAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAttestationsResponse) ProtoMessage()    {}
+func (*QueryDepositAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{15}
+}
+func (m *QueryDepositAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAttestationsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAttestationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryDepositAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryDepositAttestationsResponse) GetAttestations() []DepositAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositAttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExecutorChangePlanRequest is request type for the Query/ExecutorChangePlan RPC method.
+type QueryExecutorChangePlanRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryExecutorChangePlanRequest) Reset()         { *m = QueryExecutorChangePlanRequest{} }
+func (m *QueryExecutorChangePlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorChangePlanRequest) ProtoMessage()    {}
+func (*QueryExecutorChangePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{16}
+}
+func (m *QueryExecutorChangePlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorChangePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorChangePlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryExecutorChangePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorChangePlanRequest.Merge(m, src)
+}
+func (m *QueryExecutorChangePlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorChangePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorChangePlanRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryExecutorChangePlanRequest proto.InternalMessageInfo
+
+func (m *QueryExecutorChangePlanRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryExecutorChangePlanResponse is response type for the Query/ExecutorChangePlan RPC method.
+type QueryExecutorChangePlanResponse struct {
+	Plan ExecutorChangePlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
+}
+
+func (m *QueryExecutorChangePlanResponse) Reset()         { *m = QueryExecutorChangePlanResponse{} }
+func (m *QueryExecutorChangePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorChangePlanResponse) ProtoMessage()    {}
+func (*QueryExecutorChangePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{17}
+}
+func (m *QueryExecutorChangePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorChangePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorChangePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryExecutorChangePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorChangePlanResponse.Merge(m, src)
+}
+func (m *QueryExecutorChangePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorChangePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorChangePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutorChangePlanResponse proto.InternalMessageInfo
+
+func (m *QueryExecutorChangePlanResponse) GetPlan() ExecutorChangePlan {
+	if m != nil {
+		return m.Plan
 	}
-	return n
+	return ExecutorChangePlan{}
 }
 
-func (m *QueryValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryExecutorChangePlansRequest is request type for the Query/ExecutorChangePlans RPC method.
+type QueryExecutorChangePlansRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutorChangePlansRequest) Reset()         { *m = QueryExecutorChangePlansRequest{} }
+func (m *QueryExecutorChangePlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorChangePlansRequest) ProtoMessage()    {}
+func (*QueryExecutorChangePlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{18}
+}
+func (m *QueryExecutorChangePlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorChangePlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorChangePlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryExecutorChangePlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorChangePlansRequest.Merge(m, src)
+}
+func (m *QueryExecutorChangePlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorChangePlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorChangePlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutorChangePlansRequest proto.InternalMessageInfo
+
+func (m *QueryExecutorChangePlansRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExecutorChangePlansResponse is response type for the Query/ExecutorChangePlans RPC method.
+type QueryExecutorChangePlansResponse struct {
+	Plans []ExecutorChangePlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutorChangePlansResponse) Reset()         { *m = QueryExecutorChangePlansResponse{} }
+func (m *QueryExecutorChangePlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorChangePlansResponse) ProtoMessage()    {}
+func (*QueryExecutorChangePlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{19}
+}
+func (m *QueryExecutorChangePlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorChangePlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorChangePlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryExecutorChangePlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorChangePlansResponse.Merge(m, src)
+}
+func (m *QueryExecutorChangePlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorChangePlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorChangePlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutorChangePlansResponse proto.InternalMessageInfo
+
+func (m *QueryExecutorChangePlansResponse) GetPlans() []ExecutorChangePlan {
+	if m != nil {
+		return m.Plans
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExecutorChangePlansResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryScheduledMessagesRequest is request type for the Query/ScheduledMessages RPC method.
+type QueryScheduledMessagesRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryScheduledMessagesRequest) Reset()         { *m = QueryScheduledMessagesRequest{} }
+func (m *QueryScheduledMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMessagesRequest) ProtoMessage()    {}
+func (*QueryScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{20}
+}
+func (m *QueryScheduledMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryScheduledMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMessagesRequest.Merge(m, src)
+}
+func (m *QueryScheduledMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryScheduledMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledMessagesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryBridgeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryScheduledMessagesResponse is response type for the Query/ScheduledMessages RPC method.
+type QueryScheduledMessagesResponse struct {
+	ScheduledMessages ScheduledMessages `protobuf:"bytes,1,opt,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages"`
+}
+
+func (m *QueryScheduledMessagesResponse) Reset()         { *m = QueryScheduledMessagesResponse{} }
+func (m *QueryScheduledMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMessagesResponse) ProtoMessage()    {}
+func (*QueryScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{21}
+}
+func (m *QueryScheduledMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryScheduledMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMessagesResponse.Merge(m, src)
+}
+func (m *QueryScheduledMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBridgeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryScheduledMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledMessagesResponse) GetScheduledMessages() ScheduledMessages {
+	if m != nil {
+		return m.ScheduledMessages
 	}
-	var l int
-	_ = l
-	l = m.BridgeInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ScheduledMessages{}
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryScheduledMessagesListRequest is request type for the Query/ScheduledMessagesList RPC method.
+type QueryScheduledMessagesListRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledMessagesListRequest) Reset()         { *m = QueryScheduledMessagesListRequest{} }
+func (m *QueryScheduledMessagesListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMessagesListRequest) ProtoMessage()    {}
+func (*QueryScheduledMessagesListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{22}
+}
+func (m *QueryScheduledMessagesListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMessagesListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMessagesListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryScheduledMessagesListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMessagesListRequest.Merge(m, src)
+}
+func (m *QueryScheduledMessagesListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMessagesListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMessagesListRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryScheduledMessagesListRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledMessagesListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
+}
+
+// QueryScheduledMessagesListResponse is response type for the Query/ScheduledMessagesList RPC method.
+type QueryScheduledMessagesListResponse struct {
+	ScheduledMessages []ScheduledMessages `protobuf:"bytes,1,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryScheduledMessagesListResponse) Reset()         { *m = QueryScheduledMessagesListResponse{} }
+func (m *QueryScheduledMessagesListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMessagesListResponse) ProtoMessage()    {}
+func (*QueryScheduledMessagesListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{23}
+}
+func (m *QueryScheduledMessagesListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMessagesListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMessagesListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledMessagesListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMessagesListResponse.Merge(m, src)
+}
+func (m *QueryScheduledMessagesListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMessagesListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMessagesListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledMessagesListResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledMessagesListResponse) GetScheduledMessages() []ScheduledMessages {
+	if m != nil {
+		return m.ScheduledMessages
+	}
+	return nil
+}
+
+func (m *QueryScheduledMessagesListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingAdminRequest is request type for the Query/PendingAdmin RPC method.
+type QueryPendingAdminRequest struct {
+}
+
+func (m *QueryPendingAdminRequest) Reset()         { *m = QueryPendingAdminRequest{} }
+func (m *QueryPendingAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAdminRequest) ProtoMessage()    {}
+func (*QueryPendingAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{24}
+}
+func (m *QueryPendingAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAdminRequest.Merge(m, src)
+}
+func (m *QueryPendingAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAdminRequest proto.InternalMessageInfo
+
+// QueryPendingAdminResponse is response type for the Query/PendingAdmin RPC method.
+type QueryPendingAdminResponse struct {
+	// pending_admin is empty when no admin transfer is proposed.
+	PendingAdmin string `protobuf:"bytes,1,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
+}
+
+func (m *QueryPendingAdminResponse) Reset()         { *m = QueryPendingAdminResponse{} }
+func (m *QueryPendingAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAdminResponse) ProtoMessage()    {}
+func (*QueryPendingAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{25}
+}
+func (m *QueryPendingAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAdminResponse.Merge(m, src)
+}
+func (m *QueryPendingAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAdminResponse proto.InternalMessageInfo
+
+func (m *QueryPendingAdminResponse) GetPendingAdmin() string {
+	if m != nil {
+		return m.PendingAdmin
+	}
+	return ""
+}
+
+// QueryAdminProposalRequest is request type for the Query/AdminProposal RPC method.
+type QueryAdminProposalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryAdminProposalRequest) Reset()         { *m = QueryAdminProposalRequest{} }
+func (m *QueryAdminProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminProposalRequest) ProtoMessage()    {}
+func (*QueryAdminProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{26}
+}
+func (m *QueryAdminProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminProposalRequest.Merge(m, src)
+}
+func (m *QueryAdminProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminProposalRequest proto.InternalMessageInfo
+
+func (m *QueryAdminProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryAdminProposalResponse is response type for the Query/AdminProposal RPC method.
+type QueryAdminProposalResponse struct {
+	Proposal AdminProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryAdminProposalResponse) Reset()         { *m = QueryAdminProposalResponse{} }
+func (m *QueryAdminProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminProposalResponse) ProtoMessage()    {}
+func (*QueryAdminProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{27}
+}
+func (m *QueryAdminProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminProposalResponse.Merge(m, src)
+}
+func (m *QueryAdminProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminProposalResponse proto.InternalMessageInfo
+
+func (m *QueryAdminProposalResponse) GetProposal() AdminProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return AdminProposal{}
+}
+
+// QueryAdminProposalsRequest is request type for the Query/AdminProposals RPC method.
+type QueryAdminProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAdminProposalsRequest) Reset()         { *m = QueryAdminProposalsRequest{} }
+func (m *QueryAdminProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminProposalsRequest) ProtoMessage()    {}
+func (*QueryAdminProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{28}
+}
+func (m *QueryAdminProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminProposalsRequest.Merge(m, src)
+}
+func (m *QueryAdminProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryAdminProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAdminProposalsResponse is response type for the Query/AdminProposals RPC method.
+type QueryAdminProposalsResponse struct {
+	Proposals []AdminProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAdminProposalsResponse) Reset()         { *m = QueryAdminProposalsResponse{} }
+func (m *QueryAdminProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminProposalsResponse) ProtoMessage()    {}
+func (*QueryAdminProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{29}
+}
+func (m *QueryAdminProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminProposalsResponse.Merge(m, src)
+}
+func (m *QueryAdminProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryAdminProposalsResponse) GetProposals() []AdminProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryAdminProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySigningInfoRequest is request type for the Query/SigningInfo RPC method.
+type QuerySigningInfoRequest struct {
+	// cons_address is the consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest{} }
+func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoRequest) ProtoMessage()    {}
+func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{30}
+}
+func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoRequest.Merge(m, src)
+}
+func (m *QuerySigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfoRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QuerySigningInfoResponse is response type for the Query/SigningInfo RPC method.
+type QuerySigningInfoResponse struct {
+	SigningInfo ValidatorSigningInfo `protobuf:"bytes,1,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info"`
+}
+
+func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
+func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoResponse) ProtoMessage()    {}
+func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{31}
+}
+func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoResponse.Merge(m, src)
+}
+func (m *QuerySigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfoResponse) GetSigningInfo() ValidatorSigningInfo {
+	if m != nil {
+		return m.SigningInfo
+	}
+	return ValidatorSigningInfo{}
+}
+
+// QuerySigningInfosRequest is request type for the Query/SigningInfos RPC method.
+type QuerySigningInfosRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosRequest{} }
+func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosRequest) ProtoMessage()    {}
+func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{32}
+}
+func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosRequest.Merge(m, src)
+}
+func (m *QuerySigningInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySigningInfosResponse is response type for the Query/SigningInfos RPC method.
+type QuerySigningInfosResponse struct {
+	SigningInfos []ValidatorSigningInfo `protobuf:"bytes,1,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResponse{} }
+func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosResponse) ProtoMessage()    {}
+func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15cfbb5d02a763ec, []int{33}
+}
+func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosResponse.Merge(m, src)
+}
+func (m *QuerySigningInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfosResponse) GetSigningInfos() []ValidatorSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryValidatorsRequest)(nil), "opinit.opchild.v1.QueryValidatorsRequest")
+	proto.RegisterType((*QueryValidatorsResponse)(nil), "opinit.opchild.v1.QueryValidatorsResponse")
+	proto.RegisterType((*QueryValidatorRequest)(nil), "opinit.opchild.v1.QueryValidatorRequest")
+	proto.RegisterType((*QueryValidatorResponse)(nil), "opinit.opchild.v1.QueryValidatorResponse")
+	proto.RegisterType((*QueryBridgeInfoRequest)(nil), "opinit.opchild.v1.QueryBridgeInfoRequest")
+	proto.RegisterType((*QueryBridgeInfoResponse)(nil), "opinit.opchild.v1.QueryBridgeInfoResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "opinit.opchild.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "opinit.opchild.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryWithdrawalCommitmentRequest)(nil), "opinit.opchild.v1.QueryWithdrawalCommitmentRequest")
+	proto.RegisterType((*QueryWithdrawalCommitmentResponse)(nil), "opinit.opchild.v1.QueryWithdrawalCommitmentResponse")
+	proto.RegisterType((*QueryWithdrawalProofRequest)(nil), "opinit.opchild.v1.QueryWithdrawalProofRequest")
+	proto.RegisterType((*QueryWithdrawalProofResponse)(nil), "opinit.opchild.v1.QueryWithdrawalProofResponse")
+	proto.RegisterType((*QueryDepositStatusRequest)(nil), "opinit.opchild.v1.QueryDepositStatusRequest")
+	proto.RegisterType((*QueryDepositStatusResponse)(nil), "opinit.opchild.v1.QueryDepositStatusResponse")
+	proto.RegisterType((*QueryDepositAttestationsRequest)(nil), "opinit.opchild.v1.QueryDepositAttestationsRequest")
+	proto.RegisterType((*QueryDepositAttestationsResponse)(nil), "opinit.opchild.v1.QueryDepositAttestationsResponse")
+	proto.RegisterType((*QueryExecutorChangePlanRequest)(nil), "opinit.opchild.v1.QueryExecutorChangePlanRequest")
+	proto.RegisterType((*QueryExecutorChangePlanResponse)(nil), "opinit.opchild.v1.QueryExecutorChangePlanResponse")
+	proto.RegisterType((*QueryExecutorChangePlansRequest)(nil), "opinit.opchild.v1.QueryExecutorChangePlansRequest")
+	proto.RegisterType((*QueryExecutorChangePlansResponse)(nil), "opinit.opchild.v1.QueryExecutorChangePlansResponse")
+	proto.RegisterType((*QueryScheduledMessagesRequest)(nil), "opinit.opchild.v1.QueryScheduledMessagesRequest")
+	proto.RegisterType((*QueryScheduledMessagesResponse)(nil), "opinit.opchild.v1.QueryScheduledMessagesResponse")
+	proto.RegisterType((*QueryScheduledMessagesListRequest)(nil), "opinit.opchild.v1.QueryScheduledMessagesListRequest")
+	proto.RegisterType((*QueryScheduledMessagesListResponse)(nil), "opinit.opchild.v1.QueryScheduledMessagesListResponse")
+	proto.RegisterType((*QueryPendingAdminRequest)(nil), "opinit.opchild.v1.QueryPendingAdminRequest")
+	proto.RegisterType((*QueryPendingAdminResponse)(nil), "opinit.opchild.v1.QueryPendingAdminResponse")
+	proto.RegisterType((*QueryAdminProposalRequest)(nil), "opinit.opchild.v1.QueryAdminProposalRequest")
+	proto.RegisterType((*QueryAdminProposalResponse)(nil), "opinit.opchild.v1.QueryAdminProposalResponse")
+	proto.RegisterType((*QueryAdminProposalsRequest)(nil), "opinit.opchild.v1.QueryAdminProposalsRequest")
+	proto.RegisterType((*QueryAdminProposalsResponse)(nil), "opinit.opchild.v1.QueryAdminProposalsResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "opinit.opchild.v1.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "opinit.opchild.v1.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfosRequest)(nil), "opinit.opchild.v1.QuerySigningInfosRequest")
+	proto.RegisterType((*QuerySigningInfosResponse)(nil), "opinit.opchild.v1.QuerySigningInfosResponse")
+}
+
+func init() { proto.RegisterFile("opinit/opchild/v1/query.proto", fileDescriptor_15cfbb5d02a763ec) }
+
+var fileDescriptor_15cfbb5d02a763ec = []byte{
+	// 1736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0xc0, 0x4d, 0xdb, 0xf1, 0xc6, 0x4f, 0xb2, 0x77, 0x3d, 0x71, 0x12, 0x99, 0x8e, 0x65, 0x99,
+	0xf9, 0xb0, 0x63, 0x47, 0xe2, 0x4a, 0x76, 0xb0, 0xbb, 0x41, 0x90, 0x0f, 0xe7, 0x0b, 0x01, 0xb2,
+	0x58, 0xaf, 0xbc, 0x9b, 0x2c, 0xf6, 0xb0, 0x5a, 0x4a, 0x1c, 0x51, 0xc4, 0x4a, 0x1c, 0x85, 0x43,
+	0xd9, 0x09, 0x0c, 0x63, 0x17, 0x7b, 0xea, 0xad, 0x01, 0x7a, 0xe9, 0xa1, 0x87, 0xa2, 0xc8, 0xa1,
+	0x40, 0x2f, 0x09, 0x9a, 0x02, 0x2d, 0x8a, 0x1e, 0xda, 0x53, 0x2e, 0x05, 0x82, 0xf6, 0xd2, 0x53,
+	0x5b, 0x24, 0x05, 0xfa, 0x6f, 0x14, 0x24, 0x87, 0x5f, 0xe6, 0x50, 0xa2, 0x0b, 0x5d, 0x0c, 0x73,
+	0xe6, 0x7d, 0xfc, 0xde, 0x7b, 0xc3, 0xe1, 0x7b, 0x10, 0x2c, 0x90, 0xae, 0x6e, 0xe8, 0x96, 0x4c,
+	0xba, 0x8d, 0x96, 0xde, 0x56, 0xe5, 0x9d, 0xb2, 0xfc, 0xb0, 0x87, 0xcd, 0xc7, 0xa5, 0xae, 0x49,
+	0x2c, 0x82, 0x66, 0xdc, 0xed, 0x12, 0xdb, 0x2e, 0xed, 0x94, 0xc5, 0x19, 0xa5, 0xa3, 0x1b, 0x44,
+	0x76, 0xfe, 0xba, 0x52, 0xe2, 0x6a, 0x83, 0xd0, 0x0e, 0xa1, 0x72, 0x5d, 0xa1, 0xd8, 0x55, 0x97,
+	0x77, 0xca, 0x75, 0x6c, 0x29, 0x65, 0xb9, 0xab, 0x68, 0xba, 0xa1, 0x58, 0x3a, 0x31, 0x98, 0xec,
+	0x3c, 0x93, 0xf5, 0xc4, 0xc2, 0xee, 0xc4, 0x39, 0x77, 0xb3, 0xe6, 0x3c, 0xc9, 0xee, 0x03, 0xdb,
+	0x9a, 0xd5, 0x88, 0x46, 0xdc, 0x75, 0xfb, 0x3f, 0xb6, 0x7a, 0x4a, 0x23, 0x44, 0x6b, 0x63, 0x59,
+	0xe9, 0xea, 0xb2, 0x62, 0x18, 0xc4, 0x72, 0x5c, 0x79, 0x3a, 0x9c, 0xe0, 0xac, 0xc7, 0x5d, 0xcc,
+	0xb6, 0xa5, 0x7f, 0xc3, 0x89, 0xbf, 0xda, 0xce, 0xef, 0x2b, 0x6d, 0x5d, 0x55, 0x2c, 0x62, 0xd2,
+	0x2a, 0x7e, 0xd8, 0xc3, 0xd4, 0x42, 0xb7, 0x01, 0x02, 0xf0, 0x9c, 0x50, 0x10, 0x56, 0x32, 0x95,
+	0x73, 0x25, 0xc6, 0x63, 0x47, 0x59, 0x72, 0xa9, 0x59, 0x94, 0xa5, 0x2d, 0x45, 0xc3, 0x4c, 0xb7,
+	0x1a, 0xd2, 0x94, 0x3e, 0x12, 0xe0, 0x64, 0xcc, 0x05, 0xed, 0x12, 0x83, 0x62, 0x74, 0x07, 0x60,
+	0xc7, 0x5f, 0xcd, 0x09, 0x85, 0xb1, 0x95, 0x4c, 0xe5, 0x54, 0x29, 0x96, 0xef, 0x92, 0xaf, 0xba,
+	0x39, 0xf9, 0xf2, 0xfb, 0xc5, 0x91, 0x0f, 0x7f, 0x7e, 0xb6, 0x2a, 0x54, 0x43, 0xaa, 0xb6, 0xa1,
+	0x10, 0xec, 0xa8, 0x03, 0xbb, 0x3c, 0x10, 0xd6, 0xa5, 0x88, 0xd0, 0xfe, 0x03, 0x8e, 0x47, 0x61,
+	0xbd, 0x74, 0x5c, 0x85, 0x69, 0xdf, 0x5f, 0x4d, 0x51, 0x55, 0xd3, 0x49, 0xc9, 0xe4, 0x66, 0xee,
+	0x9b, 0x17, 0xc5, 0x59, 0xe6, 0xe8, 0xba, 0xaa, 0x9a, 0x98, 0xd2, 0x6d, 0xcb, 0xd4, 0x0d, 0xad,
+	0x3a, 0xe5, 0xcb, 0xdb, 0xeb, 0x52, 0xed, 0x60, 0xa6, 0xfd, 0x2c, 0xdc, 0x82, 0x49, 0x5f, 0x94,
+	0x25, 0x3a, 0x75, 0x12, 0x02, 0x4d, 0x29, 0xc7, 0x1c, 0x6c, 0x9a, 0xba, 0xaa, 0xe1, 0xbb, 0x46,
+	0x93, 0x30, 0x76, 0x49, 0x85, 0x93, 0xb1, 0x1d, 0xe6, 0xfb, 0x2e, 0x64, 0xea, 0xce, 0x6a, 0x4d,
+	0x37, 0x9a, 0x84, 0x79, 0x5f, 0xe0, 0x78, 0x0f, 0x74, 0x23, 0x35, 0xa8, 0xfb, 0xcb, 0xd2, 0x2c,
+	0x20, 0xc7, 0xcb, 0x96, 0x62, 0x2a, 0x1d, 0xef, 0x18, 0x49, 0xdb, 0x70, 0x2c, 0xb2, 0xca, 0xfc,
+	0x5e, 0x86, 0x89, 0xae, 0xb3, 0xc2, 0x5c, 0xce, 0x71, 0x5c, 0xba, 0x2a, 0x61, 0x77, 0x4c, 0x47,
+	0xba, 0x04, 0x05, 0xc7, 0xe8, 0x03, 0xdd, 0x6a, 0xa9, 0xa6, 0xb2, 0xab, 0xb4, 0x6f, 0x90, 0x4e,
+	0x47, 0xb7, 0x3a, 0xd8, 0xb0, 0xbc, 0x82, 0x9d, 0x80, 0x89, 0x16, 0xd6, 0xb5, 0x96, 0xe5, 0x78,
+	0x18, 0xab, 0xb2, 0x27, 0x69, 0x17, 0x96, 0xfa, 0xe8, 0x32, 0xbc, 0x2a, 0x40, 0xc3, 0x5f, 0x65,
+	0x88, 0xcb, 0x1c, 0x44, 0x9e, 0x91, 0x48, 0x7e, 0x02, 0x2b, 0xd2, 0x9f, 0x60, 0xfe, 0x80, 0xe3,
+	0x2d, 0x93, 0x90, 0xa6, 0xc7, 0x2b, 0xc2, 0x51, 0x6a, 0xff, 0x6b, 0x34, 0xb0, 0xe3, 0x70, 0xbc,
+	0xea, 0x3f, 0x4b, 0x9f, 0x8d, 0xc2, 0x29, 0xbe, 0x2e, 0xe3, 0xbd, 0x06, 0xe3, 0x6d, 0xac, 0x34,
+	0x19, 0xe9, 0x52, 0x5f, 0xd2, 0x7b, 0x58, 0x69, 0x86, 0x19, 0x1d, 0x4d, 0xb4, 0x06, 0x33, 0xbb,
+	0xbe, 0x88, 0x7d, 0xf9, 0x90, 0x26, 0xcd, 0x8d, 0x16, 0xc6, 0x56, 0xb2, 0xd5, 0xdf, 0xed, 0x46,
+	0xbd, 0xd2, 0x03, 0xe9, 0x19, 0x1b, 0x46, 0x7a, 0xd0, 0x3c, 0x4c, 0x7a, 0x27, 0x51, 0xcd, 0x8d,
+	0xbb, 0x09, 0x60, 0xa7, 0x4b, 0x45, 0x39, 0xf8, 0xcd, 0x0e, 0x36, 0xa9, 0xfd, 0x72, 0x1f, 0x29,
+	0x08, 0x2b, 0xd9, 0xaa, 0xf7, 0x88, 0x16, 0x21, 0x43, 0x7a, 0x56, 0xb7, 0x67, 0xd5, 0x4c, 0x42,
+	0xac, 0xdc, 0x84, 0xb3, 0x0b, 0xee, 0x52, 0x95, 0x10, 0x4b, 0x9a, 0x87, 0x39, 0x27, 0x75, 0x37,
+	0x71, 0x97, 0x50, 0xdd, 0xda, 0xb6, 0x14, 0xab, 0xe7, 0x9f, 0xce, 0x1f, 0x04, 0x10, 0x79, 0xbb,
+	0x2c, 0xad, 0x57, 0x60, 0xbe, 0xa5, 0x6b, 0x2d, 0x4c, 0xad, 0x5a, 0x83, 0x18, 0x96, 0xae, 0xf5,
+	0x48, 0x8f, 0xd6, 0x0e, 0x94, 0x69, 0x8e, 0x89, 0xdc, 0xf0, 0x25, 0xb6, 0x99, 0x00, 0xaa, 0xc2,
+	0x74, 0x47, 0xa7, 0x54, 0x37, 0xb4, 0x9a, 0xa9, 0x18, 0x1a, 0x76, 0x33, 0x9a, 0xa9, 0x14, 0x38,
+	0xb9, 0xf2, 0x94, 0xaa, 0xb6, 0x60, 0x38, 0x49, 0x53, 0xcc, 0x84, 0xb3, 0x41, 0x51, 0x11, 0x50,
+	0xbd, 0xd7, 0x6c, 0x62, 0x13, 0xab, 0x3e, 0x09, 0xcd, 0x8d, 0x15, 0xc6, 0x56, 0xc6, 0xab, 0x33,
+	0xde, 0x8e, 0x67, 0x8c, 0x4a, 0x3a, 0x2c, 0x86, 0x03, 0xbc, 0x6e, 0x59, 0x98, 0xb2, 0x2f, 0xc4,
+	0xb0, 0x6f, 0xfa, 0x2f, 0x05, 0x28, 0x24, 0xfb, 0x62, 0x29, 0xfd, 0x1b, 0x64, 0x95, 0xd0, 0x3a,
+	0xbb, 0xf4, 0xcf, 0x72, 0x12, 0x12, 0xb7, 0x12, 0xce, 0x4a, 0xc4, 0xca, 0xf0, 0xee, 0xff, 0x3f,
+	0x42, 0xde, 0x09, 0xe1, 0xd6, 0x23, 0xdc, 0xe8, 0x59, 0xc4, 0xbc, 0xd1, 0xb2, 0xb3, 0xbe, 0xd5,
+	0x56, 0x0c, 0xfe, 0xbd, 0x32, 0xee, 0xdf, 0x2b, 0x1a, 0x2c, 0x26, 0x6a, 0xb2, 0xd8, 0x6f, 0xc2,
+	0x78, 0xb7, 0xad, 0x78, 0x29, 0xe6, 0xc5, 0x1c, 0x57, 0x8e, 0xbc, 0xa9, 0xb6, 0xb6, 0x5f, 0xd1,
+	0xb8, 0xec, 0xd0, 0x2b, 0xfa, 0xb1, 0x57, 0x51, 0xae, 0x2f, 0x16, 0xd5, 0x6d, 0x38, 0x62, 0x73,
+	0xf5, 0x2b, 0x65, 0xff, 0xb0, 0x5c, 0xf5, 0xe1, 0xd5, 0x50, 0x86, 0x05, 0x07, 0x7a, 0xbb, 0xd1,
+	0xc2, 0x6a, 0xaf, 0x8d, 0xd5, 0x3f, 0x63, 0x4a, 0x15, 0x0d, 0xfb, 0xe9, 0x99, 0x86, 0x51, 0x5d,
+	0x65, 0xe5, 0x1b, 0xd5, 0x55, 0xe9, 0x7f, 0x02, 0xe4, 0x93, 0x34, 0x58, 0x90, 0xff, 0x02, 0x44,
+	0xbd, 0xcd, 0x5a, 0x87, 0xed, 0xb2, 0xcc, 0x9e, 0xe1, 0xbd, 0xcd, 0x07, 0x2d, 0x85, 0x03, 0x9e,
+	0xa1, 0x07, 0x77, 0xa5, 0xff, 0xb0, 0xaf, 0x52, 0x4c, 0xef, 0x9e, 0x4e, 0xad, 0x61, 0x97, 0xf5,
+	0x6b, 0x01, 0xa4, 0x7e, 0xde, 0x06, 0xc4, 0x3c, 0x36, 0x9c, 0x98, 0x87, 0x57, 0x70, 0x11, 0x72,
+	0x6e, 0x8f, 0x81, 0x0d, 0x55, 0x37, 0xb4, 0xeb, 0x6a, 0x47, 0xf7, 0x5e, 0x57, 0xe9, 0x1a, 0xcc,
+	0x71, 0xf6, 0x58, 0x84, 0xa7, 0x61, 0xaa, 0xeb, 0xae, 0xd7, 0x14, 0x7b, 0xc3, 0xed, 0xe9, 0xaa,
+	0xd9, 0x6e, 0x48, 0x58, 0x5a, 0x63, 0x16, 0x9c, 0xa7, 0x2d, 0x93, 0x74, 0x09, 0x55, 0xda, 0x49,
+	0x47, 0x09, 0x83, 0xc8, 0x13, 0xf6, 0xfb, 0xdd, 0xa3, 0x5d, 0xb6, 0xc6, 0xca, 0xc7, 0xfb, 0x12,
+	0x44, 0x74, 0xc3, 0x39, 0xf4, 0x95, 0x25, 0x95, 0xe7, 0x66, 0xe8, 0xaf, 0xff, 0x73, 0x01, 0xe6,
+	0xb9, 0x6e, 0xfc, 0xe6, 0x71, 0xd2, 0x23, 0xf2, 0xce, 0xc5, 0xa1, 0xe2, 0x09, 0xb4, 0x87, 0x77,
+	0x16, 0x2e, 0xb3, 0x5e, 0x77, 0x5b, 0xd7, 0x0c, 0xdd, 0xd0, 0x42, 0x6d, 0x30, 0x5a, 0x82, 0x6c,
+	0x83, 0x18, 0xd4, 0xe9, 0xde, 0x31, 0xa5, 0xac, 0xd8, 0x19, 0x7b, 0x8d, 0x75, 0xee, 0xd2, 0x43,
+	0xc8, 0xc5, 0xb5, 0x59, 0xb4, 0x7f, 0x87, 0x2c, 0x75, 0x97, 0xc3, 0xbd, 0xf2, 0x72, 0xbf, 0x4e,
+	0x3d, 0x64, 0x26, 0x1c, 0x77, 0x86, 0x06, 0xeb, 0x52, 0x3d, 0xee, 0x72, 0xe8, 0x85, 0xfc, 0x42,
+	0x80, 0x39, 0x8e, 0x13, 0x16, 0xd8, 0x03, 0x98, 0x0a, 0x07, 0xe6, 0x95, 0xf2, 0xd7, 0x44, 0x96,
+	0x0d, 0x45, 0x36, 0xbc, 0xa2, 0x56, 0x9e, 0x9e, 0x80, 0x23, 0x0e, 0x3f, 0x7a, 0x5b, 0x00, 0xb8,
+	0x1f, 0xcc, 0x7d, 0xe7, 0x39, 0x8c, 0xfc, 0x79, 0x56, 0x5c, 0x4d, 0x23, 0xea, 0xfa, 0x96, 0x56,
+	0xdf, 0xb2, 0xa3, 0xf9, 0xff, 0xb7, 0x3f, 0xbd, 0x33, 0xba, 0x88, 0x16, 0xe4, 0xf8, 0x08, 0x1d,
+	0x1a, 0x3d, 0xdf, 0x13, 0x60, 0xd2, 0x37, 0x81, 0x56, 0x06, 0x7a, 0xf1, 0x78, 0xce, 0xa7, 0x90,
+	0x64, 0x38, 0x97, 0x02, 0x1c, 0x19, 0x15, 0xfb, 0xe1, 0xc8, 0x7b, 0xd1, 0x21, 0x75, 0x1f, 0x3d,
+	0x11, 0x00, 0x82, 0xd9, 0x2d, 0x39, 0x61, 0xb1, 0xa9, 0x51, 0x5c, 0x4d, 0x23, 0xca, 0x08, 0xd7,
+	0x02, 0xc2, 0x02, 0xca, 0x73, 0x08, 0x43, 0x43, 0x26, 0xfa, 0x2f, 0x4c, 0xb8, 0xa3, 0x1d, 0x3a,
+	0x9b, 0xe4, 0x22, 0x32, 0x43, 0x8a, 0xe7, 0x06, 0x89, 0x31, 0x8a, 0x73, 0x01, 0xc5, 0x3c, 0x9a,
+	0xe3, 0x50, 0xb8, 0xe3, 0x23, 0xfa, 0x4a, 0x80, 0x59, 0xde, 0x68, 0x82, 0xd6, 0x93, 0x1c, 0xf5,
+	0x19, 0x34, 0xc5, 0x8d, 0xc3, 0x29, 0x31, 0xd6, 0xab, 0x01, 0xeb, 0x06, 0xaa, 0x70, 0x58, 0x43,
+	0xd3, 0x58, 0x30, 0x25, 0x51, 0x79, 0xcf, 0x6d, 0x37, 0xf7, 0xd1, 0x33, 0x01, 0x7e, 0x7b, 0x60,
+	0x1c, 0x44, 0xa5, 0xc1, 0x28, 0xe1, 0x99, 0x53, 0x94, 0x53, 0xcb, 0x33, 0xea, 0xcb, 0x01, 0x75,
+	0x19, 0xc9, 0x7d, 0xa9, 0xa9, 0xbc, 0xe7, 0x8d, 0x27, 0xfb, 0xb2, 0x33, 0x4f, 0xda, 0xaf, 0xca,
+	0x54, 0x64, 0xd0, 0x42, 0x17, 0x92, 0x00, 0x78, 0xd3, 0x9a, 0x58, 0x4c, 0x29, 0xcd, 0x60, 0x4b,
+	0x01, 0xec, 0x69, 0xb4, 0xc4, 0x81, 0x55, 0x5d, 0xb5, 0x1a, 0x75, 0x61, 0x5e, 0x08, 0x70, 0x8c,
+	0x33, 0xba, 0xa0, 0xca, 0x00, 0xb7, 0x9c, 0x99, 0x4a, 0x5c, 0x3f, 0x94, 0x0e, 0x03, 0xde, 0x08,
+	0x80, 0xcf, 0xa3, 0xe5, 0x3e, 0xc0, 0x91, 0xd9, 0xe7, 0x73, 0x01, 0x50, 0xbc, 0xc1, 0x46, 0xe5,
+	0x24, 0x82, 0xc4, 0xd1, 0x46, 0xac, 0x1c, 0x46, 0x85, 0x31, 0x5f, 0x09, 0x98, 0xd7, 0x51, 0x99,
+	0xc3, 0x8c, 0x99, 0x6e, 0xad, 0xe1, 0x28, 0xd7, 0x9c, 0x66, 0x3f, 0x38, 0xc6, 0x9f, 0x08, 0x70,
+	0x2c, 0x6e, 0xbe, 0x4f, 0xd2, 0x93, 0xc7, 0x1e, 0x71, 0xfd, 0x50, 0x3a, 0x2c, 0x80, 0x8b, 0x41,
+	0x00, 0xab, 0x68, 0x25, 0x6d, 0x00, 0xe8, 0xb9, 0x00, 0x33, 0xb1, 0x86, 0x17, 0xfd, 0x3e, 0x89,
+	0x20, 0x69, 0x16, 0x11, 0xcb, 0x87, 0xd0, 0x60, 0xc4, 0x7f, 0x08, 0x88, 0x2f, 0xa0, 0x55, 0x0e,
+	0x71, 0xbc, 0x6b, 0x97, 0xf7, 0x74, 0x75, 0x1f, 0x7d, 0x2a, 0xc0, 0x71, 0x6e, 0xcb, 0x8f, 0x36,
+	0x52, 0x53, 0x84, 0xe6, 0x11, 0xf1, 0xe2, 0x21, 0xb5, 0x18, 0x7f, 0x25, 0xe0, 0x5f, 0x46, 0x67,
+	0x53, 0xf1, 0xa3, 0x77, 0x05, 0xc8, 0x86, 0x5b, 0x78, 0xb4, 0x96, 0xf8, 0x4d, 0x88, 0x0f, 0x01,
+	0xe2, 0x85, 0x74, 0xc2, 0x8c, 0xaf, 0x18, 0xf0, 0x49, 0xa8, 0xc0, 0xfb, 0x8c, 0x84, 0x67, 0x06,
+	0xf4, 0x81, 0x00, 0x53, 0x91, 0x16, 0x37, 0xf9, 0x56, 0xe3, 0x8d, 0x10, 0x62, 0x31, 0xa5, 0x74,
+	0xda, 0x4b, 0xc2, 0xa1, 0xaa, 0xf9, 0xad, 0xb5, 0x5b, 0xfa, 0xf7, 0x05, 0x98, 0x8e, 0xd8, 0xa3,
+	0x28, 0x9d, 0x5f, 0xff, 0xa0, 0x96, 0xd2, 0x8a, 0x33, 0x4e, 0x39, 0xe0, 0x3c, 0x83, 0xa4, 0xc1,
+	0x9c, 0xe8, 0xa9, 0x00, 0x99, 0x50, 0x5b, 0x89, 0x12, 0xfb, 0x8f, 0x78, 0x6b, 0x2f, 0xae, 0xa5,
+	0x92, 0x4d, 0xfb, 0x11, 0x8b, 0x74, 0xc3, 0xf2, 0x5e, 0x78, 0x68, 0xd8, 0x77, 0x4e, 0xe2, 0x76,
+	0xb8, 0xcb, 0x4d, 0xe3, 0x9b, 0x0e, 0x3c, 0x89, 0xbc, 0xce, 0x7c, 0xf0, 0x49, 0x8c, 0x90, 0x6e,
+	0xde, 0x79, 0xf9, 0x3a, 0x2f, 0xbc, 0x7a, 0x9d, 0x17, 0x7e, 0x7c, 0x9d, 0x17, 0x9e, 0xbc, 0xc9,
+	0x8f, 0xbc, 0x7a, 0x93, 0x1f, 0xf9, 0xee, 0x4d, 0x7e, 0xe4, 0x9f, 0x45, 0x4d, 0xb7, 0x5a, 0xbd,
+	0x7a, 0xa9, 0x41, 0x3a, 0xb2, 0x6d, 0x43, 0x57, 0x8a, 0x6d, 0xa5, 0x4e, 0xe5, 0xbf, 0x6c, 0x39,
+	0x16, 0x1f, 0xf9, 0x36, 0x9d, 0xdf, 0x86, 0xea, 0x13, 0xce, 0x8f, 0x43, 0xeb, 0xbf, 0x0c, 0x00,
+	0xad, 0xae, 0x29, 0xa5, 0x1a, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Validators queries all validators
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// BridgeInfo queries the bridge information.
+	BridgeInfo(ctx context.Context, in *QueryBridgeInfoRequest, opts ...grpc.CallOption) (*QueryBridgeInfoResponse, error)
+	// Parameters queries the rollup parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// WithdrawalCommitment queries the withdrawal tree root at a height.
+	WithdrawalCommitment(ctx context.Context, in *QueryWithdrawalCommitmentRequest, opts ...grpc.CallOption) (*QueryWithdrawalCommitmentResponse, error)
+	// WithdrawalProof queries the withdrawal leaf and its merkle proof.
+	WithdrawalProof(ctx context.Context, in *QueryWithdrawalProofRequest, opts ...grpc.CallOption) (*QueryWithdrawalProofResponse, error)
+	// DepositStatus queries the highest contiguous finalized l1 sequence and
+	// the missing l1 sequences.
+	DepositStatus(ctx context.Context, in *QueryDepositStatusRequest, opts ...grpc.CallOption) (*QueryDepositStatusResponse, error)
+	// DepositAttestations queries the deposits waiting for the attestations of
+	// the bridge executors.
+	DepositAttestations(ctx context.Context, in *QueryDepositAttestationsRequest, opts ...grpc.CallOption) (*QueryDepositAttestationsResponse, error)
+	// ExecutorChangePlan queries the executor change plan at the height.
+	ExecutorChangePlan(ctx context.Context, in *QueryExecutorChangePlanRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlanResponse, error)
+	// ExecutorChangePlans queries all the executor change plans.
+	ExecutorChangePlans(ctx context.Context, in *QueryExecutorChangePlansRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlansResponse, error)
+	// ScheduledMessages queries the scheduled messages of the id.
+	ScheduledMessages(ctx context.Context, in *QueryScheduledMessagesRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesResponse, error)
+	// ScheduledMessagesList queries all the scheduled messages.
+	ScheduledMessagesList(ctx context.Context, in *QueryScheduledMessagesListRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesListResponse, error)
+	// PendingAdmin queries the address proposed to be the next admin.
+	PendingAdmin(ctx context.Context, in *QueryPendingAdminRequest, opts ...grpc.CallOption) (*QueryPendingAdminResponse, error)
+	// AdminProposal queries the admin proposal of the id.
+	AdminProposal(ctx context.Context, in *QueryAdminProposalRequest, opts ...grpc.CallOption) (*QueryAdminProposalResponse, error)
+	// AdminProposals queries all the admin proposals waiting for the approvals.
+	AdminProposals(ctx context.Context, in *QueryAdminProposalsRequest, opts ...grpc.CallOption) (*QueryAdminProposalsResponse, error)
+	// SigningInfo queries the liveness information of a validator.
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the liveness information of all the validators.
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error) {
+	out := new(QueryValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
+	out := new(QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/Validator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeInfo(ctx context.Context, in *QueryBridgeInfoRequest, opts ...grpc.CallOption) (*QueryBridgeInfoResponse, error) {
+	out := new(QueryBridgeInfoResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/BridgeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalCommitment(ctx context.Context, in *QueryWithdrawalCommitmentRequest, opts ...grpc.CallOption) (*QueryWithdrawalCommitmentResponse, error) {
+	out := new(QueryWithdrawalCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/WithdrawalCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalProof(ctx context.Context, in *QueryWithdrawalProofRequest, opts ...grpc.CallOption) (*QueryWithdrawalProofResponse, error) {
+	out := new(QueryWithdrawalProofResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/WithdrawalProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositStatus(ctx context.Context, in *QueryDepositStatusRequest, opts ...grpc.CallOption) (*QueryDepositStatusResponse, error) {
+	out := new(QueryDepositStatusResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/DepositStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositAttestations(ctx context.Context, in *QueryDepositAttestationsRequest, opts ...grpc.CallOption) (*QueryDepositAttestationsResponse, error) {
+	out := new(QueryDepositAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/DepositAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutorChangePlan(ctx context.Context, in *QueryExecutorChangePlanRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlanResponse, error) {
+	out := new(QueryExecutorChangePlanResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/ExecutorChangePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutorChangePlans(ctx context.Context, in *QueryExecutorChangePlansRequest, opts ...grpc.CallOption) (*QueryExecutorChangePlansResponse, error) {
+	out := new(QueryExecutorChangePlansResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/ExecutorChangePlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMessages(ctx context.Context, in *QueryScheduledMessagesRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesResponse, error) {
+	out := new(QueryScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/ScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMessagesList(ctx context.Context, in *QueryScheduledMessagesListRequest, opts ...grpc.CallOption) (*QueryScheduledMessagesListResponse, error) {
+	out := new(QueryScheduledMessagesListResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/ScheduledMessagesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAdmin(ctx context.Context, in *QueryPendingAdminRequest, opts ...grpc.CallOption) (*QueryPendingAdminResponse, error) {
+	out := new(QueryPendingAdminResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/PendingAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminProposal(ctx context.Context, in *QueryAdminProposalRequest, opts ...grpc.CallOption) (*QueryAdminProposalResponse, error) {
+	out := new(QueryAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/AdminProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminProposals(ctx context.Context, in *QueryAdminProposalsRequest, opts ...grpc.CallOption) (*QueryAdminProposalsResponse, error) {
+	out := new(QueryAdminProposalsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/AdminProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
+	out := new(QuerySigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Query/SigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Validators queries all validators
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// BridgeInfo queries the bridge information.
+	BridgeInfo(context.Context, *QueryBridgeInfoRequest) (*QueryBridgeInfoResponse, error)
+	// Parameters queries the rollup parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// WithdrawalCommitment queries the withdrawal tree root at a height.
+	WithdrawalCommitment(context.Context, *QueryWithdrawalCommitmentRequest) (*QueryWithdrawalCommitmentResponse, error)
+	// WithdrawalProof queries the withdrawal leaf and its merkle proof.
+	WithdrawalProof(context.Context, *QueryWithdrawalProofRequest) (*QueryWithdrawalProofResponse, error)
+	// DepositStatus queries the highest contiguous finalized l1 sequence and
+	// the missing l1 sequences.
+	DepositStatus(context.Context, *QueryDepositStatusRequest) (*QueryDepositStatusResponse, error)
+	// DepositAttestations queries the deposits waiting for the attestations of
+	// the bridge executors.
+	DepositAttestations(context.Context, *QueryDepositAttestationsRequest) (*QueryDepositAttestationsResponse, error)
+	// ExecutorChangePlan queries the executor change plan at the height.
+	ExecutorChangePlan(context.Context, *QueryExecutorChangePlanRequest) (*QueryExecutorChangePlanResponse, error)
+	// ExecutorChangePlans queries all the executor change plans.
+	ExecutorChangePlans(context.Context, *QueryExecutorChangePlansRequest) (*QueryExecutorChangePlansResponse, error)
+	// ScheduledMessages queries the scheduled messages of the id.
+	ScheduledMessages(context.Context, *QueryScheduledMessagesRequest) (*QueryScheduledMessagesResponse, error)
+	// ScheduledMessagesList queries all the scheduled messages.
+	ScheduledMessagesList(context.Context, *QueryScheduledMessagesListRequest) (*QueryScheduledMessagesListResponse, error)
+	// PendingAdmin queries the address proposed to be the next admin.
+	PendingAdmin(context.Context, *QueryPendingAdminRequest) (*QueryPendingAdminResponse, error)
+	// AdminProposal queries the admin proposal of the id.
+	AdminProposal(context.Context, *QueryAdminProposalRequest) (*QueryAdminProposalResponse, error)
+	// AdminProposals queries all the admin proposals waiting for the approvals.
+	AdminProposals(context.Context, *QueryAdminProposalsRequest) (*QueryAdminProposalsResponse, error)
+	// SigningInfo queries the liveness information of a validator.
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the liveness information of all the validators.
+	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (*UnimplementedQueryServer) BridgeInfo(ctx context.Context, req *QueryBridgeInfoRequest) (*QueryBridgeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeInfo not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalCommitment(ctx context.Context, req *QueryWithdrawalCommitmentRequest) (*QueryWithdrawalCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalCommitment not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalProof(ctx context.Context, req *QueryWithdrawalProofRequest) (*QueryWithdrawalProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalProof not implemented")
+}
+func (*UnimplementedQueryServer) DepositStatus(ctx context.Context, req *QueryDepositStatusRequest) (*QueryDepositStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositStatus not implemented")
+}
+func (*UnimplementedQueryServer) DepositAttestations(ctx context.Context, req *QueryDepositAttestationsRequest) (*QueryDepositAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAttestations not implemented")
+}
+func (*UnimplementedQueryServer) ExecutorChangePlan(ctx context.Context, req *QueryExecutorChangePlanRequest) (*QueryExecutorChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutorChangePlan not implemented")
+}
+func (*UnimplementedQueryServer) ExecutorChangePlans(ctx context.Context, req *QueryExecutorChangePlansRequest) (*QueryExecutorChangePlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutorChangePlans not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMessages(ctx context.Context, req *QueryScheduledMessagesRequest) (*QueryScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMessages not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMessagesList(ctx context.Context, req *QueryScheduledMessagesListRequest) (*QueryScheduledMessagesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMessagesList not implemented")
+}
+func (*UnimplementedQueryServer) PendingAdmin(ctx context.Context, req *QueryPendingAdminRequest) (*QueryPendingAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAdmin not implemented")
+}
+func (*UnimplementedQueryServer) AdminProposal(ctx context.Context, req *QueryAdminProposalRequest) (*QueryAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposal not implemented")
+}
+func (*UnimplementedQueryServer) AdminProposals(ctx context.Context, req *QueryAdminProposalsRequest) (*QueryAdminProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposals not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*QueryValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/BridgeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeInfo(ctx, req.(*QueryBridgeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/WithdrawalCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalCommitment(ctx, req.(*QueryWithdrawalCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/WithdrawalProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalProof(ctx, req.(*QueryWithdrawalProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/DepositStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositStatus(ctx, req.(*QueryDepositStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/DepositAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositAttestations(ctx, req.(*QueryDepositAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutorChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutorChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/ExecutorChangePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutorChangePlan(ctx, req.(*QueryExecutorChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutorChangePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorChangePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutorChangePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/ExecutorChangePlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutorChangePlans(ctx, req.(*QueryExecutorChangePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/ScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMessages(ctx, req.(*QueryScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMessagesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledMessagesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMessagesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/ScheduledMessagesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMessagesList(ctx, req.(*QueryScheduledMessagesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/PendingAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAdmin(ctx, req.(*QueryPendingAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/AdminProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminProposal(ctx, req.(*QueryAdminProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/AdminProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminProposals(ctx, req.(*QueryAdminProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Query/SigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "opinit.opchild.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "BridgeInfo",
+			Handler:    _Query_BridgeInfo_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "WithdrawalCommitment",
+			Handler:    _Query_WithdrawalCommitment_Handler,
+		},
+		{
+			MethodName: "WithdrawalProof",
+			Handler:    _Query_WithdrawalProof_Handler,
+		},
+		{
+			MethodName: "DepositStatus",
+			Handler:    _Query_DepositStatus_Handler,
+		},
+		{
+			MethodName: "DepositAttestations",
+			Handler:    _Query_DepositAttestations_Handler,
+		},
+		{
+			MethodName: "ExecutorChangePlan",
+			Handler:    _Query_ExecutorChangePlan_Handler,
+		},
+		{
+			MethodName: "ExecutorChangePlans",
+			Handler:    _Query_ExecutorChangePlans_Handler,
+		},
+		{
+			MethodName: "ScheduledMessages",
+			Handler:    _Query_ScheduledMessages_Handler,
+		},
+		{
+			MethodName: "ScheduledMessagesList",
+			Handler:    _Query_ScheduledMessagesList_Handler,
+		},
+		{
+			MethodName: "PendingAdmin",
+			Handler:    _Query_PendingAdmin_Handler,
+		},
+		{
+			MethodName: "AdminProposal",
+			Handler:    _Query_AdminProposal_Handler,
+		},
+		{
+			MethodName: "AdminProposals",
+			Handler:    _Query_AdminProposals_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opinit/opchild/v1/query.proto",
+}
+
+func (m *QueryValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutputRoot) > 0 {
+		i -= len(m.OutputRoot)
+		copy(dAtA[i:], m.OutputRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.WithdrawalProofs) > 0 {
+		for iNdEx := len(m.WithdrawalProofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawalProofs[iNdEx])
+			copy(dAtA[i:], m.WithdrawalProofs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawalProofs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Leaf.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BufferedSequences) > 0 {
+		dAtA10 := make([]byte, len(m.BufferedSequences)*10)
+		var j9 int
+		for _, num := range m.BufferedSequences {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MissingRanges) > 0 {
+		for iNdEx := len(m.MissingRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissingRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HighestContiguousSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HighestContiguousSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutorChangePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutorChangePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutorChangePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutorChangePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutorChangePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutorChangePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutorChangePlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutorChangePlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutorChangePlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutorChangePlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutorChangePlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutorChangePlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScheduledMessages.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMessagesListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMessagesListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMessagesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMessagesListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMessagesListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMessagesListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledMessages) > 0 {
+		for iNdEx := len(m.ScheduledMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BridgeInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWithdrawalCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commitment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWithdrawalProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Leaf.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.WithdrawalProofs) > 0 {
+		for _, b := range m.WithdrawalProofs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Commitment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutputRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDepositStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HighestContiguousSequence != 0 {
+		n += 1 + sovQuery(uint64(m.HighestContiguousSequence))
+	}
+	if len(m.MissingRanges) > 0 {
+		for _, e := range m.MissingRanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BufferedSequences) > 0 {
+		l = 0
+		for _, e := range m.BufferedSequences {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryDepositAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutorChangePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryExecutorChangePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExecutorChangePlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutorChangePlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduledMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledMessages.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledMessagesListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledMessagesListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledMessages) > 0 {
+		for _, e := range m.ScheduledMessages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAdminProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leaf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalProofs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalProofs = append(m.WithdrawalProofs, make([]byte, postIndex-iNdEx))
+			copy(m.WithdrawalProofs[len(m.WithdrawalProofs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputRoot = append(m.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputRoot == nil {
+				m.OutputRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestContiguousSequence", wireType)
+			}
+			m.HighestContiguousSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestContiguousSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingRanges = append(m.MissingRanges, SequenceRange{})
+			if err := m.MissingRanges[len(m.MissingRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BufferedSequences = append(m.BufferedSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BufferedSequences) == 0 {
+					m.BufferedSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BufferedSequences = append(m.BufferedSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedSequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, DepositAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutorChangePlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutorChangePlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutorChangePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutorChangePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutorChangePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutorChangePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutorChangePlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutorChangePlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutorChangePlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutorChangePlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutorChangePlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutorChangePlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, ExecutorChangePlan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledMessagesListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMessagesListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMessagesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledMessagesListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMessagesListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMessagesListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMessages = append(m.ScheduledMessages, ScheduledMessages{})
+			if err := m.ScheduledMessages[len(m.ScheduledMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,17 +6627,17 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1065,28 +6647,11 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1108,7 +6673,7 @@ func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,49 +6696,15 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validators = append(m.Validators, Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1200,10 +6731,7 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1228,7 +6756,7 @@ func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1251,17 +6779,17 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1271,23 +6799,27 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1310,7 +6842,7 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1333,15 +6865,15 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1368,7 +6900,44 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, AdminProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1393,7 +6962,7 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1416,12 +6985,44 @@ func (m *QueryBridgeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1443,7 +7044,7 @@ func (m *QueryBridgeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1466,15 +7067,15 @@ func (m *QueryBridgeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1501,7 +7102,7 @@ func (m *QueryBridgeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1526,7 +7127,7 @@ func (m *QueryBridgeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,12 +7150,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1576,7 +7213,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,15 +7236,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1634,7 +7271,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SigningInfos = append(m.SigningInfos, ValidatorSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
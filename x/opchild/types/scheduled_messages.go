@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// DefaultScheduledMessagesIdStart is the id of the first scheduled messages.
+const DefaultScheduledMessagesIdStart = 1
+
+var _ codectypes.UnpackInterfacesMessage = ScheduledMessages{}
+
+// GetMsgs unpacks the messages Any's into sdk.Msg's
+func (sm ScheduledMessages) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(sm.Messages, "sdk.MsgProposal")
+}
+
+// Validate performs basic ScheduledMessages validation.
+func (sm ScheduledMessages) Validate(ac address.Codec) error {
+	if sm.Id < DefaultScheduledMessagesIdStart {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid id")
+	}
+
+	if _, err := ac.StringToBytes(sm.Sender); err != nil {
+		return err
+	}
+
+	if sm.ExecutionHeight == 0 {
+		return ErrInvalidExecutionHeight
+	}
+
+	if len(sm.Messages) == 0 {
+		return errorsmod.Wrap(govtypes.ErrNoProposalMsgs, "Msgs length must be non-zero")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (sm ScheduledMessages) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, sm.Messages)
+}
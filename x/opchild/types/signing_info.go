@@ -0,0 +1,27 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
+func NewValidatorSigningInfo(consAddr string, startHeight int64) ValidatorSigningInfo {
+	return ValidatorSigningInfo{
+		Address:     consAddr,
+		StartHeight: startHeight,
+	}
+}
+
+// Validate performs basic validation of the validator signing info.
+func (info ValidatorSigningInfo) Validate() error {
+	if info.Address == "" {
+		return errors.New("empty signing info address")
+	}
+
+	if info.StartHeight < 0 || info.IndexOffset < 0 || info.MissedBlocksCounter < 0 {
+		return fmt.Errorf("negative signing info of %s", info.Address)
+	}
+
+	return nil
+}
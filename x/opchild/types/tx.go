@@ -1,6 +1,8 @@
 package types
 
 import (
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	"cosmossdk.io/core/address"
 	errors "cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -8,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -15,13 +18,30 @@ var (
 	_ sdk.Msg = &MsgExecuteMessages{}
 	_ sdk.Msg = &MsgAddValidator{}
 	_ sdk.Msg = &MsgRemoveValidator{}
+	_ sdk.Msg = &MsgUnjailValidator{}
+	_ sdk.Msg = &MsgRotateConsPubKey{}
+	_ sdk.Msg = &MsgUpdateValidatorPower{}
+	_ sdk.Msg = &MsgSetValidatorSet{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgSpendFeePool{}
 	_ sdk.Msg = &MsgSetBridgeInfo{}
 	_ sdk.Msg = &MsgFinalizeTokenDeposit{}
 	_ sdk.Msg = &MsgInitiateTokenWithdrawal{}
+	_ sdk.Msg = &MsgUpdateDenomMetadata{}
+	_ sdk.Msg = &MsgFinalizeNftDeposit{}
+	_ sdk.Msg = &MsgInitiateNftWithdrawal{}
+	_ sdk.Msg = &MsgRegisterExecutorChangePlan{}
+	_ sdk.Msg = &MsgCancelExecutorChangePlan{}
+	_ sdk.Msg = &MsgExecuteScheduledMessages{}
+	_ sdk.Msg = &MsgCancelScheduledMessages{}
+	_ sdk.Msg = &MsgApproveAdminProposal{}
+	_ sdk.Msg = &MsgProposeAdmin{}
+	_ sdk.Msg = &MsgAcceptAdmin{}
 
 	_ codectypes.UnpackInterfacesMessage = &MsgExecuteMessages{}
+	_ codectypes.UnpackInterfacesMessage = &MsgRegisterExecutorChangePlan{}
+	_ codectypes.UnpackInterfacesMessage = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = &MsgSetValidatorSet{}
 )
 
 // should refer initiavm/precompile/modules/minlib/sources/coin.move
@@ -146,6 +166,213 @@ func (msg MsgRemoveValidator) Validate(ac, vc address.Codec) error {
 	return nil
 }
 
+/* MsgUnjailValidator */
+
+// NewMsgUnjailValidator creates a new MsgUnjailValidator instance.
+func NewMsgUnjailValidator(
+	authority string,
+	valAddr string,
+) *MsgUnjailValidator {
+	return &MsgUnjailValidator{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+	}
+}
+
+// Validate performs basic MsgUnjailValidator message validation.
+func (msg MsgUnjailValidator) Validate(ac, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if _, err := vc.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/* MsgRotateConsPubKey */
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+func NewMsgRotateConsPubKey(
+	authority string,
+	valAddr string,
+	pubKey cryptotypes.PubKey,
+) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		Pubkey:           pkAny,
+	}, nil
+}
+
+// Validate performs basic MsgRotateConsPubKey message validation.
+func (msg MsgRotateConsPubKey) Validate(ac, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if _, err := vc.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	}
+
+	if msg.Pubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+/* MsgUpdateValidatorPower */
+
+// NewMsgUpdateValidatorPower creates a new MsgUpdateValidatorPower instance.
+func NewMsgUpdateValidatorPower(
+	authority string,
+	valAddr string,
+	power int64,
+) *MsgUpdateValidatorPower {
+	return &MsgUpdateValidatorPower{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		Power:            power,
+	}
+}
+
+// Validate performs basic MsgUpdateValidatorPower message validation.
+func (msg MsgUpdateValidatorPower) Validate(ac, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if _, err := vc.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	}
+
+	return validateValidatorPower(msg.Power)
+}
+
+/* MsgSetValidatorSet */
+
+// NewMsgSetValidatorSet creates a new MsgSetValidatorSet instance.
+func NewMsgSetValidatorSet(
+	authority string,
+	validators []ValidatorPower,
+) *MsgSetValidatorSet {
+	return &MsgSetValidatorSet{
+		Authority:  authority,
+		Validators: validators,
+	}
+}
+
+// Validate performs basic MsgSetValidatorSet message validation.
+func (msg MsgSetValidatorSet) Validate(ac, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if len(msg.Validators) == 0 {
+		return ErrInvalidValidatorSet.Wrap("empty validator set")
+	}
+
+	operators := make(map[string]bool, len(msg.Validators))
+	pubKeys := make(map[string]bool, len(msg.Validators))
+	totalPower := int64(0)
+	for _, val := range msg.Validators {
+		if err := val.Validate(vc); err != nil {
+			return err
+		}
+
+		if operators[val.ValidatorAddress] {
+			return ErrInvalidValidatorSet.Wrapf("duplicate validator %s", val.ValidatorAddress)
+		}
+		operators[val.ValidatorAddress] = true
+
+		pubKey := val.Pubkey.TypeUrl + string(val.Pubkey.Value)
+		if pubKeys[pubKey] {
+			return ErrInvalidValidatorSet.Wrapf("duplicate pubkey of validator %s", val.ValidatorAddress)
+		}
+		pubKeys[pubKey] = true
+
+		totalPower += val.Power
+		if totalPower > cmttypes.MaxTotalVotingPower {
+			return ErrInvalidValidatorSet.Wrapf("total power exceeds %d", cmttypes.MaxTotalVotingPower)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSetValidatorSet) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, val := range msg.Validators {
+		if err := val.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewValidatorPower creates a new ValidatorPower instance.
+func NewValidatorPower(moniker string, valAddr string, pubKey cryptotypes.PubKey, power int64) (ValidatorPower, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return ValidatorPower{}, err
+		}
+	}
+	return ValidatorPower{
+		Moniker:          moniker,
+		ValidatorAddress: valAddr,
+		Pubkey:           pkAny,
+		Power:            power,
+	}, nil
+}
+
+// Validate performs basic ValidatorPower validation.
+func (val ValidatorPower) Validate(vc address.Codec) error {
+	if _, err := vc.StringToBytes(val.ValidatorAddress); err != nil {
+		return err
+	}
+
+	if val.Pubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return validateValidatorPower(val.Power)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (val ValidatorPower) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(val.Pubkey, &pubKey)
+}
+
+// validateValidatorPower checks the power is accepted by the consensus engine;
+// use MsgRemoveValidator to remove a validator.
+func validateValidatorPower(power int64) error {
+	if power <= 0 || power > cmttypes.MaxTotalVotingPower {
+		return ErrInvalidValidatorPower.Wrapf("power must be between 1 and %d, got %d", cmttypes.MaxTotalVotingPower, power)
+	}
+
+	return nil
+}
+
 /* MsgInitiateTokenWithdrawal */
 
 // NewMsgInitiateTokenWithdrawal creates a new MsgInitiateTokenWithdrawal instance.
@@ -198,6 +425,10 @@ func (msg MsgSetBridgeInfo) Validate(ac address.Codec) error {
 		return err
 	}
 
+	if len(msg.Proof) > 0 && msg.ProofHeight == 0 {
+		return ErrInvalidBridgeInfoProof.Wrap("empty proof height")
+	}
+
 	return nil
 }
 
@@ -270,6 +501,102 @@ func (msg MsgFinalizeTokenDeposit) Validate(ac address.Codec) error {
 		return ErrInvalidBlockHeight
 	}
 
+	if msg.Metadata != nil && msg.Metadata.Base != msg.BaseDenom {
+		return ErrInvalidDenomMetadata.Wrap("metadata base must be the base denom")
+	}
+
+	if len(msg.Proof) > 0 && msg.ProofHeight == 0 {
+		return ErrInvalidDepositProof.Wrap("empty proof height")
+	}
+
+	return nil
+}
+
+/* MsgFinalizeNftDeposit */
+
+// NewMsgFinalizeNftDeposit creates a new MsgFinalizeNftDeposit instance.
+func NewMsgFinalizeNftDeposit(
+	sender, from, to string,
+	classId, tokenId string,
+	sequence uint64,
+	height uint64,
+	baseClassId string,
+) *MsgFinalizeNftDeposit {
+	return &MsgFinalizeNftDeposit{
+		Sender:      sender,
+		From:        from,
+		To:          to,
+		ClassId:     classId,
+		TokenId:     tokenId,
+		Sequence:    sequence,
+		Height:      height,
+		BaseClassId: baseClassId,
+	}
+}
+
+// Validate performs basic MsgFinalizeNftDeposit message validation.
+func (msg MsgFinalizeNftDeposit) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := ac.StringToBytes(msg.From); err != nil {
+		return err
+	}
+
+	if _, err := ac.StringToBytes(msg.To); err != nil {
+		return err
+	}
+
+	if msg.ClassId == "" || msg.BaseClassId == "" || msg.TokenId == "" {
+		return ErrInvalidNft.Wrap("empty class id or token id")
+	}
+
+	if msg.Sequence == 0 {
+		return ErrInvalidSequence
+	}
+
+	if msg.Height == 0 {
+		return ErrInvalidBlockHeight
+	}
+
+	if len(msg.Proof) > 0 && msg.ProofHeight == 0 {
+		return ErrInvalidDepositProof.Wrap("empty proof height")
+	}
+
+	return nil
+}
+
+/* MsgInitiateNftWithdrawal */
+
+// NewMsgInitiateNftWithdrawal creates a new MsgInitiateNftWithdrawal instance.
+func NewMsgInitiateNftWithdrawal(
+	sender string,
+	to string,
+	classId, tokenId string,
+) *MsgInitiateNftWithdrawal {
+	return &MsgInitiateNftWithdrawal{
+		Sender:  sender,
+		To:      to,
+		ClassId: classId,
+		TokenId: tokenId,
+	}
+}
+
+// Validate performs basic MsgInitiateNftWithdrawal message validation.
+func (msg MsgInitiateNftWithdrawal) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := ac.StringToBytes(msg.To); err != nil {
+		return err
+	}
+
+	if msg.ClassId == "" || msg.TokenId == "" {
+		return ErrInvalidNft.Wrap("empty class id or token id")
+	}
+
 	return nil
 }
 
@@ -342,3 +669,226 @@ func (msg MsgUpdateOracle) Validate(ac address.Codec) error {
 	}
 	return nil
 }
+
+/* MsgUpdateDenomMetadata */
+
+// NewMsgUpdateDenomMetadata creates a new MsgUpdateDenomMetadata instance.
+func NewMsgUpdateDenomMetadata(authority string, metadata banktypes.Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Authority: authority,
+		Metadata:  metadata,
+	}
+}
+
+// Validate performs basic MsgUpdateDenomMetadata message validation.
+func (msg MsgUpdateDenomMetadata) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return ErrInvalidDenomMetadata.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+/* MsgRegisterExecutorChangePlan */
+
+// NewMsgRegisterExecutorChangePlan creates a new MsgRegisterExecutorChangePlan instance.
+func NewMsgRegisterExecutorChangePlan(
+	authority string,
+	proposalID, height uint64,
+	nextExecutors []string,
+	moniker, valAddr string,
+	pubKey cryptotypes.PubKey,
+	info string,
+) (*MsgRegisterExecutorChangePlan, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRegisterExecutorChangePlan{
+		Authority:        authority,
+		ProposalID:       proposalID,
+		Height:           height,
+		NextExecutors:    nextExecutors,
+		Moniker:          moniker,
+		ValidatorAddress: valAddr,
+		Pubkey:           pkAny,
+		Info:             info,
+	}, nil
+}
+
+// Validate performs basic MsgRegisterExecutorChangePlan message validation.
+func (msg MsgRegisterExecutorChangePlan) Validate(ac, vc address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.ProposalID == 0 {
+		return errors.Wrap(ErrInvalidExecutorChangePlan, "invalid proposal id")
+	}
+
+	if msg.Height == 0 {
+		return errors.Wrap(ErrInvalidExecutorChangePlan, "invalid height")
+	}
+
+	for _, nextExecutor := range msg.NextExecutors {
+		if _, err := ac.StringToBytes(nextExecutor); err != nil {
+			return err
+		}
+	}
+
+	if _, err := vc.StringToBytes(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Pubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRegisterExecutorChangePlan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+/* MsgCancelExecutorChangePlan */
+
+// NewMsgCancelExecutorChangePlan creates a new MsgCancelExecutorChangePlan instance.
+func NewMsgCancelExecutorChangePlan(authority string, height uint64) *MsgCancelExecutorChangePlan {
+	return &MsgCancelExecutorChangePlan{
+		Authority: authority,
+		Height:    height,
+	}
+}
+
+// Validate performs basic MsgCancelExecutorChangePlan message validation.
+func (msg MsgCancelExecutorChangePlan) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.Height == 0 {
+		return errors.Wrap(ErrInvalidExecutorChangePlan, "invalid height")
+	}
+
+	return nil
+}
+
+/* MsgExecuteScheduledMessages */
+
+// NewMsgExecuteScheduledMessages creates a new MsgExecuteScheduledMessages instance.
+func NewMsgExecuteScheduledMessages(sender string, id uint64) *MsgExecuteScheduledMessages {
+	return &MsgExecuteScheduledMessages{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+// Validate performs basic MsgExecuteScheduledMessages message validation.
+func (msg MsgExecuteScheduledMessages) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid id")
+	}
+
+	return nil
+}
+
+/* MsgCancelScheduledMessages */
+
+// NewMsgCancelScheduledMessages creates a new MsgCancelScheduledMessages instance.
+func NewMsgCancelScheduledMessages(sender string, id uint64) *MsgCancelScheduledMessages {
+	return &MsgCancelScheduledMessages{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+// Validate performs basic MsgCancelScheduledMessages message validation.
+func (msg MsgCancelScheduledMessages) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid id")
+	}
+
+	return nil
+}
+
+/* MsgApproveAdminProposal */
+
+// NewMsgApproveAdminProposal creates a new MsgApproveAdminProposal instance.
+func NewMsgApproveAdminProposal(sender string, proposalId uint64) *MsgApproveAdminProposal {
+	return &MsgApproveAdminProposal{
+		Sender:     sender,
+		ProposalId: proposalId,
+	}
+}
+
+// Validate performs basic MsgApproveAdminProposal message validation.
+func (msg MsgApproveAdminProposal) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid proposal id")
+	}
+
+	return nil
+}
+
+/* MsgProposeAdmin */
+
+// NewMsgProposeAdmin creates a new MsgProposeAdmin instance.
+func NewMsgProposeAdmin(sender, newAdmin string) *MsgProposeAdmin {
+	return &MsgProposeAdmin{
+		Sender:   sender,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Validate performs basic MsgProposeAdmin message validation.
+func (msg MsgProposeAdmin) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := ac.StringToBytes(msg.NewAdmin); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/* MsgAcceptAdmin */
+
+// NewMsgAcceptAdmin creates a new MsgAcceptAdmin instance.
+func NewMsgAcceptAdmin(sender string) *MsgAcceptAdmin {
+	return &MsgAcceptAdmin{
+		Sender: sender,
+	}
+}
+
+// Validate performs basic MsgAcceptAdmin message validation.
+func (msg MsgAcceptAdmin) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -41,6 +42,9 @@ type MsgExecuteMessages struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// messages are the arbitrary messages to be executed.
 	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execution_height schedules the messages to be executed at the height
+	// instead of immediately; zero uses the execute_messages_delay of params.
+	ExecutionHeight uint64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
 }
 
 func (m *MsgExecuteMessages) Reset()         { *m = MsgExecuteMessages{} }
@@ -78,6 +82,15 @@ var xxx_messageInfo_MsgExecuteMessages proto.InternalMessageInfo
 
 // MsgExecuteMessagesResponse returns MsgExecuteMessages message result data
 type MsgExecuteMessagesResponse struct {
+	// scheduled_id is the id of the scheduled messages; zero when the
+	// messages are executed immediately.
+	ScheduledId uint64 `protobuf:"varint,1,opt,name=scheduled_id,json=scheduledId,proto3" json:"scheduled_id,omitempty"`
+	// results are the execution results of the messages in order; empty when
+	// the messages are scheduled.
+	Results []ExecuteMessageResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+	// admin_proposal_id is the id of the admin proposal waiting for the
+	// approvals; zero when the admin threshold is not set.
+	AdminProposalId uint64 `protobuf:"varint,3,opt,name=admin_proposal_id,json=adminProposalId,proto3" json:"admin_proposal_id,omitempty"`
 }
 
 func (m *MsgExecuteMessagesResponse) Reset()         { *m = MsgExecuteMessagesResponse{} }
@@ -113,26 +126,29 @@ func (m *MsgExecuteMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteMessagesResponse proto.InternalMessageInfo
 
-// MsgSetBridgeInfo is a message to set the registered bridge information.
-type MsgSetBridgeInfo struct {
-	// the sender address
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	// bridge_info is the bridge information to be set.
-	BridgeInfo BridgeInfo `protobuf:"bytes,2,opt,name=bridge_info,json=bridgeInfo,proto3" json:"bridge_info"`
-}
-
-func (m *MsgSetBridgeInfo) Reset()         { *m = MsgSetBridgeInfo{} }
-func (m *MsgSetBridgeInfo) String() string { return proto.CompactTextString(m) }
-func (*MsgSetBridgeInfo) ProtoMessage()    {}
-func (*MsgSetBridgeInfo) Descriptor() ([]byte, []int) {
+// ExecuteMessageResult is the execution result of a message executed by
+// MsgExecuteMessages.
+type ExecuteMessageResult struct {
+	// type_url is the type url of the executed message.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// data is the data returned by the message handler.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// msg_responses are the responses of the message handler.
+	MsgResponses []*types.Any `protobuf:"bytes,3,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (m *ExecuteMessageResult) Reset()         { *m = ExecuteMessageResult{} }
+func (m *ExecuteMessageResult) String() string { return proto.CompactTextString(m) }
+func (*ExecuteMessageResult) ProtoMessage()    {}
+func (*ExecuteMessageResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{2}
 }
-func (m *MsgSetBridgeInfo) XXX_Unmarshal(b []byte) error {
+func (m *ExecuteMessageResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetBridgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecuteMessageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetBridgeInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecuteMessageResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -142,34 +158,39 @@ func (m *MsgSetBridgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgSetBridgeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetBridgeInfo.Merge(m, src)
+func (m *ExecuteMessageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteMessageResult.Merge(m, src)
 }
-func (m *MsgSetBridgeInfo) XXX_Size() int {
+func (m *ExecuteMessageResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetBridgeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetBridgeInfo.DiscardUnknown(m)
+func (m *ExecuteMessageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteMessageResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetBridgeInfo proto.InternalMessageInfo
+var xxx_messageInfo_ExecuteMessageResult proto.InternalMessageInfo
 
-// MsgSetBridgeInfoResponse returns set bridge info result data
-type MsgSetBridgeInfoResponse struct {
+// MsgExecuteScheduledMessages is a message to execute the scheduled
+// messages after the execution height.
+type MsgExecuteScheduledMessages struct {
+	// sender is the address of any account triggering the execution.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// id is the id of the scheduled messages.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSetBridgeInfoResponse) Reset()         { *m = MsgSetBridgeInfoResponse{} }
-func (m *MsgSetBridgeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetBridgeInfoResponse) ProtoMessage()    {}
-func (*MsgSetBridgeInfoResponse) Descriptor() ([]byte, []int) {
+func (m *MsgExecuteScheduledMessages) Reset()         { *m = MsgExecuteScheduledMessages{} }
+func (m *MsgExecuteScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteScheduledMessages) ProtoMessage()    {}
+func (*MsgExecuteScheduledMessages) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{3}
 }
-func (m *MsgSetBridgeInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgExecuteScheduledMessages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetBridgeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecuteScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetBridgeInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecuteScheduledMessages.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -179,50 +200,36 @@ func (m *MsgSetBridgeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSetBridgeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetBridgeInfoResponse.Merge(m, src)
+func (m *MsgExecuteScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteScheduledMessages.Merge(m, src)
 }
-func (m *MsgSetBridgeInfoResponse) XXX_Size() int {
+func (m *MsgExecuteScheduledMessages) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetBridgeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetBridgeInfoResponse.DiscardUnknown(m)
+func (m *MsgExecuteScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteScheduledMessages.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetBridgeInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgExecuteScheduledMessages proto.InternalMessageInfo
 
-// MsgFinalizeTokenDeposit is a message to submit deposit funds from upper layer
-type MsgFinalizeTokenDeposit struct {
-	// the sender address
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	// from is l1 sender address
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// to is l2 recipient address
-	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	// amount is the coin amount to deposit.
-	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
-	// sequence is the sequence number of l1 bridge
-	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// height is the height of l1 which is including the deposit message
-	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
-	// base_denom is the l1 denomination of the sent coin.
-	BaseDenom string `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	/// data is a extra bytes for hooks.
-	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+// MsgExecuteScheduledMessagesResponse returns MsgExecuteScheduledMessages message result data
+type MsgExecuteScheduledMessagesResponse struct {
+	// results are the execution results of the messages in order.
+	Results []ExecuteMessageResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgFinalizeTokenDeposit) Reset()         { *m = MsgFinalizeTokenDeposit{} }
-func (m *MsgFinalizeTokenDeposit) String() string { return proto.CompactTextString(m) }
-func (*MsgFinalizeTokenDeposit) ProtoMessage()    {}
-func (*MsgFinalizeTokenDeposit) Descriptor() ([]byte, []int) {
+func (m *MsgExecuteScheduledMessagesResponse) Reset()         { *m = MsgExecuteScheduledMessagesResponse{} }
+func (m *MsgExecuteScheduledMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteScheduledMessagesResponse) ProtoMessage()    {}
+func (*MsgExecuteScheduledMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{4}
 }
-func (m *MsgFinalizeTokenDeposit) XXX_Unmarshal(b []byte) error {
+func (m *MsgExecuteScheduledMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFinalizeTokenDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecuteScheduledMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFinalizeTokenDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecuteScheduledMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -232,34 +239,38 @@ func (m *MsgFinalizeTokenDeposit) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgFinalizeTokenDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFinalizeTokenDeposit.Merge(m, src)
+func (m *MsgExecuteScheduledMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteScheduledMessagesResponse.Merge(m, src)
 }
-func (m *MsgFinalizeTokenDeposit) XXX_Size() int {
+func (m *MsgExecuteScheduledMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFinalizeTokenDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFinalizeTokenDeposit.DiscardUnknown(m)
+func (m *MsgExecuteScheduledMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteScheduledMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFinalizeTokenDeposit proto.InternalMessageInfo
+var xxx_messageInfo_MsgExecuteScheduledMessagesResponse proto.InternalMessageInfo
 
-// MsgFinalizeTokenDepositResponse returns deposit result data
-type MsgFinalizeTokenDepositResponse struct {
+// MsgCancelScheduledMessages is a message to cancel the scheduled messages.
+type MsgCancelScheduledMessages struct {
+	// sender is the admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// id is the id of the scheduled messages.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgFinalizeTokenDepositResponse) Reset()         { *m = MsgFinalizeTokenDepositResponse{} }
-func (m *MsgFinalizeTokenDepositResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgFinalizeTokenDepositResponse) ProtoMessage()    {}
-func (*MsgFinalizeTokenDepositResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelScheduledMessages) Reset()         { *m = MsgCancelScheduledMessages{} }
+func (m *MsgCancelScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMessages) ProtoMessage()    {}
+func (*MsgCancelScheduledMessages) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{5}
 }
-func (m *MsgFinalizeTokenDepositResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelScheduledMessages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFinalizeTokenDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFinalizeTokenDepositResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelScheduledMessages.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -269,40 +280,37 @@ func (m *MsgFinalizeTokenDepositResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgFinalizeTokenDepositResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFinalizeTokenDepositResponse.Merge(m, src)
+func (m *MsgCancelScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMessages.Merge(m, src)
 }
-func (m *MsgFinalizeTokenDepositResponse) XXX_Size() int {
+func (m *MsgCancelScheduledMessages) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFinalizeTokenDepositResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFinalizeTokenDepositResponse.DiscardUnknown(m)
+func (m *MsgCancelScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMessages.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFinalizeTokenDepositResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelScheduledMessages proto.InternalMessageInfo
 
-// MsgInitiateTokenWithdrawal is a message to withdraw a new token from L2 to L1.
-type MsgInitiateTokenWithdrawal struct {
-	// the l2 sender address
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// to is l1 recipient address
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	// amount is the coin amount to withdraw.
-	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+// MsgCancelScheduledMessagesResponse returns MsgCancelScheduledMessages message result data
+type MsgCancelScheduledMessagesResponse struct {
+	// admin_proposal_id is the id of the admin proposal waiting for the
+	// approvals; zero when the admin threshold is not set.
+	AdminProposalId uint64 `protobuf:"varint,1,opt,name=admin_proposal_id,json=adminProposalId,proto3" json:"admin_proposal_id,omitempty"`
 }
 
-func (m *MsgInitiateTokenWithdrawal) Reset()         { *m = MsgInitiateTokenWithdrawal{} }
-func (m *MsgInitiateTokenWithdrawal) String() string { return proto.CompactTextString(m) }
-func (*MsgInitiateTokenWithdrawal) ProtoMessage()    {}
-func (*MsgInitiateTokenWithdrawal) Descriptor() ([]byte, []int) {
+func (m *MsgCancelScheduledMessagesResponse) Reset()         { *m = MsgCancelScheduledMessagesResponse{} }
+func (m *MsgCancelScheduledMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMessagesResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{6}
 }
-func (m *MsgInitiateTokenWithdrawal) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelScheduledMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInitiateTokenWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelScheduledMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInitiateTokenWithdrawal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelScheduledMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -312,35 +320,39 @@ func (m *MsgInitiateTokenWithdrawal) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgInitiateTokenWithdrawal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInitiateTokenWithdrawal.Merge(m, src)
+func (m *MsgCancelScheduledMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMessagesResponse.Merge(m, src)
 }
-func (m *MsgInitiateTokenWithdrawal) XXX_Size() int {
+func (m *MsgCancelScheduledMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInitiateTokenWithdrawal) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInitiateTokenWithdrawal.DiscardUnknown(m)
+func (m *MsgCancelScheduledMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInitiateTokenWithdrawal proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelScheduledMessagesResponse proto.InternalMessageInfo
 
-// MsgInitiateTokenWithdrawalResponse returns create token result data
-type MsgInitiateTokenWithdrawalResponse struct {
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+// MsgApproveAdminProposal is a message to approve the admin proposal by an
+// admin signer.
+type MsgApproveAdminProposal struct {
+	// sender is the admin signer address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// proposal_id is the id of the admin proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *MsgInitiateTokenWithdrawalResponse) Reset()         { *m = MsgInitiateTokenWithdrawalResponse{} }
-func (m *MsgInitiateTokenWithdrawalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInitiateTokenWithdrawalResponse) ProtoMessage()    {}
-func (*MsgInitiateTokenWithdrawalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgApproveAdminProposal) Reset()         { *m = MsgApproveAdminProposal{} }
+func (m *MsgApproveAdminProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveAdminProposal) ProtoMessage()    {}
+func (*MsgApproveAdminProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{7}
 }
-func (m *MsgInitiateTokenWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveAdminProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInitiateTokenWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveAdminProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInitiateTokenWithdrawalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveAdminProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -350,40 +362,42 @@ func (m *MsgInitiateTokenWithdrawalResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgInitiateTokenWithdrawalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInitiateTokenWithdrawalResponse.Merge(m, src)
+func (m *MsgApproveAdminProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveAdminProposal.Merge(m, src)
 }
-func (m *MsgInitiateTokenWithdrawalResponse) XXX_Size() int {
+func (m *MsgApproveAdminProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInitiateTokenWithdrawalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInitiateTokenWithdrawalResponse.DiscardUnknown(m)
+func (m *MsgApproveAdminProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveAdminProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInitiateTokenWithdrawalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveAdminProposal proto.InternalMessageInfo
 
-// MsgAddValidator defines a SDK message for adding a new validator.
-type MsgAddValidator struct {
-	// authority is the address that controls the module
-	// (defaults to x/opchild unless overwritten).
-	Authority        string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	Moniker          string     `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	ValidatorAddress string     `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Pubkey           *types.Any `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+// MsgApproveAdminProposalResponse returns MsgApproveAdminProposal message result data
+type MsgApproveAdminProposalResponse struct {
+	// passed is true when the approvals reached the admin threshold and the
+	// messages are executed or scheduled.
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	// scheduled_id is the id of the scheduled messages; zero when the
+	// messages are executed immediately.
+	ScheduledId uint64 `protobuf:"varint,2,opt,name=scheduled_id,json=scheduledId,proto3" json:"scheduled_id,omitempty"`
+	// results are the execution results of the messages in order.
+	Results []ExecuteMessageResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgAddValidator) Reset()         { *m = MsgAddValidator{} }
-func (m *MsgAddValidator) String() string { return proto.CompactTextString(m) }
-func (*MsgAddValidator) ProtoMessage()    {}
-func (*MsgAddValidator) Descriptor() ([]byte, []int) {
+func (m *MsgApproveAdminProposalResponse) Reset()         { *m = MsgApproveAdminProposalResponse{} }
+func (m *MsgApproveAdminProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveAdminProposalResponse) ProtoMessage()    {}
+func (*MsgApproveAdminProposalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{8}
 }
-func (m *MsgAddValidator) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveAdminProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddValidator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveAdminProposalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -393,34 +407,39 @@ func (m *MsgAddValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgAddValidator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddValidator.Merge(m, src)
+func (m *MsgApproveAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveAdminProposalResponse.Merge(m, src)
 }
-func (m *MsgAddValidator) XXX_Size() int {
+func (m *MsgApproveAdminProposalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddValidator) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddValidator.DiscardUnknown(m)
+func (m *MsgApproveAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveAdminProposalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddValidator proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveAdminProposalResponse proto.InternalMessageInfo
 
-// MsgAddValidatorResponse returns add result data
-type MsgAddValidatorResponse struct {
+// MsgProposeAdmin is a message to propose the next admin, which takes effect
+// after the next admin accepts it.
+type MsgProposeAdmin struct {
+	// sender is the current admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// new_admin is the address of the next admin.
+	NewAdmin string `protobuf:"bytes,2,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
 }
 
-func (m *MsgAddValidatorResponse) Reset()         { *m = MsgAddValidatorResponse{} }
-func (m *MsgAddValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddValidatorResponse) ProtoMessage()    {}
-func (*MsgAddValidatorResponse) Descriptor() ([]byte, []int) {
+func (m *MsgProposeAdmin) Reset()         { *m = MsgProposeAdmin{} }
+func (m *MsgProposeAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdmin) ProtoMessage()    {}
+func (*MsgProposeAdmin) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{9}
 }
-func (m *MsgAddValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgProposeAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposeAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposeAdmin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -430,39 +449,37 @@ func (m *MsgAddValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgAddValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddValidatorResponse.Merge(m, src)
+func (m *MsgProposeAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdmin.Merge(m, src)
 }
-func (m *MsgAddValidatorResponse) XXX_Size() int {
+func (m *MsgProposeAdmin) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddValidatorResponse.DiscardUnknown(m)
+func (m *MsgProposeAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdmin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddValidatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposeAdmin proto.InternalMessageInfo
 
-// MsgAddValidator is a message to remove a validator from designated list
-type MsgRemoveValidator struct {
-	// authority is the address that controls the module
-	// (defaults to x/opchild unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	// validator is the validator to remove.
-	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+// MsgProposeAdminResponse returns MsgProposeAdmin message result data
+type MsgProposeAdminResponse struct {
+	// admin_proposal_id is the id of the admin proposal waiting for the
+	// approvals; zero when the admin threshold is not set.
+	AdminProposalId uint64 `protobuf:"varint,1,opt,name=admin_proposal_id,json=adminProposalId,proto3" json:"admin_proposal_id,omitempty"`
 }
 
-func (m *MsgRemoveValidator) Reset()         { *m = MsgRemoveValidator{} }
-func (m *MsgRemoveValidator) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveValidator) ProtoMessage()    {}
-func (*MsgRemoveValidator) Descriptor() ([]byte, []int) {
+func (m *MsgProposeAdminResponse) Reset()         { *m = MsgProposeAdminResponse{} }
+func (m *MsgProposeAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminResponse) ProtoMessage()    {}
+func (*MsgProposeAdminResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{10}
 }
-func (m *MsgRemoveValidator) XXX_Unmarshal(b []byte) error {
+func (m *MsgProposeAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposeAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveValidator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposeAdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -472,34 +489,36 @@ func (m *MsgRemoveValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveValidator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveValidator.Merge(m, src)
+func (m *MsgProposeAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminResponse.Merge(m, src)
 }
-func (m *MsgRemoveValidator) XXX_Size() int {
+func (m *MsgProposeAdminResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveValidator) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveValidator.DiscardUnknown(m)
+func (m *MsgProposeAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveValidator proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposeAdminResponse proto.InternalMessageInfo
 
-// MsgAddValidatorResponse returns remove result data
-type MsgRemoveValidatorResponse struct {
+// MsgAcceptAdmin is a message to accept the admin role by the proposed admin.
+type MsgAcceptAdmin struct {
+	// sender is the proposed admin address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgRemoveValidatorResponse) Reset()         { *m = MsgRemoveValidatorResponse{} }
-func (m *MsgRemoveValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveValidatorResponse) ProtoMessage()    {}
-func (*MsgRemoveValidatorResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptAdmin) Reset()         { *m = MsgAcceptAdmin{} }
+func (m *MsgAcceptAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdmin) ProtoMessage()    {}
+func (*MsgAcceptAdmin) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{11}
 }
-func (m *MsgRemoveValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptAdmin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,39 +528,34 @@ func (m *MsgRemoveValidatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveValidatorResponse.Merge(m, src)
+func (m *MsgAcceptAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdmin.Merge(m, src)
 }
-func (m *MsgRemoveValidatorResponse) XXX_Size() int {
+func (m *MsgAcceptAdmin) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveValidatorResponse.DiscardUnknown(m)
+func (m *MsgAcceptAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdmin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveValidatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptAdmin proto.InternalMessageInfo
 
-// MsgUpdateParams is a message to update parameters
-type MsgUpdateParams struct {
-	// authority is the address that controls the module
-	// (defaults to x/opchild unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	// params are the arbitrary parameters to be updated.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+// MsgAcceptAdminResponse returns MsgAcceptAdmin message result data
+type MsgAcceptAdminResponse struct {
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptAdminResponse) Reset()         { *m = MsgAcceptAdminResponse{} }
+func (m *MsgAcceptAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdminResponse) ProtoMessage()    {}
+func (*MsgAcceptAdminResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{12}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptAdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,34 +565,42 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgAcceptAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdminResponse.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgAcceptAdminResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgAcceptAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdminResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptAdminResponse proto.InternalMessageInfo
 
-// MsgUpdateParamsResponse returns parameter update result data
-type MsgUpdateParamsResponse struct {
+// MsgSetBridgeInfo is a message to set the registered bridge information.
+type MsgSetBridgeInfo struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// bridge_info is the bridge information to be set.
+	BridgeInfo BridgeInfo `protobuf:"bytes,2,opt,name=bridge_info,json=bridgeInfo,proto3" json:"bridge_info"`
+	// proof_height is the l1 revision height of the proof.
+	ProofHeight uint64 `protobuf:"varint,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+	// proof is the merkle proof of the bridge config in the l1 ophost store.
+	Proof []byte `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgeInfo) Reset()         { *m = MsgSetBridgeInfo{} }
+func (m *MsgSetBridgeInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeInfo) ProtoMessage()    {}
+func (*MsgSetBridgeInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{13}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgeInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -588,42 +610,34 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSetBridgeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeInfo.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSetBridgeInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSetBridgeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetBridgeInfo proto.InternalMessageInfo
 
-// MsgSpendFeePool is a message to withdraw collected fees from the module
-// account to the recipient address.
-type MsgSpendFeePool struct {
-	// authority is the address that controls the module
-	// (defaults to x/opchild unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
-	// recipient is address to receive the coins.
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	// the coin amount to spend.
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+// MsgSetBridgeInfoResponse returns set bridge info result data
+type MsgSetBridgeInfoResponse struct {
 }
 
-func (m *MsgSpendFeePool) Reset()         { *m = MsgSpendFeePool{} }
-func (m *MsgSpendFeePool) String() string { return proto.CompactTextString(m) }
-func (*MsgSpendFeePool) ProtoMessage()    {}
-func (*MsgSpendFeePool) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgeInfoResponse) Reset()         { *m = MsgSetBridgeInfoResponse{} }
+func (m *MsgSetBridgeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeInfoResponse) ProtoMessage()    {}
+func (*MsgSetBridgeInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{14}
 }
-func (m *MsgSpendFeePool) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSpendFeePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSpendFeePool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -633,34 +647,58 @@ func (m *MsgSpendFeePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgSpendFeePool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSpendFeePool.Merge(m, src)
+func (m *MsgSetBridgeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeInfoResponse.Merge(m, src)
 }
-func (m *MsgSpendFeePool) XXX_Size() int {
+func (m *MsgSetBridgeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSpendFeePool) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSpendFeePool.DiscardUnknown(m)
+func (m *MsgSetBridgeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSpendFeePool proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetBridgeInfoResponse proto.InternalMessageInfo
 
-// MsgSpendFeePoolResponse returns deposit result data
-type MsgSpendFeePoolResponse struct {
+// MsgFinalizeTokenDeposit is a message to submit deposit funds from upper layer
+type MsgFinalizeTokenDeposit struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// from is l1 sender address
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is l2 recipient address
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the coin amount to deposit.
+	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// sequence is the sequence number of l1 bridge
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the height of l1 which is including the deposit message
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// base_denom is the l1 denomination of the sent coin.
+	BaseDenom string `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	/// data is a extra bytes for hooks.
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// metadata is the l1 denom metadata of the base denom, which is included
+	// in the first deposit of the denom.
+	Metadata *types2.Metadata `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// proof_height is the l1 revision height of the proof.
+	ProofHeight uint64 `protobuf:"varint,10,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+	// proof is the merkle proof of the deposit commitment in the l1 ophost
+	// store. A proven deposit can be relayed by any account.
+	Proof []byte `protobuf:"bytes,11,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *MsgSpendFeePoolResponse) Reset()         { *m = MsgSpendFeePoolResponse{} }
-func (m *MsgSpendFeePoolResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSpendFeePoolResponse) ProtoMessage()    {}
-func (*MsgSpendFeePoolResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFinalizeTokenDeposit) Reset()         { *m = MsgFinalizeTokenDeposit{} }
+func (m *MsgFinalizeTokenDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeTokenDeposit) ProtoMessage()    {}
+func (*MsgFinalizeTokenDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{15}
 }
-func (m *MsgSpendFeePoolResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinalizeTokenDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSpendFeePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinalizeTokenDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSpendFeePoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinalizeTokenDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -670,40 +708,34 @@ func (m *MsgSpendFeePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgSpendFeePoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSpendFeePoolResponse.Merge(m, src)
+func (m *MsgFinalizeTokenDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeTokenDeposit.Merge(m, src)
 }
-func (m *MsgSpendFeePoolResponse) XXX_Size() int {
+func (m *MsgFinalizeTokenDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSpendFeePoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSpendFeePoolResponse.DiscardUnknown(m)
+func (m *MsgFinalizeTokenDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeTokenDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSpendFeePoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFinalizeTokenDeposit proto.InternalMessageInfo
 
-// MsgUpdateOracle is a message to update oracle prices which contains L1 extended commits for oracle.
-type MsgUpdateOracle struct {
-	// the sender address
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	// height is the height of l1 which is including the oracle message
-	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	/// data is oracle votes bytes.
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+// MsgFinalizeTokenDepositResponse returns deposit result data
+type MsgFinalizeTokenDepositResponse struct {
 }
 
-func (m *MsgUpdateOracle) Reset()         { *m = MsgUpdateOracle{} }
-func (m *MsgUpdateOracle) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateOracle) ProtoMessage()    {}
-func (*MsgUpdateOracle) Descriptor() ([]byte, []int) {
+func (m *MsgFinalizeTokenDepositResponse) Reset()         { *m = MsgFinalizeTokenDepositResponse{} }
+func (m *MsgFinalizeTokenDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeTokenDepositResponse) ProtoMessage()    {}
+func (*MsgFinalizeTokenDepositResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{16}
 }
-func (m *MsgUpdateOracle) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinalizeTokenDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinalizeTokenDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateOracle.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinalizeTokenDepositResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -713,34 +745,62 @@ func (m *MsgUpdateOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateOracle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateOracle.Merge(m, src)
+func (m *MsgFinalizeTokenDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeTokenDepositResponse.Merge(m, src)
 }
-func (m *MsgUpdateOracle) XXX_Size() int {
+func (m *MsgFinalizeTokenDepositResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateOracle) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateOracle.DiscardUnknown(m)
+func (m *MsgFinalizeTokenDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeTokenDepositResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateOracle proto.InternalMessageInfo
-
-// MsgUpdateOracleResponse returns oracle update result data
-type MsgUpdateOracleResponse struct {
-}
+var xxx_messageInfo_MsgFinalizeTokenDepositResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateOracleResponse) Reset()         { *m = MsgUpdateOracleResponse{} }
-func (m *MsgUpdateOracleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateOracleResponse) ProtoMessage()    {}
-func (*MsgUpdateOracleResponse) Descriptor() ([]byte, []int) {
+// MsgFinalizeNftDeposit is a message to mint a wrapped nft of the nft locked on l1
+type MsgFinalizeNftDeposit struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// from is l1 sender address
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is l2 recipient address
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// class_id is the l2 class id of the wrapped nft.
+	ClassId string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// token_id is the id of the nft.
+	TokenId string `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// sequence is the sequence number of l1 bridge
+	Sequence uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the height of l1 which is including the deposit message
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// base_class_id is the l1 class id of the nft.
+	BaseClassId string `protobuf:"bytes,8,opt,name=base_class_id,json=baseClassId,proto3" json:"base_class_id,omitempty"`
+	// class_name, class_symbol and class_uri are the l1 class information
+	// used to create the wrapped class on the first deposit.
+	ClassName   string `protobuf:"bytes,9,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	ClassSymbol string `protobuf:"bytes,10,opt,name=class_symbol,json=classSymbol,proto3" json:"class_symbol,omitempty"`
+	ClassUri    string `protobuf:"bytes,11,opt,name=class_uri,json=classUri,proto3" json:"class_uri,omitempty"`
+	// token_uri is the l1 uri of the nft.
+	TokenUri string `protobuf:"bytes,12,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`
+	// proof_height is the l1 revision height of the proof.
+	ProofHeight uint64 `protobuf:"varint,13,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+	// proof is the merkle proof of the deposit commitment in the l1 ophost
+	// store. A proven deposit can be relayed by any account.
+	Proof []byte `protobuf:"bytes,14,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgFinalizeNftDeposit) Reset()         { *m = MsgFinalizeNftDeposit{} }
+func (m *MsgFinalizeNftDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeNftDeposit) ProtoMessage()    {}
+func (*MsgFinalizeNftDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1ee96a503651b6e4, []int{17}
 }
-func (m *MsgUpdateOracleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinalizeNftDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinalizeNftDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateOracleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinalizeNftDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,1450 +810,8543 @@ func (m *MsgUpdateOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateOracleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateOracleResponse.Merge(m, src)
+func (m *MsgFinalizeNftDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeNftDeposit.Merge(m, src)
 }
-func (m *MsgUpdateOracleResponse) XXX_Size() int {
+func (m *MsgFinalizeNftDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateOracleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateOracleResponse.DiscardUnknown(m)
+func (m *MsgFinalizeNftDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeNftDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateOracleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFinalizeNftDeposit proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgExecuteMessages)(nil), "opinit.opchild.v1.MsgExecuteMessages")
-	proto.RegisterType((*MsgExecuteMessagesResponse)(nil), "opinit.opchild.v1.MsgExecuteMessagesResponse")
-	proto.RegisterType((*MsgSetBridgeInfo)(nil), "opinit.opchild.v1.MsgSetBridgeInfo")
-	proto.RegisterType((*MsgSetBridgeInfoResponse)(nil), "opinit.opchild.v1.MsgSetBridgeInfoResponse")
-	proto.RegisterType((*MsgFinalizeTokenDeposit)(nil), "opinit.opchild.v1.MsgFinalizeTokenDeposit")
-	proto.RegisterType((*MsgFinalizeTokenDepositResponse)(nil), "opinit.opchild.v1.MsgFinalizeTokenDepositResponse")
-	proto.RegisterType((*MsgInitiateTokenWithdrawal)(nil), "opinit.opchild.v1.MsgInitiateTokenWithdrawal")
-	proto.RegisterType((*MsgInitiateTokenWithdrawalResponse)(nil), "opinit.opchild.v1.MsgInitiateTokenWithdrawalResponse")
-	proto.RegisterType((*MsgAddValidator)(nil), "opinit.opchild.v1.MsgAddValidator")
-	proto.RegisterType((*MsgAddValidatorResponse)(nil), "opinit.opchild.v1.MsgAddValidatorResponse")
-	proto.RegisterType((*MsgRemoveValidator)(nil), "opinit.opchild.v1.MsgRemoveValidator")
-	proto.RegisterType((*MsgRemoveValidatorResponse)(nil), "opinit.opchild.v1.MsgRemoveValidatorResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "opinit.opchild.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "opinit.opchild.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgSpendFeePool)(nil), "opinit.opchild.v1.MsgSpendFeePool")
-	proto.RegisterType((*MsgSpendFeePoolResponse)(nil), "opinit.opchild.v1.MsgSpendFeePoolResponse")
-	proto.RegisterType((*MsgUpdateOracle)(nil), "opinit.opchild.v1.MsgUpdateOracle")
-	proto.RegisterType((*MsgUpdateOracleResponse)(nil), "opinit.opchild.v1.MsgUpdateOracleResponse")
+// MsgFinalizeNftDepositResponse returns deposit result data
+type MsgFinalizeNftDepositResponse struct {
 }
 
-func init() { proto.RegisterFile("opinit/opchild/v1/tx.proto", fileDescriptor_1ee96a503651b6e4) }
-
-var fileDescriptor_1ee96a503651b6e4 = []byte{
-	// 1177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x93, 0x36, 0x6d, 0xa7, 0xbb, 0x6a, 0x6b, 0x55, 0xd4, 0xb5, 0x68, 0x52, 0x8c, 0x10,
-	0x21, 0x6c, 0xec, 0x6d, 0x10, 0x1c, 0x72, 0xa2, 0x61, 0xb7, 0x52, 0x59, 0x45, 0x5b, 0xa5, 0xfc,
-	0x91, 0x38, 0x50, 0x4d, 0xe2, 0xa9, 0x33, 0x6a, 0xec, 0x31, 0x9e, 0x49, 0x68, 0xb8, 0x80, 0x38,
-	0x21, 0x4e, 0x7c, 0x84, 0x3d, 0x20, 0x84, 0x38, 0xf5, 0xc0, 0x69, 0xe1, 0x03, 0x54, 0x7b, 0x5a,
-	0x71, 0x40, 0x9c, 0x0a, 0xb4, 0x87, 0x72, 0xde, 0x2b, 0x17, 0x64, 0xcf, 0xc4, 0x71, 0x1c, 0xbb,
-	0xe9, 0xa2, 0xc2, 0xa5, 0xcd, 0xbc, 0xf7, 0x9b, 0x37, 0xef, 0xf7, 0xde, 0x9b, 0xf7, 0xc6, 0x40,
-	0x25, 0x2e, 0x76, 0x30, 0x33, 0x88, 0xdb, 0xee, 0xe0, 0xae, 0x69, 0xf4, 0xb7, 0x0c, 0x76, 0xac,
-	0xbb, 0x1e, 0x61, 0x44, 0x5e, 0xe1, 0x3a, 0x5d, 0xe8, 0xf4, 0xfe, 0x96, 0xba, 0x02, 0x6d, 0xec,
-	0x10, 0x23, 0xf8, 0xcb, 0x51, 0x6a, 0xa1, 0x4d, 0xa8, 0x4d, 0xa8, 0xd1, 0x82, 0x14, 0x19, 0xfd,
-	0xad, 0x16, 0x62, 0x70, 0xcb, 0x68, 0x13, 0xec, 0x08, 0xfd, 0x9a, 0xd0, 0xdb, 0xd4, 0xf2, 0xad,
-	0xdb, 0xd4, 0x12, 0x8a, 0x75, 0xae, 0x38, 0x08, 0x56, 0x06, 0x5f, 0x08, 0xd5, 0xaa, 0x45, 0x2c,
-	0xc2, 0xe5, 0xfe, 0xaf, 0xe1, 0x06, 0x8b, 0x10, 0xab, 0x8b, 0x8c, 0x60, 0xd5, 0xea, 0x1d, 0x1a,
-	0xd0, 0x19, 0x08, 0xd5, 0x46, 0x02, 0x8d, 0x81, 0x8b, 0x84, 0x3d, 0xed, 0x5b, 0x09, 0xc8, 0x0d,
-	0x6a, 0xdd, 0x3f, 0x46, 0xed, 0x1e, 0x43, 0x0d, 0x44, 0x29, 0xb4, 0x10, 0x95, 0xef, 0x82, 0x3c,
-	0x45, 0x8e, 0x89, 0x3c, 0x45, 0xda, 0x94, 0x4a, 0x0b, 0x75, 0xe5, 0x97, 0x1f, 0x2b, 0xab, 0xc2,
-	0x91, 0x6d, 0xd3, 0xf4, 0x10, 0xa5, 0xfb, 0xcc, 0xc3, 0x8e, 0xd5, 0x14, 0x38, 0xf9, 0x2e, 0x98,
-	0xb7, 0xc5, 0x6e, 0x25, 0xbb, 0x99, 0x2b, 0x2d, 0x56, 0x57, 0x75, 0xee, 0x95, 0x3e, 0xf4, 0x4a,
-	0xdf, 0x76, 0x06, 0xcd, 0x10, 0x55, 0x2b, 0x7f, 0x79, 0x79, 0x52, 0x16, 0xdb, 0xbf, 0xbe, 0x3c,
-	0x29, 0xab, 0x43, 0x17, 0x27, 0xfd, 0xd1, 0x5e, 0x04, 0xea, 0xa4, 0xb4, 0x89, 0xa8, 0x4b, 0x1c,
-	0x8a, 0xb4, 0x27, 0x12, 0x58, 0x6e, 0x50, 0x6b, 0x1f, 0xb1, 0xba, 0x87, 0x4d, 0x0b, 0xed, 0x3a,
-	0x87, 0x44, 0xde, 0x8e, 0x51, 0x78, 0xed, 0xd9, 0x59, 0xf1, 0xf6, 0x00, 0xda, 0xdd, 0x9a, 0xc6,
-	0xe5, 0xda, 0x54, 0x4e, 0xbb, 0x60, 0xb1, 0x15, 0x18, 0x3c, 0xc0, 0xce, 0x21, 0x51, 0xb2, 0x9b,
-	0x52, 0x69, 0xb1, 0xba, 0xa1, 0x4f, 0x24, 0x5f, 0x1f, 0x1d, 0x5b, 0x5f, 0x38, 0x3d, 0x2b, 0x66,
-	0xbe, 0xbf, 0x3c, 0x29, 0x4b, 0x4d, 0xd0, 0x0a, 0xc5, 0xb5, 0x52, 0x8c, 0xac, 0x12, 0x21, 0x3b,
-	0xe6, 0xb7, 0xa6, 0x02, 0x25, 0x2e, 0x0b, 0x89, 0x7e, 0x97, 0x03, 0x6b, 0x0d, 0x6a, 0xed, 0x60,
-	0x07, 0x76, 0xf1, 0x67, 0xe8, 0x3d, 0x72, 0x84, 0x9c, 0x7b, 0xc8, 0x25, 0x14, 0xb3, 0x9b, 0xe0,
-	0x7b, 0x07, 0xcc, 0x1c, 0x7a, 0xc4, 0x0e, 0x88, 0x5e, 0x95, 0xf3, 0x00, 0x25, 0x97, 0x40, 0x96,
-	0x11, 0x25, 0x37, 0x05, 0x9b, 0x65, 0x44, 0x6e, 0x80, 0x3c, 0xb4, 0x49, 0xcf, 0x61, 0xca, 0x4c,
-	0x10, 0xc2, 0x75, 0x5d, 0x40, 0xfd, 0x9b, 0xa1, 0x8b, 0x9b, 0xa1, 0xbf, 0x43, 0xb0, 0x53, 0x57,
-	0xfd, 0xf0, 0x8d, 0x3c, 0xe7, 0xdb, 0x34, 0x1e, 0x4f, 0x61, 0x44, 0x56, 0xc1, 0x3c, 0x45, 0x9f,
-	0xf4, 0x90, 0xd3, 0x46, 0xca, 0xec, 0xa6, 0x54, 0x9a, 0x69, 0x86, 0x6b, 0xf9, 0x05, 0x90, 0xef,
-	0x20, 0x6c, 0x75, 0x98, 0x92, 0x0f, 0x34, 0x62, 0x25, 0x6f, 0x00, 0xe0, 0x1f, 0x76, 0x60, 0x22,
-	0x87, 0xd8, 0xca, 0x9c, 0xef, 0x74, 0x73, 0xc1, 0x97, 0xdc, 0xf3, 0x05, 0xf2, 0x06, 0x98, 0x31,
-	0x21, 0x83, 0xca, 0xfc, 0xa6, 0x54, 0xba, 0x15, 0xe4, 0x50, 0xe2, 0x67, 0x06, 0xe2, 0x9a, 0x11,
-	0xcb, 0x5e, 0x31, 0x92, 0xbd, 0xa4, 0x64, 0x68, 0x2f, 0x81, 0x62, 0x8a, 0x2a, 0xcc, 0xe5, 0xdf,
-	0x52, 0x50, 0xd3, 0xbb, 0x0e, 0x66, 0x18, 0x32, 0x8e, 0xf9, 0x10, 0xb3, 0x8e, 0xe9, 0xc1, 0x4f,
-	0x61, 0xf7, 0x5f, 0xdc, 0x40, 0x9e, 0x8f, 0xec, 0xff, 0x9e, 0x8f, 0x5a, 0x35, 0x16, 0x1d, 0x2d,
-	0x12, 0x9d, 0x14, 0x7a, 0xda, 0xdb, 0x40, 0x4b, 0xd7, 0x0e, 0x63, 0x34, 0x96, 0x69, 0x69, 0x3c,
-	0xd3, 0xda, 0xe3, 0x2c, 0x58, 0x6a, 0x50, 0x6b, 0xdb, 0x34, 0x3f, 0x80, 0x5d, 0x6c, 0x42, 0x46,
-	0x3c, 0xf9, 0x5d, 0xb0, 0x00, 0x7b, 0xac, 0x43, 0x3c, 0xcc, 0x06, 0x22, 0x6e, 0x77, 0x9e, 0x9d,
-	0x15, 0x97, 0x85, 0xf3, 0x43, 0x55, 0xfa, 0x4d, 0x18, 0x6d, 0x97, 0x15, 0x30, 0x67, 0x13, 0x07,
-	0x1f, 0x21, 0x8f, 0xc7, 0xb4, 0x39, 0x5c, 0xca, 0xf7, 0xc1, 0x4a, 0x7f, 0x78, 0xe4, 0x01, 0xe4,
-	0xfb, 0xa7, 0xde, 0x83, 0xe5, 0x70, 0x8b, 0x90, 0xcb, 0x3b, 0x20, 0xef, 0xf6, 0x5a, 0x47, 0x68,
-	0x20, 0xb2, 0x90, 0xd8, 0x2f, 0xeb, 0xca, 0x93, 0x91, 0xc5, 0xb6, 0x37, 0x70, 0x19, 0xd1, 0xf7,
-	0x7a, 0xad, 0x07, 0x68, 0xd0, 0x14, 0xbb, 0x6b, 0xd5, 0xaf, 0x1e, 0x15, 0x33, 0x7f, 0x3d, 0x2a,
-	0x66, 0xfc, 0x34, 0x8c, 0x08, 0xf8, 0x99, 0x58, 0x8b, 0x64, 0x22, 0x1a, 0x28, 0x6d, 0x3d, 0xe8,
-	0x23, 0x51, 0x51, 0x58, 0x97, 0xbf, 0xf2, 0x89, 0xd0, 0x44, 0x36, 0xe9, 0xa3, 0xff, 0x26, 0xb4,
-	0x89, 0x01, 0xcc, 0x3e, 0x6f, 0x00, 0x6b, 0x95, 0x49, 0xc2, 0xd1, 0x19, 0x12, 0x63, 0x20, 0x66,
-	0x48, 0x4c, 0x1a, 0xd2, 0xfe, 0x59, 0x0a, 0xca, 0xe9, 0x7d, 0xd7, 0x84, 0x0c, 0xed, 0x41, 0x0f,
-	0xda, 0xf4, 0x46, 0x39, 0x6f, 0x81, 0xbc, 0x1b, 0x58, 0x15, 0x63, 0x64, 0x3d, 0x61, 0x8c, 0xf0,
-	0x63, 0x9b, 0x02, 0xc8, 0x07, 0x64, 0x7a, 0x42, 0xa3, 0xae, 0x8a, 0x84, 0x46, 0x45, 0x23, 0x66,
-	0xfc, 0xa2, 0xec, 0xbb, 0xc8, 0x31, 0x77, 0x10, 0xda, 0x23, 0xa4, 0x7b, 0xa3, 0xcc, 0xde, 0x02,
-	0x0b, 0x1e, 0x6a, 0x63, 0x17, 0x23, 0x87, 0x4d, 0xcd, 0xe2, 0x08, 0x2a, 0x0f, 0xc2, 0x2e, 0x94,
-	0x0b, 0xde, 0x0b, 0x57, 0x74, 0xa1, 0x9d, 0xc4, 0x2e, 0xf4, 0xc3, 0xef, 0xc5, 0x92, 0x85, 0x59,
-	0xa7, 0xd7, 0xd2, 0xdb, 0xc4, 0x16, 0xef, 0x24, 0xf1, 0xaf, 0x42, 0xcd, 0x23, 0xf1, 0xd0, 0xf1,
-	0x2d, 0xd0, 0xf1, 0x8e, 0x35, 0x25, 0xb2, 0xd1, 0x50, 0x89, 0xc8, 0x46, 0x45, 0x61, 0x64, 0x7f,
-	0x8a, 0xd6, 0xcc, 0x43, 0x0f, 0xb6, 0xbb, 0xe8, 0x26, 0xc6, 0xf0, 0x46, 0x38, 0xc3, 0xfc, 0x68,
-	0xce, 0xd4, 0x67, 0x85, 0xf3, 0xe1, 0x28, 0xe3, 0xb3, 0x2a, 0x97, 0x3c, 0xab, 0x5e, 0x8d, 0x75,
-	0xe3, 0xc9, 0x92, 0xe1, 0x9e, 0x8e, 0x95, 0x0c, 0x17, 0x0d, 0x89, 0x55, 0x1f, 0xcf, 0x81, 0x5c,
-	0x83, 0x5a, 0xb2, 0x05, 0x96, 0xe2, 0x2f, 0xc3, 0x57, 0x12, 0xea, 0x76, 0xf2, 0x69, 0xa6, 0x56,
-	0xae, 0x05, 0x0b, 0x1b, 0x3d, 0x04, 0xb7, 0xc7, 0x5f, 0x6f, 0x2f, 0x27, 0xef, 0x1f, 0x03, 0xa9,
-	0xaf, 0x5f, 0x03, 0x14, 0x1e, 0xd1, 0x07, 0xab, 0x89, 0xef, 0xa6, 0x72, 0xb2, 0x91, 0x24, 0xac,
-	0x5a, 0xbd, 0x3e, 0x36, 0x3c, 0xf7, 0x73, 0xb0, 0x96, 0x36, 0xe3, 0x53, 0x82, 0x94, 0x02, 0x57,
-	0xdf, 0x7c, 0x2e, 0x78, 0xe8, 0xc0, 0xc7, 0xe0, 0xd6, 0xd8, 0x90, 0xd4, 0x92, 0xcd, 0x44, 0x31,
-	0x6a, 0x79, 0x3a, 0x26, 0xb4, 0x6f, 0x81, 0xa5, 0xf8, 0xb0, 0x48, 0x29, 0x92, 0x18, 0x2c, 0xad,
-	0x48, 0x52, 0x5a, 0xb4, 0x4f, 0x64, 0xac, 0x3d, 0xa7, 0x10, 0x89, 0x62, 0xd2, 0x88, 0x24, 0x35,
-	0x4a, 0xdf, 0xfe, 0x58, 0x93, 0x4c, 0xb1, 0x1f, 0xc5, 0xa4, 0xd9, 0x4f, 0x6a, 0x17, 0x23, 0xff,
-	0x45, 0xab, 0xb8, 0xd2, 0x7f, 0x8e, 0xb9, 0xda, 0xff, 0xf1, 0x5b, 0xab, 0xce, 0x7e, 0xe1, 0xb7,
-	0x81, 0xfa, 0x83, 0xd3, 0x3f, 0x0b, 0x99, 0xd3, 0xf3, 0x82, 0xf4, 0xf4, 0xbc, 0x20, 0xfd, 0x71,
-	0x5e, 0x90, 0xbe, 0xb9, 0x28, 0x64, 0x9e, 0x5e, 0x14, 0x32, 0xbf, 0x5d, 0x14, 0x32, 0x1f, 0x55,
-	0x22, 0x1d, 0x13, 0x07, 0x95, 0x53, 0xe9, 0xc2, 0x16, 0x35, 0x1e, 0xee, 0x05, 0x9f, 0x89, 0xc7,
-	0xe1, 0x87, 0x62, 0xd0, 0x3c, 0x5b, 0xf9, 0xe0, 0x31, 0xf2, 0xc6, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x04, 0xb3, 0x09, 0x7a, 0x0e, 0x0f, 0x00, 0x00,
+func (m *MsgFinalizeNftDepositResponse) Reset()         { *m = MsgFinalizeNftDepositResponse{} }
+func (m *MsgFinalizeNftDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeNftDepositResponse) ProtoMessage()    {}
+func (*MsgFinalizeNftDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ExecuteMessages defines a rpc handler method for MsgExecuteMessages.
-	ExecuteMessages(ctx context.Context, in *MsgExecuteMessages, opts ...grpc.CallOption) (*MsgExecuteMessagesResponse, error)
-	// SetBridgeInfo defines a rpc handler method for MsgSetBridgeInfo.
-	SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfo, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error)
-	// FinalizeTokenDeposit defines a rpc handler method for MsgFinalizeTokenDeposit.
-	FinalizeTokenDeposit(ctx context.Context, in *MsgFinalizeTokenDeposit, opts ...grpc.CallOption) (*MsgFinalizeTokenDepositResponse, error)
-	// InitiateTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
-	InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiateTokenWithdrawal, opts ...grpc.CallOption) (*MsgInitiateTokenWithdrawalResponse, error)
-	// AddValidator defines a rpc handler method for MsgAddValidator.
-	AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error)
-	// RemoveValidator defines a rpc handler method for MsgRemoveValidator.
-	RemoveValidator(ctx context.Context, in *MsgRemoveValidator, opts ...grpc.CallOption) (*MsgRemoveValidatorResponse, error)
-	// UpdateParams defines an operation for updating the
-	// x/opchild module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// SpendFeePool defines an operation that spend fee pool to a recipient.
-	SpendFeePool(ctx context.Context, in *MsgSpendFeePool, opts ...grpc.CallOption) (*MsgSpendFeePoolResponse, error)
-	// UpdateOracle defines an operation that update oracle prices.
-	UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error)
+func (m *MsgFinalizeNftDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgFinalizeNftDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeNftDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgFinalizeNftDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeNftDepositResponse.Merge(m, src)
 }
-
-func (c *msgClient) ExecuteMessages(ctx context.Context, in *MsgExecuteMessages, opts ...grpc.CallOption) (*MsgExecuteMessagesResponse, error) {
-	out := new(MsgExecuteMessagesResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/ExecuteMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgFinalizeNftDepositResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfo, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error) {
-	out := new(MsgSetBridgeInfoResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/SetBridgeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgFinalizeNftDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeNftDepositResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) FinalizeTokenDeposit(ctx context.Context, in *MsgFinalizeTokenDeposit, opts ...grpc.CallOption) (*MsgFinalizeTokenDepositResponse, error) {
-	out := new(MsgFinalizeTokenDepositResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/FinalizeTokenDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgFinalizeNftDepositResponse proto.InternalMessageInfo
 
-func (c *msgClient) InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiateTokenWithdrawal, opts ...grpc.CallOption) (*MsgInitiateTokenWithdrawalResponse, error) {
-	out := new(MsgInitiateTokenWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/InitiateTokenWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgInitiateTokenWithdrawal is a message to withdraw a new token from L2 to L1.
+type MsgInitiateTokenWithdrawal struct {
+	// the l2 sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// to is l1 recipient address
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the coin amount to withdraw.
+	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
 }
 
-func (c *msgClient) AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error) {
-	out := new(MsgAddValidatorResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/AddValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *msgClient) RemoveValidator(ctx context.Context, in *MsgRemoveValidator, opts ...grpc.CallOption) (*MsgRemoveValidatorResponse, error) {
-	out := new(MsgRemoveValidatorResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/RemoveValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgInitiateTokenWithdrawal) Reset()         { *m = MsgInitiateTokenWithdrawal{} }
+func (m *MsgInitiateTokenWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateTokenWithdrawal) ProtoMessage()    {}
+func (*MsgInitiateTokenWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{19}
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInitiateTokenWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateTokenWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateTokenWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgInitiateTokenWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateTokenWithdrawal.Merge(m, src)
+}
+func (m *MsgInitiateTokenWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateTokenWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateTokenWithdrawal.DiscardUnknown(m)
 }
 
-func (c *msgClient) SpendFeePool(ctx context.Context, in *MsgSpendFeePool, opts ...grpc.CallOption) (*MsgSpendFeePoolResponse, error) {
-	out := new(MsgSpendFeePoolResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/SpendFeePool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgInitiateTokenWithdrawal proto.InternalMessageInfo
+
+// MsgInitiateNftWithdrawal is a message to burn a wrapped nft and release
+// the original nft on l1
+type MsgInitiateNftWithdrawal struct {
+	// the l2 sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// to is l1 recipient address
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// class_id is the l2 class id of the wrapped nft.
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// token_id is the id of the nft.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (c *msgClient) UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error) {
-	out := new(MsgUpdateOracleResponse)
-	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UpdateOracle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInitiateNftWithdrawal) Reset()         { *m = MsgInitiateNftWithdrawal{} }
+func (m *MsgInitiateNftWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateNftWithdrawal) ProtoMessage()    {}
+func (*MsgInitiateNftWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{20}
+}
+func (m *MsgInitiateNftWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateNftWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateNftWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ExecuteMessages defines a rpc handler method for MsgExecuteMessages.
-	ExecuteMessages(context.Context, *MsgExecuteMessages) (*MsgExecuteMessagesResponse, error)
-	// SetBridgeInfo defines a rpc handler method for MsgSetBridgeInfo.
-	SetBridgeInfo(context.Context, *MsgSetBridgeInfo) (*MsgSetBridgeInfoResponse, error)
-	// FinalizeTokenDeposit defines a rpc handler method for MsgFinalizeTokenDeposit.
-	FinalizeTokenDeposit(context.Context, *MsgFinalizeTokenDeposit) (*MsgFinalizeTokenDepositResponse, error)
-	// InitiateTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
-	InitiateTokenWithdrawal(context.Context, *MsgInitiateTokenWithdrawal) (*MsgInitiateTokenWithdrawalResponse, error)
-	// AddValidator defines a rpc handler method for MsgAddValidator.
-	AddValidator(context.Context, *MsgAddValidator) (*MsgAddValidatorResponse, error)
-	// RemoveValidator defines a rpc handler method for MsgRemoveValidator.
-	RemoveValidator(context.Context, *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error)
-	// UpdateParams defines an operation for updating the
-	// x/opchild module parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// SpendFeePool defines an operation that spend fee pool to a recipient.
-	SpendFeePool(context.Context, *MsgSpendFeePool) (*MsgSpendFeePoolResponse, error)
-	// UpdateOracle defines an operation that update oracle prices.
-	UpdateOracle(context.Context, *MsgUpdateOracle) (*MsgUpdateOracleResponse, error)
+func (m *MsgInitiateNftWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateNftWithdrawal.Merge(m, src)
+}
+func (m *MsgInitiateNftWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateNftWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateNftWithdrawal.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgInitiateNftWithdrawal proto.InternalMessageInfo
+
+// MsgInitiateNftWithdrawalResponse returns withdrawal result data
+type MsgInitiateNftWithdrawalResponse struct {
+	// l2 withdrawal sequence number
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (*UnimplementedMsgServer) ExecuteMessages(ctx context.Context, req *MsgExecuteMessages) (*MsgExecuteMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteMessages not implemented")
+func (m *MsgInitiateNftWithdrawalResponse) Reset()         { *m = MsgInitiateNftWithdrawalResponse{} }
+func (m *MsgInitiateNftWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateNftWithdrawalResponse) ProtoMessage()    {}
+func (*MsgInitiateNftWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{21}
 }
-func (*UnimplementedMsgServer) SetBridgeInfo(ctx context.Context, req *MsgSetBridgeInfo) (*MsgSetBridgeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeInfo not implemented")
+func (m *MsgInitiateNftWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) FinalizeTokenDeposit(ctx context.Context, req *MsgFinalizeTokenDeposit) (*MsgFinalizeTokenDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTokenDeposit not implemented")
+func (m *MsgInitiateNftWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateNftWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) InitiateTokenWithdrawal(ctx context.Context, req *MsgInitiateTokenWithdrawal) (*MsgInitiateTokenWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiateTokenWithdrawal not implemented")
+func (m *MsgInitiateNftWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateNftWithdrawalResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddValidator(ctx context.Context, req *MsgAddValidator) (*MsgAddValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddValidator not implemented")
+func (m *MsgInitiateNftWithdrawalResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveValidator(ctx context.Context, req *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveValidator not implemented")
+func (m *MsgInitiateNftWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateNftWithdrawalResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+var xxx_messageInfo_MsgInitiateNftWithdrawalResponse proto.InternalMessageInfo
+
+// MsgInitiateTokenWithdrawalResponse returns create token result data
+type MsgInitiateTokenWithdrawalResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
-func (*UnimplementedMsgServer) SpendFeePool(ctx context.Context, req *MsgSpendFeePool) (*MsgSpendFeePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpendFeePool not implemented")
+
+func (m *MsgInitiateTokenWithdrawalResponse) Reset()         { *m = MsgInitiateTokenWithdrawalResponse{} }
+func (m *MsgInitiateTokenWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateTokenWithdrawalResponse) ProtoMessage()    {}
+func (*MsgInitiateTokenWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{22}
 }
-func (*UnimplementedMsgServer) UpdateOracle(ctx context.Context, req *MsgUpdateOracle) (*MsgUpdateOracleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracle not implemented")
+func (m *MsgInitiateTokenWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgInitiateTokenWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateTokenWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitiateTokenWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateTokenWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgInitiateTokenWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateTokenWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateTokenWithdrawalResponse.DiscardUnknown(m)
 }
 
-func _Msg_ExecuteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteMessages)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteMessages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/ExecuteMessages",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteMessages(ctx, req.(*MsgExecuteMessages))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgInitiateTokenWithdrawalResponse proto.InternalMessageInfo
+
+// MsgAddValidator defines a SDK message for adding a new validator.
+type MsgAddValidator struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority        string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Moniker          string     `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pubkey           *types.Any `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 }
 
-func _Msg_SetBridgeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBridgeInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBridgeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/SetBridgeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBridgeInfo(ctx, req.(*MsgSetBridgeInfo))
+func (m *MsgAddValidator) Reset()         { *m = MsgAddValidator{} }
+func (m *MsgAddValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgAddValidator) ProtoMessage()    {}
+func (*MsgAddValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{23}
+}
+func (m *MsgAddValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddValidator.Merge(m, src)
+}
+func (m *MsgAddValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddValidator.DiscardUnknown(m)
 }
 
-func _Msg_FinalizeTokenDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeTokenDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FinalizeTokenDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/FinalizeTokenDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FinalizeTokenDeposit(ctx, req.(*MsgFinalizeTokenDeposit))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddValidator proto.InternalMessageInfo
+
+// MsgAddValidatorResponse returns add result data
+type MsgAddValidatorResponse struct {
 }
 
-func _Msg_InitiateTokenWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitiateTokenWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitiateTokenWithdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/InitiateTokenWithdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitiateTokenWithdrawal(ctx, req.(*MsgInitiateTokenWithdrawal))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAddValidatorResponse) Reset()         { *m = MsgAddValidatorResponse{} }
+func (m *MsgAddValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddValidatorResponse) ProtoMessage()    {}
+func (*MsgAddValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{24}
 }
-
-func _Msg_AddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/AddValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddValidator(ctx, req.(*MsgAddValidator))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAddValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_RemoveValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/RemoveValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveValidator(ctx, req.(*MsgRemoveValidator))
+func (m *MsgAddValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAddValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddValidatorResponse.Merge(m, src)
 }
-
-func _Msg_SpendFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSpendFeePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SpendFeePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/SpendFeePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SpendFeePool(ctx, req.(*MsgSpendFeePool))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAddValidatorResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_UpdateOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateOracle)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateOracle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.opchild.v1.Msg/UpdateOracle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateOracle(ctx, req.(*MsgUpdateOracle))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAddValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddValidatorResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "opinit.opchild.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExecuteMessages",
-			Handler:    _Msg_ExecuteMessages_Handler,
-		},
-		{
-			MethodName: "SetBridgeInfo",
-			Handler:    _Msg_SetBridgeInfo_Handler,
-		},
-		{
-			MethodName: "FinalizeTokenDeposit",
-			Handler:    _Msg_FinalizeTokenDeposit_Handler,
-		},
-		{
-			MethodName: "InitiateTokenWithdrawal",
-			Handler:    _Msg_InitiateTokenWithdrawal_Handler,
-		},
-		{
-			MethodName: "AddValidator",
-			Handler:    _Msg_AddValidator_Handler,
-		},
-		{
-			MethodName: "RemoveValidator",
-			Handler:    _Msg_RemoveValidator_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SpendFeePool",
-			Handler:    _Msg_SpendFeePool_Handler,
-		},
-		{
-			MethodName: "UpdateOracle",
-			Handler:    _Msg_UpdateOracle_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "opinit/opchild/v1/tx.proto",
-}
+var xxx_messageInfo_MsgAddValidatorResponse proto.InternalMessageInfo
 
-func (m *MsgExecuteMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgAddValidator is a message to remove a validator from designated list
+type MsgRemoveValidator struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// validator is the validator to remove.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgExecuteMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveValidator) Reset()         { *m = MsgRemoveValidator{} }
+func (m *MsgRemoveValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveValidator) ProtoMessage()    {}
+func (*MsgRemoveValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{25}
 }
-
-func (m *MsgExecuteMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRemoveValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgExecuteMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveValidator.Merge(m, src)
 }
-
-func (m *MsgExecuteMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveValidator.DiscardUnknown(m)
 }
 
-func (m *MsgExecuteMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveValidator proto.InternalMessageInfo
+
+// MsgAddValidatorResponse returns remove result data
+type MsgRemoveValidatorResponse struct {
 }
 
-func (m *MsgSetBridgeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveValidatorResponse) Reset()         { *m = MsgRemoveValidatorResponse{} }
+func (m *MsgRemoveValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveValidatorResponse) ProtoMessage()    {}
+func (*MsgRemoveValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{26}
+}
+func (m *MsgRemoveValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveValidatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBridgeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveValidatorResponse proto.InternalMessageInfo
+
+// MsgUnjailValidator is a message to restore the consensus power of a
+// validator jailed for the downtime
+type MsgUnjailValidator struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// validator is the validator to unjail.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgSetBridgeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnjailValidator) Reset()         { *m = MsgUnjailValidator{} }
+func (m *MsgUnjailValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailValidator) ProtoMessage()    {}
+func (*MsgUnjailValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{27}
+}
+func (m *MsgUnjailValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetBridgeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUnjailValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailValidator.Merge(m, src)
 }
-
-func (m *MsgSetBridgeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnjailValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailValidator.DiscardUnknown(m)
 }
 
-func (m *MsgSetBridgeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUnjailValidator proto.InternalMessageInfo
+
+// MsgUnjailValidatorResponse returns unjail result data
+type MsgUnjailValidatorResponse struct {
 }
 
-func (m *MsgFinalizeTokenDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnjailValidatorResponse) Reset()         { *m = MsgUnjailValidatorResponse{} }
+func (m *MsgUnjailValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailValidatorResponse) ProtoMessage()    {}
+func (*MsgUnjailValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{28}
+}
+func (m *MsgUnjailValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnjailValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailValidatorResponse.Merge(m, src)
+}
+func (m *MsgUnjailValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFinalizeTokenDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnjailValidatorResponse proto.InternalMessageInfo
+
+// MsgRotateConsPubKey is a message to replace the consensus pubkey of a
+// validator without removing it from the validator set
+type MsgRotateConsPubKey struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority        string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pubkey           *types.Any `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 }
 
-func (m *MsgFinalizeTokenDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{29}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgFinalizeTokenDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
 }
-
-func (m *MsgFinalizeTokenDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
 }
 
-func (m *MsgFinalizeTokenDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+// MsgRotateConsPubKeyResponse returns rotate result data
+type MsgRotateConsPubKeyResponse struct {
 }
 
-func (m *MsgInitiateTokenWithdrawal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKeyResponse{} }
+func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
+func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{30}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInitiateTokenWithdrawal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
+
+// MsgUpdateValidatorPower is a message to change the consensus power of a
+// validator
+type MsgUpdateValidatorPower struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Power            int64  `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *MsgInitiateTokenWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateValidatorPower) Reset()         { *m = MsgUpdateValidatorPower{} }
+func (m *MsgUpdateValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorPower) ProtoMessage()    {}
+func (*MsgUpdateValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{31}
+}
+func (m *MsgUpdateValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgInitiateTokenWithdrawalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorPower.Merge(m, src)
 }
-
-func (m *MsgInitiateTokenWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateValidatorPower) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgInitiateTokenWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdateValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorPower.DiscardUnknown(m)
 }
 
-func (m *MsgAddValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateValidatorPower proto.InternalMessageInfo
 
-func (m *MsgAddValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateValidatorPowerResponse returns update result data
+type MsgUpdateValidatorPowerResponse struct {
 }
 
-func (m *MsgAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pubkey != nil {
-		{
-			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateValidatorPowerResponse) Reset()         { *m = MsgUpdateValidatorPowerResponse{} }
+func (m *MsgUpdateValidatorPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorPowerResponse) ProtoMessage()    {}
+func (*MsgUpdateValidatorPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{32}
+}
+func (m *MsgUpdateValidatorPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateValidatorPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorPowerResponse.Merge(m, src)
 }
-
-func (m *MsgAddValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateValidatorPowerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateValidatorPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorPowerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateValidatorPowerResponse proto.InternalMessageInfo
 
-func (m *MsgRemoveValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetValidatorSet is a message to replace the validator set with the
+// target set; the validators not in the target set are removed
+type MsgSetValidatorSet struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority  string           `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Validators []ValidatorPower `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
 }
 
-func (m *MsgRemoveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetValidatorSet) Reset()         { *m = MsgSetValidatorSet{} }
+func (m *MsgSetValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorSet) ProtoMessage()    {}
+func (*MsgSetValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{33}
 }
-
-func (m *MsgRemoveValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorSet.Merge(m, src)
 }
-
-func (m *MsgRemoveValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetValidatorSet) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorSet.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetValidatorSet proto.InternalMessageInfo
+
+// ValidatorPower defines a validator of the target validator set.
+type ValidatorPower struct {
+	Moniker          string     `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pubkey           *types.Any `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Power            int64      `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{34}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+// MsgSetValidatorSetResponse returns set result data
+type MsgSetValidatorSetResponse struct {
 }
 
-func (m *MsgSpendFeePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetValidatorSetResponse) Reset()         { *m = MsgSetValidatorSetResponse{} }
+func (m *MsgSetValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorSetResponse) ProtoMessage()    {}
+func (*MsgSetValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{35}
 }
-
-func (m *MsgSpendFeePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSpendFeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSetValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSpendFeePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorSetResponse.Merge(m, src)
 }
-
-func (m *MsgSpendFeePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetValidatorSetResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSpendFeePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorSetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateOracle) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetValidatorSetResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateOracle) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParams is a message to update parameters
+type MsgUpdateParams struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// params are the arbitrary parameters to be updated.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *MsgUpdateOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{36}
 }
-
-func (m *MsgUpdateOracleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateOracleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse returns parameter update result data
+type MsgUpdateParamsResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{37}
 }
-func (m *MsgExecuteMessages) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *MsgExecuteMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
 }
-
-func (m *MsgSetBridgeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.BridgeInfo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBridgeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSpendFeePool is a message to withdraw collected fees from the module
+// account to the recipient address.
+type MsgSpendFeePool struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// recipient is address to receive the coins.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the coin amount to spend.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgFinalizeTokenDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSpendFeePool) Reset()         { *m = MsgSpendFeePool{} }
+func (m *MsgSpendFeePool) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendFeePool) ProtoMessage()    {}
+func (*MsgSpendFeePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{38}
 }
-
-func (m *MsgFinalizeTokenDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSpendFeePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgInitiateTokenWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSpendFeePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendFeePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
 }
-
-func (m *MsgInitiateTokenWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
-	}
-	return n
+func (m *MsgSpendFeePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendFeePool.Merge(m, src)
+}
+func (m *MsgSpendFeePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendFeePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendFeePool.DiscardUnknown(m)
 }
 
-func (m *MsgAddValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgSpendFeePool proto.InternalMessageInfo
+
+// MsgSpendFeePoolResponse returns deposit result data
+type MsgSpendFeePoolResponse struct {
 }
 
-func (m *MsgAddValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSpendFeePoolResponse) Reset()         { *m = MsgSpendFeePoolResponse{} }
+func (m *MsgSpendFeePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendFeePoolResponse) ProtoMessage()    {}
+func (*MsgSpendFeePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{39}
+}
+func (m *MsgSpendFeePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendFeePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendFeePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSpendFeePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendFeePoolResponse.Merge(m, src)
+}
+func (m *MsgSpendFeePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendFeePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendFeePoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgSpendFeePoolResponse proto.InternalMessageInfo
+
+// MsgUpdateOracle is a message to update oracle prices which contains L1 extended commits for oracle.
+type MsgUpdateOracle struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// height is the height of l1 which is including the oracle message
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	/// data is oracle votes bytes.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *MsgRemoveValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateOracle) Reset()         { *m = MsgUpdateOracle{} }
+func (m *MsgUpdateOracle) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracle) ProtoMessage()    {}
+func (*MsgUpdateOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{40}
+}
+func (m *MsgUpdateOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgUpdateOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracle.Merge(m, src)
+}
+func (m *MsgUpdateOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracle.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgUpdateOracle proto.InternalMessageInfo
+
+// MsgUpdateOracleResponse returns oracle update result data
+type MsgUpdateOracleResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateOracleResponse) Reset()         { *m = MsgUpdateOracleResponse{} }
+func (m *MsgUpdateOracleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleResponse) ProtoMessage()    {}
+func (*MsgUpdateOracleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{41}
+}
+func (m *MsgUpdateOracleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
-
-func (m *MsgSpendFeePool) Size() (n int) {
+func (m *MsgUpdateOracleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleResponse.Merge(m, src)
+}
+func (m *MsgUpdateOracleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleResponse proto.InternalMessageInfo
+
+// MsgUpdateDenomMetadata is a message to correct the denom metadata of a bridged token.
+type MsgUpdateDenomMetadata struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// metadata is the new denom metadata of the l2 denom.
+	Metadata types2.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgUpdateDenomMetadata) Reset()         { *m = MsgUpdateDenomMetadata{} }
+func (m *MsgUpdateDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadata) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{42}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadata.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomMetadata proto.InternalMessageInfo
+
+// MsgUpdateDenomMetadataResponse returns update result data
+type MsgUpdateDenomMetadataResponse struct {
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Reset()         { *m = MsgUpdateDenomMetadataResponse{} }
+func (m *MsgUpdateDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{43}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomMetadataResponse proto.InternalMessageInfo
+
+// MsgRegisterExecutorChangePlan is a message to register a plan to change
+// the validator and the bridge executors at a height.
+type MsgRegisterExecutorChangePlan struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// proposal_id is the l1 governance proposal id.
+	ProposalID uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the l2 height to apply the plan.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// next_executors are the bridge executors after the change.
+	NextExecutors []string `protobuf:"bytes,4,rep,name=next_executors,json=nextExecutors,proto3" json:"next_executors,omitempty"`
+	// moniker, validator_address and pubkey define the next validator.
+	Moniker          string     `protobuf:"bytes,5,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pubkey           *types.Any `protobuf:"bytes,7,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// info is the additional information of the plan.
+	Info string `protobuf:"bytes,8,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *MsgRegisterExecutorChangePlan) Reset()         { *m = MsgRegisterExecutorChangePlan{} }
+func (m *MsgRegisterExecutorChangePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterExecutorChangePlan) ProtoMessage()    {}
+func (*MsgRegisterExecutorChangePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{44}
+}
+func (m *MsgRegisterExecutorChangePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterExecutorChangePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterExecutorChangePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterExecutorChangePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterExecutorChangePlan.Merge(m, src)
+}
+func (m *MsgRegisterExecutorChangePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterExecutorChangePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterExecutorChangePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterExecutorChangePlan proto.InternalMessageInfo
+
+// MsgRegisterExecutorChangePlanResponse returns register result data
+type MsgRegisterExecutorChangePlanResponse struct {
+}
+
+func (m *MsgRegisterExecutorChangePlanResponse) Reset()         { *m = MsgRegisterExecutorChangePlanResponse{} }
+func (m *MsgRegisterExecutorChangePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterExecutorChangePlanResponse) ProtoMessage()    {}
+func (*MsgRegisterExecutorChangePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{45}
+}
+func (m *MsgRegisterExecutorChangePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterExecutorChangePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterExecutorChangePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterExecutorChangePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterExecutorChangePlanResponse.Merge(m, src)
+}
+func (m *MsgRegisterExecutorChangePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterExecutorChangePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterExecutorChangePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterExecutorChangePlanResponse proto.InternalMessageInfo
+
+// MsgCancelExecutorChangePlan is a message to cancel a registered executor
+// change plan.
+type MsgCancelExecutorChangePlan struct {
+	// authority is the address that controls the module
+	// (defaults to x/opchild unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// height is the l2 height of the plan.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgCancelExecutorChangePlan) Reset()         { *m = MsgCancelExecutorChangePlan{} }
+func (m *MsgCancelExecutorChangePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelExecutorChangePlan) ProtoMessage()    {}
+func (*MsgCancelExecutorChangePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{46}
+}
+func (m *MsgCancelExecutorChangePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelExecutorChangePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelExecutorChangePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelExecutorChangePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelExecutorChangePlan.Merge(m, src)
+}
+func (m *MsgCancelExecutorChangePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelExecutorChangePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelExecutorChangePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelExecutorChangePlan proto.InternalMessageInfo
+
+// MsgCancelExecutorChangePlanResponse returns cancel result data
+type MsgCancelExecutorChangePlanResponse struct {
+}
+
+func (m *MsgCancelExecutorChangePlanResponse) Reset()         { *m = MsgCancelExecutorChangePlanResponse{} }
+func (m *MsgCancelExecutorChangePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelExecutorChangePlanResponse) ProtoMessage()    {}
+func (*MsgCancelExecutorChangePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee96a503651b6e4, []int{47}
+}
+func (m *MsgCancelExecutorChangePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelExecutorChangePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelExecutorChangePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelExecutorChangePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelExecutorChangePlanResponse.Merge(m, src)
+}
+func (m *MsgCancelExecutorChangePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelExecutorChangePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelExecutorChangePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelExecutorChangePlanResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgExecuteMessages)(nil), "opinit.opchild.v1.MsgExecuteMessages")
+	proto.RegisterType((*MsgExecuteMessagesResponse)(nil), "opinit.opchild.v1.MsgExecuteMessagesResponse")
+	proto.RegisterType((*ExecuteMessageResult)(nil), "opinit.opchild.v1.ExecuteMessageResult")
+	proto.RegisterType((*MsgExecuteScheduledMessages)(nil), "opinit.opchild.v1.MsgExecuteScheduledMessages")
+	proto.RegisterType((*MsgExecuteScheduledMessagesResponse)(nil), "opinit.opchild.v1.MsgExecuteScheduledMessagesResponse")
+	proto.RegisterType((*MsgCancelScheduledMessages)(nil), "opinit.opchild.v1.MsgCancelScheduledMessages")
+	proto.RegisterType((*MsgCancelScheduledMessagesResponse)(nil), "opinit.opchild.v1.MsgCancelScheduledMessagesResponse")
+	proto.RegisterType((*MsgApproveAdminProposal)(nil), "opinit.opchild.v1.MsgApproveAdminProposal")
+	proto.RegisterType((*MsgApproveAdminProposalResponse)(nil), "opinit.opchild.v1.MsgApproveAdminProposalResponse")
+	proto.RegisterType((*MsgProposeAdmin)(nil), "opinit.opchild.v1.MsgProposeAdmin")
+	proto.RegisterType((*MsgProposeAdminResponse)(nil), "opinit.opchild.v1.MsgProposeAdminResponse")
+	proto.RegisterType((*MsgAcceptAdmin)(nil), "opinit.opchild.v1.MsgAcceptAdmin")
+	proto.RegisterType((*MsgAcceptAdminResponse)(nil), "opinit.opchild.v1.MsgAcceptAdminResponse")
+	proto.RegisterType((*MsgSetBridgeInfo)(nil), "opinit.opchild.v1.MsgSetBridgeInfo")
+	proto.RegisterType((*MsgSetBridgeInfoResponse)(nil), "opinit.opchild.v1.MsgSetBridgeInfoResponse")
+	proto.RegisterType((*MsgFinalizeTokenDeposit)(nil), "opinit.opchild.v1.MsgFinalizeTokenDeposit")
+	proto.RegisterType((*MsgFinalizeTokenDepositResponse)(nil), "opinit.opchild.v1.MsgFinalizeTokenDepositResponse")
+	proto.RegisterType((*MsgFinalizeNftDeposit)(nil), "opinit.opchild.v1.MsgFinalizeNftDeposit")
+	proto.RegisterType((*MsgFinalizeNftDepositResponse)(nil), "opinit.opchild.v1.MsgFinalizeNftDepositResponse")
+	proto.RegisterType((*MsgInitiateTokenWithdrawal)(nil), "opinit.opchild.v1.MsgInitiateTokenWithdrawal")
+	proto.RegisterType((*MsgInitiateNftWithdrawal)(nil), "opinit.opchild.v1.MsgInitiateNftWithdrawal")
+	proto.RegisterType((*MsgInitiateNftWithdrawalResponse)(nil), "opinit.opchild.v1.MsgInitiateNftWithdrawalResponse")
+	proto.RegisterType((*MsgInitiateTokenWithdrawalResponse)(nil), "opinit.opchild.v1.MsgInitiateTokenWithdrawalResponse")
+	proto.RegisterType((*MsgAddValidator)(nil), "opinit.opchild.v1.MsgAddValidator")
+	proto.RegisterType((*MsgAddValidatorResponse)(nil), "opinit.opchild.v1.MsgAddValidatorResponse")
+	proto.RegisterType((*MsgRemoveValidator)(nil), "opinit.opchild.v1.MsgRemoveValidator")
+	proto.RegisterType((*MsgRemoveValidatorResponse)(nil), "opinit.opchild.v1.MsgRemoveValidatorResponse")
+	proto.RegisterType((*MsgUnjailValidator)(nil), "opinit.opchild.v1.MsgUnjailValidator")
+	proto.RegisterType((*MsgUnjailValidatorResponse)(nil), "opinit.opchild.v1.MsgUnjailValidatorResponse")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "opinit.opchild.v1.MsgRotateConsPubKey")
+	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "opinit.opchild.v1.MsgRotateConsPubKeyResponse")
+	proto.RegisterType((*MsgUpdateValidatorPower)(nil), "opinit.opchild.v1.MsgUpdateValidatorPower")
+	proto.RegisterType((*MsgUpdateValidatorPowerResponse)(nil), "opinit.opchild.v1.MsgUpdateValidatorPowerResponse")
+	proto.RegisterType((*MsgSetValidatorSet)(nil), "opinit.opchild.v1.MsgSetValidatorSet")
+	proto.RegisterType((*ValidatorPower)(nil), "opinit.opchild.v1.ValidatorPower")
+	proto.RegisterType((*MsgSetValidatorSetResponse)(nil), "opinit.opchild.v1.MsgSetValidatorSetResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "opinit.opchild.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "opinit.opchild.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSpendFeePool)(nil), "opinit.opchild.v1.MsgSpendFeePool")
+	proto.RegisterType((*MsgSpendFeePoolResponse)(nil), "opinit.opchild.v1.MsgSpendFeePoolResponse")
+	proto.RegisterType((*MsgUpdateOracle)(nil), "opinit.opchild.v1.MsgUpdateOracle")
+	proto.RegisterType((*MsgUpdateOracleResponse)(nil), "opinit.opchild.v1.MsgUpdateOracleResponse")
+	proto.RegisterType((*MsgUpdateDenomMetadata)(nil), "opinit.opchild.v1.MsgUpdateDenomMetadata")
+	proto.RegisterType((*MsgUpdateDenomMetadataResponse)(nil), "opinit.opchild.v1.MsgUpdateDenomMetadataResponse")
+	proto.RegisterType((*MsgRegisterExecutorChangePlan)(nil), "opinit.opchild.v1.MsgRegisterExecutorChangePlan")
+	proto.RegisterType((*MsgRegisterExecutorChangePlanResponse)(nil), "opinit.opchild.v1.MsgRegisterExecutorChangePlanResponse")
+	proto.RegisterType((*MsgCancelExecutorChangePlan)(nil), "opinit.opchild.v1.MsgCancelExecutorChangePlan")
+	proto.RegisterType((*MsgCancelExecutorChangePlanResponse)(nil), "opinit.opchild.v1.MsgCancelExecutorChangePlanResponse")
+}
+
+func init() { proto.RegisterFile("opinit/opchild/v1/tx.proto", fileDescriptor_1ee96a503651b6e4) }
+
+var fileDescriptor_1ee96a503651b6e4 = []byte{
+	// 2367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0xd4, 0x07, 0x1f, 0x25, 0xd9, 0xde, 0x28, 0x16, 0xb5, 0xae, 0x44, 0x79, 0x0d,
+	0xc7, 0x32, 0x13, 0x91, 0x96, 0x0c, 0x1b, 0x2d, 0x0f, 0x4d, 0x24, 0x7f, 0xa0, 0x6a, 0x22, 0x47,
+	0x58, 0xd5, 0x2d, 0xd0, 0x02, 0x25, 0x96, 0xdc, 0xd1, 0x6a, 0x2d, 0x72, 0x87, 0xdd, 0x59, 0xca,
+	0x66, 0x2f, 0x0d, 0xd2, 0x4b, 0xd0, 0x53, 0x51, 0xf4, 0x1a, 0xc0, 0x40, 0x7b, 0x08, 0x72, 0xf2,
+	0xa1, 0x97, 0xb4, 0xfd, 0x03, 0x8c, 0x5e, 0x1a, 0xf4, 0x50, 0xb4, 0x87, 0xba, 0xad, 0x8d, 0xc2,
+	0x3d, 0x07, 0x45, 0x2f, 0xbd, 0x14, 0x3b, 0x33, 0x1c, 0xce, 0x2e, 0x77, 0x49, 0xca, 0x92, 0xf3,
+	0x71, 0xb1, 0x39, 0xef, 0xfd, 0xe6, 0xcd, 0xfb, 0x9a, 0xd9, 0x79, 0x6f, 0x04, 0x1a, 0x6e, 0x39,
+	0xae, 0xe3, 0x97, 0x71, 0xab, 0xbe, 0xef, 0x34, 0xac, 0xf2, 0xe1, 0x5a, 0xd9, 0x7f, 0x50, 0x6a,
+	0x79, 0xd8, 0xc7, 0xea, 0x19, 0xc6, 0x2b, 0x71, 0x5e, 0xe9, 0x70, 0x4d, 0x3b, 0x63, 0x36, 0x1d,
+	0x17, 0x97, 0xe9, 0xbf, 0x0c, 0xa5, 0x2d, 0xd5, 0x31, 0x69, 0x62, 0x52, 0xae, 0x99, 0xee, 0x41,
+	0xf9, 0x70, 0xad, 0x86, 0x7c, 0x73, 0x8d, 0x0e, 0xfa, 0xf8, 0x04, 0x09, 0x7e, 0x1d, 0x3b, 0x2e,
+	0xe7, 0xcf, 0x73, 0x7e, 0x93, 0xd8, 0xc1, 0xea, 0x4d, 0x62, 0x73, 0xc6, 0x02, 0x63, 0x54, 0xe9,
+	0xa8, 0xcc, 0x06, 0x9c, 0x35, 0x67, 0x63, 0x1b, 0x33, 0x7a, 0xf0, 0xab, 0x3b, 0xc1, 0xc6, 0xd8,
+	0x6e, 0xa0, 0x32, 0x1d, 0xd5, 0xda, 0x7b, 0x65, 0xd3, 0xed, 0x70, 0xd6, 0x62, 0x8c, 0x99, 0x9d,
+	0x16, 0xe2, 0xf2, 0xf4, 0x3f, 0x2a, 0xa0, 0x6e, 0x13, 0xfb, 0xd6, 0x03, 0x54, 0x6f, 0xfb, 0x68,
+	0x1b, 0x11, 0x62, 0xda, 0x88, 0xa8, 0x57, 0x60, 0x82, 0x20, 0xd7, 0x42, 0x5e, 0x5e, 0x59, 0x56,
+	0x56, 0xb2, 0x9b, 0xf9, 0x3f, 0xfd, 0x66, 0x75, 0x8e, 0x2b, 0xb2, 0x61, 0x59, 0x1e, 0x22, 0x64,
+	0xd7, 0xf7, 0x1c, 0xd7, 0x36, 0x38, 0x4e, 0xbd, 0x02, 0x53, 0x4d, 0x3e, 0x3b, 0x9f, 0x5a, 0x4e,
+	0xaf, 0xe4, 0xd6, 0xe7, 0x4a, 0x4c, 0xab, 0x52, 0x57, 0xab, 0xd2, 0x86, 0xdb, 0x31, 0x04, 0x4a,
+	0xbd, 0x0c, 0xa7, 0x11, 0x5d, 0xd6, 0xc1, 0x6e, 0x75, 0x1f, 0x39, 0xf6, 0xbe, 0x9f, 0x4f, 0x2f,
+	0x2b, 0x2b, 0x19, 0xe3, 0x94, 0xa0, 0x7f, 0x8b, 0x92, 0x2b, 0xc5, 0xf7, 0x9f, 0x3f, 0x2a, 0xf2,
+	0x95, 0x7e, 0xf6, 0xfc, 0x51, 0x51, 0xeb, 0x5a, 0xd3, 0xaf, 0xba, 0xfe, 0x89, 0x02, 0x5a, 0x3f,
+	0xd9, 0x40, 0xa4, 0x85, 0x5d, 0x82, 0xd4, 0xf3, 0x30, 0x4d, 0xea, 0xfb, 0xc8, 0x6a, 0x37, 0x90,
+	0x55, 0x75, 0x2c, 0x6a, 0x5f, 0xc6, 0xc8, 0x09, 0xda, 0x96, 0xa5, 0xbe, 0x03, 0x93, 0x1e, 0x22,
+	0xed, 0x86, 0xdf, 0xb5, 0xe4, 0x52, 0xa9, 0x2f, 0x1f, 0x4a, 0x61, 0xf9, 0x06, 0xc5, 0x6f, 0x66,
+	0x1f, 0x3f, 0x29, 0x8c, 0x7d, 0xf4, 0xfc, 0x51, 0x51, 0x31, 0xba, 0x22, 0xd4, 0x22, 0x9c, 0x31,
+	0xad, 0xa6, 0xe3, 0x06, 0xd1, 0x6c, 0x61, 0x62, 0x36, 0x82, 0x55, 0xb9, 0x9d, 0x94, 0xb1, 0xc3,
+	0xe9, 0x5b, 0x96, 0xfe, 0x9e, 0x02, 0x73, 0x71, 0x82, 0xd5, 0x05, 0x98, 0x0a, 0xa2, 0x56, 0x6d,
+	0x7b, 0x0d, 0x16, 0x11, 0x63, 0x32, 0x18, 0xdf, 0xf5, 0x1a, 0xaa, 0x0a, 0x19, 0xcb, 0xf4, 0xcd,
+	0x7c, 0x6a, 0x59, 0x59, 0x99, 0x36, 0xe8, 0x6f, 0xf5, 0x1b, 0x30, 0xd3, 0x24, 0x76, 0xd5, 0xe3,
+	0x46, 0x93, 0x7c, 0x7a, 0x40, 0x44, 0xa6, 0x9b, 0xc4, 0xee, 0xba, 0x87, 0xe8, 0xbf, 0x54, 0xe0,
+	0x5c, 0xcf, 0x7d, 0xbb, 0x5d, 0xb7, 0x1c, 0x23, 0x33, 0x66, 0x21, 0xe5, 0x58, 0x54, 0xbd, 0x8c,
+	0x91, 0x72, 0xac, 0xca, 0xd5, 0x48, 0x30, 0x2f, 0xf4, 0x07, 0xb3, 0x6f, 0x59, 0x9d, 0xc0, 0x85,
+	0x01, 0x6c, 0x11, 0x5d, 0x29, 0x74, 0xca, 0xb1, 0x43, 0xa7, 0xff, 0x82, 0xa5, 0xd2, 0x0d, 0xd3,
+	0xad, 0xa3, 0xc6, 0xcb, 0x70, 0xc5, 0x7a, 0xc4, 0x15, 0xba, 0xe4, 0x8a, 0x84, 0x55, 0xf5, 0x1d,
+	0xd0, 0x93, 0xb9, 0xc2, 0x11, 0xb1, 0x59, 0xa7, 0xc4, 0x67, 0xdd, 0x87, 0x0a, 0xcc, 0x6f, 0x13,
+	0x7b, 0xa3, 0xd5, 0xf2, 0xf0, 0x21, 0xda, 0x90, 0xb9, 0x2f, 0x60, 0x63, 0x01, 0x72, 0xf2, 0x9a,
+	0xcc, 0x58, 0x68, 0x89, 0xe5, 0x2a, 0xe5, 0x88, 0xd1, 0x05, 0xc9, 0xe8, 0x38, 0x1d, 0xf4, 0x8f,
+	0x15, 0x28, 0x24, 0xf0, 0x84, 0xbd, 0x67, 0x61, 0xa2, 0x65, 0x12, 0x82, 0x98, 0x91, 0x53, 0x06,
+	0x1f, 0xf5, 0x6d, 0xf7, 0xd4, 0xc0, 0xed, 0x9e, 0x3e, 0x7e, 0xce, 0xfc, 0x4a, 0x81, 0x53, 0xdb,
+	0xc4, 0x66, 0x0a, 0x32, 0x65, 0x5f, 0xc0, 0x89, 0xd7, 0x20, 0xeb, 0xa2, 0xfb, 0x55, 0x1a, 0xa9,
+	0x7c, 0x6a, 0xc8, 0xa4, 0x29, 0x17, 0xdd, 0xa7, 0x0b, 0x55, 0x2e, 0x45, 0x5c, 0x3b, 0x2f, 0xb9,
+	0x56, 0xd6, 0x48, 0xbf, 0x05, 0xf3, 0x11, 0xd2, 0x0b, 0x65, 0xce, 0x3d, 0x98, 0x0d, 0x02, 0x53,
+	0xaf, 0xa3, 0x96, 0xff, 0x82, 0xa6, 0x56, 0x5e, 0x8b, 0xe8, 0x7c, 0x56, 0x4e, 0x87, 0x9e, 0x64,
+	0x3d, 0x0f, 0x67, 0xc3, 0x94, 0xae, 0xc6, 0xfa, 0x4f, 0x53, 0x70, 0x7a, 0x9b, 0xd8, 0xbb, 0xc8,
+	0xdf, 0xf4, 0x1c, 0xcb, 0x46, 0x5b, 0xee, 0x1e, 0x56, 0x37, 0x22, 0x8a, 0x5c, 0xfe, 0xec, 0x49,
+	0x61, 0xa6, 0x63, 0x36, 0x1b, 0x15, 0x9d, 0xd1, 0xf5, 0xa1, 0x41, 0xd8, 0x82, 0x5c, 0x8d, 0x0a,
+	0xac, 0x3a, 0xee, 0x1e, 0xa6, 0x61, 0xc8, 0xad, 0x2f, 0xc6, 0x24, 0x47, 0x6f, 0x59, 0x39, 0x25,
+	0xa0, 0xd6, 0xd3, 0xe6, 0x3c, 0x4c, 0xb7, 0x3c, 0x8c, 0xf7, 0xc2, 0xdf, 0xb9, 0x1c, 0xa5, 0xb1,
+	0x6f, 0x9c, 0x3a, 0x07, 0xe3, 0x74, 0x98, 0xcf, 0xd0, 0x83, 0x9c, 0x0d, 0x2a, 0x2b, 0x11, 0xef,
+	0xe4, 0x25, 0xef, 0x84, 0x0c, 0xd6, 0x35, 0xc8, 0x47, 0x69, 0xc2, 0x43, 0x0f, 0x33, 0x34, 0xde,
+	0xb7, 0x1d, 0xd7, 0x6c, 0x38, 0x3f, 0x46, 0xdf, 0xc1, 0x07, 0xc8, 0xbd, 0x89, 0x5a, 0x98, 0x38,
+	0xfe, 0x49, 0x38, 0xea, 0x0d, 0xc8, 0xec, 0x79, 0xb8, 0x39, 0x34, 0x51, 0x29, 0x4a, 0x5d, 0x81,
+	0x94, 0x8f, 0xf3, 0xe9, 0x21, 0xd8, 0x94, 0x8f, 0xd5, 0x6d, 0x98, 0x30, 0x9b, 0xb8, 0xed, 0xfa,
+	0xd4, 0x27, 0xb9, 0xf5, 0x85, 0x12, 0x87, 0x06, 0x37, 0xaa, 0x12, 0xbf, 0x51, 0x95, 0x6e, 0x60,
+	0xc7, 0xdd, 0xd4, 0x02, 0xbf, 0xf7, 0x34, 0x67, 0xd3, 0x74, 0x16, 0x08, 0x2e, 0x44, 0xd5, 0x60,
+	0x8a, 0xa0, 0x1f, 0xb5, 0x91, 0x5b, 0x47, 0xf9, 0x71, 0x1a, 0x00, 0x31, 0x0e, 0xce, 0x0f, 0x1e,
+	0x9a, 0x09, 0xca, 0xe1, 0x23, 0x75, 0x11, 0x20, 0x58, 0xac, 0x6a, 0x21, 0x17, 0x37, 0xf3, 0x93,
+	0xf4, 0xd3, 0x9b, 0x0d, 0x28, 0x37, 0x03, 0x82, 0xba, 0xc8, 0x3f, 0xbe, 0x53, 0x41, 0xcc, 0x68,
+	0xf0, 0x15, 0xb6, 0x26, 0x25, 0xab, 0x6f, 0x06, 0x97, 0x22, 0xdf, 0xa4, 0x90, 0x2c, 0x4f, 0x1f,
+	0x61, 0x82, 0x7b, 0x20, 0x4c, 0xd8, 0xe6, 0xa0, 0xcd, 0x4c, 0x20, 0xc1, 0x10, 0x93, 0xfa, 0xf2,
+	0x06, 0x06, 0xe4, 0x4d, 0x4e, 0xce, 0x9b, 0x41, 0x87, 0x6c, 0x5c, 0x1a, 0xe8, 0xe7, 0xa1, 0x90,
+	0xc0, 0x12, 0x59, 0xf4, 0xeb, 0x0c, 0xbc, 0x2a, 0x61, 0xee, 0xec, 0xf9, 0x5f, 0x81, 0x1c, 0x5a,
+	0x80, 0xa9, 0x7a, 0xc3, 0x24, 0x24, 0x38, 0xc5, 0x32, 0xec, 0xe6, 0x44, 0xc7, 0x5b, 0x56, 0xc0,
+	0xf2, 0x03, 0x3b, 0x03, 0xd6, 0x38, 0x63, 0xd1, 0xf1, 0x96, 0x15, 0x4a, 0x95, 0x89, 0xc4, 0x54,
+	0x99, 0x0c, 0xa5, 0x8a, 0x0e, 0x33, 0x34, 0x55, 0xc4, 0x72, 0x53, 0x54, 0x66, 0x2e, 0x20, 0xde,
+	0xe0, 0x4b, 0x2e, 0x02, 0x30, 0xb6, 0x6b, 0x36, 0x11, 0x4d, 0x89, 0xac, 0x91, 0xa5, 0x94, 0x3b,
+	0x66, 0x93, 0x5e, 0x4e, 0x19, 0x9b, 0x74, 0x9a, 0x35, 0xdc, 0xa0, 0xe1, 0xce, 0x1a, 0x39, 0x4a,
+	0xdb, 0xa5, 0x24, 0xf5, 0x1c, 0x30, 0x7c, 0xb5, 0xed, 0x39, 0x34, 0xe4, 0x59, 0x83, 0x19, 0x78,
+	0xd7, 0x73, 0x02, 0x26, 0xb3, 0x28, 0x60, 0x4e, 0x33, 0x26, 0x25, 0x04, 0xcc, 0x68, 0x2e, 0xcd,
+	0x0c, 0xc8, 0xa5, 0x59, 0x39, 0x97, 0x56, 0x23, 0xb9, 0xb4, 0x18, 0x93, 0x4b, 0xbd, 0x64, 0xd0,
+	0x0b, 0xb0, 0x18, 0xcb, 0x10, 0x79, 0xf4, 0x3f, 0x76, 0xad, 0xda, 0x72, 0x1d, 0xdf, 0x31, 0x7d,
+	0x96, 0x6b, 0xdf, 0x73, 0xfc, 0x7d, 0xcb, 0x33, 0xef, 0xbf, 0xd0, 0x95, 0x83, 0x65, 0x43, 0xea,
+	0x73, 0x3f, 0x51, 0x06, 0xde, 0xdf, 0x12, 0xcc, 0xd3, 0x9f, 0x28, 0x90, 0x97, 0xd8, 0x77, 0xf6,
+	0xfc, 0xcf, 0xc9, 0x76, 0x79, 0x27, 0xa4, 0x93, 0x77, 0x42, 0x26, 0xb4, 0x13, 0x2a, 0x57, 0x22,
+	0x26, 0x2e, 0xc7, 0x98, 0x18, 0xb2, 0x41, 0xff, 0x26, 0x2c, 0x27, 0xf1, 0xc4, 0x25, 0x43, 0xde,
+	0x5f, 0x4a, 0x78, 0x7f, 0xe9, 0x6f, 0x81, 0x2e, 0xcd, 0x8f, 0xb8, 0x6f, 0x24, 0x09, 0xbf, 0x4d,
+	0xd1, 0x3b, 0xd8, 0x86, 0x65, 0x7d, 0xd7, 0x6c, 0x38, 0x96, 0xe9, 0x63, 0x4f, 0xfd, 0x36, 0x64,
+	0xcd, 0xb6, 0xbf, 0x8f, 0x3d, 0xc7, 0xef, 0x70, 0xe7, 0xbe, 0xf1, 0xd9, 0x93, 0xc2, 0x69, 0x1e,
+	0xdd, 0x2e, 0x2b, 0xf9, 0xa0, 0xea, 0x4d, 0x57, 0xf3, 0x30, 0xd9, 0xc4, 0xae, 0x73, 0x80, 0x3c,
+	0xe6, 0x78, 0xa3, 0x3b, 0x54, 0x6f, 0xc1, 0x99, 0xc3, 0xee, 0x92, 0x55, 0x93, 0xcd, 0x1f, 0x7a,
+	0x4c, 0x9d, 0x16, 0x53, 0x38, 0x5d, 0xbd, 0x0d, 0x13, 0xad, 0x76, 0xed, 0x00, 0x75, 0x78, 0x9a,
+	0xc6, 0x16, 0x6e, 0x9b, 0xf9, 0x3f, 0xf4, 0x24, 0xd6, 0xbd, 0x4e, 0xcb, 0xc7, 0xa5, 0x9d, 0x76,
+	0xed, 0x6d, 0xd4, 0x31, 0xf8, 0xec, 0xca, 0xfa, 0x07, 0x0f, 0x0b, 0x63, 0xff, 0x7e, 0x58, 0x18,
+	0x0b, 0x82, 0xd8, 0x33, 0x20, 0x7a, 0x35, 0x94, 0x1d, 0xa5, 0x2f, 0xc0, 0x7c, 0x84, 0x24, 0x36,
+	0xee, 0x9f, 0x59, 0xb3, 0xc0, 0x40, 0x4d, 0x7c, 0x88, 0x5e, 0x8e, 0x6b, 0x63, 0x1d, 0x98, 0x3a,
+	0xaa, 0x03, 0xd9, 0x91, 0x15, 0x36, 0x58, 0xee, 0x19, 0x44, 0x2c, 0xd0, 0xbf, 0x06, 0x5a, 0x3f,
+	0x35, 0x6a, 0xf6, 0x5d, 0xf7, 0x9e, 0xe9, 0x34, 0xbe, 0xca, 0x66, 0x47, 0x2c, 0xe0, 0x66, 0x47,
+	0xa8, 0xc2, 0xec, 0x8f, 0x52, 0xf0, 0x4a, 0xe0, 0x15, 0xec, 0x9b, 0x3e, 0xba, 0x81, 0x5d, 0xc2,
+	0x92, 0xeb, 0x4b, 0x68, 0xb7, 0xb4, 0x5f, 0xd2, 0xc7, 0xda, 0x2f, 0xd7, 0x93, 0xf7, 0xcb, 0x39,
+	0x39, 0x7d, 0x22, 0x2e, 0xd1, 0x17, 0xe1, 0x5c, 0x0c, 0x59, 0x78, 0xf2, 0xbf, 0xac, 0xc0, 0xbe,
+	0xdb, 0xb2, 0x4c, 0xbf, 0x97, 0x5f, 0x3b, 0xf8, 0x3e, 0xfa, 0x32, 0x66, 0x11, 0xbd, 0x05, 0x04,
+	0xba, 0x51, 0x67, 0xa6, 0x0d, 0x36, 0x60, 0xdf, 0xba, 0xb0, 0x4f, 0xe4, 0x4b, 0x65, 0x9c, 0x71,
+	0xfc, 0x52, 0x19, 0xc7, 0x12, 0xbe, 0xf9, 0x17, 0xdb, 0x5c, 0xbb, 0xc8, 0x17, 0x80, 0x5d, 0xe4,
+	0x9f, 0xa8, 0x5b, 0xde, 0x01, 0x10, 0x36, 0x76, 0x5b, 0x7a, 0xe7, 0x63, 0xca, 0xb8, 0xb0, 0x86,
+	0xa1, 0x52, 0xae, 0x37, 0xbf, 0x72, 0x2d, 0x39, 0x47, 0xb4, 0x70, 0x71, 0x26, 0x1b, 0xa4, 0xff,
+	0x55, 0x81, 0xd9, 0x48, 0xe8, 0xa5, 0xcf, 0x88, 0x32, 0xc2, 0x67, 0xe4, 0x0b, 0xdb, 0x16, 0xbd,
+	0x84, 0xc8, 0xc8, 0x09, 0x31, 0xd5, 0x75, 0x04, 0x3f, 0x47, 0x22, 0x16, 0x8b, 0x08, 0xff, 0x9e,
+	0x75, 0x44, 0x58, 0x16, 0xec, 0x98, 0x9e, 0xd9, 0x24, 0x27, 0x1a, 0xde, 0xb5, 0xa0, 0xf5, 0x13,
+	0x48, 0xe5, 0x15, 0xfa, 0x42, 0x4c, 0x68, 0xd9, 0xb2, 0x06, 0x07, 0xb2, 0x7e, 0x72, 0xf2, 0xf7,
+	0x50, 0x56, 0x95, 0x7f, 0x0f, 0x65, 0x52, 0xcf, 0x32, 0x76, 0xcf, 0xd8, 0x6d, 0x21, 0xd7, 0xba,
+	0x8d, 0xd0, 0x0e, 0xc6, 0x8d, 0x13, 0xb5, 0xec, 0x3a, 0x64, 0x3d, 0x54, 0x77, 0x5a, 0x0e, 0x72,
+	0xfd, 0xa1, 0xe1, 0xef, 0x41, 0xd5, 0x8e, 0xb8, 0xe5, 0xb2, 0x86, 0xd6, 0x80, 0x5b, 0xee, 0xed,
+	0xd8, 0x5b, 0xee, 0xc7, 0x7f, 0x2f, 0xac, 0xd8, 0x8e, 0xbf, 0xdf, 0xae, 0x95, 0xea, 0xb8, 0xc9,
+	0x5f, 0x20, 0xf8, 0x7f, 0xab, 0xc4, 0x3a, 0xe0, 0x4f, 0x08, 0x81, 0x04, 0x12, 0xbe, 0x11, 0x0f,
+	0xf1, 0xac, 0xec, 0x2a, 0xee, 0x59, 0x99, 0x24, 0x3c, 0xfb, 0x3b, 0x39, 0x67, 0xde, 0xf5, 0xcc,
+	0x7a, 0x03, 0x9d, 0x44, 0x91, 0xb9, 0x28, 0x4a, 0x37, 0xda, 0x07, 0xdc, 0x1c, 0xe7, 0xca, 0x8b,
+	0x62, 0x9f, 0x55, 0xf3, 0xe9, 0xd8, 0x6a, 0x7e, 0x60, 0x77, 0x4d, 0xd6, 0x34, 0x94, 0x32, 0x8c,
+	0x24, 0x0c, 0xfb, 0x9b, 0x02, 0x67, 0x05, 0x8f, 0xf6, 0x10, 0xba, 0xb5, 0xff, 0x89, 0x66, 0xce,
+	0x4d, 0xa9, 0xf1, 0x90, 0x1a, 0xa5, 0xf1, 0x20, 0x1d, 0x76, 0x62, 0x66, 0x65, 0xad, 0x3f, 0x98,
+	0x4b, 0x7d, 0x36, 0x87, 0x8c, 0xd0, 0x97, 0x61, 0x29, 0x9e, 0x23, 0x3c, 0xf0, 0x9f, 0x34, 0xad,
+	0x0f, 0x0d, 0x64, 0x3b, 0xc4, 0x47, 0x1e, 0x6b, 0xac, 0x62, 0xef, 0xc6, 0xbe, 0xe9, 0xda, 0x68,
+	0xa7, 0x61, 0xba, 0x27, 0xea, 0x88, 0x72, 0x4c, 0x37, 0x7a, 0x73, 0xf6, 0xe9, 0x93, 0x02, 0x88,
+	0x36, 0xe6, 0x4d, 0xb9, 0x3b, 0x2d, 0x55, 0xf7, 0xe9, 0x50, 0x75, 0xff, 0x26, 0xcc, 0xba, 0xe8,
+	0x81, 0x5f, 0x45, 0x5c, 0x5f, 0x92, 0xcf, 0x2c, 0xa7, 0x07, 0x6e, 0xc8, 0x99, 0x00, 0xdf, 0x35,
+	0x8f, 0xc8, 0xa7, 0xfd, 0xf8, 0x08, 0xa7, 0xfd, 0xc4, 0x31, 0x4e, 0xfb, 0xc9, 0x63, 0x9d, 0xf6,
+	0x2a, 0x64, 0x68, 0xbf, 0x93, 0xb5, 0x2f, 0xe8, 0xef, 0xca, 0x5b, 0xc9, 0x1f, 0xbd, 0x8b, 0xa1,
+	0x7b, 0x75, 0x52, 0x50, 0xf5, 0x4b, 0x70, 0x71, 0x20, 0x40, 0xe4, 0xc7, 0x27, 0xec, 0x01, 0x8a,
+	0x3d, 0x70, 0xbc, 0xe4, 0xec, 0x38, 0x1b, 0x3e, 0x0f, 0xba, 0xc1, 0xae, 0x5c, 0xef, 0x37, 0xf3,
+	0x42, 0xdf, 0xd3, 0x4c, 0x8c, 0x91, 0x17, 0xe1, 0xc2, 0x00, 0x76, 0xd7, 0xc4, 0xf5, 0x0f, 0xe7,
+	0x20, 0xbd, 0x4d, 0x6c, 0xd5, 0x86, 0x53, 0xd1, 0x87, 0xd7, 0x8b, 0x31, 0x1f, 0xaf, 0xfe, 0xd7,
+	0x4c, 0x6d, 0x75, 0x24, 0x98, 0x28, 0x96, 0xdf, 0x57, 0x20, 0x9f, 0xf8, 0xa2, 0x57, 0x1a, 0x28,
+	0xab, 0x0f, 0xaf, 0x5d, 0x3f, 0x1a, 0x5e, 0x28, 0xf1, 0x13, 0x98, 0x4f, 0x7a, 0x49, 0x4b, 0x30,
+	0x27, 0x01, 0xae, 0x5d, 0x3b, 0x12, 0x5c, 0x28, 0x70, 0x08, 0x73, 0xb1, 0x6f, 0x5c, 0xc5, 0x78,
+	0x71, 0x71, 0x58, 0x6d, 0x7d, 0x74, 0xac, 0x58, 0xf7, 0x87, 0x30, 0x1d, 0x7a, 0x0e, 0xd2, 0xe3,
+	0x65, 0xc8, 0x18, 0xad, 0x38, 0x1c, 0x23, 0xe4, 0xff, 0x00, 0x72, 0xf2, 0x13, 0xcc, 0xf9, 0x04,
+	0x15, 0x7b, 0x10, 0xed, 0xf2, 0x50, 0x88, 0x10, 0x6e, 0xc2, 0x4c, 0xf8, 0x61, 0xe5, 0x42, 0xfc,
+	0xdc, 0x10, 0x48, 0x7b, 0x7d, 0x04, 0x90, 0x1c, 0x97, 0xd8, 0x97, 0x89, 0x04, 0x1f, 0xc4, 0x61,
+	0xb5, 0xf5, 0xd1, 0xb1, 0x62, 0xdd, 0x16, 0xa8, 0x31, 0xbd, 0xec, 0x95, 0xc1, 0x92, 0x7a, 0x48,
+	0xed, 0xca, 0xa8, 0x48, 0x79, 0x0b, 0x24, 0x75, 0x3d, 0x13, 0xb6, 0x40, 0x02, 0x5c, 0xbb, 0x76,
+	0x24, 0xb8, 0x50, 0xa0, 0x03, 0xaf, 0xc6, 0x37, 0x1e, 0x5f, 0x1f, 0x2c, 0x2f, 0x04, 0xd6, 0xae,
+	0x1e, 0x01, 0x2c, 0xef, 0x82, 0x50, 0x43, 0x2e, 0x61, 0x17, 0xc8, 0x18, 0xad, 0x38, 0x1c, 0x23,
+	0xe4, 0xdb, 0x70, 0x2a, 0xda, 0x98, 0x4a, 0x38, 0x4c, 0x23, 0x30, 0x6d, 0x75, 0x24, 0x98, 0xbc,
+	0x50, 0xb4, 0x15, 0x94, 0xb0, 0x50, 0x04, 0xa6, 0xad, 0x8e, 0x04, 0x13, 0x0b, 0xdd, 0x83, 0xd3,
+	0x7d, 0xcd, 0x97, 0xd7, 0x12, 0x74, 0x8d, 0xe0, 0xb4, 0xd2, 0x68, 0x38, 0x79, 0x0f, 0xc6, 0xb6,
+	0x27, 0x12, 0x22, 0x10, 0x87, 0xd5, 0xd6, 0x47, 0xc7, 0xca, 0xce, 0x8c, 0x96, 0xfe, 0x17, 0x13,
+	0xcf, 0x0e, 0x19, 0xa6, 0xad, 0x8e, 0x04, 0x93, 0xd3, 0x2f, 0x54, 0x81, 0xea, 0x83, 0x94, 0x65,
+	0x18, 0xad, 0x38, 0x1c, 0x23, 0xcb, 0x0f, 0xd5, 0x81, 0x09, 0xf2, 0x65, 0x8c, 0x56, 0x1c, 0x8e,
+	0xe9, 0xd7, 0x9f, 0x57, 0x43, 0x03, 0xf5, 0x67, 0x18, 0xad, 0x38, 0x1c, 0x23, 0xe4, 0x13, 0x78,
+	0x25, 0xae, 0x28, 0xb9, 0x3c, 0x48, 0x44, 0x08, 0xaa, 0xad, 0x8d, 0x0c, 0x15, 0x8b, 0x7e, 0xa0,
+	0x80, 0x36, 0xa0, 0x10, 0xb8, 0x92, 0xb4, 0x31, 0x93, 0x66, 0x68, 0x5f, 0x3f, 0xea, 0x8c, 0xd0,
+	0x15, 0x29, 0xf1, 0xce, 0x59, 0x1a, 0x74, 0xe1, 0x88, 0x51, 0xe3, 0xfa, 0xd1, 0xf0, 0x5d, 0x25,
+	0xb4, 0xf1, 0xf7, 0x82, 0x0a, 0x6c, 0xf3, 0xed, 0xc7, 0xff, 0x5c, 0x1a, 0x7b, 0xfc, 0x74, 0x49,
+	0xf9, 0xf4, 0xe9, 0x92, 0xf2, 0x8f, 0xa7, 0x4b, 0xca, 0xcf, 0x9f, 0x2d, 0x8d, 0x7d, 0xfa, 0x6c,
+	0x69, 0xec, 0x2f, 0xcf, 0x96, 0xc6, 0xbe, 0xbf, 0x2a, 0x55, 0xe6, 0x0e, 0x3d, 0x72, 0x57, 0x1b,
+	0x66, 0x8d, 0x94, 0xdf, 0xdd, 0x09, 0x46, 0xe5, 0x07, 0xe2, 0x4f, 0xfd, 0x68, 0x91, 0x5e, 0x9b,
+	0xa0, 0xd7, 0xff, 0xab, 0xff, 0x1f, 0x00, 0x93, 0x7e, 0xa8, 0x17, 0xf0, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ExecuteMessages defines a rpc handler method for MsgExecuteMessages.
+	ExecuteMessages(ctx context.Context, in *MsgExecuteMessages, opts ...grpc.CallOption) (*MsgExecuteMessagesResponse, error)
+	// ExecuteScheduledMessages defines a rpc handler method for MsgExecuteScheduledMessages.
+	ExecuteScheduledMessages(ctx context.Context, in *MsgExecuteScheduledMessages, opts ...grpc.CallOption) (*MsgExecuteScheduledMessagesResponse, error)
+	// CancelScheduledMessages defines a rpc handler method for MsgCancelScheduledMessages.
+	CancelScheduledMessages(ctx context.Context, in *MsgCancelScheduledMessages, opts ...grpc.CallOption) (*MsgCancelScheduledMessagesResponse, error)
+	// ApproveAdminProposal defines a rpc handler method for MsgApproveAdminProposal.
+	ApproveAdminProposal(ctx context.Context, in *MsgApproveAdminProposal, opts ...grpc.CallOption) (*MsgApproveAdminProposalResponse, error)
+	// ProposeAdmin defines a rpc handler method for MsgProposeAdmin.
+	ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a rpc handler method for MsgAcceptAdmin.
+	AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error)
+	// SetBridgeInfo defines a rpc handler method for MsgSetBridgeInfo.
+	SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfo, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error)
+	// FinalizeTokenDeposit defines a rpc handler method for MsgFinalizeTokenDeposit.
+	FinalizeTokenDeposit(ctx context.Context, in *MsgFinalizeTokenDeposit, opts ...grpc.CallOption) (*MsgFinalizeTokenDepositResponse, error)
+	// FinalizeNftDeposit defines a rpc handler method for MsgFinalizeNftDeposit.
+	FinalizeNftDeposit(ctx context.Context, in *MsgFinalizeNftDeposit, opts ...grpc.CallOption) (*MsgFinalizeNftDepositResponse, error)
+	// InitiateTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
+	InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiateTokenWithdrawal, opts ...grpc.CallOption) (*MsgInitiateTokenWithdrawalResponse, error)
+	// InitiateNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	InitiateNftWithdrawal(ctx context.Context, in *MsgInitiateNftWithdrawal, opts ...grpc.CallOption) (*MsgInitiateNftWithdrawalResponse, error)
+	// AddValidator defines a rpc handler method for MsgAddValidator.
+	AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error)
+	// RemoveValidator defines a rpc handler method for MsgRemoveValidator.
+	RemoveValidator(ctx context.Context, in *MsgRemoveValidator, opts ...grpc.CallOption) (*MsgRemoveValidatorResponse, error)
+	// UnjailValidator defines a rpc handler method for MsgUnjailValidator.
+	UnjailValidator(ctx context.Context, in *MsgUnjailValidator, opts ...grpc.CallOption) (*MsgUnjailValidatorResponse, error)
+	// RotateConsPubKey defines a rpc handler method for MsgRotateConsPubKey.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// UpdateValidatorPower defines a rpc handler method for MsgUpdateValidatorPower.
+	UpdateValidatorPower(ctx context.Context, in *MsgUpdateValidatorPower, opts ...grpc.CallOption) (*MsgUpdateValidatorPowerResponse, error)
+	// SetValidatorSet defines a rpc handler method for MsgSetValidatorSet.
+	SetValidatorSet(ctx context.Context, in *MsgSetValidatorSet, opts ...grpc.CallOption) (*MsgSetValidatorSetResponse, error)
+	// UpdateParams defines an operation for updating the
+	// x/opchild module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SpendFeePool defines an operation that spend fee pool to a recipient.
+	SpendFeePool(ctx context.Context, in *MsgSpendFeePool, opts ...grpc.CallOption) (*MsgSpendFeePoolResponse, error)
+	// UpdateOracle defines an operation that update oracle prices.
+	UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error)
+	// UpdateDenomMetadata defines an operation that corrects the denom metadata
+	// of a bridged token.
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// RegisterExecutorChangePlan defines an operation that registers a plan
+	// to change the validator and the bridge executors at a height.
+	RegisterExecutorChangePlan(ctx context.Context, in *MsgRegisterExecutorChangePlan, opts ...grpc.CallOption) (*MsgRegisterExecutorChangePlanResponse, error)
+	// CancelExecutorChangePlan defines an operation that cancels a registered
+	// executor change plan.
+	CancelExecutorChangePlan(ctx context.Context, in *MsgCancelExecutorChangePlan, opts ...grpc.CallOption) (*MsgCancelExecutorChangePlanResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ExecuteMessages(ctx context.Context, in *MsgExecuteMessages, opts ...grpc.CallOption) (*MsgExecuteMessagesResponse, error) {
+	out := new(MsgExecuteMessagesResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/ExecuteMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteScheduledMessages(ctx context.Context, in *MsgExecuteScheduledMessages, opts ...grpc.CallOption) (*MsgExecuteScheduledMessagesResponse, error) {
+	out := new(MsgExecuteScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/ExecuteScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMessages(ctx context.Context, in *MsgCancelScheduledMessages, opts ...grpc.CallOption) (*MsgCancelScheduledMessagesResponse, error) {
+	out := new(MsgCancelScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/CancelScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveAdminProposal(ctx context.Context, in *MsgApproveAdminProposal, opts ...grpc.CallOption) (*MsgApproveAdminProposalResponse, error) {
+	out := new(MsgApproveAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/ApproveAdminProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error) {
+	out := new(MsgProposeAdminResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/ProposeAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error) {
+	out := new(MsgAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/AcceptAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBridgeInfo(ctx context.Context, in *MsgSetBridgeInfo, opts ...grpc.CallOption) (*MsgSetBridgeInfoResponse, error) {
+	out := new(MsgSetBridgeInfoResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/SetBridgeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeTokenDeposit(ctx context.Context, in *MsgFinalizeTokenDeposit, opts ...grpc.CallOption) (*MsgFinalizeTokenDepositResponse, error) {
+	out := new(MsgFinalizeTokenDepositResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/FinalizeTokenDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeNftDeposit(ctx context.Context, in *MsgFinalizeNftDeposit, opts ...grpc.CallOption) (*MsgFinalizeNftDepositResponse, error) {
+	out := new(MsgFinalizeNftDepositResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/FinalizeNftDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateTokenWithdrawal(ctx context.Context, in *MsgInitiateTokenWithdrawal, opts ...grpc.CallOption) (*MsgInitiateTokenWithdrawalResponse, error) {
+	out := new(MsgInitiateTokenWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/InitiateTokenWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateNftWithdrawal(ctx context.Context, in *MsgInitiateNftWithdrawal, opts ...grpc.CallOption) (*MsgInitiateNftWithdrawalResponse, error) {
+	out := new(MsgInitiateNftWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/InitiateNftWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddValidator(ctx context.Context, in *MsgAddValidator, opts ...grpc.CallOption) (*MsgAddValidatorResponse, error) {
+	out := new(MsgAddValidatorResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/AddValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveValidator(ctx context.Context, in *MsgRemoveValidator, opts ...grpc.CallOption) (*MsgRemoveValidatorResponse, error) {
+	out := new(MsgRemoveValidatorResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/RemoveValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailValidator(ctx context.Context, in *MsgUnjailValidator, opts ...grpc.CallOption) (*MsgUnjailValidatorResponse, error) {
+	out := new(MsgUnjailValidatorResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UnjailValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/RotateConsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorPower(ctx context.Context, in *MsgUpdateValidatorPower, opts ...grpc.CallOption) (*MsgUpdateValidatorPowerResponse, error) {
+	out := new(MsgUpdateValidatorPowerResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UpdateValidatorPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorSet(ctx context.Context, in *MsgSetValidatorSet, opts ...grpc.CallOption) (*MsgSetValidatorSetResponse, error) {
+	out := new(MsgSetValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/SetValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SpendFeePool(ctx context.Context, in *MsgSpendFeePool, opts ...grpc.CallOption) (*MsgSpendFeePoolResponse, error) {
+	out := new(MsgSpendFeePoolResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/SpendFeePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateOracle(ctx context.Context, in *MsgUpdateOracle, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error) {
+	out := new(MsgUpdateOracleResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UpdateOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/UpdateDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterExecutorChangePlan(ctx context.Context, in *MsgRegisterExecutorChangePlan, opts ...grpc.CallOption) (*MsgRegisterExecutorChangePlanResponse, error) {
+	out := new(MsgRegisterExecutorChangePlanResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/RegisterExecutorChangePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelExecutorChangePlan(ctx context.Context, in *MsgCancelExecutorChangePlan, opts ...grpc.CallOption) (*MsgCancelExecutorChangePlanResponse, error) {
+	out := new(MsgCancelExecutorChangePlanResponse)
+	err := c.cc.Invoke(ctx, "/opinit.opchild.v1.Msg/CancelExecutorChangePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ExecuteMessages defines a rpc handler method for MsgExecuteMessages.
+	ExecuteMessages(context.Context, *MsgExecuteMessages) (*MsgExecuteMessagesResponse, error)
+	// ExecuteScheduledMessages defines a rpc handler method for MsgExecuteScheduledMessages.
+	ExecuteScheduledMessages(context.Context, *MsgExecuteScheduledMessages) (*MsgExecuteScheduledMessagesResponse, error)
+	// CancelScheduledMessages defines a rpc handler method for MsgCancelScheduledMessages.
+	CancelScheduledMessages(context.Context, *MsgCancelScheduledMessages) (*MsgCancelScheduledMessagesResponse, error)
+	// ApproveAdminProposal defines a rpc handler method for MsgApproveAdminProposal.
+	ApproveAdminProposal(context.Context, *MsgApproveAdminProposal) (*MsgApproveAdminProposalResponse, error)
+	// ProposeAdmin defines a rpc handler method for MsgProposeAdmin.
+	ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a rpc handler method for MsgAcceptAdmin.
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
+	// SetBridgeInfo defines a rpc handler method for MsgSetBridgeInfo.
+	SetBridgeInfo(context.Context, *MsgSetBridgeInfo) (*MsgSetBridgeInfoResponse, error)
+	// FinalizeTokenDeposit defines a rpc handler method for MsgFinalizeTokenDeposit.
+	FinalizeTokenDeposit(context.Context, *MsgFinalizeTokenDeposit) (*MsgFinalizeTokenDepositResponse, error)
+	// FinalizeNftDeposit defines a rpc handler method for MsgFinalizeNftDeposit.
+	FinalizeNftDeposit(context.Context, *MsgFinalizeNftDeposit) (*MsgFinalizeNftDepositResponse, error)
+	// InitiateTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
+	InitiateTokenWithdrawal(context.Context, *MsgInitiateTokenWithdrawal) (*MsgInitiateTokenWithdrawalResponse, error)
+	// InitiateNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	InitiateNftWithdrawal(context.Context, *MsgInitiateNftWithdrawal) (*MsgInitiateNftWithdrawalResponse, error)
+	// AddValidator defines a rpc handler method for MsgAddValidator.
+	AddValidator(context.Context, *MsgAddValidator) (*MsgAddValidatorResponse, error)
+	// RemoveValidator defines a rpc handler method for MsgRemoveValidator.
+	RemoveValidator(context.Context, *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error)
+	// UnjailValidator defines a rpc handler method for MsgUnjailValidator.
+	UnjailValidator(context.Context, *MsgUnjailValidator) (*MsgUnjailValidatorResponse, error)
+	// RotateConsPubKey defines a rpc handler method for MsgRotateConsPubKey.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// UpdateValidatorPower defines a rpc handler method for MsgUpdateValidatorPower.
+	UpdateValidatorPower(context.Context, *MsgUpdateValidatorPower) (*MsgUpdateValidatorPowerResponse, error)
+	// SetValidatorSet defines a rpc handler method for MsgSetValidatorSet.
+	SetValidatorSet(context.Context, *MsgSetValidatorSet) (*MsgSetValidatorSetResponse, error)
+	// UpdateParams defines an operation for updating the
+	// x/opchild module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SpendFeePool defines an operation that spend fee pool to a recipient.
+	SpendFeePool(context.Context, *MsgSpendFeePool) (*MsgSpendFeePoolResponse, error)
+	// UpdateOracle defines an operation that update oracle prices.
+	UpdateOracle(context.Context, *MsgUpdateOracle) (*MsgUpdateOracleResponse, error)
+	// UpdateDenomMetadata defines an operation that corrects the denom metadata
+	// of a bridged token.
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// RegisterExecutorChangePlan defines an operation that registers a plan
+	// to change the validator and the bridge executors at a height.
+	RegisterExecutorChangePlan(context.Context, *MsgRegisterExecutorChangePlan) (*MsgRegisterExecutorChangePlanResponse, error)
+	// CancelExecutorChangePlan defines an operation that cancels a registered
+	// executor change plan.
+	CancelExecutorChangePlan(context.Context, *MsgCancelExecutorChangePlan) (*MsgCancelExecutorChangePlanResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ExecuteMessages(ctx context.Context, req *MsgExecuteMessages) (*MsgExecuteMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteMessages not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteScheduledMessages(ctx context.Context, req *MsgExecuteScheduledMessages) (*MsgExecuteScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScheduledMessages not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMessages(ctx context.Context, req *MsgCancelScheduledMessages) (*MsgCancelScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessages not implemented")
+}
+func (*UnimplementedMsgServer) ApproveAdminProposal(ctx context.Context, req *MsgApproveAdminProposal) (*MsgApproveAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAdminProposal not implemented")
+}
+func (*UnimplementedMsgServer) ProposeAdmin(ctx context.Context, req *MsgProposeAdmin) (*MsgProposeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdmin not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAdmin(ctx context.Context, req *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgeInfo(ctx context.Context, req *MsgSetBridgeInfo) (*MsgSetBridgeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeInfo not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeTokenDeposit(ctx context.Context, req *MsgFinalizeTokenDeposit) (*MsgFinalizeTokenDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTokenDeposit not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeNftDeposit(ctx context.Context, req *MsgFinalizeNftDeposit) (*MsgFinalizeNftDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeNftDeposit not implemented")
+}
+func (*UnimplementedMsgServer) InitiateTokenWithdrawal(ctx context.Context, req *MsgInitiateTokenWithdrawal) (*MsgInitiateTokenWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateTokenWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) InitiateNftWithdrawal(ctx context.Context, req *MsgInitiateNftWithdrawal) (*MsgInitiateNftWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateNftWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) AddValidator(ctx context.Context, req *MsgAddValidator) (*MsgAddValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddValidator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveValidator(ctx context.Context, req *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveValidator not implemented")
+}
+func (*UnimplementedMsgServer) UnjailValidator(ctx context.Context, req *MsgUnjailValidator) (*MsgUnjailValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailValidator not implemented")
+}
+func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorPower(ctx context.Context, req *MsgUpdateValidatorPower) (*MsgUpdateValidatorPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorPower not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorSet(ctx context.Context, req *MsgSetValidatorSet) (*MsgSetValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SpendFeePool(ctx context.Context, req *MsgSpendFeePool) (*MsgSpendFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendFeePool not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOracle(ctx context.Context, req *MsgUpdateOracle) (*MsgUpdateOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracle not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDenomMetadata(ctx context.Context, req *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) RegisterExecutorChangePlan(ctx context.Context, req *MsgRegisterExecutorChangePlan) (*MsgRegisterExecutorChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterExecutorChangePlan not implemented")
+}
+func (*UnimplementedMsgServer) CancelExecutorChangePlan(ctx context.Context, req *MsgCancelExecutorChangePlan) (*MsgCancelExecutorChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecutorChangePlan not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ExecuteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/ExecuteMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteMessages(ctx, req.(*MsgExecuteMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/ExecuteScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteScheduledMessages(ctx, req.(*MsgExecuteScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/CancelScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMessages(ctx, req.(*MsgCancelScheduledMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAdminProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/ApproveAdminProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAdminProposal(ctx, req.(*MsgApproveAdminProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/ProposeAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdmin(ctx, req.(*MsgProposeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/AcceptAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBridgeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/SetBridgeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeInfo(ctx, req.(*MsgSetBridgeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeTokenDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeTokenDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeTokenDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/FinalizeTokenDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeTokenDeposit(ctx, req.(*MsgFinalizeTokenDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeNftDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeNftDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeNftDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/FinalizeNftDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeNftDeposit(ctx, req.(*MsgFinalizeNftDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateTokenWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateTokenWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateTokenWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/InitiateTokenWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateTokenWithdrawal(ctx, req.(*MsgInitiateTokenWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateNftWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateNftWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateNftWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/InitiateNftWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateNftWithdrawal(ctx, req.(*MsgInitiateNftWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/AddValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddValidator(ctx, req.(*MsgAddValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/RemoveValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveValidator(ctx, req.(*MsgRemoveValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/UnjailValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailValidator(ctx, req.(*MsgUnjailValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/RotateConsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/UpdateValidatorPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorPower(ctx, req.(*MsgUpdateValidatorPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/SetValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorSet(ctx, req.(*MsgSetValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SpendFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSpendFeePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SpendFeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/SpendFeePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SpendFeePool(ctx, req.(*MsgSpendFeePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/UpdateOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracle(ctx, req.(*MsgUpdateOracle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/UpdateDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterExecutorChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterExecutorChangePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterExecutorChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/RegisterExecutorChangePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterExecutorChangePlan(ctx, req.(*MsgRegisterExecutorChangePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelExecutorChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelExecutorChangePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelExecutorChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.opchild.v1.Msg/CancelExecutorChangePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelExecutorChangePlan(ctx, req.(*MsgCancelExecutorChangePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "opinit.opchild.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteMessages",
+			Handler:    _Msg_ExecuteMessages_Handler,
+		},
+		{
+			MethodName: "ExecuteScheduledMessages",
+			Handler:    _Msg_ExecuteScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessages",
+			Handler:    _Msg_CancelScheduledMessages_Handler,
+		},
+		{
+			MethodName: "ApproveAdminProposal",
+			Handler:    _Msg_ApproveAdminProposal_Handler,
+		},
+		{
+			MethodName: "ProposeAdmin",
+			Handler:    _Msg_ProposeAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
+		{
+			MethodName: "SetBridgeInfo",
+			Handler:    _Msg_SetBridgeInfo_Handler,
+		},
+		{
+			MethodName: "FinalizeTokenDeposit",
+			Handler:    _Msg_FinalizeTokenDeposit_Handler,
+		},
+		{
+			MethodName: "FinalizeNftDeposit",
+			Handler:    _Msg_FinalizeNftDeposit_Handler,
+		},
+		{
+			MethodName: "InitiateTokenWithdrawal",
+			Handler:    _Msg_InitiateTokenWithdrawal_Handler,
+		},
+		{
+			MethodName: "InitiateNftWithdrawal",
+			Handler:    _Msg_InitiateNftWithdrawal_Handler,
+		},
+		{
+			MethodName: "AddValidator",
+			Handler:    _Msg_AddValidator_Handler,
+		},
+		{
+			MethodName: "RemoveValidator",
+			Handler:    _Msg_RemoveValidator_Handler,
+		},
+		{
+			MethodName: "UnjailValidator",
+			Handler:    _Msg_UnjailValidator_Handler,
+		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorPower",
+			Handler:    _Msg_UpdateValidatorPower_Handler,
+		},
+		{
+			MethodName: "SetValidatorSet",
+			Handler:    _Msg_SetValidatorSet_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SpendFeePool",
+			Handler:    _Msg_SpendFeePool_Handler,
+		},
+		{
+			MethodName: "UpdateOracle",
+			Handler:    _Msg_UpdateOracle_Handler,
+		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "RegisterExecutorChangePlan",
+			Handler:    _Msg_RegisterExecutorChangePlan_Handler,
+		},
+		{
+			MethodName: "CancelExecutorChangePlan",
+			Handler:    _Msg_CancelExecutorChangePlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opinit/opchild/v1/tx.proto",
+}
+
+func (m *MsgExecuteMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdminProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdminProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ScheduledId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteMessageResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteMessageResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteMessageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteScheduledMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteScheduledMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteScheduledMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdminProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdminProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAdminProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAdminProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ScheduledId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdminProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdminProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.BridgeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeTokenDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeTokenDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeTokenDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeTokenDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeTokenDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeTokenDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeNftDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeNftDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeNftDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TokenUri) > 0 {
+		i -= len(m.TokenUri)
+		copy(dAtA[i:], m.TokenUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenUri)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ClassUri) > 0 {
+		i -= len(m.ClassUri)
+		copy(dAtA[i:], m.ClassUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassUri)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ClassSymbol) > 0 {
+		i -= len(m.ClassSymbol)
+		copy(dAtA[i:], m.ClassSymbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassSymbol)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ClassName) > 0 {
+		i -= len(m.ClassName)
+		copy(dAtA[i:], m.ClassName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.BaseClassId) > 0 {
+		i -= len(m.BaseClassId)
+		copy(dAtA[i:], m.BaseClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseClassId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeNftDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeNftDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeNftDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateTokenWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateTokenWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateTokenWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateNftWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateNftWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateNftWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateNftWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateNftWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateNftWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateTokenWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateTokenWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateTokenWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pubkey != nil {
+		{
+			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pubkey != nil {
+		{
+			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pubkey != nil {
+		{
+			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSpendFeePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendFeePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendFeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSpendFeePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendFeePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendFeePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterExecutorChangePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterExecutorChangePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterExecutorChangePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Pubkey != nil {
+		{
+			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NextExecutors) > 0 {
+		for iNdEx := len(m.NextExecutors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextExecutors[iNdEx])
+			copy(dAtA[i:], m.NextExecutors[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NextExecutors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterExecutorChangePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterExecutorChangePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterExecutorChangePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelExecutorChangePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelExecutorChangePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelExecutorChangePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelExecutorChangePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelExecutorChangePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelExecutorChangePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgExecuteMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
+func (m *MsgExecuteMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduledId))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AdminProposalId != 0 {
+		n += 1 + sovTx(uint64(m.AdminProposalId))
+	}
+	return n
+}
+
+func (m *ExecuteMessageResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgExecuteScheduledMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AdminProposalId != 0 {
+		n += 1 + sovTx(uint64(m.AdminProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveAdminProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Passed {
+		n += 2
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduledId))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AdminProposalId != 0 {
+		n += 1 + sovTx(uint64(m.AdminProposalId))
+	}
+	return n
+}
+
+func (m *MsgAcceptAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBridgeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BridgeInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProofHeight))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizeTokenDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProofHeight))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeTokenDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizeNftDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.BaseClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassSymbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProofHeight))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeNftDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateTokenWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInitiateNftWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateNftWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgInitiateTokenWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgAddValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTx(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *MsgUpdateValidatorPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTx(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *MsgSetValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSpendFeePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSpendFeePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterExecutorChangePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.NextExecutors) > 0 {
+		for _, s := range m.NextExecutors {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterExecutorChangePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelExecutorChangePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCancelExecutorChangePlanResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgExecuteMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ExecuteMessageResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalId", wireType)
+			}
+			m.AdminProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteMessageResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteMessageResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteMessageResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteScheduledMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteScheduledMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteScheduledMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteScheduledMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteScheduledMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ExecuteMessageResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalId", wireType)
+			}
+			m.AdminProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveAdminProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveAdminProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveAdminProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveAdminProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ExecuteMessageResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalId", wireType)
+			}
+			m.AdminProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeTokenDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeTokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types2.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeTokenDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeTokenDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeTokenDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeNftDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeNftDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeNftDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeNftDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeNftDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeNftDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateTokenWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateTokenWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateTokenWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateNftWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateNftWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateNftWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateNftWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateNftWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateNftWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateTokenWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateTokenWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSpendFeePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateOracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnjailValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgExecuteMessages) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2216,15 +9369,15 @@ func (m *MsgExecuteMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2252,11 +9405,43 @@ func (m *MsgExecuteMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2283,8 +9468,10 @@ func (m *MsgExecuteMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2309,7 +9496,7 @@ func (m *MsgExecuteMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2332,10 +9519,10 @@ func (m *MsgExecuteMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2359,7 +9546,7 @@ func (m *MsgExecuteMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBridgeInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2382,15 +9569,15 @@ func (m *MsgSetBridgeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBridgeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBridgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2418,13 +9605,13 @@ func (m *MsgSetBridgeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2434,25 +9621,43 @@ func (m *MsgSetBridgeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2474,7 +9679,7 @@ func (m *MsgSetBridgeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBridgeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorPowerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2497,10 +9702,10 @@ func (m *MsgSetBridgeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBridgeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorPowerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBridgeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2524,7 +9729,7 @@ func (m *MsgSetBridgeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,15 +9752,15 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeTokenDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeTokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2583,11 +9788,95 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorPower{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2615,11 +9904,11 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2647,11 +9936,11 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2678,85 +9967,18 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2766,26 +9988,11 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2807,7 +10014,7 @@ func (m *MsgFinalizeTokenDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeTokenDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2830,10 +10037,10 @@ func (m *MsgFinalizeTokenDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeTokenDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeTokenDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2857,7 +10064,7 @@ func (m *MsgFinalizeTokenDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateTokenWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2880,15 +10087,15 @@ func (m *MsgInitiateTokenWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateTokenWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateTokenWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2916,43 +10123,11 @@ func (m *MsgInitiateTokenWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2979,7 +10154,10 @@ func (m *MsgInitiateTokenWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3004,7 +10182,7 @@ func (m *MsgInitiateTokenWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3027,31 +10205,12 @@ func (m *MsgInitiateTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateTokenWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateTokenWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3073,7 +10232,7 @@ func (m *MsgInitiateTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3096,10 +10255,10 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpendFeePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpendFeePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3136,7 +10295,7 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3164,43 +10323,11 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3227,10 +10354,8 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &types.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3255,7 +10380,7 @@ func (m *MsgAddValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSpendFeePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3278,10 +10403,10 @@ func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpendFeePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpendFeePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3305,7 +10430,7 @@ func (m *MsgAddValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3328,15 +10453,15 @@ func (m *MsgRemoveValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3364,13 +10489,32 @@ func (m *MsgRemoveValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3380,23 +10524,25 @@ func (m *MsgRemoveValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3419,7 +10565,7 @@ func (m *MsgRemoveValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3442,10 +10588,10 @@ func (m *MsgRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOracleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3469,7 +10615,7 @@ func (m *MsgRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,10 +10638,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3532,7 +10678,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3559,10 +10705,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3587,7 +10730,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3610,10 +10753,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3637,7 +10780,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterExecutorChangePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3660,10 +10803,10 @@ func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSpendFeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterExecutorChangePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSpendFeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterExecutorChangePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3699,8 +10842,46 @@ func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3728,11 +10909,75 @@ func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.NextExecutors = append(m.NextExecutors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3759,11 +11004,45 @@ func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3785,7 +11064,7 @@ func (m *MsgSpendFeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSpendFeePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterExecutorChangePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3808,10 +11087,10 @@ func (m *MsgSpendFeePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSpendFeePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterExecutorChangePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSpendFeePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterExecutorChangePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3835,7 +11114,7 @@ func (m *MsgSpendFeePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOracle) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelExecutorChangePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3858,15 +11137,15 @@ func (m *MsgUpdateOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelExecutorChangePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelExecutorChangePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3894,7 +11173,7 @@ func (m *MsgUpdateOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3915,40 +11194,6 @@ func (m *MsgUpdateOracle) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3970,7 +11215,7 @@ func (m *MsgUpdateOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelExecutorChangePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3993,10 +11238,10 @@ func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelExecutorChangePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelExecutorChangePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,6 +4,8 @@
 package types
 
 import (
+	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types2 "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -13,16 +15,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types3 "github.com/initia-labs/OPinit/x/ophost/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -44,6 +50,61 @@ type Params struct {
 	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
 	// the list of addresses that are allowed to pay zero fee.
 	FeeWhitelist []string `protobuf:"bytes,6,rep,name=fee_whitelist,json=feeWhitelist,proto3" json:"fee_whitelist,omitempty" yaml:"fee_whitelist"`
+	// strict_deposit_ordering enforces the deposits to be finalized in the l1
+	// sequence order; the deposits arrived out of order are buffered until
+	// the missing deposits are finalized.
+	StrictDepositOrdering bool `protobuf:"varint,7,opt,name=strict_deposit_ordering,json=strictDepositOrdering,proto3" json:"strict_deposit_ordering,omitempty" yaml:"strict_deposit_ordering"`
+	// deposit_attestation_threshold is the number of the deposit relayers
+	// required to submit the identical deposit before it is finalized; zero or
+	// one allows a single relayer to finalize the deposits.
+	DepositAttestationThreshold uint32 `protobuf:"varint,8,opt,name=deposit_attestation_threshold,json=depositAttestationThreshold,proto3" json:"deposit_attestation_threshold,omitempty" yaml:"deposit_attestation_threshold"`
+	// deposit_proof_required rejects the deposits without a merkle proof of
+	// the l1 state, so the bridge executors are no longer trusted to mint.
+	DepositProofRequired bool `protobuf:"varint,9,opt,name=deposit_proof_required,json=depositProofRequired,proto3" json:"deposit_proof_required,omitempty" yaml:"deposit_proof_required"`
+	// bridge_info_proof_required rejects the bridge info updates without a
+	// merkle proof of the l1 bridge config.
+	BridgeInfoProofRequired bool `protobuf:"varint,10,opt,name=bridge_info_proof_required,json=bridgeInfoProofRequired,proto3" json:"bridge_info_proof_required,omitempty" yaml:"bridge_info_proof_required"`
+	// execute_messages_delay is the number of blocks the admin messages wait
+	// in the queue before the execution; zero executes them immediately.
+	ExecuteMessagesDelay uint64 `protobuf:"varint,11,opt,name=execute_messages_delay,json=executeMessagesDelay,proto3" json:"execute_messages_delay,omitempty" yaml:"execute_messages_delay"`
+	// allowed_execute_messages is the list of the message type urls the admin
+	// can execute with MsgExecuteMessages; empty denies all the messages.
+	AllowedExecuteMessages []string `protobuf:"bytes,12,rep,name=allowed_execute_messages,json=allowedExecuteMessages,proto3" json:"allowed_execute_messages,omitempty" yaml:"allowed_execute_messages"`
+	// admin_signers are the additional addresses sharing the admin permission
+	// with the admin.
+	AdminSigners []string `protobuf:"bytes,13,rep,name=admin_signers,json=adminSigners,proto3" json:"admin_signers,omitempty" yaml:"admin_signers"`
+	// admin_threshold is the number of the approvals of the admin and the
+	// admin signers required to execute the admin messages; zero or one allows
+	// a single signer to execute them.
+	AdminThreshold uint32 `protobuf:"varint,14,opt,name=admin_threshold,json=adminThreshold,proto3" json:"admin_threshold,omitempty" yaml:"admin_threshold"`
+	// deposit_relayers are the addresses allowed to finalize the deposits;
+	// empty grants the role to the bridge executors.
+	DepositRelayers []string `protobuf:"bytes,15,rep,name=deposit_relayers,json=depositRelayers,proto3" json:"deposit_relayers,omitempty" yaml:"deposit_relayers"`
+	// oracle_relayers are the addresses allowed to update the oracle prices;
+	// empty grants the role to the bridge executors.
+	OracleRelayers []string `protobuf:"bytes,16,rep,name=oracle_relayers,json=oracleRelayers,proto3" json:"oracle_relayers,omitempty" yaml:"oracle_relayers"`
+	// bridge_info_setters are the addresses allowed to set the bridge info;
+	// empty grants the role to the bridge executors.
+	BridgeInfoSetters []string `protobuf:"bytes,17,rep,name=bridge_info_setters,json=bridgeInfoSetters,proto3" json:"bridge_info_setters,omitempty" yaml:"bridge_info_setters"`
+	// signed_blocks_window is the number of the recent blocks used to track
+	// the validator liveness; zero disables the liveness tracking.
+	SignedBlocksWindow int64 `protobuf:"varint,18,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	// min_signed_per_window is the minimum ratio of the blocks a validator
+	// must sign within the signed blocks window.
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	// downtime_jail_enabled jails the validators signing less than the
+	// min_signed_per_window; otherwise the downtime only emits the events.
+	DowntimeJailEnabled bool `protobuf:"varint,20,opt,name=downtime_jail_enabled,json=downtimeJailEnabled,proto3" json:"downtime_jail_enabled,omitempty" yaml:"downtime_jail_enabled"`
+	// admin_proposal_expiry is the number of blocks an admin proposal waits
+	// for the approvals before it expires.
+	AdminProposalExpiry uint64 `protobuf:"varint,21,opt,name=admin_proposal_expiry,json=adminProposalExpiry,proto3" json:"admin_proposal_expiry,omitempty" yaml:"admin_proposal_expiry"`
+	// l2_native_denoms are the denoms originated from l2 which can be withdrawn
+	// to l1 as minted representations; the ibc vouchers and the bridged l1
+	// tokens are not allowed.
+	L2NativeDenoms []string `protobuf:"bytes,22,rep,name=l2_native_denoms,json=l2NativeDenoms,proto3" json:"l2_native_denoms,omitempty" yaml:"l2_native_denoms"`
+	// l1_client_id is the ibc client of l1 used to verify the deposit and the
+	// bridge info proofs; empty rejects all the proofs.
+	L1ClientId string `protobuf:"bytes,23,opt,name=l1_client_id,json=l1ClientId,proto3" json:"l1_client_id,omitempty" yaml:"l1_client_id"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -95,6 +156,9 @@ type Validator struct {
 	// as a Protobuf Any.
 	ConsensusPubkey *types1.Any `protobuf:"bytes,3,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty" yaml:"consensus_pubkey"`
 	ConsPower       int64       `protobuf:"varint,4,opt,name=cons_power,json=consPower,proto3" json:"cons_power,omitempty" yaml:"cons_power"`
+	// jailed is true when the validator is removed from the consensus for the
+	// downtime; the cons_power is restored when the validator is unjailed.
+	Jailed bool `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty" yaml:"jailed"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -129,6 +193,52 @@ func (m *Validator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Validator proto.InternalMessageInfo
 
+// ValidatorSigningInfo defines the liveness information of a validator.
+type ValidatorSigningInfo struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// start_height is the height the liveness tracking of the validator started.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the index of the next block in the signed blocks window.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_blocks_counter is the number of the blocks missed in the signed
+	// blocks window.
+	MissedBlocksCounter int64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+}
+
+func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
+func (m *ValidatorSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSigningInfo) ProtoMessage()    {}
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{2}
+}
+func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfo.Merge(m, src)
+}
+func (m *ValidatorSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfo proto.InternalMessageInfo
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules
 // from tendermint dependence
@@ -140,7 +250,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc6df244b706d68, []int{2}
+	return fileDescriptor_2cc6df244b706d68, []int{3}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +298,7 @@ func (m *BridgeInfo) Reset()         { *m = BridgeInfo{} }
 func (m *BridgeInfo) String() string { return proto.CompactTextString(m) }
 func (*BridgeInfo) ProtoMessage()    {}
 func (*BridgeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc6df244b706d68, []int{3}
+	return fileDescriptor_2cc6df244b706d68, []int{4}
 }
 func (m *BridgeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,73 +327,601 @@ func (m *BridgeInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BridgeInfo proto.InternalMessageInfo
 
+// WithdrawalLeaf defines a withdrawal committed in the withdrawal tree.
+type WithdrawalLeaf struct {
+	// sequence is the l2 sequence of the withdrawal.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the l2 block height of the withdrawal.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// from is the l2 sender address.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the l1 recipient address.
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// base_denom is the l1 denom of the withdrawn token.
+	BaseDenom string `protobuf:"bytes,5,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// amount is the withdrawn token amount.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// base_class_id is the l1 class id of the withdrawn nft.
+	BaseClassId string `protobuf:"bytes,7,opt,name=base_class_id,json=baseClassId,proto3" json:"base_class_id,omitempty"`
+	// token_id is the id of the withdrawn nft.
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// leaf_version is the encoding of the token withdrawal leaf; zero for
+	// the nft withdrawals.
+	LeafVersion uint32 `protobuf:"varint,9,opt,name=leaf_version,json=leafVersion,proto3" json:"leaf_version,omitempty"`
+	// hash is the leaf hash of the withdrawal.
+	Hash []byte `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+	// l2_denom is the l2 denom of the withdrawn token originated from l2;
+	// empty for the tokens originated from l1.
+	L2Denom string `protobuf:"bytes,11,opt,name=l2_denom,json=l2Denom,proto3" json:"l2_denom,omitempty"`
+}
+
+func (m *WithdrawalLeaf) Reset()         { *m = WithdrawalLeaf{} }
+func (m *WithdrawalLeaf) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalLeaf) ProtoMessage()    {}
+func (*WithdrawalLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{5}
+}
+func (m *WithdrawalLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalLeaf.Merge(m, src)
+}
+func (m *WithdrawalLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalLeaf proto.InternalMessageInfo
+
+// WithdrawalCommitment defines the root of the withdrawal tree of the current
+// output window at a block.
+type WithdrawalCommitment struct {
+	// height is the l2 block height of the commitment.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// start_sequence is the first l2 sequence of the output window.
+	StartSequence uint64 `protobuf:"varint,2,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// end_sequence is the last l2 sequence of the output window at the height.
+	EndSequence uint64 `protobuf:"varint,3,opt,name=end_sequence,json=endSequence,proto3" json:"end_sequence,omitempty"`
+	// storage_root is the root of the withdrawal tree; empty if the output
+	// window has no withdrawals.
+	StorageRoot []byte `protobuf:"bytes,4,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	// window_end is true if the output window is closed at the height.
+	WindowEnd bool `protobuf:"varint,5,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// state_root is the app hash after the block at the height; it is
+	// recorded at the next block.
+	StateRoot []byte `protobuf:"bytes,6,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// latest_block_hash is the hash of the block at the height.
+	LatestBlockHash []byte `protobuf:"bytes,7,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+}
+
+func (m *WithdrawalCommitment) Reset()         { *m = WithdrawalCommitment{} }
+func (m *WithdrawalCommitment) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalCommitment) ProtoMessage()    {}
+func (*WithdrawalCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{6}
+}
+func (m *WithdrawalCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalCommitment.Merge(m, src)
+}
+func (m *WithdrawalCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalCommitment proto.InternalMessageInfo
+
+// WithdrawalWindow defines the output window of the withdrawal tree.
+type WithdrawalWindow struct {
+	// start_sequence is the first l2 sequence of the window.
+	StartSequence uint64 `protobuf:"varint,1,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// end_time is the time at which the window is closed.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// num_leaves is the number of the leaves committed in the window.
+	NumLeaves uint64 `protobuf:"varint,3,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// frontier is the last complete left node of each level of the withdrawal
+	// tree, from which the root is computed without rebuilding the tree.
+	Frontier [][]byte `protobuf:"bytes,4,rep,name=frontier,proto3" json:"frontier,omitempty"`
+	// next_leaf_sequence is the first l2 sequence not appended to the tree.
+	NextLeafSequence uint64 `protobuf:"varint,5,opt,name=next_leaf_sequence,json=nextLeafSequence,proto3" json:"next_leaf_sequence,omitempty"`
+}
+
+func (m *WithdrawalWindow) Reset()         { *m = WithdrawalWindow{} }
+func (m *WithdrawalWindow) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalWindow) ProtoMessage()    {}
+func (*WithdrawalWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{7}
+}
+func (m *WithdrawalWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalWindow.Merge(m, src)
+}
+func (m *WithdrawalWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalWindow proto.InternalMessageInfo
+
+// SequenceRange defines an inclusive range of sequences.
+type SequenceRange struct {
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *SequenceRange) Reset()         { *m = SequenceRange{} }
+func (m *SequenceRange) String() string { return proto.CompactTextString(m) }
+func (*SequenceRange) ProtoMessage()    {}
+func (*SequenceRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{8}
+}
+func (m *SequenceRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceRange.Merge(m, src)
+}
+func (m *SequenceRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceRange proto.InternalMessageInfo
+
+// ExecutorChangePlan defines the validator and the bridge executors which
+// replace the current ones at the height.
+type ExecutorChangePlan struct {
+	// proposal_id is the l1 governance proposal id.
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the l2 height to apply the plan.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// next_executors are the bridge executors after the change.
+	NextExecutors []string `protobuf:"bytes,3,rep,name=next_executors,json=nextExecutors,proto3" json:"next_executors,omitempty"`
+	// next_validator is the validator after the change.
+	NextValidator Validator `protobuf:"bytes,4,opt,name=next_validator,json=nextValidator,proto3" json:"next_validator"`
+	// info is the additional information of the plan.
+	Info string `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *ExecutorChangePlan) Reset()         { *m = ExecutorChangePlan{} }
+func (m *ExecutorChangePlan) String() string { return proto.CompactTextString(m) }
+func (*ExecutorChangePlan) ProtoMessage()    {}
+func (*ExecutorChangePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{9}
+}
+func (m *ExecutorChangePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorChangePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorChangePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorChangePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorChangePlan.Merge(m, src)
+}
+func (m *ExecutorChangePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorChangePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorChangePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorChangePlan proto.InternalMessageInfo
+
+// DepositAttestation defines the pending attestations of a deposit.
+type DepositAttestation struct {
+	// sequence is the l1 sequence of the deposit.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// votes are the attestations grouped by the deposit contents.
+	Votes []DepositAttestationVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *DepositAttestation) Reset()         { *m = DepositAttestation{} }
+func (m *DepositAttestation) String() string { return proto.CompactTextString(m) }
+func (*DepositAttestation) ProtoMessage()    {}
+func (*DepositAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{10}
+}
+func (m *DepositAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAttestation.Merge(m, src)
+}
+func (m *DepositAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAttestation proto.InternalMessageInfo
+
+// DepositAttestationVote defines the executors attested the same deposit
+// contents.
+type DepositAttestationVote struct {
+	// deposit_hash is the hash of the deposit contents.
+	DepositHash []byte `protobuf:"bytes,1,opt,name=deposit_hash,json=depositHash,proto3" json:"deposit_hash,omitempty"`
+	// attesters are the bridge executors submitted the deposit contents.
+	Attesters []string `protobuf:"bytes,2,rep,name=attesters,proto3" json:"attesters,omitempty"`
+}
+
+func (m *DepositAttestationVote) Reset()         { *m = DepositAttestationVote{} }
+func (m *DepositAttestationVote) String() string { return proto.CompactTextString(m) }
+func (*DepositAttestationVote) ProtoMessage()    {}
+func (*DepositAttestationVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{11}
+}
+func (m *DepositAttestationVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAttestationVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAttestationVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAttestationVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAttestationVote.Merge(m, src)
+}
+func (m *DepositAttestationVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAttestationVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAttestationVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAttestationVote proto.InternalMessageInfo
+
+// ScheduledMessages defines the admin messages queued for the execution
+// after the timelock.
+type ScheduledMessages struct {
+	// id is the unique identifier of the scheduled messages.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the admin who scheduled the messages.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// execution_height is the l2 height from which the messages can be executed.
+	ExecutionHeight uint64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// messages are the messages to be executed with the module authority.
+	Messages []*types1.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ScheduledMessages) Reset()         { *m = ScheduledMessages{} }
+func (m *ScheduledMessages) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMessages) ProtoMessage()    {}
+func (*ScheduledMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{12}
+}
+func (m *ScheduledMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMessages.Merge(m, src)
+}
+func (m *ScheduledMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMessages proto.InternalMessageInfo
+
+// AdminProposal defines the admin messages waiting for the approvals of the
+// admin signers.
+type AdminProposal struct {
+	// id is the unique identifier of the admin proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposer is the admin signer who submitted the proposal.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// execution_height is the requested execution height of the messages.
+	ExecutionHeight uint64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// messages are the messages to be executed with the module authority.
+	Messages []*types1.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// approvals are the admin signers approved the proposal.
+	Approvals []string `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// expiration_height is the height from which the proposal can no longer
+	// be approved.
+	ExpirationHeight uint64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *AdminProposal) Reset()         { *m = AdminProposal{} }
+func (m *AdminProposal) String() string { return proto.CompactTextString(m) }
+func (*AdminProposal) ProtoMessage()    {}
+func (*AdminProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc6df244b706d68, []int{13}
+}
+func (m *AdminProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminProposal.Merge(m, src)
+}
+func (m *AdminProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "opinit.opchild.v1.Params")
 	proto.RegisterType((*Validator)(nil), "opinit.opchild.v1.Validator")
+	proto.RegisterType((*ValidatorSigningInfo)(nil), "opinit.opchild.v1.ValidatorSigningInfo")
 	proto.RegisterType((*ValidatorUpdates)(nil), "opinit.opchild.v1.ValidatorUpdates")
 	proto.RegisterType((*BridgeInfo)(nil), "opinit.opchild.v1.BridgeInfo")
+	proto.RegisterType((*WithdrawalLeaf)(nil), "opinit.opchild.v1.WithdrawalLeaf")
+	proto.RegisterType((*WithdrawalCommitment)(nil), "opinit.opchild.v1.WithdrawalCommitment")
+	proto.RegisterType((*WithdrawalWindow)(nil), "opinit.opchild.v1.WithdrawalWindow")
+	proto.RegisterType((*SequenceRange)(nil), "opinit.opchild.v1.SequenceRange")
+	proto.RegisterType((*ExecutorChangePlan)(nil), "opinit.opchild.v1.ExecutorChangePlan")
+	proto.RegisterType((*DepositAttestation)(nil), "opinit.opchild.v1.DepositAttestation")
+	proto.RegisterType((*DepositAttestationVote)(nil), "opinit.opchild.v1.DepositAttestationVote")
+	proto.RegisterType((*ScheduledMessages)(nil), "opinit.opchild.v1.ScheduledMessages")
+	proto.RegisterType((*AdminProposal)(nil), "opinit.opchild.v1.AdminProposal")
 }
 
 func init() { proto.RegisterFile("opinit/opchild/v1/types.proto", fileDescriptor_2cc6df244b706d68) }
 
 var fileDescriptor_2cc6df244b706d68 = []byte{
-	// 887 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0xbb, 0xe9, 0x16, 0x4f, 0xb2, 0x5f, 0xa3, 0xad, 0xc8, 0xee, 0xb6, 0x76, 0xe4, 0x53,
-	0x54, 0x88, 0xad, 0x6c, 0x41, 0x82, 0x3d, 0x51, 0x87, 0x05, 0xad, 0x8a, 0x68, 0x64, 0x04, 0x48,
-	0xbd, 0x58, 0x63, 0x7b, 0x92, 0x8c, 0xd6, 0x9e, 0xb1, 0x3c, 0x4e, 0xba, 0xf9, 0x0f, 0x10, 0x17,
-	0x38, 0x72, 0xdc, 0x63, 0xc5, 0xa9, 0x07, 0x6e, 0xfc, 0x03, 0x2b, 0x24, 0xa4, 0x8a, 0x13, 0xa7,
-	0x00, 0xbb, 0x87, 0x72, 0xce, 0x81, 0x33, 0x9a, 0x8f, 0x7c, 0x34, 0x08, 0xf5, 0x92, 0xcc, 0xbc,
-	0xdf, 0xef, 0xbd, 0x79, 0xf3, 0x9b, 0xf7, 0x9e, 0xc1, 0x7d, 0x96, 0x13, 0x4a, 0x4a, 0x8f, 0xe5,
-	0xf1, 0x90, 0xa4, 0x89, 0x37, 0xee, 0x78, 0xe5, 0x24, 0xc7, 0xdc, 0xcd, 0x0b, 0x56, 0x32, 0xb8,
-	0xa7, 0x60, 0x57, 0xc3, 0xee, 0xb8, 0x73, 0xb8, 0x87, 0x32, 0x42, 0x99, 0x27, 0x7f, 0x15, 0xeb,
-	0xd0, 0x8a, 0x19, 0xcf, 0x18, 0xf7, 0x22, 0xc4, 0xb1, 0x37, 0xee, 0x44, 0xb8, 0x44, 0x1d, 0x2f,
-	0x66, 0x84, 0x6a, 0xfc, 0x40, 0xe1, 0xa1, 0xdc, 0x79, 0x6a, 0xa3, 0xa1, 0xfd, 0x01, 0x1b, 0x30,
-	0x65, 0x17, 0xab, 0xb9, 0xc3, 0x80, 0xb1, 0x41, 0x8a, 0x3d, 0xb9, 0x8b, 0x46, 0x7d, 0x0f, 0xd1,
-	0x89, 0x86, 0x8e, 0x4a, 0x4c, 0x13, 0x5c, 0x64, 0x84, 0x96, 0x1e, 0x8a, 0x62, 0xb2, 0x9a, 0xee,
-	0xe1, 0xbd, 0xc5, 0x6d, 0x86, 0x8c, 0x97, 0x6b, 0x97, 0x71, 0x7e, 0xad, 0x82, 0xcd, 0x1e, 0x2a,
-	0x50, 0xc6, 0xe1, 0x47, 0x60, 0x3b, 0x43, 0x17, 0xe1, 0x18, 0xa5, 0x24, 0x41, 0x25, 0x2b, 0x78,
-	0xc3, 0x68, 0x1a, 0xad, 0x2d, 0xff, 0x60, 0x36, 0xb5, 0xef, 0x4e, 0x50, 0x96, 0x9e, 0x38, 0xaf,
-	0xe3, 0x4e, 0xb0, 0x95, 0xa1, 0x8b, 0xaf, 0x16, 0x7b, 0xf8, 0x19, 0x80, 0x43, 0xc2, 0x4b, 0x56,
-	0x90, 0x18, 0xa5, 0x21, 0xa6, 0x65, 0x41, 0x30, 0x6f, 0xdc, 0x92, 0x51, 0xee, 0xcf, 0xa6, 0xf6,
-	0x81, 0x8a, 0xf2, 0x5f, 0x8e, 0x13, 0xec, 0x2d, 0x8d, 0xa7, 0xca, 0x06, 0xbf, 0x33, 0xc0, 0x76,
-	0x46, 0x68, 0x38, 0x40, 0x42, 0x25, 0x12, 0x63, 0xde, 0xd8, 0x68, 0x6e, 0xb4, 0x6a, 0xc7, 0xf7,
-	0x5c, 0x2d, 0x97, 0xd0, 0xd6, 0xd5, 0xda, 0xba, 0x1f, 0xe3, 0xb8, 0xcb, 0x08, 0xf5, 0x1f, 0x5f,
-	0x4d, 0xed, 0xca, 0x6c, 0x6a, 0xef, 0xeb, 0x94, 0x57, 0x23, 0x38, 0x3f, 0xfe, 0x61, 0xbf, 0x33,
-	0x20, 0xe5, 0x70, 0x14, 0xb9, 0x31, 0xcb, 0xb4, 0xec, 0xfa, 0xaf, 0xcd, 0x93, 0x73, 0xad, 0x8d,
-	0x8e, 0xc5, 0x83, 0x7a, 0x46, 0xe8, 0xa7, 0x88, 0xf7, 0xe4, 0xf1, 0x30, 0x06, 0xbb, 0x51, 0x41,
-	0x92, 0x01, 0x0e, 0xf1, 0x05, 0x8e, 0x47, 0x52, 0xa3, 0x6a, 0x73, 0xa3, 0x65, 0xfa, 0x1f, 0xcc,
-	0xa6, 0xf6, 0xdb, 0xea, 0xc0, 0x75, 0x86, 0xf3, 0xdb, 0x4f, 0xed, 0x7d, 0x9d, 0xf0, 0xa3, 0x24,
-	0x29, 0x30, 0xe7, 0x5f, 0x94, 0x05, 0xa1, 0x83, 0xe7, 0xaf, 0x5e, 0x3c, 0x30, 0x82, 0x1d, 0xc5,
-	0x3f, 0x9d, 0xd3, 0x61, 0x17, 0xdc, 0x46, 0x49, 0x46, 0x68, 0xe3, 0x76, 0xd3, 0x68, 0x99, 0x7e,
-	0x7b, 0x36, 0xb5, 0xeb, 0x2a, 0xb2, 0x34, 0xbf, 0x21, 0x9c, 0xf2, 0x85, 0x4f, 0xc1, 0x56, 0x1f,
-	0xe3, 0xf0, 0xd9, 0x90, 0x94, 0x38, 0x25, 0xbc, 0x6c, 0x6c, 0xca, 0x34, 0xdf, 0x5f, 0xea, 0xf2,
-	0x1a, 0xfc, 0x86, 0xa0, 0xf5, 0x3e, 0xc6, 0x5f, 0xcf, 0xb9, 0x27, 0x47, 0x3f, 0x5c, 0xda, 0x95,
-	0xbf, 0x2f, 0x6d, 0xe3, 0xdb, 0x57, 0x2f, 0x1e, 0x6c, 0xcf, 0x9b, 0x44, 0x15, 0x91, 0xf3, 0xf3,
-	0x2d, 0x60, 0x2e, 0x2a, 0x02, 0xbe, 0x0b, 0xee, 0x64, 0x8c, 0x92, 0x73, 0x5c, 0xc8, 0x5a, 0x32,
-	0x7d, 0x38, 0x9b, 0xda, 0xdb, 0xfa, 0x61, 0x14, 0xe0, 0x04, 0x73, 0x0a, 0xfc, 0x04, 0xec, 0xb2,
-	0x1c, 0x17, 0xc2, 0x33, 0x44, 0x2a, 0x0b, 0x59, 0x3c, 0xa6, 0x7f, 0xb4, 0x94, 0x77, 0x9d, 0xe1,
-	0x04, 0x3b, 0x73, 0x93, 0xce, 0x1c, 0x96, 0x60, 0x37, 0x66, 0x94, 0x63, 0xca, 0x47, 0x3c, 0xcc,
-	0x47, 0xd1, 0x39, 0x9e, 0x34, 0x36, 0x9a, 0x46, 0xab, 0x76, 0xbc, 0xef, 0xaa, 0x26, 0x72, 0xe7,
-	0x4d, 0xe4, 0x3e, 0xa2, 0x13, 0xff, 0xe1, 0x32, 0xfa, 0xba, 0x9f, 0xf3, 0xcb, 0x52, 0x98, 0xb8,
-	0x98, 0xe4, 0x25, 0x73, 0x7b, 0xa3, 0xe8, 0x31, 0x9e, 0x04, 0x3b, 0x0b, 0x6a, 0x4f, 0x32, 0xe1,
-	0x7b, 0x00, 0x08, 0x53, 0x98, 0xb3, 0x67, 0xb8, 0x68, 0x54, 0x9b, 0x46, 0x6b, 0xc3, 0xbf, 0x3b,
-	0x9b, 0xda, 0x7b, 0xcb, 0xc8, 0x0a, 0x73, 0x02, 0x53, 0x6c, 0x7a, 0x62, 0x7d, 0x52, 0xff, 0xe6,
-	0xd2, 0xae, 0x68, 0x41, 0x2b, 0x4e, 0x08, 0x76, 0x17, 0xe2, 0x7d, 0x99, 0x27, 0xa8, 0xc4, 0x1c,
-	0x9e, 0x82, 0x3b, 0x23, 0xb5, 0x6c, 0x18, 0xb2, 0xfc, 0x9b, 0xee, 0xb2, 0xdd, 0x5d, 0xd1, 0xee,
-	0xee, 0x9a, 0x8f, 0x6f, 0x8a, 0x16, 0x50, 0x4f, 0x37, 0xf7, 0x3d, 0xa9, 0xca, 0x03, 0xfe, 0x31,
-	0x00, 0xf0, 0x65, 0xc1, 0x9d, 0xd1, 0x3e, 0x83, 0x47, 0xc0, 0xd4, 0xe5, 0x4a, 0x12, 0xf9, 0x42,
-	0xd5, 0xe0, 0x2d, 0x65, 0x38, 0x4b, 0xe0, 0x87, 0xa0, 0xa6, 0x41, 0x21, 0xb5, 0x7e, 0x89, 0xc6,
-	0xff, 0x55, 0x4a, 0x00, 0x14, 0x59, 0x18, 0xa1, 0x05, 0x6a, 0x69, 0x27, 0x8c, 0x87, 0x88, 0x50,
-	0x11, 0x59, 0x88, 0x6f, 0x06, 0x66, 0xda, 0xe9, 0x0a, 0xcb, 0x59, 0x02, 0x9b, 0xa0, 0x2e, 0xf0,
-	0x94, 0x60, 0x5a, 0x0a, 0x42, 0x55, 0x12, 0x40, 0xda, 0xe9, 0x4a, 0xd3, 0x59, 0x02, 0x3f, 0x07,
-	0x5b, 0xfa, 0xf0, 0x98, 0xd1, 0x3e, 0x19, 0xc8, 0x6e, 0xa8, 0x1d, 0x5b, 0xee, 0x62, 0xf8, 0x8a,
-	0x69, 0xe6, 0x8e, 0x3b, 0xae, 0xba, 0x4e, 0x57, 0xb2, 0x56, 0x6f, 0x5e, 0x8f, 0x56, 0x81, 0x27,
-	0x57, 0x7f, 0x59, 0x95, 0xe7, 0xd7, 0x96, 0x71, 0x75, 0x6d, 0x19, 0x2f, 0xaf, 0x2d, 0xe3, 0xcf,
-	0x6b, 0xcb, 0xf8, 0xfe, 0xc6, 0xaa, 0xbc, 0xbc, 0xb1, 0x2a, 0xbf, 0xdf, 0x58, 0x95, 0xa7, 0xed,
-	0x95, 0xb9, 0x20, 0x8e, 0x20, 0xa8, 0x9d, 0xa2, 0x88, 0x7b, 0x4f, 0x7a, 0x72, 0x7c, 0x5e, 0x2c,
-	0x3e, 0x07, 0x72, 0x44, 0x44, 0x9b, 0xb2, 0x84, 0x1e, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x1e,
-	0xec, 0xe4, 0x8b, 0x2d, 0x06, 0x00, 0x00,
+	// 2294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x70, 0x1b, 0x49,
+	0xf9, 0xf7, 0x58, 0x7e, 0x48, 0xad, 0x87, 0xe5, 0xf6, 0x23, 0x13, 0x7b, 0xa3, 0x51, 0xe6, 0xff,
+	0xdf, 0x2a, 0xed, 0x23, 0xd2, 0xda, 0xbb, 0xb0, 0x6c, 0x38, 0x40, 0x64, 0x1b, 0xd6, 0xbb, 0x21,
+	0x31, 0x93, 0x90, 0x54, 0xa5, 0x8a, 0x1a, 0x5a, 0x33, 0x2d, 0xa9, 0xf1, 0xcc, 0xb4, 0x76, 0xba,
+	0xe5, 0x47, 0x15, 0x57, 0xaa, 0x16, 0x2e, 0xec, 0x89, 0xe2, 0x18, 0x6e, 0x5b, 0x9c, 0xf6, 0xc0,
+	0x91, 0x23, 0x87, 0x14, 0xa7, 0x2d, 0x4e, 0x14, 0x07, 0x2d, 0x24, 0x55, 0x2c, 0x27, 0x0e, 0x3a,
+	0x70, 0xa6, 0xfa, 0x31, 0x33, 0x92, 0xec, 0xc4, 0x5c, 0xb8, 0xd8, 0xd3, 0xbf, 0xef, 0xd7, 0x5f,
+	0xf7, 0xf7, 0xea, 0xfe, 0x5a, 0xe0, 0x06, 0x1d, 0x90, 0x88, 0xf0, 0x16, 0x1d, 0x78, 0x7d, 0x12,
+	0xf8, 0xad, 0x93, 0x9d, 0x16, 0x3f, 0x1f, 0x60, 0xd6, 0x1c, 0xc4, 0x94, 0x53, 0xb8, 0xaa, 0xc4,
+	0x4d, 0x2d, 0x6e, 0x9e, 0xec, 0x6c, 0xad, 0xa2, 0x90, 0x44, 0xb4, 0x25, 0xff, 0x2a, 0xd6, 0x56,
+	0xcd, 0xa3, 0x2c, 0xa4, 0xac, 0xd5, 0x41, 0x0c, 0xb7, 0x4e, 0x76, 0x3a, 0x98, 0xa3, 0x9d, 0x96,
+	0x47, 0x49, 0xa4, 0xe5, 0xd7, 0x95, 0xdc, 0x95, 0xa3, 0x96, 0x1a, 0x68, 0xd1, 0x7a, 0x8f, 0xf6,
+	0xa8, 0xc2, 0xc5, 0x57, 0x32, 0xa1, 0x47, 0x69, 0x2f, 0xc0, 0x2d, 0x39, 0xea, 0x0c, 0xbb, 0x2d,
+	0x14, 0x9d, 0x6b, 0x91, 0x35, 0x2b, 0xe2, 0x24, 0xc4, 0x8c, 0xa3, 0x70, 0xa0, 0x09, 0xdb, 0x1c,
+	0x47, 0x3e, 0x8e, 0x43, 0x12, 0xf1, 0x16, 0xea, 0x78, 0x64, 0xd2, 0x9e, 0xad, 0xd7, 0x52, 0x73,
+	0xfb, 0x94, 0xf1, 0x19, 0x6b, 0xed, 0x7f, 0x55, 0xc1, 0xd2, 0x11, 0x8a, 0x51, 0xc8, 0xe0, 0x77,
+	0x41, 0x25, 0x44, 0x67, 0xee, 0x09, 0x0a, 0x88, 0x8f, 0x38, 0x8d, 0x99, 0x69, 0xd4, 0x8d, 0x46,
+	0xb9, 0x7d, 0x7d, 0x3c, 0xb2, 0x36, 0xce, 0x51, 0x18, 0xdc, 0xb6, 0xa7, 0xe5, 0xb6, 0x53, 0x0e,
+	0xd1, 0xd9, 0xa3, 0x74, 0x0c, 0xef, 0x02, 0xd8, 0x27, 0x8c, 0xd3, 0x98, 0x78, 0x28, 0x70, 0x71,
+	0xc4, 0x63, 0x82, 0x99, 0x39, 0x2f, 0xb5, 0xdc, 0x18, 0x8f, 0xac, 0xeb, 0x4a, 0xcb, 0x45, 0x8e,
+	0xed, 0xac, 0x66, 0xe0, 0x81, 0xc2, 0xe0, 0xaf, 0x0c, 0x50, 0x09, 0x49, 0xe4, 0xf6, 0x90, 0x70,
+	0x23, 0xf1, 0x30, 0x33, 0x73, 0xf5, 0x5c, 0xa3, 0xb8, 0xfb, 0x5a, 0x53, 0xfb, 0x53, 0x38, 0xbf,
+	0xa9, 0x9d, 0xdf, 0xdc, 0xc7, 0xde, 0x1e, 0x25, 0x51, 0xfb, 0xe3, 0x67, 0x23, 0x6b, 0x6e, 0x3c,
+	0xb2, 0xd6, 0xf5, 0x96, 0x27, 0x35, 0xd8, 0xbf, 0xfb, 0xca, 0x7a, 0xab, 0x47, 0x78, 0x7f, 0xd8,
+	0x69, 0x7a, 0x34, 0xd4, 0x71, 0xd1, 0xff, 0x6e, 0x31, 0xff, 0x58, 0xfb, 0x46, 0xeb, 0x62, 0x4e,
+	0x29, 0x24, 0xd1, 0xf7, 0x11, 0x3b, 0x92, 0xcb, 0x43, 0x0f, 0x54, 0x3b, 0x31, 0xf1, 0x7b, 0xd8,
+	0xc5, 0x67, 0xd8, 0x1b, 0x4a, 0x1f, 0x2d, 0xd4, 0x73, 0x8d, 0x42, 0xfb, 0x5b, 0xe3, 0x91, 0x75,
+	0x4d, 0x2d, 0x38, 0xcb, 0xb0, 0xff, 0xfc, 0xfb, 0x5b, 0xeb, 0x7a, 0xc3, 0x77, 0x7c, 0x3f, 0xc6,
+	0x8c, 0x3d, 0xe0, 0x31, 0x89, 0x7a, 0x9f, 0x7f, 0xfd, 0xc5, 0x9b, 0x86, 0xb3, 0xa2, 0xf8, 0x07,
+	0x09, 0x1d, 0xee, 0x81, 0x45, 0xe4, 0x87, 0x24, 0x32, 0x17, 0xeb, 0x46, 0xa3, 0xd0, 0xbe, 0x35,
+	0x1e, 0x59, 0x25, 0xa5, 0x59, 0xc2, 0x57, 0xa8, 0x53, 0x73, 0xe1, 0x13, 0x50, 0xee, 0x62, 0xec,
+	0x9e, 0xf6, 0x09, 0xc7, 0x01, 0x61, 0xdc, 0x5c, 0x92, 0xdb, 0xfc, 0x46, 0xe6, 0x97, 0x29, 0xf1,
+	0x15, 0x4a, 0x4b, 0x5d, 0x8c, 0x1f, 0x27, 0x5c, 0xf8, 0x04, 0x5c, 0x63, 0x3c, 0x26, 0x1e, 0x77,
+	0x7d, 0x3c, 0xa0, 0x8c, 0x70, 0x97, 0xc6, 0x3e, 0x16, 0x6c, 0x73, 0xb9, 0x6e, 0x34, 0xf2, 0x6d,
+	0x7b, 0x3c, 0xb2, 0x6a, 0x6a, 0x95, 0x97, 0x10, 0x6d, 0x67, 0x43, 0x49, 0xf6, 0x95, 0xe0, 0xbe,
+	0xc6, 0x61, 0x00, 0x6e, 0x24, 0x5c, 0xc4, 0xb9, 0x48, 0x72, 0x4e, 0x68, 0xe4, 0xf2, 0x7e, 0x8c,
+	0x59, 0x9f, 0x06, 0xbe, 0x99, 0x97, 0xc9, 0xd4, 0x18, 0x8f, 0xac, 0xff, 0x57, 0x2b, 0xbc, 0x92,
+	0x6e, 0x3b, 0xdb, 0x5a, 0x7e, 0x27, 0x13, 0x3f, 0x4c, 0xa4, 0xf0, 0x31, 0xd8, 0x4c, 0xa6, 0x0f,
+	0x62, 0x4a, 0xbb, 0x6e, 0x8c, 0x3f, 0x19, 0x92, 0x18, 0xfb, 0x66, 0x41, 0x1a, 0x72, 0x73, 0x3c,
+	0xb2, 0x6e, 0x4c, 0x2f, 0x33, 0xcd, 0xb3, 0x9d, 0x75, 0x2d, 0x38, 0x12, 0xb8, 0xa3, 0x61, 0xd8,
+	0x01, 0x5b, 0x3a, 0x0d, 0x48, 0xd4, 0xa5, 0xb3, 0xca, 0x81, 0x54, 0xfe, 0xfa, 0x78, 0x64, 0xdd,
+	0x9c, 0x4a, 0x99, 0x4b, 0xb8, 0xb6, 0x73, 0x4d, 0x09, 0x0f, 0xa3, 0x2e, 0x9d, 0x5e, 0xe3, 0x31,
+	0xd8, 0x54, 0x39, 0x86, 0xdd, 0x10, 0x33, 0x86, 0x7a, 0x98, 0xb9, 0x3e, 0x0e, 0xd0, 0xb9, 0x59,
+	0xac, 0x1b, 0x8d, 0x85, 0xc9, 0xcd, 0x5f, 0xce, 0xb3, 0x9d, 0x75, 0x2d, 0xf8, 0x81, 0xc6, 0xf7,
+	0x05, 0x0c, 0x7f, 0x0c, 0x4c, 0x14, 0x04, 0xf4, 0x14, 0xfb, 0xee, 0xec, 0x44, 0xb3, 0x24, 0xd3,
+	0xe8, 0xff, 0xc6, 0x23, 0xcb, 0xd2, 0x39, 0xf9, 0x12, 0xa6, 0xed, 0x6c, 0x6a, 0xd1, 0xc1, 0xf4,
+	0x1a, 0x22, 0x35, 0x65, 0x8e, 0xba, 0x8c, 0xf4, 0x22, 0x1c, 0x33, 0xb3, 0x3c, 0x9b, 0x9a, 0x53,
+	0xe2, 0xab, 0x52, 0x53, 0x92, 0x1f, 0x28, 0x2e, 0xdc, 0x03, 0x2b, 0x6a, 0x72, 0x96, 0x30, 0x15,
+	0x99, 0x30, 0x5b, 0xe3, 0x91, 0xb5, 0x39, 0xa9, 0x7d, 0x22, 0x45, 0x2a, 0x12, 0xc9, 0xb2, 0xc2,
+	0x03, 0xd5, 0x24, 0xda, 0xb1, 0x70, 0x88, 0xd8, 0xe3, 0xca, 0x6c, 0x95, 0xcf, 0x32, 0xae, 0xaa,
+	0x72, 0xcd, 0x77, 0x34, 0x1d, 0xfe, 0x04, 0xac, 0xd0, 0x18, 0x79, 0x01, 0xce, 0xd6, 0xa8, 0xca,
+	0x35, 0xde, 0xcf, 0x76, 0x3a, 0x43, 0xb8, 0x62, 0x89, 0x8a, 0xa2, 0xa7, 0x2b, 0x1c, 0x83, 0xb5,
+	0xc9, 0xbc, 0x62, 0x98, 0x73, 0xb1, 0xca, 0xaa, 0x5c, 0xe5, 0xdb, 0xe3, 0x91, 0xb5, 0x75, 0x31,
+	0xf9, 0x34, 0xe9, 0x8a, 0x95, 0x56, 0xb3, 0x94, 0x7c, 0xa0, 0x26, 0xc0, 0x1f, 0x82, 0x75, 0x19,
+	0x2f, 0xdf, 0xed, 0x04, 0xd4, 0x3b, 0x66, 0xee, 0x29, 0x89, 0x7c, 0x7a, 0x6a, 0xc2, 0xba, 0xd1,
+	0xc8, 0xb5, 0xad, 0xf1, 0xc8, 0xda, 0x56, 0xab, 0x5d, 0xc6, 0xb2, 0x1d, 0xa8, 0xe0, 0xb6, 0x44,
+	0x1f, 0x4b, 0x10, 0xfe, 0xc2, 0x00, 0x1b, 0x69, 0x1e, 0xf8, 0xee, 0x00, 0xc7, 0x89, 0xd2, 0xb5,
+	0xba, 0xd1, 0x28, 0xb5, 0x1f, 0x89, 0x73, 0xfe, 0xaf, 0x23, 0x6b, 0x5b, 0x6d, 0x94, 0xf9, 0xc7,
+	0x4d, 0x42, 0x5b, 0x21, 0xe2, 0xfd, 0xe6, 0x5d, 0xdc, 0x43, 0xde, 0xf9, 0x3e, 0xf6, 0xc6, 0x23,
+	0xeb, 0xb5, 0xec, 0x1a, 0xb8, 0xa0, 0x49, 0xd8, 0x09, 0xb4, 0x9d, 0xfb, 0xd8, 0x53, 0xd6, 0xc1,
+	0x24, 0x9f, 0xfc, 0x23, 0x1c, 0xeb, 0xbd, 0x3c, 0x04, 0x1b, 0x3e, 0x3d, 0x8d, 0xc4, 0xbd, 0xeb,
+	0xfe, 0x14, 0x11, 0x71, 0x6f, 0xa1, 0x4e, 0x80, 0x7d, 0x73, 0x5d, 0x96, 0x72, 0x3d, 0x5b, 0xe7,
+	0x52, 0x9a, 0xed, 0xac, 0x25, 0xf8, 0x47, 0x88, 0x04, 0x07, 0x0a, 0x15, 0x5a, 0x55, 0x32, 0x0e,
+	0x62, 0x3a, 0xa0, 0x4c, 0x5c, 0x87, 0x67, 0x03, 0x12, 0x9f, 0x9b, 0x1b, 0xb2, 0x80, 0x27, 0xb4,
+	0x5e, 0x4a, 0xb3, 0x9d, 0x35, 0x89, 0x1f, 0x69, 0xf8, 0x40, 0xa2, 0xf0, 0x00, 0x54, 0x83, 0x5d,
+	0x37, 0x42, 0x9c, 0x9c, 0x60, 0xd7, 0xc7, 0x11, 0x0d, 0x99, 0xb9, 0x29, 0x83, 0xbe, 0x9d, 0xa5,
+	0xef, 0x2c, 0xc3, 0x76, 0x2a, 0xc1, 0xee, 0x3d, 0x89, 0xec, 0x4b, 0x00, 0x7e, 0x00, 0x4a, 0xc1,
+	0x8e, 0xeb, 0x05, 0x04, 0x47, 0xdc, 0x25, 0xbe, 0x79, 0x4d, 0xde, 0x46, 0xd7, 0xc6, 0x23, 0x6b,
+	0x4d, 0xab, 0x98, 0x90, 0xda, 0x0e, 0x08, 0x76, 0xf6, 0xe4, 0xe8, 0xd0, 0xbf, 0xbd, 0xfd, 0x9b,
+	0xa7, 0xd6, 0xdc, 0x3f, 0x9f, 0x5a, 0xc6, 0x2f, 0xbf, 0xfe, 0xe2, 0xcd, 0x4a, 0xd2, 0x66, 0xa9,
+	0x2e, 0xc3, 0xfe, 0xc7, 0x3c, 0x28, 0xa4, 0x2d, 0x03, 0x7c, 0x1b, 0x2c, 0x87, 0x34, 0x22, 0xc7,
+	0x38, 0x96, 0xcd, 0x46, 0xa1, 0x0d, 0xc7, 0x23, 0xab, 0xa2, 0x43, 0xa6, 0x04, 0xb6, 0x93, 0x50,
+	0xe0, 0xf7, 0x40, 0x95, 0x0e, 0x70, 0x2c, 0x66, 0xba, 0x48, 0xe5, 0xa5, 0xec, 0x2e, 0xa6, 0x4c,
+	0x9b, 0x65, 0xd8, 0xce, 0x4a, 0x02, 0xe9, 0x5c, 0x86, 0x1c, 0x54, 0x3d, 0x1a, 0x31, 0x1c, 0xb1,
+	0x21, 0x73, 0x07, 0xc3, 0xce, 0x31, 0x3e, 0x37, 0x73, 0x75, 0xa3, 0x51, 0xdc, 0x5d, 0x6f, 0xaa,
+	0x5e, 0xab, 0x99, 0xf4, 0x5a, 0xcd, 0x3b, 0xd1, 0x79, 0xfb, 0xdd, 0x4c, 0xfb, 0xec, 0x3c, 0xfb,
+	0x4f, 0x59, 0xa9, 0x78, 0xf1, 0xf9, 0x80, 0xd3, 0xe6, 0xd1, 0xb0, 0xf3, 0x31, 0x3e, 0x77, 0x56,
+	0x52, 0xea, 0x91, 0x64, 0xc2, 0xf7, 0x00, 0x10, 0x90, 0x3b, 0xa0, 0xa7, 0x38, 0x36, 0x17, 0x64,
+	0x65, 0x6c, 0x8c, 0x47, 0xd6, 0x6a, 0xa6, 0x59, 0xc9, 0x6c, 0xa7, 0x20, 0x06, 0x47, 0xe2, 0x1b,
+	0xbe, 0x01, 0x96, 0x44, 0x2a, 0x61, 0x5f, 0xf6, 0x03, 0xf9, 0xf6, 0xea, 0x78, 0x64, 0x95, 0xd5,
+	0x0c, 0x85, 0xdb, 0x8e, 0x26, 0xdc, 0x2e, 0x7d, 0xfa, 0xd4, 0x9a, 0xd3, 0xbe, 0x9f, 0xb3, 0xff,
+	0x68, 0x80, 0xf5, 0xd4, 0xd1, 0x22, 0xa1, 0x49, 0xd4, 0x13, 0x25, 0x0b, 0x77, 0xc1, 0x72, 0xe2,
+	0x3c, 0xe5, 0x73, 0xf3, 0x65, 0xe5, 0xee, 0x24, 0x44, 0x78, 0x13, 0x94, 0x18, 0x47, 0x31, 0x77,
+	0xfb, 0x98, 0xf4, 0xfa, 0x5c, 0x7a, 0x3d, 0xe7, 0x14, 0x25, 0xf6, 0xa1, 0x84, 0x04, 0x85, 0x44,
+	0x3e, 0x3e, 0x73, 0x69, 0xb7, 0xcb, 0x30, 0x97, 0x0e, 0xcd, 0x39, 0x45, 0x89, 0xdd, 0x97, 0x10,
+	0xdc, 0x15, 0x15, 0xcd, 0x58, 0x56, 0xff, 0x1e, 0x1d, 0x46, 0x3c, 0x71, 0x86, 0xb3, 0xa6, 0x84,
+	0xea, 0x14, 0xd8, 0x53, 0x22, 0xdb, 0x05, 0xd5, 0xd4, 0x8a, 0x1f, 0x0d, 0x7c, 0xc4, 0x31, 0x83,
+	0x07, 0x60, 0x79, 0xa8, 0x3e, 0x4d, 0x43, 0x76, 0x84, 0xf5, 0x66, 0xd6, 0x01, 0x37, 0x45, 0x07,
+	0xdc, 0x9c, 0x99, 0xd3, 0x2e, 0x88, 0xd3, 0x42, 0x15, 0x78, 0x32, 0xf7, 0xf6, 0x82, 0xf4, 0xd3,
+	0xbf, 0x0d, 0x00, 0xda, 0xe9, 0x81, 0x06, 0xb7, 0x41, 0x21, 0x39, 0x11, 0x7d, 0xe9, 0x9f, 0x05,
+	0x27, 0xaf, 0xcf, 0x3b, 0x1f, 0x7e, 0x00, 0x8a, 0x5a, 0x28, 0x1c, 0x63, 0xce, 0x5f, 0xe1, 0x3e,
+	0xa0, 0xc8, 0x02, 0x84, 0x35, 0x50, 0x14, 0x15, 0xd3, 0x47, 0x24, 0x12, 0x9a, 0x85, 0x77, 0x0a,
+	0x4e, 0x21, 0xd8, 0xd9, 0x13, 0xc8, 0xa1, 0x0f, 0xeb, 0x33, 0xf5, 0xb6, 0x20, 0x09, 0x13, 0x65,
+	0x05, 0xef, 0x81, 0xb2, 0x5e, 0xdc, 0xa3, 0x51, 0x97, 0xf4, 0x64, 0x42, 0x14, 0x77, 0x6b, 0xcd,
+	0xf4, 0xc1, 0x22, 0x1a, 0xfc, 0xe6, 0xc9, 0x4e, 0x53, 0x99, 0xb3, 0x27, 0x59, 0x93, 0x96, 0x97,
+	0x3a, 0x13, 0x02, 0xfb, 0xd7, 0x39, 0x50, 0x79, 0x4c, 0x78, 0xdf, 0x8f, 0xd1, 0x29, 0x0a, 0xee,
+	0x62, 0xd4, 0x85, 0x5b, 0x20, 0xcf, 0xf0, 0x27, 0x43, 0x1c, 0x79, 0x38, 0xb1, 0x3d, 0x19, 0xc3,
+	0x4d, 0xb0, 0x34, 0x15, 0x7c, 0x3d, 0x82, 0x6f, 0x83, 0x85, 0x6e, 0x4c, 0x43, 0x33, 0x77, 0x85,
+	0x33, 0x24, 0x0b, 0x36, 0xc0, 0x3c, 0xa7, 0xe6, 0xc2, 0x15, 0xdc, 0x79, 0x4e, 0xe1, 0x0d, 0x00,
+	0x44, 0x7f, 0xaf, 0x0e, 0x28, 0xd5, 0x0c, 0x3b, 0x05, 0x81, 0xc8, 0x03, 0x0a, 0x7e, 0x08, 0x96,
+	0x50, 0x28, 0x72, 0xc4, 0x5c, 0x92, 0xca, 0xde, 0xd1, 0xd7, 0xc1, 0xc6, 0xc5, 0xeb, 0xe0, 0x30,
+	0xe2, 0x13, 0x07, 0xfd, 0x61, 0xc4, 0x95, 0x37, 0xf4, 0x7c, 0x68, 0x83, 0xb2, 0x5c, 0xc8, 0x0b,
+	0x10, 0x63, 0xc2, 0xf5, 0xcb, 0x72, 0xad, 0xa2, 0x00, 0xf7, 0x04, 0x76, 0xe8, 0xc3, 0xeb, 0x20,
+	0xcf, 0xe9, 0x31, 0x96, 0xa1, 0xcb, 0x4b, 0xf1, 0xb2, 0x1c, 0x1f, 0xfa, 0x22, 0xef, 0x03, 0x8c,
+	0xba, 0xee, 0x09, 0x8e, 0x19, 0xa1, 0x91, 0x6c, 0x1d, 0xcb, 0x4e, 0x51, 0x60, 0x8f, 0x14, 0x04,
+	0x21, 0x58, 0xe8, 0x23, 0xd6, 0x97, 0x8d, 0x5f, 0xc9, 0x91, 0xdf, 0x42, 0x63, 0xb0, 0xab, 0x8d,
+	0x2b, 0x2a, 0x8d, 0xc1, 0xae, 0x34, 0xcd, 0xfe, 0xf9, 0x3c, 0x58, 0xcf, 0x02, 0xb3, 0x47, 0xc3,
+	0x90, 0xf0, 0x10, 0x47, 0x7c, 0x22, 0x04, 0xc6, 0x54, 0x08, 0x5e, 0x07, 0x15, 0x55, 0x9d, 0x69,
+	0xf0, 0xe6, 0x65, 0xf0, 0xca, 0x12, 0x7d, 0x90, 0x44, 0xf0, 0x26, 0x28, 0xe1, 0xc8, 0xcf, 0x48,
+	0x39, 0x49, 0x2a, 0xe2, 0xc8, 0x9f, 0xa4, 0x30, 0x4e, 0x63, 0xd4, 0xc3, 0x6e, 0x4c, 0x29, 0x97,
+	0x81, 0x2a, 0x39, 0x45, 0x8d, 0x39, 0x94, 0x72, 0x11, 0x17, 0x75, 0x7b, 0xba, 0x38, 0xd2, 0x87,
+	0x92, 0x53, 0x50, 0xc8, 0x41, 0xe4, 0x0b, 0x31, 0xe3, 0x88, 0xeb, 0xf9, 0x4b, 0x72, 0x7e, 0x41,
+	0x22, 0x72, 0xf6, 0x9b, 0x60, 0x35, 0x10, 0xc5, 0xc7, 0xd5, 0x11, 0xe0, 0x4a, 0xbf, 0x2c, 0x4b,
+	0xd6, 0x8a, 0x12, 0xc8, 0xf2, 0xff, 0x10, 0xb1, 0xbe, 0xfd, 0xc2, 0x00, 0xd5, 0xcc, 0x0f, 0xfa,
+	0x2a, 0xbe, 0x68, 0xab, 0x71, 0x99, 0xad, 0xdf, 0x01, 0x79, 0x61, 0xab, 0xb8, 0x72, 0xa5, 0x33,
+	0x8a, 0xbb, 0x5b, 0x17, 0x8e, 0xf6, 0x87, 0xc9, 0x33, 0xba, 0x9d, 0x17, 0xc9, 0xf3, 0xd9, 0x57,
+	0x96, 0xe1, 0x2c, 0xe3, 0xc8, 0x17, 0xb8, 0xb0, 0x23, 0x1a, 0x86, 0x6e, 0x80, 0xd1, 0x89, 0x7c,
+	0x78, 0x8a, 0x35, 0x0a, 0xd1, 0x30, 0xbc, 0x2b, 0x01, 0x51, 0x29, 0xdd, 0x98, 0x46, 0x9c, 0xc8,
+	0xd3, 0x2b, 0xd7, 0x28, 0x39, 0xe9, 0x18, 0xbe, 0x0d, 0x60, 0x84, 0xcf, 0xb8, 0x2b, 0xd3, 0x22,
+	0xdd, 0xe6, 0xa2, 0x54, 0x51, 0x15, 0x12, 0x51, 0x6b, 0xc9, 0x4e, 0xed, 0xf7, 0x41, 0x39, 0xf9,
+	0x76, 0x50, 0xd4, 0xc3, 0x70, 0x1d, 0x2c, 0x4a, 0x5b, 0xb4, 0x61, 0x6a, 0x00, 0xab, 0x20, 0x27,
+	0xfc, 0xad, 0x02, 0x2b, 0x3e, 0xed, 0xb1, 0x01, 0x60, 0xf2, 0x6c, 0xdc, 0xeb, 0x8b, 0xa9, 0x47,
+	0x01, 0x8a, 0x60, 0x0b, 0x14, 0xd3, 0x46, 0x21, 0x39, 0xc2, 0xda, 0x95, 0xe7, 0x23, 0x0b, 0x24,
+	0x8d, 0xc2, 0xe1, 0xbe, 0x03, 0x12, 0xca, 0xa1, 0x3f, 0x53, 0xd8, 0x0b, 0x93, 0x59, 0x25, 0xcd,
+	0xc8, 0xde, 0xba, 0xe2, 0xf9, 0x5d, 0x70, 0xca, 0x02, 0xcd, 0xde, 0xab, 0xf7, 0x34, 0x2d, 0xfd,
+	0x5d, 0x40, 0x26, 0x8d, 0x78, 0xa5, 0x5f, 0xf8, 0x21, 0x25, 0x3b, 0x95, 0x27, 0x4f, 0x25, 0xa9,
+	0x2f, 0x95, 0x88, 0x62, 0x11, 0xbd, 0xa8, 0xae, 0x78, 0xf9, 0x7d, 0x3b, 0xff, 0x69, 0x72, 0xab,
+	0xfd, 0x0c, 0xc0, 0xfd, 0x0b, 0x2f, 0xba, 0x57, 0x9e, 0x5b, 0x1f, 0x81, 0xc5, 0x13, 0xca, 0xe5,
+	0xef, 0x10, 0xe2, 0xaa, 0x78, 0xe3, 0x92, 0x6d, 0x5d, 0xd4, 0xf8, 0x88, 0x4e, 0xdf, 0x19, 0x4a,
+	0x85, 0xcd, 0xc0, 0xe6, 0xe5, 0x5c, 0x51, 0x38, 0xc9, 0x93, 0x40, 0xa6, 0xb4, 0xa1, 0x0a, 0x47,
+	0x63, 0x22, 0x9d, 0xe1, 0x37, 0x41, 0x41, 0x3d, 0x52, 0x71, 0xac, 0x36, 0xf3, 0xaa, 0x13, 0x30,
+	0xa3, 0xda, 0x7f, 0x30, 0xc0, 0xea, 0x03, 0xaf, 0x8f, 0xfd, 0x61, 0x80, 0xfd, 0xf4, 0x19, 0x55,
+	0x01, 0xf3, 0xe9, 0x05, 0x35, 0x4f, 0x7c, 0xf8, 0x0e, 0x58, 0x62, 0xf2, 0x0e, 0xbc, 0xf2, 0x56,
+	0xd2, 0x3c, 0xf8, 0x06, 0xa8, 0xaa, 0xd0, 0x8a, 0x27, 0xb3, 0xce, 0x00, 0x95, 0xe7, 0x2b, 0x29,
+	0xae, 0xef, 0xf6, 0x77, 0x40, 0x3e, 0x7d, 0x02, 0x2e, 0xd4, 0x73, 0x2f, 0x6b, 0x94, 0x9c, 0x94,
+	0x35, 0x11, 0xb1, 0xdf, 0xce, 0x83, 0xf2, 0x9d, 0xc9, 0x3e, 0xf5, 0xc2, 0xd6, 0xdf, 0x03, 0x79,
+	0x95, 0x8e, 0xff, 0xc5, 0xe6, 0x53, 0xe6, 0xff, 0x74, 0xfb, 0x32, 0x56, 0x83, 0x41, 0x4c, 0x4f,
+	0x50, 0xc0, 0xcc, 0xc5, 0x2b, 0x63, 0x95, 0x50, 0xe1, 0x5b, 0x60, 0x55, 0x36, 0xe7, 0x68, 0x72,
+	0x57, 0x4b, 0xaa, 0xf2, 0x33, 0x81, 0xda, 0x56, 0xe6, 0xa3, 0xf6, 0xfd, 0x67, 0x7f, 0xaf, 0xcd,
+	0x7d, 0xfe, 0xbc, 0x66, 0x3c, 0x7b, 0x5e, 0x33, 0xbe, 0x7c, 0x5e, 0x33, 0xfe, 0xf6, 0xbc, 0x66,
+	0x7c, 0xf6, 0xa2, 0x36, 0xf7, 0xe5, 0x8b, 0xda, 0xdc, 0x5f, 0x5e, 0xd4, 0xe6, 0x9e, 0xdc, 0x9a,
+	0xf8, 0xd5, 0x4a, 0xa4, 0x2f, 0x41, 0xb7, 0x02, 0xd4, 0x61, 0xad, 0xfb, 0x47, 0x62, 0xd4, 0x3a,
+	0x4b, 0x7f, 0xcd, 0x94, 0x3f, 0x60, 0x75, 0x96, 0xa4, 0x5d, 0xef, 0xfe, 0x67, 0x00, 0x65, 0x3a,
+	0x99, 0xbc, 0xec, 0x14, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -338,386 +976,3646 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
-}
-func (this *BridgeInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.StrictDepositOrdering != that1.StrictDepositOrdering {
+		return false
 	}
-
-	that1, ok := that.(*BridgeInfo)
-	if !ok {
-		that2, ok := that.(BridgeInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.DepositAttestationThreshold != that1.DepositAttestationThreshold {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.DepositProofRequired != that1.DepositProofRequired {
 		return false
 	}
-	if this.BridgeId != that1.BridgeId {
+	if this.BridgeInfoProofRequired != that1.BridgeInfoProofRequired {
 		return false
 	}
-	if this.BridgeAddr != that1.BridgeAddr {
+	if this.ExecuteMessagesDelay != that1.ExecuteMessagesDelay {
 		return false
 	}
-	if this.L1ChainId != that1.L1ChainId {
+	if len(this.AllowedExecuteMessages) != len(that1.AllowedExecuteMessages) {
 		return false
 	}
-	if this.L1ClientId != that1.L1ClientId {
+	for i := range this.AllowedExecuteMessages {
+		if this.AllowedExecuteMessages[i] != that1.AllowedExecuteMessages[i] {
+			return false
+		}
+	}
+	if len(this.AdminSigners) != len(that1.AdminSigners) {
 		return false
 	}
-	if !this.BridgeConfig.Equal(&that1.BridgeConfig) {
+	for i := range this.AdminSigners {
+		if this.AdminSigners[i] != that1.AdminSigners[i] {
+			return false
+		}
+	}
+	if this.AdminThreshold != that1.AdminThreshold {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.DepositRelayers) != len(that1.DepositRelayers) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeWhitelist) > 0 {
-		for iNdEx := len(m.FeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FeeWhitelist[iNdEx])
-			copy(dAtA[i:], m.FeeWhitelist[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x32
+	for i := range this.DepositRelayers {
+		if this.DepositRelayers[i] != that1.DepositRelayers[i] {
+			return false
 		}
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x2a
+	if len(this.OracleRelayers) != len(that1.OracleRelayers) {
+		return false
 	}
-	if len(m.BridgeExecutors) > 0 {
-		for iNdEx := len(m.BridgeExecutors) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BridgeExecutors[iNdEx])
-			copy(dAtA[i:], m.BridgeExecutors[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeExecutors[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	for i := range this.OracleRelayers {
+		if this.OracleRelayers[i] != that1.OracleRelayers[i] {
+			return false
 		}
 	}
-	if len(m.MinGasPrices) > 0 {
-		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	if len(this.BridgeInfoSetters) != len(that1.BridgeInfoSetters) {
+		return false
+	}
+	for i := range this.BridgeInfoSetters {
+		if this.BridgeInfoSetters[i] != that1.BridgeInfoSetters[i] {
+			return false
 		}
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x10
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
 	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxValidators))
-		i--
-		dAtA[i] = 0x8
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DowntimeJailEnabled != that1.DowntimeJailEnabled {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsPower != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ConsPower))
-		i--
-		dAtA[i] = 0x20
+	if this.AdminProposalExpiry != that1.AdminProposalExpiry {
+		return false
 	}
-	if m.ConsensusPubkey != nil {
-		{
-			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if len(this.L2NativeDenoms) != len(that1.L2NativeDenoms) {
+		return false
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	for i := range this.L2NativeDenoms {
+		if this.L2NativeDenoms[i] != that1.L2NativeDenoms[i] {
+			return false
+		}
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	if this.L1ClientId != that1.L1ClientId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ValidatorSigningInfo)
+	if !ok {
+		that2, ok := that.(ValidatorSigningInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BridgeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BridgeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.IndexOffset != that1.IndexOffset {
+		return false
+	}
+	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
+		return false
+	}
+	return true
 }
+func (this *BridgeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BridgeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*BridgeInfo)
+	if !ok {
+		that2, ok := that.(BridgeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.L1ClientId) > 0 {
-		i -= len(m.L1ClientId)
-		copy(dAtA[i:], m.L1ClientId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.L1ClientId)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.L1ChainId) > 0 {
-		i -= len(m.L1ChainId)
-		copy(dAtA[i:], m.L1ChainId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.L1ChainId)))
-		i--
-		dAtA[i] = 0x1a
+	if this.BridgeId != that1.BridgeId {
+		return false
 	}
-	if len(m.BridgeAddr) > 0 {
-		i -= len(m.BridgeAddr)
-		copy(dAtA[i:], m.BridgeAddr)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeAddr)))
-		i--
-		dAtA[i] = 0x12
+	if this.BridgeAddr != that1.BridgeAddr {
+		return false
 	}
-	if m.BridgeId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BridgeId))
-		i--
-		dAtA[i] = 0x8
+	if this.L1ChainId != that1.L1ChainId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.L1ClientId != that1.L1ClientId {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if !this.BridgeConfig.Equal(&that1.BridgeConfig) {
+		return false
+	}
+	return true
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *WithdrawalLeaf) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.MaxValidators != 0 {
-		n += 1 + sovTypes(uint64(m.MaxValidators))
+
+	that1, ok := that.(*WithdrawalLeaf)
+	if !ok {
+		that2, ok := that.(WithdrawalLeaf)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovTypes(uint64(m.HistoricalEntries))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.MinGasPrices) > 0 {
-		for _, e := range m.MinGasPrices {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.BaseClassId != that1.BaseClassId {
+		return false
+	}
+	if this.TokenId != that1.TokenId {
+		return false
+	}
+	if this.LeafVersion != that1.LeafVersion {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.L2Denom != that1.L2Denom {
+		return false
+	}
+	return true
+}
+func (this *WithdrawalCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawalCommitment)
+	if !ok {
+		that2, ok := that.(WithdrawalCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.BridgeExecutors) > 0 {
-		for _, s := range m.BridgeExecutors {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.StartSequence != that1.StartSequence {
+		return false
+	}
+	if this.EndSequence != that1.EndSequence {
+		return false
+	}
+	if !bytes.Equal(this.StorageRoot, that1.StorageRoot) {
+		return false
+	}
+	if this.WindowEnd != that1.WindowEnd {
+		return false
+	}
+	if !bytes.Equal(this.StateRoot, that1.StateRoot) {
+		return false
+	}
+	if !bytes.Equal(this.LatestBlockHash, that1.LatestBlockHash) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawalWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawalWindow)
+	if !ok {
+		that2, ok := that.(WithdrawalWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.FeeWhitelist) > 0 {
-		for _, s := range m.FeeWhitelist {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	if this.StartSequence != that1.StartSequence {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if this.NumLeaves != that1.NumLeaves {
+		return false
+	}
+	if len(this.Frontier) != len(that1.Frontier) {
+		return false
+	}
+	for i := range this.Frontier {
+		if !bytes.Equal(this.Frontier[i], that1.Frontier[i]) {
+			return false
 		}
 	}
-	return n
+	if this.NextLeafSequence != that1.NextLeafSequence {
+		return false
+	}
+	return true
 }
-
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *SequenceRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*SequenceRange)
+	if !ok {
+		that2, ok := that.(SequenceRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Start != that1.Start {
+		return false
 	}
-	if m.ConsPower != 0 {
-		n += 1 + sovTypes(uint64(m.ConsPower))
+	if this.End != that1.End {
+		return false
 	}
-	return n
+	return true
 }
+func (this *DepositAttestation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorUpdates) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DepositAttestation)
+	if !ok {
+		that2, ok := that.(DepositAttestation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if len(this.Votes) != len(that1.Votes) {
+		return false
+	}
+	for i := range this.Votes {
+		if !this.Votes[i].Equal(&that1.Votes[i]) {
+			return false
 		}
 	}
-	return n
+	return true
 }
+func (this *DepositAttestationVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BridgeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DepositAttestationVote)
+	if !ok {
+		that2, ok := that.(DepositAttestationVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DepositHash, that1.DepositHash) {
+		return false
+	}
+	if len(this.Attesters) != len(that1.Attesters) {
+		return false
+	}
+	for i := range this.Attesters {
+		if this.Attesters[i] != that1.Attesters[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovTypes(uint64(m.BridgeId))
+	if len(m.L1ClientId) > 0 {
+		i -= len(m.L1ClientId)
+		copy(dAtA[i:], m.L1ClientId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.L1ClientId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	l = len(m.BridgeAddr)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.L2NativeDenoms) > 0 {
+		for iNdEx := len(m.L2NativeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.L2NativeDenoms[iNdEx])
+			copy(dAtA[i:], m.L2NativeDenoms[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.L2NativeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	l = len(m.L1ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.AdminProposalExpiry != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AdminProposalExpiry))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	l = len(m.L1ClientId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.DowntimeJailEnabled {
+		i--
+		if m.DowntimeJailEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.BridgeInfoSetters) > 0 {
+		for iNdEx := len(m.BridgeInfoSetters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BridgeInfoSetters[iNdEx])
+			copy(dAtA[i:], m.BridgeInfoSetters[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeInfoSetters[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OracleRelayers) > 0 {
+		for iNdEx := len(m.OracleRelayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OracleRelayers[iNdEx])
+			copy(dAtA[i:], m.OracleRelayers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.OracleRelayers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DepositRelayers) > 0 {
+		for iNdEx := len(m.DepositRelayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DepositRelayers[iNdEx])
+			copy(dAtA[i:], m.DepositRelayers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DepositRelayers[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AdminThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AdminThreshold))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AdminSigners) > 0 {
+		for iNdEx := len(m.AdminSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdminSigners[iNdEx])
+			copy(dAtA[i:], m.AdminSigners[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AdminSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AllowedExecuteMessages) > 0 {
+		for iNdEx := len(m.AllowedExecuteMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedExecuteMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedExecuteMessages[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedExecuteMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecuteMessagesDelay != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteMessagesDelay))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BridgeInfoProofRequired {
+		i--
+		if m.BridgeInfoProofRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DepositProofRequired {
+		i--
+		if m.DepositProofRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DepositAttestationThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DepositAttestationThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StrictDepositOrdering {
+		i--
+		if m.StrictDepositOrdering {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeWhitelist) > 0 {
+		for iNdEx := len(m.FeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeWhitelist[iNdEx])
+			copy(dAtA[i:], m.FeeWhitelist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BridgeExecutors) > 0 {
+		for iNdEx := len(m.BridgeExecutors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BridgeExecutors[iNdEx])
+			copy(dAtA[i:], m.BridgeExecutors[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeExecutors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ConsPower != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.L1ClientId) > 0 {
+		i -= len(m.L1ClientId)
+		copy(dAtA[i:], m.L1ClientId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.L1ClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.L1ChainId) > 0 {
+		i -= len(m.L1ChainId)
+		copy(dAtA[i:], m.L1ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.L1ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BridgeAddr) > 0 {
+		i -= len(m.BridgeAddr)
+		copy(dAtA[i:], m.BridgeAddr)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L2Denom) > 0 {
+		i -= len(m.L2Denom)
+		copy(dAtA[i:], m.L2Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.L2Denom)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LeafVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeafVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseClassId) > 0 {
+		i -= len(m.BaseClassId)
+		copy(dAtA[i:], m.BaseClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseClassId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LatestBlockHash) > 0 {
+		i -= len(m.LatestBlockHash)
+		copy(dAtA[i:], m.LatestBlockHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LatestBlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WindowEnd {
+		i--
+		if m.WindowEnd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextLeafSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextLeafSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Frontier) > 0 {
+		for iNdEx := len(m.Frontier) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frontier[iNdEx])
+			copy(dAtA[i:], m.Frontier[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Frontier[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NumLeaves != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumLeaves))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.StartSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SequenceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorChangePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorChangePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorChangePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.NextValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NextExecutors) > 0 {
+		for iNdEx := len(m.NextExecutors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextExecutors[iNdEx])
+			copy(dAtA[i:], m.NextExecutors[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.NextExecutors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositAttestationVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositAttestationVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositAttestationVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attesters) > 0 {
+		for iNdEx := len(m.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attesters[iNdEx])
+			copy(dAtA[i:], m.Attesters[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Attesters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DepositHash) > 0 {
+		i -= len(m.DepositHash)
+		copy(dAtA[i:], m.DepositHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DepositHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxValidators != 0 {
+		n += 1 + sovTypes(uint64(m.MaxValidators))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovTypes(uint64(m.HistoricalEntries))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BridgeExecutors) > 0 {
+		for _, s := range m.BridgeExecutors {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.FeeWhitelist) > 0 {
+		for _, s := range m.FeeWhitelist {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.StrictDepositOrdering {
+		n += 2
+	}
+	if m.DepositAttestationThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.DepositAttestationThreshold))
+	}
+	if m.DepositProofRequired {
+		n += 2
+	}
+	if m.BridgeInfoProofRequired {
+		n += 2
+	}
+	if m.ExecuteMessagesDelay != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteMessagesDelay))
+	}
+	if len(m.AllowedExecuteMessages) > 0 {
+		for _, s := range m.AllowedExecuteMessages {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AdminSigners) > 0 {
+		for _, s := range m.AdminSigners {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.AdminThreshold))
+	}
+	if len(m.DepositRelayers) > 0 {
+		for _, s := range m.DepositRelayers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.OracleRelayers) > 0 {
+		for _, s := range m.OracleRelayers {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BridgeInfoSetters) > 0 {
+		for _, s := range m.BridgeInfoSetters {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 2 + sovTypes(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.DowntimeJailEnabled {
+		n += 3
+	}
+	if m.AdminProposalExpiry != 0 {
+		n += 2 + sovTypes(uint64(m.AdminProposalExpiry))
+	}
+	if len(m.L2NativeDenoms) > 0 {
+		for _, s := range m.L2NativeDenoms {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.L1ClientId)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ConsPower != 0 {
+		n += 1 + sovTypes(uint64(m.ConsPower))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovTypes(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovTypes(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovTypes(uint64(m.BridgeId))
+	}
+	l = len(m.BridgeAddr)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.L1ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.L1ClientId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BridgeConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *WithdrawalLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.BaseClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LeafVersion != 0 {
+		n += 1 + sovTypes(uint64(m.LeafVersion))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.L2Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.StartSequence != 0 {
+		n += 1 + sovTypes(uint64(m.StartSequence))
+	}
+	if m.EndSequence != 0 {
+		n += 1 + sovTypes(uint64(m.EndSequence))
+	}
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowEnd {
+		n += 2
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LatestBlockHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSequence != 0 {
+		n += 1 + sovTypes(uint64(m.StartSequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.NumLeaves != 0 {
+		n += 1 + sovTypes(uint64(m.NumLeaves))
+	}
+	if len(m.Frontier) > 0 {
+		for _, b := range m.Frontier {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.NextLeafSequence != 0 {
+		n += 1 + sovTypes(uint64(m.NextLeafSequence))
+	}
+	return n
+}
+
+func (m *SequenceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovTypes(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovTypes(uint64(m.End))
+	}
+	return n
+}
+
+func (m *ExecutorChangePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if len(m.NextExecutors) > 0 {
+		for _, s := range m.NextExecutors {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.NextValidator.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositAttestationVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attesters) > 0 {
+		for _, s := range m.Attesters {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutionHeight))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutionHeight))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeExecutors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeExecutors = append(m.BridgeExecutors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeWhitelist = append(m.FeeWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictDepositOrdering", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictDepositOrdering = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAttestationThreshold", wireType)
+			}
+			m.DepositAttestationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositAttestationThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositProofRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositProofRequired = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfoProofRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeInfoProofRequired = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMessagesDelay", wireType)
+			}
+			m.ExecuteMessagesDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteMessagesDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedExecuteMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedExecuteMessages = append(m.AllowedExecuteMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminSigners = append(m.AdminSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRelayers = append(m.DepositRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleRelayers = append(m.OracleRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInfoSetters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeInfoSetters = append(m.BridgeInfoSetters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeJailEnabled = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposalExpiry", wireType)
+			}
+			m.AdminProposalExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminProposalExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2NativeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2NativeDenoms = append(m.L2NativeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPower", wireType)
+			}
+			m.ConsPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, types2.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalLeaf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalLeaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafVersion", wireType)
+			}
+			m.LeafVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
+			}
+			m.StartSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSequence", wireType)
+			}
+			m.EndSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRoot = append(m.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StorageRoot == nil {
+				m.StorageRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WindowEnd = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestBlockHash = append(m.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LatestBlockHash == nil {
+				m.LatestBlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.BridgeConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *WithdrawalWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -740,17 +4638,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawalWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawalWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
 			}
-			m.MaxValidators = 0
+			m.StartSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -760,16 +4658,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				m.StartSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.HistoricalEntries = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -779,16 +4677,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLeaves", wireType)
+			}
+			m.NumLeaves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLeaves |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frontier", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -798,29 +4729,222 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
-			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Frontier = append(m.Frontier, make([]byte, postIndex-iNdEx))
+			copy(m.Frontier[len(m.Frontier)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLeafSequence", wireType)
+			}
+			m.NextLeafSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLeafSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SequenceRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorChangePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorChangePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorChangePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeExecutors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -848,13 +4972,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeExecutors = append(m.BridgeExecutors, string(dAtA[iNdEx:postIndex]))
+			m.NextExecutors = append(m.NextExecutors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -864,27 +4988,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.NextValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -912,7 +5037,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeWhitelist = append(m.FeeWhitelist, string(dAtA[iNdEx:postIndex]))
+			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -935,7 +5060,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *DepositAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -958,17 +5083,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -978,29 +5103,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1010,29 +5122,81 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Votes = append(m.Votes, DepositAttestationVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositAttestationVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositAttestationVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositAttestationVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1042,33 +5206,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DepositHash = append(m.DepositHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositHash == nil {
+				m.DepositHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsPower", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
 			}
-			m.ConsPower = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1078,11 +5240,24 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsPower |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attesters = append(m.Attesters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1104,7 +5279,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
+func (m *ScheduledMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,15 +5302,85 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1162,8 +5407,8 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types2.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1188,7 +5433,7 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
+func (m *AdminProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,17 +5456,17 @@ func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.BridgeId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1231,14 +5476,14 @@ func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1266,13 +5511,32 @@ func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeAddr = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L1ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1282,27 +5546,29 @@ func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L1ChainId = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L1ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1330,13 +5596,13 @@ func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L1ClientId = string(dAtA[iNdEx:postIndex])
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeConfig", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
-			var msglen int
+			m.ExpirationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1346,25 +5612,11 @@ func (m *BridgeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BridgeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -202,8 +202,12 @@ func (v Validator) ABCIValidatorUpdateZero() abci.ValidatorUpdate {
 }
 
 // ConsensusPower gets the consensus-engine power.
-// Return constant value 1 for all validators
+// Return zero for the jailed validators
 func (v Validator) ConsensusPower() int64 {
+	if v.Jailed {
+		return 0
+	}
+
 	return v.ConsPower
 }
 
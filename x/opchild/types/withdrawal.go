@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ophosttypes "github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// IsNft returns true if the leaf is a nft withdrawal.
+func (leaf WithdrawalLeaf) IsNft() bool {
+	return leaf.TokenId != ""
+}
+
+// ComputeHash returns the leaf hash of the withdrawal, which is proven
+// on l1 with the storage root.
+func (leaf WithdrawalLeaf) ComputeHash(bridgeId uint64, from, to []byte) ([32]byte, error) {
+	if leaf.IsNft() {
+		return ophosttypes.GenerateNftWithdrawalHash(bridgeId, leaf.Sequence, from, to, leaf.BaseClassId, leaf.TokenId), nil
+	}
+
+	return ophosttypes.GenerateWithdrawalHash(byte(leaf.LeafVersion), bridgeId, leaf.Sequence, from, to, leaf.BaseDenom, leaf.Amount)
+}
+
+// NewMsgFinalizeWithdrawal returns the l1 message finalizing the withdrawal
+// of the proof with the output of the output index.
+func NewMsgFinalizeWithdrawal(res *QueryWithdrawalProofResponse, outputIndex uint64) (sdk.Msg, error) {
+	commitment := res.Commitment
+	if len(commitment.StateRoot) == 0 {
+		return nil, ErrWithdrawalNotCommitted.Wrap("state root is not recorded yet")
+	}
+
+	leaf := res.Leaf
+	if leaf.IsNft() {
+		return ophosttypes.NewMsgFinalizeNftWithdrawal(
+			res.BridgeId, outputIndex, leaf.Sequence, res.WithdrawalProofs,
+			leaf.From, leaf.To, leaf.BaseClassId, leaf.TokenId,
+			res.Version, commitment.StateRoot, commitment.StorageRoot, commitment.LatestBlockHash,
+		), nil
+	}
+
+	return ophosttypes.NewMsgFinalizeTokenWithdrawal(
+		res.BridgeId, outputIndex, leaf.Sequence, res.WithdrawalProofs,
+		leaf.From, leaf.To, sdk.NewCoin(leaf.BaseDenom, leaf.Amount),
+		res.Version, commitment.StateRoot, commitment.StorageRoot, commitment.LatestBlockHash,
+	), nil
+}
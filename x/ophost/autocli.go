@@ -73,6 +73,56 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "bridge_id"},
 					},
 				},
+				{
+					RpcMethod: "L2NativeTokenPairs",
+					Use:       "l2_native_token_pairs [bridge-id]",
+					Short:     "Get all the token pairs of the tokens originated from l2",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bridge_id"},
+					},
+				},
+				{
+					RpcMethod: "NftClassPairs",
+					Use:       "nft_class_pairs [bridge-id]",
+					Short:     "Get all the nft class pairs of a bridge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bridge_id"},
+					},
+				},
+				{
+					RpcMethod: "DepositCap",
+					Use:       "deposit_cap [bridge-id] [denom]",
+					Short:     "Get the deposit cap and the locked amount of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bridge_id"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "DepositCaps",
+					Use:       "deposit_caps [bridge-id]",
+					Short:     "Get the deposit caps and the locked amounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bridge_id"},
+					},
+				},
+				{
+					RpcMethod: "BridgeAccounting",
+					Use:       "bridge_accounting [bridge-id] [denom]",
+					Short:     "Get the deposited and withdrawn totals of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bridge_id"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "BridgeAccountings",
+					Use:       "bridge_accountings [bridge-id]",
+					Short:     "Get the deposited and withdrawn totals of all denoms",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bridge_id"},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
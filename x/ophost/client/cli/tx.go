@@ -38,6 +38,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewDeleteOutput(ac),
 		NewInitiateTokenDeposit(ac),
 		NewFinalizeTokenWithdrawal(ac),
+		NewInitiateNftDeposit(ac),
 	)
 
 	return ophostTxCmd
@@ -180,9 +181,9 @@ func NewCreateBridge(ac address.Codec) *cobra.Command {
 // NewProposeOutput returns a CLI command handler for transaction to propose an output.
 func NewProposeOutput(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "propose-output [bridge-id] [l2-block-number] [output-root-hash]",
+		Use:   "propose-output [bridge-id] [l2-block-number] [output-root-hash] [version]",
 		Short: "send a output-proposing tx",
-		Args:  cobra.ExactArgs(3),
+		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -204,12 +205,17 @@ func NewProposeOutput(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			version, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+
 			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgProposeOutput(fromAddr, bridgeId, l2BlockNumber, outputBytes)
+			msg := types.NewMsgProposeOutput(fromAddr, bridgeId, l2BlockNumber, outputBytes, version)
 			if err = msg.Validate(ac); err != nil {
 				return err
 			}
@@ -316,6 +322,48 @@ func NewInitiateTokenDeposit(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewInitiateNftDeposit returns a CLI command handler for transaction to initiate a nft deposit.
+func NewInitiateNftDeposit(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "initiate-nft-deposit [bridge-id] [to] [class-id] [token-id]",
+		Short: "send a nft deposit initiating tx",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bridgeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			toAddr := args[1]
+			_, err = ac.StringToBytes(toAddr)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInitiateNftDeposit(fromAddr, bridgeId, toAddr, args[2], args[3])
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewFinalizeTokenWithdrawal returns a CLI command handler for transaction to finalize token withdrawal.
 func NewFinalizeTokenWithdrawal(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -246,6 +246,7 @@ func (s *CLITestSuite) TestNewProposeOutput() {
 				"0",
 				"1234",
 				"12e297e695e451144fc44db083d6b3d56f0a5f920721e3efc90ec7662c7775d1",
+				"0000000000000000000000000000000000000000000000000000000000000002",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -259,6 +260,7 @@ func (s *CLITestSuite) TestNewProposeOutput() {
 				"1",
 				"-1",
 				"12e297e695e451144fc44db083d6b3d56f0a5f920721e3efc90ec7662c7775d1",
+				"0000000000000000000000000000000000000000000000000000000000000002",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -272,6 +274,21 @@ func (s *CLITestSuite) TestNewProposeOutput() {
 				"1",
 				"1234",
 				"2e297e695e451144fc44db083d6b3d56f0a5f920721e3efc90ec7662c7775d1",
+				"0000000000000000000000000000000000000000000000000000000000000002",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))).String()),
+			},
+			true, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid transaction (invalid version)",
+			[]string{
+				"1",
+				"1234",
+				"12e297e695e451144fc44db083d6b3d56f0a5f920721e3efc90ec7662c7775d1",
+				"01",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -285,6 +302,7 @@ func (s *CLITestSuite) TestNewProposeOutput() {
 				"1",
 				"1234",
 				"12e297e695e451144fc44db083d6b3d56f0a5f920721e3efc90ec7662c7775d1",
+				"0000000000000000000000000000000000000000000000000000000000000002",
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, addr0),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func (k Keeper) SetBridgeAccounting(ctx context.Context, bridgeId uint64, accounting types.BridgeAccounting) error {
+	return k.Accountings.Set(ctx, collections.Join(bridgeId, accounting.Denom), accounting)
+}
+
+// GetBridgeAccounting returns the accounting of the denom. If nothing has
+// flowed through the bridge yet, an accounting with zero totals is returned.
+func (k Keeper) GetBridgeAccounting(ctx context.Context, bridgeId uint64, denom string) (types.BridgeAccounting, error) {
+	accounting, err := k.Accountings.Get(ctx, collections.Join(bridgeId, denom))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.NewBridgeAccounting(denom), nil
+	}
+
+	return accounting, err
+}
+
+func (k Keeper) IterateBridgeAccountings(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(bridgeId uint64, accounting types.BridgeAccounting) (stop bool, err error),
+) error {
+	return k.Accountings.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](bridgeId), func(key collections.Pair[uint64, string], accounting types.BridgeAccounting) (stop bool, err error) {
+		return cb(key.K1(), accounting)
+	})
+}
+
+// RecordDeposit adds the deposited l1 native coin to the bridge accounting.
+func (k Keeper) RecordDeposit(ctx context.Context, bridgeId uint64, coin sdk.Coin) error {
+	accounting, err := k.GetBridgeAccounting(ctx, bridgeId, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	accounting.DepositedAmount = accounting.DepositedAmount.Add(coin.Amount)
+	accounting.DepositCount++
+
+	return k.SetBridgeAccounting(ctx, bridgeId, accounting)
+}
+
+// RecordWithdrawal adds the withdrawn l1 native coin to the bridge accounting.
+func (k Keeper) RecordWithdrawal(ctx context.Context, bridgeId uint64, coin sdk.Coin) error {
+	accounting, err := k.GetBridgeAccounting(ctx, bridgeId, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	accounting.WithdrawnAmount = accounting.WithdrawnAmount.Add(coin.Amount)
+	accounting.WithdrawalCount++
+
+	return k.SetBridgeAccounting(ctx, bridgeId, accounting)
+}
+
+// RecordBurn adds the burned l1 representation of the token originated from
+// l2 to the bridge accounting.
+func (k Keeper) RecordBurn(ctx context.Context, bridgeId uint64, coin sdk.Coin) error {
+	accounting, err := k.GetBridgeAccounting(ctx, bridgeId, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	accounting.BurnedAmount = accounting.BurnedAmount.Add(coin.Amount)
+	accounting.DepositCount++
+	if accounting.MintedSupply().IsNegative() {
+		return types.ErrInvalidBridgeAccounting.Wrapf("burned amount exceeds minted amount of %s", coin.Denom)
+	}
+
+	return k.SetBridgeAccounting(ctx, bridgeId, accounting)
+}
+
+// RecordMint adds the minted l1 representation of the token originated from
+// l2 to the bridge accounting.
+func (k Keeper) RecordMint(ctx context.Context, bridgeId uint64, coin sdk.Coin) error {
+	accounting, err := k.GetBridgeAccounting(ctx, bridgeId, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	accounting.MintedAmount = accounting.MintedAmount.Add(coin.Amount)
+	accounting.WithdrawalCount++
+
+	return k.SetBridgeAccounting(ctx, bridgeId, accounting)
+}
+
+// GetBridgeSurplus returns the amount of the denom held by the bridge account
+// on top of the tracked locked amount, i.e. funds which were sent directly to
+// the bridge account.
+func (k Keeper) GetBridgeSurplus(ctx context.Context, bridgeId uint64, denom string) (math.Int, error) {
+	accounting, err := k.GetBridgeAccounting(ctx, bridgeId, denom)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	locked := accounting.LockedAmount()
+	if locked.IsNegative() {
+		return math.ZeroInt(), types.ErrInvalidBridgeAccounting.Wrapf("withdrawn amount exceeds deposited amount of %s", denom)
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, types.BridgeAddress(bridgeId), denom).Amount
+	if balance.LTE(locked) {
+		return math.ZeroInt(), nil
+	}
+
+	return balance.Sub(locked), nil
+}
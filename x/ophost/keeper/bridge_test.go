@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
+
 	"github.com/initia-labs/OPinit/x/ophost/types"
 	"github.com/stretchr/testify/require"
 )
@@ -23,6 +25,11 @@ func Test_BridgeConfig(t *testing.T) {
 	_config, err := input.OPHostKeeper.GetBridgeConfig(ctx, 1)
 	require.NoError(t, err)
 	require.Equal(t, config, _config)
+
+	// the proof path must match the store key of the bridge config
+	key, err := collections.EncodeKeyWithPrefix(types.BridgeConfigPrefix, input.OPHostKeeper.BridgeConfigs.KeyCodec(), 1)
+	require.NoError(t, err)
+	require.Equal(t, types.BridgeConfigKey(1), key)
 }
 
 func Test_IterateBridgeConfig(t *testing.T) {
@@ -18,6 +18,8 @@ import (
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/tx/signing"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -186,6 +188,7 @@ func (f *TestFaucet) NewFundedAccount(ctx sdk.Context, amounts ...sdk.Coin) sdk.
 type TestKeepers struct {
 	AccountKeeper       authkeeper.AccountKeeper
 	BankKeeper          bankkeeper.Keeper
+	NftKeeper           nftkeeper.Keeper
 	OPHostKeeper        ophostkeeper.Keeper
 	CommunityPoolKeeper *MockCommunityPoolKeeper
 	BridgeHook          *bridgeHook
@@ -226,7 +229,7 @@ func _createTestInput(
 	db dbm.DB,
 ) (sdk.Context, TestKeepers) {
 	keys := storetypes.NewKVStoreKeys(
-		authtypes.StoreKey, banktypes.StoreKey, ophosttypes.StoreKey,
+		authtypes.StoreKey, banktypes.StoreKey, nft.StoreKey, ophosttypes.StoreKey,
 	)
 	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	for _, v := range keys {
@@ -253,6 +256,7 @@ func _createTestInput(
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		ophosttypes.ModuleName:         {authtypes.Burner, authtypes.Minter},
+		nft.ModuleName:                 nil,
 
 		// for testing
 		authtypes.Minter: {authtypes.Minter, authtypes.Burner},
@@ -281,6 +285,13 @@ func _createTestInput(
 	)
 	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
 
+	nftKeeper := nftkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[nft.StoreKey]),
+		appCodec,
+		accountKeeper,
+		bankKeeper,
+	)
+
 	msgRouter := baseapp.NewMsgServiceRouter()
 	msgRouter.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
 
@@ -291,6 +302,7 @@ func _createTestInput(
 		runtime.NewKVStoreService(keys[ophosttypes.StoreKey]),
 		accountKeeper,
 		bankKeeper,
+		nftKeeper,
 		communityPoolKeeper,
 		bridgeHook,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -307,6 +319,7 @@ func _createTestInput(
 	keepers := TestKeepers{
 		AccountKeeper:       accountKeeper,
 		BankKeeper:          bankKeeper,
+		NftKeeper:           nftKeeper,
 		OPHostKeeper:        *ophostKeeper,
 		CommunityPoolKeeper: communityPoolKeeper,
 		BridgeHook:          bridgeHook,
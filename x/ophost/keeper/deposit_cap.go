@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func (k Keeper) SetDepositCap(ctx context.Context, bridgeId uint64, depositCap types.DepositCap) error {
+	return k.DepositCaps.Set(ctx, collections.Join(bridgeId, depositCap.Denom), depositCap)
+}
+
+func (k Keeper) DeleteDepositCap(ctx context.Context, bridgeId uint64, denom string) error {
+	return k.DepositCaps.Remove(ctx, collections.Join(bridgeId, denom))
+}
+
+// GetDepositCap returns the deposit cap of the denom. If no cap is registered,
+// an empty cap which does not limit deposits is returned.
+func (k Keeper) GetDepositCap(ctx context.Context, bridgeId uint64, denom string) (types.DepositCap, error) {
+	depositCap, err := k.DepositCaps.Get(ctx, collections.Join(bridgeId, denom))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.NewDepositCap(denom, math.ZeroInt(), math.ZeroInt()), nil
+	}
+
+	return depositCap, err
+}
+
+func (k Keeper) IterateDepositCaps(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(bridgeId uint64, depositCap types.DepositCap) (stop bool, err error),
+) error {
+	return k.DepositCaps.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](bridgeId), func(key collections.Pair[uint64, string], depositCap types.DepositCap) (stop bool, err error) {
+		return cb(key.K1(), depositCap)
+	})
+}
+
+// CheckDepositCap checks the deposit against the cap of the denom with the
+// current bridge account balance.
+func (k Keeper) CheckDepositCap(ctx context.Context, bridgeId uint64, coin sdk.Coin) error {
+	depositCap, err := k.GetDepositCap(ctx, bridgeId, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if depositCap.IsEmpty() {
+		return nil
+	}
+
+	locked := k.bankKeeper.GetBalance(ctx, types.BridgeAddress(bridgeId), coin.Denom)
+	return depositCap.CheckDeposit(locked.Amount, coin.Amount)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// SetDepositCommitment stores the commitment of the deposit, which is proven
+// on l2 against the l1 state, with the l1 block time of the deposit.
+func (k Keeper) SetDepositCommitment(ctx context.Context, bridgeId, l1Sequence uint64, commitment []byte, depositTime time.Time) error {
+	if err := k.DepositCommitments.Set(ctx, collections.Join(bridgeId, l1Sequence), commitment); err != nil {
+		return err
+	}
+
+	return k.DepositCommitmentTimes.Set(ctx, collections.Join3(bridgeId, depositTime, l1Sequence))
+}
+
+// GetDepositCommitment returns the commitment of the deposit.
+func (k Keeper) GetDepositCommitment(ctx context.Context, bridgeId, l1Sequence uint64) ([]byte, error) {
+	return k.DepositCommitments.Get(ctx, collections.Join(bridgeId, l1Sequence))
+}
+
+// PruneDepositCommitments removes the deposit commitments of the bridge
+// older than the deposit commitment retention.
+func (k Keeper) PruneDepositCommitments(ctx context.Context, bridgeId uint64) error {
+	retention := k.GetParams(ctx).DepositCommitmentRetention
+	if retention == 0 {
+		return nil
+	}
+
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-retention)
+
+	var keys []collections.Triple[uint64, time.Time, uint64]
+	if err := k.DepositCommitmentTimes.Walk(ctx, collections.NewPrefixedTripleRange[uint64, time.Time, uint64](bridgeId), func(key collections.Triple[uint64, time.Time, uint64]) (stop bool, err error) {
+		if !key.K2().Before(cutoff) {
+			return true, nil
+		}
+
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.DepositCommitments.Remove(ctx, collections.Join(bridgeId, key.K3())); err != nil {
+			return err
+		}
+		if err := k.DepositCommitmentTimes.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IterateDepositCommitments iterates the deposit commitments of the bridge
+// in the order of their deposit time.
+func (k Keeper) IterateDepositCommitments(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(bridgeId uint64, commitment types.DepositCommitment) (stop bool, err error),
+) error {
+	return k.DepositCommitmentTimes.Walk(ctx, collections.NewPrefixedTripleRange[uint64, time.Time, uint64](bridgeId), func(key collections.Triple[uint64, time.Time, uint64]) (stop bool, err error) {
+		commitment, err := k.DepositCommitments.Get(ctx, collections.Join(bridgeId, key.K3()))
+		if err != nil {
+			return true, err
+		}
+
+		return cb(bridgeId, types.DepositCommitment{
+			Sequence:   key.K3(),
+			Commitment: commitment,
+			Time:       key.K2(),
+		})
+	})
+}
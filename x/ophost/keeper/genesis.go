@@ -34,7 +34,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		for _, provenWithdrawal := range bridge.ProvenWithdrawals {
 			withdrawalHash := [32]byte{}
 			copy(withdrawalHash[:], provenWithdrawal)
-			if err := k.RecordProvenWithdrawal(ctx, bridgeId, withdrawalHash); err != nil {
+			if err := k.RecordLegacyProvenWithdrawal(ctx, bridgeId, withdrawalHash); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, bitmap := range bridge.ProvenWithdrawalBitmaps {
+			if err := k.SetProvenBitmap(ctx, bridgeId, bitmap); err != nil {
 				panic(err)
 			}
 		}
@@ -50,6 +56,36 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 				panic(err)
 			}
 		}
+
+		for _, depositCap := range bridge.DepositCaps {
+			if err := k.SetDepositCap(ctx, bridgeId, depositCap); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, tokenPair := range bridge.L2NativeTokenPairs {
+			if err := k.SetL2NativeTokenPair(ctx, bridgeId, tokenPair.L1Denom, tokenPair.L2Denom); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, accounting := range bridge.Accountings {
+			if err := k.SetBridgeAccounting(ctx, bridgeId, accounting); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, classPair := range bridge.NftClassPairs {
+			if err := k.SetNftClassPair(ctx, bridgeId, classPair.L2ClassId, classPair.L1ClassId); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, commitment := range bridge.DepositCommitments {
+			if err := k.SetDepositCommitment(ctx, bridgeId, commitment.Sequence, commitment.Commitment, commitment.Time); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	if err := k.SetNextBridgeId(ctx, data.NextBridgeId); err != nil {
@@ -87,13 +123,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 
 		var provenWithdrawals [][]byte
-		if err := k.IterateProvenWithdrawals(ctx, bridgeId, func(bridgeId uint64, withdrawalHash [32]byte) (bool, error) {
+		if err := k.IterateLegacyProvenWithdrawals(ctx, bridgeId, func(bridgeId uint64, withdrawalHash [32]byte) (bool, error) {
 			provenWithdrawals = append(provenWithdrawals, withdrawalHash[:])
 			return false, nil
 		}); err != nil {
 			return true, err
 		}
 
+		var provenBitmaps []types.ProvenWithdrawalBitmap
+		if err := k.IterateProvenBitmaps(ctx, bridgeId, func(bridgeId uint64, bitmap types.ProvenWithdrawalBitmap) (bool, error) {
+			provenBitmaps = append(provenBitmaps, bitmap)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
 		var tokenPairs []types.TokenPair
 		if err := k.IterateTokenPair(ctx, bridgeId, func(bridgeId uint64, tokenPair types.TokenPair) (stop bool, err error) {
 			tokenPairs = append(tokenPairs, tokenPair)
@@ -110,15 +154,61 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			return true, err
 		}
 
+		var depositCaps []types.DepositCap
+		if err := k.IterateDepositCaps(ctx, bridgeId, func(bridgeId uint64, depositCap types.DepositCap) (stop bool, err error) {
+			depositCaps = append(depositCaps, depositCap)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
+		var l2NativeTokenPairs []types.TokenPair
+		if err := k.IterateL2NativeTokenPair(ctx, bridgeId, func(bridgeId uint64, tokenPair types.TokenPair) (stop bool, err error) {
+			l2NativeTokenPairs = append(l2NativeTokenPairs, tokenPair)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
+		var nftClassPairs []types.NftClassPair
+		if err := k.IterateNftClassPairs(ctx, bridgeId, func(bridgeId uint64, classPair types.NftClassPair) (stop bool, err error) {
+			nftClassPairs = append(nftClassPairs, classPair)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
+		var accountings []types.BridgeAccounting
+		if err := k.IterateBridgeAccountings(ctx, bridgeId, func(bridgeId uint64, accounting types.BridgeAccounting) (stop bool, err error) {
+			accountings = append(accountings, accounting)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
+		var depositCommitments []types.DepositCommitment
+		if err := k.IterateDepositCommitments(ctx, bridgeId, func(bridgeId uint64, commitment types.DepositCommitment) (stop bool, err error) {
+			depositCommitments = append(depositCommitments, commitment)
+			return false, nil
+		}); err != nil {
+			return true, err
+		}
+
 		bridges = append(bridges, types.Bridge{
-			BridgeId:          bridgeId,
-			NextL1Sequence:    nextL1Sequence,
-			NextOutputIndex:   nextOutputIndex,
-			BridgeConfig:      bridgeConfig,
-			TokenPairs:        tokenPairs,
-			ProvenWithdrawals: provenWithdrawals,
-			Proposals:         proposals,
-			BatchInfos:        batchInfos,
+			BridgeId:                bridgeId,
+			NextL1Sequence:          nextL1Sequence,
+			NextOutputIndex:         nextOutputIndex,
+			BridgeConfig:            bridgeConfig,
+			TokenPairs:              tokenPairs,
+			ProvenWithdrawals:       provenWithdrawals,
+			ProvenWithdrawalBitmaps: provenBitmaps,
+			Proposals:               proposals,
+			BatchInfos:              batchInfos,
+			DepositCaps:             depositCaps,
+			Accountings:             accountings,
+			L2NativeTokenPairs:      l2NativeTokenPairs,
+			NftClassPairs:           nftClassPairs,
+			DepositCommitments:      depositCommitments,
 		})
 
 		return false, nil
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/initia-labs/OPinit/x/ophost/types"
 	"github.com/stretchr/testify/require"
 )
@@ -61,8 +63,10 @@ func Test_GenesisExport(t *testing.T) {
 	input.OPHostKeeper.SetTokenPair(ctx, 1, "l2denom", "l1denom")
 	input.OPHostKeeper.SetTokenPair(ctx, 2, "l12denom", "l11denom")
 
-	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, [32]byte{1, 2, 3})
-	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, [32]byte{3, 4, 5})
+	input.OPHostKeeper.RecordLegacyProvenWithdrawal(ctx, 1, [32]byte{1, 2, 3})
+	input.OPHostKeeper.RecordLegacyProvenWithdrawal(ctx, 1, [32]byte{3, 4, 5})
+	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, 3)
+	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, 257)
 
 	input.OPHostKeeper.SetBatchInfo(ctx, 1, types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"}, types.Output{})
 	input.OPHostKeeper.SetBatchInfo(ctx, 1, types.BatchInfo{Submitter: addrsStr[1], Chain: "ll1"}, output1)
@@ -96,6 +100,10 @@ func Test_GenesisExport(t *testing.T) {
 			{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 			{3, 4, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 		},
+		ProvenWithdrawalBitmaps: []types.ProvenWithdrawalBitmap{
+			{Index: 0, Bitmap: []byte{0x08, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+			{Index: 1, Bitmap: []byte{0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		},
 		BatchInfos: []types.BatchInfoWithOutput{
 			{types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"}, types.Output{}},
 			{types.BatchInfo{Submitter: addrsStr[1], Chain: "ll1"}, output1},
@@ -160,11 +168,52 @@ func Test_GenesisImportExport(t *testing.T) {
 					{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 					{3, 4, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 				},
+				ProvenWithdrawalBitmaps: []types.ProvenWithdrawalBitmap{
+					{Index: 2, Bitmap: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80}},
+				},
 				BatchInfos: []types.BatchInfoWithOutput{
 					{types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"}, types.Output{}},
 					{types.BatchInfo{Submitter: addrsStr[1], Chain: "ll1"}, output1},
 					{types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"}, output3},
 				},
+				DepositCaps: []types.DepositCap{
+					types.NewDepositCap("l1denom", math.NewInt(1_000_000), math.NewInt(1_000)),
+				},
+				L2NativeTokenPairs: []types.TokenPair{
+					{
+						L1Denom: types.L1Denom(1, "umin"),
+						L2Denom: "umin",
+					},
+				},
+				NftClassPairs: []types.NftClassPair{
+					{
+						L1ClassId: "kitty",
+						L2ClassId: types.L2ClassId(1, "kitty"),
+					},
+				},
+				Accountings: []types.BridgeAccounting{
+					{
+						Denom:           "l1denom",
+						DepositedAmount: math.NewInt(1_000),
+						WithdrawnAmount: math.NewInt(100),
+						DepositCount:    3,
+						WithdrawalCount: 1,
+						MintedAmount:    math.ZeroInt(),
+						BurnedAmount:    math.ZeroInt(),
+					},
+				},
+				DepositCommitments: []types.DepositCommitment{
+					{
+						Sequence:   2,
+						Commitment: bytes.Repeat([]byte{2}, 32),
+						Time:       time.Unix(100, 0).UTC(),
+					},
+					{
+						Sequence:   1,
+						Commitment: bytes.Repeat([]byte{1}, 32),
+						Time:       time.Unix(200, 0).UTC(),
+					},
+				},
 			}},
 		NextBridgeId: 2,
 	}
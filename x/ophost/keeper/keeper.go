@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
@@ -19,6 +20,7 @@ type Keeper struct {
 
 	authKeeper          types.AccountKeeper
 	bankKeeper          types.BankKeeper
+	nftKeeper           types.NftKeeper
 	bridgeHook          types.BridgeHook
 	communityPoolKeeper types.CommunityPoolKeeper
 
@@ -35,7 +37,22 @@ type Keeper struct {
 	TokenPairs        collections.Map[collections.Pair[uint64, string], string]
 	OutputProposals   collections.Map[collections.Pair[uint64, uint64], types.Output]
 	NextOutputIndexes collections.Map[uint64, uint64]
+	// (bridge id, withdrawal hash) => true; the withdrawals proven before
+	// the bitmaps are introduced.
 	ProvenWithdrawals collections.Map[collections.Pair[uint64, []byte], bool]
+	// (bridge id, l2 sequence / 256) => proven flags of the 256 l2 sequences
+	ProvenBitmaps collections.Map[collections.Pair[uint64, uint64], []byte]
+	DepositCaps   collections.Map[collections.Pair[uint64, string], types.DepositCap]
+	Accountings   collections.Map[collections.Pair[uint64, string], types.BridgeAccounting]
+	// (bridge id, l1 representation denom) => l2 native denom
+	L2NativeTokenPairs collections.Map[collections.Pair[uint64, string], string]
+	// (bridge id, l2 class id) => l1 class id
+	NftClassPairs collections.Map[collections.Pair[uint64, string], string]
+	// (bridge id, l1 sequence) => deposit commitment
+	DepositCommitments collections.Map[collections.Pair[uint64, uint64], []byte]
+	// (bridge id, deposit time, l1 sequence); the retention index of the
+	// deposit commitments.
+	DepositCommitmentTimes collections.KeySet[collections.Triple[uint64, time.Time, uint64]]
 }
 
 func NewKeeper(
@@ -43,6 +60,7 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	nk types.NftKeeper,
 	ck types.CommunityPoolKeeper,
 	bridgeHook types.BridgeHook,
 	authority string,
@@ -60,20 +78,29 @@ func NewKeeper(
 
 		authKeeper:          ak,
 		bankKeeper:          bk,
+		nftKeeper:           nk,
 		communityPoolKeeper: ck,
 
 		bridgeHook: bridgeHook,
 		authority:  authority,
 
-		NextBridgeId:      collections.NewSequence(sb, types.NextBridgeIdKey, "next_bridge_id"),
-		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		BridgeConfigs:     collections.NewMap(sb, types.BridgeConfigPrefix, "bridge_configs", collections.Uint64Key, codec.CollValue[types.BridgeConfig](cdc)),
-		BatchInfos:        collections.NewMap(sb, types.BatchInfoPrefix, "batch_infos", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.BatchInfoWithOutput](cdc)),
-		NextL1Sequences:   collections.NewMap(sb, types.NextL1SequencePrefix, "next_l1_sequences", collections.Uint64Key, collections.Uint64Value),
-		TokenPairs:        collections.NewMap(sb, types.TokenPairPrefix, "token_pairs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
-		OutputProposals:   collections.NewMap(sb, types.OutputProposalPrefix, "output_proposals", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Output](cdc)),
-		NextOutputIndexes: collections.NewMap(sb, types.NextOutputIndexPrefix, "next_output_indexes", collections.Uint64Key, collections.Uint64Value),
-		ProvenWithdrawals: collections.NewMap(sb, types.ProvenWithdrawalPrefix, "proven_withdrawals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BoolValue),
+		NextBridgeId:       collections.NewSequence(sb, types.NextBridgeIdKey, "next_bridge_id"),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BridgeConfigs:      collections.NewMap(sb, types.BridgeConfigPrefix, "bridge_configs", collections.Uint64Key, codec.CollValue[types.BridgeConfig](cdc)),
+		BatchInfos:         collections.NewMap(sb, types.BatchInfoPrefix, "batch_infos", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.BatchInfoWithOutput](cdc)),
+		NextL1Sequences:    collections.NewMap(sb, types.NextL1SequencePrefix, "next_l1_sequences", collections.Uint64Key, collections.Uint64Value),
+		TokenPairs:         collections.NewMap(sb, types.TokenPairPrefix, "token_pairs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
+		OutputProposals:    collections.NewMap(sb, types.OutputProposalPrefix, "output_proposals", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Output](cdc)),
+		NextOutputIndexes:  collections.NewMap(sb, types.NextOutputIndexPrefix, "next_output_indexes", collections.Uint64Key, collections.Uint64Value),
+		ProvenWithdrawals:  collections.NewMap(sb, types.ProvenWithdrawalPrefix, "proven_withdrawals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BoolValue),
+		ProvenBitmaps:      collections.NewMap(sb, types.ProvenBitmapPrefix, "proven_bitmaps", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.BytesValue),
+		DepositCaps:        collections.NewMap(sb, types.DepositCapPrefix, "deposit_caps", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.DepositCap](cdc)),
+		Accountings:        collections.NewMap(sb, types.BridgeAccountingPrefix, "accountings", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.BridgeAccounting](cdc)),
+		L2NativeTokenPairs: collections.NewMap(sb, types.L2NativeTokenPairPrefix, "l2_native_token_pairs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
+		NftClassPairs:      collections.NewMap(sb, types.NftClassPairPrefix, "nft_class_pairs", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.StringValue),
+		DepositCommitments: collections.NewMap(sb, types.DepositCommitmentPrefix, "deposit_commitments", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.BytesValue),
+
+		DepositCommitmentTimes: collections.NewKeySet(sb, types.DepositCommitmentTimePrefix, "deposit_commitment_times", collections.TripleKeyCodec(collections.Uint64Key, sdk.TimeKey, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
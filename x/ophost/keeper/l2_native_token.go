@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func (k Keeper) SetL2NativeTokenPair(ctx context.Context, bridgeId uint64, l1Denom, l2Denom string) error {
+	return k.L2NativeTokenPairs.Set(ctx, collections.Join(bridgeId, l1Denom), l2Denom)
+}
+
+// GetL2NativeTokenPair returns the l2 native denom of the l1 representation denom.
+// The returned bool is false if the denom is not a representation of a token
+// originated from l2.
+func (k Keeper) GetL2NativeTokenPair(ctx context.Context, bridgeId uint64, l1Denom string) (string, bool, error) {
+	l2Denom, err := k.L2NativeTokenPairs.Get(ctx, collections.Join(bridgeId, l1Denom))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return l2Denom, true, nil
+}
+
+func (k Keeper) IterateL2NativeTokenPair(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(bridgeId uint64, tokenPair types.TokenPair) (stop bool, err error),
+) error {
+	return k.L2NativeTokenPairs.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](bridgeId), func(key collections.Pair[uint64, string], l2Denom string) (stop bool, err error) {
+		return cb(key.K1(), types.TokenPair{
+			L1Denom: key.K2(),
+			L2Denom: l2Denom,
+		})
+	})
+}
+
+// RegisterL2NativeToken registers the l1 representation denom of a token
+// originated from l2 with its denom metadata.
+func (k Keeper) RegisterL2NativeToken(ctx context.Context, bridgeId uint64, l2Denom string, metadata banktypes.Metadata) error {
+	if err := k.SetL2NativeTokenPair(ctx, bridgeId, metadata.Base, l2Denom); err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
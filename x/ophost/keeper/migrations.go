@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 initializes the bridge accountings from the current bridge
+// balances and sets the deposit commitment retention. The funds locked
+// before the accounting was introduced are treated as bridged collateral,
+// so they never show up as surplus.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.DepositCommitmentRetention = types.DefaultDepositCommitmentRetention
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	var bridgeIds []uint64
+	if err := m.keeper.IterateBridgeConfig(ctx, func(bridgeId uint64, _ types.BridgeConfig) (stop bool, err error) {
+		bridgeIds = append(bridgeIds, bridgeId)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, bridgeId := range bridgeIds {
+		balances := m.keeper.bankKeeper.GetAllBalances(ctx, types.BridgeAddress(bridgeId))
+		for _, balance := range balances {
+			if found, err := m.keeper.Accountings.Has(ctx, collections.Join(bridgeId, balance.Denom)); err != nil {
+				return err
+			} else if found {
+				continue
+			}
+
+			accounting := types.NewBridgeAccounting(balance.Denom)
+			accounting.DepositedAmount = balance.Amount
+			if err := m.keeper.SetBridgeAccounting(ctx, bridgeId, accounting); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
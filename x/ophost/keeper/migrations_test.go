@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/ophost/keeper"
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func Test_Migrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	// funds locked before the accounting was introduced
+	input.Faucet.Fund(ctx, types.BridgeAddress(1), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+
+	// already tracked denom is left untouched
+	tracked := sdk.NewCoin("utracked", math.NewInt(50))
+	input.Faucet.Fund(ctx, types.BridgeAddress(1), tracked)
+	require.NoError(t, input.OPHostKeeper.RecordDeposit(ctx, 1, sdk.NewCoin(tracked.Denom, math.NewInt(40))))
+
+	surplus, err := input.OPHostKeeper.GetBridgeSurplus(ctx, 1, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), surplus)
+
+	// the deposit commitment retention is added in version 2
+	params := input.OPHostKeeper.GetParams(ctx)
+	params.DepositCommitmentRetention = 0
+	require.NoError(t, input.OPHostKeeper.SetParams(ctx, params))
+
+	m := keeper.NewMigrator(input.OPHostKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.Equal(t, types.DefaultDepositCommitmentRetention, input.OPHostKeeper.GetParams(ctx).DepositCommitmentRetention)
+
+	accounting, err := input.OPHostKeeper.GetBridgeAccounting(ctx, 1, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), accounting.LockedAmount())
+
+	surplus, err = input.OPHostKeeper.GetBridgeSurplus(ctx, 1, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.True(t, surplus.IsZero())
+
+	surplus, err = input.OPHostKeeper.GetBridgeSurplus(ctx, 1, tracked.Denom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10), surplus)
+}
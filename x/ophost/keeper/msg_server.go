@@ -1,16 +1,12 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
 	"encoding/hex"
 	"slices"
 	"strconv"
 	"strings"
 
-	"golang.org/x/crypto/sha3"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -153,6 +149,7 @@ func (ms MsgServer) ProposeOutput(ctx context.Context, req *types.MsgProposeOutp
 		OutputRoot:    outputRoot,
 		L1BlockTime:   sdkCtx.BlockTime(),
 		L2BlockNumber: l2BlockNumber,
+		Version:       req.Version,
 	}); err != nil {
 		return nil, err
 	}
@@ -233,29 +230,90 @@ func (ms MsgServer) InitiateTokenDeposit(ctx context.Context, req *types.MsgInit
 
 	coin := req.Amount
 	bridgeId := req.BridgeId
+
+	// deposit cap check
+	if err := ms.CheckDepositCap(ctx, bridgeId, coin); err != nil {
+		return nil, err
+	}
+
 	l1Sequence, err := ms.IncreaseNextL1Sequence(ctx, bridgeId)
 	if err != nil {
 		return nil, err
 	}
 
-	// send the funds to bridge address
-	bridgeAddr := types.BridgeAddress(bridgeId)
-	if err := ms.bankKeeper.SendCoins(ctx, sender, bridgeAddr, sdk.NewCoins(coin)); err != nil {
+	l2Denom, isL2Native, err := ms.GetL2NativeTokenPair(ctx, bridgeId, coin.Denom)
+	if err != nil {
 		return nil, err
 	}
 
-	// record token pairs
-	l2Denom := types.L2Denom(bridgeId, coin.Denom)
-	if ok, err := ms.HasTokenPair(ctx, bridgeId, l2Denom); err != nil {
-		return nil, err
-	} else if !ok {
-		if err := ms.SetTokenPair(ctx, bridgeId, l2Denom, coin.Denom); err != nil {
+	var metadataAttr sdk.Attribute
+	var metadataBz []byte
+	if isL2Native {
+		// burn the l1 representation of the token originated from l2
+		if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return nil, err
+		}
+		if err := ms.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 			return nil, err
 		}
+	} else {
+		// send the funds to bridge address
+		bridgeAddr := types.BridgeAddress(bridgeId)
+		if err := ms.bankKeeper.SendCoins(ctx, sender, bridgeAddr, sdk.NewCoins(coin)); err != nil {
+			return nil, err
+		}
+
+		// record token pairs
+		l2Denom = types.L2Denom(bridgeId, coin.Denom)
+		if ok, err := ms.HasTokenPair(ctx, bridgeId, l2Denom); err != nil {
+			return nil, err
+		} else if !ok {
+			if err := ms.SetTokenPair(ctx, bridgeId, l2Denom, coin.Denom); err != nil {
+				return nil, err
+			}
+
+			// include the denom metadata in the first deposit of the denom
+			if metadata, found := ms.bankKeeper.GetDenomMetaData(ctx, coin.Denom); found {
+				bz, err := ms.cdc.MarshalJSON(&metadata)
+				if err != nil {
+					return nil, err
+				}
+
+				metadataAttr = sdk.NewAttribute(types.AttributeKeyMetadata, string(bz))
+
+				metadataBz, err = ms.cdc.Marshal(&metadata)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// record deposit totals
+	if isL2Native {
+		if err := ms.RecordBurn(ctx, bridgeId, coin); err != nil {
+			return nil, err
+		}
+	} else if err := ms.RecordDeposit(ctx, bridgeId, coin); err != nil {
+		return nil, err
+	}
+
+	// commit the deposit to be proven on l2
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	depositHash := types.GenerateTokenDepositHash(
+		bridgeId, l1Sequence, uint64(sdkCtx.BlockHeight()),
+		req.Sender, req.To, coin.Denom, l2Denom, coin.Amount,
+		req.Data, metadataBz,
+	)
+	if err := ms.SetDepositCommitment(ctx, bridgeId, l1Sequence, depositHash[:], sdkCtx.BlockTime()); err != nil {
+		return nil, err
+	}
+	if err := ms.PruneDepositCommitments(ctx, bridgeId); err != nil {
+		return nil, err
 	}
 
 	// emit events for bridge executor
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+	event := sdk.NewEvent(
 		types.EventTypeInitiateTokenDeposit,
 		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
 		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(l1Sequence, 10)),
@@ -265,7 +323,11 @@ func (ms MsgServer) InitiateTokenDeposit(ctx context.Context, req *types.MsgInit
 		sdk.NewAttribute(types.AttributeKeyL2Denom, l2Denom),
 		sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
 		sdk.NewAttribute(types.AttributeKeyData, hex.EncodeToString(req.Data)),
-	))
+	)
+	if metadataAttr.Key != "" {
+		event = event.AppendAttributes(metadataAttr)
+	}
+	sdkCtx.EventManager().EmitEvent(event)
 
 	return &types.MsgInitiateTokenDepositResponse{}, nil
 }
@@ -290,86 +352,58 @@ func (ms MsgServer) FinalizeTokenWithdrawal(ctx context.Context, req *types.MsgF
 	amount := req.Amount.Amount
 	denom := req.Amount.Denom
 
-	if ok, err := ms.IsFinalized(ctx, bridgeId, outputIndex); err != nil {
+	// the leaf encoding is selected by the output root version
+	scheme, err := ms.getOutputRootScheme(ctx, bridgeId, outputIndex, req.Version)
+	if err != nil {
 		return nil, err
-	} else if !ok {
-		return nil, types.ErrNotFinalized
 	}
 
-	outputProposal, err := ms.GetOutputProposal(ctx, bridgeId, outputIndex)
+	withdrawalHash, err := types.GenerateWithdrawalHash(scheme.WithdrawalLeafVersion, bridgeId, l2Sequence, sender, receiver, denom, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	// validate output root generation
-	{
-		seed := make([]byte, 32*4)
-		copy(seed, req.Version)
-		copy(seed[32:], req.StateRoot)
-		copy(seed[64:], req.StorageRoot)
-		copy(seed[96:], req.LatestBlockHash)
-		outputRoot := sha3.Sum256(seed)
+	// the l1 representation of a token originated from l2 must be
+	// registered before its withdrawals are finalized
+	l2Denom, isL2Native, err := ms.GetL2NativeTokenPair(ctx, bridgeId, denom)
+	if err != nil {
+		return nil, err
+	} else if !isL2Native && strings.HasPrefix(denom, types.L1_DENOM_PREFIX) {
+		return nil, types.ErrL2NativeTokenNotRegistered.Wrap(denom)
+	}
 
-		if !bytes.Equal(outputProposal.OutputRoot, outputRoot[:]) {
-			return nil, types.ErrFailedToVerifyWithdrawal.Wrap("invalid output root")
-		}
+	if err := ms.proveWithdrawal(
+		ctx, bridgeId, outputIndex, l2Sequence, scheme, withdrawalHash, req.WithdrawalProofs,
+		req.Version, req.StateRoot, req.StorageRoot, req.LatestBlockHash,
+	); err != nil {
+		return nil, err
 	}
 
-	// verify storage root can be generated from
-	// withdrawal proofs and withdrawal tx data.
-	{
-		var withdrawalHash [32]byte
-		{
-			seed := []byte{}
-			seed = binary.BigEndian.AppendUint64(seed, bridgeId)
-			seed = binary.BigEndian.AppendUint64(seed, req.Sequence)
-			// variable length
-			seed = append(seed, sender...)
-			seed = append(seed, types.Splitter)
-			// variable length
-			seed = append(seed, receiver...)
-			seed = append(seed, types.Splitter)
-			// variable length
-			seed = append(seed, []byte(denom)...)
-			seed = append(seed, types.Splitter)
-			seed = binary.BigEndian.AppendUint64(seed, amount.Uint64())
-
-			// double hash the leaf node
-			withdrawalHash = sha3.Sum256(seed)
-			withdrawalHash = sha3.Sum256(withdrawalHash[:])
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if isL2Native {
+		// mint the l1 representation of the token originated from l2
+		if err := ms.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
 		}
-
-		if ok, err := ms.HasProvenWithdrawal(ctx, bridgeId, withdrawalHash); err != nil {
+		if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
 			return nil, err
-		} else if ok {
-			return nil, types.ErrWithdrawalAlreadyFinalized
 		}
-
-		// should works with sorted merkle tree
-		rootSeed := withdrawalHash
-		proofs := req.WithdrawalProofs
-		for _, proof := range proofs {
-			switch bytes.Compare(rootSeed[:], proof) {
-			case 0, 1: // equal or greater
-				rootSeed = sha3.Sum256(append(proof, rootSeed[:]...))
-			case -1: // less
-				rootSeed = sha3.Sum256(append(rootSeed[:], proof...))
-			}
+	} else {
+		// transfer asset to a user from the bridge account
+		bridgeAddr := types.BridgeAddress(bridgeId)
+		if err := ms.bankKeeper.SendCoins(ctx, bridgeAddr, receiver, coins); err != nil {
+			return nil, err
 		}
 
-		rootHash := rootSeed
-		if !bytes.Equal(req.StorageRoot, rootHash[:]) {
-			return nil, types.ErrFailedToVerifyWithdrawal.Wrap("invalid storage root proofs")
-		}
+		l2Denom = types.L2Denom(bridgeId, denom)
+	}
 
-		if err := ms.RecordProvenWithdrawal(ctx, bridgeId, withdrawalHash); err != nil {
+	// record withdrawal totals
+	if isL2Native {
+		if err := ms.RecordMint(ctx, bridgeId, sdk.NewCoin(denom, amount)); err != nil {
 			return nil, err
 		}
-	}
-
-	// transfer asset to a user from the bridge account
-	bridgeAddr := types.BridgeAddress(bridgeId)
-	if err := ms.bankKeeper.SendCoins(ctx, bridgeAddr, receiver, sdk.NewCoins(sdk.NewCoin(denom, amount))); err != nil {
+	} else if err := ms.RecordWithdrawal(ctx, bridgeId, sdk.NewCoin(denom, amount)); err != nil {
 		return nil, err
 	}
 
@@ -381,13 +415,159 @@ func (ms MsgServer) FinalizeTokenWithdrawal(ctx context.Context, req *types.MsgF
 		sdk.NewAttribute(types.AttributeKeyFrom, sdk.AccAddress(sender).String()),
 		sdk.NewAttribute(types.AttributeKeyTo, sdk.AccAddress(receiver).String()),
 		sdk.NewAttribute(types.AttributeKeyL1Denom, denom),
-		sdk.NewAttribute(types.AttributeKeyL2Denom, types.L2Denom(bridgeId, denom)),
+		sdk.NewAttribute(types.AttributeKeyL2Denom, l2Denom),
 		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 	))
 
 	return &types.MsgFinalizeTokenWithdrawalResponse{}, nil
 }
 
+func (ms MsgServer) InitiateNftDeposit(ctx context.Context, req *types.MsgInitiateNftDeposit) (*types.MsgInitiateNftDepositResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	sender, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeId := req.BridgeId
+	if _, err := ms.GetBridgeConfig(ctx, bridgeId); err != nil {
+		return nil, err
+	}
+
+	class, found := ms.nftKeeper.GetClass(ctx, req.ClassId)
+	if !found {
+		return nil, types.ErrInvalidNft.Wrapf("class %s not found", req.ClassId)
+	}
+	token, found := ms.nftKeeper.GetNFT(ctx, req.ClassId, req.TokenId)
+	if !found {
+		return nil, types.ErrInvalidNft.Wrapf("nft %s/%s not found", req.ClassId, req.TokenId)
+	}
+	if owner := ms.nftKeeper.GetOwner(ctx, req.ClassId, req.TokenId); !owner.Equals(sdk.AccAddress(sender)) {
+		return nil, errors.ErrUnauthorized.Wrapf("%s is not the owner of nft %s/%s", req.Sender, req.ClassId, req.TokenId)
+	}
+
+	// lock the nft in the bridge address
+	bridgeAddr := types.BridgeAddress(bridgeId)
+	if err := ms.nftKeeper.Transfer(ctx, req.ClassId, req.TokenId, bridgeAddr); err != nil {
+		return nil, err
+	}
+
+	l1Sequence, err := ms.IncreaseNextL1Sequence(ctx, bridgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// record class pairs
+	l2ClassId := types.L2ClassId(bridgeId, req.ClassId)
+	if ok, err := ms.HasNftClassPair(ctx, bridgeId, l2ClassId); err != nil {
+		return nil, err
+	} else if !ok {
+		if err := ms.SetNftClassPair(ctx, bridgeId, l2ClassId, req.ClassId); err != nil {
+			return nil, err
+		}
+	}
+
+	// commit the deposit to be proven on l2
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	depositHash := types.GenerateNftDepositHash(
+		bridgeId, l1Sequence, uint64(sdkCtx.BlockHeight()),
+		req.Sender, req.To, req.ClassId, l2ClassId,
+		class.Name, class.Symbol, class.Uri,
+		req.TokenId, token.Uri,
+	)
+	if err := ms.SetDepositCommitment(ctx, bridgeId, l1Sequence, depositHash[:], sdkCtx.BlockTime()); err != nil {
+		return nil, err
+	}
+	if err := ms.PruneDepositCommitments(ctx, bridgeId); err != nil {
+		return nil, err
+	}
+
+	// emit events for bridge executor
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInitiateNftDeposit,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyL1Sequence, strconv.FormatUint(l1Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyFrom, req.Sender),
+		sdk.NewAttribute(types.AttributeKeyTo, req.To),
+		sdk.NewAttribute(types.AttributeKeyL1ClassId, req.ClassId),
+		sdk.NewAttribute(types.AttributeKeyL2ClassId, l2ClassId),
+		sdk.NewAttribute(types.AttributeKeyClassName, class.Name),
+		sdk.NewAttribute(types.AttributeKeyClassSymbol, class.Symbol),
+		sdk.NewAttribute(types.AttributeKeyClassUri, class.Uri),
+		sdk.NewAttribute(types.AttributeKeyTokenId, req.TokenId),
+		sdk.NewAttribute(types.AttributeKeyTokenUri, token.Uri),
+	))
+
+	return &types.MsgInitiateNftDepositResponse{
+		Sequence: l1Sequence,
+	}, nil
+}
+
+func (ms MsgServer) FinalizeNftWithdrawal(ctx context.Context, req *types.MsgFinalizeNftWithdrawal) (*types.MsgFinalizeNftWithdrawalResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	sender, err := ms.authKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := ms.authKeeper.AddressCodec().StringToBytes(req.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeId := req.BridgeId
+	outputIndex := req.OutputIndex
+	l2Sequence := req.Sequence
+
+	l2ClassId := types.L2ClassId(bridgeId, req.ClassId)
+	if ok, err := ms.HasNftClassPair(ctx, bridgeId, l2ClassId); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, types.ErrInvalidNft.Wrapf("class %s was never deposited to the bridge", req.ClassId)
+	}
+
+	scheme, err := ms.getOutputRootScheme(ctx, bridgeId, outputIndex, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawalHash := types.GenerateNftWithdrawalHash(bridgeId, l2Sequence, sender, receiver, req.ClassId, req.TokenId)
+	if err := ms.proveWithdrawal(
+		ctx, bridgeId, outputIndex, l2Sequence, scheme, withdrawalHash, req.WithdrawalProofs,
+		req.Version, req.StateRoot, req.StorageRoot, req.LatestBlockHash,
+	); err != nil {
+		return nil, err
+	}
+
+	// release the nft to a user from the bridge address
+	bridgeAddr := types.BridgeAddress(bridgeId)
+	if owner := ms.nftKeeper.GetOwner(ctx, req.ClassId, req.TokenId); !owner.Equals(bridgeAddr) {
+		return nil, types.ErrInvalidNft.Wrapf("nft %s/%s is not locked in the bridge", req.ClassId, req.TokenId)
+	}
+	if err := ms.nftKeeper.Transfer(ctx, req.ClassId, req.TokenId, receiver); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFinalizeNftWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyOutputIndex, strconv.FormatUint(outputIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyL2Sequence, strconv.FormatUint(l2Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyFrom, sdk.AccAddress(sender).String()),
+		sdk.NewAttribute(types.AttributeKeyTo, sdk.AccAddress(receiver).String()),
+		sdk.NewAttribute(types.AttributeKeyL1ClassId, req.ClassId),
+		sdk.NewAttribute(types.AttributeKeyL2ClassId, l2ClassId),
+		sdk.NewAttribute(types.AttributeKeyTokenId, req.TokenId),
+	))
+
+	return &types.MsgFinalizeNftWithdrawalResponse{}, nil
+}
+
 func (ms MsgServer) UpdateProposer(ctx context.Context, req *types.MsgUpdateProposer) (*types.MsgUpdateProposerResponse, error) {
 	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
 		return nil, err
@@ -602,3 +782,119 @@ func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 	return &types.MsgUpdateParamsResponse{}, nil
 
 }
+
+// UpdateDepositCap implements setting or removing a deposit cap of a bridge
+func (ms MsgServer) UpdateDepositCap(ctx context.Context, req *types.MsgUpdateDepositCap) (*types.MsgUpdateDepositCapResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	bridgeId := req.BridgeId
+	if _, err := ms.GetBridgeConfig(ctx, bridgeId); err != nil {
+		return nil, err
+	}
+
+	depositCap := req.DepositCap
+	if depositCap.IsEmpty() {
+		if err := ms.DeleteDepositCap(ctx, bridgeId, depositCap.Denom); err != nil {
+			return nil, err
+		}
+	} else if err := ms.SetDepositCap(ctx, bridgeId, depositCap); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateDepositCap,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyDenom, depositCap.Denom),
+		sdk.NewAttribute(types.AttributeKeyMaxTotalLocked, depositCap.MaxTotalLocked.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxPerDeposit, depositCap.MaxPerDeposit.String()),
+	))
+
+	return &types.MsgUpdateDepositCapResponse{}, nil
+}
+
+// RegisterL2NativeToken implements registering the l1 representation of a token originated from l2
+func (ms MsgServer) RegisterL2NativeToken(ctx context.Context, req *types.MsgRegisterL2NativeToken) (*types.MsgRegisterL2NativeTokenResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	bridgeId := req.BridgeId
+	if _, err := ms.GetBridgeConfig(ctx, bridgeId); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RegisterL2NativeToken(ctx, bridgeId, req.L2Denom, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterL2NativeToken,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyL1Denom, req.Metadata.Base),
+		sdk.NewAttribute(types.AttributeKeyL2Denom, req.L2Denom),
+	))
+
+	return &types.MsgRegisterL2NativeTokenResponse{}, nil
+}
+
+// RecoverBridgeFunds implements releasing the funds sent directly to a bridge account
+func (ms MsgServer) RecoverBridgeFunds(ctx context.Context, req *types.MsgRecoverBridgeFunds) (*types.MsgRecoverBridgeFundsResponse, error) {
+	if err := req.Validate(ms.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	bridgeId := req.BridgeId
+	if _, err := ms.GetBridgeConfig(ctx, bridgeId); err != nil {
+		return nil, err
+	}
+
+	recipient, err := ms.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeAddr := types.BridgeAddress(bridgeId)
+	if bridgeAddr.Equals(sdk.AccAddress(recipient)) {
+		return nil, errors.ErrInvalidAddress.Wrap("recipient cannot be the bridge account")
+	}
+
+	// only the surplus above the locked amount can be recovered, so the
+	// bridged collateral is never touched.
+	for _, coin := range req.Amount {
+		surplus, err := ms.GetBridgeSurplus(ctx, bridgeId, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if coin.Amount.GT(surplus) {
+			return nil, types.ErrInsufficientSurplus.Wrapf("requested %s, surplus %s%s", coin, surplus, coin.Denom)
+		}
+	}
+
+	if err := ms.bankKeeper.SendCoins(ctx, bridgeAddr, recipient, req.Amount); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecoverBridgeFunds,
+		sdk.NewAttribute(types.AttributeKeyBridgeId, strconv.FormatUint(bridgeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTo, req.Recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+	))
+
+	return &types.MsgRecoverBridgeFundsResponse{}, nil
+}
@@ -1,18 +1,25 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/initia-labs/OPinit/x/ophost/keeper"
 	"github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
 )
 
 func Test_RecordBatch(t *testing.T) {
@@ -75,11 +82,11 @@ func Test_ProposeOutput(t *testing.T) {
 	ctx = ctx.WithBlockTime(blockTime)
 
 	// unauthorized
-	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[1], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[1], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, types.OutputVersionV2))
 	require.Error(t, err)
 
 	// valid
-	proposeRes, err := ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	proposeRes, err := ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, types.OutputVersionV2))
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), proposeRes.OutputIndex)
 
@@ -89,6 +96,7 @@ func Test_ProposeOutput(t *testing.T) {
 		OutputRoot:    []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 		L1BlockTime:   blockTime,
 		L2BlockNumber: 100,
+		Version:       types.OutputVersionV2,
 	}, output)
 }
 
@@ -113,11 +121,11 @@ func Test_DeleteOutput(t *testing.T) {
 	blockTime := time.Now().UTC()
 	ctx = ctx.WithBlockTime(blockTime)
 
-	proposeRes, err := ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	proposeRes, err := ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, types.OutputVersionV2))
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), proposeRes.OutputIndex)
 
-	proposeRes, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 200, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	proposeRes, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 200, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, types.OutputVersionV2))
 	require.NoError(t, err)
 	require.Equal(t, uint64(2), proposeRes.OutputIndex)
 
@@ -137,7 +145,7 @@ func Test_DeleteOutput(t *testing.T) {
 	require.Error(t, err)
 
 	// should be able to resubmit the same output
-	proposeRes, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	proposeRes, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, []byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, types.OutputVersionV2))
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), proposeRes.OutputIndex)
 
@@ -172,6 +180,80 @@ func Test_InitiateTokenDeposit(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, input.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).IsZero())
 	require.Equal(t, amount, input.BankKeeper.GetBalance(ctx, types.BridgeAddress(1), sdk.DefaultBondDenom))
+
+	accounting, err := input.OPHostKeeper.GetBridgeAccounting(ctx, 1, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, amount.Amount, accounting.DepositedAmount)
+	require.True(t, accounting.WithdrawnAmount.IsZero())
+	require.Equal(t, uint64(1), accounting.DepositCount)
+	require.Equal(t, uint64(0), accounting.WithdrawalCount)
+
+	// the deposit is committed to be proven on l2
+	commitment, err := input.OPHostKeeper.GetDepositCommitment(ctx, 1, 1)
+	require.NoError(t, err)
+	expected := types.GenerateTokenDepositHash(
+		1, 1, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		addrsStr[1], addrsStr[2], sdk.DefaultBondDenom, types.L2Denom(1, sdk.DefaultBondDenom), amount.Amount,
+		[]byte("messages"), nil,
+	)
+	require.Equal(t, expected[:], commitment)
+
+	// the proof path must match the store key of the commitment
+	key, err := collections.EncodeKeyWithPrefix(types.DepositCommitmentPrefix, input.OPHostKeeper.DepositCommitments.KeyCodec(), collections.Join(uint64(1), uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, types.DepositCommitmentKey(1, 1), key)
+}
+
+func Test_PruneDepositCommitments(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	params := input.OPHostKeeper.GetParams(ctx)
+	params.DepositCommitmentRetention = time.Hour
+	require.NoError(t, input.OPHostKeeper.SetParams(ctx, params))
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	input.Faucet.Fund(ctx, addrs[1], sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(300)))
+	deposit := func(ctx sdk.Context) {
+		_, err := ms.InitiateTokenDeposit(ctx, types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], amount, nil))
+		require.NoError(t, err)
+	}
+
+	now := time.Now().UTC()
+	deposit(sdk.UnwrapSDKContext(ctx).WithBlockTime(now))
+	deposit(sdk.UnwrapSDKContext(ctx).WithBlockTime(now.Add(30 * time.Minute)))
+
+	// the commitments within the retention are kept
+	_, err = input.OPHostKeeper.GetDepositCommitment(ctx, 1, 1)
+	require.NoError(t, err)
+
+	// the expired commitment is pruned at the next deposit
+	deposit(sdk.UnwrapSDKContext(ctx).WithBlockTime(now.Add(time.Hour + time.Second)))
+	_, err = input.OPHostKeeper.GetDepositCommitment(ctx, 1, 1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	for _, sequence := range []uint64{2, 3} {
+		_, err = input.OPHostKeeper.GetDepositCommitment(ctx, 1, sequence)
+		require.NoError(t, err)
+	}
+
+	var sequences []uint64
+	require.NoError(t, input.OPHostKeeper.IterateDepositCommitments(ctx, 1, func(_ uint64, commitment types.DepositCommitment) (bool, error) {
+		sequences = append(sequences, commitment.Sequence)
+		return false, nil
+	}))
+	require.Equal(t, []uint64{2, 3}, sequences)
 }
 
 func Test_FinalizeTokenWithdrawal(t *testing.T) {
@@ -207,9 +289,15 @@ func Test_FinalizeTokenWithdrawal(t *testing.T) {
 
 	now := time.Now().UTC()
 	ctx = ctx.WithBlockTime(now)
-	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, outputRoot))
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, outputRoot, types.OutputVersionV1))
 	require.NoError(t, err)
 
+	// the fixture is an output proposed before the versions are recorded
+	output, err := input.OPHostKeeper.GetOutputProposal(ctx, 1, 1)
+	require.NoError(t, err)
+	output.Version = nil
+	require.NoError(t, input.OPHostKeeper.SetOutputProposal(ctx, 1, 1, output))
+
 	ctx = ctx.WithBlockTime(now.Add(time.Second * 60))
 	sender, err := input.AccountKeeper.AddressCodec().BytesToString(decodeHex(t, "70b337786a5a87d896d5f9480016817529d0d61b"))
 	require.NoError(t, err)
@@ -224,6 +312,11 @@ func Test_FinalizeTokenWithdrawal(t *testing.T) {
 	))
 	require.NoError(t, err)
 	require.Equal(t, amount, input.BankKeeper.GetBalance(ctx, sdk.AccAddress(decodeHex(t, "f56d386248d1ced6acd23c364909fe88e2ea6f70")), amount.Denom))
+
+	accounting, err := input.OPHostKeeper.GetBridgeAccounting(ctx, 1, amount.Denom)
+	require.NoError(t, err)
+	require.Equal(t, amount.Amount, accounting.WithdrawnAmount)
+	require.Equal(t, uint64(1), accounting.WithdrawalCount)
 }
 
 func decodeHex(t *testing.T, str string) []byte {
@@ -517,3 +610,486 @@ func Test_MsgServer_UpdateParams(t *testing.T) {
 	)
 	require.Error(t, err)
 }
+
+func Test_MsgServer_UpdateDepositCap(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	depositCap := types.NewDepositCap(sdk.DefaultBondDenom, math.NewInt(150), math.NewInt(100))
+	_, err = ms.UpdateDepositCap(ctx, types.NewMsgUpdateDepositCap(govAddr, 1, depositCap))
+	require.NoError(t, err)
+
+	res, err := input.OPHostKeeper.GetDepositCap(ctx, 1, sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, depositCap, res)
+
+	// invalid signer
+	_, err = ms.UpdateDepositCap(ctx, types.NewMsgUpdateDepositCap(addrsStr[0], 1, depositCap))
+	require.Error(t, err)
+
+	// unknown bridge
+	_, err = ms.UpdateDepositCap(ctx, types.NewMsgUpdateDepositCap(govAddr, 2, depositCap))
+	require.Error(t, err)
+
+	input.Faucet.Fund(ctx, addrs[1], sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(300)))
+
+	// exceeds max per deposit
+	_, err = ms.InitiateTokenDeposit(
+		ctx,
+		types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(101)), []byte("messages")),
+	)
+	require.ErrorIs(t, err, types.ErrDepositCapExceeded)
+
+	_, err = ms.InitiateTokenDeposit(
+		ctx,
+		types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)), []byte("messages")),
+	)
+	require.NoError(t, err)
+
+	// exceeds max total locked
+	_, err = ms.InitiateTokenDeposit(
+		ctx,
+		types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(51)), []byte("messages")),
+	)
+	require.ErrorIs(t, err, types.ErrDepositCapExceeded)
+
+	// remove the cap
+	_, err = ms.UpdateDepositCap(ctx, types.NewMsgUpdateDepositCap(govAddr, 1, types.NewDepositCap(sdk.DefaultBondDenom, math.ZeroInt(), math.ZeroInt())))
+	require.NoError(t, err)
+
+	_, err = ms.InitiateTokenDeposit(
+		ctx,
+		types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(200)), []byte("messages")),
+	)
+	require.NoError(t, err)
+}
+
+func Test_MsgServer_RecoverBridgeFunds(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	// bridged deposit
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	input.Faucet.Fund(ctx, addrs[1], deposit)
+	_, err = ms.InitiateTokenDeposit(
+		ctx,
+		types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], deposit, []byte("messages")),
+	)
+	require.NoError(t, err)
+
+	// stray send to the bridge account
+	input.Faucet.Fund(ctx, types.BridgeAddress(1), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(30)))
+
+	// invalid signer
+	_, err = ms.RecoverBridgeFunds(ctx, types.NewMsgRecoverBridgeFunds(addrsStr[0], 1, addrsStr[3], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(30)))))
+	require.Error(t, err)
+
+	// cannot touch the bridged collateral
+	_, err = ms.RecoverBridgeFunds(ctx, types.NewMsgRecoverBridgeFunds(govAddr, 1, addrsStr[3], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(31)))))
+	require.ErrorIs(t, err, types.ErrInsufficientSurplus)
+
+	// cannot recover to the bridge account itself
+	bridgeAddr, err := input.AccountKeeper.AddressCodec().BytesToString(types.BridgeAddress(1))
+	require.NoError(t, err)
+	_, err = ms.RecoverBridgeFunds(ctx, types.NewMsgRecoverBridgeFunds(govAddr, 1, bridgeAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(30)))))
+	require.Error(t, err)
+
+	_, err = ms.RecoverBridgeFunds(ctx, types.NewMsgRecoverBridgeFunds(govAddr, 1, addrsStr[3], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(30)))))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(30), input.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, deposit, input.BankKeeper.GetBalance(ctx, types.BridgeAddress(1), sdk.DefaultBondDenom))
+
+	// nothing left to recover
+	_, err = ms.RecoverBridgeFunds(ctx, types.NewMsgRecoverBridgeFunds(govAddr, 1, addrsStr[3], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1)))))
+	require.ErrorIs(t, err, types.ErrInsufficientSurplus)
+}
+
+func Test_FinalizeTokenWithdrawal_L2NativeToken(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	l2Denom := "umin"
+	l1Denom := types.L1Denom(1, l2Denom)
+	amount := sdk.NewCoin(l1Denom, math.NewInt(1_000))
+
+	// single leaf withdrawal tree
+	var withdrawalHash [32]byte
+	{
+		seed := []byte{}
+		seed = binary.BigEndian.AppendUint64(seed, 1)
+		seed = binary.BigEndian.AppendUint64(seed, 1)
+		seed = append(seed, addrs[1]...)
+		seed = append(seed, types.Splitter)
+		seed = append(seed, addrs[2]...)
+		seed = append(seed, types.Splitter)
+		seed = append(seed, []byte(l1Denom)...)
+		seed = append(seed, types.Splitter)
+		seed = binary.BigEndian.AppendUint64(seed, amount.Amount.Uint64())
+
+		withdrawalHash = sha3.Sum256(seed)
+		withdrawalHash = sha3.Sum256(withdrawalHash[:])
+	}
+
+	version := types.OutputVersionV1
+	stateRoot := bytes.Repeat([]byte{2}, 32)
+	storageRoot := withdrawalHash[:]
+	blockHash := bytes.Repeat([]byte{3}, 32)
+	outputRoot := sha3.Sum256(bytes.Join([][]byte{version, stateRoot, storageRoot, blockHash}, nil))
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, outputRoot[:], version))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Second * 60))
+	msg := types.NewMsgFinalizeTokenWithdrawal(1, 1, 1, nil, addrsStr[1], addrsStr[2], amount, version, stateRoot, storageRoot, blockHash)
+
+	govAddr, err := input.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	// not registered yet
+	_, err = ms.FinalizeTokenWithdrawal(ctx, msg)
+	require.ErrorIs(t, err, types.ErrL2NativeTokenNotRegistered)
+
+	metadata := banktypes.Metadata{
+		Base:    l1Denom,
+		Display: "MIN",
+		Name:    "Minitia",
+		Symbol:  "MIN",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: l1Denom, Exponent: 0},
+			{Denom: "MIN", Exponent: 6},
+		},
+	}
+
+	// only the authority can register
+	_, err = ms.RegisterL2NativeToken(ctx, types.NewMsgRegisterL2NativeToken(addrsStr[0], 1, l2Denom, metadata))
+	require.Error(t, err)
+
+	// l2 denom must be bound to the representation denom
+	_, err = ms.RegisterL2NativeToken(ctx, types.NewMsgRegisterL2NativeToken(govAddr, 1, "uother", metadata))
+	require.ErrorIs(t, err, types.ErrInvalidL2Denom)
+
+	_, err = ms.RegisterL2NativeToken(ctx, types.NewMsgRegisterL2NativeToken(govAddr, 1, l2Denom, metadata))
+	require.NoError(t, err)
+
+	_, err = ms.FinalizeTokenWithdrawal(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, amount, input.BankKeeper.GetBalance(ctx, addrs[2], l1Denom))
+	require.Equal(t, amount, input.BankKeeper.GetSupply(ctx, l1Denom))
+
+	res, found := input.BankKeeper.GetDenomMetaData(ctx, l1Denom)
+	require.True(t, found)
+	require.Equal(t, uint32(6), res.DenomUnits[1].Exponent)
+
+	pairDenom, ok, err := input.OPHostKeeper.GetL2NativeTokenPair(ctx, 1, l1Denom)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, l2Denom, pairDenom)
+
+	// return deposit burns the representation
+	_, err = ms.InitiateTokenDeposit(ctx, types.NewMsgInitiateTokenDeposit(addrsStr[2], 1, addrsStr[1], sdk.NewCoin(l1Denom, math.NewInt(400)), nil))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), input.BankKeeper.GetSupply(ctx, l1Denom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(ctx, types.BridgeAddress(1), l1Denom).IsZero())
+
+	// no token pair is registered for the representation
+	ok, err = input.OPHostKeeper.HasTokenPair(ctx, 1, types.L2Denom(1, l1Denom))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the minted supply is tracked apart from the locked amount
+	accounting, err := input.OPHostKeeper.GetBridgeAccounting(ctx, 1, l1Denom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000), accounting.MintedAmount)
+	require.Equal(t, math.NewInt(400), accounting.BurnedAmount)
+	require.Equal(t, math.NewInt(600), accounting.MintedSupply())
+	require.True(t, accounting.LockedAmount().IsZero())
+	require.NoError(t, accounting.Validate())
+
+	surplus, err := input.OPHostKeeper.GetBridgeSurplus(ctx, 1, l1Denom)
+	require.NoError(t, err)
+	require.True(t, surplus.IsZero())
+}
+
+func Test_InitiateTokenDeposit_DenomMetadata(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	metadata := banktypes.Metadata{
+		Base:    "uinit",
+		Display: "INIT",
+		Name:    "Initia",
+		Symbol:  "INIT",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uinit", Exponent: 0},
+			{Denom: "INIT", Exponent: 6},
+		},
+	}
+	input.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	amount := sdk.NewCoin("uinit", math.NewInt(100))
+	input.Faucet.Fund(ctx, addrs[1], sdk.NewCoin("uinit", math.NewInt(200)))
+
+	findMetadata := func(events sdk.Events) (string, bool) {
+		for _, event := range events {
+			if event.Type != types.EventTypeInitiateTokenDeposit {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyMetadata {
+					return attr.Value, true
+				}
+			}
+		}
+		return "", false
+	}
+
+	// first deposit includes the metadata
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.InitiateTokenDeposit(ctx, types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], amount, nil))
+	require.NoError(t, err)
+
+	value, found := findMetadata(ctx.EventManager().Events())
+	require.True(t, found)
+
+	var res banktypes.Metadata
+	require.NoError(t, input.EncodingConfig.Marshaler.UnmarshalJSON([]byte(value), &res))
+	require.Equal(t, metadata.Display, res.Display)
+	require.Len(t, res.DenomUnits, 2)
+	require.Equal(t, uint32(6), res.DenomUnits[1].Exponent)
+
+	// following deposits do not
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.InitiateTokenDeposit(ctx, types.NewMsgInitiateTokenDeposit(addrsStr[1], 1, addrsStr[2], amount, nil))
+	require.NoError(t, err)
+
+	_, found = findMetadata(ctx.EventManager().Events())
+	require.False(t, found)
+}
+
+func Test_NftBridging(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	classId := "kitty"
+	require.NoError(t, input.NftKeeper.SaveClass(ctx, nft.Class{Id: classId, Name: "Kitty", Symbol: "KTY", Uri: "https://kitty"}))
+	require.NoError(t, input.NftKeeper.Mint(ctx, nft.NFT{ClassId: classId, Id: "1", Uri: "https://kitty/1"}, addrs[1]))
+
+	// only the owner can deposit
+	_, err = ms.InitiateNftDeposit(ctx, types.NewMsgInitiateNftDeposit(addrsStr[2], 1, addrsStr[2], classId, "1"))
+	require.Error(t, err)
+
+	res, err := ms.InitiateNftDeposit(ctx, types.NewMsgInitiateNftDeposit(addrsStr[1], 1, addrsStr[2], classId, "1"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+	require.Equal(t, types.BridgeAddress(1), input.NftKeeper.GetOwner(ctx, classId, "1"))
+
+	l1ClassId, found, err := input.OPHostKeeper.GetNftClassPair(ctx, 1, types.L2ClassId(1, classId))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, classId, l1ClassId)
+
+	// single leaf withdrawal tree
+	withdrawalHash := types.GenerateNftWithdrawalHash(1, 1, addrs[2], addrs[3], classId, "1")
+
+	version := types.OutputVersionV1
+	stateRoot := bytes.Repeat([]byte{2}, 32)
+	storageRoot := withdrawalHash[:]
+	blockHash := bytes.Repeat([]byte{3}, 32)
+	outputRoot := sha3.Sum256(bytes.Join([][]byte{version, stateRoot, storageRoot, blockHash}, nil))
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, outputRoot[:], version))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Second * 60))
+
+	// the proof cannot be used to release a different nft
+	_, err = ms.FinalizeNftWithdrawal(ctx, types.NewMsgFinalizeNftWithdrawal(1, 1, 1, nil, addrsStr[2], addrsStr[3], classId, "2", version, stateRoot, storageRoot, blockHash))
+	require.ErrorIs(t, err, types.ErrFailedToVerifyWithdrawal)
+
+	_, err = ms.FinalizeNftWithdrawal(ctx, types.NewMsgFinalizeNftWithdrawal(1, 1, 1, nil, addrsStr[2], addrsStr[3], classId, "1", version, stateRoot, storageRoot, blockHash))
+	require.NoError(t, err)
+	require.Equal(t, addrs[3], input.NftKeeper.GetOwner(ctx, classId, "1"))
+
+	// cannot finalize twice
+	_, err = ms.FinalizeNftWithdrawal(ctx, types.NewMsgFinalizeNftWithdrawal(1, 1, 1, nil, addrsStr[2], addrsStr[3], classId, "1", version, stateRoot, storageRoot, blockHash))
+	require.ErrorIs(t, err, types.ErrWithdrawalAlreadyFinalized)
+}
+
+func Test_FinalizeTokenWithdrawal_V2Leaf(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	// amount exceeding uint64
+	amount, ok := math.NewIntFromString("100000000000000000000000")
+	require.True(t, ok)
+	coin := sdk.NewCoin("uinit", amount)
+	input.Faucet.Mint(ctx, types.BridgeAddress(1), coin)
+
+	withdrawalHash := types.GenerateWithdrawalHashV2(1, 1, addrs[1], addrs[2], coin.Denom, coin.Amount)
+
+	stateRoot := bytes.Repeat([]byte{2}, 32)
+	storageRoot := withdrawalHash[:]
+	blockHash := bytes.Repeat([]byte{3}, 32)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// v1 output
+	outputRoot := sha3.Sum256(bytes.Join([][]byte{types.OutputVersionV1, stateRoot, storageRoot, blockHash}, nil))
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, outputRoot[:], types.OutputVersionV1))
+	require.NoError(t, err)
+
+	// v2 output
+	outputRoot = sha3.Sum256(bytes.Join([][]byte{types.OutputVersionV2, stateRoot, storageRoot, blockHash}, nil))
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 200, outputRoot[:], types.OutputVersionV2))
+	require.NoError(t, err)
+
+	// unknown versions cannot be proposed
+	unknownVersion := bytes.Repeat([]byte{1}, 32)
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 300, outputRoot[:], unknownVersion))
+	require.ErrorIs(t, err, types.ErrInvalidOutputVersion)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Second * 60))
+
+	// v1 leaves cannot carry amounts above uint64
+	_, err = ms.FinalizeTokenWithdrawal(ctx, types.NewMsgFinalizeTokenWithdrawal(1, 1, 1, nil, addrsStr[1], addrsStr[2], coin, types.OutputVersionV1, stateRoot, storageRoot, blockHash))
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// the version must be the one recorded at the proposal
+	_, err = ms.FinalizeTokenWithdrawal(ctx, types.NewMsgFinalizeTokenWithdrawal(1, 2, 1, nil, addrsStr[1], addrsStr[2], coin, types.OutputVersionV1, stateRoot, storageRoot, blockHash))
+	require.ErrorIs(t, err, types.ErrInvalidOutputVersion)
+
+	_, err = ms.FinalizeTokenWithdrawal(ctx, types.NewMsgFinalizeTokenWithdrawal(1, 2, 1, nil, addrsStr[1], addrsStr[2], coin, types.OutputVersionV2, stateRoot, storageRoot, blockHash))
+	require.NoError(t, err)
+	require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, addrs[2], coin.Denom))
+}
+
+func Test_FinalizeTokenWithdrawal_MerkleTree(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewMsgServerImpl(input.OPHostKeeper)
+	config := types.BridgeConfig{
+		Proposer:            addrsStr[0],
+		Challengers:         []string{addrsStr[1]},
+		SubmissionInterval:  time.Second * 10,
+		FinalizationPeriod:  time.Second * 60,
+		SubmissionStartTime: time.Now().UTC(),
+		Metadata:            []byte{1, 2, 3},
+		BatchInfo:           types.BatchInfo{Submitter: addrsStr[0], Chain: "l1"},
+	}
+	_, err := ms.CreateBridge(ctx, types.NewMsgCreateBridge(addrsStr[0], config))
+	require.NoError(t, err)
+
+	input.Faucet.Fund(ctx, types.BridgeAddress(1), sdk.NewCoin("uinit", math.NewInt(1_000)))
+
+	var leaves [][merkle.HashSize]byte
+	for i := uint64(1); i <= 5; i++ {
+		leaves = append(leaves, types.GenerateWithdrawalHashV2(1, i, addrs[1], addrs[2], "uinit", math.NewIntFromUint64(i*10)))
+	}
+	tree, err := merkle.NewTree(leaves)
+	require.NoError(t, err)
+
+	stateRoot := bytes.Repeat([]byte{2}, 32)
+	storageRoot := tree.Root()
+	blockHash := bytes.Repeat([]byte{3}, 32)
+	outputRoot := sha3.Sum256(bytes.Join([][]byte{types.OutputVersionV2, stateRoot, storageRoot[:], blockHash}, nil))
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	_, err = ms.ProposeOutput(ctx, types.NewMsgProposeOutput(addrsStr[0], 1, 100, outputRoot[:], types.OutputVersionV2))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Second * 60))
+	for i := range leaves {
+		proof, err := tree.Proof(i)
+		require.NoError(t, err)
+
+		sequence := uint64(i + 1)
+		amount := sdk.NewCoin("uinit", math.NewIntFromUint64(sequence*10))
+		_, err = ms.FinalizeTokenWithdrawal(ctx, types.NewMsgFinalizeTokenWithdrawal(1, 1, sequence, proof, addrsStr[1], addrsStr[2], amount, types.OutputVersionV2, stateRoot, storageRoot[:], blockHash))
+		require.NoError(t, err)
+	}
+	require.Equal(t, math.NewInt(150), input.BankKeeper.GetBalance(ctx, addrs[2], "uinit").Amount)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+)
+
+func (k Keeper) SetNftClassPair(ctx context.Context, bridgeId uint64, l2ClassId, l1ClassId string) error {
+	return k.NftClassPairs.Set(ctx, collections.Join(bridgeId, l2ClassId), l1ClassId)
+}
+
+func (k Keeper) HasNftClassPair(ctx context.Context, bridgeId uint64, l2ClassId string) (bool, error) {
+	return k.NftClassPairs.Has(ctx, collections.Join(bridgeId, l2ClassId))
+}
+
+// GetNftClassPair returns the l1 class id of the l2 class id.
+func (k Keeper) GetNftClassPair(ctx context.Context, bridgeId uint64, l2ClassId string) (string, bool, error) {
+	l1ClassId, err := k.NftClassPairs.Get(ctx, collections.Join(bridgeId, l2ClassId))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return l1ClassId, true, nil
+}
+
+func (k Keeper) IterateNftClassPairs(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(bridgeId uint64, classPair types.NftClassPair) (stop bool, err error),
+) error {
+	return k.NftClassPairs.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](bridgeId), func(key collections.Pair[uint64, string], l1ClassId string) (stop bool, err error) {
+		return cb(key.K1(), types.NftClassPair{
+			L1ClassId: l1ClassId,
+			L2ClassId: key.K2(),
+		})
+	})
+}
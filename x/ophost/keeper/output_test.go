@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"cosmossdk.io/collections"
-	"github.com/initia-labs/OPinit/x/ophost/types"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 func Test_OutputProposal(t *testing.T) {
@@ -199,3 +202,28 @@ func Test_DeleteOutputProposal(t *testing.T) {
 	err = input.OPHostKeeper.DeleteOutputProposal(ctx.WithBlockTime(ctx.BlockTime().Add(time.Second*9)), 1, 1)
 	require.NoError(t, err)
 }
+
+func Test_OutputRootV1(t *testing.T) {
+	version := bytes.Repeat([]byte{1}, 32)
+	stateRoot := bytes.Repeat([]byte{2}, 32)
+	storageRoot := bytes.Repeat([]byte{3}, 32)
+	blockHash := bytes.Repeat([]byte{4}, 32)
+
+	outputRoot := types.NewOutputRootV1(version, stateRoot, storageRoot, blockHash)
+	require.Equal(t, sha3.Sum256(bytes.Join([][]byte{version, stateRoot, storageRoot, blockHash}, nil)), outputRoot.Compute())
+
+	// unknown or malformed versions are rejected
+	_, err := types.GetOutputRootScheme(version)
+	require.ErrorIs(t, err, types.ErrInvalidOutputVersion)
+	_, err = types.GetOutputRootScheme([]byte{1})
+	require.ErrorIs(t, err, types.ErrInvalidOutputVersion)
+
+	scheme, err := types.GetOutputRootScheme(types.OutputVersionV1)
+	require.NoError(t, err)
+	require.Equal(t, types.WithdrawalLeafVersionV1, scheme.WithdrawalLeafVersion)
+	require.Equal(t, outputRoot.Compute(), scheme.NewOutputRoot(version, stateRoot, storageRoot, blockHash).Compute())
+
+	scheme, err = types.GetOutputRootScheme(types.OutputVersionV2)
+	require.NoError(t, err)
+	require.Equal(t, types.WithdrawalLeafVersionV2, scheme.WithdrawalLeafVersion)
+}
@@ -95,6 +95,40 @@ func (q Querier) TokenPairs(ctx context.Context, req *types.QueryTokenPairsReque
 	}, nil
 }
 
+func (q Querier) L2NativeTokenPairs(ctx context.Context, req *types.QueryL2NativeTokenPairsRequest) (*types.QueryL2NativeTokenPairsResponse, error) {
+	pairs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.L2NativeTokenPairs, req.Pagination, func(key collections.Pair[uint64, string], l2Denom string) (types.TokenPair, error) {
+		return types.TokenPair{
+			L1Denom: key.K2(),
+			L2Denom: l2Denom,
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, string](req.BridgeId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryL2NativeTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) NftClassPairs(ctx context.Context, req *types.QueryNftClassPairsRequest) (*types.QueryNftClassPairsResponse, error) {
+	pairs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.NftClassPairs, req.Pagination, func(key collections.Pair[uint64, string], l1ClassId string) (types.NftClassPair, error) {
+		return types.NftClassPair{
+			L1ClassId: l1ClassId,
+			L2ClassId: key.K2(),
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, string](req.BridgeId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNftClassPairsResponse{
+		ClassPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q Querier) LastFinalizedOutput(ctx context.Context, req *types.QueryLastFinalizedOutputRequest) (*types.QueryLastFinalizedOutputResponse, error) {
 	lastOutputIndex, lastOutput, err := q.GetLastFinalizedOutput(ctx, req.BridgeId)
 	if err != nil {
@@ -138,6 +172,72 @@ func (q Querier) OutputProposals(ctx context.Context, req *types.QueryOutputProp
 	}, nil
 }
 
+func (q Querier) DepositCap(ctx context.Context, req *types.QueryDepositCapRequest) (*types.QueryDepositCapResponse, error) {
+	depositCap, err := q.GetDepositCap(ctx, req.BridgeId, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := q.bankKeeper.GetBalance(ctx, types.BridgeAddress(req.BridgeId), req.Denom)
+	return &types.QueryDepositCapResponse{
+		DepositCap:   depositCap,
+		LockedAmount: locked.Amount,
+	}, nil
+}
+
+func (q Querier) DepositCaps(ctx context.Context, req *types.QueryDepositCapsRequest) (*types.QueryDepositCapsResponse, error) {
+	bridgeAddr := types.BridgeAddress(req.BridgeId)
+	depositCaps, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.DepositCaps, req.Pagination, func(_ collections.Pair[uint64, string], depositCap types.DepositCap) (types.QueryDepositCapResponse, error) {
+		locked := q.bankKeeper.GetBalance(ctx, bridgeAddr, depositCap.Denom)
+		return types.QueryDepositCapResponse{
+			DepositCap:   depositCap,
+			LockedAmount: locked.Amount,
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, string](req.BridgeId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositCapsResponse{
+		DepositCaps: depositCaps,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (q Querier) BridgeAccounting(ctx context.Context, req *types.QueryBridgeAccountingRequest) (*types.QueryBridgeAccountingResponse, error) {
+	accounting, err := q.GetBridgeAccounting(ctx, req.BridgeId, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := q.bankKeeper.GetBalance(ctx, types.BridgeAddress(req.BridgeId), req.Denom)
+	return &types.QueryBridgeAccountingResponse{
+		Accounting:   accounting,
+		LockedAmount: accounting.LockedAmount(),
+		Balance:      balance.Amount,
+	}, nil
+}
+
+func (q Querier) BridgeAccountings(ctx context.Context, req *types.QueryBridgeAccountingsRequest) (*types.QueryBridgeAccountingsResponse, error) {
+	bridgeAddr := types.BridgeAddress(req.BridgeId)
+	accountings, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Accountings, req.Pagination, func(_ collections.Pair[uint64, string], accounting types.BridgeAccounting) (types.QueryBridgeAccountingResponse, error) {
+		balance := q.bankKeeper.GetBalance(ctx, bridgeAddr, accounting.Denom)
+		return types.QueryBridgeAccountingResponse{
+			Accounting:   accounting,
+			LockedAmount: accounting.LockedAmount(),
+			Balance:      balance.Amount,
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, string](req.BridgeId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBridgeAccountingsResponse{
+		Accountings: accountings,
+		Pagination:  pageRes,
+	}, nil
+}
+
 func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/initia-labs/OPinit/x/ophost/keeper"
@@ -217,3 +219,79 @@ func Test_QueryLastFinalizedOutput(t *testing.T) {
 		L2BlockNumber: 100,
 	}, res.OutputProposal)
 }
+
+func Test_QueryDepositCaps(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	cap1 := types.NewDepositCap("bar", math.NewInt(1_000), math.ZeroInt())
+	cap2 := types.NewDepositCap("foo", math.NewInt(2_000), math.NewInt(100))
+	require.NoError(t, input.OPHostKeeper.SetDepositCap(ctx, 1, cap1))
+	require.NoError(t, input.OPHostKeeper.SetDepositCap(ctx, 1, cap2))
+	require.NoError(t, input.OPHostKeeper.SetDepositCap(ctx, 2, cap1))
+
+	input.Faucet.Fund(ctx, types.BridgeAddress(1), sdk.NewCoin("foo", math.NewInt(300)))
+
+	q := keeper.NewQuerier(input.OPHostKeeper)
+	res, err := q.DepositCap(ctx, &types.QueryDepositCapRequest{
+		BridgeId: 1,
+		Denom:    "foo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, cap2, res.DepositCap)
+	require.Equal(t, math.NewInt(300), res.LockedAmount)
+
+	// not registered denom
+	res, err = q.DepositCap(ctx, &types.QueryDepositCapRequest{
+		BridgeId: 1,
+		Denom:    "baz",
+	})
+	require.NoError(t, err)
+	require.True(t, res.DepositCap.IsEmpty())
+	require.True(t, res.LockedAmount.IsZero())
+
+	resAll, err := q.DepositCaps(ctx, &types.QueryDepositCapsRequest{
+		BridgeId: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.QueryDepositCapResponse{
+		{DepositCap: cap1, LockedAmount: math.ZeroInt()},
+		{DepositCap: cap2, LockedAmount: math.NewInt(300)},
+	}, resAll.DepositCaps)
+}
+
+func Test_QueryBridgeAccountings(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	require.NoError(t, input.OPHostKeeper.RecordDeposit(ctx, 1, sdk.NewCoin("foo", math.NewInt(300))))
+	require.NoError(t, input.OPHostKeeper.RecordDeposit(ctx, 1, sdk.NewCoin("foo", math.NewInt(200))))
+	require.NoError(t, input.OPHostKeeper.RecordWithdrawal(ctx, 1, sdk.NewCoin("foo", math.NewInt(100))))
+	require.NoError(t, input.OPHostKeeper.RecordDeposit(ctx, 1, sdk.NewCoin("bar", math.NewInt(10))))
+	require.NoError(t, input.OPHostKeeper.RecordDeposit(ctx, 2, sdk.NewCoin("bar", math.NewInt(10))))
+
+	// stray send to the bridge account
+	input.Faucet.Fund(ctx, types.BridgeAddress(1), sdk.NewCoin("foo", math.NewInt(450)))
+
+	q := keeper.NewQuerier(input.OPHostKeeper)
+	res, err := q.BridgeAccounting(ctx, &types.QueryBridgeAccountingRequest{
+		BridgeId: 1,
+		Denom:    "foo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.BridgeAccounting{
+		Denom:           "foo",
+		DepositedAmount: math.NewInt(500),
+		WithdrawnAmount: math.NewInt(100),
+		DepositCount:    2,
+		WithdrawalCount: 1,
+		MintedAmount:    math.ZeroInt(),
+		BurnedAmount:    math.ZeroInt(),
+	}, res.Accounting)
+	require.Equal(t, math.NewInt(400), res.LockedAmount)
+	require.Equal(t, math.NewInt(450), res.Balance)
+
+	resAll, err := q.BridgeAccountings(ctx, &types.QueryBridgeAccountingsRequest{
+		BridgeId: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resAll.Accountings, 2)
+	require.Equal(t, "bar", resAll.Accountings[0].Accounting.Denom)
+	require.Equal(t, "foo", resAll.Accountings[1].Accounting.Denom)
+}
@@ -1,20 +1,69 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
 )
 
-func (k Keeper) RecordProvenWithdrawal(ctx context.Context, bridgeId uint64, withdrawalHash [32]byte) error {
-	return k.ProvenWithdrawals.Set(ctx, collections.Join(bridgeId, withdrawalHash[:]), true)
+// provenBitmapSize is the number of l2 sequences covered by a bitmap.
+const provenBitmapSize = 256
+
+func provenBitmapPosition(l2Sequence uint64) (index uint64, byteIndex int, mask byte) {
+	index = l2Sequence / provenBitmapSize
+	offset := l2Sequence % provenBitmapSize
+	return index, int(offset / 8), byte(1) << (offset % 8)
+}
+
+// RecordProvenWithdrawal records the withdrawal of the l2 sequence as proven.
+func (k Keeper) RecordProvenWithdrawal(ctx context.Context, bridgeId, l2Sequence uint64) error {
+	index, byteIndex, mask := provenBitmapPosition(l2Sequence)
+	key := collections.Join(bridgeId, index)
+
+	bitmap, err := k.ProvenBitmaps.Get(ctx, key)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		bitmap = make([]byte, provenBitmapSize/8)
+	} else if err != nil {
+		return err
+	}
+
+	bitmap[byteIndex] |= mask
+	return k.ProvenBitmaps.Set(ctx, key, bitmap)
+}
+
+// HasProvenWithdrawal returns true if the withdrawal of the l2 sequence is
+// proven.
+func (k Keeper) HasProvenWithdrawal(ctx context.Context, bridgeId, l2Sequence uint64) (bool, error) {
+	index, byteIndex, mask := provenBitmapPosition(l2Sequence)
+
+	bitmap, err := k.ProvenBitmaps.Get(ctx, collections.Join(bridgeId, index))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return bitmap[byteIndex]&mask != 0, nil
 }
 
-func (k Keeper) HasProvenWithdrawal(ctx context.Context, bridgeId uint64, withdrawalHash [32]byte) (bool, error) {
+// HasLegacyProvenWithdrawal returns true if the withdrawal hash is proven
+// before the bitmaps are introduced.
+func (k Keeper) HasLegacyProvenWithdrawal(ctx context.Context, bridgeId uint64, withdrawalHash [32]byte) (bool, error) {
 	return k.ProvenWithdrawals.Has(ctx, collections.Join(bridgeId, withdrawalHash[:]))
 }
 
-func (k Keeper) IterateProvenWithdrawals(
+// RecordLegacyProvenWithdrawal records the withdrawal hash as proven. It is
+// only used to import the withdrawals proven before the bitmaps are introduced.
+func (k Keeper) RecordLegacyProvenWithdrawal(ctx context.Context, bridgeId uint64, withdrawalHash [32]byte) error {
+	return k.ProvenWithdrawals.Set(ctx, collections.Join(bridgeId, withdrawalHash[:]), true)
+}
+
+func (k Keeper) IterateLegacyProvenWithdrawals(
 	ctx context.Context,
 	bridgeId uint64,
 	cb func(bridgeId uint64, withdrawalHash [32]byte) (bool, error),
@@ -25,3 +74,88 @@ func (k Keeper) IterateProvenWithdrawals(
 		return cb(bridgeId, withdrawalHash)
 	})
 }
+
+// SetProvenBitmap sets the proven flags of the 256 l2 sequences of the index.
+func (k Keeper) SetProvenBitmap(ctx context.Context, bridgeId uint64, bitmap types.ProvenWithdrawalBitmap) error {
+	return k.ProvenBitmaps.Set(ctx, collections.Join(bridgeId, bitmap.Index), bitmap.Bitmap)
+}
+
+func (k Keeper) IterateProvenBitmaps(
+	ctx context.Context,
+	bridgeId uint64,
+	cb func(bridgeId uint64, bitmap types.ProvenWithdrawalBitmap) (bool, error),
+) error {
+	return k.ProvenBitmaps.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](bridgeId), func(key collections.Pair[uint64, uint64], bitmap []byte) (stop bool, err error) {
+		return cb(bridgeId, types.ProvenWithdrawalBitmap{Index: key.K2(), Bitmap: bitmap})
+	})
+}
+
+// getOutputRootScheme returns the scheme of the finalized output of the
+// given output index. The version must be the one recorded at the proposal,
+// and the outputs proposed before the versions are recorded use the legacy
+// scheme.
+func (k Keeper) getOutputRootScheme(ctx context.Context, bridgeId, outputIndex uint64, version []byte) (types.OutputRootScheme, error) {
+	if ok, err := k.IsFinalized(ctx, bridgeId, outputIndex); err != nil {
+		return types.OutputRootScheme{}, err
+	} else if !ok {
+		return types.OutputRootScheme{}, types.ErrNotFinalized
+	}
+
+	outputProposal, err := k.GetOutputProposal(ctx, bridgeId, outputIndex)
+	if err != nil {
+		return types.OutputRootScheme{}, err
+	}
+
+	if len(outputProposal.Version) == 0 {
+		return types.LegacyOutputRootScheme, nil
+	} else if !bytes.Equal(outputProposal.Version, version) {
+		return types.OutputRootScheme{}, types.ErrInvalidOutputVersion.Wrapf("expected %x, got %x", outputProposal.Version, version)
+	}
+
+	return types.GetOutputRootScheme(version)
+}
+
+// proveWithdrawal verifies the withdrawal leaf against the finalized output
+// root of the given output index and records it as proven.
+func (k Keeper) proveWithdrawal(
+	ctx context.Context,
+	bridgeId, outputIndex, l2Sequence uint64,
+	scheme types.OutputRootScheme,
+	withdrawalHash [32]byte,
+	withdrawalProofs [][]byte,
+	version, stateRoot, storageRoot, latestBlockHash []byte,
+) error {
+	outputProposal, err := k.GetOutputProposal(ctx, bridgeId, outputIndex)
+	if err != nil {
+		return err
+	}
+
+	// validate output root generation
+	outputRoot := scheme.NewOutputRoot(version, stateRoot, storageRoot, latestBlockHash)
+	if computed := outputRoot.Compute(); !bytes.Equal(outputProposal.OutputRoot, computed[:]) {
+		return types.ErrFailedToVerifyWithdrawal.Wrap("invalid output root")
+	}
+
+	if ok, err := k.HasProvenWithdrawal(ctx, bridgeId, l2Sequence); err != nil {
+		return err
+	} else if ok {
+		return types.ErrWithdrawalAlreadyFinalized
+	}
+
+	// only the v1 leaves were proven before the bitmaps are introduced
+	if scheme.WithdrawalLeafVersion == types.WithdrawalLeafVersionV1 {
+		if ok, err := k.HasLegacyProvenWithdrawal(ctx, bridgeId, withdrawalHash); err != nil {
+			return err
+		} else if ok {
+			return types.ErrWithdrawalAlreadyFinalized
+		}
+	}
+
+	// verify storage root can be generated from
+	// withdrawal proofs and withdrawal tx data.
+	if !merkle.VerifyProof(outputRoot.GetStorageRoot(), withdrawalHash, withdrawalProofs) {
+		return types.ErrFailedToVerifyWithdrawal.Wrap("invalid storage root proofs")
+	}
+
+	return k.RecordProvenWithdrawal(ctx, bridgeId, l2Sequence)
+}
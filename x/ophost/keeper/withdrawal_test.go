@@ -3,25 +3,56 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/OPinit/x/ophost/types"
 )
 
 func Test_ProvenWithdrawal(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
-	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, [32]byte{1, 2, 3})
-	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, [32]byte{4, 5, 6})
-	input.OPHostKeeper.RecordProvenWithdrawal(ctx, 2, [32]byte{7, 8, 9})
+	require.NoError(t, input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, 1))
+	require.NoError(t, input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, 255))
+	require.NoError(t, input.OPHostKeeper.RecordProvenWithdrawal(ctx, 1, 256))
+	require.NoError(t, input.OPHostKeeper.RecordProvenWithdrawal(ctx, 2, 2))
+
+	for sequence, proven := range map[uint64]bool{0: false, 1: true, 2: false, 254: false, 255: true, 256: true, 257: false} {
+		found, err := input.OPHostKeeper.HasProvenWithdrawal(ctx, 1, sequence)
+		require.NoError(t, err)
+		require.Equal(t, proven, found, "sequence %d", sequence)
+	}
 
-	found, err := input.OPHostKeeper.HasProvenWithdrawal(ctx, 1, [32]byte{1, 2, 3})
+	found, err := input.OPHostKeeper.HasProvenWithdrawal(ctx, 2, 1)
 	require.NoError(t, err)
-	require.True(t, found)
+	require.False(t, found)
+
+	// the bitmaps of the sequences in the same range share an entry
+	count := 0
+	require.NoError(t, input.OPHostKeeper.ProvenBitmaps.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], _ []byte) (bool, error) {
+		count++
+		return false, nil
+	}))
+	require.Equal(t, 3, count)
+}
+
+func Test_LegacyProvenWithdrawal(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
 
-	found, err = input.OPHostKeeper.HasProvenWithdrawal(ctx, 1, [32]byte{4, 5, 6})
+	input.OPHostKeeper.RecordLegacyProvenWithdrawal(ctx, 1, [32]byte{1, 2, 3})
+	input.OPHostKeeper.RecordLegacyProvenWithdrawal(ctx, 1, [32]byte{4, 5, 6})
+	input.OPHostKeeper.RecordLegacyProvenWithdrawal(ctx, 2, [32]byte{7, 8, 9})
+
+	found, err := input.OPHostKeeper.HasLegacyProvenWithdrawal(ctx, 1, [32]byte{1, 2, 3})
 	require.NoError(t, err)
 	require.True(t, found)
 
-	input.OPHostKeeper.IterateProvenWithdrawals(ctx, 1, func(bridgeId uint64, withdrawalHash [32]byte) (bool, error) {
+	found, err = input.OPHostKeeper.HasLegacyProvenWithdrawal(ctx, 1, [32]byte{7, 8, 9})
+	require.NoError(t, err)
+	require.False(t, found)
+
+	input.OPHostKeeper.IterateLegacyProvenWithdrawals(ctx, 1, func(bridgeId uint64, withdrawalHash [32]byte) (bool, error) {
 		require.Equal(t, uint64(1), bridgeId)
 		if withdrawalHash != [32]byte{1, 2, 3} {
 			require.Equal(t, [32]byte{4, 5, 6}, withdrawalHash)
@@ -30,3 +61,16 @@ func Test_ProvenWithdrawal(t *testing.T) {
 		return false, nil
 	})
 }
+
+func Test_GenerateNftWithdrawalHash_Collision(t *testing.T) {
+	sender := []byte{1, 2, 3}
+	receiver := []byte{4, 5, 6}
+
+	// the fields containing the splitter byte cannot shift the boundaries
+	hash := types.GenerateNftWithdrawalHash(1, 1, sender, receiver, "class|a", "1")
+	require.NotEqual(t, hash, types.GenerateNftWithdrawalHash(1, 1, sender, receiver, "class", "a|1"))
+	require.NotEqual(t, hash, types.GenerateNftWithdrawalHash(1, 1, sender, append(append(receiver, '|'), []byte("class")...), "a", "1"))
+
+	// the nft leaves never collide with the token leaves
+	require.NotEqual(t, types.GenerateWithdrawalHashV2(1, 1, sender, receiver, "class", math.NewInt(1)), types.GenerateNftWithdrawalHash(1, 1, sender, receiver, "class", "1"))
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBridgeAccounting creates a new BridgeAccounting instance with zero totals.
+func NewBridgeAccounting(denom string) BridgeAccounting {
+	return BridgeAccounting{
+		Denom:           denom,
+		DepositedAmount: math.ZeroInt(),
+		WithdrawnAmount: math.ZeroInt(),
+		MintedAmount:    math.ZeroInt(),
+		BurnedAmount:    math.ZeroInt(),
+	}
+}
+
+// Validate performs basic BridgeAccounting validation.
+func (a BridgeAccounting) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if a.DepositedAmount.IsNil() || a.DepositedAmount.IsNegative() {
+		return ErrInvalidBridgeAccounting.Wrap("deposited amount must be non-negative")
+	}
+
+	if a.WithdrawnAmount.IsNil() || a.WithdrawnAmount.IsNegative() {
+		return ErrInvalidBridgeAccounting.Wrap("withdrawn amount must be non-negative")
+	}
+
+	if a.MintedAmount.IsNil() || a.MintedAmount.IsNegative() {
+		return ErrInvalidBridgeAccounting.Wrap("minted amount must be non-negative")
+	}
+
+	if a.BurnedAmount.IsNil() || a.BurnedAmount.IsNegative() {
+		return ErrInvalidBridgeAccounting.Wrap("burned amount must be non-negative")
+	}
+
+	if a.LockedAmount().IsNegative() {
+		return ErrInvalidBridgeAccounting.Wrap("withdrawn amount exceeds deposited amount")
+	}
+
+	if a.MintedSupply().IsNegative() {
+		return ErrInvalidBridgeAccounting.Wrap("burned amount exceeds minted amount")
+	}
+
+	return nil
+}
+
+// LockedAmount returns the amount which is locked in the bridge account
+// by the bridge, i.e. deposited amount minus withdrawn amount.
+func (a BridgeAccounting) LockedAmount() math.Int {
+	return a.DepositedAmount.Sub(a.WithdrawnAmount)
+}
+
+// MintedSupply returns the outstanding supply of the l1 representation of
+// the token originated from l2, i.e. minted amount minus burned amount.
+func (a BridgeAccounting) MintedSupply() math.Int {
+	return a.MintedAmount.Sub(a.BurnedAmount)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"slices"
 	time "time"
 
@@ -97,3 +98,10 @@ func (config BridgeConfig) isValidChallengers() bool {
 
 	return true
 }
+
+// BridgeConfigKey returns the ophost store key of the bridge config, which is
+// used as the merkle path of the bridge config proof.
+func BridgeConfigKey(bridgeId uint64) []byte {
+	key := append([]byte{}, BridgeConfigPrefix...)
+	return binary.BigEndian.AppendUint64(key, bridgeId)
+}
@@ -17,10 +17,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDeleteOutput{}, "ophost/MsgDeleteOutput")
 	legacy.RegisterAminoMsg(cdc, &MsgInitiateTokenDeposit{}, "ophost/MsgInitiateTokenDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgFinalizeTokenWithdrawal{}, "ophost/MsgFinalizeTokenWithdrawal")
+	legacy.RegisterAminoMsg(cdc, &MsgInitiateNftDeposit{}, "ophost/MsgInitiateNftDeposit")
+	legacy.RegisterAminoMsg(cdc, &MsgFinalizeNftWithdrawal{}, "ophost/MsgFinalizeNftWithdrawal")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateProposer{}, "ophost/MsgUpdateProposer")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateChallengers{}, "ophost/MsgUpdateChallengers")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateBatchInfo{}, "ophost/MsgUpdateBatchInfo")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "ophost/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDepositCap{}, "ophost/MsgUpdateDepositCap")
+	legacy.RegisterAminoMsg(cdc, &MsgRecoverBridgeFunds{}, "ophost/MsgRecoverBridgeFunds")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterL2NativeToken{}, "ophost/MsgRegisterL2NativeToken")
 
 	cdc.RegisterConcrete(Params{}, "ophost/Params", nil)
 	cdc.RegisterConcrete(&BridgeAccount{}, "ophost/BridgeAccount", nil)
@@ -35,10 +40,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeleteOutput{},
 		&MsgInitiateTokenDeposit{},
 		&MsgFinalizeTokenWithdrawal{},
+		&MsgInitiateNftDeposit{},
+		&MsgFinalizeNftWithdrawal{},
 		&MsgUpdateProposer{},
 		&MsgUpdateChallengers{},
 		&MsgUpdateBatchInfo{},
 		&MsgUpdateParams{},
+		&MsgUpdateDepositCap{},
+		&MsgRecoverBridgeFunds{},
+		&MsgRegisterL2NativeToken{},
 	)
 
 	// auth account registration
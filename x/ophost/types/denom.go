@@ -8,6 +8,7 @@ import (
 )
 
 const L2_DENOM_PREFIX = "l2/"
+const L1_DENOM_PREFIX = "l1/"
 
 func L2Denom(bridgeId uint64, l1Denom string) string {
 	var bz []byte
@@ -17,3 +18,13 @@ func L2Denom(bridgeId uint64, l1Denom string) string {
 	hash := sha3.Sum256(bz)
 	return fmt.Sprintf("%s%x", L2_DENOM_PREFIX, hash[:])
 }
+
+// L1Denom returns the l1 representation denom of a token originated from l2.
+func L1Denom(bridgeId uint64, l2Denom string) string {
+	var bz []byte
+	bz = binary.BigEndian.AppendUint64(bz, bridgeId)
+	bz = append(bz, []byte(l2Denom)...)
+
+	hash := sha3.Sum256(bz)
+	return fmt.Sprintf("%s%x", L1_DENOM_PREFIX, hash[:])
+}
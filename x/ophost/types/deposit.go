@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"golang.org/x/crypto/sha3"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DepositTypeToken is the deposit commitment type of the token deposits.
+	DepositTypeToken = byte(1)
+	// DepositTypeNft is the deposit commitment type of the nft deposits.
+	DepositTypeNft = byte(2)
+)
+
+// DepositCommitmentKey returns the ophost store key of the deposit
+// commitment, which is used as the merkle path of the deposit proof.
+func DepositCommitmentKey(bridgeId, l1Sequence uint64) []byte {
+	key := append([]byte{}, DepositCommitmentPrefix...)
+	key = binary.BigEndian.AppendUint64(key, bridgeId)
+	return binary.BigEndian.AppendUint64(key, l1Sequence)
+}
+
+// GenerateTokenDepositHash returns the sha3_256 commitment of the token
+// deposit;
+//
+//	deposit_type(1) || bridge_id(8) || l1_sequence(8) || l1_height(8) ||
+//	len(from)(4) || from || len(to)(4) || to ||
+//	len(l1_denom)(4) || l1_denom || len(l2_denom)(4) || l2_denom ||
+//	amount(32) || len(data)(4) || data || len(metadata)(4) || metadata
+//
+// All integers are big endian and the metadata is the protobuf encoded
+// denom metadata included in the first deposit of the denom.
+func GenerateTokenDepositHash(
+	bridgeId, l1Sequence, l1Height uint64,
+	from, to, l1Denom, l2Denom string,
+	amount math.Int,
+	data, metadata []byte,
+) [32]byte {
+	seed := []byte{DepositTypeToken}
+	seed = binary.BigEndian.AppendUint64(seed, bridgeId)
+	seed = binary.BigEndian.AppendUint64(seed, l1Sequence)
+	seed = binary.BigEndian.AppendUint64(seed, l1Height)
+	seed = appendLengthPrefixed(seed, []byte(from))
+	seed = appendLengthPrefixed(seed, []byte(to))
+	seed = appendLengthPrefixed(seed, []byte(l1Denom))
+	seed = appendLengthPrefixed(seed, []byte(l2Denom))
+
+	var amountBz [32]byte
+	amount.BigInt().FillBytes(amountBz[:])
+	seed = append(seed, amountBz[:]...)
+
+	seed = appendLengthPrefixed(seed, data)
+	seed = appendLengthPrefixed(seed, metadata)
+
+	return sha3.Sum256(seed)
+}
+
+// GenerateNftDepositHash returns the sha3_256 commitment of the nft deposit;
+//
+//	deposit_type(1) || bridge_id(8) || l1_sequence(8) || l1_height(8) ||
+//	from || to || l1_class_id || l2_class_id || class_name ||
+//	class_symbol || class_uri || token_id || token_uri
+//
+// The variable length fields are prefixed with their 4-byte length.
+func GenerateNftDepositHash(
+	bridgeId, l1Sequence, l1Height uint64,
+	from, to, l1ClassId, l2ClassId string,
+	className, classSymbol, classUri string,
+	tokenId, tokenUri string,
+) [32]byte {
+	seed := []byte{DepositTypeNft}
+	seed = binary.BigEndian.AppendUint64(seed, bridgeId)
+	seed = binary.BigEndian.AppendUint64(seed, l1Sequence)
+	seed = binary.BigEndian.AppendUint64(seed, l1Height)
+	for _, field := range []string{
+		from, to, l1ClassId, l2ClassId,
+		className, classSymbol, classUri,
+		tokenId, tokenUri,
+	} {
+		seed = appendLengthPrefixed(seed, []byte(field))
+	}
+
+	return sha3.Sum256(seed)
+}
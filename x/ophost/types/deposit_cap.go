@@ -0,0 +1,53 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDepositCap creates a new DepositCap instance. Zero values
+// disable the corresponding limit.
+func NewDepositCap(denom string, maxTotalLocked, maxPerDeposit math.Int) DepositCap {
+	return DepositCap{
+		Denom:          denom,
+		MaxTotalLocked: maxTotalLocked,
+		MaxPerDeposit:  maxPerDeposit,
+	}
+}
+
+// Validate performs basic DepositCap validation.
+func (c DepositCap) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+
+	if c.MaxTotalLocked.IsNil() || c.MaxTotalLocked.IsNegative() {
+		return ErrInvalidDepositCap.Wrap("max total locked must be non-negative")
+	}
+
+	if c.MaxPerDeposit.IsNil() || c.MaxPerDeposit.IsNegative() {
+		return ErrInvalidDepositCap.Wrap("max per deposit must be non-negative")
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if both limits are disabled.
+func (c DepositCap) IsEmpty() bool {
+	return c.MaxTotalLocked.IsZero() && c.MaxPerDeposit.IsZero()
+}
+
+// CheckDeposit returns an error if depositing the amount on top of the
+// locked amount violates the cap.
+func (c DepositCap) CheckDeposit(locked, amount math.Int) error {
+	if c.MaxPerDeposit.IsPositive() && amount.GT(c.MaxPerDeposit) {
+		return ErrDepositCapExceeded.Wrapf("deposit amount %s exceeds max per deposit %s%s", amount, c.MaxPerDeposit, c.Denom)
+	}
+
+	if c.MaxTotalLocked.IsPositive() && locked.Add(amount).GT(c.MaxTotalLocked) {
+		return ErrDepositCapExceeded.Wrapf("total locked amount %s exceeds max total locked %s%s", locked.Add(amount), c.MaxTotalLocked, c.Denom)
+	}
+
+	return nil
+}
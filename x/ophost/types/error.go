@@ -20,4 +20,15 @@ var (
 	ErrInvalidBridgeMetadata      = errorsmod.Register(ModuleName, 13, "invalid bridge metadata")
 	ErrInvalidBatchInfo           = errorsmod.Register(ModuleName, 14, "invalid batch info")
 	ErrInvalidChallengerUpdate    = errorsmod.Register(ModuleName, 15, "invalid challenger update")
+	ErrInvalidDepositCap          = errorsmod.Register(ModuleName, 16, "invalid deposit cap")
+	ErrDepositCapExceeded         = errorsmod.Register(ModuleName, 17, "deposit cap exceeded")
+	ErrInvalidBridgeAccounting    = errorsmod.Register(ModuleName, 18, "invalid bridge accounting")
+	ErrInsufficientSurplus        = errorsmod.Register(ModuleName, 19, "insufficient bridge account surplus")
+	ErrInvalidL2Denom             = errorsmod.Register(ModuleName, 20, "l2 denom does not match the l1 representation denom")
+	ErrInvalidNft                 = errorsmod.Register(ModuleName, 21, "invalid nft")
+	ErrInvalidProvenBitmap        = errorsmod.Register(ModuleName, 22, "invalid proven withdrawal bitmap")
+	ErrInvalidDepositCommitment   = errorsmod.Register(ModuleName, 23, "invalid deposit commitment")
+	ErrL2NativeTokenNotRegistered = errorsmod.Register(ModuleName, 24, "l2 native token not registered")
+	ErrInvalidOutputVersion       = errorsmod.Register(ModuleName, 25, "invalid output version")
+	ErrInvalidParams              = errorsmod.Register(ModuleName, 26, "invalid params")
 )
@@ -10,6 +10,11 @@ const (
 	EventTypeUpdateProposer          = "update_proposer"
 	EventTypeUpdateChallenger        = "update_challenger"
 	EventTypeUpdateBatchInfo         = "update_batch_info"
+	EventTypeUpdateDepositCap        = "update_deposit_cap"
+	EventTypeRecoverBridgeFunds      = "recover_bridge_funds"
+	EventTypeInitiateNftDeposit      = "initiate_nft_deposit"
+	EventTypeFinalizeNftWithdrawal   = "finalize_nft_withdrawal"
+	EventTypeRegisterL2NativeToken   = "register_l2_native_token"
 
 	AttributeKeySubmitter              = "submitter"
 	AttributeKeyCreator                = "creator"
@@ -27,8 +32,19 @@ const (
 	AttributeKeyL1Denom                = "l1_denom"
 	AttributeKeyL2Denom                = "l2_denom"
 	AttributeKeyData                   = "data"
+	AttributeKeyMetadata               = "metadata"
+	AttributeKeyL1ClassId              = "l1_class_id"
+	AttributeKeyL2ClassId              = "l2_class_id"
+	AttributeKeyClassName              = "class_name"
+	AttributeKeyClassSymbol            = "class_symbol"
+	AttributeKeyClassUri               = "class_uri"
+	AttributeKeyTokenId                = "token_id"
+	AttributeKeyTokenUri               = "token_uri"
 	AttributeKeyL1Sequence             = "l1_sequence"
 	AttributeKeyL2Sequence             = "l2_sequence"
 	AttributeKeyFinalizedOutputIndex   = "finalized_output_index"
 	AttributeKeyFinalizedL2BlockNumber = "finalized_l2_block_number"
+	AttributeKeyDenom                  = "denom"
+	AttributeKeyMaxTotalLocked         = "max_total_locked"
+	AttributeKeyMaxPerDeposit          = "max_per_deposit"
 )
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -40,6 +42,18 @@ type BankKeeper interface {
 
 	MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+
+	HasDenomMetaData(ctx context.Context, denom string) bool
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+}
+
+// NftKeeper defines the expected interface needed to lock and release nfts.
+type NftKeeper interface {
+	GetClass(ctx context.Context, classID string) (nft.Class, bool)
+	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
 }
 
 type CommunityPoolKeeper interface {
@@ -53,12 +53,44 @@ func ValidateGenesis(data *GenesisState, ac address.Codec) error {
 			}
 		}
 
+		for _, tokenPair := range bridge.L2NativeTokenPairs {
+			if err := sdk.ValidateDenom(tokenPair.L2Denom); err != nil {
+				return err
+			}
+
+			if L1Denom(bridge.BridgeId, tokenPair.L2Denom) != tokenPair.L1Denom {
+				return ErrInvalidL2Denom
+			}
+		}
+
+		for _, classPair := range bridge.NftClassPairs {
+			if L2ClassId(bridge.BridgeId, classPair.L1ClassId) != classPair.L2ClassId {
+				return ErrInvalidNft.Wrap("l2 class id does not match the l1 class id")
+			}
+		}
+
 		for _, withdrawalHash := range bridge.ProvenWithdrawals {
 			if len(withdrawalHash) != 32 {
 				return ErrInvalidHashLength
 			}
 		}
 
+		for _, bitmap := range bridge.ProvenWithdrawalBitmaps {
+			if len(bitmap.Bitmap) != 32 {
+				return ErrInvalidProvenBitmap.Wrap("proven withdrawal bitmap must be 32 bytes")
+			}
+		}
+
+		for _, commitment := range bridge.DepositCommitments {
+			if len(commitment.Commitment) != 32 {
+				return ErrInvalidDepositCommitment.Wrap("deposit commitment must be 32 bytes")
+			}
+
+			if commitment.Time.IsZero() {
+				return ErrInvalidDepositCommitment.Wrap("deposit commitment time must be set")
+			}
+		}
+
 		for _, proposal := range bridge.Proposals {
 			if proposal.OutputIndex == 0 {
 				return ErrInvalidOutputIndex
@@ -69,6 +101,18 @@ func ValidateGenesis(data *GenesisState, ac address.Codec) error {
 			}
 		}
 
+		for _, depositCap := range bridge.DepositCaps {
+			if err := depositCap.Validate(); err != nil {
+				return err
+			}
+		}
+
+		for _, accounting := range bridge.Accountings {
+			if err := accounting.Validate(); err != nil {
+				return err
+			}
+		}
+
 		if len(bridge.BatchInfos) == 0 {
 			return ErrEmptyBatchInfo
 		}
@@ -99,12 +99,23 @@ type Bridge struct {
 	BridgeConfig BridgeConfig `protobuf:"bytes,4,opt,name=bridge_config,json=bridgeConfig,proto3" json:"bridge_config"`
 	// a list of (l1, l2) token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,5,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
-	// a list of l2 output proposals, which has been proven.
+	// a list of the legacy proven withdrawal hashes.
 	ProvenWithdrawals [][]byte `protobuf:"bytes,6,rep,name=proven_withdrawals,json=provenWithdrawals,proto3" json:"proven_withdrawals,omitempty"`
 	// a list of l2 output proposals.
 	Proposals []WrappedOutput `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals"`
 	// a list of batch infos.
 	BatchInfos []BatchInfoWithOutput `protobuf:"bytes,8,rep,name=batch_infos,json=batchInfos,proto3" json:"batch_infos"`
+	// a list of deposit caps.
+	DepositCaps []DepositCap `protobuf:"bytes,9,rep,name=deposit_caps,json=depositCaps,proto3" json:"deposit_caps"`
+	// a list of the deposit and withdrawal totals by denom.
+	Accountings []BridgeAccounting `protobuf:"bytes,10,rep,name=accountings,proto3" json:"accountings"`
+	// token pairs of the tokens originated from l2.
+	L2NativeTokenPairs []TokenPair    `protobuf:"bytes,11,rep,name=l2_native_token_pairs,json=l2NativeTokenPairs,proto3" json:"l2_native_token_pairs"`
+	NftClassPairs      []NftClassPair `protobuf:"bytes,12,rep,name=nft_class_pairs,json=nftClassPairs,proto3" json:"nft_class_pairs"`
+	// the proven withdrawals by l2 sequence.
+	ProvenWithdrawalBitmaps []ProvenWithdrawalBitmap `protobuf:"bytes,13,rep,name=proven_withdrawal_bitmaps,json=provenWithdrawalBitmaps,proto3" json:"proven_withdrawal_bitmaps"`
+	// the deposit commitments to be proven on l2.
+	DepositCommitments []DepositCommitment `protobuf:"bytes,14,rep,name=deposit_commitments,json=depositCommitments,proto3" json:"deposit_commitments"`
 }
 
 func (m *Bridge) Reset()         { *m = Bridge{} }
@@ -196,6 +207,48 @@ func (m *Bridge) GetBatchInfos() []BatchInfoWithOutput {
 	return nil
 }
 
+func (m *Bridge) GetDepositCaps() []DepositCap {
+	if m != nil {
+		return m.DepositCaps
+	}
+	return nil
+}
+
+func (m *Bridge) GetAccountings() []BridgeAccounting {
+	if m != nil {
+		return m.Accountings
+	}
+	return nil
+}
+
+func (m *Bridge) GetL2NativeTokenPairs() []TokenPair {
+	if m != nil {
+		return m.L2NativeTokenPairs
+	}
+	return nil
+}
+
+func (m *Bridge) GetNftClassPairs() []NftClassPair {
+	if m != nil {
+		return m.NftClassPairs
+	}
+	return nil
+}
+
+func (m *Bridge) GetProvenWithdrawalBitmaps() []ProvenWithdrawalBitmap {
+	if m != nil {
+		return m.ProvenWithdrawalBitmaps
+	}
+	return nil
+}
+
+func (m *Bridge) GetDepositCommitments() []DepositCommitment {
+	if m != nil {
+		return m.DepositCommitments
+	}
+	return nil
+}
+
 type WrappedOutput struct {
 	OutputIndex    uint64 `protobuf:"varint,1,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 	OutputProposal Output `protobuf:"bytes,2,opt,name=output_proposal,json=outputProposal,proto3" json:"output_proposal"`
@@ -257,41 +310,51 @@ func init() {
 func init() { proto.RegisterFile("opinit/ophost/v1/genesis.proto", fileDescriptor_5e2545c1f1c6a3ab) }
 
 var fileDescriptor_5e2545c1f1c6a3ab = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0xaf, 0xd7, 0xd1, 0xad, 0x6e, 0xf6, 0xa7, 0x16, 0x87, 0x68, 0x43, 0x59, 0xa9, 0x40, 0xaa,
-	0x26, 0x96, 0xa8, 0x70, 0x44, 0x5c, 0x8a, 0xc4, 0xa8, 0x34, 0xb1, 0xd2, 0x21, 0x4d, 0xe2, 0x12,
-	0x39, 0x8d, 0x9b, 0x5a, 0xb4, 0xb6, 0x89, 0xdd, 0xae, 0xdc, 0x78, 0x04, 0x1e, 0x83, 0xe3, 0x1e,
-	0x63, 0xc7, 0x1d, 0x39, 0xa1, 0xa9, 0x3d, 0xf0, 0x1a, 0x28, 0x76, 0xa2, 0xa6, 0xa4, 0x5c, 0xaa,
-	0xf8, 0xfb, 0xfd, 0xb3, 0xbf, 0xaf, 0x1f, 0x74, 0xb8, 0xa0, 0x8c, 0x2a, 0x8f, 0x8b, 0x11, 0x97,
-	0xca, 0x9b, 0xb5, 0xbd, 0x88, 0x30, 0x22, 0xa9, 0x74, 0x45, 0xcc, 0x15, 0x47, 0x87, 0x06, 0x77,
-	0x0d, 0xee, 0xce, 0xda, 0x47, 0x75, 0x3c, 0xa1, 0x8c, 0x7b, 0xfa, 0xd7, 0x90, 0x8e, 0x1e, 0x47,
-	0x3c, 0xe2, 0xfa, 0xd3, 0x4b, 0xbe, 0xd2, 0xea, 0x93, 0x82, 0xb5, 0xfa, 0x26, 0x48, 0x6a, 0xdc,
-	0xbc, 0x05, 0xd0, 0x3a, 0x37, 0x51, 0x57, 0x0a, 0x2b, 0x82, 0x5e, 0xc3, 0x8a, 0xc0, 0x31, 0x9e,
-	0x48, 0x1b, 0x34, 0x40, 0xab, 0xf6, 0xd2, 0x76, 0xff, 0x8d, 0x76, 0x7b, 0x1a, 0xef, 0x54, 0xef,
-	0x7e, 0x9f, 0x94, 0x7e, 0xfe, 0xb9, 0x3d, 0x05, 0xfd, 0x54, 0x82, 0xde, 0xc0, 0x9d, 0x20, 0xa6,
-	0x61, 0x44, 0xa4, 0xbd, 0xd5, 0x28, 0x6f, 0x56, 0x77, 0x34, 0x21, 0xaf, 0xce, 0x34, 0xe8, 0x19,
-	0xdc, 0x67, 0x64, 0xae, 0x7c, 0x73, 0xf6, 0x69, 0x68, 0x97, 0x1b, 0xa0, 0xb5, 0xdd, 0xb7, 0x92,
-	0xaa, 0xd1, 0x75, 0xc3, 0xe6, 0x43, 0x19, 0x56, 0xcc, 0x01, 0x1d, 0xc3, 0xea, 0x8a, 0x0b, 0x34,
-	0x77, 0x37, 0x48, 0x79, 0xa8, 0x05, 0x0f, 0xb5, 0xdb, 0xb8, 0xed, 0x4b, 0xf2, 0x75, 0x4a, 0xd8,
-	0x80, 0xd8, 0x5b, 0x9a, 0xa3, 0x53, 0x2e, 0xda, 0x57, 0x69, 0x15, 0x9d, 0xc2, 0xba, 0x66, 0xf2,
-	0xa9, 0x12, 0x53, 0xe5, 0x53, 0x16, 0x92, 0x79, 0x1a, 0x7d, 0x90, 0x00, 0x97, 0xba, 0xde, 0x4d,
-	0xca, 0xe8, 0x03, 0xdc, 0x4b, 0x23, 0x07, 0x9c, 0x0d, 0x69, 0x64, 0x6f, 0xeb, 0x36, 0x39, 0xff,
-	0x7b, 0xe8, 0x5b, 0xcd, 0xca, 0x3f, 0xd7, 0x0a, 0x72, 0x00, 0x3a, 0x87, 0x35, 0xc5, 0xbf, 0x10,
-	0xe6, 0x0b, 0x4c, 0x63, 0x69, 0x3f, 0xd2, 0x6d, 0x3b, 0x2e, 0xba, 0x7d, 0x4a, 0x48, 0x3d, 0x4c,
-	0xe3, 0xbc, 0x15, 0x54, 0x59, 0x55, 0xa2, 0x33, 0x88, 0x44, 0xcc, 0x67, 0x84, 0xf9, 0x37, 0x54,
-	0x8d, 0xc2, 0x18, 0xdf, 0xe0, 0xb1, 0xb4, 0x2b, 0x8d, 0x72, 0xcb, 0xea, 0xd7, 0x0d, 0x72, 0xbd,
-	0x02, 0xd0, 0x7b, 0x58, 0x15, 0x31, 0x17, 0x5c, 0x26, 0xac, 0x1d, 0x9d, 0x7a, 0x52, 0x4c, 0xbd,
-	0x8e, 0xb1, 0x10, 0x24, 0x34, 0x0d, 0xc8, 0x27, 0xaf, 0xc4, 0xe8, 0x23, 0xac, 0x05, 0x58, 0x0d,
-	0x46, 0x3e, 0x65, 0x43, 0x2e, 0xed, 0x5d, 0xed, 0xf5, 0x7c, 0x43, 0x3f, 0x12, 0x52, 0x97, 0x0d,
-	0x79, 0x72, 0x8d, 0xa2, 0x23, 0x0c, 0x32, 0x5c, 0x36, 0xbf, 0x03, 0xb8, 0xb7, 0x16, 0x8d, 0x9e,
-	0x42, 0x6b, 0x6d, 0x3a, 0x66, 0xd8, 0x35, 0x9e, 0x9b, 0xcc, 0x05, 0x3c, 0x48, 0x29, 0xd9, 0xdd,
-	0xf4, 0xb8, 0x37, 0xfe, 0x09, 0x8b, 0xf1, 0xfb, 0x46, 0xdb, 0x4b, 0xa5, 0x9d, 0x77, 0x77, 0x0b,
-	0x07, 0xdc, 0x2f, 0x1c, 0xf0, 0xb0, 0x70, 0xc0, 0x8f, 0xa5, 0x53, 0xba, 0x5f, 0x3a, 0xa5, 0x5f,
-	0x4b, 0xa7, 0xf4, 0xf9, 0x45, 0x44, 0xd5, 0x68, 0x1a, 0xb8, 0x03, 0x3e, 0xf1, 0x12, 0x5b, 0x8a,
-	0xcf, 0xc6, 0x38, 0x90, 0xde, 0x65, 0x4f, 0xef, 0xd9, 0x3c, 0xdb, 0x34, 0xbd, 0x66, 0x41, 0x45,
-	0xef, 0xd9, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xd6, 0xaa, 0xf2, 0xe2, 0x03, 0x00,
-	0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0xde, 0xb4, 0x19, 0x3b, 0xfd, 0x99, 0x7b, 0xaf, 0x30, 0x6d, 0xe5, 0x86, 0x00,
+	0x52, 0x54, 0xd1, 0x58, 0x29, 0x4b, 0xc4, 0x82, 0x14, 0x51, 0x8a, 0xaa, 0x36, 0xfd, 0x91, 0x2a,
+	0xd8, 0x58, 0x63, 0x7b, 0xe2, 0x8c, 0x88, 0x67, 0x06, 0xcf, 0x24, 0x2d, 0x3b, 0x1e, 0x81, 0xc7,
+	0x60, 0xd9, 0xc7, 0xe8, 0xb2, 0x4b, 0x56, 0x08, 0xb5, 0x0b, 0x9e, 0x02, 0x09, 0x79, 0xec, 0x24,
+	0x4e, 0x9c, 0x2e, 0xd8, 0x44, 0x9e, 0xf3, 0x7d, 0xe7, 0xfb, 0x32, 0xe7, 0x9c, 0x39, 0xc0, 0x62,
+	0x9c, 0x50, 0x22, 0x6d, 0xc6, 0xbb, 0x4c, 0x48, 0x7b, 0xd0, 0xb4, 0x03, 0x4c, 0xb1, 0x20, 0xa2,
+	0xc1, 0x23, 0x26, 0x19, 0x5c, 0x49, 0xf0, 0x46, 0x82, 0x37, 0x06, 0xcd, 0xb5, 0x55, 0x14, 0x12,
+	0xca, 0x6c, 0xf5, 0x9b, 0x90, 0xd6, 0xfe, 0x0b, 0x58, 0xc0, 0xd4, 0xa7, 0x1d, 0x7f, 0xa5, 0xd1,
+	0x8d, 0x9c, 0xb4, 0xfc, 0xcc, 0x71, 0x2a, 0x5c, 0xbb, 0xd2, 0x80, 0xb1, 0x97, 0x58, 0x9d, 0x4a,
+	0x24, 0x31, 0x7c, 0x01, 0x4a, 0x1c, 0x45, 0x28, 0x14, 0xa6, 0x56, 0xd5, 0xea, 0xfa, 0x8e, 0xd9,
+	0x98, 0xb6, 0x6e, 0xb4, 0x15, 0xde, 0x2a, 0x5f, 0xff, 0xd8, 0x2c, 0x7c, 0xfb, 0x75, 0xb5, 0xa5,
+	0x9d, 0xa4, 0x29, 0xf0, 0x25, 0x58, 0x70, 0x23, 0xe2, 0x07, 0x58, 0x98, 0x73, 0xd5, 0xe2, 0xec,
+	0xec, 0x96, 0x22, 0x64, 0xb3, 0x87, 0x39, 0xf0, 0x09, 0x58, 0xa2, 0xf8, 0x52, 0x3a, 0xc9, 0xd9,
+	0x21, 0xbe, 0x59, 0xac, 0x6a, 0xf5, 0xf9, 0x13, 0x23, 0x8e, 0x26, 0x79, 0xfb, 0x7e, 0xed, 0xf7,
+	0x02, 0x28, 0x25, 0x07, 0xb8, 0x0e, 0xca, 0x63, 0xae, 0xa6, 0xb8, 0x8b, 0x6e, 0xca, 0x83, 0x75,
+	0xb0, 0xa2, 0xd4, 0x7a, 0x4d, 0x47, 0xe0, 0x4f, 0x7d, 0x4c, 0x3d, 0x6c, 0xce, 0x29, 0x8e, 0x72,
+	0x39, 0x68, 0x9e, 0xa6, 0x51, 0xb8, 0x05, 0x56, 0x15, 0x93, 0xf5, 0x25, 0xef, 0x4b, 0x87, 0x50,
+	0x1f, 0x5f, 0xa6, 0xd6, 0xcb, 0x31, 0x70, 0xa4, 0xe2, 0xfb, 0x71, 0x18, 0x1e, 0x82, 0x4a, 0x6a,
+	0xe9, 0x31, 0xda, 0x21, 0x81, 0x39, 0xaf, 0xca, 0x64, 0xdd, 0x77, 0xd1, 0x5d, 0xc5, 0xca, 0x5e,
+	0xd7, 0x70, 0x33, 0x00, 0xdc, 0x03, 0xba, 0x64, 0x1f, 0x31, 0x75, 0x38, 0x22, 0x91, 0x30, 0xff,
+	0x51, 0x65, 0x5b, 0xcf, 0xab, 0x9d, 0xc5, 0xa4, 0x36, 0x22, 0x51, 0x56, 0x0a, 0xc8, 0x61, 0x54,
+	0xc0, 0x6d, 0x00, 0x79, 0xc4, 0x06, 0x98, 0x3a, 0x17, 0x44, 0x76, 0xfd, 0x08, 0x5d, 0xa0, 0x9e,
+	0x30, 0x4b, 0xd5, 0x62, 0xdd, 0x38, 0x59, 0x4d, 0x90, 0xf3, 0x31, 0x00, 0xdf, 0x82, 0x32, 0x8f,
+	0x18, 0x67, 0x22, 0x66, 0x2d, 0x28, 0xd7, 0xcd, 0xbc, 0xeb, 0x79, 0x84, 0x38, 0xc7, 0x7e, 0x52,
+	0x80, 0xac, 0xf3, 0x38, 0x19, 0x1e, 0x03, 0xdd, 0x45, 0xd2, 0xeb, 0x3a, 0x84, 0x76, 0x98, 0x30,
+	0x17, 0x95, 0xd6, 0xd3, 0x19, 0xf5, 0x88, 0x49, 0xfb, 0xb4, 0xc3, 0xe2, 0xbf, 0x91, 0x57, 0x04,
+	0xee, 0x10, 0x17, 0xf0, 0x1d, 0x30, 0x7c, 0xcc, 0x99, 0x20, 0xd2, 0xf1, 0x10, 0x17, 0x66, 0x59,
+	0x69, 0x6e, 0xe4, 0x35, 0x5f, 0x27, 0xac, 0x5d, 0xc4, 0xb3, 0x52, 0xba, 0x3f, 0x0a, 0x0b, 0x78,
+	0x04, 0x74, 0xe4, 0x79, 0xac, 0x4f, 0x25, 0xa1, 0x81, 0x30, 0x81, 0x92, 0xaa, 0xdd, 0xd7, 0xae,
+	0x57, 0x23, 0xea, 0x84, 0x60, 0x46, 0x01, 0xbe, 0x07, 0xff, 0xf7, 0x76, 0x1c, 0x8a, 0x24, 0x19,
+	0x60, 0x27, 0xdb, 0x3b, 0xfd, 0xaf, 0x7a, 0x07, 0x7b, 0x3b, 0x87, 0x4a, 0xe3, 0x6c, 0xdc, 0xc3,
+	0x63, 0xb0, 0x4c, 0x3b, 0xd2, 0xf1, 0x7a, 0x48, 0x88, 0x54, 0xd4, 0xa8, 0x16, 0x67, 0x8f, 0xd7,
+	0x61, 0x47, 0xee, 0xc6, 0xbc, 0x69, 0xdd, 0x0a, 0xcd, 0x00, 0x02, 0x32, 0xf0, 0x30, 0x37, 0x16,
+	0x8e, 0x4b, 0x64, 0x18, 0xd7, 0xb5, 0xa2, 0xc4, 0xeb, 0x33, 0x9e, 0xf8, 0xd4, 0xbc, 0xb4, 0x54,
+	0x42, 0xd6, 0xe6, 0x01, 0x9f, 0x49, 0x11, 0xd0, 0x01, 0xff, 0x8e, 0x7a, 0xc7, 0xc2, 0x90, 0xc8,
+	0x10, 0x53, 0x29, 0xcc, 0x25, 0x65, 0xf5, 0xf8, 0xfe, 0x16, 0x8e, 0xb8, 0x13, 0x45, 0xf2, 0xa7,
+	0x51, 0x51, 0xfb, 0xa2, 0x81, 0xca, 0xc4, 0x5c, 0xc2, 0x47, 0xc0, 0x98, 0x78, 0xba, 0xc9, 0x26,
+	0xd0, 0x59, 0xe6, 0xd9, 0x1e, 0x80, 0xe5, 0x94, 0x32, 0x1c, 0x5c, 0xb5, 0x0b, 0x66, 0x6e, 0xa8,
+	0xfc, 0x6c, 0x2e, 0x25, 0xb9, 0xed, 0x34, 0xb5, 0xf5, 0xe6, 0xfa, 0xd6, 0xd2, 0x6e, 0x6e, 0x2d,
+	0xed, 0xe7, 0xad, 0xa5, 0x7d, 0xbd, 0xb3, 0x0a, 0x37, 0x77, 0x56, 0xe1, 0xfb, 0x9d, 0x55, 0xf8,
+	0xf0, 0x2c, 0x20, 0xb2, 0xdb, 0x77, 0x1b, 0x1e, 0x0b, 0xed, 0x58, 0x96, 0xa0, 0xed, 0x1e, 0x72,
+	0x85, 0x7d, 0xd4, 0x8e, 0x4f, 0xf6, 0xe5, 0x70, 0x0d, 0xab, 0x1d, 0xec, 0x96, 0xd4, 0x12, 0x7e,
+	0xfe, 0x67, 0x00, 0xdf, 0xfc, 0x23, 0xca, 0xff, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -366,6 +429,90 @@ func (m *Bridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DepositCommitments) > 0 {
+		for iNdEx := len(m.DepositCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ProvenWithdrawalBitmaps) > 0 {
+		for iNdEx := len(m.ProvenWithdrawalBitmaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvenWithdrawalBitmaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.NftClassPairs) > 0 {
+		for iNdEx := len(m.NftClassPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NftClassPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.L2NativeTokenPairs) > 0 {
+		for iNdEx := len(m.L2NativeTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.L2NativeTokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Accountings) > 0 {
+		for iNdEx := len(m.Accountings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accountings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DepositCaps) > 0 {
+		for iNdEx := len(m.DepositCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.BatchInfos) > 0 {
 		for iNdEx := len(m.BatchInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -555,6 +702,42 @@ func (m *Bridge) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DepositCaps) > 0 {
+		for _, e := range m.DepositCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Accountings) > 0 {
+		for _, e := range m.Accountings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.L2NativeTokenPairs) > 0 {
+		for _, e := range m.L2NativeTokenPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NftClassPairs) > 0 {
+		for _, e := range m.NftClassPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProvenWithdrawalBitmaps) > 0 {
+		for _, e := range m.ProvenWithdrawalBitmaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositCommitments) > 0 {
+		for _, e := range m.DepositCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -967,6 +1150,210 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCaps = append(m.DepositCaps, DepositCap{})
+			if err := m.DepositCaps[len(m.DepositCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accountings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accountings = append(m.Accountings, BridgeAccounting{})
+			if err := m.Accountings[len(m.Accountings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2NativeTokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2NativeTokenPairs = append(m.L2NativeTokenPairs, TokenPair{})
+			if err := m.L2NativeTokenPairs[len(m.L2NativeTokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftClassPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftClassPairs = append(m.NftClassPairs, NftClassPair{})
+			if err := m.NftClassPairs[len(m.NftClassPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenWithdrawalBitmaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvenWithdrawalBitmaps = append(m.ProvenWithdrawalBitmaps, ProvenWithdrawalBitmap{})
+			if err := m.ProvenWithdrawalBitmaps[len(m.ProvenWithdrawalBitmaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCommitments = append(m.DepositCommitments, DepositCommitment{})
+			if err := m.DepositCommitments[len(m.DepositCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,13 +17,20 @@ var (
 	NextBridgeIdKey = []byte{0x11}
 	ParamsKey       = []byte{0x12}
 
-	BridgeConfigPrefix     = []byte{0x21}
-	NextL1SequencePrefix   = []byte{0x31}
-	TokenPairPrefix        = []byte{0x41}
-	OutputProposalPrefix   = []byte{0x51}
-	NextOutputIndexPrefix  = []byte{0x61}
-	ProvenWithdrawalPrefix = []byte{0x71}
-	BatchInfoPrefix        = []byte{0x81}
+	BridgeConfigPrefix          = []byte{0x21}
+	NextL1SequencePrefix        = []byte{0x31}
+	TokenPairPrefix             = []byte{0x41}
+	OutputProposalPrefix        = []byte{0x51}
+	NextOutputIndexPrefix       = []byte{0x61}
+	ProvenWithdrawalPrefix      = []byte{0x71}
+	ProvenBitmapPrefix          = []byte{0x72}
+	BatchInfoPrefix             = []byte{0x81}
+	DepositCapPrefix            = []byte{0x91}
+	BridgeAccountingPrefix      = []byte{0x92}
+	L2NativeTokenPairPrefix     = []byte{0x93}
+	NftClassPairPrefix          = []byte{0x94}
+	DepositCommitmentPrefix     = []byte{0xa1}
+	DepositCommitmentTimePrefix = []byte{0xa2}
 
 	Splitter = byte('|')
 )
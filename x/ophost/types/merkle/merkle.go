@@ -0,0 +1,208 @@
+// Package merkle implements the sorted-pair Merkle tree used to commit the
+// L2 withdrawals into the output root.
+//
+// The leaves are double hashed with sha3-256, and each parent node is the
+// sha3-256 hash of its children concatenated in ascending byte order, so a
+// proof is just the list of sibling hashes without any position bits. On the
+// levels with an odd number of nodes, the last node is paired with itself.
+package merkle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// HashSize is the byte length of the tree nodes.
+const HashSize = 32
+
+var (
+	ErrEmptyTree          = errors.New("empty merkle tree")
+	ErrInvalidProofLength = errors.New("invalid merkle proof length")
+	ErrIndexOutOfRange    = errors.New("leaf index out of range")
+	ErrInvalidFrontier    = errors.New("invalid merkle frontier")
+)
+
+// HashLeaf returns the double sha3-256 hash of the leaf data.
+func HashLeaf(data []byte) [HashSize]byte {
+	hash := sha3.Sum256(data)
+	return sha3.Sum256(hash[:])
+}
+
+// HashPair returns the parent of the two nodes, which does not depend on the
+// order of the nodes.
+func HashPair(a, b []byte) [HashSize]byte {
+	seed := make([]byte, 0, len(a)+len(b))
+	if bytes.Compare(a, b) <= 0 {
+		seed = append(append(seed, a...), b...)
+	} else {
+		seed = append(append(seed, b...), a...)
+	}
+
+	return sha3.Sum256(seed)
+}
+
+// ComputeRoot returns the root reached by folding the proof into the leaf.
+func ComputeRoot(leaf [HashSize]byte, proof [][]byte) ([HashSize]byte, error) {
+	node := leaf
+	for _, sibling := range proof {
+		if len(sibling) != HashSize {
+			return [HashSize]byte{}, ErrInvalidProofLength
+		}
+
+		node = HashPair(node[:], sibling)
+	}
+
+	return node, nil
+}
+
+// VerifyProof returns true if the proof connects the leaf to the root.
+func VerifyProof(root []byte, leaf [HashSize]byte, proof [][]byte) bool {
+	computed, err := ComputeRoot(leaf, proof)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(root, computed[:])
+}
+
+// Tree is a sorted-pair Merkle tree built from the ordered leaves.
+type Tree struct {
+	// levels[0] holds the leaves and the last level holds the root.
+	levels [][][HashSize]byte
+}
+
+// NewTree builds a tree from the hashed leaves.
+func NewTree(leaves [][HashSize]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, ErrEmptyTree
+	}
+
+	level := make([][HashSize]byte, len(leaves))
+	copy(level, leaves)
+
+	levels := [][][HashSize]byte{level}
+	for len(level) > 1 {
+		next := make([][HashSize]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			left := level[i]
+			right := left
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+
+			next = append(next, HashPair(left[:], right[:]))
+		}
+
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &Tree{levels: levels}, nil
+}
+
+// Len returns the number of leaves.
+func (t *Tree) Len() int {
+	return len(t.levels[0])
+}
+
+// Leaf returns the leaf at the index.
+func (t *Tree) Leaf(index int) ([HashSize]byte, error) {
+	if index < 0 || index >= t.Len() {
+		return [HashSize]byte{}, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	return t.levels[0][index], nil
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() [HashSize]byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof returns the sibling hashes from the leaf at the index up to the root.
+func (t *Tree) Proof(index int) ([][]byte, error) {
+	if index < 0 || index >= t.Len() {
+		return nil, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	proof := make([][]byte, 0, len(t.levels)-1)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling >= len(level) {
+			// the last node of an odd level is paired with itself
+			sibling = index
+		}
+
+		node := level[sibling]
+		proof = append(proof, append([]byte{}, node[:]...))
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// AppendFrontier appends the leaf to the frontier of a tree with numLeaves
+// leaves and returns the new frontier. The frontier holds the last complete
+// left node of each level, which is valid when the bit of the level is set in
+// the number of the leaves, so the tree grows without keeping all the leaves.
+func AppendFrontier(frontier [][]byte, numLeaves uint64, leaf [HashSize]byte) [][]byte {
+	node := leaf
+	level := 0
+	for ; numLeaves&(1<<level) != 0; level++ {
+		node = HashPair(frontier[level], node[:])
+	}
+
+	for len(frontier) <= level {
+		frontier = append(frontier, nil)
+	}
+	frontier[level] = append([]byte{}, node[:]...)
+
+	return frontier
+}
+
+// FrontierRoot returns the root of the tree with numLeaves leaves from its
+// frontier. It equals the root of NewTree built from the same leaves.
+func FrontierRoot(frontier [][]byte, numLeaves uint64) ([HashSize]byte, error) {
+	if numLeaves == 0 {
+		return [HashSize]byte{}, ErrEmptyTree
+	}
+
+	// carry is the last node of the level built from an incomplete subtree
+	var carry []byte
+	for level := 0; ; level++ {
+		complete := numLeaves >> level
+		if complete == 0 && carry != nil {
+			return [HashSize]byte(carry), nil
+		} else if complete == 1 && carry == nil {
+			if level >= len(frontier) || len(frontier[level]) != HashSize {
+				return [HashSize]byte{}, ErrInvalidFrontier
+			}
+
+			return [HashSize]byte(frontier[level]), nil
+		}
+
+		switch {
+		case complete&1 == 1:
+			if level >= len(frontier) || len(frontier[level]) != HashSize {
+				return [HashSize]byte{}, ErrInvalidFrontier
+			}
+
+			// the last complete node is paired with the carry, or with
+			// itself on an odd level
+			sibling := carry
+			if sibling == nil {
+				sibling = frontier[level]
+			}
+
+			parent := HashPair(frontier[level], sibling)
+			carry = parent[:]
+		case carry != nil:
+			// the carry is the last node of an odd level
+			parent := HashPair(carry, carry)
+			carry = parent[:]
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package merkle_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
+)
+
+func randomLeaves(r *rand.Rand, n int) [][merkle.HashSize]byte {
+	leaves := make([][merkle.HashSize]byte, n)
+	for i := range leaves {
+		data := make([]byte, 1+r.Intn(64))
+		r.Read(data)
+		leaves[i] = merkle.HashLeaf(data)
+	}
+
+	return leaves
+}
+
+func Test_HashPair_Commutative(t *testing.T) {
+	f := func(a, b [merkle.HashSize]byte) bool {
+		return merkle.HashPair(a[:], b[:]) == merkle.HashPair(b[:], a[:])
+	}
+	require.NoError(t, quick.Check(f, nil))
+}
+
+func Test_SingleLeaf(t *testing.T) {
+	leaf := merkle.HashLeaf([]byte("leaf"))
+	tree, err := merkle.NewTree([][merkle.HashSize]byte{leaf})
+	require.NoError(t, err)
+	require.Equal(t, leaf, tree.Root())
+
+	proof, err := tree.Proof(0)
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.True(t, merkle.VerifyProof(leaf[:], leaf, proof))
+}
+
+func Test_EmptyTree(t *testing.T) {
+	_, err := merkle.NewTree(nil)
+	require.ErrorIs(t, err, merkle.ErrEmptyTree)
+}
+
+func Test_KnownRoot(t *testing.T) {
+	leaves := [][merkle.HashSize]byte{
+		merkle.HashLeaf([]byte("a")),
+		merkle.HashLeaf([]byte("b")),
+		merkle.HashLeaf([]byte("c")),
+	}
+	tree, err := merkle.NewTree(leaves)
+	require.NoError(t, err)
+
+	sortedHash := func(a, b [merkle.HashSize]byte) [merkle.HashSize]byte {
+		if bytes.Compare(a[:], b[:]) > 0 {
+			a, b = b, a
+		}
+		return sha3.Sum256(append(a[:], b[:]...))
+	}
+
+	ab := sortedHash(leaves[0], leaves[1])
+	cc := sortedHash(leaves[2], leaves[2])
+	require.Equal(t, sortedHash(ab, cc), tree.Root())
+}
+
+// Test_BuilderVerifierAgree checks that every proof produced by the tree
+// verifies against its root, and fails for a different leaf or root.
+func Test_BuilderVerifierAgree(t *testing.T) {
+	f := func(seed int64, size uint8) bool {
+		r := rand.New(rand.NewSource(seed))
+		leaves := randomLeaves(r, 1+int(size))
+
+		tree, err := merkle.NewTree(leaves)
+		if err != nil {
+			return false
+		}
+		root := tree.Root()
+
+		for i, leaf := range leaves {
+			proof, err := tree.Proof(i)
+			if err != nil {
+				return false
+			}
+
+			if !merkle.VerifyProof(root[:], leaf, proof) {
+				return false
+			}
+
+			computed, err := merkle.ComputeRoot(leaf, proof)
+			if err != nil || computed != root {
+				return false
+			}
+
+			other := merkle.HashLeaf(append(leaf[:], 1))
+			if merkle.VerifyProof(root[:], other, proof) {
+				return false
+			}
+
+			otherRoot := merkle.HashLeaf(root[:])
+			if merkle.VerifyProof(otherRoot[:], leaf, proof) {
+				return false
+			}
+		}
+
+		return true
+	}
+	require.NoError(t, quick.Check(f, &quick.Config{MaxCount: 50}))
+}
+
+func Test_InvalidProof(t *testing.T) {
+	leaves := randomLeaves(rand.New(rand.NewSource(1)), 4)
+	tree, err := merkle.NewTree(leaves)
+	require.NoError(t, err)
+
+	_, err = tree.Proof(4)
+	require.ErrorIs(t, err, merkle.ErrIndexOutOfRange)
+
+	root := tree.Root()
+	_, err = merkle.ComputeRoot(leaves[0], [][]byte{{1, 2, 3}})
+	require.ErrorIs(t, err, merkle.ErrInvalidProofLength)
+	require.False(t, merkle.VerifyProof(root[:], leaves[0], [][]byte{{1, 2, 3}}))
+}
+
+func Test_FrontierRoot(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	leaves := randomLeaves(r, 70)
+
+	var frontier [][]byte
+	for i, leaf := range leaves {
+		frontier = merkle.AppendFrontier(frontier, uint64(i), leaf)
+
+		tree, err := merkle.NewTree(leaves[:i+1])
+		require.NoError(t, err)
+
+		root, err := merkle.FrontierRoot(frontier, uint64(i+1))
+		require.NoError(t, err)
+		require.Equal(t, tree.Root(), root, "leaves %d", i+1)
+	}
+
+	_, err := merkle.FrontierRoot(nil, 0)
+	require.ErrorIs(t, err, merkle.ErrEmptyTree)
+}
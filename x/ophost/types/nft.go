@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+	fmt "fmt"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
+)
+
+// NftWithdrawalLeafDomain separates the nft withdrawal leaves from the
+// token withdrawal leaves in the withdrawal merkle tree.
+var NftWithdrawalLeafDomain = sha3.Sum256([]byte("opinit/nft_withdrawal"))
+
+// L2_NFT_CLASS_PREFIX separates the wrapped nft class ids from the l2
+// denoms of the bridged tokens.
+const L2_NFT_CLASS_PREFIX = "l2nft/"
+
+// L2ClassId returns the class id of the wrapped nft on l2.
+func L2ClassId(bridgeId uint64, l1ClassId string) string {
+	var bz []byte
+	bz = binary.BigEndian.AppendUint64(bz, bridgeId)
+	bz = append(bz, []byte(l1ClassId)...)
+
+	hash := sha3.Sum256(bz)
+	return fmt.Sprintf("%s%x", L2_NFT_CLASS_PREFIX, hash[:])
+}
+
+// GenerateNftWithdrawalHash returns the double hashed leaf of a nft withdrawal;
+//
+//	nft_withdrawal_domain(32) || leaf_version(1) ||
+//	bridge_id(8) || l2_sequence(8) ||
+//	len(sender)(4) || sender || len(receiver)(4) || receiver ||
+//	len(class_id)(4) || class_id || len(token_id)(4) || token_id
+//
+// All integers are big endian. The leaf version is WithdrawalLeafVersionV2.
+func GenerateNftWithdrawalHash(bridgeId, sequence uint64, sender, receiver []byte, classId, tokenId string) [32]byte {
+	seed := append([]byte{}, NftWithdrawalLeafDomain[:]...)
+	seed = append(seed, WithdrawalLeafVersionV2)
+	seed = binary.BigEndian.AppendUint64(seed, bridgeId)
+	seed = binary.BigEndian.AppendUint64(seed, sequence)
+	seed = appendLengthPrefixed(seed, sender)
+	seed = appendLengthPrefixed(seed, receiver)
+	seed = appendLengthPrefixed(seed, []byte(classId))
+	seed = appendLengthPrefixed(seed, []byte(tokenId))
+
+	return merkle.HashLeaf(seed)
+}
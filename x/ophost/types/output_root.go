@@ -0,0 +1,116 @@
+package types
+
+import (
+	"golang.org/x/crypto/sha3"
+)
+
+// OutputRoot is the preimage of an output root proposed to the bridge.
+type OutputRoot interface {
+	// Compute returns the output root.
+	Compute() [32]byte
+	// GetStorageRoot returns the root of the withdrawal merkle tree.
+	GetStorageRoot() []byte
+}
+
+// OutputRootV1 is the output root preimage of
+// sha3(version || state_root || storage_root || latest_block_hash)
+// with 32 bytes for each field.
+type OutputRootV1 struct {
+	Version         []byte
+	StateRoot       []byte
+	StorageRoot     []byte
+	LatestBlockHash []byte
+}
+
+var _ OutputRoot = OutputRootV1{}
+
+// NewOutputRootV1 creates a new OutputRootV1 instance.
+func NewOutputRootV1(version, stateRoot, storageRoot, latestBlockHash []byte) OutputRootV1 {
+	return OutputRootV1{
+		Version:         version,
+		StateRoot:       stateRoot,
+		StorageRoot:     storageRoot,
+		LatestBlockHash: latestBlockHash,
+	}
+}
+
+// Compute implements OutputRoot.
+func (o OutputRootV1) Compute() [32]byte {
+	seed := make([]byte, 32*4)
+	copy(seed, o.Version)
+	copy(seed[32:], o.StateRoot)
+	copy(seed[64:], o.StorageRoot)
+	copy(seed[96:], o.LatestBlockHash)
+
+	return sha3.Sum256(seed)
+}
+
+// GetStorageRoot implements OutputRoot.
+func (o OutputRootV1) GetStorageRoot() []byte {
+	return o.StorageRoot
+}
+
+// OutputRootScheme defines how the outputs of a version are built.
+type OutputRootScheme struct {
+	// NewOutputRoot builds the output root preimage from the fields
+	// submitted with a withdrawal.
+	NewOutputRoot func(version, stateRoot, storageRoot, latestBlockHash []byte) OutputRoot
+	// WithdrawalLeafVersion is the encoding of the withdrawal leaves
+	// committed in the storage root.
+	WithdrawalLeafVersion byte
+}
+
+// OutputVersionV1 is the output root version of the outputs whose
+// withdrawal leaves are encoded with WithdrawalLeafVersionV1.
+var OutputVersionV1 = func() []byte {
+	version := make([]byte, 32)
+	version[31] = WithdrawalLeafVersionV1
+	return version
+}()
+
+// OutputVersionV2 is the output root version of the outputs whose
+// withdrawal leaves are encoded with WithdrawalLeafVersionV2.
+var OutputVersionV2 = func() []byte {
+	version := make([]byte, 32)
+	version[31] = WithdrawalLeafVersionV2
+	return version
+}()
+
+// LegacyOutputRootScheme is the scheme of the outputs proposed before the
+// output versions are recorded. Their version is an opaque part of the
+// output root preimage and their withdrawal leaves are encoded with
+// WithdrawalLeafVersionV1.
+var LegacyOutputRootScheme = OutputRootScheme{
+	NewOutputRoot: func(version, stateRoot, storageRoot, latestBlockHash []byte) OutputRoot {
+		return NewOutputRootV1(version, stateRoot, storageRoot, latestBlockHash)
+	},
+	WithdrawalLeafVersion: WithdrawalLeafVersionV1,
+}
+
+// outputRootSchemes is the fixed table of the output root versions. The
+// table is a part of the consensus rules, so a new scheme is added here
+// together with a chain upgrade.
+var outputRootSchemes = map[[32]byte]OutputRootScheme{
+	[32]byte(OutputVersionV1): LegacyOutputRootScheme,
+	[32]byte(OutputVersionV2): {
+		NewOutputRoot: func(version, stateRoot, storageRoot, latestBlockHash []byte) OutputRoot {
+			return NewOutputRootV1(version, stateRoot, storageRoot, latestBlockHash)
+		},
+		WithdrawalLeafVersion: WithdrawalLeafVersionV2,
+	},
+}
+
+// GetOutputRootScheme returns the scheme of the output root version. It
+// returns ErrInvalidOutputVersion for a malformed or an unknown version.
+func GetOutputRootScheme(version []byte) (OutputRootScheme, error) {
+	if len(version) != 32 {
+		return OutputRootScheme{}, ErrInvalidOutputVersion.Wrapf("invalid length %d", len(version))
+	}
+
+	scheme, found := outputRootSchemes[[32]byte(version)]
+	if !found {
+		return OutputRootScheme{}, ErrInvalidOutputVersion.Wrapf("unknown version %x", version)
+	}
+
+	return scheme, nil
+}
@@ -1,24 +1,29 @@
 package types
 
 import (
+	"time"
+
 	"gopkg.in/yaml.v3"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	DefaultRegistrationFee = sdk.Coins{}
+	DefaultRegistrationFee            = sdk.Coins{}
+	DefaultDepositCommitmentRetention = 30 * 24 * time.Hour
 )
 
 func DefaultParams() Params {
 	return Params{
-		RegistrationFee: DefaultRegistrationFee,
+		RegistrationFee:            DefaultRegistrationFee,
+		DepositCommitmentRetention: DefaultDepositCommitmentRetention,
 	}
 }
 
-func NewParams(registrationFee ...sdk.Coin) Params {
+func NewParams(depositCommitmentRetention time.Duration, registrationFee ...sdk.Coin) Params {
 	return Params{
-		RegistrationFee: registrationFee,
+		RegistrationFee:            registrationFee,
+		DepositCommitmentRetention: depositCommitmentRetention,
 	}
 }
 
@@ -35,5 +40,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.DepositCommitmentRetention < 0 {
+		return ErrInvalidParams.Wrap("deposit commitment retention must be non-negative")
+	}
+
 	return nil
 }
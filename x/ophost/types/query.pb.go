@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -528,6 +529,222 @@ func (m *QueryTokenPairsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryL2NativeTokenPairsRequest is request type for the Query/L2NativeTokenPairs RPC method
+type QueryL2NativeTokenPairsRequest struct {
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryL2NativeTokenPairsRequest) Reset()         { *m = QueryL2NativeTokenPairsRequest{} }
+func (m *QueryL2NativeTokenPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryL2NativeTokenPairsRequest) ProtoMessage()    {}
+func (*QueryL2NativeTokenPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{10}
+}
+func (m *QueryL2NativeTokenPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryL2NativeTokenPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryL2NativeTokenPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryL2NativeTokenPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryL2NativeTokenPairsRequest.Merge(m, src)
+}
+func (m *QueryL2NativeTokenPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryL2NativeTokenPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryL2NativeTokenPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryL2NativeTokenPairsRequest proto.InternalMessageInfo
+
+func (m *QueryL2NativeTokenPairsRequest) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
+	}
+	return 0
+}
+
+func (m *QueryL2NativeTokenPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryL2NativeTokenPairsResponse is response type for the Query/L2NativeTokenPairs RPC method
+type QueryL2NativeTokenPairsResponse struct {
+	TokenPairs []TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryL2NativeTokenPairsResponse) Reset()         { *m = QueryL2NativeTokenPairsResponse{} }
+func (m *QueryL2NativeTokenPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryL2NativeTokenPairsResponse) ProtoMessage()    {}
+func (*QueryL2NativeTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{11}
+}
+func (m *QueryL2NativeTokenPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryL2NativeTokenPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryL2NativeTokenPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryL2NativeTokenPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryL2NativeTokenPairsResponse.Merge(m, src)
+}
+func (m *QueryL2NativeTokenPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryL2NativeTokenPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryL2NativeTokenPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryL2NativeTokenPairsResponse proto.InternalMessageInfo
+
+func (m *QueryL2NativeTokenPairsResponse) GetTokenPairs() []TokenPair {
+	if m != nil {
+		return m.TokenPairs
+	}
+	return nil
+}
+
+func (m *QueryL2NativeTokenPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNftClassPairsRequest is request type for the Query/NftClassPairs RPC method
+type QueryNftClassPairsRequest struct {
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNftClassPairsRequest) Reset()         { *m = QueryNftClassPairsRequest{} }
+func (m *QueryNftClassPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNftClassPairsRequest) ProtoMessage()    {}
+func (*QueryNftClassPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{12}
+}
+func (m *QueryNftClassPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNftClassPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNftClassPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNftClassPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNftClassPairsRequest.Merge(m, src)
+}
+func (m *QueryNftClassPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNftClassPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNftClassPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNftClassPairsRequest proto.InternalMessageInfo
+
+func (m *QueryNftClassPairsRequest) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
+	}
+	return 0
+}
+
+func (m *QueryNftClassPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNftClassPairsResponse is response type for the Query/NftClassPairs RPC method
+type QueryNftClassPairsResponse struct {
+	ClassPairs []NftClassPair `protobuf:"bytes,1,rep,name=class_pairs,json=classPairs,proto3" json:"class_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNftClassPairsResponse) Reset()         { *m = QueryNftClassPairsResponse{} }
+func (m *QueryNftClassPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNftClassPairsResponse) ProtoMessage()    {}
+func (*QueryNftClassPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{13}
+}
+func (m *QueryNftClassPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNftClassPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNftClassPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNftClassPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNftClassPairsResponse.Merge(m, src)
+}
+func (m *QueryNftClassPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNftClassPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNftClassPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNftClassPairsResponse proto.InternalMessageInfo
+
+func (m *QueryNftClassPairsResponse) GetClassPairs() []NftClassPair {
+	if m != nil {
+		return m.ClassPairs
+	}
+	return nil
+}
+
+func (m *QueryNftClassPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryLastFinalizedOutputRequest struct {
 	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
 }
@@ -536,7 +753,7 @@ func (m *QueryLastFinalizedOutputRequest) Reset()         { *m = QueryLastFinali
 func (m *QueryLastFinalizedOutputRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastFinalizedOutputRequest) ProtoMessage()    {}
 func (*QueryLastFinalizedOutputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{10}
+	return fileDescriptor_7dd525d30e46de74, []int{14}
 }
 func (m *QueryLastFinalizedOutputRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +798,7 @@ func (m *QueryLastFinalizedOutputResponse) Reset()         { *m = QueryLastFinal
 func (m *QueryLastFinalizedOutputResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastFinalizedOutputResponse) ProtoMessage()    {}
 func (*QueryLastFinalizedOutputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{11}
+	return fileDescriptor_7dd525d30e46de74, []int{15}
 }
 func (m *QueryLastFinalizedOutputResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +851,7 @@ func (m *QueryOutputProposalRequest) Reset()         { *m = QueryOutputProposalR
 func (m *QueryOutputProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOutputProposalRequest) ProtoMessage()    {}
 func (*QueryOutputProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{12}
+	return fileDescriptor_7dd525d30e46de74, []int{16}
 }
 func (m *QueryOutputProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +905,7 @@ func (m *QueryOutputProposalResponse) Reset()         { *m = QueryOutputProposal
 func (m *QueryOutputProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutputProposalResponse) ProtoMessage()    {}
 func (*QueryOutputProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{13}
+	return fileDescriptor_7dd525d30e46de74, []int{17}
 }
 func (m *QueryOutputProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +967,7 @@ func (m *QueryOutputProposalsRequest) Reset()         { *m = QueryOutputProposal
 func (m *QueryOutputProposalsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOutputProposalsRequest) ProtoMessage()    {}
 func (*QueryOutputProposalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{14}
+	return fileDescriptor_7dd525d30e46de74, []int{18}
 }
 func (m *QueryOutputProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1028,7 @@ func (m *QueryOutputProposalsResponse) Reset()         { *m = QueryOutputProposa
 func (m *QueryOutputProposalsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutputProposalsResponse) ProtoMessage()    {}
 func (*QueryOutputProposalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{15}
+	return fileDescriptor_7dd525d30e46de74, []int{19}
 }
 func (m *QueryOutputProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -854,22 +1071,24 @@ func (m *QueryOutputProposalsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryDepositCapRequest is request type for the Query/DepositCap RPC method
+type QueryDepositCapRequest struct {
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{16}
+func (m *QueryDepositCapRequest) Reset()         { *m = QueryDepositCapRequest{} }
+func (m *QueryDepositCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapRequest) ProtoMessage()    {}
+func (*QueryDepositCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{20}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDepositCapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDepositCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDepositCapRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -879,36 +1098,51 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryDepositCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryDepositCapRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryDepositCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDepositCapRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryDepositCapRequest) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd525d30e46de74, []int{17}
+func (m *QueryDepositCapRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryDepositCapResponse is response type for the Query/DepositCap RPC method
+type QueryDepositCapResponse struct {
+	DepositCap DepositCap `protobuf:"bytes,1,opt,name=deposit_cap,json=depositCap,proto3" json:"deposit_cap"`
+	// locked_amount is the current balance of the denom in the bridge account.
+	LockedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked_amount,json=lockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"locked_amount"`
+}
+
+func (m *QueryDepositCapResponse) Reset()         { *m = QueryDepositCapResponse{} }
+func (m *QueryDepositCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapResponse) ProtoMessage()    {}
+func (*QueryDepositCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{21}
+}
+func (m *QueryDepositCapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDepositCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDepositCapResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -918,887 +1152,1176 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryDepositCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryDepositCapResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryDepositCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDepositCapResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryDepositCapResponse) GetDepositCap() DepositCap {
 	if m != nil {
-		return m.Params
+		return m.DepositCap
 	}
-	return Params{}
+	return DepositCap{}
 }
 
-func init() {
-	proto.RegisterType((*QueryBridgeRequest)(nil), "opinit.ophost.v1.QueryBridgeRequest")
-	proto.RegisterType((*QueryBridgeResponse)(nil), "opinit.ophost.v1.QueryBridgeResponse")
-	proto.RegisterType((*QueryBridgesRequest)(nil), "opinit.ophost.v1.QueryBridgesRequest")
-	proto.RegisterType((*QueryBridgesResponse)(nil), "opinit.ophost.v1.QueryBridgesResponse")
-	proto.RegisterType((*QueryTokenPairByL1DenomRequest)(nil), "opinit.ophost.v1.QueryTokenPairByL1DenomRequest")
-	proto.RegisterType((*QueryTokenPairByL1DenomResponse)(nil), "opinit.ophost.v1.QueryTokenPairByL1DenomResponse")
-	proto.RegisterType((*QueryTokenPairByL2DenomRequest)(nil), "opinit.ophost.v1.QueryTokenPairByL2DenomRequest")
-	proto.RegisterType((*QueryTokenPairByL2DenomResponse)(nil), "opinit.ophost.v1.QueryTokenPairByL2DenomResponse")
-	proto.RegisterType((*QueryTokenPairsRequest)(nil), "opinit.ophost.v1.QueryTokenPairsRequest")
-	proto.RegisterType((*QueryTokenPairsResponse)(nil), "opinit.ophost.v1.QueryTokenPairsResponse")
-	proto.RegisterType((*QueryLastFinalizedOutputRequest)(nil), "opinit.ophost.v1.QueryLastFinalizedOutputRequest")
-	proto.RegisterType((*QueryLastFinalizedOutputResponse)(nil), "opinit.ophost.v1.QueryLastFinalizedOutputResponse")
-	proto.RegisterType((*QueryOutputProposalRequest)(nil), "opinit.ophost.v1.QueryOutputProposalRequest")
-	proto.RegisterType((*QueryOutputProposalResponse)(nil), "opinit.ophost.v1.QueryOutputProposalResponse")
-	proto.RegisterType((*QueryOutputProposalsRequest)(nil), "opinit.ophost.v1.QueryOutputProposalsRequest")
-	proto.RegisterType((*QueryOutputProposalsResponse)(nil), "opinit.ophost.v1.QueryOutputProposalsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "opinit.ophost.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "opinit.ophost.v1.QueryParamsResponse")
+// QueryDepositCapsRequest is request type for the Query/DepositCaps RPC method
+type QueryDepositCapsRequest struct {
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("opinit/ophost/v1/query.proto", fileDescriptor_7dd525d30e46de74) }
-
-var fileDescriptor_7dd525d30e46de74 = []byte{
-	// 1073 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xde, 0x49, 0x20, 0x3f, 0xde, 0x96, 0xa6, 0x4c, 0x23, 0xd8, 0xec, 0x46, 0x9b, 0xc5, 0x22,
-	0xe9, 0x52, 0x35, 0x36, 0xeb, 0x9e, 0x68, 0x68, 0x10, 0x0b, 0xa4, 0xa4, 0x8a, 0xc8, 0xb2, 0x70,
-	0x40, 0x08, 0x69, 0x35, 0x1b, 0xbb, 0x8e, 0xc5, 0xae, 0xc7, 0xf5, 0x78, 0xa3, 0x86, 0x12, 0x09,
-	0x71, 0x2a, 0x37, 0xa4, 0xfe, 0x03, 0xe5, 0x80, 0x84, 0x04, 0x07, 0x0e, 0x1c, 0xb8, 0x70, 0x04,
-	0xf5, 0xc0, 0xa1, 0x82, 0x0b, 0x27, 0x84, 0x12, 0x24, 0xf8, 0x1f, 0xb8, 0x20, 0x7b, 0xc6, 0x6b,
-	0x7b, 0x6d, 0x77, 0xbd, 0x51, 0x7a, 0x89, 0xb2, 0x6f, 0xde, 0x7b, 0xf3, 0x7d, 0xdf, 0x7b, 0x7e,
-	0xcf, 0x86, 0x65, 0x6a, 0x9b, 0x96, 0xe9, 0x2a, 0xd4, 0xde, 0xa7, 0xcc, 0x55, 0x0e, 0x1a, 0xca,
-	0xed, 0x81, 0xee, 0x1c, 0xca, 0xb6, 0x43, 0x5d, 0x8a, 0x2f, 0xf0, 0x53, 0x99, 0x9f, 0xca, 0x07,
-	0x8d, 0xf2, 0xb3, 0xa4, 0x6f, 0x5a, 0x54, 0xf1, 0xff, 0x72, 0xa7, 0xf2, 0xe5, 0x3d, 0xca, 0xfa,
-	0x94, 0x29, 0x5d, 0xc2, 0x74, 0x1e, 0xad, 0x1c, 0x34, 0xba, 0xba, 0x4b, 0x1a, 0x8a, 0x4d, 0x0c,
-	0xd3, 0x22, 0xae, 0x49, 0x2d, 0xe1, 0x5b, 0x11, 0xbe, 0x81, 0x5b, 0xf4, 0xb6, 0xf2, 0x12, 0x3f,
-	0xec, 0xf8, 0xbf, 0x14, 0xfe, 0x43, 0x1c, 0x2d, 0x1a, 0xd4, 0xa0, 0xdc, 0xee, 0xfd, 0x27, 0xac,
-	0xcb, 0x06, 0xa5, 0x46, 0x4f, 0x57, 0x88, 0x6d, 0x2a, 0xc4, 0xb2, 0xa8, 0xeb, 0x5f, 0x15, 0xc4,
-	0x24, 0xa9, 0xb9, 0x87, 0xb6, 0x2e, 0x4e, 0xa5, 0x0d, 0xc0, 0xef, 0x7a, 0x77, 0x37, 0x1d, 0x53,
-	0x33, 0xf4, 0xb6, 0x7e, 0x7b, 0xa0, 0x33, 0x17, 0x57, 0x60, 0xbe, 0xeb, 0x1b, 0x3a, 0xa6, 0x56,
-	0x42, 0x35, 0x54, 0x7f, 0xaa, 0x3d, 0xc7, 0x0d, 0xdb, 0xda, 0xb5, 0xb9, 0x7b, 0x0f, 0x56, 0x0a,
-	0xff, 0x3e, 0x58, 0x29, 0x48, 0x3f, 0x21, 0xb8, 0x18, 0x8b, 0x66, 0x36, 0xb5, 0x98, 0xfe, 0xd8,
-	0x70, 0xfc, 0x0a, 0x14, 0xc5, 0x21, 0xd1, 0x34, 0xa7, 0x34, 0x55, 0x43, 0xf5, 0xf9, 0x66, 0xe9,
-	0xb7, 0x1f, 0xd6, 0x17, 0x05, 0xd5, 0xd7, 0x35, 0xcd, 0xd1, 0x19, 0x7b, 0xcf, 0x75, 0x4c, 0xcb,
-	0x68, 0x03, 0x77, 0xf6, 0x8c, 0xf8, 0x1d, 0x78, 0x46, 0x84, 0xee, 0x51, 0xeb, 0x96, 0x69, 0x94,
-	0xa6, 0x6b, 0xa8, 0x5e, 0x54, 0xab, 0xf2, 0x68, 0x7d, 0x64, 0x0e, 0xe8, 0x0d, 0xdf, 0xab, 0x39,
-	0xff, 0xf0, 0xcf, 0x95, 0xc2, 0x37, 0xff, 0x7c, 0x7f, 0x19, 0xb5, 0xcf, 0x75, 0x23, 0x07, 0x92,
-	0x11, 0x83, 0xcf, 0x02, 0xf6, 0x5b, 0x00, 0x61, 0xc5, 0x7c, 0xfc, 0x45, 0x75, 0x4d, 0x16, 0xe8,
-	0xbc, 0xf2, 0xca, 0xbc, 0x5c, 0xa2, 0xbc, 0x72, 0x8b, 0x0c, 0x95, 0x6b, 0x47, 0x22, 0x23, 0x42,
-	0x7d, 0x8b, 0x60, 0x31, 0x7e, 0x93, 0x50, 0xea, 0x26, 0xcc, 0x72, 0x44, 0xac, 0x84, 0x6a, 0xd3,
-	0xf5, 0xa2, 0xba, 0x9a, 0xe4, 0x92, 0xa2, 0x70, 0x94, 0x52, 0x90, 0x00, 0xdf, 0x88, 0xc1, 0x9e,
-	0xf2, 0x61, 0x5f, 0x1a, 0x0b, 0x9b, 0x27, 0x8c, 0xe2, 0x96, 0x3e, 0x80, 0xaa, 0x7f, 0xe7, 0xfb,
-	0xf4, 0x63, 0xdd, 0x6a, 0x11, 0xd3, 0x69, 0x1e, 0xee, 0x34, 0xde, 0xd4, 0x2d, 0xda, 0xcf, 0xd3,
-	0x1f, 0x78, 0x09, 0xe6, 0x7a, 0x8d, 0x8e, 0xe6, 0xf9, 0xf3, 0xea, 0xb6, 0x67, 0x7b, 0x3c, 0x5c,
-	0xda, 0x87, 0x95, 0xcc, 0xcc, 0x42, 0x91, 0xb7, 0x00, 0x5c, 0xef, 0xb4, 0x63, 0x13, 0xd3, 0x11,
-	0xe2, 0x57, 0x92, 0xa2, 0x84, 0x19, 0x22, 0x52, 0xcc, 0xbb, 0x81, 0x35, 0x95, 0x83, 0x3a, 0x19,
-	0x07, 0x75, 0x84, 0x83, 0x9a, 0xcd, 0x41, 0x7d, 0x22, 0x1c, 0x8e, 0xe0, 0xb9, 0xf8, 0x4d, 0x2c,
-	0x17, 0xf6, 0xad, 0x94, 0x3e, 0x38, 0x45, 0xfb, 0x7a, 0x4d, 0xfb, 0x7c, 0xe2, 0x7e, 0xc1, 0xf0,
-	0x06, 0x14, 0x43, 0x86, 0x41, 0xef, 0xe6, 0xa5, 0x08, 0x43, 0x8a, 0x67, 0xd8, 0xb4, 0x9b, 0xa2,
-	0x2c, 0x3b, 0x84, 0xb9, 0x5b, 0xa6, 0x45, 0x7a, 0xe6, 0x27, 0xba, 0xb6, 0x3b, 0x70, 0xed, 0x81,
-	0x9b, 0x47, 0x35, 0xe9, 0x3e, 0x82, 0x5a, 0x76, 0x02, 0x41, 0xfb, 0x05, 0x38, 0x47, 0x7d, 0x4b,
-	0xc7, 0xb4, 0x34, 0xfd, 0x8e, 0x48, 0x52, 0xe4, 0xb6, 0x6d, 0xcf, 0x84, 0x77, 0x60, 0x41, 0xb8,
-	0xd8, 0x0e, 0xb5, 0x29, 0x23, 0x3d, 0xc1, 0xaa, 0x94, 0x54, 0x87, 0x67, 0x8f, 0x4a, 0x73, 0x9e,
-	0xc7, 0xb6, 0x44, 0xa8, 0xf4, 0x11, 0x94, 0x7d, 0x50, 0xbb, 0x31, 0x73, 0xae, 0x36, 0x18, 0xc5,
-	0x3a, 0x95, 0xc0, 0xea, 0x55, 0xb8, 0x92, 0x9a, 0x3e, 0xcf, 0x1c, 0x1f, 0x9f, 0x3f, 0x4d, 0x8b,
-	0xe9, 0xd3, 0x6b, 0xf1, 0x75, 0x3a, 0x5a, 0x76, 0x46, 0x6a, 0x8c, 0x3c, 0x37, 0xd3, 0xa7, 0x7e,
-	0x6e, 0x7e, 0x45, 0xb0, 0x9c, 0x8e, 0x53, 0xc8, 0xba, 0x07, 0x17, 0x46, 0x64, 0x09, 0x9e, 0xa0,
-	0xf5, 0x8c, 0xe9, 0x9f, 0x5e, 0x9f, 0xa8, 0x58, 0x0b, 0x71, 0xb1, 0xce, 0xf0, 0xc1, 0x5a, 0x14,
-	0x6f, 0x08, 0x2d, 0xe2, 0x90, 0x7e, 0x20, 0xb6, 0xd4, 0x16, 0xab, 0x33, 0xb0, 0x0a, 0x6a, 0x1b,
-	0x30, 0x63, 0xfb, 0x16, 0x31, 0xf5, 0x52, 0x0a, 0xcd, 0x23, 0xa2, 0xd8, 0x45, 0x88, 0xfa, 0x5f,
-	0x11, 0x9e, 0xf6, 0x93, 0xe2, 0x2f, 0x10, 0xcc, 0xf0, 0x8d, 0x87, 0x5f, 0x1c, 0xb3, 0x10, 0x7d,
-	0x38, 0xe5, 0x7c, 0x6b, 0x53, 0x52, 0xef, 0x79, 0x17, 0x7e, 0xfe, 0xfb, 0xdf, 0xf7, 0xa7, 0x2e,
-	0xe1, 0x55, 0x25, 0xf1, 0x66, 0x24, 0x56, 0xa9, 0x72, 0x77, 0xd8, 0x48, 0x47, 0xf8, 0x33, 0x04,
-	0xb3, 0x62, 0x6d, 0xe3, 0xc7, 0x5f, 0x13, 0x88, 0x53, 0x5e, 0x1b, 0xe7, 0x26, 0xe0, 0xac, 0x85,
-	0x70, 0x2a, 0x78, 0x29, 0x13, 0x0e, 0xfe, 0x19, 0x01, 0x4e, 0xae, 0x4c, 0xfc, 0x72, 0xc6, 0x35,
-	0x99, 0x7b, 0xbb, 0xdc, 0x98, 0x20, 0x42, 0x60, 0xbc, 0x19, 0x62, 0x7c, 0x0d, 0x5f, 0xcf, 0x25,
-	0x99, 0x12, 0x59, 0x0d, 0x4a, 0xf7, 0xb0, 0x13, 0xbc, 0x0d, 0x24, 0x78, 0xa8, 0xf9, 0x79, 0xa8,
-	0x13, 0xf3, 0x50, 0xcf, 0x9e, 0x87, 0x78, 0x23, 0xc0, 0x5f, 0x21, 0x80, 0x70, 0x29, 0xe2, 0xfa,
-	0x38, 0x34, 0xc3, 0xc6, 0x78, 0x29, 0x87, 0xa7, 0xc0, 0xbb, 0x19, 0xe2, 0xbd, 0x8a, 0x1b, 0x13,
-	0xe3, 0xc5, 0xbf, 0x20, 0xb8, 0x98, 0xb2, 0xca, 0x70, 0x96, 0x74, 0xd9, 0x7b, 0xb3, 0xac, 0x4e,
-	0x12, 0x22, 0xe0, 0xbf, 0x1d, 0xc2, 0xbf, 0x8e, 0x37, 0xf2, 0xc1, 0xef, 0x11, 0xe6, 0x76, 0x6e,
-	0x05, 0x09, 0x3b, 0x7c, 0xa2, 0xe1, 0x1f, 0x11, 0x9c, 0x8f, 0xcf, 0x3f, 0x7c, 0x25, 0xe7, 0x98,
-	0xe4, 0xf0, 0x27, 0x1b, 0xaa, 0xd2, 0x76, 0x88, 0x7c, 0x13, 0xbf, 0x9a, 0x0f, 0x39, 0x87, 0xca,
-	0x94, 0xbb, 0xd1, 0x1d, 0x73, 0x84, 0xbf, 0x43, 0xb0, 0x30, 0xb2, 0x04, 0x70, 0x3e, 0x34, 0xc3,
-	0x8e, 0x91, 0xf3, 0xba, 0x0b, 0xf4, 0xd7, 0x42, 0xf4, 0x0a, 0x5e, 0x9f, 0x08, 0x3d, 0xfe, 0x14,
-	0x66, 0xf8, 0x70, 0xce, 0x1c, 0xba, 0xb1, 0x1d, 0x90, 0x39, 0x74, 0xe3, 0x3b, 0x41, 0x5a, 0x0d,
-	0x21, 0x95, 0x71, 0x29, 0x09, 0x89, 0x4f, 0xff, 0xe6, 0xd6, 0xc3, 0xe3, 0x2a, 0x7a, 0x74, 0x5c,
-	0x45, 0x7f, 0x1d, 0x57, 0xd1, 0x97, 0x27, 0xd5, 0xc2, 0xa3, 0x93, 0x6a, 0xe1, 0x8f, 0x93, 0x6a,
-	0xe1, 0xc3, 0x2b, 0x86, 0xe9, 0xee, 0x0f, 0xba, 0xf2, 0x1e, 0xed, 0x2b, 0x5e, 0xac, 0x49, 0xd6,
-	0x7b, 0xa4, 0xcb, 0x94, 0xdd, 0x96, 0x9f, 0xe9, 0x4e, 0x90, 0xcb, 0xff, 0xae, 0xed, 0xce, 0xf8,
-	0x1f, 0xb6, 0x57, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x43, 0x63, 0x75, 0xd3, 0x0f, 0x00,
-	0x00,
+func (m *QueryDepositCapsRequest) Reset()         { *m = QueryDepositCapsRequest{} }
+func (m *QueryDepositCapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapsRequest) ProtoMessage()    {}
+func (*QueryDepositCapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{22}
+}
+func (m *QueryDepositCapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositCapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositCapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositCapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapsRequest.Merge(m, src)
+}
+func (m *QueryDepositCapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositCapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDepositCapsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Bridge queries bridge info.
-	Bridge(ctx context.Context, in *QueryBridgeRequest, opts ...grpc.CallOption) (*QueryBridgeResponse, error)
-	// Bridges queries bridge infos.
-	Bridges(ctx context.Context, in *QueryBridgesRequest, opts ...grpc.CallOption) (*QueryBridgesResponse, error)
-	// TokenPairByL1Denom queries token pair by l1 denom.
-	TokenPairByL1Denom(ctx context.Context, in *QueryTokenPairByL1DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL1DenomResponse, error)
-	// TokenPairByL2Denom queries token pair by l2 denom.
-	TokenPairByL2Denom(ctx context.Context, in *QueryTokenPairByL2DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL2DenomResponse, error)
-	// TokenPairs queries all (l1 denom, l2 denom) pair.
-	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
-	LastFinalizedOutput(ctx context.Context, in *QueryLastFinalizedOutputRequest, opts ...grpc.CallOption) (*QueryLastFinalizedOutputResponse, error)
-	// OutputProposal queries output proposal by output index.
-	OutputProposal(ctx context.Context, in *QueryOutputProposalRequest, opts ...grpc.CallOption) (*QueryOutputProposalResponse, error)
-	// OutputProposals queries all output proposals.
-	OutputProposals(ctx context.Context, in *QueryOutputProposalsRequest, opts ...grpc.CallOption) (*QueryOutputProposalsResponse, error)
-	// Parameters queries the rollup parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryDepositCapsRequest) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDepositCapsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryDepositCapsResponse is response type for the Query/DepositCaps RPC method
+type QueryDepositCapsResponse struct {
+	DepositCaps []QueryDepositCapResponse `protobuf:"bytes,1,rep,name=deposit_caps,json=depositCaps,proto3" json:"deposit_caps"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Bridge(ctx context.Context, in *QueryBridgeRequest, opts ...grpc.CallOption) (*QueryBridgeResponse, error) {
-	out := new(QueryBridgeResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/Bridge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositCapsResponse) Reset()         { *m = QueryDepositCapsResponse{} }
+func (m *QueryDepositCapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapsResponse) ProtoMessage()    {}
+func (*QueryDepositCapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{23}
+}
+func (m *QueryDepositCapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositCapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositCapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDepositCapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapsResponse.Merge(m, src)
+}
+func (m *QueryDepositCapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositCapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Bridges(ctx context.Context, in *QueryBridgesRequest, opts ...grpc.CallOption) (*QueryBridgesResponse, error) {
-	out := new(QueryBridgesResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/Bridges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositCapsResponse proto.InternalMessageInfo
+
+func (m *QueryDepositCapsResponse) GetDepositCaps() []QueryDepositCapResponse {
+	if m != nil {
+		return m.DepositCaps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TokenPairByL1Denom(ctx context.Context, in *QueryTokenPairByL1DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL1DenomResponse, error) {
-	out := new(QueryTokenPairByL1DenomResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/TokenPairByL1Denom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDepositCapsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TokenPairByL2Denom(ctx context.Context, in *QueryTokenPairByL2DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL2DenomResponse, error) {
-	out := new(QueryTokenPairByL2DenomResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/TokenPairByL2Denom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBridgeAccountingRequest is request type for the Query/BridgeAccounting RPC method
+type QueryBridgeAccountingRequest struct {
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (c *queryClient) TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error) {
-	out := new(QueryTokenPairsResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/TokenPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeAccountingRequest) Reset()         { *m = QueryBridgeAccountingRequest{} }
+func (m *QueryBridgeAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeAccountingRequest) ProtoMessage()    {}
+func (*QueryBridgeAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{24}
+}
+func (m *QueryBridgeAccountingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeAccountingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBridgeAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeAccountingRequest.Merge(m, src)
+}
+func (m *QueryBridgeAccountingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeAccountingRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastFinalizedOutput(ctx context.Context, in *QueryLastFinalizedOutputRequest, opts ...grpc.CallOption) (*QueryLastFinalizedOutputResponse, error) {
-	out := new(QueryLastFinalizedOutputResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/LastFinalizedOutput", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeAccountingRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeAccountingRequest) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) OutputProposal(ctx context.Context, in *QueryOutputProposalRequest, opts ...grpc.CallOption) (*QueryOutputProposalResponse, error) {
-	out := new(QueryOutputProposalResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/OutputProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeAccountingRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OutputProposals(ctx context.Context, in *QueryOutputProposalsRequest, opts ...grpc.CallOption) (*QueryOutputProposalsResponse, error) {
-	out := new(QueryOutputProposalsResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/OutputProposals", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBridgeAccountingResponse is response type for the Query/BridgeAccounting RPC method
+type QueryBridgeAccountingResponse struct {
+	Accounting BridgeAccounting `protobuf:"bytes,1,opt,name=accounting,proto3" json:"accounting"`
+	// locked_amount is the deposited amount minus the withdrawn amount.
+	LockedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked_amount,json=lockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"locked_amount"`
+	// balance is the current balance of the denom in the bridge account.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeAccountingResponse) Reset()         { *m = QueryBridgeAccountingResponse{} }
+func (m *QueryBridgeAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeAccountingResponse) ProtoMessage()    {}
+func (*QueryBridgeAccountingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{25}
+}
+func (m *QueryBridgeAccountingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeAccountingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Bridge queries bridge info.
-	Bridge(context.Context, *QueryBridgeRequest) (*QueryBridgeResponse, error)
-	// Bridges queries bridge infos.
-	Bridges(context.Context, *QueryBridgesRequest) (*QueryBridgesResponse, error)
-	// TokenPairByL1Denom queries token pair by l1 denom.
-	TokenPairByL1Denom(context.Context, *QueryTokenPairByL1DenomRequest) (*QueryTokenPairByL1DenomResponse, error)
-	// TokenPairByL2Denom queries token pair by l2 denom.
-	TokenPairByL2Denom(context.Context, *QueryTokenPairByL2DenomRequest) (*QueryTokenPairByL2DenomResponse, error)
-	// TokenPairs queries all (l1 denom, l2 denom) pair.
-	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
-	LastFinalizedOutput(context.Context, *QueryLastFinalizedOutputRequest) (*QueryLastFinalizedOutputResponse, error)
-	// OutputProposal queries output proposal by output index.
-	OutputProposal(context.Context, *QueryOutputProposalRequest) (*QueryOutputProposalResponse, error)
-	// OutputProposals queries all output proposals.
-	OutputProposals(context.Context, *QueryOutputProposalsRequest) (*QueryOutputProposalsResponse, error)
-	// Parameters queries the rollup parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryBridgeAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeAccountingResponse.Merge(m, src)
+}
+func (m *QueryBridgeAccountingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeAccountingResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryBridgeAccountingResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeAccountingResponse) GetAccounting() BridgeAccounting {
+	if m != nil {
+		return m.Accounting
+	}
+	return BridgeAccounting{}
 }
 
-func (*UnimplementedQueryServer) Bridge(ctx context.Context, req *QueryBridgeRequest) (*QueryBridgeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bridge not implemented")
+// QueryBridgeAccountingsRequest is request type for the Query/BridgeAccountings RPC method
+type QueryBridgeAccountingsRequest struct {
+	BridgeId uint64 `protobuf:"varint,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty"`
+	// pagination defines the pagination in the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Bridges(ctx context.Context, req *QueryBridgesRequest) (*QueryBridgesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bridges not implemented")
+
+func (m *QueryBridgeAccountingsRequest) Reset()         { *m = QueryBridgeAccountingsRequest{} }
+func (m *QueryBridgeAccountingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeAccountingsRequest) ProtoMessage()    {}
+func (*QueryBridgeAccountingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{26}
 }
-func (*UnimplementedQueryServer) TokenPairByL1Denom(ctx context.Context, req *QueryTokenPairByL1DenomRequest) (*QueryTokenPairByL1DenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenPairByL1Denom not implemented")
+func (m *QueryBridgeAccountingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TokenPairByL2Denom(ctx context.Context, req *QueryTokenPairByL2DenomRequest) (*QueryTokenPairByL2DenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenPairByL2Denom not implemented")
+func (m *QueryBridgeAccountingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeAccountingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenPairs not implemented")
+func (m *QueryBridgeAccountingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeAccountingsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LastFinalizedOutput(ctx context.Context, req *QueryLastFinalizedOutputRequest) (*QueryLastFinalizedOutputResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastFinalizedOutput not implemented")
+func (m *QueryBridgeAccountingsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OutputProposal(ctx context.Context, req *QueryOutputProposalRequest) (*QueryOutputProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutputProposal not implemented")
+func (m *QueryBridgeAccountingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeAccountingsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) OutputProposals(ctx context.Context, req *QueryOutputProposalsRequest) (*QueryOutputProposalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutputProposals not implemented")
+
+var xxx_messageInfo_QueryBridgeAccountingsRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeAccountingsRequest) GetBridgeId() uint64 {
+	if m != nil {
+		return m.BridgeId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+func (m *QueryBridgeAccountingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryBridgeAccountingsResponse is response type for the Query/BridgeAccountings RPC method
+type QueryBridgeAccountingsResponse struct {
+	Accountings []QueryBridgeAccountingResponse `protobuf:"bytes,1,rep,name=accountings,proto3" json:"accountings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Bridge(ctx, in)
+func (m *QueryBridgeAccountingsResponse) Reset()         { *m = QueryBridgeAccountingsResponse{} }
+func (m *QueryBridgeAccountingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeAccountingsResponse) ProtoMessage()    {}
+func (*QueryBridgeAccountingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{27}
+}
+func (m *QueryBridgeAccountingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeAccountingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeAccountingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/Bridge",
+}
+func (m *QueryBridgeAccountingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeAccountingsResponse.Merge(m, src)
+}
+func (m *QueryBridgeAccountingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeAccountingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeAccountingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeAccountingsResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeAccountingsResponse) GetAccountings() []QueryBridgeAccountingResponse {
+	if m != nil {
+		return m.Accountings
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Bridge(ctx, req.(*QueryBridgeRequest))
+	return nil
+}
+
+func (m *QueryBridgeAccountingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Bridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Bridges(ctx, in)
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{28}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/Bridges",
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd525d30e46de74, []int{29}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Bridges(ctx, req.(*QueryBridgesRequest))
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Query_TokenPairByL1Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenPairByL1DenomRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryBridgeRequest)(nil), "opinit.ophost.v1.QueryBridgeRequest")
+	proto.RegisterType((*QueryBridgeResponse)(nil), "opinit.ophost.v1.QueryBridgeResponse")
+	proto.RegisterType((*QueryBridgesRequest)(nil), "opinit.ophost.v1.QueryBridgesRequest")
+	proto.RegisterType((*QueryBridgesResponse)(nil), "opinit.ophost.v1.QueryBridgesResponse")
+	proto.RegisterType((*QueryTokenPairByL1DenomRequest)(nil), "opinit.ophost.v1.QueryTokenPairByL1DenomRequest")
+	proto.RegisterType((*QueryTokenPairByL1DenomResponse)(nil), "opinit.ophost.v1.QueryTokenPairByL1DenomResponse")
+	proto.RegisterType((*QueryTokenPairByL2DenomRequest)(nil), "opinit.ophost.v1.QueryTokenPairByL2DenomRequest")
+	proto.RegisterType((*QueryTokenPairByL2DenomResponse)(nil), "opinit.ophost.v1.QueryTokenPairByL2DenomResponse")
+	proto.RegisterType((*QueryTokenPairsRequest)(nil), "opinit.ophost.v1.QueryTokenPairsRequest")
+	proto.RegisterType((*QueryTokenPairsResponse)(nil), "opinit.ophost.v1.QueryTokenPairsResponse")
+	proto.RegisterType((*QueryL2NativeTokenPairsRequest)(nil), "opinit.ophost.v1.QueryL2NativeTokenPairsRequest")
+	proto.RegisterType((*QueryL2NativeTokenPairsResponse)(nil), "opinit.ophost.v1.QueryL2NativeTokenPairsResponse")
+	proto.RegisterType((*QueryNftClassPairsRequest)(nil), "opinit.ophost.v1.QueryNftClassPairsRequest")
+	proto.RegisterType((*QueryNftClassPairsResponse)(nil), "opinit.ophost.v1.QueryNftClassPairsResponse")
+	proto.RegisterType((*QueryLastFinalizedOutputRequest)(nil), "opinit.ophost.v1.QueryLastFinalizedOutputRequest")
+	proto.RegisterType((*QueryLastFinalizedOutputResponse)(nil), "opinit.ophost.v1.QueryLastFinalizedOutputResponse")
+	proto.RegisterType((*QueryOutputProposalRequest)(nil), "opinit.ophost.v1.QueryOutputProposalRequest")
+	proto.RegisterType((*QueryOutputProposalResponse)(nil), "opinit.ophost.v1.QueryOutputProposalResponse")
+	proto.RegisterType((*QueryOutputProposalsRequest)(nil), "opinit.ophost.v1.QueryOutputProposalsRequest")
+	proto.RegisterType((*QueryOutputProposalsResponse)(nil), "opinit.ophost.v1.QueryOutputProposalsResponse")
+	proto.RegisterType((*QueryDepositCapRequest)(nil), "opinit.ophost.v1.QueryDepositCapRequest")
+	proto.RegisterType((*QueryDepositCapResponse)(nil), "opinit.ophost.v1.QueryDepositCapResponse")
+	proto.RegisterType((*QueryDepositCapsRequest)(nil), "opinit.ophost.v1.QueryDepositCapsRequest")
+	proto.RegisterType((*QueryDepositCapsResponse)(nil), "opinit.ophost.v1.QueryDepositCapsResponse")
+	proto.RegisterType((*QueryBridgeAccountingRequest)(nil), "opinit.ophost.v1.QueryBridgeAccountingRequest")
+	proto.RegisterType((*QueryBridgeAccountingResponse)(nil), "opinit.ophost.v1.QueryBridgeAccountingResponse")
+	proto.RegisterType((*QueryBridgeAccountingsRequest)(nil), "opinit.ophost.v1.QueryBridgeAccountingsRequest")
+	proto.RegisterType((*QueryBridgeAccountingsResponse)(nil), "opinit.ophost.v1.QueryBridgeAccountingsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "opinit.ophost.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "opinit.ophost.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("opinit/ophost/v1/query.proto", fileDescriptor_7dd525d30e46de74) }
+
+var fileDescriptor_7dd525d30e46de74 = []byte{
+	// 1532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xc7, 0x7b, 0x5b, 0x29, 0xf4, 0x2c, 0x3f, 0x2f, 0x55, 0xb7, 0xd3, 0xba, 0xad, 0x13, 0x0b,
+	0x05, 0xe9, 0x0c, 0x3b, 0x68, 0x8c, 0xfc, 0x0c, 0x05, 0x0b, 0x45, 0xa4, 0x65, 0xc5, 0x68, 0x0c,
+	0xc9, 0xe4, 0xee, 0xce, 0x74, 0x3b, 0x61, 0x77, 0xee, 0xb0, 0x33, 0x6d, 0xa8, 0x88, 0x21, 0x46,
+	0x13, 0x7c, 0xd3, 0xf0, 0x0f, 0xe0, 0x03, 0x89, 0x09, 0xc6, 0x68, 0x24, 0xc6, 0x18, 0x4c, 0x4c,
+	0x8c, 0xca, 0x83, 0x0f, 0x04, 0x5f, 0x8c, 0x0f, 0xc4, 0x80, 0x89, 0xfe, 0x19, 0x66, 0xe6, 0xde,
+	0xd9, 0x99, 0xd9, 0x99, 0x61, 0x67, 0xcb, 0x52, 0x7d, 0x21, 0xec, 0x99, 0x7b, 0xce, 0xfd, 0x9c,
+	0xef, 0x3d, 0x7b, 0xe7, 0x9c, 0x2e, 0x8c, 0x50, 0xcb, 0x30, 0x0d, 0x47, 0xa6, 0xd6, 0x02, 0xb5,
+	0x1d, 0x79, 0xa9, 0x28, 0x9f, 0x5f, 0xd4, 0x1b, 0xcb, 0x92, 0xd5, 0xa0, 0x0e, 0xc5, 0x9b, 0xd9,
+	0x53, 0x89, 0x3d, 0x95, 0x96, 0x8a, 0xc2, 0x16, 0x52, 0x37, 0x4c, 0x2a, 0x7b, 0xff, 0xb2, 0x45,
+	0xc2, 0xce, 0x0a, 0xb5, 0xeb, 0xd4, 0x96, 0xcb, 0xc4, 0xd6, 0x99, 0xb7, 0xbc, 0x54, 0x2c, 0xeb,
+	0x0e, 0x29, 0xca, 0x16, 0xa9, 0x1a, 0x26, 0x71, 0x0c, 0x6a, 0xf2, 0xb5, 0xc3, 0x7c, 0xad, 0xbf,
+	0x2c, 0xbc, 0x9b, 0x30, 0xc4, 0x1e, 0xaa, 0xde, 0x27, 0x99, 0x7d, 0xe0, 0x8f, 0x06, 0xab, 0xb4,
+	0x4a, 0x99, 0xdd, 0xfd, 0x1f, 0xb7, 0x8e, 0x54, 0x29, 0xad, 0xd6, 0x74, 0x99, 0x58, 0x86, 0x4c,
+	0x4c, 0x93, 0x3a, 0xde, 0x56, 0xbe, 0x4f, 0x3c, 0x35, 0x67, 0xd9, 0xd2, 0xf9, 0x53, 0x71, 0x1f,
+	0xe0, 0xd3, 0xee, 0xde, 0x53, 0x0d, 0x43, 0xab, 0xea, 0x25, 0xfd, 0xfc, 0xa2, 0x6e, 0x3b, 0x78,
+	0x18, 0x06, 0xca, 0x9e, 0x41, 0x35, 0xb4, 0x3c, 0x1a, 0x43, 0x13, 0x4f, 0x94, 0xd6, 0x31, 0xc3,
+	0x8c, 0xb6, 0x77, 0xdd, 0x95, 0x6b, 0xa3, 0x3d, 0xff, 0x5c, 0x1b, 0xed, 0x11, 0xbf, 0x47, 0xb0,
+	0x35, 0xe2, 0x6d, 0x5b, 0xd4, 0xb4, 0xf5, 0x87, 0xba, 0xe3, 0x97, 0x21, 0xc7, 0x1f, 0x12, 0x4d,
+	0x6b, 0xe4, 0x7b, 0xc7, 0xd0, 0xc4, 0xc0, 0x54, 0xfe, 0xee, 0xcd, 0xc9, 0x41, 0x9e, 0xea, 0x61,
+	0x4d, 0x6b, 0xe8, 0xb6, 0xfd, 0xba, 0xd3, 0x30, 0xcc, 0x6a, 0x09, 0xd8, 0x62, 0xd7, 0x88, 0x4f,
+	0xc1, 0x06, 0xee, 0x5a, 0xa1, 0xe6, 0xbc, 0x51, 0xcd, 0xf7, 0x8d, 0xa1, 0x89, 0x9c, 0x52, 0x90,
+	0x5a, 0xcf, 0x47, 0x62, 0x40, 0x47, 0xbc, 0x55, 0x53, 0x03, 0xb7, 0xef, 0x8d, 0xf6, 0x7c, 0xf6,
+	0xf7, 0x97, 0x3b, 0x51, 0x69, 0x7d, 0x39, 0xf4, 0x40, 0xac, 0x46, 0xf0, 0x6d, 0x3f, 0xfb, 0x69,
+	0x80, 0xe0, 0xc4, 0x3c, 0xfe, 0x9c, 0xb2, 0x4d, 0xe2, 0x74, 0xee, 0xf1, 0x4a, 0xec, 0xb8, 0xf8,
+	0xf1, 0x4a, 0x73, 0xa4, 0xa9, 0x5c, 0x29, 0xe4, 0x19, 0x12, 0xea, 0x06, 0x82, 0xc1, 0xe8, 0x4e,
+	0x5c, 0xa9, 0x13, 0xb0, 0x96, 0x11, 0xd9, 0x79, 0x34, 0xd6, 0x37, 0x91, 0x53, 0xc6, 0xe3, 0xb9,
+	0x24, 0x28, 0x1c, 0x4e, 0xc9, 0x0f, 0x80, 0x8f, 0x45, 0xb0, 0x7b, 0x3d, 0xec, 0xed, 0x6d, 0xb1,
+	0x59, 0xc0, 0x30, 0xb7, 0xf8, 0x16, 0x14, 0xbc, 0x3d, 0xcf, 0xd0, 0x73, 0xba, 0x39, 0x47, 0x8c,
+	0xc6, 0xd4, 0xf2, 0xc9, 0xe2, 0x51, 0xdd, 0xa4, 0xf5, 0x2c, 0xf5, 0x81, 0x87, 0x60, 0x5d, 0xad,
+	0xa8, 0x6a, 0xee, 0x7a, 0x76, 0xba, 0xa5, 0xb5, 0x35, 0xe6, 0x2e, 0x2e, 0xc0, 0x68, 0x6a, 0x64,
+	0xae, 0xc8, 0x2b, 0x00, 0x8e, 0xfb, 0x54, 0xb5, 0x88, 0xd1, 0xe0, 0xe2, 0x0f, 0xc7, 0x45, 0x09,
+	0x22, 0x84, 0xa4, 0x18, 0x70, 0x7c, 0x6b, 0x62, 0x0e, 0x4a, 0x67, 0x39, 0x28, 0x2d, 0x39, 0x28,
+	0xe9, 0x39, 0x28, 0x8f, 0x25, 0x87, 0x4b, 0xf0, 0x54, 0x74, 0x27, 0x3b, 0x13, 0xfb, 0x74, 0x42,
+	0x1d, 0xac, 0xa0, 0x7c, 0xdd, 0xa2, 0x7d, 0x3a, 0xb6, 0x3f, 0xcf, 0xf0, 0x18, 0xe4, 0x82, 0x0c,
+	0xfd, 0xda, 0xcd, 0x9a, 0x22, 0x34, 0x53, 0xec, 0x62, 0xd1, 0x7e, 0x88, 0xf8, 0x89, 0x9f, 0x54,
+	0x4e, 0x11, 0xc7, 0x58, 0xd2, 0xff, 0x23, 0xd5, 0xbe, 0x46, 0x30, 0x9a, 0xca, 0xf1, 0xbf, 0x55,
+	0xef, 0x32, 0x82, 0x21, 0x8f, 0xfa, 0xd4, 0xbc, 0x73, 0xa4, 0x46, 0x6c, 0x7b, 0xf5, 0x85, 0xfb,
+	0x0a, 0x81, 0x90, 0x84, 0xd0, 0xbc, 0x29, 0x73, 0x15, 0xd7, 0x1a, 0xd1, 0x2c, 0xe1, 0xe6, 0x0f,
+	0x7b, 0x47, 0x64, 0xab, 0x34, 0x63, 0x76, 0x4f, 0xb6, 0x83, 0xfe, 0x59, 0x13, 0xdb, 0x99, 0x36,
+	0x4c, 0x52, 0x33, 0xde, 0xd1, 0xb5, 0xd9, 0x45, 0xc7, 0x5a, 0x74, 0xb2, 0x68, 0x27, 0x5e, 0x45,
+	0x30, 0x96, 0x1e, 0x80, 0x67, 0xfe, 0x2c, 0xac, 0xa7, 0x9e, 0x45, 0x35, 0x4c, 0x4d, 0xbf, 0xc0,
+	0x83, 0xe4, 0x98, 0x6d, 0xc6, 0x35, 0xe1, 0x93, 0xb0, 0x89, 0x2f, 0xb1, 0x1a, 0xd4, 0xa2, 0x36,
+	0xa9, 0xf1, 0xac, 0xf2, 0x71, 0x81, 0x58, 0xf4, 0xb0, 0x34, 0x1b, 0x99, 0xef, 0x1c, 0x77, 0x15,
+	0xcf, 0xf2, 0x83, 0x98, 0x8d, 0x98, 0x33, 0x15, 0x43, 0x2b, 0x6b, 0x6f, 0x8c, 0xd5, 0xbd, 0x56,
+	0x86, 0x13, 0xc3, 0x67, 0x69, 0x1e, 0xda, 0xc7, 0x4f, 0xd2, 0xa2, 0x6f, 0xe5, 0x5a, 0x5c, 0x4f,
+	0xa6, 0xb5, 0xbb, 0xa4, 0x46, 0xcb, 0xb7, 0xa7, 0x6f, 0xc5, 0xdf, 0x9e, 0x5f, 0x11, 0x8c, 0x24,
+	0x73, 0x72, 0x59, 0x2b, 0xb0, 0xb9, 0x45, 0x16, 0xff, 0x4b, 0x34, 0x99, 0xd2, 0x72, 0x24, 0x9f,
+	0x4f, 0x58, 0xac, 0x4d, 0x51, 0xb1, 0xba, 0xf8, 0xc5, 0x7a, 0x95, 0xbf, 0xfa, 0x8e, 0xea, 0x16,
+	0xb5, 0x0d, 0xe7, 0x08, 0xb1, 0x32, 0x09, 0x3e, 0x08, 0x6b, 0xc2, 0xef, 0x6c, 0xf6, 0x41, 0xfc,
+	0xce, 0x7f, 0x91, 0x85, 0xa3, 0x71, 0x59, 0x8e, 0x43, 0x4e, 0x63, 0x56, 0xb5, 0x42, 0x2c, 0xfe,
+	0xae, 0x1e, 0x89, 0x2b, 0x12, 0xb8, 0x46, 0x2e, 0x15, 0xad, 0x69, 0xc6, 0x6f, 0xc0, 0x86, 0x1a,
+	0xad, 0x9c, 0xd3, 0x35, 0x95, 0xd4, 0xe9, 0xa2, 0xe9, 0xf0, 0xce, 0x76, 0xb7, 0xbb, 0xfa, 0x8f,
+	0x7b, 0xa3, 0x4f, 0x32, 0x15, 0x6c, 0xed, 0x9c, 0x64, 0x50, 0xb9, 0x4e, 0x9c, 0x05, 0x69, 0xc6,
+	0x74, 0xee, 0xde, 0x9c, 0x04, 0x2e, 0xcf, 0x8c, 0xe9, 0xf0, 0x1e, 0x95, 0x85, 0x39, 0xec, 0x45,
+	0x11, 0xdf, 0x8b, 0xb1, 0xaf, 0xee, 0xb5, 0x7c, 0x0b, 0x41, 0x3e, 0x0e, 0xc0, 0xd5, 0x7b, 0x13,
+	0xd6, 0x87, 0xd4, 0xf3, 0x0b, 0x6a, 0x47, 0x4a, 0x41, 0xc5, 0xe5, 0x0f, 0x6b, 0x99, 0x0b, 0xb4,
+	0xec, 0x62, 0x21, 0x9d, 0x86, 0x91, 0x50, 0xff, 0x7c, 0xb8, 0x52, 0x71, 0x45, 0x75, 0xe7, 0x8a,
+	0x95, 0x97, 0xd3, 0x27, 0xbd, 0xf0, 0x4c, 0x4a, 0x4c, 0x2e, 0xcb, 0x6b, 0x00, 0xa4, 0x69, 0xe5,
+	0x35, 0x25, 0xa6, 0x0d, 0x29, 0x81, 0x7f, 0xa4, 0xb2, 0x82, 0x00, 0x8f, 0xa9, 0xb2, 0xbc, 0xd9,
+	0x83, 0xd4, 0x88, 0x59, 0xd1, 0xf3, 0x7d, 0x2b, 0x0c, 0xe8, 0x07, 0x10, 0x3f, 0x40, 0x29, 0x9a,
+	0xac, 0x6e, 0xb1, 0xfe, 0xe2, 0x37, 0x81, 0x09, 0x18, 0xfc, 0x6c, 0xce, 0x42, 0x2e, 0x90, 0xd6,
+	0xaf, 0x58, 0xf9, 0xa1, 0x53, 0x57, 0xfc, 0x84, 0x23, 0x75, 0x1b, 0x0a, 0xd7, 0xbd, 0xba, 0x1d,
+	0xe4, 0x73, 0xf9, 0x1c, 0x69, 0x90, 0xba, 0x2f, 0xa2, 0x58, 0x82, 0xad, 0x11, 0x2b, 0xcf, 0x69,
+	0x1f, 0xf4, 0x5b, 0x9e, 0x25, 0x8f, 0xd2, 0xde, 0x74, 0xcc, 0x23, 0xcc, 0xcd, 0x5d, 0x94, 0x1f,
+	0x06, 0x61, 0x8d, 0x17, 0x14, 0x7f, 0x84, 0xa0, 0x9f, 0x65, 0x8c, 0x9f, 0x6b, 0x33, 0x86, 0x7a,
+	0x38, 0x42, 0xb6, 0x61, 0x55, 0x54, 0xae, 0xb8, 0x1b, 0xbe, 0xff, 0xdb, 0x5f, 0x57, 0x7b, 0xb7,
+	0xe3, 0x71, 0x39, 0xf6, 0xf7, 0x08, 0x3e, 0xc0, 0xca, 0x17, 0x9b, 0x05, 0x72, 0x09, 0x5f, 0x46,
+	0xb0, 0x96, 0x85, 0xb1, 0xf1, 0xc3, 0xb7, 0xf1, 0xc5, 0x11, 0xb6, 0xb5, 0x5b, 0xc6, 0x71, 0xb6,
+	0x05, 0x38, 0xc3, 0x78, 0x28, 0x15, 0x07, 0xff, 0x84, 0x00, 0xc7, 0x07, 0x55, 0xbc, 0x3b, 0x65,
+	0x9b, 0xd4, 0x69, 0x59, 0x28, 0x76, 0xe0, 0xc1, 0x19, 0x4f, 0x04, 0x8c, 0x87, 0xf0, 0x81, 0x4c,
+	0x92, 0xc9, 0xa1, 0x91, 0x42, 0x2e, 0x2f, 0xab, 0xfe, 0x0c, 0x1e, 0xcb, 0x43, 0xc9, 0x9e, 0x87,
+	0xd2, 0x71, 0x1e, 0x4a, 0xf7, 0xf3, 0xe0, 0x73, 0x38, 0xfe, 0x14, 0x01, 0x9c, 0x09, 0x66, 0x9f,
+	0x89, 0x76, 0x34, 0xcd, 0xc2, 0xd8, 0x91, 0x61, 0x25, 0xe7, 0x3d, 0x18, 0xf0, 0xee, 0xc1, 0xc5,
+	0x8e, 0x79, 0xf1, 0x8f, 0x08, 0x70, 0x7c, 0xf0, 0x4b, 0xd5, 0x3a, 0x75, 0x56, 0x15, 0x8a, 0x1d,
+	0x78, 0x70, 0xf6, 0xe3, 0x01, 0xfb, 0x01, 0xbc, 0x2f, 0x1b, 0x7b, 0x4d, 0x51, 0x4d, 0x2f, 0x9e,
+	0x1a, 0xce, 0xe2, 0x0b, 0x04, 0x1b, 0x22, 0x53, 0x18, 0x7e, 0x3e, 0x05, 0x27, 0x69, 0x5c, 0x14,
+	0x76, 0x65, 0x5b, 0xcc, 0xb1, 0xa7, 0x02, 0xec, 0x97, 0xf0, 0x8b, 0xd9, 0xb0, 0xcd, 0x79, 0x47,
+	0x0d, 0x4d, 0x83, 0xf8, 0x67, 0x04, 0x5b, 0x13, 0x46, 0x28, 0x9c, 0xaa, 0x62, 0xea, 0xbc, 0x26,
+	0x28, 0x9d, 0xb8, 0x3c, 0x8a, 0xf2, 0xc4, 0x76, 0xd4, 0x79, 0x3f, 0xa0, 0xca, 0x3a, 0x69, 0xfc,
+	0x2d, 0x82, 0x8d, 0xd1, 0xbe, 0x1b, 0xef, 0xca, 0xd8, 0x9e, 0x33, 0xfc, 0xce, 0x9a, 0x79, 0x71,
+	0x26, 0x20, 0x3f, 0x88, 0xf7, 0x67, 0x23, 0x67, 0xa8, 0xb6, 0x7c, 0x31, 0x3c, 0xdb, 0x5c, 0xc2,
+	0x9f, 0x23, 0xd8, 0x34, 0xdb, 0x32, 0x0f, 0x64, 0xa3, 0x69, 0x16, 0x8e, 0x94, 0x75, 0x39, 0xa7,
+	0xdf, 0x1b, 0xd0, 0xcb, 0x78, 0xb2, 0x23, 0x7a, 0x7c, 0x03, 0x01, 0x04, 0x0d, 0x69, 0xea, 0x6d,
+	0x12, 0x1b, 0x40, 0x84, 0xec, 0xdd, 0xed, 0x8a, 0xea, 0x22, 0xdc, 0x4f, 0xbb, 0xd7, 0x1f, 0xbb,
+	0xfb, 0xae, 0x23, 0xc8, 0x1d, 0x0d, 0xf5, 0xc7, 0xed, 0x21, 0x9a, 0xa2, 0xee, 0xcc, 0xb2, 0x94,
+	0x03, 0x1f, 0x0a, 0x80, 0x5f, 0xc0, 0x4a, 0xe7, 0xc0, 0xf8, 0x16, 0x82, 0xcd, 0xad, 0x4d, 0x13,
+	0x96, 0x32, 0x77, 0x57, 0x8c, 0xb8, 0xd3, 0x6e, 0x4c, 0x3c, 0x16, 0x60, 0xef, 0xc7, 0x7b, 0xb3,
+	0x61, 0x87, 0xba, 0xb6, 0x40, 0xe6, 0x6f, 0x10, 0x6c, 0x69, 0xdd, 0xc5, 0xc6, 0x59, 0x79, 0x9a,
+	0x92, 0xef, 0xce, 0xee, 0xf0, 0x08, 0xef, 0x9d, 0x70, 0xdf, 0xf9, 0x2e, 0xf4, 0xb3, 0x0e, 0x2f,
+	0xb5, 0x73, 0x8b, 0x34, 0x92, 0xc2, 0x78, 0x9b, 0x55, 0x1c, 0x6b, 0x3c, 0xc0, 0x12, 0x70, 0x3e,
+	0x8e, 0xc5, 0x5a, 0xc8, 0xa9, 0xe9, 0xdb, 0xf7, 0x0b, 0xe8, 0xce, 0xfd, 0x02, 0xfa, 0xf3, 0x7e,
+	0x01, 0x7d, 0xfc, 0xa0, 0xd0, 0x73, 0xe7, 0x41, 0xa1, 0xe7, 0xf7, 0x07, 0x85, 0x9e, 0xb7, 0x77,
+	0x55, 0x0d, 0x67, 0x61, 0xb1, 0x2c, 0x55, 0x68, 0x5d, 0x76, 0x7d, 0x0d, 0x32, 0x59, 0x23, 0x65,
+	0x5b, 0x9e, 0x9d, 0xf3, 0x22, 0x5d, 0xf0, 0x63, 0x79, 0x3f, 0x49, 0x95, 0xfb, 0xbd, 0xdf, 0xa4,
+	0xf6, 0xfc, 0x3b, 0x00, 0xe3, 0x6b, 0xe1, 0x6a, 0x8e, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Bridge queries bridge info.
+	Bridge(ctx context.Context, in *QueryBridgeRequest, opts ...grpc.CallOption) (*QueryBridgeResponse, error)
+	// Bridges queries bridge infos.
+	Bridges(ctx context.Context, in *QueryBridgesRequest, opts ...grpc.CallOption) (*QueryBridgesResponse, error)
+	// TokenPairByL1Denom queries token pair by l1 denom.
+	TokenPairByL1Denom(ctx context.Context, in *QueryTokenPairByL1DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL1DenomResponse, error)
+	// TokenPairByL2Denom queries token pair by l2 denom.
+	TokenPairByL2Denom(ctx context.Context, in *QueryTokenPairByL2DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL2DenomResponse, error)
+	// TokenPairs queries all (l1 denom, l2 denom) pair.
+	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
+	// L2NativeTokenPairs queries all (l1 representation denom, l2 native denom) pairs
+	// of the tokens originated from l2.
+	L2NativeTokenPairs(ctx context.Context, in *QueryL2NativeTokenPairsRequest, opts ...grpc.CallOption) (*QueryL2NativeTokenPairsResponse, error)
+	// NftClassPairs queries all (l1 class id, l2 class id) pairs.
+	NftClassPairs(ctx context.Context, in *QueryNftClassPairsRequest, opts ...grpc.CallOption) (*QueryNftClassPairsResponse, error)
+	LastFinalizedOutput(ctx context.Context, in *QueryLastFinalizedOutputRequest, opts ...grpc.CallOption) (*QueryLastFinalizedOutputResponse, error)
+	// OutputProposal queries output proposal by output index.
+	OutputProposal(ctx context.Context, in *QueryOutputProposalRequest, opts ...grpc.CallOption) (*QueryOutputProposalResponse, error)
+	// OutputProposals queries all output proposals.
+	OutputProposals(ctx context.Context, in *QueryOutputProposalsRequest, opts ...grpc.CallOption) (*QueryOutputProposalsResponse, error)
+	// DepositCap queries the deposit cap and the locked amount of a denom.
+	DepositCap(ctx context.Context, in *QueryDepositCapRequest, opts ...grpc.CallOption) (*QueryDepositCapResponse, error)
+	// DepositCaps queries all deposit caps and locked amounts of a bridge.
+	DepositCaps(ctx context.Context, in *QueryDepositCapsRequest, opts ...grpc.CallOption) (*QueryDepositCapsResponse, error)
+	// BridgeAccounting queries the deposited and withdrawn totals of a denom.
+	BridgeAccounting(ctx context.Context, in *QueryBridgeAccountingRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingResponse, error)
+	// BridgeAccountings queries the deposited and withdrawn totals of all denoms of a bridge.
+	BridgeAccountings(ctx context.Context, in *QueryBridgeAccountingsRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingsResponse, error)
+	// Parameters queries the rollup parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Bridge(ctx context.Context, in *QueryBridgeRequest, opts ...grpc.CallOption) (*QueryBridgeResponse, error) {
+	out := new(QueryBridgeResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/Bridge", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenPairByL1Denom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/TokenPairByL1Denom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenPairByL1Denom(ctx, req.(*QueryTokenPairByL1DenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TokenPairByL2Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenPairByL2DenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Bridges(ctx context.Context, in *QueryBridgesRequest, opts ...grpc.CallOption) (*QueryBridgesResponse, error) {
+	out := new(QueryBridgesResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/Bridges", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenPairByL2Denom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/TokenPairByL2Denom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenPairByL2Denom(ctx, req.(*QueryTokenPairByL2DenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenPairsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TokenPairByL1Denom(ctx context.Context, in *QueryTokenPairByL1DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL1DenomResponse, error) {
+	out := new(QueryTokenPairByL1DenomResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/TokenPairByL1Denom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/TokenPairs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenPairs(ctx, req.(*QueryTokenPairsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastFinalizedOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastFinalizedOutputRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TokenPairByL2Denom(ctx context.Context, in *QueryTokenPairByL2DenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByL2DenomResponse, error) {
+	out := new(QueryTokenPairByL2DenomResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/TokenPairByL2Denom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastFinalizedOutput(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/LastFinalizedOutput",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastFinalizedOutput(ctx, req.(*QueryLastFinalizedOutputRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_OutputProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutputProposalRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error) {
+	out := new(QueryTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/TokenPairs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutputProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/OutputProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutputProposal(ctx, req.(*QueryOutputProposalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_OutputProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutputProposalsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) L2NativeTokenPairs(ctx context.Context, in *QueryL2NativeTokenPairsRequest, opts ...grpc.CallOption) (*QueryL2NativeTokenPairsResponse, error) {
+	out := new(QueryL2NativeTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/L2NativeTokenPairs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutputProposals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/OutputProposals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutputProposals(ctx, req.(*QueryOutputProposalsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NftClassPairs(ctx context.Context, in *QueryNftClassPairsRequest, opts ...grpc.CallOption) (*QueryNftClassPairsResponse, error) {
+	out := new(QueryNftClassPairsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/NftClassPairs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/opinit.ophost.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "opinit.ophost.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Bridge",
-			Handler:    _Query_Bridge_Handler,
-		},
-		{
-			MethodName: "Bridges",
-			Handler:    _Query_Bridges_Handler,
-		},
-		{
-			MethodName: "TokenPairByL1Denom",
-			Handler:    _Query_TokenPairByL1Denom_Handler,
-		},
-		{
-			MethodName: "TokenPairByL2Denom",
-			Handler:    _Query_TokenPairByL2Denom_Handler,
-		},
-		{
-			MethodName: "TokenPairs",
-			Handler:    _Query_TokenPairs_Handler,
-		},
-		{
-			MethodName: "LastFinalizedOutput",
-			Handler:    _Query_LastFinalizedOutput_Handler,
-		},
-		{
-			MethodName: "OutputProposal",
-			Handler:    _Query_OutputProposal_Handler,
-		},
-		{
-			MethodName: "OutputProposals",
-			Handler:    _Query_OutputProposals_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "opinit/ophost/v1/query.proto",
+	return out, nil
 }
 
-func (m *QueryBridgeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastFinalizedOutput(ctx context.Context, in *QueryLastFinalizedOutputRequest, opts ...grpc.CallOption) (*QueryLastFinalizedOutputResponse, error) {
+	out := new(QueryLastFinalizedOutputResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/LastFinalizedOutput", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BridgeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBridgeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OutputProposal(ctx context.Context, in *QueryOutputProposalRequest, opts ...grpc.CallOption) (*QueryOutputProposalResponse, error) {
+	out := new(QueryOutputProposalResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/OutputProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.BridgeAddr) > 0 {
-		i -= len(m.BridgeAddr)
-		copy(dAtA[i:], m.BridgeAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BridgeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) OutputProposals(ctx context.Context, in *QueryOutputProposalsRequest, opts ...grpc.CallOption) (*QueryOutputProposalsResponse, error) {
+	out := new(QueryOutputProposalsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/OutputProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBridgesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DepositCap(ctx context.Context, in *QueryDepositCapRequest, opts ...grpc.CallOption) (*QueryDepositCapResponse, error) {
+	out := new(QueryDepositCapResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/DepositCap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBridgesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryBridgesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DepositCaps(ctx context.Context, in *QueryDepositCapsRequest, opts ...grpc.CallOption) (*QueryDepositCapsResponse, error) {
+	out := new(QueryDepositCapsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/DepositCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBridgesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BridgeAccounting(ctx context.Context, in *QueryBridgeAccountingRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingResponse, error) {
+	out := new(QueryBridgeAccountingResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/BridgeAccounting", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBridgesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryBridgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bridges) > 0 {
-		for iNdEx := len(m.Bridges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bridges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) BridgeAccountings(ctx context.Context, in *QueryBridgeAccountingsRequest, opts ...grpc.CallOption) (*QueryBridgeAccountingsResponse, error) {
+	out := new(QueryBridgeAccountingsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/BridgeAccountings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTokenPairByL1DenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTokenPairByL1DenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Bridge queries bridge info.
+	Bridge(context.Context, *QueryBridgeRequest) (*QueryBridgeResponse, error)
+	// Bridges queries bridge infos.
+	Bridges(context.Context, *QueryBridgesRequest) (*QueryBridgesResponse, error)
+	// TokenPairByL1Denom queries token pair by l1 denom.
+	TokenPairByL1Denom(context.Context, *QueryTokenPairByL1DenomRequest) (*QueryTokenPairByL1DenomResponse, error)
+	// TokenPairByL2Denom queries token pair by l2 denom.
+	TokenPairByL2Denom(context.Context, *QueryTokenPairByL2DenomRequest) (*QueryTokenPairByL2DenomResponse, error)
+	// TokenPairs queries all (l1 denom, l2 denom) pair.
+	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
+	// L2NativeTokenPairs queries all (l1 representation denom, l2 native denom) pairs
+	// of the tokens originated from l2.
+	L2NativeTokenPairs(context.Context, *QueryL2NativeTokenPairsRequest) (*QueryL2NativeTokenPairsResponse, error)
+	// NftClassPairs queries all (l1 class id, l2 class id) pairs.
+	NftClassPairs(context.Context, *QueryNftClassPairsRequest) (*QueryNftClassPairsResponse, error)
+	LastFinalizedOutput(context.Context, *QueryLastFinalizedOutputRequest) (*QueryLastFinalizedOutputResponse, error)
+	// OutputProposal queries output proposal by output index.
+	OutputProposal(context.Context, *QueryOutputProposalRequest) (*QueryOutputProposalResponse, error)
+	// OutputProposals queries all output proposals.
+	OutputProposals(context.Context, *QueryOutputProposalsRequest) (*QueryOutputProposalsResponse, error)
+	// DepositCap queries the deposit cap and the locked amount of a denom.
+	DepositCap(context.Context, *QueryDepositCapRequest) (*QueryDepositCapResponse, error)
+	// DepositCaps queries all deposit caps and locked amounts of a bridge.
+	DepositCaps(context.Context, *QueryDepositCapsRequest) (*QueryDepositCapsResponse, error)
+	// BridgeAccounting queries the deposited and withdrawn totals of a denom.
+	BridgeAccounting(context.Context, *QueryBridgeAccountingRequest) (*QueryBridgeAccountingResponse, error)
+	// BridgeAccountings queries the deposited and withdrawn totals of all denoms of a bridge.
+	BridgeAccountings(context.Context, *QueryBridgeAccountingsRequest) (*QueryBridgeAccountingsResponse, error)
+	// Parameters queries the rollup parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryTokenPairByL1DenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.L1Denom) > 0 {
-		i -= len(m.L1Denom)
-		copy(dAtA[i:], m.L1Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.L1Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BridgeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTokenPairByL1DenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Bridge(ctx context.Context, req *QueryBridgeRequest) (*QueryBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bridge not implemented")
 }
-
-func (m *QueryTokenPairByL1DenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Bridges(ctx context.Context, req *QueryBridgesRequest) (*QueryBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bridges not implemented")
 }
-
-func (m *QueryTokenPairByL1DenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) TokenPairByL1Denom(ctx context.Context, req *QueryTokenPairByL1DenomRequest) (*QueryTokenPairByL1DenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairByL1Denom not implemented")
 }
-
-func (m *QueryTokenPairByL2DenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) TokenPairByL2Denom(ctx context.Context, req *QueryTokenPairByL2DenomRequest) (*QueryTokenPairByL2DenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairByL2Denom not implemented")
+}
+func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairs not implemented")
+}
+func (*UnimplementedQueryServer) L2NativeTokenPairs(ctx context.Context, req *QueryL2NativeTokenPairsRequest) (*QueryL2NativeTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method L2NativeTokenPairs not implemented")
+}
+func (*UnimplementedQueryServer) NftClassPairs(ctx context.Context, req *QueryNftClassPairsRequest) (*QueryNftClassPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NftClassPairs not implemented")
+}
+func (*UnimplementedQueryServer) LastFinalizedOutput(ctx context.Context, req *QueryLastFinalizedOutputRequest) (*QueryLastFinalizedOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastFinalizedOutput not implemented")
+}
+func (*UnimplementedQueryServer) OutputProposal(ctx context.Context, req *QueryOutputProposalRequest) (*QueryOutputProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutputProposal not implemented")
+}
+func (*UnimplementedQueryServer) OutputProposals(ctx context.Context, req *QueryOutputProposalsRequest) (*QueryOutputProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutputProposals not implemented")
+}
+func (*UnimplementedQueryServer) DepositCap(ctx context.Context, req *QueryDepositCapRequest) (*QueryDepositCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCap not implemented")
+}
+func (*UnimplementedQueryServer) DepositCaps(ctx context.Context, req *QueryDepositCapsRequest) (*QueryDepositCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCaps not implemented")
+}
+func (*UnimplementedQueryServer) BridgeAccounting(ctx context.Context, req *QueryBridgeAccountingRequest) (*QueryBridgeAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeAccounting not implemented")
+}
+func (*UnimplementedQueryServer) BridgeAccountings(ctx context.Context, req *QueryBridgeAccountingsRequest) (*QueryBridgeAccountingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeAccountings not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryTokenPairByL2DenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryTokenPairByL2DenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.L2Denom) > 0 {
-		i -= len(m.L2Denom)
-		copy(dAtA[i:], m.L2Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.L2Denom)))
-		i--
-		dAtA[i] = 0x12
+func _Query_Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BridgeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Bridge(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/Bridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bridge(ctx, req.(*QueryBridgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairByL2DenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Bridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTokenPairByL2DenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Bridges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/Bridges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bridges(ctx, req.(*QueryBridgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairByL2DenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_TokenPairByL1Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairByL1DenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairByL1Denom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/TokenPairByL1Denom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairByL1Denom(ctx, req.(*QueryTokenPairByL1DenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TokenPairByL2Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairByL2DenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairByL2Denom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/TokenPairByL2Denom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairByL2Denom(ctx, req.(*QueryTokenPairByL2DenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/TokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairs(ctx, req.(*QueryTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_L2NativeTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryL2NativeTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BridgeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).L2NativeTokenPairs(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/L2NativeTokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).L2NativeTokenPairs(ctx, req.(*QueryL2NativeTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NftClassPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNftClassPairsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NftClassPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/NftClassPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NftClassPairs(ctx, req.(*QueryNftClassPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LastFinalizedOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastFinalizedOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastFinalizedOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/LastFinalizedOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastFinalizedOutput(ctx, req.(*QueryLastFinalizedOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OutputProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutputProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenPairs) > 0 {
-		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OutputProposal(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/OutputProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutputProposal(ctx, req.(*QueryOutputProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastFinalizedOutputRequest) Marshal() (dAtA []byte, err error) {
+func _Query_OutputProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutputProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutputProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/OutputProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutputProposals(ctx, req.(*QueryOutputProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/DepositCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositCap(ctx, req.(*QueryDepositCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositCapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/DepositCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositCaps(ctx, req.(*QueryDepositCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/BridgeAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeAccounting(ctx, req.(*QueryBridgeAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeAccountings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeAccountingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeAccountings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/BridgeAccountings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeAccountings(ctx, req.(*QueryBridgeAccountingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "opinit.ophost.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bridge",
+			Handler:    _Query_Bridge_Handler,
+		},
+		{
+			MethodName: "Bridges",
+			Handler:    _Query_Bridges_Handler,
+		},
+		{
+			MethodName: "TokenPairByL1Denom",
+			Handler:    _Query_TokenPairByL1Denom_Handler,
+		},
+		{
+			MethodName: "TokenPairByL2Denom",
+			Handler:    _Query_TokenPairByL2Denom_Handler,
+		},
+		{
+			MethodName: "TokenPairs",
+			Handler:    _Query_TokenPairs_Handler,
+		},
+		{
+			MethodName: "L2NativeTokenPairs",
+			Handler:    _Query_L2NativeTokenPairs_Handler,
+		},
+		{
+			MethodName: "NftClassPairs",
+			Handler:    _Query_NftClassPairs_Handler,
+		},
+		{
+			MethodName: "LastFinalizedOutput",
+			Handler:    _Query_LastFinalizedOutput_Handler,
+		},
+		{
+			MethodName: "OutputProposal",
+			Handler:    _Query_OutputProposal_Handler,
+		},
+		{
+			MethodName: "OutputProposals",
+			Handler:    _Query_OutputProposals_Handler,
+		},
+		{
+			MethodName: "DepositCap",
+			Handler:    _Query_DepositCap_Handler,
+		},
+		{
+			MethodName: "DepositCaps",
+			Handler:    _Query_DepositCaps_Handler,
+		},
+		{
+			MethodName: "BridgeAccounting",
+			Handler:    _Query_BridgeAccounting_Handler,
+		},
+		{
+			MethodName: "BridgeAccountings",
+			Handler:    _Query_BridgeAccountings_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opinit/ophost/v1/query.proto",
+}
+
+func (m *QueryBridgeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1808,12 +2331,12 @@ func (m *QueryLastFinalizedOutputRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastFinalizedOutputRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastFinalizedOutputRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1826,7 +2349,7 @@ func (m *QueryLastFinalizedOutputRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastFinalizedOutputResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBridgeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1836,18 +2359,18 @@ func (m *QueryLastFinalizedOutputResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastFinalizedOutputResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastFinalizedOutputResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.OutputProposal.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BridgeConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1855,16 +2378,23 @@ func (m *QueryLastFinalizedOutputResponse) MarshalToSizedBuffer(dAtA []byte) (in
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.OutputIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+	dAtA[i] = 0x1a
+	if len(m.BridgeAddr) > 0 {
+		i -= len(m.BridgeAddr)
+		copy(dAtA[i:], m.BridgeAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOutputProposalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBridgesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1874,30 +2404,116 @@ func (m *QueryOutputProposalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOutputProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBridgesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutputProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBridgesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OutputIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
-	if m.BridgeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bridges) > 0 {
+		for iNdEx := len(m.Bridges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bridges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairByL1DenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairByL1DenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairByL1DenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L1Denom) > 0 {
+		i -= len(m.L1Denom)
+		copy(dAtA[i:], m.L1Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.L1Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOutputProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTokenPairByL1DenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1907,18 +2523,18 @@ func (m *QueryOutputProposalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOutputProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTokenPairByL1DenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutputProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTokenPairByL1DenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.OutputProposal.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1926,11 +2542,36 @@ func (m *QueryOutputProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.OutputIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairByL2DenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairByL2DenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairByL2DenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L2Denom) > 0 {
+		i -= len(m.L2Denom)
+		copy(dAtA[i:], m.L2Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.L2Denom)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.BridgeId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
@@ -1940,7 +2581,7 @@ func (m *QueryOutputProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOutputProposalsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTokenPairByL2DenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1950,12 +2591,45 @@ func (m *QueryOutputProposalsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOutputProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTokenPairByL2DenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutputProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTokenPairByL2DenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1970,12 +2644,7 @@ func (m *QueryOutputProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.OutputIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.BridgeId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
@@ -1985,7 +2654,7 @@ func (m *QueryOutputProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOutputProposalsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTokenPairsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1995,12 +2664,12 @@ func (m *QueryOutputProposalsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOutputProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutputProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2017,10 +2686,10 @@ func (m *QueryOutputProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.OutputProposals) > 0 {
-		for iNdEx := len(m.OutputProposals) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OutputProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2034,7 +2703,7 @@ func (m *QueryOutputProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryL2NativeTokenPairsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2044,20 +2713,37 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryL2NativeTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryL2NativeTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryL2NativeTokenPairsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2067,314 +2753,2482 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryL2NativeTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryL2NativeTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryNftClassPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBridgeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
-	}
-	return n
+
+func (m *QueryNftClassPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBridgeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNftClassPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BridgeAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.BridgeConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNftClassPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBridgesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNftClassPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNftClassPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Bridges) > 0 {
-		for _, e := range m.Bridges {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassPairs) > 0 {
+		for iNdEx := len(m.ClassPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairByL1DenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastFinalizedOutputRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastFinalizedOutputRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastFinalizedOutputRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
-	}
-	l = len(m.L1Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairByL1DenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastFinalizedOutputResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenPairByL2DenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastFinalizedOutputResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastFinalizedOutputResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
+	{
+		size, err := m.OutputProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.L2Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.OutputIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairByL2DenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOutputProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutputProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutputProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.OutputIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOutputProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutputProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutputProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.OutputProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OutputIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutputProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOutputProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutputProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OutputIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutputProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutputProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutputProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokenPairs) > 0 {
-		for _, e := range m.TokenPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutputProposals) > 0 {
+		for iNdEx := len(m.OutputProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutputProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LockedAmount.Size()
+		i -= size
+		if _, err := m.LockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DepositCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DepositCaps) > 0 {
+		for iNdEx := len(m.DepositCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeAccountingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeAccountingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LockedAmount.Size()
+		i -= size
+		if _, err := m.LockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Accounting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeAccountingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeAccountingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeAccountingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeAccountingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeAccountingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeAccountingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accountings) > 0 {
+		for iNdEx := len(m.Accountings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accountings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBridgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	return n
+}
+
+func (m *QueryBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	l = len(m.BridgeAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BridgeConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bridges) > 0 {
+		for _, e := range m.Bridges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByL1DenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	l = len(m.L1Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByL1DenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenPairByL2DenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	l = len(m.L2Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByL2DenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryL2NativeTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryL2NativeTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNftClassPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNftClassPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClassPairs) > 0 {
+		for _, e := range m.ClassPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastFinalizedOutputRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	return n
+}
+
+func (m *QueryLastFinalizedOutputResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputIndex != 0 {
+		n += 1 + sovQuery(uint64(m.OutputIndex))
+	}
+	l = m.OutputProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutputProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.OutputIndex != 0 {
+		n += 1 + sovQuery(uint64(m.OutputIndex))
+	}
+	return n
+}
+
+func (m *QueryOutputProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.OutputIndex != 0 {
+		n += 1 + sovQuery(uint64(m.OutputIndex))
+	}
+	l = m.OutputProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutputProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.OutputIndex != 0 {
+		n += 1 + sovQuery(uint64(m.OutputIndex))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutputProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutputProposals) > 0 {
+		for _, e := range m.OutputProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositCapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DepositCaps) > 0 {
+		for _, e := range m.DepositCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accounting.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeAccountingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeAccountingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accountings) > 0 {
+		for _, e := range m.Accountings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBridgeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bridges = append(m.Bridges, QueryBridgeResponse{})
+			if err := m.Bridges[len(m.Bridges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByL1DenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByL1DenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByL1DenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByL1DenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByL1DenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByL1DenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByL2DenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByL2DenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByL2DenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByL2DenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByL2DenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByL2DenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryL2NativeTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryL2NativeTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryL2NativeTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryLastFinalizedOutputRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
-	}
-	return n
-}
-
-func (m *QueryLastFinalizedOutputResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OutputIndex != 0 {
-		n += 1 + sovQuery(uint64(m.OutputIndex))
-	}
-	l = m.OutputProposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryOutputProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
-	}
-	if m.OutputIndex != 0 {
-		n += 1 + sovQuery(uint64(m.OutputIndex))
-	}
-	return n
-}
-
-func (m *QueryOutputProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
-	}
-	if m.OutputIndex != 0 {
-		n += 1 + sovQuery(uint64(m.OutputIndex))
-	}
-	l = m.OutputProposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryOutputProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovQuery(uint64(m.BridgeId))
-	}
-	if m.OutputIndex != 0 {
-		n += 1 + sovQuery(uint64(m.OutputIndex))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryOutputProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OutputProposals) > 0 {
-		for _, e := range m.OutputProposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryL2NativeTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryL2NativeTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryL2NativeTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryBridgeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNftClassPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2397,17 +5251,36 @@ func (m *QueryBridgeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNftClassPairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNftClassPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
 			}
-			m.BridgeId = 0
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2417,11 +5290,28 @@ func (m *QueryBridgeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2443,7 +5333,7 @@ func (m *QueryBridgeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNftClassPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2466,36 +5356,17 @@ func (m *QueryBridgeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNftClassPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNftClassPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
-			}
-			m.BridgeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassPairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2505,27 +5376,29 @@ func (m *QueryBridgeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeAddr = string(dAtA[iNdEx:postIndex])
+			m.ClassPairs = append(m.ClassPairs, NftClassPair{})
+			if err := m.ClassPairs[len(m.ClassPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2552,7 +5425,10 @@ func (m *QueryBridgeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2577,7 +5453,7 @@ func (m *QueryBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastFinalizedOutputRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2600,17 +5476,17 @@ func (m *QueryBridgesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastFinalizedOutputRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastFinalizedOutputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
 			}
-			var msglen int
+			m.BridgeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2620,28 +5496,11 @@ func (m *QueryBridgesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BridgeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2663,7 +5522,7 @@ func (m *QueryBridgesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastFinalizedOutputResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2686,17 +5545,17 @@ func (m *QueryBridgesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastFinalizedOutputResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastFinalizedOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bridges", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 			}
-			var msglen int
+			m.OutputIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2706,29 +5565,14 @@ func (m *QueryBridgesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OutputIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bridges = append(m.Bridges, QueryBridgeResponse{})
-			if err := m.Bridges[len(m.Bridges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2755,10 +5599,7 @@ func (m *QueryBridgesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2783,7 +5624,7 @@ func (m *QueryBridgesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairByL1DenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutputProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2806,10 +5647,10 @@ func (m *QueryTokenPairByL1DenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByL1DenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutputProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByL1DenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutputProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2832,10 +5673,10 @@ func (m *QueryTokenPairByL1DenomRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L1Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 			}
-			var stringLen uint64
+			m.OutputIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2845,24 +5686,11 @@ func (m *QueryTokenPairByL1DenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OutputIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.L1Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2884,7 +5712,7 @@ func (m *QueryTokenPairByL1DenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairByL1DenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2907,15 +5735,53 @@ func (m *QueryTokenPairByL1DenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByL1DenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutputProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByL1DenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutputProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2942,7 +5808,7 @@ func (m *QueryTokenPairByL1DenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2967,7 +5833,7 @@ func (m *QueryTokenPairByL1DenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairByL2DenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutputProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2990,10 +5856,10 @@ func (m *QueryTokenPairByL2DenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByL2DenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutputProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByL2DenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutputProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3016,10 +5882,29 @@ func (m *QueryTokenPairByL2DenomRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3029,23 +5914,27 @@ func (m *QueryTokenPairByL2DenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L2Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3068,7 +5957,7 @@ func (m *QueryTokenPairByL2DenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairByL2DenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOutputProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3091,15 +5980,15 @@ func (m *QueryTokenPairByL2DenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByL2DenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutputProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByL2DenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutputProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputProposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3126,7 +6015,44 @@ func (m *QueryTokenPairByL2DenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutputProposals = append(m.OutputProposals, QueryOutputProposalResponse{})
+			if err := m.OutputProposals[len(m.OutputProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3151,7 +6077,7 @@ func (m *QueryTokenPairByL2DenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositCapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,10 +6100,10 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositCapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3201,9 +6127,9 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3213,27 +6139,23 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3256,7 +6178,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3279,15 +6201,15 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3314,16 +6236,15 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3333,25 +6254,23 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3376,7 +6295,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastFinalizedOutputRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositCapsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3399,10 +6318,10 @@ func (m *QueryLastFinalizedOutputRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastFinalizedOutputRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositCapsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastFinalizedOutputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3424,6 +6343,42 @@ func (m *QueryLastFinalizedOutputRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3445,7 +6400,7 @@ func (m *QueryLastFinalizedOutputRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastFinalizedOutputResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositCapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3468,17 +6423,17 @@ func (m *QueryLastFinalizedOutputResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastFinalizedOutputResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositCapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastFinalizedOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCaps", wireType)
 			}
-			m.OutputIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3488,14 +6443,29 @@ func (m *QueryLastFinalizedOutputResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCaps = append(m.DepositCaps, QueryDepositCapResponse{})
+			if err := m.DepositCaps[len(m.DepositCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3522,7 +6492,10 @@ func (m *QueryLastFinalizedOutputResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3547,7 +6520,7 @@ func (m *QueryLastFinalizedOutputResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutputProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeAccountingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,10 +6543,10 @@ func (m *QueryOutputProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutputProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeAccountingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutputProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3596,10 +6569,10 @@ func (m *QueryOutputProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.OutputIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3609,11 +6582,24 @@ func (m *QueryOutputProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3635,7 +6621,7 @@ func (m *QueryOutputProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeAccountingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,17 +6644,17 @@ func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutputProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeAccountingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutputProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounting", wireType)
 			}
-			m.BridgeId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3678,16 +6664,30 @@ func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accounting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
 			}
-			m.OutputIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3697,16 +6697,31 @@ func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputProposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3716,22 +6731,23 @@ func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3756,7 +6772,7 @@ func (m *QueryOutputProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutputProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeAccountingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3779,10 +6795,10 @@ func (m *QueryOutputProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutputProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeAccountingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutputProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeAccountingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3805,25 +6821,6 @@ func (m *QueryOutputProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-			}
-			m.OutputIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3880,7 +6877,7 @@ func (m *QueryOutputProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutputProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeAccountingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3903,15 +6900,15 @@ func (m *QueryOutputProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutputProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeAccountingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutputProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeAccountingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputProposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accountings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3938,8 +6935,8 @@ func (m *QueryOutputProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputProposals = append(m.OutputProposals, QueryOutputProposalResponse{})
-			if err := m.OutputProposals[len(m.OutputProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accountings = append(m.Accountings, QueryBridgeAccountingResponse{})
+			if err := m.Accountings[len(m.Accountings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
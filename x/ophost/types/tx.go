@@ -3,6 +3,7 @@ package types
 import (
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var (
@@ -17,6 +18,11 @@ var (
 	_ sdk.Msg = &MsgUpdateBatchInfo{}
 	_ sdk.Msg = &MsgUpdateMetadata{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdateDepositCap{}
+	_ sdk.Msg = &MsgRecoverBridgeFunds{}
+	_ sdk.Msg = &MsgInitiateNftDeposit{}
+	_ sdk.Msg = &MsgFinalizeNftWithdrawal{}
+	_ sdk.Msg = &MsgRegisterL2NativeToken{}
 )
 
 const (
@@ -90,12 +96,14 @@ func NewMsgProposeOutput(
 	bridgeId uint64,
 	l2BlockNumber uint64,
 	outputRoot []byte,
+	version []byte,
 ) *MsgProposeOutput {
 	return &MsgProposeOutput{
 		Proposer:      proposer,
 		BridgeId:      bridgeId,
 		L2BlockNumber: l2BlockNumber,
 		OutputRoot:    outputRoot,
+		Version:       version,
 	}
 }
 
@@ -114,6 +122,10 @@ func (msg MsgProposeOutput) Validate(accAddressCodec address.Codec) error {
 		return ErrInvalidHashLength.Wrap("output_root")
 	}
 
+	if _, err := GetOutputRootScheme(msg.Version); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -435,3 +447,231 @@ func (msg MsgUpdateParams) Validate(accAddressCodec address.Codec) error {
 
 	return nil
 }
+
+/* MsgUpdateDepositCap */
+
+// NewMsgUpdateDepositCap returns a new MsgUpdateDepositCap instance
+func NewMsgUpdateDepositCap(
+	authority string,
+	bridgeId uint64,
+	depositCap DepositCap,
+) *MsgUpdateDepositCap {
+	return &MsgUpdateDepositCap{
+		Authority:  authority,
+		BridgeId:   bridgeId,
+		DepositCap: depositCap,
+	}
+}
+
+// Validate performs basic MsgUpdateDepositCap message validation.
+func (msg MsgUpdateDepositCap) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	return msg.DepositCap.Validate()
+}
+
+/* MsgRecoverBridgeFunds */
+
+// NewMsgRecoverBridgeFunds returns a new MsgRecoverBridgeFunds instance
+func NewMsgRecoverBridgeFunds(
+	authority string,
+	bridgeId uint64,
+	recipient string,
+	amount sdk.Coins,
+) *MsgRecoverBridgeFunds {
+	return &MsgRecoverBridgeFunds{
+		Authority: authority,
+		BridgeId:  bridgeId,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// Validate performs basic MsgRecoverBridgeFunds message validation.
+func (msg MsgRecoverBridgeFunds) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if _, err := accAddressCodec.StringToBytes(msg.Recipient); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+/* MsgInitiateNftDeposit */
+
+// NewMsgInitiateNftDeposit creates a new MsgInitiateNftDeposit instance.
+func NewMsgInitiateNftDeposit(
+	sender string,
+	bridgeId uint64,
+	to string,
+	classId string,
+	tokenId string,
+) *MsgInitiateNftDeposit {
+	return &MsgInitiateNftDeposit{
+		Sender:   sender,
+		BridgeId: bridgeId,
+		To:       to,
+		ClassId:  classId,
+		TokenId:  tokenId,
+	}
+}
+
+// Validate performs basic MsgInitiateNftDeposit message validation.
+func (msg MsgInitiateNftDeposit) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := accAddressCodec.StringToBytes(msg.To); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if msg.ClassId == "" || msg.TokenId == "" {
+		return ErrInvalidNft.Wrap("empty class id or token id")
+	}
+
+	return nil
+}
+
+/* MsgFinalizeNftWithdrawal */
+
+// NewMsgFinalizeNftWithdrawal creates a new MsgFinalizeNftWithdrawal
+func NewMsgFinalizeNftWithdrawal(
+	bridgeId uint64,
+	outputIndex uint64,
+	sequence uint64,
+	withdrawalProofs [][]byte,
+	sender string,
+	receiver string,
+	classId string,
+	tokenId string,
+	version []byte,
+	stateRoot []byte,
+	storageRoot []byte,
+	latestBlockHash []byte,
+) *MsgFinalizeNftWithdrawal {
+	return &MsgFinalizeNftWithdrawal{
+		BridgeId:         bridgeId,
+		OutputIndex:      outputIndex,
+		WithdrawalProofs: withdrawalProofs,
+		Sender:           sender,
+		Receiver:         receiver,
+		Sequence:         sequence,
+		ClassId:          classId,
+		TokenId:          tokenId,
+		Version:          version,
+		StateRoot:        stateRoot,
+		StorageRoot:      storageRoot,
+		LatestBlockHash:  latestBlockHash,
+	}
+}
+
+// Validate performs basic MsgFinalizeNftWithdrawal message validation.
+func (msg MsgFinalizeNftWithdrawal) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := accAddressCodec.StringToBytes(msg.Receiver); err != nil {
+		return err
+	}
+
+	if msg.ClassId == "" || msg.TokenId == "" {
+		return ErrInvalidNft.Wrap("empty class id or token id")
+	}
+
+	if msg.Sequence == 0 {
+		return ErrInvalidSequence
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if msg.OutputIndex == 0 {
+		return ErrInvalidOutputIndex
+	}
+
+	for _, proof := range msg.WithdrawalProofs {
+		if len(proof) != 32 {
+			return ErrInvalidHashLength.Wrap("withdrawal_proofs")
+		}
+	}
+
+	if len(msg.Version) != 32 {
+		return ErrInvalidHashLength.Wrap("version")
+	}
+
+	if len(msg.StateRoot) != 32 {
+		return ErrInvalidHashLength.Wrap("state_root")
+	}
+
+	if len(msg.StorageRoot) != 32 {
+		return ErrInvalidHashLength.Wrap("storage_root")
+	}
+
+	if len(msg.LatestBlockHash) != 32 {
+		return ErrInvalidHashLength.Wrap("latest_block_hash")
+	}
+
+	return nil
+}
+
+/* MsgRegisterL2NativeToken */
+
+// NewMsgRegisterL2NativeToken returns a new MsgRegisterL2NativeToken instance
+func NewMsgRegisterL2NativeToken(
+	authority string,
+	bridgeId uint64,
+	l2Denom string,
+	metadata banktypes.Metadata,
+) *MsgRegisterL2NativeToken {
+	return &MsgRegisterL2NativeToken{
+		Authority: authority,
+		BridgeId:  bridgeId,
+		L2Denom:   l2Denom,
+		Metadata:  metadata,
+	}
+}
+
+// Validate performs basic MsgRegisterL2NativeToken message validation.
+func (msg MsgRegisterL2NativeToken) Validate(accAddressCodec address.Codec) error {
+	if _, err := accAddressCodec.StringToBytes(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.BridgeId == 0 {
+		return ErrInvalidBridgeId
+	}
+
+	if err := sdk.ValidateDenom(msg.L2Denom); err != nil {
+		return err
+	}
+
+	if msg.Metadata.Base != L1Denom(msg.BridgeId, msg.L2Denom) {
+		return ErrInvalidL2Denom
+	}
+
+	return msg.Metadata.Validate()
+}
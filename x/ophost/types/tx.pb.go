@@ -7,9 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -194,6 +196,8 @@ type MsgProposeOutput struct {
 	BridgeId      uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
 	L2BlockNumber uint64 `protobuf:"varint,3,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty" yaml:"l2_block_number"`
 	OutputRoot    []byte `protobuf:"bytes,4,opt,name=output_root,json=outputRoot,proto3" json:"output_root,omitempty" yaml:"output_root"`
+	// version of the output root, which selects the output root scheme.
+	Version []byte `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty" yaml:"version"`
 }
 
 func (m *MsgProposeOutput) Reset()         { *m = MsgProposeOutput{} }
@@ -511,6 +515,176 @@ func (m *MsgFinalizeTokenWithdrawalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFinalizeTokenWithdrawalResponse proto.InternalMessageInfo
 
+// MsgInitiateNftDeposit is a message to lock a nft in the bridge account
+// and mint the wrapped nft on l2.
+type MsgInitiateNftDeposit struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	BridgeId uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
+	To       string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty" yaml:"to"`
+	ClassId  string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty" yaml:"class_id"`
+	TokenId  string `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty" yaml:"token_id"`
+}
+
+func (m *MsgInitiateNftDeposit) Reset()         { *m = MsgInitiateNftDeposit{} }
+func (m *MsgInitiateNftDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateNftDeposit) ProtoMessage()    {}
+func (*MsgInitiateNftDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{12}
+}
+func (m *MsgInitiateNftDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateNftDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateNftDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitiateNftDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateNftDeposit.Merge(m, src)
+}
+func (m *MsgInitiateNftDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateNftDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateNftDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateNftDeposit proto.InternalMessageInfo
+
+// MsgInitiateNftDepositResponse returns a message handle result.
+type MsgInitiateNftDepositResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgInitiateNftDepositResponse) Reset()         { *m = MsgInitiateNftDepositResponse{} }
+func (m *MsgInitiateNftDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateNftDepositResponse) ProtoMessage()    {}
+func (*MsgInitiateNftDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{13}
+}
+func (m *MsgInitiateNftDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateNftDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateNftDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitiateNftDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateNftDepositResponse.Merge(m, src)
+}
+func (m *MsgInitiateNftDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateNftDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateNftDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateNftDepositResponse proto.InternalMessageInfo
+
+// MsgFinalizeNftWithdrawal is a message to release a nft locked in the bridge
+// account with the withdrawal proofs of the burned wrapped nft on l2.
+type MsgFinalizeNftWithdrawal struct {
+	BridgeId         uint64   `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
+	OutputIndex      uint64   `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty" yaml:"output_index"`
+	WithdrawalProofs [][]byte `protobuf:"bytes,4,rep,name=withdrawal_proofs,json=withdrawalProofs,proto3" json:"withdrawal_proofs,omitempty"`
+	Sender           string   `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Receiver         string   `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
+	Sequence         uint64   `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty" yaml:"sequence"`
+	// class_id is the l1 class id of the nft.
+	ClassId string `protobuf:"bytes,7,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty" yaml:"class_id"`
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty" yaml:"token_id"`
+	// version of the output root
+	Version         []byte `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty" yaml:"version"`
+	StateRoot       []byte `protobuf:"bytes,10,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty" yaml:"state_root"`
+	StorageRoot     []byte `protobuf:"bytes,11,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty" yaml:"storage_root"`
+	LatestBlockHash []byte `protobuf:"bytes,12,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty" yaml:"latest_block_hash"`
+}
+
+func (m *MsgFinalizeNftWithdrawal) Reset()         { *m = MsgFinalizeNftWithdrawal{} }
+func (m *MsgFinalizeNftWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeNftWithdrawal) ProtoMessage()    {}
+func (*MsgFinalizeNftWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{14}
+}
+func (m *MsgFinalizeNftWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeNftWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeNftWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeNftWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeNftWithdrawal.Merge(m, src)
+}
+func (m *MsgFinalizeNftWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeNftWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeNftWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeNftWithdrawal proto.InternalMessageInfo
+
+// MsgFinalizeNftWithdrawalResponse returns a message handle result.
+type MsgFinalizeNftWithdrawalResponse struct {
+}
+
+func (m *MsgFinalizeNftWithdrawalResponse) Reset()         { *m = MsgFinalizeNftWithdrawalResponse{} }
+func (m *MsgFinalizeNftWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeNftWithdrawalResponse) ProtoMessage()    {}
+func (*MsgFinalizeNftWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{15}
+}
+func (m *MsgFinalizeNftWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeNftWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeNftWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeNftWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeNftWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgFinalizeNftWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeNftWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeNftWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeNftWithdrawalResponse proto.InternalMessageInfo
+
 // MsgUpdateProposer is a message to change a proposer
 type MsgUpdateProposer struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten)
@@ -524,7 +698,7 @@ func (m *MsgUpdateProposer) Reset()         { *m = MsgUpdateProposer{} }
 func (m *MsgUpdateProposer) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateProposer) ProtoMessage()    {}
 func (*MsgUpdateProposer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{12}
+	return fileDescriptor_d16af6eaf4088d05, []int{16}
 }
 func (m *MsgUpdateProposer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +739,7 @@ func (m *MsgUpdateProposerResponse) Reset()         { *m = MsgUpdateProposerResp
 func (m *MsgUpdateProposerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateProposerResponse) ProtoMessage()    {}
 func (*MsgUpdateProposerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{13}
+	return fileDescriptor_d16af6eaf4088d05, []int{17}
 }
 func (m *MsgUpdateProposerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +783,7 @@ func (m *MsgUpdateChallengers) Reset()         { *m = MsgUpdateChallengers{} }
 func (m *MsgUpdateChallengers) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateChallengers) ProtoMessage()    {}
 func (*MsgUpdateChallengers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{14}
+	return fileDescriptor_d16af6eaf4088d05, []int{18}
 }
 func (m *MsgUpdateChallengers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +824,7 @@ func (m *MsgUpdateChallengersResponse) Reset()         { *m = MsgUpdateChallenge
 func (m *MsgUpdateChallengersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateChallengersResponse) ProtoMessage()    {}
 func (*MsgUpdateChallengersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{15}
+	return fileDescriptor_d16af6eaf4088d05, []int{19}
 }
 func (m *MsgUpdateChallengersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +866,7 @@ func (m *MsgUpdateBatchInfo) Reset()         { *m = MsgUpdateBatchInfo{} }
 func (m *MsgUpdateBatchInfo) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateBatchInfo) ProtoMessage()    {}
 func (*MsgUpdateBatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{16}
+	return fileDescriptor_d16af6eaf4088d05, []int{20}
 }
 func (m *MsgUpdateBatchInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +907,7 @@ func (m *MsgUpdateBatchInfoResponse) Reset()         { *m = MsgUpdateBatchInfoRe
 func (m *MsgUpdateBatchInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateBatchInfoResponse) ProtoMessage()    {}
 func (*MsgUpdateBatchInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{17}
+	return fileDescriptor_d16af6eaf4088d05, []int{21}
 }
 func (m *MsgUpdateBatchInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +952,7 @@ func (m *MsgUpdateMetadata) Reset()         { *m = MsgUpdateMetadata{} }
 func (m *MsgUpdateMetadata) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMetadata) ProtoMessage()    {}
 func (*MsgUpdateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{18}
+	return fileDescriptor_d16af6eaf4088d05, []int{22}
 }
 func (m *MsgUpdateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +993,7 @@ func (m *MsgUpdateMetadataResponse) Reset()         { *m = MsgUpdateMetadataResp
 func (m *MsgUpdateMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMetadataResponse) ProtoMessage()    {}
 func (*MsgUpdateMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{19}
+	return fileDescriptor_d16af6eaf4088d05, []int{23}
 }
 func (m *MsgUpdateMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +1035,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{20}
+	return fileDescriptor_d16af6eaf4088d05, []int{24}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1072,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d16af6eaf4088d05, []int{21}
+	return fileDescriptor_d16af6eaf4088d05, []int{25}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,6 +1101,254 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateDepositCap is a message to set or remove a deposit cap of a bridge
+type MsgUpdateDepositCap struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	BridgeId  uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
+	// deposit_cap is the new deposit cap of the denom. The cap is removed
+	// when both limits are zero.
+	DepositCap DepositCap `protobuf:"bytes,3,opt,name=deposit_cap,json=depositCap,proto3" json:"deposit_cap" yaml:"deposit_cap"`
+}
+
+func (m *MsgUpdateDepositCap) Reset()         { *m = MsgUpdateDepositCap{} }
+func (m *MsgUpdateDepositCap) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDepositCap) ProtoMessage()    {}
+func (*MsgUpdateDepositCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{26}
+}
+func (m *MsgUpdateDepositCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDepositCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDepositCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDepositCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDepositCap.Merge(m, src)
+}
+func (m *MsgUpdateDepositCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDepositCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDepositCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDepositCap proto.InternalMessageInfo
+
+// MsgUpdateDepositCapResponse returns a message handle result.
+type MsgUpdateDepositCapResponse struct {
+}
+
+func (m *MsgUpdateDepositCapResponse) Reset()         { *m = MsgUpdateDepositCapResponse{} }
+func (m *MsgUpdateDepositCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDepositCapResponse) ProtoMessage()    {}
+func (*MsgUpdateDepositCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{27}
+}
+func (m *MsgUpdateDepositCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDepositCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDepositCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDepositCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDepositCapResponse.Merge(m, src)
+}
+func (m *MsgUpdateDepositCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDepositCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDepositCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDepositCapResponse proto.InternalMessageInfo
+
+// MsgRecoverBridgeFunds is a message to release the funds which were sent
+// directly to a bridge account without going through the bridge. Only the
+// surplus above the tracked locked amount can be recovered.
+type MsgRecoverBridgeFunds struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	BridgeId  uint64                                   `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
+	Recipient string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgRecoverBridgeFunds) Reset()         { *m = MsgRecoverBridgeFunds{} }
+func (m *MsgRecoverBridgeFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverBridgeFunds) ProtoMessage()    {}
+func (*MsgRecoverBridgeFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{28}
+}
+func (m *MsgRecoverBridgeFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverBridgeFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverBridgeFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverBridgeFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverBridgeFunds.Merge(m, src)
+}
+func (m *MsgRecoverBridgeFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverBridgeFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverBridgeFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverBridgeFunds proto.InternalMessageInfo
+
+// MsgRecoverBridgeFundsResponse returns a message handle result.
+type MsgRecoverBridgeFundsResponse struct {
+}
+
+func (m *MsgRecoverBridgeFundsResponse) Reset()         { *m = MsgRecoverBridgeFundsResponse{} }
+func (m *MsgRecoverBridgeFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverBridgeFundsResponse) ProtoMessage()    {}
+func (*MsgRecoverBridgeFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{29}
+}
+func (m *MsgRecoverBridgeFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverBridgeFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverBridgeFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverBridgeFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverBridgeFundsResponse.Merge(m, src)
+}
+func (m *MsgRecoverBridgeFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverBridgeFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverBridgeFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverBridgeFundsResponse proto.InternalMessageInfo
+
+// MsgRegisterL2NativeToken is a message to register the l1 representation
+// of a token originated from l2, which is minted on the withdrawals of the
+// token and burned on its deposits.
+type MsgRegisterL2NativeToken struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	BridgeId  uint64 `protobuf:"varint,2,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
+	// l2_denom is the native l2 denom of the token.
+	L2Denom string `protobuf:"bytes,3,opt,name=l2_denom,json=l2Denom,proto3" json:"l2_denom,omitempty" yaml:"l2_denom"`
+	// metadata is the denom metadata of the l1 representation denom, whose
+	// base must be the representation denom of the l2 denom.
+	Metadata types1.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
+}
+
+func (m *MsgRegisterL2NativeToken) Reset()         { *m = MsgRegisterL2NativeToken{} }
+func (m *MsgRegisterL2NativeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterL2NativeToken) ProtoMessage()    {}
+func (*MsgRegisterL2NativeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{30}
+}
+func (m *MsgRegisterL2NativeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterL2NativeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterL2NativeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterL2NativeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterL2NativeToken.Merge(m, src)
+}
+func (m *MsgRegisterL2NativeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterL2NativeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterL2NativeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterL2NativeToken proto.InternalMessageInfo
+
+// MsgRegisterL2NativeTokenResponse returns a message handle result.
+type MsgRegisterL2NativeTokenResponse struct {
+}
+
+func (m *MsgRegisterL2NativeTokenResponse) Reset()         { *m = MsgRegisterL2NativeTokenResponse{} }
+func (m *MsgRegisterL2NativeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterL2NativeTokenResponse) ProtoMessage()    {}
+func (*MsgRegisterL2NativeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d16af6eaf4088d05, []int{31}
+}
+func (m *MsgRegisterL2NativeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterL2NativeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterL2NativeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterL2NativeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterL2NativeTokenResponse.Merge(m, src)
+}
+func (m *MsgRegisterL2NativeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterL2NativeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterL2NativeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterL2NativeTokenResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRecordBatch)(nil), "opinit.ophost.v1.MsgRecordBatch")
 	proto.RegisterType((*MsgRecordBatchResponse)(nil), "opinit.ophost.v1.MsgRecordBatchResponse")
@@ -940,6 +1362,10 @@ func init() {
 	proto.RegisterType((*MsgInitiateTokenDepositResponse)(nil), "opinit.ophost.v1.MsgInitiateTokenDepositResponse")
 	proto.RegisterType((*MsgFinalizeTokenWithdrawal)(nil), "opinit.ophost.v1.MsgFinalizeTokenWithdrawal")
 	proto.RegisterType((*MsgFinalizeTokenWithdrawalResponse)(nil), "opinit.ophost.v1.MsgFinalizeTokenWithdrawalResponse")
+	proto.RegisterType((*MsgInitiateNftDeposit)(nil), "opinit.ophost.v1.MsgInitiateNftDeposit")
+	proto.RegisterType((*MsgInitiateNftDepositResponse)(nil), "opinit.ophost.v1.MsgInitiateNftDepositResponse")
+	proto.RegisterType((*MsgFinalizeNftWithdrawal)(nil), "opinit.ophost.v1.MsgFinalizeNftWithdrawal")
+	proto.RegisterType((*MsgFinalizeNftWithdrawalResponse)(nil), "opinit.ophost.v1.MsgFinalizeNftWithdrawalResponse")
 	proto.RegisterType((*MsgUpdateProposer)(nil), "opinit.ophost.v1.MsgUpdateProposer")
 	proto.RegisterType((*MsgUpdateProposerResponse)(nil), "opinit.ophost.v1.MsgUpdateProposerResponse")
 	proto.RegisterType((*MsgUpdateChallengers)(nil), "opinit.ophost.v1.MsgUpdateChallengers")
@@ -950,113 +1376,148 @@ func init() {
 	proto.RegisterType((*MsgUpdateMetadataResponse)(nil), "opinit.ophost.v1.MsgUpdateMetadataResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "opinit.ophost.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "opinit.ophost.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateDepositCap)(nil), "opinit.ophost.v1.MsgUpdateDepositCap")
+	proto.RegisterType((*MsgUpdateDepositCapResponse)(nil), "opinit.ophost.v1.MsgUpdateDepositCapResponse")
+	proto.RegisterType((*MsgRecoverBridgeFunds)(nil), "opinit.ophost.v1.MsgRecoverBridgeFunds")
+	proto.RegisterType((*MsgRecoverBridgeFundsResponse)(nil), "opinit.ophost.v1.MsgRecoverBridgeFundsResponse")
+	proto.RegisterType((*MsgRegisterL2NativeToken)(nil), "opinit.ophost.v1.MsgRegisterL2NativeToken")
+	proto.RegisterType((*MsgRegisterL2NativeTokenResponse)(nil), "opinit.ophost.v1.MsgRegisterL2NativeTokenResponse")
 }
 
 func init() { proto.RegisterFile("opinit/ophost/v1/tx.proto", fileDescriptor_d16af6eaf4088d05) }
 
 var fileDescriptor_d16af6eaf4088d05 = []byte{
-	// 1604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xb7, 0x9d, 0x8f, 0xc6, 0xe3, 0x34, 0x1f, 0xdb, 0x34, 0x71, 0xdc, 0xca, 0x9b, 0x0c, 0xa5,
-	0xa4, 0x69, 0x6a, 0x2b, 0x69, 0x29, 0x92, 0xa5, 0x1e, 0xba, 0xa9, 0x68, 0x53, 0xc9, 0x34, 0x5a,
-	0x40, 0x08, 0xa8, 0x64, 0xad, 0xed, 0xc9, 0x7a, 0x55, 0x7b, 0xc7, 0xec, 0x8c, 0x93, 0x06, 0x09,
-	0x09, 0x71, 0x42, 0x9c, 0x90, 0xf8, 0x07, 0x2a, 0x4e, 0x3d, 0xf6, 0x80, 0xf8, 0x1b, 0x72, 0x41,
-	0xaa, 0x10, 0x07, 0x4e, 0x16, 0xb4, 0x87, 0x72, 0x42, 0xc8, 0x1c, 0x7b, 0x41, 0xf3, 0xb1, 0xb3,
-	0x1f, 0xb6, 0xd3, 0x34, 0x6a, 0xe9, 0x25, 0xca, 0xbc, 0xf7, 0x7b, 0x33, 0xef, 0xfd, 0xde, 0xdb,
-	0xf7, 0x66, 0x0c, 0x16, 0x71, 0xdb, 0x71, 0x1d, 0x5a, 0xc4, 0xed, 0x06, 0x26, 0xb4, 0xb8, 0xbb,
-	0x5e, 0xa4, 0xf7, 0x0b, 0x6d, 0x0f, 0x53, 0xac, 0xcd, 0x08, 0x55, 0x41, 0xa8, 0x0a, 0xbb, 0xeb,
-	0xb9, 0x59, 0xab, 0xe5, 0xb8, 0xb8, 0xc8, 0xff, 0x0a, 0x50, 0x2e, 0x5f, 0xc3, 0xa4, 0x85, 0x49,
-	0xb1, 0x6a, 0x11, 0x54, 0xdc, 0x5d, 0xaf, 0x22, 0x6a, 0xad, 0x17, 0x6b, 0xd8, 0x71, 0xa5, 0x7e,
-	0x41, 0xea, 0x5b, 0xc4, 0x66, 0x9b, 0xb7, 0x88, 0x2d, 0x15, 0x8b, 0x42, 0x51, 0xe1, 0xab, 0xa2,
-	0x58, 0x48, 0xd5, 0x9c, 0x8d, 0x6d, 0x2c, 0xe4, 0xec, 0x3f, 0x29, 0x3d, 0xdb, 0xef, 0xe9, 0x7e,
-	0x1b, 0x49, 0x1b, 0xd8, 0x4b, 0x82, 0xa9, 0x32, 0xb1, 0x4d, 0x54, 0xc3, 0x5e, 0xdd, 0xb0, 0x68,
-	0xad, 0xa1, 0xdd, 0x06, 0x69, 0xd2, 0xa9, 0xb6, 0x1c, 0x4a, 0x91, 0x97, 0x4d, 0x2e, 0x25, 0x57,
-	0xd2, 0xc6, 0x5a, 0xaf, 0xab, 0xcf, 0xec, 0x5b, 0xad, 0x66, 0x09, 0x2a, 0x15, 0xfc, 0xf5, 0xa7,
-	0x4b, 0x73, 0xf2, 0xfc, 0xeb, 0xf5, 0xba, 0x87, 0x08, 0xf9, 0x90, 0x7a, 0x8e, 0x6b, 0x9b, 0x81,
-	0xb9, 0xb6, 0x0e, 0xd2, 0x55, 0xcf, 0xa9, 0xdb, 0xa8, 0xe2, 0xd4, 0xb3, 0xa9, 0xa5, 0xe4, 0xca,
-	0xa8, 0x31, 0x17, 0xec, 0xa5, 0x54, 0xd0, 0x9c, 0x10, 0xff, 0x6f, 0xd5, 0xb5, 0xf7, 0x40, 0xa6,
-	0xca, 0xfc, 0xa8, 0x54, 0xf7, 0x29, 0x22, 0xd9, 0x91, 0xa5, 0xe4, 0xca, 0xa4, 0x31, 0xdf, 0xeb,
-	0xea, 0x9a, 0x34, 0x0a, 0x94, 0xd0, 0x04, 0x7c, 0x65, 0xb0, 0x45, 0x69, 0xe5, 0x9b, 0x67, 0x8f,
-	0x56, 0x83, 0xb3, 0xbf, 0x7b, 0xf6, 0x68, 0xf5, 0xb4, 0x0c, 0x3a, 0x1a, 0x21, 0xcc, 0x82, 0xf9,
-	0xa8, 0xc4, 0x44, 0xa4, 0x8d, 0x5d, 0x82, 0xe0, 0x6f, 0x49, 0x30, 0x5d, 0x26, 0xf6, 0xa6, 0x87,
-	0x2c, 0x8a, 0x0c, 0xee, 0x92, 0x76, 0x03, 0x9c, 0xa8, 0xb1, 0x35, 0xf6, 0xd9, 0x58, 0xed, 0x75,
-	0xf5, 0x29, 0xe1, 0x8c, 0x54, 0x0c, 0xe7, 0xc2, 0x37, 0xd5, 0x4c, 0x30, 0x5e, 0xc3, 0xee, 0x8e,
-	0x63, 0x73, 0x1a, 0x32, 0x1b, 0xf9, 0x42, 0xbc, 0x4c, 0x0a, 0xe2, 0xbc, 0x4d, 0x8e, 0x32, 0x72,
-	0x07, 0x5d, 0x3d, 0xd1, 0xeb, 0xea, 0x27, 0xe5, 0x41, 0x5c, 0x0a, 0x1f, 0x3e, 0x7b, 0xb4, 0x9a,
-	0x34, 0xe5, 0x4e, 0xa5, 0x77, 0x58, 0xc4, 0xfe, 0x09, 0x2c, 0xde, 0xf9, 0x20, 0xde, 0x70, 0x08,
-	0xf0, 0x2a, 0x58, 0x88, 0x89, 0xfc, 0x88, 0xb5, 0x33, 0xe1, 0x0c, 0xb1, 0xf8, 0x46, 0x83, 0x5c,
-	0xc0, 0x9f, 0x53, 0x60, 0xa6, 0x4c, 0xec, 0x6d, 0x0f, 0xb7, 0x31, 0x41, 0x77, 0x3a, 0xb4, 0xdd,
-	0xa1, 0xda, 0x4d, 0x30, 0xd1, 0x16, 0x02, 0x9f, 0x90, 0x8b, 0xbd, 0xae, 0x3e, 0x2d, 0xfc, 0xf4,
-	0x35, 0xc3, 0x19, 0x51, 0xc6, 0xc7, 0x29, 0x0e, 0x03, 0x4c, 0x37, 0x37, 0x2a, 0xd5, 0x26, 0xae,
-	0xdd, 0xab, 0xb8, 0x9d, 0x56, 0x15, 0x79, 0xbc, 0x40, 0x46, 0x8d, 0x5c, 0xaf, 0xab, 0xcf, 0x0b,
-	0xc3, 0x18, 0x00, 0x9a, 0x27, 0x9b, 0x1b, 0x06, 0x13, 0x7c, 0xc0, 0xd7, 0xac, 0xc0, 0x30, 0x8f,
-	0xa4, 0xe2, 0x61, 0x4c, 0xb3, 0xa3, 0xf1, 0x02, 0x0b, 0x29, 0xa1, 0x09, 0xc4, 0xca, 0xc4, 0x98,
-	0x96, 0x2e, 0x30, 0xba, 0x95, 0xfb, 0x8c, 0xef, 0x85, 0x80, 0xef, 0x08, 0x47, 0xf0, 0x1a, 0xc8,
-	0xc6, 0x65, 0x8a, 0xf1, 0x65, 0x30, 0x29, 0x8f, 0x70, 0xdc, 0x3a, 0xba, 0x2f, 0x49, 0x97, 0x3e,
-	0x6d, 0x31, 0x11, 0x7c, 0x2e, 0xca, 0xf0, 0x06, 0x6a, 0x22, 0xea, 0xd3, 0x5e, 0x06, 0xa0, 0xd6,
-	0xb0, 0x9a, 0x4d, 0xe4, 0xda, 0x8a, 0xf8, 0x4b, 0xbd, 0xae, 0x3e, 0x2b, 0x0b, 0x44, 0xe9, 0x86,
-	0x53, 0x1f, 0xda, 0xe0, 0x38, 0xe4, 0x97, 0x62, 0x8e, 0x0b, 0xe6, 0x17, 0x7a, 0x5d, 0xfd, 0x54,
-	0x84, 0x39, 0xae, 0x85, 0x91, 0x88, 0x4a, 0xab, 0x8c, 0xbb, 0xd0, 0xf9, 0xb1, 0x6a, 0x0d, 0x47,
-	0x0a, 0x17, 0x79, 0xb5, 0x86, 0x45, 0xea, 0xfb, 0x7c, 0x9e, 0xe2, 0xba, 0x2d, 0xd7, 0xa1, 0x8e,
-	0x45, 0xd1, 0x47, 0xf8, 0x1e, 0x72, 0x6f, 0xa0, 0x36, 0x26, 0x0e, 0xd5, 0xae, 0x83, 0x71, 0x82,
-	0xdc, 0xba, 0x22, 0xe7, 0x42, 0xf0, 0xf5, 0x08, 0xf9, 0x70, 0x62, 0xa4, 0xe1, 0x71, 0x48, 0x79,
-	0x17, 0xa4, 0x28, 0xe6, 0x54, 0xa4, 0x8d, 0xb7, 0x7b, 0x5d, 0x3d, 0x2d, 0xb0, 0x14, 0x0f, 0x3f,
-	0x2d, 0x45, 0xb1, 0x56, 0x06, 0xe3, 0x56, 0x0b, 0x77, 0x5c, 0x51, 0x7f, 0x99, 0x8d, 0xc5, 0x82,
-	0x84, 0xb2, 0x81, 0x50, 0x90, 0x03, 0xa1, 0xb0, 0x89, 0x1d, 0x37, 0xde, 0x09, 0x84, 0x99, 0xdf,
-	0x09, 0xc4, 0x4a, 0x5b, 0x03, 0xa3, 0x75, 0x8b, 0x5a, 0xd9, 0x31, 0x5e, 0xcc, 0xd9, 0x83, 0xae,
-	0x9e, 0xec, 0x75, 0xf5, 0x8c, 0xb0, 0x60, 0x1a, 0x8e, 0x4f, 0x98, 0x1c, 0x55, 0xba, 0xfa, 0xed,
-	0x03, 0x3d, 0xf1, 0xd7, 0x03, 0x3d, 0xc1, 0x92, 0x22, 0x63, 0x67, 0x09, 0xc9, 0x07, 0x09, 0x19,
-	0xc4, 0x30, 0xbc, 0x06, 0xf4, 0x21, 0x2a, 0x55, 0xdc, 0x39, 0x30, 0x41, 0xd0, 0x17, 0x1d, 0xe4,
-	0xd6, 0x90, 0xdf, 0x4d, 0xfc, 0x35, 0xfc, 0x7b, 0x0c, 0xe4, 0xca, 0xc4, 0x7e, 0xdf, 0x71, 0xad,
-	0xa6, 0xf3, 0xa5, 0xb0, 0xff, 0xc4, 0xa1, 0x8d, 0xba, 0x67, 0xed, 0x59, 0xcd, 0xff, 0xb9, 0x22,
-	0xb5, 0xab, 0x60, 0x76, 0x4f, 0x1d, 0xce, 0xc6, 0x29, 0xde, 0x21, 0xd9, 0xd1, 0xa5, 0x91, 0x95,
-	0x49, 0x23, 0xcd, 0xf8, 0x13, 0x84, 0xcd, 0x04, 0x98, 0x6d, 0x0e, 0x09, 0x95, 0xd9, 0xd8, 0x71,
-	0xcb, 0xec, 0x26, 0x98, 0xf0, 0x50, 0x0d, 0x39, 0xbb, 0x83, 0x3a, 0xa8, 0xaf, 0x39, 0xa4, 0x83,
-	0xfa, 0x10, 0xad, 0x18, 0x62, 0x7b, 0x9c, 0xc7, 0x7e, 0x2a, 0xd8, 0x48, 0xf1, 0x1e, 0xa4, 0x20,
-	0x54, 0x76, 0x27, 0x5e, 0x4d, 0xd9, 0x9d, 0xd8, 0x45, 0x1e, 0x71, 0xb0, 0x9b, 0x9d, 0xe0, 0x95,
-	0xa7, 0x05, 0xa3, 0x51, 0x2a, 0xa0, 0xe9, 0x43, 0xb4, 0x2b, 0x00, 0x10, 0x6a, 0x51, 0x24, 0xfa,
-	0x6e, 0x9a, 0x1b, 0x9c, 0x0e, 0x3a, 0x58, 0xa0, 0x83, 0x66, 0x9a, 0x2f, 0x58, 0xd7, 0x65, 0x39,
-	0x26, 0x14, 0x7b, 0x96, 0x2d, 0xed, 0x00, 0xb7, 0x0b, 0xe5, 0x38, 0xac, 0x85, 0x66, 0x46, 0x2e,
-	0xb9, 0xed, 0x2d, 0x30, 0xdb, 0xb4, 0x28, 0x22, 0x54, 0x4e, 0x84, 0x86, 0x45, 0x1a, 0xd9, 0x0c,
-	0xdf, 0xe0, 0x6c, 0xaf, 0xab, 0x67, 0xe5, 0xc0, 0x88, 0x43, 0xa0, 0x39, 0x2d, 0x64, 0x7c, 0x6c,
-	0xdc, 0xb2, 0x48, 0xa3, 0x74, 0x99, 0xf7, 0x7e, 0x9f, 0x78, 0xf6, 0xb1, 0x2c, 0x07, 0x1f, 0xcb,
-	0x90, 0x8a, 0x86, 0xe7, 0x00, 0x1c, 0xae, 0x55, 0x3d, 0xed, 0x87, 0x14, 0x98, 0x2d, 0x13, 0xfb,
-	0xe3, 0x76, 0xdd, 0xa2, 0x68, 0xdb, 0x1f, 0x8e, 0xb7, 0x41, 0xda, 0xea, 0xd0, 0x06, 0xf6, 0x1c,
-	0xba, 0xdf, 0x7f, 0x0b, 0x53, 0xaa, 0x43, 0x6e, 0x61, 0x0a, 0x73, 0x9c, 0x2f, 0xcb, 0x04, 0x93,
-	0x2e, 0xda, 0xab, 0xa8, 0x41, 0x2f, 0x1a, 0x5c, 0x31, 0x60, 0x3d, 0xac, 0x1d, 0xee, 0x44, 0xc6,
-	0x45, 0x7b, 0x7e, 0x48, 0xa5, 0x8b, 0xfc, 0x82, 0xa6, 0xdc, 0x62, 0x24, 0x66, 0x03, 0x12, 0xa3,
-	0xf1, 0xc3, 0x1d, 0xb0, 0xd8, 0x27, 0x7c, 0x89, 0x11, 0xaa, 0x9d, 0xef, 0xbf, 0x29, 0xf0, 0xc8,
-	0x63, 0xb7, 0x01, 0xf8, 0x63, 0x0a, 0xcc, 0xa9, 0x83, 0x36, 0xd5, 0x7c, 0x22, 0x6f, 0x3a, 0x01,
-	0x9f, 0x83, 0x69, 0x46, 0x71, 0x30, 0x31, 0xd9, 0x55, 0x78, 0x64, 0x25, 0x6d, 0x6c, 0x04, 0x37,
-	0x9d, 0x18, 0x60, 0xb8, 0x2b, 0x53, 0x2e, 0xda, 0x0b, 0xc5, 0x56, 0x2a, 0xf4, 0x67, 0xe2, 0x4c,
-	0x3c, 0x13, 0x21, 0x3c, 0x74, 0xc0, 0xd9, 0x41, 0xf2, 0xd7, 0x91, 0x8f, 0x87, 0x29, 0xa0, 0xa9,
-	0xb3, 0xf8, 0xe5, 0x7c, 0xcb, 0xdd, 0xc1, 0x6f, 0x3a, 0x1b, 0x08, 0x30, 0x0a, 0x2b, 0xe2, 0xed,
-	0xe1, 0xb8, 0x3b, 0x62, 0xe2, 0x67, 0x36, 0xce, 0x0c, 0xb8, 0xc5, 0xfb, 0x3e, 0x1b, 0x50, 0x76,
-	0xd0, 0xd3, 0x41, 0xb6, 0x82, 0x0d, 0x64, 0x27, 0x65, 0x5f, 0x99, 0xb2, 0x28, 0xad, 0xf5, 0xe7,
-	0x65, 0x31, 0x9e, 0x17, 0x85, 0x86, 0x36, 0x1f, 0xa7, 0x31, 0xe9, 0xeb, 0xc8, 0xc9, 0x3f, 0xc9,
-	0x50, 0x87, 0x2a, 0x23, 0x6a, 0xb1, 0x5b, 0xc4, 0x9b, 0x4e, 0x49, 0x11, 0x4c, 0xb4, 0xa4, 0x2b,
-	0xf2, 0x91, 0x18, 0x9a, 0x7d, 0xbe, 0x06, 0x9a, 0x0a, 0x74, 0xa4, 0xf6, 0xe3, 0x07, 0x17, 0x69,
-	0x3f, 0xbe, 0xf0, 0x75, 0x50, 0xfb, 0x8b, 0xb8, 0xe9, 0xcb, 0x3e, 0x67, 0x79, 0x56, 0xeb, 0xd5,
-	0x76, 0x9e, 0x4d, 0x30, 0xde, 0xe6, 0xbb, 0xca, 0x67, 0x67, 0xb6, 0xbf, 0x60, 0xc5, 0xa9, 0xc6,
-	0x6c, 0x30, 0xeb, 0x85, 0x05, 0x34, 0xa5, 0xa9, 0x78, 0xf8, 0x44, 0x99, 0x9b, 0xef, 0x6b, 0xdc,
-	0xc2, 0x46, 0xdc, 0xdd, 0xc3, 0x22, 0x9f, 0xb5, 0x8d, 0x7f, 0x27, 0xc0, 0x48, 0x99, 0xd8, 0xda,
-	0xa7, 0x20, 0x13, 0xfe, 0xb9, 0x61, 0xa9, 0xdf, 0xa3, 0xe8, 0xe3, 0x3c, 0xb7, 0xf2, 0x22, 0x84,
-	0x4a, 0xcc, 0x5d, 0x30, 0x19, 0x79, 0xba, 0x2f, 0x0f, 0xb4, 0x0c, 0x43, 0x72, 0x17, 0x5e, 0x08,
-	0x51, 0xbb, 0x57, 0xc0, 0xc9, 0xe8, 0x4b, 0x18, 0x0e, 0xb4, 0x8d, 0x60, 0x72, 0xab, 0x2f, 0xc6,
-	0x84, 0xdd, 0x8f, 0x3c, 0xf9, 0x06, 0xbb, 0x1f, 0x86, 0x0c, 0x71, 0x7f, 0xd0, 0xdb, 0x49, 0xa3,
-	0x60, 0x6e, 0xe0, 0xbb, 0x69, 0xf0, 0x16, 0x83, 0xa0, 0xb9, 0xf5, 0x23, 0x43, 0xd5, 0xa9, 0x5f,
-	0x81, 0x85, 0x61, 0x17, 0xfe, 0xb5, 0x81, 0xbb, 0x0d, 0x41, 0xe7, 0xae, 0xbc, 0x0c, 0x5a, 0x1d,
-	0x5f, 0x05, 0x53, 0xb1, 0x8b, 0xd5, 0x5b, 0x03, 0xf7, 0x89, 0x82, 0x72, 0x17, 0x8f, 0x00, 0x52,
-	0x67, 0xdc, 0x03, 0xb3, 0xfd, 0xd7, 0x87, 0xf3, 0x87, 0xec, 0x10, 0xc2, 0xe5, 0x0a, 0x47, 0xc3,
-	0xa9, 0xc3, 0x10, 0x98, 0x8e, 0xcf, 0xc6, 0x73, 0x87, 0x6c, 0xa1, 0x50, 0xb9, 0xb5, 0xa3, 0xa0,
-	0xfa, 0x79, 0x53, 0xed, 0xfe, 0x30, 0xde, 0x7c, 0xd0, 0xa1, 0xbc, 0xf5, 0xb5, 0xd1, 0xbb, 0x60,
-	0x32, 0xd2, 0xf7, 0x96, 0x0f, 0x23, 0x9d, 0x43, 0x86, 0x94, 0xfb, 0xa0, 0x76, 0x93, 0x1b, 0xfb,
-	0x9a, 0x0d, 0x58, 0xe3, 0xf6, 0xc1, 0x9f, 0xf9, 0xc4, 0xc1, 0x93, 0x7c, 0xf2, 0xf1, 0x93, 0x7c,
-	0xf2, 0x8f, 0x27, 0xf9, 0xe4, 0xf7, 0x4f, 0xf3, 0x89, 0xc7, 0x4f, 0xf3, 0x89, 0xdf, 0x9f, 0xe6,
-	0x13, 0x9f, 0xad, 0xd9, 0x0e, 0x6d, 0x74, 0xaa, 0x85, 0x1a, 0x6e, 0x15, 0x1d, 0x5e, 0xc6, 0x97,
-	0x9a, 0x56, 0x95, 0x14, 0xef, 0x6c, 0xf3, 0xdf, 0x4c, 0xef, 0xfb, 0xbf, 0x9a, 0xf2, 0x9f, 0x4c,
-	0xab, 0xe3, 0xfc, 0x37, 0xd3, 0xcb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x85, 0xea, 0x3c,
-	0xfd, 0x15, 0x00, 0x00,
+	// 2078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdb, 0x6f, 0x5b, 0x49,
+	0x19, 0x8f, 0x9d, 0x9b, 0xfd, 0x39, 0x6d, 0x92, 0xd3, 0xa6, 0x71, 0x4e, 0x53, 0x9f, 0x74, 0xd8,
+	0xdd, 0x26, 0x69, 0x62, 0x2b, 0xde, 0x52, 0x24, 0xa3, 0x7d, 0x58, 0xa7, 0xea, 0x6e, 0x22, 0xd2,
+	0xad, 0x0e, 0xa0, 0x15, 0x17, 0x61, 0x1d, 0xdb, 0x13, 0xfb, 0x28, 0xf6, 0x19, 0x73, 0xce, 0x24,
+	0x69, 0x90, 0x90, 0x10, 0x4f, 0x88, 0x07, 0x84, 0xc4, 0x3f, 0xb0, 0x82, 0x97, 0x15, 0x2f, 0xf4,
+	0x81, 0x3f, 0xa2, 0x2f, 0x48, 0x2b, 0x84, 0x10, 0xe2, 0xc1, 0x40, 0x8b, 0x54, 0x1e, 0x91, 0xc5,
+	0x5b, 0x5f, 0xd0, 0x99, 0x99, 0x33, 0xe7, 0x6a, 0xc7, 0x0d, 0xdb, 0x8d, 0x90, 0xf6, 0xa5, 0xf5,
+	0xcc, 0xf7, 0xfb, 0xe6, 0x9b, 0xef, 0x32, 0xbf, 0xb9, 0x9c, 0xc0, 0x0a, 0xe9, 0x99, 0x96, 0x49,
+	0x4b, 0xa4, 0xd7, 0x26, 0x0e, 0x2d, 0x9d, 0xec, 0x94, 0xe8, 0x93, 0x62, 0xcf, 0x26, 0x94, 0x28,
+	0x0b, 0x5c, 0x54, 0xe4, 0xa2, 0xe2, 0xc9, 0x8e, 0xba, 0x68, 0x74, 0x4d, 0x8b, 0x94, 0xd8, 0xbf,
+	0x1c, 0xa4, 0x16, 0x1a, 0xc4, 0xe9, 0x12, 0xa7, 0x54, 0x37, 0xac, 0xa3, 0xd2, 0xc9, 0x4e, 0x1d,
+	0x53, 0x63, 0x87, 0x35, 0x62, 0x72, 0x07, 0x4b, 0x79, 0x83, 0x98, 0x96, 0x90, 0x2f, 0x0b, 0x79,
+	0xd7, 0x69, 0xb9, 0xc6, 0xbb, 0x4e, 0x4b, 0x08, 0x56, 0xb8, 0xa0, 0xc6, 0x5a, 0x25, 0xde, 0x10,
+	0xa2, 0xeb, 0x2d, 0xd2, 0x22, 0xbc, 0xdf, 0xfd, 0x25, 0x7a, 0x57, 0xe3, 0x9e, 0x9c, 0xf5, 0xb0,
+	0xd0, 0x41, 0x83, 0x14, 0x5c, 0x3d, 0x70, 0x5a, 0x3a, 0x6e, 0x10, 0xbb, 0x59, 0x35, 0x68, 0xa3,
+	0xad, 0xec, 0x43, 0xd6, 0x39, 0xae, 0x77, 0x4d, 0x4a, 0xb1, 0x9d, 0x4f, 0xad, 0xa5, 0xd6, 0xb3,
+	0xd5, 0xad, 0x41, 0x5f, 0x5b, 0x38, 0x33, 0xba, 0x9d, 0x0a, 0x92, 0x22, 0xf4, 0xc7, 0xdf, 0x6f,
+	0x5f, 0x17, 0xf6, 0xdf, 0x6f, 0x36, 0x6d, 0xec, 0x38, 0xdf, 0xa4, 0xb6, 0x69, 0xb5, 0x74, 0x5f,
+	0x5d, 0xd9, 0x81, 0x6c, 0xdd, 0x36, 0x9b, 0x2d, 0x5c, 0x33, 0x9b, 0xf9, 0xf4, 0x5a, 0x6a, 0x7d,
+	0xaa, 0x7a, 0xdd, 0x1f, 0x4b, 0x8a, 0x90, 0x9e, 0xe1, 0xbf, 0xf7, 0x9a, 0xca, 0xd7, 0x20, 0x57,
+	0x77, 0xe7, 0x51, 0xab, 0x9f, 0x51, 0xec, 0xe4, 0x27, 0xd7, 0x52, 0xeb, 0x73, 0xd5, 0x1b, 0x83,
+	0xbe, 0xa6, 0x08, 0x25, 0x5f, 0x88, 0x74, 0x60, 0xad, 0xaa, 0xdb, 0xa8, 0xac, 0xff, 0xf4, 0xe5,
+	0xd3, 0x4d, 0xdf, 0xf6, 0xcf, 0x5f, 0x3e, 0xdd, 0x5c, 0x12, 0x4e, 0x87, 0x3d, 0x44, 0x79, 0xb8,
+	0x11, 0xee, 0xd1, 0xb1, 0xd3, 0x23, 0x96, 0x83, 0xd1, 0x9f, 0x52, 0x30, 0x7f, 0xe0, 0xb4, 0x76,
+	0x6d, 0x6c, 0x50, 0x5c, 0x65, 0x53, 0x52, 0x1e, 0xc0, 0x6c, 0xc3, 0x6d, 0x13, 0x2f, 0x1a, 0x9b,
+	0x83, 0xbe, 0x76, 0x95, 0x4f, 0x46, 0x08, 0x86, 0xc7, 0xc2, 0x53, 0x55, 0x74, 0x98, 0x69, 0x10,
+	0xeb, 0xd0, 0x6c, 0xb1, 0x30, 0xe4, 0xca, 0x85, 0x62, 0xb4, 0x8c, 0x8a, 0xdc, 0xde, 0x2e, 0x43,
+	0x55, 0xd5, 0x67, 0x7d, 0x6d, 0x62, 0xd0, 0xd7, 0xae, 0x08, 0x43, 0xac, 0x17, 0x7d, 0xfa, 0xf2,
+	0xe9, 0x66, 0x4a, 0x17, 0x23, 0x55, 0xee, 0xb8, 0x1e, 0x7b, 0x16, 0x5c, 0x7f, 0x6f, 0xf8, 0xfe,
+	0x06, 0x5d, 0x40, 0xf7, 0x61, 0x39, 0xd2, 0xe5, 0x79, 0xac, 0xdc, 0x0c, 0x66, 0xc8, 0xf5, 0x6f,
+	0xca, 0xcf, 0x05, 0xfa, 0x67, 0x1a, 0x16, 0x0e, 0x9c, 0xd6, 0x63, 0x9b, 0xf4, 0x88, 0x83, 0x3f,
+	0x3a, 0xa6, 0xbd, 0x63, 0xaa, 0x7c, 0x00, 0x99, 0x1e, 0xef, 0xf0, 0x02, 0x72, 0x77, 0xd0, 0xd7,
+	0xe6, 0xf9, 0x3c, 0x3d, 0xc9, 0xf0, 0x88, 0x48, 0xe5, 0x8b, 0x14, 0x47, 0x15, 0xe6, 0x3b, 0xe5,
+	0x5a, 0xbd, 0x43, 0x1a, 0x47, 0x35, 0xeb, 0xb8, 0x5b, 0xc7, 0x36, 0x2b, 0x90, 0xa9, 0xaa, 0x3a,
+	0xe8, 0x6b, 0x37, 0xb8, 0x62, 0x04, 0x80, 0xf4, 0x2b, 0x9d, 0x72, 0xd5, 0xed, 0x78, 0xc4, 0xda,
+	0x6e, 0x81, 0x11, 0xe6, 0x49, 0xcd, 0x26, 0x84, 0xe6, 0xa7, 0xa2, 0x05, 0x16, 0x10, 0x22, 0x1d,
+	0x78, 0x4b, 0x27, 0x84, 0x2a, 0x5b, 0x30, 0x7b, 0x82, 0x6d, 0xc7, 0x24, 0x56, 0x7e, 0x9a, 0x29,
+	0x29, 0x7e, 0x21, 0x08, 0x01, 0xd2, 0x3d, 0x48, 0x65, 0xc3, 0x4d, 0x8e, 0x74, 0xd6, 0xcd, 0xce,
+	0xb2, 0x9f, 0x9d, 0x50, 0x44, 0xd1, 0x7b, 0x90, 0x8f, 0xf6, 0xc9, 0xfc, 0xdc, 0x86, 0x39, 0x31,
+	0x21, 0xd3, 0x6a, 0xe2, 0x27, 0x22, 0x45, 0xc2, 0x83, 0x3d, 0xb7, 0x0b, 0xbd, 0xe2, 0x45, 0xfb,
+	0x00, 0x77, 0x30, 0xf5, 0x92, 0x74, 0x00, 0xd0, 0x68, 0x1b, 0x9d, 0x0e, 0xb6, 0x5a, 0x32, 0x4d,
+	0xdb, 0x83, 0xbe, 0xb6, 0x28, 0xca, 0x49, 0xca, 0x86, 0x27, 0x2a, 0x30, 0xc0, 0x45, 0x52, 0x55,
+	0x89, 0x4c, 0x9c, 0xe7, 0x69, 0x79, 0xd0, 0xd7, 0xae, 0x85, 0xe2, 0xcc, 0xa4, 0x28, 0xe4, 0x51,
+	0x65, 0xd3, 0x8d, 0x5d, 0xc0, 0x7e, 0xa4, 0xb6, 0x83, 0x9e, 0xa2, 0x15, 0x58, 0x8e, 0x74, 0xc9,
+	0xd5, 0xfc, 0x2a, 0xcd, 0x64, 0x7b, 0x96, 0x49, 0x4d, 0x83, 0xe2, 0x6f, 0x91, 0x23, 0x6c, 0x3d,
+	0xc0, 0x3d, 0xe2, 0x98, 0x54, 0x79, 0x1f, 0x66, 0x1c, 0x6c, 0x35, 0x65, 0x70, 0x36, 0xfc, 0xb5,
+	0xc6, 0xfb, 0x87, 0x07, 0x46, 0x28, 0x5e, 0x24, 0x28, 0x5f, 0x85, 0x34, 0x25, 0x2c, 0x14, 0xd9,
+	0xea, 0xdb, 0x83, 0xbe, 0x96, 0xe5, 0x58, 0x4a, 0x86, 0x5b, 0x4b, 0x53, 0xa2, 0x1c, 0xc0, 0x8c,
+	0xd1, 0x25, 0xc7, 0x16, 0xaf, 0xd6, 0x5c, 0x79, 0xa5, 0x28, 0xa0, 0xee, 0xf6, 0x51, 0x14, 0xdb,
+	0x47, 0x71, 0x97, 0x98, 0x56, 0x94, 0x37, 0xb8, 0x9a, 0xc7, 0x1b, 0xbc, 0xa5, 0x6c, 0xc1, 0x54,
+	0xd3, 0xa0, 0x86, 0xa8, 0xe2, 0xfc, 0xb3, 0xbe, 0x96, 0x1a, 0xf4, 0xb5, 0x1c, 0xd7, 0x70, 0x25,
+	0x0c, 0x3f, 0xa1, 0x33, 0x54, 0xe5, 0xfe, 0xcf, 0x3e, 0xd1, 0x26, 0xfe, 0xf5, 0x89, 0x36, 0xe1,
+	0x26, 0x45, 0xf8, 0xee, 0x26, 0xa4, 0xe0, 0x27, 0x24, 0x29, 0xc2, 0xe8, 0x3d, 0xd0, 0x86, 0x88,
+	0x64, 0x71, 0xab, 0x90, 0x71, 0xf0, 0x0f, 0x8f, 0xb1, 0xd5, 0xc0, 0x1e, 0xf7, 0x78, 0x6d, 0xf4,
+	0x9f, 0x69, 0x50, 0x0f, 0x9c, 0xd6, 0x43, 0xd3, 0x32, 0x3a, 0xe6, 0x8f, 0xb8, 0xfe, 0xc7, 0x26,
+	0x6d, 0x37, 0x6d, 0xe3, 0xd4, 0xe8, 0x7c, 0xc1, 0x15, 0xa9, 0xdc, 0x87, 0xc5, 0x53, 0x69, 0xdc,
+	0xdd, 0x7c, 0xc9, 0xa1, 0x93, 0x9f, 0x5a, 0x9b, 0x5c, 0x9f, 0xab, 0x66, 0xdd, 0xf8, 0xf1, 0x80,
+	0x2d, 0xf8, 0x98, 0xc7, 0x0c, 0x12, 0x28, 0xb3, 0xe9, 0x8b, 0x96, 0xd9, 0x07, 0x90, 0xb1, 0x71,
+	0x03, 0x9b, 0x27, 0x49, 0x7c, 0xeb, 0x49, 0x46, 0xf0, 0xad, 0x07, 0x51, 0x4a, 0x81, 0x68, 0xcf,
+	0x30, 0xdf, 0xaf, 0xf9, 0x03, 0xc9, 0xb8, 0xfb, 0x29, 0x08, 0x94, 0xdd, 0xec, 0xe7, 0x53, 0x76,
+	0x92, 0x3f, 0x33, 0xe7, 0xf2, 0xa7, 0x72, 0x0f, 0xc0, 0xa1, 0x06, 0xc5, 0x9c, 0xa5, 0xb3, 0x4c,
+	0x61, 0xc9, 0x67, 0x30, 0x5f, 0x86, 0xf4, 0x2c, 0x6b, 0x30, 0x8e, 0xae, 0xc0, 0x9c, 0x43, 0x89,
+	0x6d, 0xb4, 0x84, 0x1e, 0x30, 0xbd, 0x40, 0x8e, 0x83, 0x52, 0xa4, 0xe7, 0x44, 0x93, 0xe9, 0x7e,
+	0x08, 0x8b, 0x1d, 0x83, 0x62, 0x87, 0x8a, 0xfd, 0xa3, 0x6d, 0x38, 0xed, 0x7c, 0x8e, 0x0d, 0xb0,
+	0x3a, 0xe8, 0x6b, 0x79, 0xb1, 0xbd, 0x44, 0x21, 0x48, 0x9f, 0xe7, 0x7d, 0x6c, 0x93, 0xf9, 0xd0,
+	0x70, 0xda, 0x95, 0x77, 0x19, 0xf7, 0x7b, 0x81, 0x77, 0x17, 0xcb, 0x6d, 0x7f, 0xb1, 0x0c, 0xa9,
+	0xe8, 0xfd, 0xa9, 0xcc, 0xdc, 0xc2, 0x15, 0xf4, 0x16, 0xa0, 0xe1, 0x18, 0xc9, 0x6c, 0x7f, 0x4d,
+	0xc3, 0x52, 0x60, 0x71, 0x3d, 0x3a, 0xa4, 0xff, 0x97, 0xbc, 0x56, 0x84, 0x4c, 0xa3, 0x63, 0x38,
+	0x8e, 0x6b, 0x68, 0x8a, 0x29, 0x07, 0x2a, 0xd2, 0x93, 0x20, 0x7d, 0x96, 0xfd, 0xdc, 0x6b, 0xba,
+	0x78, 0xea, 0x46, 0xc4, 0xc5, 0x4f, 0x47, 0xf1, 0x9e, 0x04, 0xe9, 0xb3, 0xec, 0xe7, 0x5e, 0xb3,
+	0x72, 0x6f, 0x08, 0x75, 0xad, 0xc6, 0xa9, 0xcb, 0x0f, 0x21, 0xfa, 0x3a, 0xdc, 0x4a, 0x14, 0x8c,
+	0x45, 0x5b, 0xaf, 0xa6, 0x21, 0x1f, 0x48, 0xe0, 0xa3, 0x43, 0xfa, 0x25, 0x69, 0x7d, 0xa1, 0xa4,
+	0x15, 0xac, 0xa9, 0xd9, 0xd7, 0xac, 0xa9, 0xcc, 0xf9, 0x35, 0x15, 0x64, 0xb1, 0xec, 0xeb, 0xb2,
+	0x18, 0x5c, 0x90, 0xc5, 0x72, 0xff, 0x2b, 0x8b, 0xcd, 0x5d, 0x84, 0xc5, 0x76, 0x62, 0x2c, 0xa6,
+	0xc5, 0x59, 0x2c, 0x54, 0xe0, 0x08, 0xc1, 0xda, 0x30, 0x99, 0xe4, 0xae, 0x5f, 0xa5, 0x61, 0xf1,
+	0xc0, 0x69, 0x7d, 0xbb, 0xd7, 0x34, 0x28, 0x7e, 0xec, 0x5d, 0x06, 0xf6, 0x21, 0x6b, 0x1c, 0xd3,
+	0x36, 0xb1, 0x4d, 0x7a, 0x16, 0xbf, 0x75, 0x4a, 0xd1, 0x88, 0x5b, 0xa7, 0xc4, 0x5c, 0x64, 0x99,
+	0xe9, 0x30, 0x67, 0xe1, 0xd3, 0x9a, 0xbc, 0xd8, 0x70, 0x2a, 0x2b, 0xf9, 0x11, 0x0f, 0x4a, 0x87,
+	0x4f, 0x22, 0x67, 0xe1, 0x53, 0xcf, 0xa5, 0xca, 0x5d, 0x76, 0x21, 0x95, 0xd3, 0x72, 0x03, 0x98,
+	0xf7, 0x03, 0x18, 0xf6, 0x1f, 0x1d, 0xc2, 0x4a, 0xac, 0xf3, 0x35, 0x2e, 0x01, 0xca, 0x3b, 0xf1,
+	0x9b, 0x11, 0xf3, 0x3c, 0x72, 0xfb, 0x41, 0xbf, 0x4e, 0xc3, 0x75, 0x69, 0x68, 0x57, 0x9e, 0xb0,
+	0x9d, 0xcb, 0x4e, 0xc0, 0xf7, 0x60, 0xde, 0x0d, 0xb1, 0x7f, 0xe6, 0x77, 0xaf, 0xfe, 0x93, 0xeb,
+	0xd9, 0x6a, 0xd9, 0xbf, 0xd9, 0x45, 0x00, 0xc3, 0xa7, 0x72, 0xd5, 0xc2, 0xa7, 0x01, 0xdf, 0x2a,
+	0xc5, 0x78, 0x26, 0x6e, 0x46, 0x33, 0x11, 0xc0, 0x23, 0x13, 0x56, 0x93, 0xfa, 0xdf, 0x44, 0x3e,
+	0x3e, 0x4d, 0x83, 0x22, 0x6d, 0xb1, 0xc7, 0x88, 0x3d, 0xeb, 0x90, 0x5c, 0x76, 0x36, 0x30, 0xb8,
+	0x21, 0xac, 0xf1, 0xb7, 0x16, 0xd3, 0x3a, 0xe4, 0x7b, 0x7b, 0xae, 0x7c, 0x33, 0xe1, 0xd5, 0xc2,
+	0x9b, 0x73, 0x15, 0x89, 0x33, 0xe0, 0x92, 0x9f, 0x2d, 0x7f, 0x00, 0x71, 0x16, 0x74, 0x57, 0x99,
+	0xd4, 0xa8, 0x6c, 0xc5, 0xf3, 0xb2, 0x12, 0xcd, 0x8b, 0x44, 0xa3, 0x16, 0xa8, 0xf1, 0xde, 0x37,
+	0x91, 0x93, 0x7f, 0xa7, 0x02, 0x0c, 0x75, 0x80, 0xa9, 0xe1, 0xde, 0x83, 0x2e, 0x3b, 0x25, 0x25,
+	0xc8, 0x74, 0xc5, 0x54, 0xc4, 0xa3, 0x58, 0x60, 0x9f, 0xf2, 0x24, 0x48, 0x97, 0xa0, 0xb1, 0xe8,
+	0xc7, 0x73, 0x2e, 0x44, 0x3f, 0x5e, 0xe7, 0x9b, 0x08, 0xed, 0x1f, 0xf8, 0x5b, 0x85, 0xe0, 0x39,
+	0xc3, 0x36, 0xba, 0x9f, 0x2f, 0xf3, 0xec, 0xc2, 0x4c, 0x8f, 0x8d, 0x2a, 0x9e, 0xd9, 0xf2, 0xf1,
+	0x82, 0xe5, 0x56, 0xab, 0x8b, 0xfe, 0xa1, 0x86, 0x6b, 0x20, 0x5d, 0xa8, 0xf2, 0xa7, 0x9b, 0x70,
+	0xe4, 0x6e, 0xc4, 0x88, 0x9b, 0xeb, 0xf0, 0xd7, 0x87, 0x60, 0x97, 0xdc, 0xe7, 0x7e, 0x93, 0x86,
+	0x6b, 0x52, 0x26, 0x8e, 0x90, 0xbb, 0x46, 0xef, 0xb2, 0xeb, 0xe8, 0x07, 0x90, 0x6b, 0xf2, 0xc9,
+	0xd4, 0x1a, 0x46, 0x4f, 0xac, 0xeb, 0xd5, 0x78, 0x98, 0xfc, 0x19, 0x57, 0x35, 0xb1, 0xb0, 0xc5,
+	0x03, 0x59, 0x40, 0x5d, 0xac, 0x6a, 0x68, 0x4a, 0x70, 0x65, 0x3b, 0x1e, 0x3c, 0x35, 0x1a, 0x3c,
+	0x7f, 0x6c, 0x74, 0x0b, 0x6e, 0x26, 0x74, 0xcb, 0x20, 0xfe, 0x62, 0x12, 0x96, 0xc4, 0x5b, 0xed,
+	0x09, 0xb6, 0xf9, 0xdb, 0xe5, 0xc3, 0x63, 0xab, 0x79, 0xe9, 0xfb, 0xd5, 0x3e, 0x64, 0x6d, 0xdc,
+	0x30, 0x7b, 0x26, 0xb6, 0x68, 0x7e, 0x32, 0x6a, 0x5e, 0x8a, 0x46, 0x98, 0x97, 0x18, 0xe5, 0x2c,
+	0xf0, 0xbc, 0x33, 0x39, 0xfa, 0x9e, 0xfd, 0x30, 0xf1, 0x9e, 0xfd, 0xdb, 0xbf, 0x69, 0xeb, 0x2d,
+	0x93, 0xb6, 0x8f, 0xeb, 0xc5, 0x06, 0xe9, 0x8a, 0xaf, 0x02, 0xe2, 0xbf, 0x6d, 0xa7, 0x79, 0x24,
+	0x9e, 0xfc, 0xdd, 0x11, 0x9c, 0xd0, 0x9d, 0xbc, 0x52, 0x8a, 0x67, 0x6b, 0x35, 0xfc, 0x68, 0x1e,
+	0x0e, 0x3b, 0xd2, 0xe0, 0x56, 0xa2, 0x40, 0x66, 0xec, 0xcf, 0x69, 0x76, 0x01, 0xd2, 0x71, 0xcb,
+	0x74, 0x28, 0xb6, 0xbf, 0x51, 0x7e, 0x64, 0x50, 0xf3, 0x84, 0xdf, 0x64, 0x2f, 0x3b, 0x69, 0x45,
+	0xc8, 0x74, 0xca, 0xb5, 0x26, 0xb6, 0x48, 0x57, 0xe4, 0x2c, 0xc0, 0xa1, 0x9e, 0x04, 0xe9, 0xb3,
+	0x9d, 0xf2, 0x03, 0xf7, 0x97, 0xf2, 0x71, 0x80, 0x73, 0xf9, 0xcb, 0xdb, 0x2d, 0x3f, 0x35, 0xd6,
+	0x91, 0x4c, 0x8d, 0xc7, 0x98, 0xd5, 0x55, 0x91, 0x9e, 0x28, 0x2d, 0xf3, 0xa0, 0xfb, 0xdc, 0x5c,
+	0x8e, 0x87, 0x5d, 0x0b, 0x86, 0x3d, 0x21, 0x76, 0xe2, 0x6c, 0x9d, 0x28, 0xf3, 0x82, 0x5f, 0xfe,
+	0xdd, 0x15, 0x98, 0x3c, 0x70, 0x5a, 0xca, 0x77, 0x20, 0x17, 0xfc, 0xa4, 0xb3, 0x16, 0x5f, 0xde,
+	0xe1, 0x0f, 0x20, 0xea, 0xfa, 0x79, 0x08, 0xb9, 0x19, 0x7c, 0x1f, 0xe6, 0x42, 0x9f, 0x47, 0x6e,
+	0x27, 0x6a, 0x06, 0x21, 0xea, 0xc6, 0xb9, 0x10, 0x39, 0x7a, 0x0d, 0xae, 0x84, 0xbf, 0x36, 0xa0,
+	0x44, 0xdd, 0x10, 0x46, 0xdd, 0x3c, 0x1f, 0x13, 0x9c, 0x7e, 0xe8, 0xa1, 0x3c, 0x79, 0xfa, 0x41,
+	0x88, 0xba, 0x71, 0x2e, 0x44, 0x8e, 0x4e, 0xe1, 0x7a, 0xe2, 0x6b, 0x73, 0xf2, 0x10, 0x49, 0x50,
+	0x75, 0x67, 0x6c, 0xa8, 0xb4, 0xfa, 0x63, 0x58, 0x1e, 0xf6, 0x4c, 0xba, 0x95, 0x38, 0xda, 0x10,
+	0xb4, 0x7a, 0xef, 0x75, 0xd0, 0xd2, 0xbc, 0x05, 0x4a, 0xc2, 0x43, 0xd4, 0x9d, 0x91, 0x7e, 0xf8,
+	0x40, 0xb5, 0x34, 0x26, 0x50, 0xda, 0x3b, 0x85, 0xa5, 0xe4, 0xe7, 0x95, 0xcd, 0x91, 0xd3, 0x0f,
+	0x61, 0xd5, 0xf2, 0xf8, 0x58, 0x69, 0xb8, 0x0e, 0x57, 0x23, 0xb7, 0xd6, 0xaf, 0x24, 0x8e, 0x12,
+	0x06, 0xa9, 0x77, 0xc7, 0x00, 0x49, 0x1b, 0x47, 0xb0, 0x18, 0xbf, 0x9b, 0xbd, 0x33, 0x62, 0x84,
+	0x00, 0x4e, 0x2d, 0x8e, 0x87, 0x93, 0xc6, 0x30, 0xcc, 0x47, 0x2f, 0x1e, 0x6f, 0x8d, 0x18, 0x42,
+	0xa2, 0xd4, 0xad, 0x71, 0x50, 0xf1, 0xb8, 0xc9, 0xb3, 0xf4, 0xa8, 0xb8, 0x79, 0x20, 0xf5, 0xee,
+	0x18, 0xa0, 0xe0, 0xba, 0x0e, 0x1d, 0x2a, 0x6f, 0x8f, 0x0a, 0x3a, 0x83, 0xa8, 0x1b, 0xe7, 0x42,
+	0xe4, 0xe8, 0x6d, 0x58, 0x88, 0x9d, 0xe3, 0xde, 0x1e, 0xa1, 0xee, 0xc3, 0xd4, 0xed, 0xb1, 0x60,
+	0xc1, 0xc5, 0x94, 0x70, 0xd8, 0xb9, 0x33, 0x94, 0x9e, 0xc3, 0x40, 0xb5, 0x34, 0x26, 0x30, 0xb8,
+	0x98, 0x92, 0xb7, 0xea, 0xcd, 0x21, 0x23, 0x25, 0x60, 0xd5, 0xf2, 0xf8, 0x58, 0xcf, 0xb0, 0x3a,
+	0xfd, 0x13, 0x77, 0x4f, 0xac, 0xee, 0x3f, 0xfb, 0x47, 0x61, 0xe2, 0xd9, 0xf3, 0x42, 0xea, 0xb3,
+	0xe7, 0x85, 0xd4, 0xdf, 0x9f, 0x17, 0x52, 0xbf, 0x7c, 0x51, 0x98, 0xf8, 0xec, 0x45, 0x61, 0xe2,
+	0x2f, 0x2f, 0x0a, 0x13, 0xdf, 0xdd, 0x0a, 0x9c, 0x6a, 0x4c, 0xc6, 0x08, 0xdb, 0x1d, 0xa3, 0xee,
+	0x94, 0x3e, 0x7a, 0xec, 0xb6, 0x4a, 0x4f, 0xbc, 0xbf, 0x6a, 0x60, 0xe7, 0x9b, 0xfa, 0x0c, 0xfb,
+	0x9b, 0x86, 0x77, 0xff, 0x3b, 0x00, 0x02, 0xd3, 0x4e, 0x39, 0xbd, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1083,6 +1544,10 @@ type MsgClient interface {
 	InitiateTokenDeposit(ctx context.Context, in *MsgInitiateTokenDeposit, opts ...grpc.CallOption) (*MsgInitiateTokenDepositResponse, error)
 	// FinalizeTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
 	FinalizeTokenWithdrawal(ctx context.Context, in *MsgFinalizeTokenWithdrawal, opts ...grpc.CallOption) (*MsgFinalizeTokenWithdrawalResponse, error)
+	// InitiateNftDeposit defines a user facing l1 => l2 nft transfer interface.
+	InitiateNftDeposit(ctx context.Context, in *MsgInitiateNftDeposit, opts ...grpc.CallOption) (*MsgInitiateNftDepositResponse, error)
+	// FinalizeNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	FinalizeNftWithdrawal(ctx context.Context, in *MsgFinalizeNftWithdrawal, opts ...grpc.CallOption) (*MsgFinalizeNftWithdrawalResponse, error)
 	// UpdateProposer defines a rpc handler method for MsgUpdateProposer.
 	UpdateProposer(ctx context.Context, in *MsgUpdateProposer, opts ...grpc.CallOption) (*MsgUpdateProposerResponse, error)
 	// UpdateChallengers defines a rpc handler method for MsgUpdateChallengers.
@@ -1094,6 +1559,12 @@ type MsgClient interface {
 	// UpdateParams defines an operation for updating the
 	// x/opchild module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateDepositCap defines a rpc handler method for MsgUpdateDepositCap.
+	UpdateDepositCap(ctx context.Context, in *MsgUpdateDepositCap, opts ...grpc.CallOption) (*MsgUpdateDepositCapResponse, error)
+	// RecoverBridgeFunds defines a rpc handler method for MsgRecoverBridgeFunds.
+	RecoverBridgeFunds(ctx context.Context, in *MsgRecoverBridgeFunds, opts ...grpc.CallOption) (*MsgRecoverBridgeFundsResponse, error)
+	// RegisterL2NativeToken defines a rpc handler method for MsgRegisterL2NativeToken.
+	RegisterL2NativeToken(ctx context.Context, in *MsgRegisterL2NativeToken, opts ...grpc.CallOption) (*MsgRegisterL2NativeTokenResponse, error)
 }
 
 type msgClient struct {
@@ -1158,6 +1629,24 @@ func (c *msgClient) FinalizeTokenWithdrawal(ctx context.Context, in *MsgFinalize
 	return out, nil
 }
 
+func (c *msgClient) InitiateNftDeposit(ctx context.Context, in *MsgInitiateNftDeposit, opts ...grpc.CallOption) (*MsgInitiateNftDepositResponse, error) {
+	out := new(MsgInitiateNftDepositResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Msg/InitiateNftDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeNftWithdrawal(ctx context.Context, in *MsgFinalizeNftWithdrawal, opts ...grpc.CallOption) (*MsgFinalizeNftWithdrawalResponse, error) {
+	out := new(MsgFinalizeNftWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Msg/FinalizeNftWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateProposer(ctx context.Context, in *MsgUpdateProposer, opts ...grpc.CallOption) (*MsgUpdateProposerResponse, error) {
 	out := new(MsgUpdateProposerResponse)
 	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Msg/UpdateProposer", in, out, opts...)
@@ -1203,8 +1692,35 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
+func (c *msgClient) UpdateDepositCap(ctx context.Context, in *MsgUpdateDepositCap, opts ...grpc.CallOption) (*MsgUpdateDepositCapResponse, error) {
+	out := new(MsgUpdateDepositCapResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Msg/UpdateDepositCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverBridgeFunds(ctx context.Context, in *MsgRecoverBridgeFunds, opts ...grpc.CallOption) (*MsgRecoverBridgeFundsResponse, error) {
+	out := new(MsgRecoverBridgeFundsResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Msg/RecoverBridgeFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterL2NativeToken(ctx context.Context, in *MsgRegisterL2NativeToken, opts ...grpc.CallOption) (*MsgRegisterL2NativeTokenResponse, error) {
+	out := new(MsgRegisterL2NativeTokenResponse)
+	err := c.cc.Invoke(ctx, "/opinit.ophost.v1.Msg/RegisterL2NativeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
 	// RecordBatch defines a rpc handler method for MsgRecordBatch.
 	RecordBatch(context.Context, *MsgRecordBatch) (*MsgRecordBatchResponse, error)
 	// CreateBridge defines a rpc handler method for MsgCreateBridge.
@@ -1217,6 +1733,10 @@ type MsgServer interface {
 	InitiateTokenDeposit(context.Context, *MsgInitiateTokenDeposit) (*MsgInitiateTokenDepositResponse, error)
 	// FinalizeTokenWithdrawal defines a user facing l2 => l1 token transfer interface.
 	FinalizeTokenWithdrawal(context.Context, *MsgFinalizeTokenWithdrawal) (*MsgFinalizeTokenWithdrawalResponse, error)
+	// InitiateNftDeposit defines a user facing l1 => l2 nft transfer interface.
+	InitiateNftDeposit(context.Context, *MsgInitiateNftDeposit) (*MsgInitiateNftDepositResponse, error)
+	// FinalizeNftWithdrawal defines a user facing l2 => l1 nft transfer interface.
+	FinalizeNftWithdrawal(context.Context, *MsgFinalizeNftWithdrawal) (*MsgFinalizeNftWithdrawalResponse, error)
 	// UpdateProposer defines a rpc handler method for MsgUpdateProposer.
 	UpdateProposer(context.Context, *MsgUpdateProposer) (*MsgUpdateProposerResponse, error)
 	// UpdateChallengers defines a rpc handler method for MsgUpdateChallengers.
@@ -1228,6 +1748,12 @@ type MsgServer interface {
 	// UpdateParams defines an operation for updating the
 	// x/opchild module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateDepositCap defines a rpc handler method for MsgUpdateDepositCap.
+	UpdateDepositCap(context.Context, *MsgUpdateDepositCap) (*MsgUpdateDepositCapResponse, error)
+	// RecoverBridgeFunds defines a rpc handler method for MsgRecoverBridgeFunds.
+	RecoverBridgeFunds(context.Context, *MsgRecoverBridgeFunds) (*MsgRecoverBridgeFundsResponse, error)
+	// RegisterL2NativeToken defines a rpc handler method for MsgRegisterL2NativeToken.
+	RegisterL2NativeToken(context.Context, *MsgRegisterL2NativeToken) (*MsgRegisterL2NativeTokenResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1252,6 +1778,12 @@ func (*UnimplementedMsgServer) InitiateTokenDeposit(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) FinalizeTokenWithdrawal(ctx context.Context, req *MsgFinalizeTokenWithdrawal) (*MsgFinalizeTokenWithdrawalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTokenWithdrawal not implemented")
 }
+func (*UnimplementedMsgServer) InitiateNftDeposit(ctx context.Context, req *MsgInitiateNftDeposit) (*MsgInitiateNftDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateNftDeposit not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeNftWithdrawal(ctx context.Context, req *MsgFinalizeNftWithdrawal) (*MsgFinalizeNftWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeNftWithdrawal not implemented")
+}
 func (*UnimplementedMsgServer) UpdateProposer(ctx context.Context, req *MsgUpdateProposer) (*MsgUpdateProposerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposer not implemented")
 }
@@ -1267,6 +1799,15 @@ func (*UnimplementedMsgServer) UpdateMetadata(ctx context.Context, req *MsgUpdat
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDepositCap(ctx context.Context, req *MsgUpdateDepositCap) (*MsgUpdateDepositCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepositCap not implemented")
+}
+func (*UnimplementedMsgServer) RecoverBridgeFunds(ctx context.Context, req *MsgRecoverBridgeFunds) (*MsgRecoverBridgeFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverBridgeFunds not implemented")
+}
+func (*UnimplementedMsgServer) RegisterL2NativeToken(ctx context.Context, req *MsgRegisterL2NativeToken) (*MsgRegisterL2NativeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterL2NativeToken not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1380,6 +1921,42 @@ func _Msg_FinalizeTokenWithdrawal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InitiateNftDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateNftDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateNftDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Msg/InitiateNftDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateNftDeposit(ctx, req.(*MsgInitiateNftDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeNftWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeNftWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeNftWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Msg/FinalizeNftWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeNftWithdrawal(ctx, req.(*MsgFinalizeNftWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateProposer)
 	if err := dec(in); err != nil {
@@ -1470,6 +2047,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDepositCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDepositCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDepositCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Msg/UpdateDepositCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDepositCap(ctx, req.(*MsgUpdateDepositCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverBridgeFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverBridgeFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverBridgeFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Msg/RecoverBridgeFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverBridgeFunds(ctx, req.(*MsgRecoverBridgeFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterL2NativeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterL2NativeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterL2NativeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opinit.ophost.v1.Msg/RegisterL2NativeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterL2NativeToken(ctx, req.(*MsgRegisterL2NativeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "opinit.ophost.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1498,6 +2129,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizeTokenWithdrawal",
 			Handler:    _Msg_FinalizeTokenWithdrawal_Handler,
 		},
+		{
+			MethodName: "InitiateNftDeposit",
+			Handler:    _Msg_InitiateNftDeposit_Handler,
+		},
+		{
+			MethodName: "FinalizeNftWithdrawal",
+			Handler:    _Msg_FinalizeNftWithdrawal_Handler,
+		},
 		{
 			MethodName: "UpdateProposer",
 			Handler:    _Msg_UpdateProposer_Handler,
@@ -1518,6 +2157,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateDepositCap",
+			Handler:    _Msg_UpdateDepositCap_Handler,
+		},
+		{
+			MethodName: "RecoverBridgeFunds",
+			Handler:    _Msg_RecoverBridgeFunds_Handler,
+		},
+		{
+			MethodName: "RegisterL2NativeToken",
+			Handler:    _Msg_RegisterL2NativeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "opinit/ophost/v1/tx.proto",
@@ -1676,6 +2327,13 @@ func (m *MsgProposeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.OutputRoot) > 0 {
 		i -= len(m.OutputRoot)
 		copy(dAtA[i:], m.OutputRoot)
@@ -2003,7 +2661,7 @@ func (m *MsgFinalizeTokenWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateProposer) Marshal() (dAtA []byte, err error) {
+func (m *MsgInitiateNftDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2013,20 +2671,34 @@ func (m *MsgUpdateProposer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateProposer) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInitiateNftDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInitiateNftDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewProposer) > 0 {
-		i -= len(m.NewProposer)
-		copy(dAtA[i:], m.NewProposer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewProposer)))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2035,17 +2707,17 @@ func (m *MsgUpdateProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateProposerResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInitiateNftDepositResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2055,30 +2727,25 @@ func (m *MsgUpdateProposerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInitiateNftDepositResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInitiateNftDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.L2BlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.L2BlockNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OutputIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutputIndex))
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateChallengers) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinalizeNftWithdrawal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2088,41 +2755,100 @@ func (m *MsgUpdateChallengers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateChallengers) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinalizeNftWithdrawal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateChallengers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinalizeNftWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewChallengers) > 0 {
-		for iNdEx := len(m.NewChallengers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NewChallengers[iNdEx])
-			copy(dAtA[i:], m.NewChallengers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.NewChallengers[iNdEx])))
+	if len(m.LatestBlockHash) > 0 {
+		i -= len(m.LatestBlockHash)
+		copy(dAtA[i:], m.LatestBlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LatestBlockHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WithdrawalProofs) > 0 {
+		for iNdEx := len(m.WithdrawalProofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawalProofs[iNdEx])
+			copy(dAtA[i:], m.WithdrawalProofs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawalProofs[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
+	if m.OutputIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.BridgeId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateChallengersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinalizeNftWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2132,30 +2858,20 @@ func (m *MsgUpdateChallengersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateChallengersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinalizeNftWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateChallengersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinalizeNftWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.L2BlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.L2BlockNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OutputIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutputIndex))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBatchInfo) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateProposer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2165,26 +2881,23 @@ func (m *MsgUpdateBatchInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBatchInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateProposer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.NewBatchInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.NewProposer) > 0 {
+		i -= len(m.NewProposer)
+		copy(dAtA[i:], m.NewProposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewProposer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.BridgeId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
 		i--
@@ -2200,7 +2913,7 @@ func (m *MsgUpdateBatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBatchInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateProposerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2210,12 +2923,167 @@ func (m *MsgUpdateBatchInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBatchInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateProposerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBatchInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.L2BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.L2BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OutputIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChallengers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChallengers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChallengers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewChallengers) > 0 {
+		for iNdEx := len(m.NewChallengers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewChallengers[iNdEx])
+			copy(dAtA[i:], m.NewChallengers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NewChallengers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChallengersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChallengersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChallengersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.L2BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.L2BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OutputIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutputIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBatchInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBatchInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewBatchInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BridgeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBatchInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBatchInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBatchInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2373,80 +3241,302 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateDepositCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRecordBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateDepositCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDepositCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.DepositCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.BridgeId != 0 {
-		n += 1 + sovTx(uint64(m.BridgeId))
+		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BatchBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecordBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateDepositCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBridge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Config.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUpdateDepositCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBridgeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateDepositCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BridgeId != 0 {
-		n += 1 + sovTx(uint64(m.BridgeId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposeOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRecoverBridgeFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverBridgeFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverBridgeFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverBridgeFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverBridgeFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverBridgeFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterL2NativeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterL2NativeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterL2NativeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.L2Denom) > 0 {
+		i -= len(m.L2Denom)
+		copy(dAtA[i:], m.L2Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.L2Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BridgeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BridgeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterL2NativeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterL2NativeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterL2NativeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRecordBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	l = len(m.BatchBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecordBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	return n
+}
+
+func (m *MsgProposeOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2460,6 +3550,10 @@ func (m *MsgProposeOutput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2600,90 +3694,195 @@ func (m *MsgFinalizeTokenWithdrawalResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateProposer) Size() (n int) {
+func (m *MsgInitiateNftDeposit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.BridgeId != 0 {
 		n += 1 + sovTx(uint64(m.BridgeId))
 	}
-	l = len(m.NewProposer)
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgUpdateProposerResponse) Size() (n int) {
+func (m *MsgInitiateNftDepositResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.OutputIndex != 0 {
-		n += 1 + sovTx(uint64(m.OutputIndex))
-	}
-	if m.L2BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.L2BlockNumber))
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
 	}
 	return n
 }
 
-func (m *MsgUpdateChallengers) Size() (n int) {
+func (m *MsgFinalizeNftWithdrawal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Receiver)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.BridgeId != 0 {
 		n += 1 + sovTx(uint64(m.BridgeId))
 	}
-	if len(m.NewChallengers) > 0 {
-		for _, s := range m.NewChallengers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *MsgUpdateChallengersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	if m.OutputIndex != 0 {
 		n += 1 + sovTx(uint64(m.OutputIndex))
 	}
-	if m.L2BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.L2BlockNumber))
-	}
-	return n
-}
-
-func (m *MsgUpdateBatchInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.WithdrawalProofs) > 0 {
+		for _, b := range m.WithdrawalProofs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.BridgeId != 0 {
-		n += 1 + sovTx(uint64(m.BridgeId))
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LatestBlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeNftWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProposer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	l = len(m.NewProposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputIndex != 0 {
+		n += 1 + sovTx(uint64(m.OutputIndex))
+	}
+	if m.L2BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.L2BlockNumber))
+	}
+	return n
+}
+
+func (m *MsgUpdateChallengers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	if len(m.NewChallengers) > 0 {
+		for _, s := range m.NewChallengers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateChallengersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputIndex != 0 {
+		n += 1 + sovTx(uint64(m.OutputIndex))
+	}
+	if m.L2BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.L2BlockNumber))
+	}
+	return n
+}
+
+func (m *MsgUpdateBatchInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
 	}
 	l = m.NewBatchInfo.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -2766,6 +3965,99 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateDepositCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	l = m.DepositCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDepositCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverBridgeFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRecoverBridgeFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterL2NativeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BridgeId != 0 {
+		n += 1 + sovTx(uint64(m.BridgeId))
+	}
+	l = len(m.L2Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterL2NativeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2782,30 +4074,1281 @@ func (m *MsgRecordBatch) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchBytes = append(m.BatchBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchBytes == nil {
+				m.BatchBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBridge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBridge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+			}
+			m.L2BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.L2BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputRoot = append(m.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputRoot == nil {
+				m.OutputRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeOutputResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeOutputResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOutputResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOutputResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateTokenDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateTokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateTokenDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateTokenDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateTokenDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalProofs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalProofs = append(m.WithdrawalProofs, make([]byte, postIndex-iNdEx))
+			copy(m.WithdrawalProofs[len(m.WithdrawalProofs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordBatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordBatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2815,29 +5358,31 @@ func (m *MsgRecordBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			m.BridgeId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2847,14 +5392,29 @@ func (m *MsgRecordBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2881,9 +5441,43 @@ func (m *MsgRecordBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchBytes = append(m.BatchBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.BatchBytes == nil {
-				m.BatchBytes = []byte{}
+			m.StorageRoot = append(m.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StorageRoot == nil {
+				m.StorageRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestBlockHash = append(m.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LatestBlockHash == nil {
+				m.LatestBlockHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2907,7 +5501,7 @@ func (m *MsgRecordBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizeTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2930,10 +5524,10 @@ func (m *MsgRecordBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2957,7 +5551,7 @@ func (m *MsgRecordBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBridge) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateNftDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2980,15 +5574,15 @@ func (m *MsgCreateBridge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBridge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateNftDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateNftDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3016,13 +5610,32 @@ func (m *MsgCreateBridge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3032,24 +5645,87 @@ func (m *MsgCreateBridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3072,7 +5748,7 @@ func (m *MsgCreateBridge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBridgeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateNftDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3095,17 +5771,17 @@ func (m *MsgCreateBridgeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBridgeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateNftDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateNftDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.BridgeId = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3115,7 +5791,7 @@ func (m *MsgCreateBridgeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3141,7 +5817,7 @@ func (m *MsgCreateBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizeNftWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3164,15 +5840,15 @@ func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizeNftWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizeNftWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3200,7 +5876,7 @@ func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3223,9 +5899,9 @@ func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 			}
-			m.L2BlockNumber = 0
+			m.OutputIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3235,14 +5911,14 @@ func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.L2BlockNumber |= uint64(b&0x7F) << shift
+				m.OutputIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalProofs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3269,66 +5945,46 @@ func (m *MsgProposeOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputRoot = append(m.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.OutputRoot == nil {
-				m.OutputRoot = []byte{}
-			}
+			m.WithdrawalProofs = append(m.WithdrawalProofs, make([]byte, postIndex-iNdEx))
+			copy(m.WithdrawalProofs[len(m.WithdrawalProofs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgProposeOutputResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeOutputResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.OutputIndex = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3338,64 +5994,46 @@ func (m *MsgProposeOutputResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3423,13 +6061,13 @@ func (m *MsgDeleteOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenger = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.BridgeId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3439,16 +6077,99 @@ func (m *MsgDeleteOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRoot = append(m.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StorageRoot == nil {
+				m.StorageRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
 			}
-			m.OutputIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3458,11 +6179,26 @@ func (m *MsgDeleteOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestBlockHash = append(m.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LatestBlockHash == nil {
+				m.LatestBlockHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3484,7 +6220,7 @@ func (m *MsgDeleteOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOutputResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizeNftWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,10 +6243,10 @@ func (m *MsgDeleteOutputResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOutputResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizeNftWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOutputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizeNftWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3534,7 +6270,7 @@ func (m *MsgDeleteOutputResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProposer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3557,15 +6293,15 @@ func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateTokenDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProposer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateTokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProposer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3593,7 +6329,7 @@ func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3616,7 +6352,7 @@ func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3644,74 +6380,7 @@ func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.NewProposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3734,7 +6403,7 @@ func (m *MsgInitiateTokenDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateTokenDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProposerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3757,17 +6426,17 @@ func (m *MsgInitiateTokenDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateTokenDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProposerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateTokenDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 			}
-			m.Sequence = 0
+			m.OutputIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3777,7 +6446,26 @@ func (m *MsgInitiateTokenDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+			}
+			m.L2BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.L2BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3803,7 +6491,7 @@ func (m *MsgInitiateTokenDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChallengers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3826,15 +6514,15 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChallengers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChallengers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3862,7 +6550,7 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3884,29 +6572,10 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-			}
-			m.OutputIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalProofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChallengers", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3916,61 +6585,79 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalProofs = append(m.WithdrawalProofs, make([]byte, postIndex-iNdEx))
-			copy(m.WithdrawalProofs[len(m.WithdrawalProofs)-1], dAtA[iNdEx:postIndex])
+			m.NewChallengers = append(m.NewChallengers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChallengersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChallengersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChallengersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
 			}
-			m.Sequence = 0
+			m.OutputIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3980,16 +6667,16 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.OutputIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
 			}
-			var msglen int
+			m.L2BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3999,64 +6686,66 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.L2BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBatchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
-			if m.Version == nil {
-				m.Version = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBatchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4066,31 +6755,29 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.StateRoot == nil {
-				m.StateRoot = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
 			}
-			var byteLen int
+			m.BridgeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4100,31 +6787,16 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BridgeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageRoot = append(m.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.StorageRoot == nil {
-				m.StorageRoot = []byte{}
-			}
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBatchInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4134,24 +6806,23 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestBlockHash = append(m.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.LatestBlockHash == nil {
-				m.LatestBlockHash = []byte{}
+			if err := m.NewBatchInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4175,7 +6846,7 @@ func (m *MsgFinalizeTokenWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBatchInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4198,12 +6869,50 @@ func (m *MsgFinalizeTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBatchInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeTokenWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBatchInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
+			}
+			m.OutputIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+			}
+			m.L2BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.L2BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4225,7 +6934,7 @@ func (m *MsgFinalizeTokenWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateProposer) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4248,10 +6957,10 @@ func (m *MsgUpdateProposer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProposer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4307,9 +7016,9 @@ func (m *MsgUpdateProposer) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewProposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4319,23 +7028,25 @@ func (m *MsgUpdateProposer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewProposer = string(dAtA[iNdEx:postIndex])
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4358,7 +7069,7 @@ func (m *MsgUpdateProposer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateProposerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4381,10 +7092,10 @@ func (m *MsgUpdateProposerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProposerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4446,7 +7157,7 @@ func (m *MsgUpdateProposerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChallengers) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4469,10 +7180,10 @@ func (m *MsgUpdateChallengers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChallengers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChallengers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4508,29 +7219,10 @@ func (m *MsgUpdateChallengers) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
-			}
-			m.BridgeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BridgeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChallengers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4540,23 +7232,27 @@ func (m *MsgUpdateChallengers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewChallengers = append(m.NewChallengers, string(dAtA[iNdEx:postIndex]))
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4579,7 +7275,7 @@ func (m *MsgUpdateChallengers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChallengersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4602,50 +7298,12 @@ func (m *MsgUpdateChallengersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChallengersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChallengersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-			}
-			m.OutputIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-			}
-			m.L2BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.L2BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4667,7 +7325,7 @@ func (m *MsgUpdateChallengersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBatchInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDepositCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4690,10 +7348,10 @@ func (m *MsgUpdateBatchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBatchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDepositCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDepositCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4749,7 +7407,7 @@ func (m *MsgUpdateBatchInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBatchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4776,7 +7434,7 @@ func (m *MsgUpdateBatchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewBatchInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4801,7 +7459,7 @@ func (m *MsgUpdateBatchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBatchInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDepositCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4824,50 +7482,12 @@ func (m *MsgUpdateBatchInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBatchInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDepositCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBatchInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDepositCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-			}
-			m.OutputIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-			}
-			m.L2BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.L2BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4889,7 +7509,7 @@ func (m *MsgUpdateBatchInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverBridgeFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4912,10 +7532,10 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverBridgeFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverBridgeFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4971,9 +7591,9 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4983,24 +7603,56 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5024,7 +7676,7 @@ func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverBridgeFundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5047,50 +7699,12 @@ func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverBridgeFundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverBridgeFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIndex", wireType)
-			}
-			m.OutputIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutputIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
-			}
-			m.L2BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.L2BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5112,7 +7726,7 @@ func (m *MsgUpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterL2NativeToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5135,10 +7749,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterL2NativeToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterL2NativeToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5174,8 +7788,59 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			m.BridgeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5202,10 +7867,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5230,7 +7892,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterL2NativeTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5253,10 +7915,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterL2NativeTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterL2NativeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,7 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// The amount to be paid by l2 creator.
 	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// The period for which a deposit commitment is kept to be proven on l2.
+	// Zero keeps the commitments forever.
+	DepositCommitmentRetention time.Duration `protobuf:"bytes,2,opt,name=deposit_commitment_retention,json=depositCommitmentRetention,proto3,stdduration" json:"deposit_commitment_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -204,6 +208,127 @@ func (m *TokenPair) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenPair proto.InternalMessageInfo
 
+// NftClassPair defines l1 and l2 nft class pair
+type NftClassPair struct {
+	L1ClassId string `protobuf:"bytes,1,opt,name=l1_class_id,json=l1ClassId,proto3" json:"l1_class_id,omitempty"`
+	L2ClassId string `protobuf:"bytes,2,opt,name=l2_class_id,json=l2ClassId,proto3" json:"l2_class_id,omitempty"`
+}
+
+func (m *NftClassPair) Reset()         { *m = NftClassPair{} }
+func (m *NftClassPair) String() string { return proto.CompactTextString(m) }
+func (*NftClassPair) ProtoMessage()    {}
+func (*NftClassPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29cadbd84ee898dd, []int{4}
+}
+func (m *NftClassPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NftClassPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NftClassPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NftClassPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftClassPair.Merge(m, src)
+}
+func (m *NftClassPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *NftClassPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftClassPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftClassPair proto.InternalMessageInfo
+
+// ProvenWithdrawalBitmap defines the proven flags of 256 l2 sequences
+// starting from index * 256.
+type ProvenWithdrawalBitmap struct {
+	Index  uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Bitmap []byte `protobuf:"bytes,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (m *ProvenWithdrawalBitmap) Reset()         { *m = ProvenWithdrawalBitmap{} }
+func (m *ProvenWithdrawalBitmap) String() string { return proto.CompactTextString(m) }
+func (*ProvenWithdrawalBitmap) ProtoMessage()    {}
+func (*ProvenWithdrawalBitmap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29cadbd84ee898dd, []int{5}
+}
+func (m *ProvenWithdrawalBitmap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvenWithdrawalBitmap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvenWithdrawalBitmap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvenWithdrawalBitmap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvenWithdrawalBitmap.Merge(m, src)
+}
+func (m *ProvenWithdrawalBitmap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvenWithdrawalBitmap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvenWithdrawalBitmap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvenWithdrawalBitmap proto.InternalMessageInfo
+
+// DepositCommitment defines the hash of a deposit committed on l1, which
+// allows l2 to verify the deposit with a merkle proof of the l1 state.
+type DepositCommitment struct {
+	Sequence   uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The l1 block time of the deposit, which starts the retention period.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *DepositCommitment) Reset()         { *m = DepositCommitment{} }
+func (m *DepositCommitment) String() string { return proto.CompactTextString(m) }
+func (*DepositCommitment) ProtoMessage()    {}
+func (*DepositCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29cadbd84ee898dd, []int{6}
+}
+func (m *DepositCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositCommitment.Merge(m, src)
+}
+func (m *DepositCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositCommitment proto.InternalMessageInfo
+
 // Output is a l2 block submitted by proposer.
 type Output struct {
 	// Hash of the l2 output.
@@ -212,13 +337,16 @@ type Output struct {
 	L1BlockTime time.Time `protobuf:"bytes,2,opt,name=l1_block_time,json=l1BlockTime,proto3,stdtime" json:"l1_block_time"`
 	// The l2 block number that the output root was submitted in.
 	L2BlockNumber uint64 `protobuf:"varint,3,opt,name=l2_block_number,json=l2BlockNumber,proto3" json:"l2_block_number,omitempty"`
+	// The version of the output root. Empty for the outputs proposed
+	// before the versions are recorded.
+	Version []byte `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29cadbd84ee898dd, []int{4}
+	return fileDescriptor_29cadbd84ee898dd, []int{7}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,6 +375,106 @@ func (m *Output) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Output proto.InternalMessageInfo
 
+// DepositCap defines the deposit limits of a denom for a bridge.
+// Zero values mean the corresponding limit is disabled.
+type DepositCap struct {
+	// The l1 denom of the capped token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The maximum amount which can be locked in the bridge account.
+	MaxTotalLocked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_total_locked,json=maxTotalLocked,proto3,customtype=cosmossdk.io/math.Int" json:"max_total_locked"`
+	// The maximum amount which can be deposited in a single deposit.
+	MaxPerDeposit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_per_deposit,json=maxPerDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_deposit"`
+}
+
+func (m *DepositCap) Reset()         { *m = DepositCap{} }
+func (m *DepositCap) String() string { return proto.CompactTextString(m) }
+func (*DepositCap) ProtoMessage()    {}
+func (*DepositCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29cadbd84ee898dd, []int{8}
+}
+func (m *DepositCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositCap.Merge(m, src)
+}
+func (m *DepositCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositCap proto.InternalMessageInfo
+
+// BridgeAccounting defines the running totals of a denom which has
+// flowed through a bridge.
+type BridgeAccounting struct {
+	// The l1 denom of the token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The total amount of the l1 native token deposited through
+	// InitiateTokenDeposit.
+	DepositedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=deposited_amount,json=depositedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"deposited_amount"`
+	// The total amount of the l1 native token withdrawn through
+	// FinalizeTokenWithdrawal.
+	WithdrawnAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=withdrawn_amount,json=withdrawnAmount,proto3,customtype=cosmossdk.io/math.Int" json:"withdrawn_amount"`
+	// The number of deposits.
+	DepositCount uint64 `protobuf:"varint,4,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	// The number of withdrawals.
+	WithdrawalCount uint64 `protobuf:"varint,5,opt,name=withdrawal_count,json=withdrawalCount,proto3" json:"withdrawal_count,omitempty"`
+	// The total amount of the l1 representation of the token originated from
+	// l2 minted through FinalizeTokenWithdrawal.
+	MintedAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=minted_amount,json=mintedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"minted_amount"`
+	// The total amount of the l1 representation of the token originated from
+	// l2 burned through InitiateTokenDeposit.
+	BurnedAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=burned_amount,json=burnedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"burned_amount"`
+}
+
+func (m *BridgeAccounting) Reset()         { *m = BridgeAccounting{} }
+func (m *BridgeAccounting) String() string { return proto.CompactTextString(m) }
+func (*BridgeAccounting) ProtoMessage()    {}
+func (*BridgeAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29cadbd84ee898dd, []int{9}
+}
+func (m *BridgeAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeAccounting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeAccounting.Merge(m, src)
+}
+func (m *BridgeAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeAccounting proto.InternalMessageInfo
+
 // BatchInfoWithOutput defines the batch information with output.
 type BatchInfoWithOutput struct {
 	BatchInfo BatchInfo `protobuf:"bytes,1,opt,name=batch_info,json=batchInfo,proto3" json:"batch_info"`
@@ -257,7 +485,7 @@ func (m *BatchInfoWithOutput) Reset()         { *m = BatchInfoWithOutput{} }
 func (m *BatchInfoWithOutput) String() string { return proto.CompactTextString(m) }
 func (*BatchInfoWithOutput) ProtoMessage()    {}
 func (*BatchInfoWithOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29cadbd84ee898dd, []int{5}
+	return fileDescriptor_29cadbd84ee898dd, []int{10}
 }
 func (m *BatchInfoWithOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,63 +519,91 @@ func init() {
 	proto.RegisterType((*BridgeConfig)(nil), "opinit.ophost.v1.BridgeConfig")
 	proto.RegisterType((*BatchInfo)(nil), "opinit.ophost.v1.BatchInfo")
 	proto.RegisterType((*TokenPair)(nil), "opinit.ophost.v1.TokenPair")
+	proto.RegisterType((*NftClassPair)(nil), "opinit.ophost.v1.NftClassPair")
+	proto.RegisterType((*ProvenWithdrawalBitmap)(nil), "opinit.ophost.v1.ProvenWithdrawalBitmap")
+	proto.RegisterType((*DepositCommitment)(nil), "opinit.ophost.v1.DepositCommitment")
 	proto.RegisterType((*Output)(nil), "opinit.ophost.v1.Output")
+	proto.RegisterType((*DepositCap)(nil), "opinit.ophost.v1.DepositCap")
+	proto.RegisterType((*BridgeAccounting)(nil), "opinit.ophost.v1.BridgeAccounting")
 	proto.RegisterType((*BatchInfoWithOutput)(nil), "opinit.ophost.v1.BatchInfoWithOutput")
 }
 
 func init() { proto.RegisterFile("opinit/ophost/v1/types.proto", fileDescriptor_29cadbd84ee898dd) }
 
 var fileDescriptor_29cadbd84ee898dd = []byte{
-	// 776 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xcf, 0x74, 0xbb, 0xd9, 0x66, 0xd2, 0x68, 0x17, 0x6f, 0x91, 0x9c, 0xb0, 0xd8, 0x51, 0x0e,
-	0x28, 0x5a, 0x6d, 0x6d, 0x39, 0xc0, 0x65, 0x39, 0xa0, 0xf5, 0x2e, 0x48, 0x7b, 0xa0, 0x8d, 0xdc,
-	0x4a, 0x48, 0x48, 0x28, 0x1a, 0xc7, 0x13, 0x67, 0x54, 0xdb, 0x63, 0xcd, 0x4c, 0x22, 0x5a, 0xbe,
-	0x01, 0xa7, 0x1e, 0xcb, 0xad, 0xdc, 0x2a, 0x4e, 0x3d, 0x70, 0xe2, 0x13, 0xf4, 0x58, 0x71, 0xe2,
-	0xd4, 0x42, 0x7a, 0x28, 0xe2, 0x53, 0xa0, 0xf9, 0x93, 0x34, 0xb4, 0x15, 0x02, 0x2e, 0x89, 0xdf,
-	0x7b, 0xbf, 0xf7, 0x7e, 0x6f, 0x7e, 0xef, 0xe9, 0xc1, 0x67, 0xb4, 0x24, 0x05, 0x11, 0x3e, 0x2d,
-	0xc7, 0x94, 0x0b, 0x7f, 0x1a, 0xf8, 0x62, 0xbf, 0xc4, 0xdc, 0x2b, 0x19, 0x15, 0xd4, 0x7a, 0xa2,
-	0xa3, 0x9e, 0x8e, 0x7a, 0xd3, 0xa0, 0xf5, 0x0e, 0xca, 0x49, 0x41, 0x7d, 0xf5, 0xab, 0x41, 0x2d,
-	0x67, 0x48, 0x79, 0x4e, 0xb9, 0x1f, 0x23, 0x8e, 0xfd, 0x69, 0x10, 0x63, 0x81, 0x02, 0x7f, 0x48,
-	0x49, 0x61, 0xe2, 0x4d, 0x1d, 0x1f, 0x28, 0xcb, 0xd7, 0x86, 0x09, 0x6d, 0xa4, 0x34, 0xa5, 0xda,
-	0x2f, 0xbf, 0x8c, 0xd7, 0x4d, 0x29, 0x4d, 0x33, 0xec, 0x2b, 0x2b, 0x9e, 0x8c, 0x7c, 0x41, 0x72,
-	0xcc, 0x05, 0xca, 0xcb, 0x39, 0xe3, 0x6d, 0x40, 0x32, 0x61, 0x48, 0x10, 0x6a, 0x18, 0x3b, 0x3f,
-	0x00, 0x58, 0xed, 0x23, 0x86, 0x72, 0x6e, 0x7d, 0x0b, 0x9f, 0x30, 0x9c, 0x12, 0x2e, 0x34, 0x60,
-	0x30, 0xc2, 0xd8, 0x06, 0xed, 0x07, 0xdd, 0x7a, 0xaf, 0xe9, 0x99, 0x56, 0x64, 0xdf, 0x9e, 0xe9,
-	0xdb, 0x7b, 0x4d, 0x49, 0x11, 0x7e, 0x7c, 0x76, 0xe1, 0x56, 0x7e, 0xbc, 0x74, 0xbb, 0x29, 0x11,
-	0xe3, 0x49, 0xec, 0x0d, 0x69, 0x6e, 0xfa, 0x36, 0x7f, 0x9b, 0x3c, 0xd9, 0x33, 0x42, 0xc9, 0x04,
-	0x7e, 0x72, 0x7d, 0xfa, 0x1c, 0x44, 0x8f, 0x97, 0x99, 0x3e, 0xc7, 0xf8, 0x65, 0xeb, 0xe8, 0xd8,
-	0xad, 0xfc, 0x71, 0xec, 0x82, 0xef, 0xae, 0x4f, 0x9f, 0x37, 0x8c, 0xc4, 0xba, 0xb1, 0xce, 0xcf,
-	0xab, 0x70, 0x3d, 0x64, 0x24, 0x49, 0xf1, 0x6b, 0x5a, 0x8c, 0x48, 0x6a, 0xbd, 0x84, 0xf5, 0xe1,
-	0x18, 0x65, 0x19, 0x2e, 0x52, 0xcc, 0xb8, 0x6a, 0xb2, 0x16, 0xda, 0xbf, 0xfc, 0xb4, 0xb9, 0x61,
-	0xfa, 0x7c, 0x95, 0x24, 0x0c, 0x73, 0xbe, 0x23, 0x18, 0x29, 0xd2, 0x68, 0x19, 0x6c, 0x7d, 0x04,
-	0xd7, 0x4a, 0x46, 0x4b, 0xca, 0x31, 0xb3, 0x57, 0xda, 0xe0, 0x1f, 0x13, 0x17, 0x48, 0xeb, 0x33,
-	0x08, 0x63, 0x24, 0x86, 0xe3, 0x01, 0x29, 0x46, 0xd4, 0x7e, 0xd0, 0x06, 0xdd, 0x7a, 0xef, 0x3d,
-	0xef, 0xf6, 0xc8, 0xbd, 0x50, 0x62, 0xde, 0x16, 0x23, 0x1a, 0xd6, 0xa4, 0x2e, 0xfa, 0xad, 0xb5,
-	0x78, 0xee, 0xb5, 0x0e, 0xe0, 0x53, 0x3e, 0x89, 0x73, 0xc2, 0xb9, 0x14, 0x98, 0x14, 0x02, 0xb3,
-	0x29, 0xca, 0xec, 0x55, 0x55, 0xaf, 0xe9, 0xe9, 0x59, 0x79, 0xf3, 0x59, 0x79, 0x6f, 0xcc, 0xac,
-	0x42, 0x4f, 0x56, 0xfb, 0xf3, 0xc2, 0x7d, 0xff, 0x9e, 0xec, 0x17, 0x34, 0x27, 0x02, 0xe7, 0xa5,
-	0xd8, 0x3f, 0xba, 0x74, 0x81, 0xa6, 0xb4, 0x6e, 0x70, 0x6f, 0x0d, 0x4c, 0x72, 0x8f, 0x48, 0x81,
-	0x32, 0x72, 0xa0, 0xc7, 0x5b, 0x62, 0x46, 0x68, 0x62, 0x3f, 0xfc, 0xd7, 0xdc, 0xf7, 0x64, 0xdf,
-	0xcb, 0xbd, 0x8c, 0xeb, 0x2b, 0x98, 0xf5, 0x35, 0x7c, 0x77, 0xa9, 0x73, 0x2e, 0x10, 0x13, 0x03,
-	0xb9, 0xa9, 0x76, 0x55, 0xb1, 0xb7, 0xee, 0xb0, 0xef, 0xce, 0xd7, 0x38, 0x6c, 0x48, 0xfa, 0xc3,
-	0x45, 0xf5, 0x25, 0xfd, 0x76, 0x64, 0x19, 0x09, 0xb4, 0x5a, 0x70, 0x2d, 0xc7, 0x02, 0x25, 0x48,
-	0x20, 0xfb, 0x51, 0x1b, 0x74, 0xd7, 0xa3, 0x85, 0xdd, 0xf9, 0x14, 0xd6, 0x16, 0x53, 0xb1, 0x9e,
-	0xc1, 0x9a, 0xca, 0x17, 0x02, 0x33, 0x1b, 0xc8, 0xe9, 0x47, 0x37, 0x0e, 0x6b, 0x03, 0x3e, 0x1c,
-	0x8e, 0x11, 0x29, 0xf4, 0x5e, 0x44, 0xda, 0xe8, 0xbc, 0x82, 0xb5, 0x5d, 0xba, 0x87, 0x8b, 0x3e,
-	0x22, 0xcc, 0x6a, 0xc2, 0xb5, 0x2c, 0x18, 0x24, 0xb8, 0xa0, 0xb9, 0xc9, 0x7f, 0x94, 0x05, 0x6f,
-	0xa4, 0xa9, 0x42, 0x3d, 0x13, 0x5a, 0x31, 0xa1, 0x9e, 0x0a, 0x75, 0x8e, 0x01, 0xac, 0x6e, 0x4f,
-	0x44, 0x39, 0x11, 0x96, 0x0b, 0xeb, 0x54, 0x7d, 0x0d, 0x18, 0xa5, 0x42, 0xd5, 0x58, 0x8f, 0xa0,
-	0x76, 0x45, 0x94, 0x0a, 0xeb, 0x0b, 0xd8, 0xc8, 0x82, 0x41, 0x9c, 0xd1, 0xe1, 0x9e, 0x96, 0x68,
-	0xe5, 0xbf, 0x4a, 0x54, 0xcf, 0x82, 0x50, 0xa6, 0x2b, 0x69, 0x3e, 0x80, 0x8f, 0xb3, 0x9e, 0x29,
-	0x57, 0x4c, 0xf2, 0x18, 0x33, 0xb5, 0xbd, 0xab, 0x51, 0x23, 0xeb, 0x29, 0xd4, 0x96, 0x72, 0x76,
-	0xbe, 0x07, 0xf0, 0xe9, 0x42, 0xa7, 0x2f, 0x89, 0x18, 0x9b, 0x7e, 0xff, 0xbe, 0xf8, 0xe0, 0xff,
-	0x2e, 0xfe, 0x27, 0xb0, 0xaa, 0xdf, 0x68, 0x9e, 0x63, 0xdf, 0x2d, 0xa1, 0x09, 0x97, 0xf3, 0x4d,
-	0x4a, 0xb8, 0x75, 0xf6, 0xbb, 0x53, 0x39, 0x99, 0x39, 0xe0, 0x6c, 0xe6, 0x80, 0xf3, 0x99, 0x03,
-	0x7e, 0x9b, 0x39, 0xe0, 0xf0, 0xca, 0xa9, 0x9c, 0x5f, 0x39, 0x95, 0x5f, 0xaf, 0x9c, 0xca, 0x57,
-	0x2f, 0x96, 0xae, 0x8f, 0x2c, 0x4b, 0xd0, 0x66, 0x86, 0x62, 0xee, 0x6f, 0xf7, 0xd5, 0xc5, 0xfe,
-	0x66, 0x7e, 0xb3, 0xd5, 0x1d, 0x8a, 0xab, 0x4a, 0xc3, 0x0f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x97, 0x27, 0x99, 0xda, 0xd1, 0x05, 0x00, 0x00,
+	// 1140 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xf7, 0x26, 0xae, 0x53, 0x4f, 0x6c, 0x9c, 0x6e, 0x1f, 0xda, 0x98, 0x60, 0x47, 0x46, 0x42,
+	0xa1, 0x6a, 0x76, 0xb1, 0x81, 0x4b, 0x11, 0x42, 0x71, 0x42, 0xa5, 0x48, 0x90, 0x5a, 0xdb, 0x54,
+	0xa0, 0x22, 0xb4, 0x9a, 0xdd, 0x1d, 0xdb, 0x43, 0x76, 0x67, 0x96, 0x99, 0xb1, 0x9b, 0x96, 0xff,
+	0x00, 0x09, 0xd4, 0x63, 0xb9, 0xf5, 0x58, 0x71, 0xea, 0xa1, 0xa7, 0xfe, 0x05, 0x39, 0x56, 0x3d,
+	0x55, 0x1c, 0xd2, 0x92, 0x1c, 0x8a, 0xf8, 0x2b, 0xd0, 0x3c, 0xfc, 0x20, 0x0d, 0x8f, 0xfa, 0x92,
+	0xf8, 0xfb, 0xbe, 0xdf, 0xf7, 0xfb, 0x9e, 0x33, 0xb3, 0x60, 0x85, 0x66, 0x98, 0x60, 0xe1, 0xd1,
+	0xac, 0x4f, 0xb9, 0xf0, 0x86, 0x4d, 0x4f, 0xdc, 0xc9, 0x10, 0x77, 0x33, 0x46, 0x05, 0xb5, 0x97,
+	0xb4, 0xd5, 0xd5, 0x56, 0x77, 0xd8, 0xac, 0x9e, 0x83, 0x29, 0x26, 0xd4, 0x53, 0x7f, 0x35, 0xa8,
+	0x5a, 0x8b, 0x28, 0x4f, 0x29, 0xf7, 0x42, 0xc8, 0x91, 0x37, 0x6c, 0x86, 0x48, 0xc0, 0xa6, 0x17,
+	0x51, 0x4c, 0x8c, 0x7d, 0x59, 0xdb, 0x03, 0x25, 0x79, 0x5a, 0x30, 0xa6, 0x0b, 0x3d, 0xda, 0xa3,
+	0x5a, 0x2f, 0x7f, 0x19, 0x6d, 0xbd, 0x47, 0x69, 0x2f, 0x41, 0x9e, 0x92, 0xc2, 0x41, 0xd7, 0x13,
+	0x38, 0x45, 0x5c, 0xc0, 0x34, 0x1b, 0x45, 0x3c, 0x09, 0x88, 0x07, 0x0c, 0x0a, 0x4c, 0x4d, 0xc4,
+	0xc6, 0x4f, 0x73, 0xa0, 0xd0, 0x81, 0x0c, 0xa6, 0xdc, 0xfe, 0x01, 0x2c, 0x31, 0xd4, 0xc3, 0x5c,
+	0x68, 0x40, 0xd0, 0x45, 0xc8, 0xb1, 0x56, 0xe7, 0xd7, 0x16, 0x5b, 0xcb, 0xae, 0x49, 0x45, 0xe6,
+	0xed, 0x9a, 0xbc, 0xdd, 0x4d, 0x8a, 0x49, 0xfb, 0xe3, 0x83, 0xc3, 0x7a, 0xee, 0xd7, 0x17, 0xf5,
+	0xb5, 0x1e, 0x16, 0xfd, 0x41, 0xe8, 0x46, 0x34, 0x35, 0x79, 0x9b, 0x7f, 0xeb, 0x3c, 0xde, 0x33,
+	0x8d, 0x92, 0x0e, 0xfc, 0xe1, 0xab, 0x47, 0x97, 0x2d, 0xbf, 0x32, 0x1d, 0xe9, 0x1a, 0x42, 0xf6,
+	0x77, 0x60, 0x25, 0x46, 0x19, 0xe5, 0x58, 0x04, 0x11, 0x4d, 0x53, 0x2c, 0x52, 0x44, 0x44, 0xc0,
+	0x90, 0x40, 0x44, 0x42, 0x9c, 0xb9, 0x55, 0x4b, 0x25, 0xa2, 0xcb, 0x71, 0x47, 0xe5, 0xb8, 0x5b,
+	0xa6, 0x9c, 0x76, 0x59, 0x26, 0x72, 0xff, 0x45, 0xdd, 0xd2, 0x01, 0xaa, 0x86, 0x6d, 0x73, 0x4c,
+	0xe6, 0x8f, 0xb8, 0xae, 0x56, 0xef, 0x3f, 0xa8, 0xe7, 0xfe, 0x78, 0x50, 0xb7, 0x7e, 0x7c, 0xf5,
+	0xe8, 0x72, 0xd9, 0x8c, 0x53, 0x37, 0xa1, 0xf1, 0x24, 0x0f, 0x4a, 0x6d, 0x86, 0xe3, 0x1e, 0xda,
+	0xa4, 0xa4, 0x8b, 0x7b, 0xf6, 0x55, 0xb0, 0x18, 0xf5, 0x61, 0x92, 0x20, 0xd2, 0x43, 0x8c, 0xab,
+	0x86, 0x14, 0xdb, 0xce, 0xb3, 0xc7, 0xeb, 0x17, 0x4c, 0x4f, 0x36, 0xe2, 0x98, 0x21, 0xce, 0x6f,
+	0x08, 0x86, 0x49, 0xcf, 0x9f, 0x06, 0xdb, 0x1f, 0x81, 0xb3, 0x19, 0xa3, 0x19, 0xe5, 0x88, 0xa9,
+	0x02, 0xfe, 0xcd, 0x71, 0x8c, 0xb4, 0x3f, 0x07, 0x20, 0x84, 0x22, 0xea, 0x07, 0x98, 0x74, 0xa9,
+	0x33, 0xaf, 0x0a, 0x7f, 0xdb, 0x3d, 0xb9, 0x5e, 0x6e, 0x5b, 0x62, 0xb6, 0x49, 0x97, 0xb6, 0x8b,
+	0xb2, 0x74, 0x5d, 0x76, 0x31, 0x1c, 0x69, 0xed, 0xbb, 0xe0, 0x3c, 0x1f, 0x84, 0x29, 0xe6, 0x5c,
+	0x0e, 0x13, 0x13, 0x81, 0xd8, 0x10, 0x26, 0x4e, 0xfe, 0xbf, 0x1a, 0xe9, 0x4a, 0xb6, 0x3f, 0x0f,
+	0xeb, 0xef, 0x9c, 0xe2, 0x7d, 0x85, 0xa6, 0x58, 0xa0, 0x34, 0x13, 0x77, 0x26, 0x9d, 0xb6, 0x27,
+	0xb8, 0x6d, 0x03, 0x93, 0xb1, 0xbb, 0x98, 0xc0, 0x04, 0xdf, 0xd5, 0xab, 0x94, 0x21, 0x86, 0x69,
+	0xec, 0x9c, 0xf9, 0xdf, 0xb1, 0x4f, 0xf1, 0x3e, 0x35, 0xf6, 0x34, 0xae, 0xa3, 0x60, 0xf6, 0xb7,
+	0xe0, 0xe2, 0x54, 0xe6, 0x5c, 0x40, 0x26, 0x02, 0x79, 0x2a, 0x9c, 0x82, 0x8a, 0x5e, 0x7d, 0x2d,
+	0xfa, 0xee, 0xe8, 0xc8, 0xe8, 0x1d, 0xba, 0x37, 0x66, 0x9f, 0xea, 0xdf, 0x0d, 0x49, 0x23, 0x81,
+	0x76, 0x15, 0x9c, 0x4d, 0x91, 0x80, 0x31, 0x14, 0xd0, 0x59, 0x58, 0xb5, 0xd6, 0x4a, 0xfe, 0x58,
+	0x6e, 0x7c, 0x06, 0x8a, 0xe3, 0xa9, 0xd8, 0x2b, 0xa0, 0xa8, 0xfc, 0x85, 0x40, 0xcc, 0xb1, 0xe4,
+	0xf4, 0xfd, 0x89, 0xc2, 0xbe, 0x00, 0xce, 0x44, 0x7d, 0x88, 0xf5, 0x62, 0x17, 0x7d, 0x2d, 0x34,
+	0x36, 0x40, 0x71, 0x97, 0xee, 0x21, 0xd2, 0x81, 0x98, 0xd9, 0xcb, 0xe0, 0x6c, 0xd2, 0x0c, 0x62,
+	0x44, 0x68, 0x6a, 0xfc, 0x17, 0x92, 0xe6, 0x96, 0x14, 0x95, 0xa9, 0x65, 0x4c, 0x73, 0xc6, 0xd4,
+	0x52, 0xa6, 0xc6, 0x0e, 0x28, 0xed, 0x74, 0xc5, 0x66, 0x02, 0x39, 0x57, 0x2c, 0x35, 0xb0, 0x98,
+	0x34, 0x83, 0x48, 0xca, 0x01, 0x8e, 0x47, 0x89, 0x24, 0x4d, 0x85, 0xd8, 0x8e, 0x95, 0xbd, 0x35,
+	0xb1, 0xcf, 0x19, 0x7b, 0xcb, 0xd8, 0x1b, 0xd7, 0xc0, 0xa5, 0x0e, 0xa3, 0x43, 0x44, 0xbe, 0xc2,
+	0xa2, 0x1f, 0x33, 0x78, 0x1b, 0x26, 0x6d, 0x2c, 0x52, 0x98, 0xc9, 0x12, 0x30, 0x89, 0xd1, 0xbe,
+	0xe2, 0xcc, 0xfb, 0x5a, 0xb0, 0x2f, 0x81, 0x42, 0xa8, 0xec, 0x8a, 0xaa, 0xe4, 0x1b, 0xa9, 0xf1,
+	0xb3, 0x05, 0xce, 0x6d, 0x9d, 0x3c, 0x93, 0xb2, 0x9b, 0x1c, 0x7d, 0x3f, 0x40, 0x24, 0x42, 0x86,
+	0x66, 0x2c, 0xdb, 0x35, 0x00, 0x26, 0x57, 0x81, 0x61, 0x9b, 0xd2, 0xd8, 0x9f, 0x82, 0xbc, 0x9a,
+	0xeb, 0xfc, 0x9b, 0xce, 0x55, 0xb9, 0x35, 0x9e, 0x58, 0xa0, 0x70, 0x7d, 0x20, 0xb2, 0x81, 0xb0,
+	0xeb, 0x60, 0x91, 0xaa, 0x5f, 0x01, 0xa3, 0x54, 0xa8, 0x44, 0x4a, 0x3e, 0xd0, 0x2a, 0x9f, 0x52,
+	0x61, 0x7f, 0x09, 0xca, 0x49, 0x33, 0x08, 0x13, 0x1a, 0xed, 0xe9, 0x5d, 0x9a, 0x7b, 0xd3, 0x98,
+	0x8b, 0x49, 0xb3, 0x2d, 0xdd, 0xd5, 0x0e, 0xbd, 0x07, 0x2a, 0x49, 0xcb, 0xd0, 0x91, 0x41, 0x1a,
+	0x22, 0xa6, 0x8a, 0xc8, 0xfb, 0xe5, 0xa4, 0xa5, 0x50, 0x3b, 0x4a, 0x69, 0x3b, 0x60, 0x61, 0x88,
+	0x98, 0xdc, 0x3f, 0x75, 0x6c, 0x4b, 0xfe, 0x48, 0x6c, 0x3c, 0xb7, 0x00, 0x18, 0x75, 0x53, 0x8f,
+	0x62, 0x7a, 0x4f, 0xb4, 0x60, 0xdf, 0x02, 0x4b, 0x29, 0xdc, 0x0f, 0x04, 0x15, 0x30, 0x09, 0x24,
+	0x2d, 0x32, 0xf3, 0x6d, 0x7f, 0x20, 0x93, 0xfb, 0xed, 0xb0, 0x7e, 0x51, 0x5f, 0x45, 0x3c, 0xde,
+	0x73, 0x31, 0xf5, 0x52, 0x28, 0xfa, 0xee, 0x36, 0x11, 0xcf, 0x1e, 0xaf, 0x03, 0x6d, 0x90, 0x92,
+	0xce, 0xff, 0xad, 0x14, 0xee, 0xef, 0x4a, 0xa2, 0x2f, 0x14, 0x8f, 0xfd, 0x35, 0xa8, 0x48, 0xee,
+	0x0c, 0xb1, 0xc0, 0xdc, 0xb4, 0xce, 0xfc, 0x8c, 0xd4, 0xe5, 0x14, 0xee, 0x77, 0x10, 0x33, 0xe5,
+	0x34, 0x5e, 0xce, 0x83, 0x25, 0x7d, 0x03, 0x6f, 0x44, 0x11, 0x1d, 0x10, 0x81, 0x49, 0xef, 0x1f,
+	0x0a, 0xfc, 0x06, 0x2c, 0x99, 0xe0, 0x28, 0x0e, 0x60, 0x2a, 0xc1, 0x33, 0x17, 0x58, 0x19, 0x33,
+	0x6d, 0x28, 0x22, 0x49, 0x7e, 0xdb, 0xac, 0x3c, 0x19, 0x91, 0xcf, 0x5a, 0x62, 0x65, 0xcc, 0x64,
+	0xc8, 0xdf, 0x05, 0xe5, 0xc9, 0x73, 0x27, 0x99, 0xf3, 0x6a, 0xfe, 0xa5, 0xf1, 0xab, 0x25, 0x41,
+	0xef, 0x4f, 0x32, 0x80, 0x89, 0xc1, 0x9d, 0x51, 0xb8, 0xca, 0x44, 0xaf, 0xa1, 0x37, 0x41, 0x39,
+	0xc5, 0x64, 0xaa, 0x0d, 0x85, 0x19, 0x33, 0x2d, 0x69, 0x1a, 0x93, 0xe6, 0x4d, 0x50, 0x0e, 0x07,
+	0x8c, 0x4c, 0x68, 0x17, 0x66, 0xa5, 0xd5, 0x34, 0x9a, 0xb6, 0xf1, 0x8b, 0x05, 0xce, 0x8f, 0x2f,
+	0x4a, 0x79, 0xaf, 0x98, 0x73, 0xf8, 0xf7, 0x97, 0xcf, 0x9a, 0xf5, 0xe5, 0xfb, 0x04, 0x14, 0xf4,
+	0xd9, 0x35, 0xc7, 0xd4, 0x79, 0x9d, 0x42, 0x07, 0x9c, 0xf6, 0x37, 0x2e, 0xed, 0x9d, 0x83, 0xdf,
+	0x6b, 0xb9, 0x87, 0x47, 0x35, 0xeb, 0xe0, 0xa8, 0x66, 0x3d, 0x3d, 0xaa, 0x59, 0x2f, 0x8f, 0x6a,
+	0xd6, 0xbd, 0xe3, 0x5a, 0xee, 0xe9, 0x71, 0x2d, 0xf7, 0xfc, 0xb8, 0x96, 0xbb, 0x75, 0x65, 0xea,
+	0x53, 0x47, 0xd2, 0x62, 0xb8, 0x9e, 0xc0, 0x90, 0x7b, 0xd7, 0x3b, 0x52, 0xf2, 0xf6, 0x47, 0x1f,
+	0x88, 0xea, 0xa3, 0x27, 0x2c, 0xa8, 0xbb, 0xe1, 0xc3, 0xbf, 0x06, 0x00, 0x96, 0x76, 0xd7, 0x67,
+	0x3e, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -377,6 +633,9 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.DepositCommitmentRetention != that1.DepositCommitmentRetention {
+		return false
+	}
 	return true
 }
 func (this *BridgeConfig) Equal(that interface{}) bool {
@@ -480,6 +739,90 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *NftClassPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NftClassPair)
+	if !ok {
+		that2, ok := that.(NftClassPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.L1ClassId != that1.L1ClassId {
+		return false
+	}
+	if this.L2ClassId != that1.L2ClassId {
+		return false
+	}
+	return true
+}
+func (this *ProvenWithdrawalBitmap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProvenWithdrawalBitmap)
+	if !ok {
+		that2, ok := that.(ProvenWithdrawalBitmap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !bytes.Equal(this.Bitmap, that1.Bitmap) {
+		return false
+	}
+	return true
+}
+func (this *DepositCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DepositCommitment)
+	if !ok {
+		that2, ok := that.(DepositCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if !bytes.Equal(this.Commitment, that1.Commitment) {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
 func (this *Output) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -508,6 +851,81 @@ func (this *Output) Equal(that interface{}) bool {
 	if this.L2BlockNumber != that1.L2BlockNumber {
 		return false
 	}
+	if !bytes.Equal(this.Version, that1.Version) {
+		return false
+	}
+	return true
+}
+func (this *DepositCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DepositCap)
+	if !ok {
+		that2, ok := that.(DepositCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MaxTotalLocked.Equal(that1.MaxTotalLocked) {
+		return false
+	}
+	if !this.MaxPerDeposit.Equal(that1.MaxPerDeposit) {
+		return false
+	}
+	return true
+}
+func (this *BridgeAccounting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BridgeAccounting)
+	if !ok {
+		that2, ok := that.(BridgeAccounting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.DepositedAmount.Equal(that1.DepositedAmount) {
+		return false
+	}
+	if !this.WithdrawnAmount.Equal(that1.WithdrawnAmount) {
+		return false
+	}
+	if this.DepositCount != that1.DepositCount {
+		return false
+	}
+	if this.WithdrawalCount != that1.WithdrawalCount {
+		return false
+	}
+	if !this.MintedAmount.Equal(that1.MintedAmount) {
+		return false
+	}
+	if !this.BurnedAmount.Equal(that1.BurnedAmount) {
+		return false
+	}
 	return true
 }
 func (this *BatchInfoWithOutput) Equal(that interface{}) bool {
@@ -557,6 +975,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DepositCommitmentRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DepositCommitmentRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if len(m.RegistrationFee) > 0 {
 		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -601,29 +1027,29 @@ func (m *BridgeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmissionStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmissionStartTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FinalizationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FinalizationPeriod):])
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmissionStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmissionStartTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x2a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SubmissionInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SubmissionInterval):])
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FinalizationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FinalizationPeriod):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintTypes(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SubmissionInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SubmissionInterval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x22
 	{
 		size, err := m.BatchInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -728,6 +1154,121 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NftClassPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NftClassPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NftClassPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L2ClassId) > 0 {
+		i -= len(m.L2ClassId)
+		copy(dAtA[i:], m.L2ClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.L2ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.L1ClassId) > 0 {
+		i -= len(m.L1ClassId)
+		copy(dAtA[i:], m.L1ClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.L1ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvenWithdrawalBitmap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvenWithdrawalBitmap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvenWithdrawalBitmap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Output) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,17 +1289,24 @@ func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.L2BlockNumber != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.L2BlockNumber))
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.L1BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.L1BlockTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.L1BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.L1BlockTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if len(m.OutputRoot) > 0 {
@@ -771,6 +1319,136 @@ func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DepositCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPerDeposit.Size()
+		i -= size
+		if _, err := m.MaxPerDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxTotalLocked.Size()
+		i -= size
+		if _, err := m.MaxTotalLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedAmount.Size()
+		i -= size
+		if _, err := m.BurnedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MintedAmount.Size()
+		i -= size
+		if _, err := m.MintedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.WithdrawalCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WithdrawalCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.WithdrawnAmount.Size()
+		i -= size
+		if _, err := m.WithdrawnAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DepositedAmount.Size()
+		i -= size
+		if _, err := m.DepositedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BatchInfoWithOutput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -837,6 +1515,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DepositCommitmentRetention)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -905,6 +1585,57 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *NftClassPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.L1ClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.L2ClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvenWithdrawalBitmap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Output) Size() (n int) {
 	if m == nil {
 		return 0
@@ -920,6 +1651,54 @@ func (m *Output) Size() (n int) {
 	if m.L2BlockNumber != 0 {
 		n += 1 + sovTypes(uint64(m.L2BlockNumber))
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MaxTotalLocked.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxPerDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *BridgeAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.DepositedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.WithdrawnAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DepositCount != 0 {
+		n += 1 + sovTypes(uint64(m.DepositCount))
+	}
+	if m.WithdrawalCount != 0 {
+		n += 1 + sovTypes(uint64(m.WithdrawalCount))
+	}
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1005,11 +1784,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCommitmentRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DepositCommitmentRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
@@ -1145,18 +1957,782 @@ func (m *BridgeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SubmissionInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FinalizationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmissionStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NftClassPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NftClassPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NftClassPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvenWithdrawalBitmap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvenWithdrawalBitmap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvenWithdrawalBitmap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1166,28 +2742,29 @@ func (m *BridgeConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SubmissionInterval, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OutputRoot = append(m.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputRoot == nil {
+				m.OutputRoot = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field L1BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1214,15 +2791,15 @@ func (m *BridgeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FinalizationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.L1BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionStartTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
 			}
-			var msglen int
+			m.L2BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1232,28 +2809,14 @@ func (m *BridgeConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.L2BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmissionStartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1280,9 +2843,9 @@ func (m *BridgeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1306,7 +2869,7 @@ func (m *BridgeConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchInfo) Unmarshal(dAtA []byte) error {
+func (m *DepositCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1329,15 +2892,15 @@ func (m *BatchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1365,11 +2928,11 @@ func (m *BatchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalLocked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1397,7 +2960,43 @@ func (m *BatchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxTotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1420,7 +3019,7 @@ func (m *BatchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *BridgeAccounting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1443,15 +3042,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeAccounting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L1Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,11 +3078,11 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L1Denom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,63 +3110,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L2Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.DepositedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Output) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1577,31 +3128,69 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputRoot = append(m.OutputRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.OutputRoot == nil {
-				m.OutputRoot = []byte{}
+			if err := m.WithdrawnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+			}
+			m.WithdrawalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L1BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1611,30 +3200,31 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.L1BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2BlockNumber", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
 			}
-			m.L2BlockNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1644,11 +3234,26 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.L2BlockNumber |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/OPinit/x/ophost/types/merkle"
+)
+
+const (
+	// WithdrawalLeafVersionV1 is the legacy leaf format which separates the
+	// variable length fields with Splitter and encodes the amount as uint64.
+	WithdrawalLeafVersionV1 = byte(1)
+	// WithdrawalLeafVersionV2 is the leaf format with length-prefixed fields
+	// and a 256-bit amount.
+	WithdrawalLeafVersionV2 = byte(2)
+)
+
+// GenerateWithdrawalHash returns the double hashed withdrawal leaf encoded
+// with the given leaf version.
+func GenerateWithdrawalHash(
+	leafVersion byte,
+	bridgeId, l2Sequence uint64,
+	sender, receiver []byte,
+	denom string,
+	amount math.Int,
+) ([32]byte, error) {
+	if amount.IsNegative() {
+		return [32]byte{}, ErrInvalidAmount
+	}
+
+	switch leafVersion {
+	case WithdrawalLeafVersionV1:
+		if !amount.IsUint64() {
+			return [32]byte{}, ErrInvalidAmount.Wrap("amount exceeds the v1 withdrawal leaf limit")
+		}
+
+		return GenerateWithdrawalHashV1(bridgeId, l2Sequence, sender, receiver, denom, amount.Uint64()), nil
+	case WithdrawalLeafVersionV2:
+		return GenerateWithdrawalHashV2(bridgeId, l2Sequence, sender, receiver, denom, amount), nil
+	}
+
+	return [32]byte{}, ErrFailedToVerifyWithdrawal.Wrapf("unknown withdrawal leaf version %d", leafVersion)
+}
+
+// GenerateWithdrawalHashV1 returns the double hashed v1 withdrawal leaf.
+func GenerateWithdrawalHashV1(
+	bridgeId, l2Sequence uint64,
+	sender, receiver []byte,
+	denom string,
+	amount uint64,
+) [32]byte {
+	seed := []byte{}
+	seed = binary.BigEndian.AppendUint64(seed, bridgeId)
+	seed = binary.BigEndian.AppendUint64(seed, l2Sequence)
+	// variable length
+	seed = append(seed, sender...)
+	seed = append(seed, Splitter)
+	// variable length
+	seed = append(seed, receiver...)
+	seed = append(seed, Splitter)
+	// variable length
+	seed = append(seed, []byte(denom)...)
+	seed = append(seed, Splitter)
+	seed = binary.BigEndian.AppendUint64(seed, amount)
+
+	return merkle.HashLeaf(seed)
+}
+
+// GenerateWithdrawalHashV2 returns the double hashed v2 withdrawal leaf;
+//
+//	leaf_version(1) || bridge_id(8) || l2_sequence(8) ||
+//	len(sender)(4) || sender || len(receiver)(4) || receiver ||
+//	len(denom)(4) || denom || amount(32)
+//
+// All integers are big endian. The amount must be non-negative.
+func GenerateWithdrawalHashV2(
+	bridgeId, l2Sequence uint64,
+	sender, receiver []byte,
+	denom string,
+	amount math.Int,
+) [32]byte {
+	seed := []byte{WithdrawalLeafVersionV2}
+	seed = binary.BigEndian.AppendUint64(seed, bridgeId)
+	seed = binary.BigEndian.AppendUint64(seed, l2Sequence)
+	seed = appendLengthPrefixed(seed, sender)
+	seed = appendLengthPrefixed(seed, receiver)
+	seed = appendLengthPrefixed(seed, []byte(denom))
+
+	var amountBz [32]byte
+	amount.BigInt().FillBytes(amountBz[:])
+	seed = append(seed, amountBz[:]...)
+
+	return merkle.HashLeaf(seed)
+}
+
+func appendLengthPrefixed(bz []byte, field []byte) []byte {
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(field)))
+	return append(bz, field...)
+}